@@ -33,6 +33,10 @@ public class ControllersConfigurationProperties {
     private int maxIntegrationsPerUser = 1;
     private int maxDeploymentsPerUser = 1;
     private int integrationStateCheckInterval = 60;
+    private int maxConcurrentDeployments = 1;
+
+    @NestedConfigurationProperty
+    private final RetryBackoff retryBackoff = new RetryBackoff();
 
     @NestedConfigurationProperty
     private final CamelK camelk = new CamelK();
@@ -61,10 +65,63 @@ public class ControllersConfigurationProperties {
         return integrationStateCheckInterval;
     }
 
+    public int getMaxConcurrentDeployments() {
+        return maxConcurrentDeployments;
+    }
+
+    public void setMaxConcurrentDeployments(int maxConcurrentDeployments) {
+        this.maxConcurrentDeployments = maxConcurrentDeployments;
+    }
+
+    public RetryBackoff getRetryBackoff() {
+        return retryBackoff;
+    }
+
     public CamelK getCamelk() {
         return camelk;
     }
 
+    /**
+     * Delays between the attempts of a failed deployment, in seconds. Failed
+     * deployments are not retried when the initial delay is 0.
+     */
+    public static class RetryBackoff {
+        private int initialDelay;
+        private int maxDelay = 600;
+
+        public int getInitialDelay() {
+            return initialDelay;
+        }
+
+        public void setInitialDelay(int initialDelay) {
+            this.initialDelay = initialDelay;
+        }
+
+        public int getMaxDelay() {
+            return maxDelay;
+        }
+
+        public void setMaxDelay(int maxDelay) {
+            this.maxDelay = maxDelay;
+        }
+
+        public boolean isEnabled() {
+            return initialDelay > 0;
+        }
+
+        /**
+         * Delay before the given attempt, the first retry being attempt 1,
+         * doubled at every attempt up to the max delay.
+         */
+        public long delay(int attempt) {
+            long delay = initialDelay;
+            for (int i = 1; i < attempt && delay < maxDelay; i++) {
+                delay *= 2;
+            }
+            return Math.min(delay, Math.max(maxDelay, initialDelay));
+        }
+    }
+
     public static class CamelK {
         private boolean compression;
         private boolean prettyPrint;
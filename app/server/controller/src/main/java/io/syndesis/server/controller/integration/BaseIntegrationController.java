@@ -54,9 +54,11 @@ public abstract class BaseIntegrationController implements BackendController {
     private final EventBus eventBus;
     private final ConcurrentHashMap<IntegrationDeploymentState, StateChangeHandler> handlers = new ConcurrentHashMap<>();
     private final Set<String> scheduledChecks = ConcurrentHashMap.newKeySet();
+    private final ConcurrentHashMap<String, Integer> failedAttempts = new ConcurrentHashMap<>();
     private final ControllersConfigurationProperties properties;
 
     private ExecutorService executor;
+    private ExecutorService deployments;
     private ScheduledExecutorService scheduler;
 
     protected BaseIntegrationController(OpenShiftService openShiftService, DataManager dataManager, EventBus eventBus,
@@ -95,6 +97,7 @@ public abstract class BaseIntegrationController implements BackendController {
     @SuppressWarnings("FutureReturnValueIgnored")
     protected void doStart() {
         executor = Executors.newSingleThreadExecutor(Threads.newThreadFactory("Integration Controller"));
+        deployments = Executors.newFixedThreadPool(Math.max(1, properties.getMaxConcurrentDeployments()), Threads.newThreadFactory("Integration Deployments"));
         scheduler = Executors.newScheduledThreadPool(2, Threads.newThreadFactory("Integration Controller Scheduler"));
 
         scheduler.scheduleAtFixedRate(this::scanIntegrationsForWork, 0, properties.getIntegrationStateCheckInterval(), TimeUnit.SECONDS);
@@ -106,14 +109,17 @@ public abstract class BaseIntegrationController implements BackendController {
 
         scheduler.shutdownNow();
         executor.shutdownNow();
+        deployments.shutdownNow();
         try {
             boolean schedulerStopped = false;
             boolean executorStopped = false;
+            boolean deploymentsStopped = false;
 
             do {
                 schedulerStopped = scheduler.awaitTermination(10, TimeUnit.SECONDS);
                 executorStopped = executor.awaitTermination(10, TimeUnit.SECONDS);
-            } while (!schedulerStopped && !executorStopped);
+                deploymentsStopped = deployments.awaitTermination(10, TimeUnit.SECONDS);
+            } while (!schedulerStopped && !executorStopped && !deploymentsStopped);
         } catch (final InterruptedException e) {
             LOG.warn("Unable to cleanly stop: {}", e.getMessage());
             LOG.debug("Interrupted while stopping", e);
@@ -199,10 +205,10 @@ public abstract class BaseIntegrationController implements BackendController {
 
     void callStateChangeHandler(StateChangeHandler handler, IntegrationDeployment integrationDeployment) {
         String integrationDeploymentId = integrationDeployment.getId().get();
-        executor.execute(() -> {
-            String checkKey = getIntegrationMarkerKey(integrationDeployment);
-            scheduledChecks.add(checkKey);
-
+        // Marked before waiting for a deployment thread, so that the deployment is not queued twice
+        String checkKey = getIntegrationMarkerKey(integrationDeployment);
+        scheduledChecks.add(checkKey);
+        deployments.execute(() -> {
             if (stale(handler, integrationDeployment)) {
                 scheduledChecks.remove(checkKey);
                 return;
@@ -235,6 +241,7 @@ public abstract class BaseIntegrationController implements BackendController {
                         dataManager.update(updated.builder().updatedAt(System.currentTimeMillis()).build());
                     }
                 });
+                failedAttempts.remove(integrationDeploymentId);
             } catch (Exception e) {
                 LOG.error("Error while processing integration status for integration {}", integrationDeploymentId, e);
                 // Something went wrong.. lets note it.
@@ -245,7 +252,7 @@ public abstract class BaseIntegrationController implements BackendController {
                     .statusMessage(Exceptions.toString(e))
                     .updatedAt(System.currentTimeMillis())
                     .build());
-
+                retry(handler, integrationDeploymentId);
             } finally {
                 // Add a next check for the next interval
                 reschedule(integrationDeploymentId, checkKey);
@@ -267,6 +274,29 @@ public abstract class BaseIntegrationController implements BackendController {
         );
     }
 
+    @SuppressWarnings("FutureReturnValueIgnored")
+    private void retry(StateChangeHandler handler, String integrationDeploymentId) {
+        ControllersConfigurationProperties.RetryBackoff backoff = properties.getRetryBackoff();
+        if (!backoff.isEnabled()) {
+            return;
+        }
+        int attempt = failedAttempts.merge(integrationDeploymentId, 1, Integer::sum);
+        long delay = backoff.delay(attempt);
+        LOG.info("IntegrationDeploymentId {} : Retrying the failed deployment in {} seconds, attempt {}", integrationDeploymentId, delay, attempt);
+        scheduler.schedule(() -> {
+                IntegrationDeployment current = dataManager.fetch(IntegrationDeployment.class, integrationDeploymentId);
+                // Deployments changed since they failed are left to the state checks
+                if (current != null && current.getCurrentState() == IntegrationDeploymentState.Error && handlers.get(current.getTargetState()) == handler) {
+                    callStateChangeHandler(handler, current);
+                } else {
+                    failedAttempts.remove(integrationDeploymentId);
+                }
+            },
+            delay,
+            TimeUnit.SECONDS
+        );
+    }
+
     private static String getIntegrationMarkerKey(IntegrationDeployment integrationDeployment) {
         return integrationDeployment.getTargetState() +
                ":" +
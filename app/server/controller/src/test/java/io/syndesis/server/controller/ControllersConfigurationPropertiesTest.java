@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2016 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package io.syndesis.server.controller;
+
+import org.junit.Test;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+public class ControllersConfigurationPropertiesTest {
+    @Test
+    public void shouldNotRetryByDefault() {
+        assertThat(new ControllersConfigurationProperties().getRetryBackoff().isEnabled()).isFalse();
+    }
+
+    @Test
+    public void shouldDoubleTheRetryDelayUpToTheMaxDelay() {
+        ControllersConfigurationProperties.RetryBackoff backoff = new ControllersConfigurationProperties().getRetryBackoff();
+        backoff.setInitialDelay(10);
+        backoff.setMaxDelay(60);
+
+        assertThat(backoff.isEnabled()).isTrue();
+        assertThat(backoff.delay(1)).isEqualTo(10);
+        assertThat(backoff.delay(2)).isEqualTo(20);
+        assertThat(backoff.delay(3)).isEqualTo(40);
+        assertThat(backoff.delay(4)).isEqualTo(60);
+        assertThat(backoff.delay(100)).isEqualTo(60);
+    }
+
+    @Test
+    public void shouldKeepTheInitialDelayAboveTheMaxDelay() {
+        ControllersConfigurationProperties.RetryBackoff backoff = new ControllersConfigurationProperties().getRetryBackoff();
+        backoff.setInitialDelay(900);
+
+        assertThat(backoff.delay(3)).isEqualTo(900);
+    }
+}
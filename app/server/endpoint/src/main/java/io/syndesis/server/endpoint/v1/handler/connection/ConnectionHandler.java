@@ -27,12 +27,14 @@ import javax.servlet.http.HttpServletRequest;
 import javax.servlet.http.HttpServletResponse;
 import javax.validation.Validator;
 import javax.validation.constraints.NotNull;
+import javax.ws.rs.ClientErrorException;
 import javax.ws.rs.GET;
 import javax.ws.rs.Path;
 import javax.ws.rs.PathParam;
 import javax.ws.rs.Produces;
 import javax.ws.rs.core.Context;
 import javax.ws.rs.core.MediaType;
+import javax.ws.rs.core.Response;
 import javax.ws.rs.core.SecurityContext;
 import javax.ws.rs.core.UriInfo;
 
@@ -81,15 +83,18 @@ public class ConnectionHandler
 
     private final MetadataConfigurationProperties config;
     private final EncryptionComponent encryptionComponent;
+    private final ConnectorsConfigurationProperties connectors;
 
     public ConnectionHandler(final DataManager dataMgr, final Validator validator, final Credentials credentials,
-                             final ClientSideState state, final MetadataConfigurationProperties config, final EncryptionComponent encryptionComponent) {
+                             final ClientSideState state, final MetadataConfigurationProperties config, final EncryptionComponent encryptionComponent,
+                             final ConnectorsConfigurationProperties connectors) {
         super(dataMgr);
         this.validator = validator;
         this.credentials = credentials;
         this.state = state;
         this.config = config;
         this.encryptionComponent = encryptionComponent;
+        this.connectors = connectors;
     }
 
     @Override
@@ -144,6 +149,7 @@ public class ConnectionHandler
 
     @Override
     public Connection create(@Context SecurityContext sec, final Connection connection) {
+        checkConnectorAllowed(connection);
         final Date rightNow = new Date();
 
         // Lets make sure we store encrypt secrets.
@@ -191,6 +197,13 @@ public class ConnectionHandler
             }).findFirst().orElse(connection);
     }
 
+    private void checkConnectorAllowed(final Connection connection) {
+        final String connectorId = connection.getConnectorId();
+        if (!connectors.isAllowed(connectorId)) {
+            throw new ClientErrorException("Connector " + connectorId + " is not allowed", Response.Status.FORBIDDEN);
+        }
+    }
+
     private Map<String, ConfigurationProperty> getConnectorProperties(String connectorId) {
         Connector connector = getDataManager().fetch(Connector.class, connectorId);
         if (connector != null) {
@@ -202,6 +215,7 @@ public class ConnectionHandler
 
     @Override
     public void update(final String id, final Connection connection) {
+        checkConnectorAllowed(connection);
         // Lets make sure we store encrypt secrets.
         Map<String, String> configuredProperties = connection.getConfiguredProperties();
         Map<String, ConfigurationProperty> connectorProperties = getConnectorProperties(connection.getConnectorId());
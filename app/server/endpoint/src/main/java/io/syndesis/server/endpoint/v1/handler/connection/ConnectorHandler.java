@@ -79,10 +79,11 @@ public class ConnectorHandler extends BaseHandler implements Lister<Connector>,
     private final Inspectors inspectors;
     private final ClientSideState state;
     private final Verifier verifier;
+    private final ConnectorsConfigurationProperties connectors;
 
     public ConnectorHandler(final DataManager dataMgr, final Verifier verifier, final Credentials credentials, final Inspectors inspectors,
                             final ClientSideState state, final EncryptionComponent encryptionComponent, final ApplicationContext applicationContext,
-                            final IconDao iconDao, final FileDataManager extensionDataManager) {
+                            final IconDao iconDao, final FileDataManager extensionDataManager, final ConnectorsConfigurationProperties connectors) {
         super(dataMgr);
         this.verifier = verifier;
         this.credentials = credentials;
@@ -92,6 +93,7 @@ public class ConnectorHandler extends BaseHandler implements Lister<Connector>,
         this.applicationContext = applicationContext;
         this.iconDao = iconDao;
         this.extensionDataManager = extensionDataManager;
+        this.connectors = connectors;
     }
 
     @Path("/{id}/credentials")
@@ -162,7 +164,8 @@ public class ConnectorHandler extends BaseHandler implements Lister<Connector>,
 
     @Override
     public ListResult<Connector> list(final UriInfo uriInfo) {
-        final List<Connector> connectors = Lister.super.list(uriInfo).getItems().stream()
+        final List<Connector> allowedConnectors = Lister.super.list(uriInfo).getItems().stream()
+            .filter(c -> connectors.isAllowed(c.getId().get()))
             .map(c -> {
                 final APISummary summary = new APISummary.Builder().createFrom(c).build();
 
@@ -170,7 +173,7 @@ public class ConnectorHandler extends BaseHandler implements Lister<Connector>,
             })
             .collect(Collectors.toList());
 
-        return ListResult.of(augmentedWithUsage(connectors));
+        return ListResult.of(augmentedWithUsage(allowedConnectors));
     }
 
     @Override
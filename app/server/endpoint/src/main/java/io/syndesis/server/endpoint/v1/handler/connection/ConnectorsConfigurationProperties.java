@@ -0,0 +1,51 @@
+/*
+ * Copyright (C) 2016 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package io.syndesis.server.endpoint.v1.handler.connection;
+
+import java.util.ArrayList;
+import java.util.List;
+
+import org.springframework.boot.context.properties.ConfigurationProperties;
+import org.springframework.context.annotation.Configuration;
+
+/**
+ * Connectors the platform owners allow or deny, a denied connector is
+ * neither listed nor usable in new connections. All the connectors are
+ * allowed when none is listed as allowed.
+ */
+@Configuration
+@ConfigurationProperties("connectors")
+public class ConnectorsConfigurationProperties {
+
+    private final List<String> allowed = new ArrayList<>();
+
+    private final List<String> denied = new ArrayList<>();
+
+    public List<String> getAllowed() {
+        return allowed;
+    }
+
+    public List<String> getDenied() {
+        return denied;
+    }
+
+    public boolean isAllowed(String connectorId) {
+        if (denied.contains(connectorId)) {
+            return false;
+        }
+        return allowed.isEmpty() || allowed.contains(connectorId);
+    }
+}
@@ -70,6 +70,10 @@ import com.fasterxml.jackson.databind.ObjectWriter;
  * Cookie with {@link #persist(String, String, Object)} method, and restore the
  * state with {@link #restoreFrom(Cookie, Class)} method.
  * <p>
+ * When the keys are rotated, the state persisted with the previous
+ * {@link Edition} is still restored if it is given, the new state being
+ * persisted with the current one only.
+ * <p>
  * The implementation follows the
  * <a href="https://tools.ietf.org/html/rfc6896">RFC6896</a> Secure Cookie
  * Sessions for HTTP.
@@ -94,6 +98,8 @@ public final class ClientSideState {
 
     private final Supplier<byte[]> ivSource;
 
+    private final Edition previousEdition;
+
     private final Function<Object, byte[]> serialization;
 
     private final int timeout;
@@ -155,6 +161,11 @@ public final class ClientSideState {
             ClientSideState::deserialize, DEFAULT_TIMEOUT);
     }
 
+    public ClientSideState(final Edition edition, final Edition previousEdition) {
+        this(edition, previousEdition, ClientSideState::currentTimestmpUtc, new RandomIvSource(), ClientSideState::serialize,
+            ClientSideState::deserialize, DEFAULT_TIMEOUT);
+    }
+
     public ClientSideState(final Edition edition, final int timeout) {
         this(edition, ClientSideState::currentTimestmpUtc, new RandomIvSource(), ClientSideState::serialize, ClientSideState::deserialize,
             timeout);
@@ -165,8 +176,14 @@ public final class ClientSideState {
     }
 
     ClientSideState(final Edition edition, final LongSupplier timeSource, final Supplier<byte[]> ivSource,
+        final Function<Object, byte[]> serialization, final BiFunction<Class<?>, byte[], Object> deserialization, final int timeout) {
+        this(edition, null, timeSource, ivSource, serialization, deserialization, timeout);
+    }
+
+    ClientSideState(final Edition edition, final Edition previousEdition, final LongSupplier timeSource, final Supplier<byte[]> ivSource,
         final Function<Object, byte[]> serialization, final BiFunction<Class<?>, byte[], Object> deserialization, final int timeout) {
         this.edition = edition;
+        this.previousEdition = previousEdition;
         this.timeSource = timeSource;
         this.ivSource = ivSource;
         this.serialization = serialization;
@@ -247,15 +264,12 @@ public final class ClientSideState {
         }
 
         final byte[] tid = DECODER.decode(parts[2]);
-        if (!MessageDigest.isEqual(tid, edition.tid)) {
-            throw new IllegalArgumentException(String.format("Given TID `%s`, mismatches current TID `%s`",
-                new BigInteger(tid).toString(16), new BigInteger(edition.tid).toString(16)));
-        }
+        final Edition persistedWith = editionOf(tid);
 
-        final KeySource keySource = edition.keySource();
+        final KeySource keySource = persistedWith.keySource();
         final int lastSeparatorIdx = value.lastIndexOf('|');
         final byte[] mac = DECODER.decode(parts[4]);
-        final byte[] calculated = mac(edition.authenticationAlgorithm, value.substring(0, lastSeparatorIdx),
+        final byte[] calculated = mac(persistedWith.authenticationAlgorithm, value.substring(0, lastSeparatorIdx),
             keySource.authenticationKey());
         if (!MessageDigest.isEqual(mac, calculated)) {
             throw new IllegalArgumentException("Cookie value fails authenticity check");
@@ -263,7 +277,7 @@ public final class ClientSideState {
 
         final byte[] iv = DECODER.decode(parts[3]);
         final byte[] encrypted = DECODER.decode(parts[0]);
-        final byte[] clear = decrypt(edition.encryptionAlgorithm, iv, encrypted, keySource.encryptionKey());
+        final byte[] clear = decrypt(persistedWith.encryptionAlgorithm, iv, encrypted, keySource.encryptionKey());
 
         @SuppressWarnings("unchecked")
         final T ret = (T) deserialization.apply(type, clear);
@@ -271,6 +285,19 @@ public final class ClientSideState {
         return new TimestampedState<>(ret, atimeLong);
     }
 
+    private Edition editionOf(final byte[] tid) {
+        if (MessageDigest.isEqual(tid, edition.tid)) {
+            return edition;
+        }
+
+        if (previousEdition != null && MessageDigest.isEqual(tid, previousEdition.tid)) {
+            return previousEdition;
+        }
+
+        throw new IllegalArgumentException(String.format("Given TID `%s`, mismatches current TID `%s`",
+            new BigInteger(tid).toString(16), new BigInteger(edition.tid).toString(16)));
+    }
+
     static long atime(final byte[] atime) {
         final String timeAsStr = new String(atime, StandardCharsets.US_ASCII);
 
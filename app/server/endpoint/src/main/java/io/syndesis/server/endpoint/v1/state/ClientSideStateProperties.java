@@ -40,6 +40,12 @@ public class ClientSideStateProperties {
 
     private String encryptionKey;
 
+    private String previousAuthenticationKey;
+
+    private String previousEncryptionKey;
+
+    private Long previousTid;
+
     private Long tid;
 
     public boolean areSet() {
@@ -63,10 +69,30 @@ public class ClientSideStateProperties {
         return value(encryptionKey, this::setEncryptionKey, ClientSideStateProperties::generateKey);
     }
 
+    public String getPreviousAuthenticationKey() {
+        return previousAuthenticationKey;
+    }
+
+    public String getPreviousEncryptionKey() {
+        return previousEncryptionKey;
+    }
+
+    public Long getPreviousTid() {
+        return previousTid;
+    }
+
     public long getTid() {
         return value(tid, this::setTid, () -> Long.valueOf(RANDOM.nextLong()));
     }
 
+    /**
+     * Whether the keys in use before their last rotation are given, the state
+     * they protect being still restored.
+     */
+    public boolean hasPrevious() {
+        return previousTid != null && !StringUtils.isEmpty(previousAuthenticationKey) && !StringUtils.isEmpty(previousEncryptionKey);
+    }
+
     public void setAuthenticationAlgorithm(final String authenticationAlgorithm) {
         this.authenticationAlgorithm = authenticationAlgorithm;
     }
@@ -83,6 +109,18 @@ public class ClientSideStateProperties {
         this.encryptionKey = encryptionKey;
     }
 
+    public void setPreviousAuthenticationKey(final String previousAuthenticationKey) {
+        this.previousAuthenticationKey = previousAuthenticationKey;
+    }
+
+    public void setPreviousEncryptionKey(final String previousEncryptionKey) {
+        this.previousEncryptionKey = previousEncryptionKey;
+    }
+
+    public void setPreviousTid(final Long previousTid) {
+        this.previousTid = previousTid;
+    }
+
     public void setTid(final Long tid) {
         this.tid = tid;
     }
@@ -32,10 +32,16 @@ public class StaticEdition extends Edition {
         private final SecretKey encryptionKey;
 
         StaticKeySource(final ClientSideStateProperties properties) {
-            final String encryptionKeyAlgorithm = properties.getEncryptionAlgorithm().replaceFirst("/.*", "");
+            this(properties.getEncryptionAlgorithm(), properties.getEncryptionKey(), properties.getAuthenticationAlgorithm(),
+                properties.getAuthenticationKey());
+        }
+
+        StaticKeySource(final String encryptionAlgorithm, final String encryptionKey, final String authenticationAlgorithm,
+            final String authenticationKey) {
+            final String encryptionKeyAlgorithm = encryptionAlgorithm.replaceFirst("/.*", "");
 
-            encryptionKey = new SecretKeySpec(decode(properties.getEncryptionKey()), encryptionKeyAlgorithm);
-            authenticationKey = new SecretKeySpec(decode(properties.getAuthenticationKey()), properties.getAuthenticationAlgorithm());
+            this.encryptionKey = new SecretKeySpec(decode(encryptionKey), encryptionKeyAlgorithm);
+            this.authenticationKey = new SecretKeySpec(decode(authenticationKey), authenticationAlgorithm);
         }
 
         @Override
@@ -50,8 +56,27 @@ public class StaticEdition extends Edition {
     }
 
     public StaticEdition(final ClientSideStateProperties properties) {
-        super(properties.getTid(), properties.getEncryptionAlgorithm(), properties.getAuthenticationAlgorithm());
-        keySource = new StaticKeySource(properties);
+        this(properties.getTid(), properties.getEncryptionAlgorithm(), properties.getAuthenticationAlgorithm(),
+            new StaticKeySource(properties));
+    }
+
+    private StaticEdition(final long tid, final String encryptionAlgorithm, final String authenticationAlgorithm,
+        final KeySource keySource) {
+        super(tid, encryptionAlgorithm, authenticationAlgorithm);
+        this.keySource = keySource;
+    }
+
+    /**
+     * The edition of the keys in use before their last rotation, see
+     * {@link ClientSideStateProperties#hasPrevious()}.
+     */
+    public static StaticEdition previous(final ClientSideStateProperties properties) {
+        final String encryptionAlgorithm = properties.getEncryptionAlgorithm();
+        final String authenticationAlgorithm = properties.getAuthenticationAlgorithm();
+
+        return new StaticEdition(properties.getPreviousTid(), encryptionAlgorithm, authenticationAlgorithm,
+            new StaticKeySource(encryptionAlgorithm, properties.getPreviousEncryptionKey(), authenticationAlgorithm,
+                properties.getPreviousAuthenticationKey()));
     }
 
     @Override
@@ -15,12 +15,16 @@
  */
 package io.syndesis.server.endpoint.v1.handler.connection;
 
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
 import static org.mockito.Mockito.mock;
 import static org.mockito.Mockito.verify;
+import static org.mockito.Mockito.verifyZeroInteractions;
 import static org.mockito.Mockito.when;
 import java.util.HashSet;
 import java.util.Set;
 import javax.validation.Validator;
+import javax.ws.rs.ClientErrorException;
 import org.junit.Before;
 import org.junit.Test;
 import io.syndesis.common.model.bulletin.ConnectionBulletinBoard;
@@ -35,6 +39,7 @@ public class ConnectionHandlerTest {
 
     private ConnectionHandler handler;
     private DataManager dataManager;
+    private ConnectorsConfigurationProperties connectors;
 
     @Before
     public void setUp() {
@@ -44,7 +49,33 @@ public class ConnectionHandlerTest {
         ClientSideState state = mock(ClientSideState.class);
         MetadataConfigurationProperties config = mock(MetadataConfigurationProperties.class);
         EncryptionComponent encryptionSupport = mock(EncryptionComponent.class);
-        handler = new ConnectionHandler(dataManager, validator, credentials, state, config, encryptionSupport);
+        connectors = new ConnectorsConfigurationProperties();
+        handler = new ConnectionHandler(dataManager, validator, credentials, state, config, encryptionSupport, connectors);
+    }
+
+    @Test
+    public void shouldNotCreateConnectionsOfDeniedConnectors() {
+        connectors.getDenied().add("ftp");
+        Connection connection = new Connection.Builder().name("files").connectorId("ftp").build();
+
+        assertThatThrownBy(() -> handler.create(null, connection))
+            .isInstanceOf(ClientErrorException.class)
+            .hasMessage("Connector ftp is not allowed");
+        assertThatThrownBy(() -> handler.update("files", connection))
+            .isInstanceOf(ClientErrorException.class);
+        verifyZeroInteractions(dataManager);
+    }
+
+    @Test
+    public void shouldOnlyAllowTheAllowedConnectors() {
+        assertThat(connectors.isAllowed("ftp")).isTrue();
+
+        connectors.getAllowed().add("sql");
+        connectors.getAllowed().add("ftp");
+        connectors.getDenied().add("ftp");
+        assertThat(connectors.isAllowed("sql")).isTrue();
+        assertThat(connectors.isAllowed("ftp")).isFalse();
+        assertThat(connectors.isAllowed("http4")).isFalse();
     }
 
     @Test
@@ -133,6 +133,20 @@ public class ClientSideStateTest {
         assertThat(cookie.isSecure()).isTrue();
     }
 
+    @Test
+    public void shouldRestoreFromPreviousEdition() {
+        final ClientSideState beforeRotation = new ClientSideState(RFC_EDITION);
+        final ClientSideState afterRotation = new ClientSideState(rotatedEdition(), RFC_EDITION);
+
+        final NewCookie persistedBefore = beforeRotation.persist("key", "/path", "before");
+        assertThat(afterRotation.restoreFrom(persistedBefore, String.class)).isEqualTo("before");
+
+        final NewCookie persistedAfter = afterRotation.persist("key", "/path", "after");
+        assertThat(afterRotation.restoreFrom(persistedAfter, String.class)).isEqualTo("after");
+        assertThatExceptionOfType(IllegalArgumentException.class).isThrownBy(() -> beforeRotation.restoreFrom(persistedAfter, String.class))
+            .withMessage("Given TID `2`, mismatches current TID `746964`");
+    }
+
     @Test
     public void shouldRestoreMultipleAndOrderByTimestamp() {
         final Iterator<Long> times = Arrays
@@ -177,6 +191,15 @@ public class ClientSideStateTest {
         assertThat(value).isEqualTo(data);
     }
 
+    private static Edition rotatedEdition() {
+        final ClientSideStateProperties properties = new ClientSideStateProperties();
+        properties.setAuthenticationKey("oID3dF6UovTkzMyr3a9dr0kgTnE=");
+        properties.setEncryptionKey("T2NasjRXURA3dSL8dUQubQ==");
+        properties.setTid(2L);
+
+        return new StaticEdition(properties);
+    }
+
     private static Edition withCustomTid(final byte[] tid) {
         return new Edition(new BigInteger(tid).longValue(), "AES/CBC/PKCS5Padding", "HmacSHA1") {
             @Override
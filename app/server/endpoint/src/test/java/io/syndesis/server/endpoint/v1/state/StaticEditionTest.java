@@ -88,6 +88,35 @@ public class StaticEditionTest {
         KeySourceAssert.assertThat(keySource).canBeUsedForCryptography();
     }
 
+    @Test
+    public void shouldCreatePreviousEditionFromProperties() {
+        final ClientSideStateProperties properties = new ClientSideStateProperties();
+        properties.setAuthenticationAlgorithm("HmacSHA1");
+        properties.setAuthenticationKey(RandomStringUtils.random(32, true, true));
+        properties.setEncryptionAlgorithm("AES/CBC/PKCS5Padding");
+        properties.setEncryptionKey(RandomStringUtils.random(32, true, true));
+        properties.setTid(2L);
+        assertThat(properties.hasPrevious()).isFalse();
+
+        properties.setPreviousAuthenticationKey("oID3dF6UovTkzMyr3a9dr0kgTnE=");
+        properties.setPreviousEncryptionKey("T2NasjRXURA3dSL8dUQubQ==");
+        properties.setPreviousTid(1L);
+        assertThat(properties.hasPrevious()).isTrue();
+
+        final StaticEdition edition = StaticEdition.previous(properties);
+
+        assertThat(edition.authenticationAlgorithm).isEqualTo("HmacSHA1");
+        assertThat(edition.encryptionAlgorithm).isEqualTo("AES/CBC/PKCS5Padding");
+        assertThat(edition.tid).isEqualTo(new byte[] {1});
+
+        final KeySource keySource = edition.keySource();
+        assertThat(keySource.encryptionKey()).isEqualTo(new SecretKeySpec(
+            new byte[] {0x4f, 0x63, 0x5a, (byte) 0xb2, 0x34, 0x57, 0x51, 0x10, 0x37, 0x75, 0x22, (byte) 0xfc, 0x75, 0x44, 0x2e, 0x6d},
+            "AES"));
+
+        KeySourceAssert.assertThat(keySource).canBeUsedForCryptography();
+    }
+
     @Test
     public void shouldCreateEditionWithNonBase64Passwords() {
         final ClientSideStateProperties properties = new ClientSideStateProperties();
@@ -21,10 +21,13 @@ import java.util.ArrayList;
 import java.util.Iterator;
 import java.util.List;
 import java.util.Map;
+import java.util.Optional;
 
 import io.syndesis.common.util.json.JsonUtils;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.beans.factory.annotation.Qualifier;
 import org.springframework.boot.autoconfigure.condition.ConditionalOnProperty;
 import org.springframework.stereotype.Component;
 
@@ -51,6 +54,15 @@ public class DBActivityTrackingService implements ActivityTrackingService {
         this.jsondb = jsondb;
     }
 
+    /**
+     * Reads the activities from the read replicas of the database, when
+     * there are any.
+     */
+    @Autowired
+    public DBActivityTrackingService(final JsonDB jsondb, @Qualifier("readOnlyJsonDB") final Optional<JsonDB> readOnlyJsondb) {
+        this(readOnlyJsondb.orElse(jsondb));
+    }
+
     @Override
     public List<Activity> getActivities(String integrationId, String from, Integer requestedLimit) throws IOException {
 
@@ -0,0 +1,58 @@
+/*
+ * Copyright (C) 2016 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package io.syndesis.server.logging.jsondb.service;
+
+import java.io.IOException;
+import java.nio.charset.StandardCharsets;
+import java.util.Optional;
+
+import io.syndesis.server.jsondb.GetOptions;
+import io.syndesis.server.jsondb.JsonDB;
+import org.junit.Test;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.ArgumentMatchers.any;
+import static org.mockito.ArgumentMatchers.eq;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.verifyZeroInteractions;
+import static org.mockito.Mockito.when;
+
+public class DBActivityTrackingServiceTest {
+
+    private static final byte[] ACTIVITIES = "{\"i-1\":\"{\\\"id\\\":\\\"i-1\\\"}\"}".getBytes(StandardCharsets.UTF_8);
+
+    @Test
+    public void shouldReadActivitiesFromTheReadReplicas() throws IOException {
+        final JsonDB primary = mock(JsonDB.class);
+        final JsonDB readOnly = mock(JsonDB.class);
+        when(readOnly.getAsByteArray(eq("/activity/exchanges/integration"), any(GetOptions.class))).thenReturn(ACTIVITIES);
+
+        final DBActivityTrackingService service = new DBActivityTrackingService(primary, Optional.of(readOnly));
+
+        assertThat(service.getActivities("integration", null, null)).hasSize(1);
+        verifyZeroInteractions(primary);
+    }
+
+    @Test
+    public void shouldReadActivitiesFromThePrimaryWithoutReadReplicas() throws IOException {
+        final JsonDB primary = mock(JsonDB.class);
+        when(primary.getAsByteArray(eq("/activity/exchanges/integration"), any(GetOptions.class))).thenReturn(ACTIVITIES);
+
+        final DBActivityTrackingService service = new DBActivityTrackingService(primary, Optional.empty());
+
+        assertThat(service.getActivities("integration", null, null)).hasSize(1);
+    }
+}
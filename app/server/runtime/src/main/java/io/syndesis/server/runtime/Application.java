@@ -122,6 +122,11 @@ public class Application extends SpringBootServletInitializer {
 
         final StaticEdition edition = new StaticEdition(properties);
 
+        if (properties.hasPrevious()) {
+            // State persisted before the keys were rotated is restored until the previous keys are taken away
+            return new ClientSideState(edition, StaticEdition.previous(properties));
+        }
+
         return new ClientSideState(edition);
     }
 
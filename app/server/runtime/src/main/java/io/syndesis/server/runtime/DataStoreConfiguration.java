@@ -26,6 +26,7 @@ import org.slf4j.LoggerFactory;
 import org.springframework.beans.factory.annotation.Autowired;
 import org.springframework.context.annotation.Bean;
 import org.springframework.context.annotation.Configuration;
+import org.springframework.context.annotation.Primary;
 
 import java.util.ArrayList;
 import java.util.List;
@@ -39,10 +40,20 @@ public class DataStoreConfiguration {
     private static final Logger LOG = LoggerFactory.getLogger(Migrations.class);
 
     @Bean
+    @Primary
     @Autowired
     @SuppressWarnings("PMD.EmptyCatchBlock")
     public SqlJsonDB jsonDB(DBI dbi, Optional<List<Index>> beanIndexes) {
+        SqlJsonDB jsondb = new SqlJsonDB(dbi, null, indexes(beanIndexes));
+        try {
+            jsondb.createTables();
+        } catch (@SuppressWarnings("PMD.AvoidCatchingGenericException") Exception ignore) {
+            LOG.debug("Could not create tables", ignore);
+        }
+        return jsondb;
+    }
 
+    static List<Index> indexes(Optional<List<Index>> beanIndexes) {
         ArrayList<Index> indexes = new ArrayList<>();
         if(beanIndexes.isPresent()) {
             indexes.addAll(beanIndexes.get());
@@ -66,13 +77,7 @@ public class DataStoreConfiguration {
             }
         }
 
-        SqlJsonDB jsondb = new SqlJsonDB(dbi, null, indexes);
-        try {
-            jsondb.createTables();
-        } catch (@SuppressWarnings("PMD.AvoidCatchingGenericException") Exception ignore) {
-            LOG.debug("Could not create tables", ignore);
-        }
-        return jsondb;
+        return indexes;
     }
 
     private static void addIndex(List<Index> indexes, Kind kind, IndexedProperty indexedProperty) {
@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2016 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package io.syndesis.server.runtime;
+
+import java.util.List;
+import java.util.Optional;
+
+import javax.sql.DataSource;
+
+import io.syndesis.server.jsondb.impl.Index;
+import io.syndesis.server.jsondb.impl.SqlJsonDB;
+import org.skife.jdbi.v2.DBI;
+import org.springframework.boot.autoconfigure.condition.ConditionalOnProperty;
+import org.springframework.boot.autoconfigure.jdbc.DataSourceBuilder;
+import org.springframework.boot.context.properties.ConfigurationProperties;
+import org.springframework.boot.context.properties.EnableConfigurationProperties;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+
+/**
+ * Creates the datastore of the read only queries, served by the read replicas
+ * of the database. The primary datastore serves them when no read only
+ * datasource is configured.
+ */
+@Configuration
+@EnableConfigurationProperties
+@ConfigurationProperties("read-only-datasource")
+@ConditionalOnProperty("read-only-datasource.url")
+public class ReadOnlyDataStoreConfiguration {
+
+    private String url;
+
+    private String username;
+
+    private String password;
+
+    private String driverClassName;
+
+    public String getUrl() {
+        return url;
+    }
+
+    public void setUrl(String url) {
+        this.url = url;
+    }
+
+    public String getUsername() {
+        return username;
+    }
+
+    public void setUsername(String username) {
+        this.username = username;
+    }
+
+    public String getPassword() {
+        return password;
+    }
+
+    public void setPassword(String password) {
+        this.password = password;
+    }
+
+    public String getDriverClassName() {
+        return driverClassName;
+    }
+
+    public void setDriverClassName(String driverClassName) {
+        this.driverClassName = driverClassName;
+    }
+
+    /**
+     * Not a DataSource bean, so that the primary datasource is still the one
+     * Spring Boot configures. The tables are created through the primary
+     * datastore, the replicas only follow it.
+     */
+    @Bean(name = "readOnlyJsonDB")
+    public SqlJsonDB readOnlyJsonDB(Optional<List<Index>> beanIndexes) {
+        DataSource dataSource = DataSourceBuilder.create()
+            .url(url)
+            .username(username)
+            .password(password)
+            .driverClassName(driverClassName)
+            .build();
+        return new SqlJsonDB(new DBI(dataSource), null, DataStoreConfiguration.indexes(beanIndexes));
+    }
+}
@@ -16,4 +16,7 @@ var (
 
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme registers the types, used by the generated clientset
+	AddToScheme = SchemeBuilder.AddToScheme
 )
@@ -17,6 +17,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,12 +29,124 @@ import (
 type SyndesisSpec struct {
 	ImageStreamNamespace string `json:"imageStreamNamespace,omitempty"`
 
+	// Set to "dev" for a small footprint installation fitting into CodeReady Containers or minikube.
+	Profile SyndesisProfile `json:"profile,omitempty"`
+
+	// What gets installed: full (default), or infrastructureOnly to provision the database, prometheus, oauth
+	// and secrets without syndesis-server, syndesis-meta and syndesis-ui, e.g. to restore data before bringing them up.
+	InstallMode SyndesisInstallMode `json:"installMode,omitempty"`
+
 	// Components is used to configure all the core components of Syndesis
 	Components ComponentsSpec `json:"components,omitempty"`
 
 	// Optional add on features that can be enabled.
 	Addons AddonsSpec `json:"addons,omitempty"`
 
+	// Entry added to the OpenShift 4 web console application launcher.
+	ConsoleLink ConsoleLinkConfiguration `json:"consoleLink,omitempty"`
+
+	// How syndesis is exposed outside of the cluster: route (default), ingress, loadbalancer, nodeport or none.
+	Exposure SyndesisExposure `json:"exposure,omitempty"`
+
+	// Hostname syndesis is reachable at, required when not exposed with a route.
+	ExternalHostname string `json:"externalHostname,omitempty"`
+
+	// Additional hostnames syndesis is reachable at, e.g. a vanity DNS name in front of the route.
+	// They are accepted as CORS origins and OAuth redirect URIs.
+	AlternateHostnames []string `json:"alternateHostnames,omitempty"`
+
+	// How the alternate hostnames are served: none (default) when they are routed to syndesis outside of the cluster,
+	// proxy to serve syndesis on them too, or redirect to send their visitors to the canonical hostname.
+	AlternateHostnamesMode SyndesisAlternateHostnamesMode `json:"alternateHostnamesMode,omitempty"`
+
+	// Additional origins allowed by the server CORS configuration.
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+
+	// Where the operator sends notifications of upgrades, backup failures and certificate expiry to.
+	Notifications NotificationsConfiguration `json:"notifications,omitempty"`
+
+	// Enables the test support endpoints of syndesis-server, together with the access rules test runners need.
+	// Never enable it on a production installation.
+	TestSupport bool `json:"testSupport,omitempty"`
+
+	// Labels the operator keeps on the namespace syndesis is installed into, e.g. monitoring or pod security labels.
+	NamespaceManagement NamespaceManagementConfiguration `json:"namespaceManagement,omitempty"`
+
+	// Log levels of syndesis-server and syndesis-meta.
+	Logging LoggingConfiguration `json:"logging,omitempty"`
+
+	// Connections created once syndesis is installed, so that environments can be provisioned without using the console.
+	Connections []ConnectionConfiguration `json:"connections,omitempty"`
+
+	// Exports of integrations imported once syndesis is installed, so that they can be promoted from one environment
+	// to the next declaratively.
+	Integrations IntegrationsConfiguration `json:"integrations,omitempty"`
+
+	// Opt-in reporting of anonymous usage data to the syndesis maintainers.
+	Telemetry TelemetryConfiguration `json:"telemetry,omitempty"`
+
+	// Time given to syndesis-server, syndesis-meta and the data virtualization server to start, before liveness probes apply.
+	// Only used on clusters running startup probes.
+	StartupProbe StartupProbeConfiguration `json:"startupProbe,omitempty"`
+
+	// Watchdog restarting components stuck in CrashLoopBackOff, reporting a Degraded condition when that doesn't help.
+	Remediation RemediationConfiguration `json:"remediation,omitempty"`
+
+	// Namespaces integrations are built and deployed in besides the syndesis namespace. The syndesis pull secret is
+	// copied there and linked to their builder and deployer service accounts, the operator needs to be granted
+	// the management of secrets and service accounts in these namespaces, and of limit ranges and resource quotas
+	// when integration.quota limits the integrations.
+	IntegrationNamespaces []string `json:"integrationNamespaces,omitempty"`
+
+	// Entries added to the hosts file of the component pods, e.g. for corporate names resolving differently inside the cluster.
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+
+	// DNS suffix of the cluster when it is not the one configured on the nodes, the component pods
+	// then search <namespace>.svc.<suffix>, svc.<suffix> and <suffix> to resolve short names.
+	DNSSuffix string `json:"dnsSuffix,omitempty"`
+
+	// Time zone of the schedules of the scheduled operations like the database maintenance, e.g. Europe/Paris.
+	// Schedules are in UTC when not set.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Tuning of how syndesis-server runs the integrations, for installations with many of them.
+	Integration IntegrationConfiguration `json:"integration,omitempty"`
+
+	// How the database is backed up, by the backup command and before upgrades.
+	Backup BackupConfiguration `json:"backup,omitempty"`
+
+	// Probe the external dependencies, like the maven repositories or an external database, from the operator before
+	// declaring syndesis ready. Unreachable ones are reported by the Dependencies condition, the installation goes on.
+	ConnectivityChecks bool `json:"connectivityChecks,omitempty"`
+
+	// Expiry monitoring of the serving certificates of the route and the oauth proxy.
+	Certificates CertificatesConfiguration `json:"certificates,omitempty"`
+
+	// Connectors teams may use, e.g. to forbid ftp or plain http in a managed environment.
+	Connectors ConnectorsConfiguration `json:"connectors,omitempty"`
+
+	// Job exercising the installation once its deployments are ready, after installs and upgrades.
+	// Syndesis is only declared ready once the job passes.
+	SmokeTest SmokeTestConfiguration `json:"smokeTest,omitempty"`
+
+	// Labels and annotations of the routes syndesis is exposed with, e.g. the labels selecting the
+	// ingress controller shard serving them on clusters with sharded routers.
+	Route RouteConfiguration `json:"route,omitempty"`
+
+	// Run the installation as the warm standby of a primary one in another namespace or cluster, for disaster
+	// recovery: its database streams the one of the primary while syndesis-server, syndesis-meta and syndesis-ui
+	// stay scaled down. Setting standby.enabled back to false promotes the installation.
+	Standby StandbyConfiguration `json:"standby,omitempty"`
+
+	// Encrypt the traffic between syndesis-server, syndesis-meta and the database with certificates issued by the
+	// OpenShift service CA or cert-manager, for clusters requiring all in-cluster traffic to be encrypted.
+	InternalTLS InternalTLSConfiguration `json:"internalTLS,omitempty"`
+
+	// Whether the components follow new releases of their images: pinned (default) runs the images of the operator
+	// release until they are explicitly bumped, track-minor follows new patch releases and track-latest new minor
+	// and patch releases, resolving the tags to their digests on a schedule.
+	ImagePolicy ImagePolicyConfiguration `json:"imagePolicy,omitempty"`
+
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -50,6 +163,36 @@ type SyndesisStatus struct {
 	Description        string               `json:"description,omitempty"`
 	Version            string               `json:"version,omitempty"`
 	TargetVersion      string               `json:"targetVersion,omitempty"`
+	// URL the syndesis console is reachable at
+	ExternalURL string `json:"externalURL,omitempty"`
+	// Set when test support is enabled, flagging the installation as not fit for production
+	TestSupport bool `json:"testSupport,omitempty"`
+	// Namespaces holding a copy of the syndesis pull secret
+	PullSecretNamespaces []string `json:"pullSecretNamespaces,omitempty"`
+	// Copies of the secrets selected by integration.secretSync, as namespace/name
+	SyncedSecrets []string `json:"syncedSecrets,omitempty"`
+	// Outcome of each step of the installation, the last one reached tells where an install is stuck
+	Conditions []SyndesisCondition `json:"conditions,omitempty"`
+	// Problems worth the attention of an administrator that don't prevent the installation
+	Warnings []string `json:"warnings,omitempty"`
+	// Names of the connections of the spec already created, they are not touched afterwards
+	ProvisionedConnections []string `json:"provisionedConnections,omitempty"`
+	// Names of the integration imports of the spec already done, they are not repeated afterwards
+	ImportedIntegrations []string `json:"importedIntegrations,omitempty"`
+	// Remediations attempted on the components currently crash looping
+	Remediations []SyndesisRemediation `json:"remediations,omitempty"`
+	// Value of the syndesis.io/force-reconcile annotation last acted upon
+	ForcedReconcile string `json:"forcedReconcile,omitempty"`
+	// Resources last found changed outside of the operator, their configuration has been restored since
+	Drift *SyndesisDrift `json:"drift,omitempty"`
+	// Set while the installation runs as the warm standby of a primary one
+	Standby bool `json:"standby,omitempty"`
+	// When the installation, a warm standby until then, was promoted
+	StandbyPromotedAt *metav1.Time `json:"standbyPromotedAt,omitempty"`
+	// Progress of the republication of the integrations, resumed from here when the operator restarts
+	Republish *IntegrationRepublishStatus `json:"republish,omitempty"`
+	// Outcome of the last check of the registries for new releases of the component images
+	Images *ImagePolicyStatus `json:"images,omitempty"`
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -59,6 +202,7 @@ type SyndesisStatus struct {
 
 // +k8s:openapi-gen=true
 type ComponentsSpec struct {
+	UI         UIConfiguration         `json:"ui,omitempty"`
 	Oauth      OauthConfiguration      `json:"oauth,omitempty"`
 	Server     ServerConfiguration     `json:"server,omitempty"`
 	Meta       MetaConfiguration       `json:"meta,omitempty"`
@@ -68,27 +212,128 @@ type ComponentsSpec struct {
 	Upgrade    UpgradeConfiguration    `json:"upgrade,omitempty"`
 }
 
+type UIConfiguration struct {
+	Replicas            int                   `json:"replicas,omitempty"`
+	DisableAntiAffinity bool                  `json:"disableAntiAffinity,omitempty"`
+	Shutdown            ShutdownConfiguration `json:"shutdown,omitempty"`
+	// Nodes the ui pods are scheduled on
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
 type OauthConfiguration struct {
-	DisableSarCheck bool   `json:"disable-sar-check,omitempty"`
-	SarNamespace    string `json:"sarNamespace,omitempty"`
+	DisableSarCheck         bool   `json:"disable-sar-check,omitempty"`
+	SarNamespace            string `json:"sarNamespace,omitempty"`
+	CookieExpire            string `json:"cookieExpire,omitempty"`
+	CookieRefresh           string `json:"cookieRefresh,omitempty"`
+	CookieSecretGracePeriod string `json:"cookieSecretGracePeriod,omitempty"`
+	// OpenShift OAuthClient the oauth proxy authenticates with, instead of the syndesis-oauth-client service account
+	Client OAuthClientConfiguration `json:"client,omitempty"`
+	// Requests and limits of the oauth proxy container, e.g. for namespaces with mandatory quotas
+	Resources AddonResources `json:"resources,omitempty"`
+	// Nodes the oauth proxy pod is scheduled on
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+type OAuthClientConfiguration struct {
+	// Create an OAuthClient named syndesis-<namespace> and keep it in sync, it is removed with the syndesis resource
+	Managed bool `json:"managed,omitempty"`
+	// Name of an existing OAuthClient provided by the cluster administrator, used as is
+	Name string `json:"name,omitempty"`
 }
 
 type DvConfiguration struct {
 	Enabled   bool      `json:"enabled,omitempty"`
 	Resources Resources `json:"resources,omitempty"`
+	// Nodes and resources of the dv server
+	Pods AddonPodsConfiguration `json:"pods,omitempty"`
 }
 
 type DatabaseConfiguration struct {
 	User          string              `json:"user,omitempty"`
 	Name          string              `json:"name,omitempty"`
-	URL           string              `url:"url,omitempty"`
+	URL           string              `url:"url,omitempty"` // Deprecated: use connection, the url is parsed into it
 	ExternalDbURL string              `json:"externalDbURL,omitempty"`
 	Resources     ResourcesWithVolume `json:"resources,omitempty"`
+	// Connection parameters of the database, e.g. to use TLS or set a search_path
+	Connection DatabaseConnection `json:"connection,omitempty"`
+	// Number of streaming replicas of the installed database, serving the activity reads of the server
+	ReadReplicas int `json:"readReplicas,omitempty"`
+	// Read replicas of the external database, e.g. postgresql://replica:5432
+	ExternalReplicaURLs []string `json:"externalReplicaURLs,omitempty"`
+	// Routine VACUUM, ANALYZE and REINDEX of the database
+	Maintenance DatabaseMaintenance `json:"maintenance,omitempty"`
+	// Postgres exporter sidecar serving the metrics of the database
+	Exporter DatabaseExporterConfiguration `json:"exporter,omitempty"`
+	// Nodes the database pods are scheduled on, e.g. the ones with local storage
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+type DatabaseExporterConfiguration struct {
+	// Requests and limits of the exporter container, e.g. for namespaces with mandatory quotas
+	Resources AddonResources `json:"resources,omitempty"`
+}
+
+type DatabaseConnection struct {
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
+	// One of disable, allow, prefer, require, verify-ca or verify-full
+	SSLMode string `json:"sslMode,omitempty"`
+	// Seconds to wait for a connection
+	ConnectTimeout int `json:"connectTimeout,omitempty"`
+	// Further libpq parameters, e.g. options: "-c search_path=syndesis"
+	Options map[string]string `json:"options,omitempty"`
+}
+
+type DatabaseMaintenance struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Cron schedule of the maintenance in the time zone of the installation, e.g. "0 3 * * 0"
+	Schedule string `json:"schedule,omitempty"`
+	// Longest time a maintenance statement may run, in postgresql units, e.g. "1h" or "30min"
+	StatementTimeout string `json:"statementTimeout,omitempty"`
 }
 
 type PrometheusConfiguration struct {
-	Rules     string              `json:"rules,omitempty"`
-	Resources ResourcesWithVolume `json:"resources,omitempty"`
+	// Installs prometheus, unless set to false for clusters whose monitoring already scrapes syndesis
+	Enabled            *bool               `json:"enabled,omitempty"`
+	Rules              string              `json:"rules,omitempty"`
+	Resources          ResourcesWithVolume `json:"resources,omitempty"`
+	DisablePersistence bool                `json:"disablePersistence,omitempty"`
+	// Remote storages the scraped samples are also sent to, e.g. a central Thanos or Mimir
+	RemoteWrite []PrometheusRemoteWrite `json:"remoteWrite,omitempty"`
+	// Federation endpoint exposed with an authenticated route, for a central prometheus to pull syndesis series from
+	Federation PrometheusFederation `json:"federation,omitempty"`
+	// Nodes the prometheus pod is scheduled on
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+type PrometheusFederation struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Hostname of the route, generated by the cluster when not set
+	Hostname string `json:"hostname,omitempty"`
+	// Series selectors of the match[] parameters in the scrape configuration generated for the central prometheus.
+	// Bearer tokens are checked with token reviews, which requires the syndesis-prometheus service account to be
+	// bound to the system:auth-delegator cluster role.
+	Match []string `json:"match,omitempty"`
+}
+
+type PrometheusRemoteWrite struct {
+	URL string `json:"url"`
+	// Username for basic authentication, its password being the password key of PasswordSecret
+	Username       string `json:"username,omitempty"`
+	PasswordSecret string `json:"passwordSecret,omitempty"`
+	// Secret whose token key is sent as bearer token
+	BearerTokenSecret string `json:"bearerTokenSecret,omitempty"`
+	// Relabelings applied to the samples before they are sent, e.g. to only send some metrics
+	WriteRelabelConfigs []PrometheusRelabelConfig `json:"writeRelabelConfigs,omitempty"`
+}
+
+type PrometheusRelabelConfig struct {
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	Separator    string   `json:"separator,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	TargetLabel  string   `json:"targetLabel,omitempty"`
+	Replacement  string   `json:"replacement,omitempty"`
+	Action       string   `json:"action,omitempty"`
 }
 
 type GrafanaConfiguration struct {
@@ -96,39 +341,125 @@ type GrafanaConfiguration struct {
 }
 
 type ServerConfiguration struct {
-	Resources Resources      `json:"resources,omitempty"`
-	Features  ServerFeatures `json:"features,omitempty"`
+	Resources           Resources      `json:"resources,omitempty"`
+	Features            ServerFeatures `json:"features,omitempty"`
+	Replicas            int            `json:"replicas,omitempty"`
+	DisableAntiAffinity bool           `json:"disableAntiAffinity,omitempty"`
+	// Name of a ConfigMap whose application.yml is merged into the generated server configuration
+	ConfigOverride string `json:"configOverride,omitempty"`
+	// How the server pods stop, e.g. to let running integrations drain in-flight exchanges
+	Shutdown ShutdownConfiguration `json:"shutdown,omitempty"`
+	// How long the client state keys in use before a rotation are still accepted, e.g. 24h
+	ClientStateKeyGracePeriod string `json:"clientStateKeyGracePeriod,omitempty"`
+	// Nodes the server pods are scheduled on
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
 }
 
 type MetaConfiguration struct {
-	Resources ResourcesWithVolume `json:"resources,omitempty"`
+	Resources ResourcesWithVolume   `json:"resources,omitempty"`
+	Shutdown  ShutdownConfiguration `json:"shutdown,omitempty"`
+	// Nodes the meta pod is scheduled on
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+// Placement of the pods of a component, by default they land on any node without taints
+type SchedulingConfiguration struct {
+	// Labels of the nodes the pods are scheduled on
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Taints of the nodes the pods tolerate
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Node and pod affinities of the pods. A pod anti-affinity replaces the one spreading the replicas of the ui
+	// and the server across nodes and zones.
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+type ShutdownConfiguration struct {
+	// Seconds given to the pods to stop before they are killed
+	TerminationGracePeriodSeconds int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// Command run in the container before it is stopped
+	PreStop []string `json:"preStop,omitempty"`
 }
 
 type UpgradeConfiguration struct {
 	Resources VolumeOnlyResources `json:"resources,omitempty"`
+	// Nodes and resources of the upgrade pod
+	Pods AddonPodsConfiguration `json:"pods,omitempty"`
+	// Longest time the upgrade pod may run before the upgrade is considered failed, e.g. 1h
+	Timeout string `json:"timeout,omitempty"`
+	// What happens when the upgrade fails: retry (default) with an exponential backoff, or quarantine to keep
+	// the failed upgrade pod and database dump untouched until the syndesis.io/acknowledge-quarantine annotation is set
+	OnFailure SyndesisUpgradeFailurePolicy `json:"onFailure,omitempty"`
 }
 
 type Resources struct {
+	// Deprecated: use MemoryLimit, which wins when both are set
 	Memory string `json:",inline,omitempty"`
+	// Memory the pod is limited to, e.g. 512Mi
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+	// CPU requested by the pod, e.g. 500m
+	CPU string `json:"cpu,omitempty"`
+	// Memory requested by the pod, e.g. 256Mi. When empty the server requests 256Mi, meta 280Mi and the others their memory limit
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	// CPU the pod is limited to, e.g. "1"
+	CPULimit string `json:"cpuLimit,omitempty"`
 }
 
+// The storage class, access mode and volume of a claim cannot change once it is created
 type ResourcesWithVolume struct {
-	Memory         string `json:",inline,omitempty"`
+	// Deprecated: use MemoryLimit, which wins when both are set
+	Memory string `json:",inline,omitempty"`
+	// Memory the pod is limited to, e.g. 512Mi
+	MemoryLimit    string `json:"memoryLimit,omitempty"`
 	VolumeCapacity string `json:"volumeCapacity,omitempty"`
+	// CPU requested by the pod, e.g. 500m
+	CPU string `json:"cpu,omitempty"`
+	// Memory requested by the pod, e.g. 256Mi. When empty meta requests 280Mi and the others their memory limit
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	// CPU the pod is limited to, e.g. "1"
+	CPULimit string `json:"cpuLimit,omitempty"`
+	// Storage class of the volume claim, the default one of the cluster when empty, e.g. gp2 or ceph-rbd
+	VolumeStorageClass string `json:"volumeStorageClass,omitempty"`
+	// Access mode of the volume claim, ReadWriteOnce when empty
+	VolumeAccessMode corev1.PersistentVolumeAccessMode `json:"volumeAccessMode,omitempty"`
+	// Existing persistent volume the claim binds to, e.g. one provisioned by hand
+	VolumeName string `json:"volumeName,omitempty"`
 }
 
+// The storage class, access mode and volume of a claim cannot change once it is created
 type VolumeOnlyResources struct {
 	VolumeCapacity string `json:"volumeCapacity,omitempty"`
+	// Storage class of the volume claim, the default one of the cluster when empty, e.g. gp2 or ceph-rbd
+	VolumeStorageClass string `json:"volumeStorageClass,omitempty"`
+	// Access mode of the volume claim, ReadWriteOnce when empty
+	VolumeAccessMode corev1.PersistentVolumeAccessMode `json:"volumeAccessMode,omitempty"`
+	// Existing persistent volume the claim binds to, e.g. one provisioned by hand
+	VolumeName string `json:"volumeName,omitempty"`
 }
 
 type ServerFeatures struct {
 	MavenRepositories map[string]string `json:"mavenRepositories,omitempty"`
+	// Demo content installed with syndesis, none by default
+	DemoData DemoDataConfiguration `json:"demoData,omitempty"`
+}
+
+type DemoDataConfiguration struct {
+	// The sampledb database and its sample tables, also installed with the todo app and the sample integrations using it
+	SampleDB bool `json:"sampleDb,omitempty"`
+	// The todo example application
+	Todo bool `json:"todo,omitempty"`
+	// The sample integrations loaded by syndesis-server when it starts
+	SampleIntegrations bool `json:"sampleIntegrations,omitempty"`
+	// Removes the demo content: the sampledb database is dropped and the todo app uninstalled. Sample integrations
+	// already loaded are kept, as they may have been modified, they are only not loaded anymore.
+	Purge bool `json:"purge,omitempty"`
 }
 
 type AddonsSpec struct {
-	Jaeger  JaegerConfiguration `json:"jaeger,omitempty"`
-	Ops     AddonSpec           `json:"ops,omitempty"`
-	Todo    AddonSpec           `json:"todo,omitempty"`
+	Jaeger JaegerConfiguration `json:"jaeger,omitempty"`
+	Ops    OpsConfiguration    `json:"ops,omitempty"`
+	Todo   TodoConfiguration   `json:"todo,omitempty"`
+	// An ActiveMQ Artemis broker, registered as a connection
+	Broker  AddonSpec           `json:"broker,omitempty"`
 	Knative AddonSpec           `json:"knative,omitempty"`
 	DV      DvConfiguration     `json:"dv,omitempty"`
 	CamelK  CamelKConfiguration `json:"camelk,omitempty"`
@@ -138,12 +469,283 @@ type JaegerConfiguration struct {
 	Enabled      bool   `json:"enabled,omitempty"`
 	SamplerType  string `json:"samplerType,omitempty"`
 	SamplerParam string `json:"samplerParam,omitempty"`
+	// Sampling strategies served by the jaeger collector to the integrations
+	Sampling JaegerSamplingConfiguration `json:"sampling,omitempty"`
+	// Nodes and resources of the jaeger all in one pod
+	Pods AddonPodsConfiguration `json:"pods,omitempty"`
+}
+
+type JaegerSamplingConfiguration struct {
+	// Strategy of the integrations without their own, all the traces are kept by default
+	Default JaegerSamplingStrategy `json:"default,omitempty"`
+	// Strategies by integration name, e.g. to sample down high volume integrations
+	Integrations map[string]JaegerSamplingStrategy `json:"integrations,omitempty"`
+}
+
+type JaegerSamplingStrategy struct {
+	// Either probabilistic or ratelimiting
+	Type string `json:"type,omitempty"`
+	// Probability a trace is kept, or traces kept per second when rate limiting
+	Param string `json:"param,omitempty"`
+}
+
+type OpsConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Service level objectives of the integrations, recorded and alerted on
+	SLO SLOConfiguration `json:"slo,omitempty"`
+}
+
+type SLOConfiguration struct {
+	// Percentage of exchanges expected to succeed, e.g. "99.5"
+	SuccessRate string `json:"successRate,omitempty"`
+	// Processing time in milliseconds the 95th percentile of exchanges is expected to stay below
+	LatencyThreshold int `json:"latencyThreshold,omitempty"`
 }
 
 type AddonSpec struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+type TodoConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Nodes and resources of the todo example application
+	Pods AddonPodsConfiguration `json:"pods,omitempty"`
+}
+
+// Placement and resource budget of the pods of an addon, by default they land on any node without limits
+type AddonPodsConfiguration struct {
+	// Labels of the nodes the addon pods are scheduled on
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Taints of the nodes the addon pods tolerate
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Requests and limits of the addon containers, the addon defaults are kept for the ones left empty
+	Resources AddonResources `json:"resources,omitempty"`
+}
+
+type AddonResources struct {
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+}
+
+type StartupProbeConfiguration struct {
+	// Seconds between two probes while starting
+	PeriodSeconds int `json:"periodSeconds,omitempty"`
+	// Failed probes before the container is restarted
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+}
+
+type ConsoleLinkConfiguration struct {
+	Disabled bool   `json:"disabled,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Section  string `json:"section,omitempty"`
+	ImageURL string `json:"imageURL,omitempty"`
+}
+
+type NamespaceManagementConfiguration struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type ConnectionConfiguration struct {
+	// Name of the connection
+	Name string `json:"name"`
+	// Id of the connector the connection is made with, e.g. "activemq" or "salesforce"
+	Connector string `json:"connector"`
+	// Secret holding the properties of the connection, one key per property
+	Secret string `json:"secret"`
+}
+
+type IntegrationsConfiguration struct {
+	// Exports imported in order, each one once
+	Import []IntegrationImportConfiguration `json:"import,omitempty"`
+}
+
+type IntegrationImportConfiguration struct {
+	// Name of the import, recorded in the status once done
+	Name string `json:"name"`
+	// ConfigMap holding the export, like the ones saved before an uninstall
+	ConfigMap string `json:"configMap,omitempty"`
+	// Secret holding the export, instead of a ConfigMap
+	Secret string `json:"secret,omitempty"`
+	// Key of the export in the ConfigMap or the Secret, export.zip when empty
+	Key string `json:"key,omitempty"`
+	// Publish the imported integrations, otherwise they are left as drafts
+	Publish bool `json:"publish,omitempty"`
+}
+
+type LoggingConfiguration struct {
+	// Level of each logger, e.g. "org.apache.camel": "WARN"
+	Categories map[string]string `json:"categories,omitempty"`
+}
+
+type TelemetryConfiguration struct {
+	Enabled  bool   `json:"enabled,omitempty"`
+	Endpoint string `json:"endpoint,omitempty"`
+	Interval string `json:"interval,omitempty"`
+}
+
+type BackupConfiguration struct {
+	// dump (default) for a logical dump, or snapshot for CSI volume snapshots of the database volume claim,
+	// which are faster on large databases and also taken before upgrades
+	Method SyndesisBackupMethod `json:"method,omitempty"`
+	// Volume snapshot class of the snapshots, the default class of the CSI driver when not set
+	SnapshotClass string `json:"snapshotClass,omitempty"`
+}
+
+type SmokeTestConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Image of the job, it needs a shell and curl
+	Image string `json:"image,omitempty"`
+	// Shell script run instead of the default one, which calls the oauth proxy, syndesis-server and syndesis-meta.
+	// A non zero exit code fails the smoke test.
+	Script string `json:"script,omitempty"`
+}
+
+type RouteConfiguration struct {
+	// Labels set on the generated routes, e.g. the one matched by the route selector of a router shard
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations set on the generated routes, e.g. haproxy.router.openshift.io/timeout
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// A warm standby needs the syndesis-global-config secret of the primary installation to be copied into its
+// namespace before it is created, so that it shares the passwords and encryption keys of the replicated database.
+// The primary installation sets allowReplication for the standby to stream its database.
+type StandbyConfiguration struct {
+	// Follow the primary installation, set to false to promote this installation
+	Enabled bool `json:"enabled,omitempty"`
+	// Host of the database of the primary installation, e.g. syndesis-db.<namespace>.svc, or its address from another cluster
+	PrimaryHost string `json:"primaryHost,omitempty"`
+	// Let standby installations stream the database of this installation
+	AllowReplication bool `json:"allowReplication,omitempty"`
+}
+
+// With the service CA, syndesis-meta and the database serve certificates syndesis-server verifies. With cert-manager,
+// syndesis-server also presents a client certificate syndesis-meta requires.
+type InternalTLSConfiguration struct {
+	// Encrypt the traffic between the components
+	Enabled bool `json:"enabled,omitempty"`
+	// Who issues the certificates: service-ca (default) or cert-manager
+	Issuer SyndesisCertificateIssuer `json:"issuer,omitempty"`
+	// Issuer of cert-manager signing the certificates, required with the cert-manager issuer
+	IssuerRef CertificateIssuerReference `json:"issuerRef,omitempty"`
+}
+
+// Major versions are never followed, they come with an upgrade of the operator migrating the database.
+type ImagePolicyConfiguration struct {
+	// pinned (default), track-minor or track-latest
+	Update ImageUpdatePolicy `json:"update,omitempty"`
+	// How often the registries are checked, e.g. 6h. The pinned policy only checks them when it is set, to report
+	// the available updates.
+	CheckInterval string `json:"checkInterval,omitempty"`
+}
+
+type CertificateIssuerReference struct {
+	// Name of the issuer
+	Name string `json:"name,omitempty"`
+	// Issuer (default) or ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+}
+
+type ConnectorsConfiguration struct {
+	// Ids of the only connectors syndesis-server offers, all of them when empty
+	Allow []string `json:"allow,omitempty"`
+	// Ids of the connectors syndesis-server never offers, e.g. ftp or http4
+	Deny []string `json:"deny,omitempty"`
+}
+
+type CertificatesConfiguration struct {
+	// Time left before the expiry of a serving certificate from which it is reported, e.g. 720h
+	ExpiryThreshold string `json:"expiryThreshold,omitempty"`
+	// Ask cert-manager to issue a certificate it manages again once it is about to expire
+	Renew bool `json:"renew,omitempty"`
+}
+
+type IntegrationConfiguration struct {
+	// Integration controller of syndesis-server, the server defaults are kept for the settings left empty
+	Controller IntegrationControllerConfiguration `json:"controller,omitempty"`
+	// How integrations run: springboot builds them with S2I, camelk enables the camelk addon and runs them as
+	// Camel K integrations once its platform is ready. When empty they run with Camel K if the addon is enabled.
+	Runtime SyndesisIntegrationRuntime `json:"runtime,omitempty"`
+	// Limits protecting shared clusters from runaway integrations
+	Quota IntegrationQuota `json:"quota,omitempty"`
+	// Secrets the integrations need in the integration namespaces, e.g. the ones their connections reference
+	SecretSync SecretSyncConfiguration `json:"secretSync,omitempty"`
+	// Republication of the published integrations, in batches so that their builds don't all run at once
+	Republish IntegrationRepublishConfiguration `json:"republish,omitempty"`
+}
+
+// The integrations are republished when the syndesis.io/republish-integrations annotation changes, and after a
+// change of the S2I image or of the maven repositories they are built with when automatic.
+type IntegrationRepublishConfiguration struct {
+	// Republish the integrations once the S2I image or the maven repositories change
+	Automatic bool `json:"automatic,omitempty"`
+	// Integrations republished at the same time
+	BatchSize int `json:"batchSize,omitempty"`
+	// Shortest time between the start of two batches, e.g. 1m
+	Delay string `json:"delay,omitempty"`
+	// Time the integrations of a batch get to be published again before they count as failed, e.g. 30m
+	Timeout string `json:"timeout,omitempty"`
+	// Failed integrations after which the republication is aborted
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+}
+
+// Copies of secrets of the syndesis namespace kept in the integration namespaces. Their data is updated on every
+// reconcile so that rotated credentials reach the integrations, and they are removed once no longer selected.
+type SecretSyncConfiguration struct {
+	// Label selector of the secrets of the syndesis namespace to copy, e.g. syndesis.io/sync=true. No secret is copied when empty.
+	Selector string `json:"selector,omitempty"`
+	// Integration namespaces the secrets are copied to, all of them when empty
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Limits of the integrations. The namespace and memory limits are enforced by a ResourceQuota and a LimitRange the
+// operator keeps in the integration namespaces, which it then needs to be allowed to manage there.
+type IntegrationQuota struct {
+	// Integrations a user may create and deploy, replacing components.server.features.integrationLimit when set
+	MaxIntegrationsPerUser int `json:"maxIntegrationsPerUser,omitempty"`
+	// Integrations deployed at the same time in each of the integration namespaces
+	MaxIntegrationsPerNamespace int `json:"maxIntegrationsPerNamespace,omitempty"`
+	// Memory an integration container may use, e.g. 1Gi. Integrations are deployed with a lower memory limit
+	// when the server default exceeds it.
+	MaxMemory string `json:"maxMemory,omitempty"`
+}
+
+type IntegrationControllerConfiguration struct {
+	// Seconds between two checks of the state of the integrations
+	StateCheckInterval int `json:"stateCheckInterval,omitempty"`
+	// Integrations built and deployed at the same time, the other ones waiting for their turn
+	MaxConcurrentDeployments int `json:"maxConcurrentDeployments,omitempty"`
+	// Delays between the attempts of a failed deployment, failed deployments are not retried without an initial delay
+	RetryBackoff IntegrationRetryBackoff `json:"retryBackoff,omitempty"`
+}
+
+type IntegrationRetryBackoff struct {
+	// Seconds before the first retry, doubled at every attempt
+	InitialDelay int `json:"initialDelay,omitempty"`
+	// Longest delay between two attempts, in seconds
+	MaxDelay int `json:"maxDelay,omitempty"`
+}
+
+type RemediationConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Restarts of a crash looping container before it gets remediated
+	RestartThreshold int32 `json:"restartThreshold,omitempty"`
+	// Time given to a remediation to take effect before trying the next one
+	Interval string `json:"interval,omitempty"`
+}
+
+// Only the operator sends notifications, these settings are not passed to syndesis-server.
+type NotificationsConfiguration struct {
+	// Secret holding the host, port, username, password and from address of the SMTP server
+	SmtpSecret string `json:"smtpSecret,omitempty"`
+	// Email addresses notified
+	Recipients []string `json:"recipients,omitempty"`
+	// URL receiving a JSON POST for each notification
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
+
 type CamelKConfiguration struct {
 	Enabled       bool   `json:"enabled,omitempty"`
 	CamelVersion  string `json:"camelVersion,omitempty"`
@@ -165,6 +767,83 @@ const (
 	SyndesisPhaseUpgrading             SyndesisPhase = "Upgrading"
 	SyndesisPhaseUpgradeFailureBackoff SyndesisPhase = "UpgradeFailureBackoff"
 	SyndesisPhaseUpgradeFailed         SyndesisPhase = "UpgradeFailed"
+	SyndesisPhaseUpgradeQuarantined    SyndesisPhase = "UpgradeQuarantined"
+)
+
+type SyndesisProfile string
+
+const (
+	SyndesisProfileDefault SyndesisProfile = ""
+	SyndesisProfileDev     SyndesisProfile = "dev"
+)
+
+type SyndesisInstallMode string
+
+const (
+	SyndesisInstallModeFull               SyndesisInstallMode = "full"
+	SyndesisInstallModeInfrastructureOnly SyndesisInstallMode = "infrastructureOnly"
+)
+
+type SyndesisExposure string
+
+const (
+	SyndesisExposureRoute        SyndesisExposure = "route"
+	SyndesisExposureIngress      SyndesisExposure = "ingress"
+	SyndesisExposureLoadBalancer SyndesisExposure = "loadbalancer"
+	SyndesisExposureNodePort     SyndesisExposure = "nodeport"
+	SyndesisExposureNone         SyndesisExposure = "none"
+)
+
+type SyndesisAlternateHostnamesMode string
+
+const (
+	SyndesisAlternateHostnamesModeNone     SyndesisAlternateHostnamesMode = "none"
+	SyndesisAlternateHostnamesModeProxy    SyndesisAlternateHostnamesMode = "proxy"
+	SyndesisAlternateHostnamesModeRedirect SyndesisAlternateHostnamesMode = "redirect"
+)
+
+type SyndesisBackupMethod string
+
+const (
+	SyndesisBackupMethodDump     SyndesisBackupMethod = "dump"
+	SyndesisBackupMethodSnapshot SyndesisBackupMethod = "snapshot"
+)
+
+type SyndesisUpgradeFailurePolicy string
+
+const (
+	SyndesisUpgradeFailurePolicyRetry      SyndesisUpgradeFailurePolicy = "retry"
+	SyndesisUpgradeFailurePolicyQuarantine SyndesisUpgradeFailurePolicy = "quarantine"
+)
+
+type IntegrationRepublishPhase string
+
+const (
+	IntegrationRepublishPhaseRunning   IntegrationRepublishPhase = "Running"
+	IntegrationRepublishPhaseCompleted IntegrationRepublishPhase = "Completed"
+	IntegrationRepublishPhaseAborted   IntegrationRepublishPhase = "Aborted"
+)
+
+type SyndesisCertificateIssuer string
+
+const (
+	SyndesisCertificateIssuerServiceCA   SyndesisCertificateIssuer = "service-ca"
+	SyndesisCertificateIssuerCertManager SyndesisCertificateIssuer = "cert-manager"
+)
+
+type ImageUpdatePolicy string
+
+const (
+	ImageUpdatePolicyPinned      ImageUpdatePolicy = "pinned"
+	ImageUpdatePolicyTrackMinor  ImageUpdatePolicy = "track-minor"
+	ImageUpdatePolicyTrackLatest ImageUpdatePolicy = "track-latest"
+)
+
+type SyndesisIntegrationRuntime string
+
+const (
+	SyndesisIntegrationRuntimeSpringBoot SyndesisIntegrationRuntime = "springboot"
+	SyndesisIntegrationRuntimeCamelK     SyndesisIntegrationRuntime = "camelk"
 )
 
 type SyndesisStatusReason string
@@ -174,16 +853,112 @@ const (
 	SyndesisStatusReasonDuplicate              SyndesisStatusReason = "Duplicate"
 	SyndesisStatusReasonDeploymentNotReady     SyndesisStatusReason = "DeploymentNotReady"
 	SyndesisStatusReasonUpgradePodFailed       SyndesisStatusReason = "UpgradePodFailed"
+	SyndesisStatusReasonUpgradeTimedOut        SyndesisStatusReason = "UpgradeTimedOut"
+	SyndesisStatusReasonSmokeTestFailed        SyndesisStatusReason = "SmokeTestFailed"
 	SyndesisStatusReasonTooManyUpgradeAttempts SyndesisStatusReason = "TooManyUpgradeAttempts"
+	SyndesisStatusReasonInsufficientResources  SyndesisStatusReason = "InsufficientResources"
+	SyndesisStatusReasonUnsupportedCluster     SyndesisStatusReason = "UnsupportedCluster"
+)
+
+// +k8s:openapi-gen=true
+type SyndesisCondition struct {
+	Type               SyndesisConditionType  `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+}
+
+// Steps of the installation, in the order they are run
+type SyndesisConditionType string
+
+const (
+	SyndesisConditionPreflight      SyndesisConditionType = "Preflight"
+	SyndesisConditionSecrets        SyndesisConditionType = "Secrets"
+	SyndesisConditionDatabase       SyndesisConditionType = "Database"
+	SyndesisConditionInfrastructure SyndesisConditionType = "Infrastructure"
+	SyndesisConditionAddons         SyndesisConditionType = "Addons"
+	SyndesisConditionExposure       SyndesisConditionType = "Exposure"
+	SyndesisConditionReady          SyndesisConditionType = "Ready"
+	SyndesisConditionDependencies   SyndesisConditionType = "Dependencies"
+	SyndesisConditionCertificates   SyndesisConditionType = "Certificates"
+	SyndesisConditionDegraded       SyndesisConditionType = "Degraded"
+	SyndesisConditionReconciled     SyndesisConditionType = "Reconciled"
 )
 
+type SyndesisDrift struct {
+	DetectedAt metav1.Time `json:"detectedAt"`
+	// Kind and name of each drifted resource, e.g. DeploymentConfig/syndesis-server
+	Resources []string `json:"resources"`
+}
+
+type IntegrationRepublishStatus struct {
+	Phase IntegrationRepublishPhase `json:"phase,omitempty"`
+	// What started the republication: the configuration, or the annotation and its value
+	Reason string `json:"reason,omitempty"`
+	// Hash of the S2I image and of the maven repositories the integrations were last republished for
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Value of the syndesis.io/republish-integrations annotation last acted upon
+	Annotation  string       `json:"annotation,omitempty"`
+	StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// When the last batch started
+	LastBatchAt *metav1.Time `json:"lastBatchAt,omitempty"`
+	// Integrations to republish, and the ones republished so far
+	Total       int `json:"total,omitempty"`
+	Republished int `json:"republished,omitempty"`
+	// Deployments of the current batch not published yet, as <integration id>:<deployment version>
+	Batch []string `json:"batch,omitempty"`
+	// Ids of the integrations waiting for a batch
+	Pending []string `json:"pending,omitempty"`
+	// Ids of the integrations that failed to be published again
+	Failed []string `json:"failed,omitempty"`
+	// Ids of the integrations left out as they have unpublished changes, which republishing them would publish
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+type ImagePolicyStatus struct {
+	// When the registries were last checked
+	LastCheck *metav1.Time `json:"lastCheck,omitempty"`
+	// Policy and images as configured at the last check, they are checked again as soon as either changes
+	Policy  ImageUpdatePolicy `json:"policy,omitempty"`
+	Checked []string          `json:"checked,omitempty"`
+	// Images the components run instead of the configured ones, by digest, keyed by the configured image
+	Resolved map[string]string `json:"resolved,omitempty"`
+	// Newer releases of the component images the policy doesn't follow
+	Available []ImageUpdate `json:"available,omitempty"`
+	// Images that could not be checked, with the reason
+	Errors []string `json:"errors,omitempty"`
+}
+
+type ImageUpdate struct {
+	// Image as configured
+	Image string `json:"image"`
+	// Newest tag of its repository the policy doesn't follow
+	Tag string `json:"tag"`
+}
+
+type SyndesisRemediation struct {
+	Component   string      `json:"component"`
+	Attempts    int32       `json:"attempts"`
+	LastAction  string      `json:"lastAction,omitempty"`
+	LastAttempt metav1.Time `json:"lastAttempt,omitempty"`
+}
+
 // =============================================================================
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Syndesis is the Schema for the syndeses API
+// +genclient
+// +resourceName=syndeses
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version"
+// +kubebuilder:printcolumn:name="Target Version",type="string",JSONPath=".status.targetVersion",priority=1
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.externalURL"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type Syndesis struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Name of the SyndesisOperatorConfig holding the cluster defaults, others are ignored
+const SyndesisOperatorConfigName = "cluster"
+
+// SyndesisOperatorConfigSpec defines the defaults platform administrators set for all the syndesis
+// installations of the cluster. The default profile can be changed by each Syndesis resource, the
+// other settings are enforced.
+// +k8s:openapi-gen=true
+type SyndesisOperatorConfigSpec struct {
+	// Registry replacing the one of all the images syndesis runs, e.g. a mirror of docker.io and quay.io.
+	RegistryMirror string `json:"registryMirror,omitempty"`
+
+	// Serve the image stream tags the components trigger on from image streams of the syndesis namespace,
+	// importing the images from the registry mirror, so the deployments keep working when the registry moves.
+	MirrorImageStreams bool `json:"mirrorImageStreams,omitempty"`
+
+	// Rules the passwords and keys generated by the operator follow.
+	PasswordPolicy PasswordPolicy `json:"passwordPolicy,omitempty"`
+
+	// Profile of the Syndesis resources not setting one.
+	DefaultProfile SyndesisProfile `json:"defaultProfile,omitempty"`
+
+	// Addons that are never installed, even when enabled by a Syndesis resource.
+	ForbiddenAddons []string `json:"forbiddenAddons,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type PasswordPolicy struct {
+	// Minimum length of the generated passwords, existing passwords are kept as they are.
+	MinLength int `json:"minLength,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SyndesisOperatorConfig is the Schema for the syndesisoperatorconfigs API
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:scope=Cluster
+// +genclient
+// +genclient:nonNamespaced
+type SyndesisOperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SyndesisOperatorConfigSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SyndesisOperatorConfigList contains a list of SyndesisOperatorConfig
+type SyndesisOperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyndesisOperatorConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SyndesisOperatorConfig{}, &SyndesisOperatorConfigList{})
+}
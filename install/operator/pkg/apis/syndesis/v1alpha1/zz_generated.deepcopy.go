@@ -5,298 +5,1328 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonPodsConfiguration) DeepCopyInto(out *AddonPodsConfiguration) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Resources = in.Resources
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonPodsConfiguration.
+func (in *AddonPodsConfiguration) DeepCopy() *AddonPodsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonPodsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonResources) DeepCopyInto(out *AddonResources) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonResources.
+func (in *AddonResources) DeepCopy() *AddonResources {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSpec.
-func (in *AddonSpec) DeepCopy() *AddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSpec.
+func (in *AddonSpec) DeepCopy() *AddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonsSpec) DeepCopyInto(out *AddonsSpec) {
+	*out = *in
+	in.Jaeger.DeepCopyInto(&out.Jaeger)
+	out.Ops = in.Ops
+	in.Todo.DeepCopyInto(&out.Todo)
+	out.Broker = in.Broker
+	out.Knative = in.Knative
+	in.DV.DeepCopyInto(&out.DV)
+	out.CamelK = in.CamelK
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsSpec.
+func (in *AddonsSpec) DeepCopy() *AddonsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupConfiguration) DeepCopyInto(out *BackupConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupConfiguration.
+func (in *BackupConfiguration) DeepCopy() *BackupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelKConfiguration) DeepCopyInto(out *CamelKConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelKConfiguration.
+func (in *CamelKConfiguration) DeepCopy() *CamelKConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelKConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerReference) DeepCopyInto(out *CertificateIssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerReference.
+func (in *CertificateIssuerReference) DeepCopy() *CertificateIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesConfiguration) DeepCopyInto(out *CertificatesConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesConfiguration.
+func (in *CertificatesConfiguration) DeepCopy() *CertificatesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
+	*out = *in
+	in.UI.DeepCopyInto(&out.UI)
+	in.Oauth.DeepCopyInto(&out.Oauth)
+	in.Server.DeepCopyInto(&out.Server)
+	in.Meta.DeepCopyInto(&out.Meta)
+	in.Database.DeepCopyInto(&out.Database)
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	out.Grafana = in.Grafana
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentsSpec.
+func (in *ComponentsSpec) DeepCopy() *ComponentsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionConfiguration) DeepCopyInto(out *ConnectionConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionConfiguration.
+func (in *ConnectionConfiguration) DeepCopy() *ConnectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorsConfiguration) DeepCopyInto(out *ConnectorsConfiguration) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorsConfiguration.
+func (in *ConnectorsConfiguration) DeepCopy() *ConnectorsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleLinkConfiguration) DeepCopyInto(out *ConsoleLinkConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleLinkConfiguration.
+func (in *ConsoleLinkConfiguration) DeepCopy() *ConsoleLinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleLinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseConfiguration) DeepCopyInto(out *DatabaseConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.ExternalReplicaURLs != nil {
+		in, out := &in.ExternalReplicaURLs, &out.ExternalReplicaURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Maintenance = in.Maintenance
+	out.Exporter = in.Exporter
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseConfiguration.
+func (in *DatabaseConfiguration) DeepCopy() *DatabaseConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseConnection) DeepCopyInto(out *DatabaseConnection) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseConnection.
+func (in *DatabaseConnection) DeepCopy() *DatabaseConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseExporterConfiguration) DeepCopyInto(out *DatabaseExporterConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseExporterConfiguration.
+func (in *DatabaseExporterConfiguration) DeepCopy() *DatabaseExporterConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseExporterConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseMaintenance) DeepCopyInto(out *DatabaseMaintenance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseMaintenance.
+func (in *DatabaseMaintenance) DeepCopy() *DatabaseMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DemoDataConfiguration) DeepCopyInto(out *DemoDataConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DemoDataConfiguration.
+func (in *DemoDataConfiguration) DeepCopy() *DemoDataConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DemoDataConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DvConfiguration) DeepCopyInto(out *DvConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	in.Pods.DeepCopyInto(&out.Pods)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DvConfiguration.
+func (in *DvConfiguration) DeepCopy() *DvConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DvConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaConfiguration) DeepCopyInto(out *GrafanaConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaConfiguration.
+func (in *GrafanaConfiguration) DeepCopy() *GrafanaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicyConfiguration) DeepCopyInto(out *ImagePolicyConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicyConfiguration.
+func (in *ImagePolicyConfiguration) DeepCopy() *ImagePolicyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicyStatus) DeepCopyInto(out *ImagePolicyStatus) {
+	*out = *in
+	if in.LastCheck != nil {
+		in, out := &in.LastCheck, &out.LastCheck
+		*out = (*in).DeepCopy()
+	}
+	if in.Checked != nil {
+		in, out := &in.Checked, &out.Checked
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resolved != nil {
+		in, out := &in.Resolved, &out.Resolved
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Available != nil {
+		in, out := &in.Available, &out.Available
+		*out = make([]ImageUpdate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicyStatus.
+func (in *ImagePolicyStatus) DeepCopy() *ImagePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUpdate) DeepCopyInto(out *ImageUpdate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpdate.
+func (in *ImageUpdate) DeepCopy() *ImageUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationConfiguration) DeepCopyInto(out *IntegrationConfiguration) {
+	*out = *in
+	out.Controller = in.Controller
+	out.Quota = in.Quota
+	in.SecretSync.DeepCopyInto(&out.SecretSync)
+	out.Republish = in.Republish
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationConfiguration.
+func (in *IntegrationConfiguration) DeepCopy() *IntegrationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationControllerConfiguration) DeepCopyInto(out *IntegrationControllerConfiguration) {
+	*out = *in
+	out.RetryBackoff = in.RetryBackoff
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationControllerConfiguration.
+func (in *IntegrationControllerConfiguration) DeepCopy() *IntegrationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationImportConfiguration) DeepCopyInto(out *IntegrationImportConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationImportConfiguration.
+func (in *IntegrationImportConfiguration) DeepCopy() *IntegrationImportConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationImportConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationQuota) DeepCopyInto(out *IntegrationQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationQuota.
+func (in *IntegrationQuota) DeepCopy() *IntegrationQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRepublishConfiguration) DeepCopyInto(out *IntegrationRepublishConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRepublishConfiguration.
+func (in *IntegrationRepublishConfiguration) DeepCopy() *IntegrationRepublishConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRepublishConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRepublishStatus) DeepCopyInto(out *IntegrationRepublishStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastBatchAt != nil {
+		in, out := &in.LastBatchAt, &out.LastBatchAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRepublishStatus.
+func (in *IntegrationRepublishStatus) DeepCopy() *IntegrationRepublishStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRepublishStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRetryBackoff) DeepCopyInto(out *IntegrationRetryBackoff) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRetryBackoff.
+func (in *IntegrationRetryBackoff) DeepCopy() *IntegrationRetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationsConfiguration) DeepCopyInto(out *IntegrationsConfiguration) {
+	*out = *in
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = make([]IntegrationImportConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationsConfiguration.
+func (in *IntegrationsConfiguration) DeepCopy() *IntegrationsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalTLSConfiguration) DeepCopyInto(out *InternalTLSConfiguration) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalTLSConfiguration.
+func (in *InternalTLSConfiguration) DeepCopy() *InternalTLSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalTLSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerConfiguration) DeepCopyInto(out *JaegerConfiguration) {
+	*out = *in
+	in.Sampling.DeepCopyInto(&out.Sampling)
+	in.Pods.DeepCopyInto(&out.Pods)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerConfiguration.
+func (in *JaegerConfiguration) DeepCopy() *JaegerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerSamplingConfiguration) DeepCopyInto(out *JaegerSamplingConfiguration) {
+	*out = *in
+	out.Default = in.Default
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make(map[string]JaegerSamplingStrategy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerSamplingConfiguration.
+func (in *JaegerSamplingConfiguration) DeepCopy() *JaegerSamplingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerSamplingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerSamplingStrategy) DeepCopyInto(out *JaegerSamplingStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerSamplingStrategy.
+func (in *JaegerSamplingStrategy) DeepCopy() *JaegerSamplingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerSamplingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetaConfiguration) DeepCopyInto(out *MetaConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	in.Shutdown.DeepCopyInto(&out.Shutdown)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetaConfiguration.
+func (in *MetaConfiguration) DeepCopy() *MetaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MetaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceManagementConfiguration) DeepCopyInto(out *NamespaceManagementConfiguration) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceManagementConfiguration.
+func (in *NamespaceManagementConfiguration) DeepCopy() *NamespaceManagementConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceManagementConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsConfiguration) DeepCopyInto(out *NotificationsConfiguration) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfiguration.
+func (in *NotificationsConfiguration) DeepCopy() *NotificationsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuthClientConfiguration) DeepCopyInto(out *OAuthClientConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthClientConfiguration.
+func (in *OAuthClientConfiguration) DeepCopy() *OAuthClientConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuthClientConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OauthConfiguration) DeepCopyInto(out *OauthConfiguration) {
+	*out = *in
+	out.Client = in.Client
+	out.Resources = in.Resources
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OauthConfiguration.
+func (in *OauthConfiguration) DeepCopy() *OauthConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OauthConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConfiguration) DeepCopyInto(out *OpsConfiguration) {
+	*out = *in
+	out.SLO = in.SLO
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConfiguration.
+func (in *OpsConfiguration) DeepCopy() *OpsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusConfiguration) DeepCopyInto(out *PrometheusConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	out.Resources = in.Resources
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = make([]PrometheusRemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Federation.DeepCopyInto(&out.Federation)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusConfiguration.
+func (in *PrometheusConfiguration) DeepCopy() *PrometheusConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusFederation) DeepCopyInto(out *PrometheusFederation) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusFederation.
+func (in *PrometheusFederation) DeepCopy() *PrometheusFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRelabelConfig) DeepCopyInto(out *PrometheusRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRelabelConfig.
+func (in *PrometheusRelabelConfig) DeepCopy() *PrometheusRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
+	*out = *in
+	if in.WriteRelabelConfigs != nil {
+		in, out := &in.WriteRelabelConfigs, &out.WriteRelabelConfigs
+		*out = make([]PrometheusRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationConfiguration) DeepCopyInto(out *RemediationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationConfiguration.
+func (in *RemediationConfiguration) DeepCopy() *RemediationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resources) DeepCopyInto(out *Resources) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
+func (in *Resources) DeepCopy() *Resources {
+	if in == nil {
+		return nil
+	}
+	out := new(Resources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcesWithVolume) DeepCopyInto(out *ResourcesWithVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesWithVolume.
+func (in *ResourcesWithVolume) DeepCopy() *ResourcesWithVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(AddonSpec)
+	out := new(ResourcesWithVolume)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AddonsSpec) DeepCopyInto(out *AddonsSpec) {
+func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
 	*out = *in
-	out.Jaeger = in.Jaeger
-	out.Ops = in.Ops
-	out.Todo = in.Todo
-	out.Knative = in.Knative
-	out.DV = in.DV
-	out.CamelK = in.CamelK
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsSpec.
-func (in *AddonsSpec) DeepCopy() *AddonsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
+func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(AddonsSpec)
+	out := new(RouteConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CamelKConfiguration) DeepCopyInto(out *CamelKConfiguration) {
+func (in *SLOConfiguration) DeepCopyInto(out *SLOConfiguration) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelKConfiguration.
-func (in *CamelKConfiguration) DeepCopy() *CamelKConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOConfiguration.
+func (in *SLOConfiguration) DeepCopy() *SLOConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(CamelKConfiguration)
+	out := new(SLOConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
+func (in *SchedulingConfiguration) DeepCopyInto(out *SchedulingConfiguration) {
 	*out = *in
-	out.Oauth = in.Oauth
-	in.Server.DeepCopyInto(&out.Server)
-	out.Meta = in.Meta
-	out.Database = in.Database
-	out.Prometheus = in.Prometheus
-	out.Grafana = in.Grafana
-	out.Upgrade = in.Upgrade
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentsSpec.
-func (in *ComponentsSpec) DeepCopy() *ComponentsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConfiguration.
+func (in *SchedulingConfiguration) DeepCopy() *SchedulingConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentsSpec)
+	out := new(SchedulingConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseConfiguration) DeepCopyInto(out *DatabaseConfiguration) {
+func (in *SecretSyncConfiguration) DeepCopyInto(out *SecretSyncConfiguration) {
 	*out = *in
-	out.Resources = in.Resources
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseConfiguration.
-func (in *DatabaseConfiguration) DeepCopy() *DatabaseConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncConfiguration.
+func (in *SecretSyncConfiguration) DeepCopy() *SecretSyncConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseConfiguration)
+	out := new(SecretSyncConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DvConfiguration) DeepCopyInto(out *DvConfiguration) {
+func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 	*out = *in
 	out.Resources = in.Resources
+	in.Features.DeepCopyInto(&out.Features)
+	in.Shutdown.DeepCopyInto(&out.Shutdown)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DvConfiguration.
-func (in *DvConfiguration) DeepCopy() *DvConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfiguration.
+func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DvConfiguration)
+	out := new(ServerConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaConfiguration) DeepCopyInto(out *GrafanaConfiguration) {
+func (in *ServerFeatures) DeepCopyInto(out *ServerFeatures) {
 	*out = *in
-	out.Resources = in.Resources
+	if in.MavenRepositories != nil {
+		in, out := &in.MavenRepositories, &out.MavenRepositories
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.DemoData = in.DemoData
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaConfiguration.
-func (in *GrafanaConfiguration) DeepCopy() *GrafanaConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerFeatures.
+func (in *ServerFeatures) DeepCopy() *ServerFeatures {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaConfiguration)
+	out := new(ServerFeatures)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JaegerConfiguration) DeepCopyInto(out *JaegerConfiguration) {
+func (in *ShutdownConfiguration) DeepCopyInto(out *ShutdownConfiguration) {
 	*out = *in
+	if in.PreStop != nil {
+		in, out := &in.PreStop, &out.PreStop
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerConfiguration.
-func (in *JaegerConfiguration) DeepCopy() *JaegerConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownConfiguration.
+func (in *ShutdownConfiguration) DeepCopy() *ShutdownConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(JaegerConfiguration)
+	out := new(ShutdownConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetaConfiguration) DeepCopyInto(out *MetaConfiguration) {
+func (in *SmokeTestConfiguration) DeepCopyInto(out *SmokeTestConfiguration) {
 	*out = *in
-	out.Resources = in.Resources
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetaConfiguration.
-func (in *MetaConfiguration) DeepCopy() *MetaConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmokeTestConfiguration.
+func (in *SmokeTestConfiguration) DeepCopy() *SmokeTestConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(MetaConfiguration)
+	out := new(SmokeTestConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OauthConfiguration) DeepCopyInto(out *OauthConfiguration) {
+func (in *StandbyConfiguration) DeepCopyInto(out *StandbyConfiguration) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OauthConfiguration.
-func (in *OauthConfiguration) DeepCopy() *OauthConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandbyConfiguration.
+func (in *StandbyConfiguration) DeepCopy() *StandbyConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(OauthConfiguration)
+	out := new(StandbyConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusConfiguration) DeepCopyInto(out *PrometheusConfiguration) {
+func (in *StartupProbeConfiguration) DeepCopyInto(out *StartupProbeConfiguration) {
 	*out = *in
-	out.Resources = in.Resources
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusConfiguration.
-func (in *PrometheusConfiguration) DeepCopy() *PrometheusConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupProbeConfiguration.
+func (in *StartupProbeConfiguration) DeepCopy() *StartupProbeConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusConfiguration)
+	out := new(StartupProbeConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Resources) DeepCopyInto(out *Resources) {
+func (in *Syndesis) DeepCopyInto(out *Syndesis) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
-func (in *Resources) DeepCopy() *Resources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syndesis.
+func (in *Syndesis) DeepCopy() *Syndesis {
 	if in == nil {
 		return nil
 	}
-	out := new(Resources)
+	out := new(Syndesis)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Syndesis) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourcesWithVolume) DeepCopyInto(out *ResourcesWithVolume) {
+func (in *SyndesisCondition) DeepCopyInto(out *SyndesisCondition) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesWithVolume.
-func (in *ResourcesWithVolume) DeepCopy() *ResourcesWithVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisCondition.
+func (in *SyndesisCondition) DeepCopy() *SyndesisCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourcesWithVolume)
+	out := new(SyndesisCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
+func (in *SyndesisDrift) DeepCopyInto(out *SyndesisDrift) {
 	*out = *in
-	out.Resources = in.Resources
-	in.Features.DeepCopyInto(&out.Features)
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfiguration.
-func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisDrift.
+func (in *SyndesisDrift) DeepCopy() *SyndesisDrift {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerConfiguration)
+	out := new(SyndesisDrift)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerFeatures) DeepCopyInto(out *ServerFeatures) {
+func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
 	*out = *in
-	if in.MavenRepositories != nil {
-		in, out := &in.MavenRepositories, &out.MavenRepositories
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Syndesis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerFeatures.
-func (in *ServerFeatures) DeepCopy() *ServerFeatures {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisList.
+func (in *SyndesisList) DeepCopy() *SyndesisList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerFeatures)
+	out := new(SyndesisList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyndesisList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Syndesis) DeepCopyInto(out *Syndesis) {
+func (in *SyndesisOperatorConfig) DeepCopyInto(out *SyndesisOperatorConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syndesis.
-func (in *Syndesis) DeepCopy() *Syndesis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisOperatorConfig.
+func (in *SyndesisOperatorConfig) DeepCopy() *SyndesisOperatorConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Syndesis)
+	out := new(SyndesisOperatorConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Syndesis) DeepCopyObject() runtime.Object {
+func (in *SyndesisOperatorConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -304,13 +1334,13 @@ func (in *Syndesis) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
+func (in *SyndesisOperatorConfigList) DeepCopyInto(out *SyndesisOperatorConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Syndesis, len(*in))
+		*out = make([]SyndesisOperatorConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -318,29 +1348,112 @@ func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisList.
-func (in *SyndesisList) DeepCopy() *SyndesisList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisOperatorConfigList.
+func (in *SyndesisOperatorConfigList) DeepCopy() *SyndesisOperatorConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(SyndesisList)
+	out := new(SyndesisOperatorConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SyndesisList) DeepCopyObject() runtime.Object {
+func (in *SyndesisOperatorConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisOperatorConfigSpec) DeepCopyInto(out *SyndesisOperatorConfigSpec) {
+	*out = *in
+	out.PasswordPolicy = in.PasswordPolicy
+	if in.ForbiddenAddons != nil {
+		in, out := &in.ForbiddenAddons, &out.ForbiddenAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisOperatorConfigSpec.
+func (in *SyndesisOperatorConfigSpec) DeepCopy() *SyndesisOperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisOperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisRemediation) DeepCopyInto(out *SyndesisRemediation) {
+	*out = *in
+	in.LastAttempt.DeepCopyInto(&out.LastAttempt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisRemediation.
+func (in *SyndesisRemediation) DeepCopy() *SyndesisRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyndesisSpec) DeepCopyInto(out *SyndesisSpec) {
 	*out = *in
 	in.Components.DeepCopyInto(&out.Components)
-	out.Addons = in.Addons
+	in.Addons.DeepCopyInto(&out.Addons)
+	out.ConsoleLink = in.ConsoleLink
+	if in.AlternateHostnames != nil {
+		in, out := &in.AlternateHostnames, &out.AlternateHostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Notifications.DeepCopyInto(&out.Notifications)
+	in.NamespaceManagement.DeepCopyInto(&out.NamespaceManagement)
+	in.Logging.DeepCopyInto(&out.Logging)
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]ConnectionConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	in.Integrations.DeepCopyInto(&out.Integrations)
+	out.Telemetry = in.Telemetry
+	out.StartupProbe = in.StartupProbe
+	out.Remediation = in.Remediation
+	if in.IntegrationNamespaces != nil {
+		in, out := &in.IntegrationNamespaces, &out.IntegrationNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]v1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Integration.DeepCopyInto(&out.Integration)
+	out.Backup = in.Backup
+	out.Certificates = in.Certificates
+	in.Connectors.DeepCopyInto(&out.Connectors)
+	out.SmokeTest = in.SmokeTest
+	in.Route.DeepCopyInto(&out.Route)
+	out.Standby = in.Standby
+	out.InternalTLS = in.InternalTLS
+	out.ImagePolicy = in.ImagePolicy
 	return
 }
 
@@ -361,6 +1474,64 @@ func (in *SyndesisStatus) DeepCopyInto(out *SyndesisStatus) {
 		in, out := &in.LastUpgradeFailure, &out.LastUpgradeFailure
 		*out = (*in).DeepCopy()
 	}
+	if in.PullSecretNamespaces != nil {
+		in, out := &in.PullSecretNamespaces, &out.PullSecretNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncedSecrets != nil {
+		in, out := &in.SyncedSecrets, &out.SyncedSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]SyndesisCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProvisionedConnections != nil {
+		in, out := &in.ProvisionedConnections, &out.ProvisionedConnections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportedIntegrations != nil {
+		in, out := &in.ImportedIntegrations, &out.ImportedIntegrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]SyndesisRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(SyndesisDrift)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StandbyPromotedAt != nil {
+		in, out := &in.StandbyPromotedAt, &out.StandbyPromotedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Republish != nil {
+		in, out := &in.Republish, &out.Republish
+		*out = new(IntegrationRepublishStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ImagePolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -374,10 +1545,62 @@ func (in *SyndesisStatus) DeepCopy() *SyndesisStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryConfiguration) DeepCopyInto(out *TelemetryConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryConfiguration.
+func (in *TelemetryConfiguration) DeepCopy() *TelemetryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TodoConfiguration) DeepCopyInto(out *TodoConfiguration) {
+	*out = *in
+	in.Pods.DeepCopyInto(&out.Pods)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TodoConfiguration.
+func (in *TodoConfiguration) DeepCopy() *TodoConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TodoConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UIConfiguration) DeepCopyInto(out *UIConfiguration) {
+	*out = *in
+	in.Shutdown.DeepCopyInto(&out.Shutdown)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UIConfiguration.
+func (in *UIConfiguration) DeepCopy() *UIConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UIConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradeConfiguration) DeepCopyInto(out *UpgradeConfiguration) {
 	*out = *in
 	out.Resources = in.Resources
+	in.Pods.DeepCopyInto(&out.Pods)
 	return
 }
 
@@ -11,10 +11,14 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ComponentsSpec": schema_pkg_apis_syndesis_v1alpha1_ComponentsSpec(ref),
-		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.Syndesis":       schema_pkg_apis_syndesis_v1alpha1_Syndesis(ref),
-		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisSpec":   schema_pkg_apis_syndesis_v1alpha1_SyndesisSpec(ref),
-		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisStatus": schema_pkg_apis_syndesis_v1alpha1_SyndesisStatus(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ComponentsSpec":             schema_pkg_apis_syndesis_v1alpha1_ComponentsSpec(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.PasswordPolicy":             schema_pkg_apis_syndesis_v1alpha1_PasswordPolicy(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.Syndesis":                   schema_pkg_apis_syndesis_v1alpha1_Syndesis(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisCondition":          schema_pkg_apis_syndesis_v1alpha1_SyndesisCondition(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisOperatorConfig":     schema_pkg_apis_syndesis_v1alpha1_SyndesisOperatorConfig(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisOperatorConfigSpec": schema_pkg_apis_syndesis_v1alpha1_SyndesisOperatorConfigSpec(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisSpec":               schema_pkg_apis_syndesis_v1alpha1_SyndesisSpec(ref),
+		"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisStatus":             schema_pkg_apis_syndesis_v1alpha1_SyndesisStatus(ref),
 	}
 }
 
@@ -23,6 +27,11 @@ func schema_pkg_apis_syndesis_v1alpha1_ComponentsSpec(ref common.ReferenceCallba
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Properties: map[string]spec.Schema{
+					"ui": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.UIConfiguration"),
+						},
+					},
 					"oauth": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.OauthConfiguration"),
@@ -62,7 +71,25 @@ func schema_pkg_apis_syndesis_v1alpha1_ComponentsSpec(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.DatabaseConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.GrafanaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.MetaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.OauthConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.PrometheusConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ServerConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.UpgradeConfiguration"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.DatabaseConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.GrafanaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.MetaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.OauthConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.PrometheusConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ServerConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.UIConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.UpgradeConfiguration"},
+	}
+}
+
+func schema_pkg_apis_syndesis_v1alpha1_PasswordPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Properties: map[string]spec.Schema{
+					"minLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum length of the generated passwords, existing passwords are kept as they are.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -109,6 +136,142 @@ func schema_pkg_apis_syndesis_v1alpha1_Syndesis(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_syndesis_v1alpha1_SyndesisCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_syndesis_v1alpha1_SyndesisOperatorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyndesisOperatorConfig is the Schema for the syndesisoperatorconfigs API",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisOperatorConfigSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisOperatorConfigSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_syndesis_v1alpha1_SyndesisOperatorConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyndesisOperatorConfigSpec defines the defaults platform administrators set for all the syndesis installations of the cluster. The default profile can be changed by each Syndesis resource, the other settings are enforced.",
+				Properties: map[string]spec.Schema{
+					"registryMirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Registry replacing the one of all the images syndesis runs, e.g. a mirror of docker.io and quay.io.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirrorImageStreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Serve the image stream tags the components trigger on from image streams of the syndesis namespace, importing the images from the registry mirror, so the deployments keep working when the registry moves.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"passwordPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules the passwords and keys generated by the operator follow.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.PasswordPolicy"),
+						},
+					},
+					"defaultProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile of the Syndesis resources not setting one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forbiddenAddons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addons that are never installed, even when enabled by a Syndesis resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.PasswordPolicy"},
+	}
+}
+
 func schema_pkg_apis_syndesis_v1alpha1_SyndesisSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -121,6 +284,20 @@ func schema_pkg_apis_syndesis_v1alpha1_SyndesisSpec(ref common.ReferenceCallback
 							Format: "",
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set to \"dev\" for a small footprint installation fitting into CodeReady Containers or minikube.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"installMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "What gets installed: full (default), or infrastructureOnly to provision the database, prometheus, oauth and secrets without syndesis-server, syndesis-meta and syndesis-ui, e.g. to restore data before bringing them up.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"components": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Components is used to configure all the core components of Syndesis",
@@ -133,11 +310,230 @@ func schema_pkg_apis_syndesis_v1alpha1_SyndesisSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.AddonsSpec"),
 						},
 					},
+					"consoleLink": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entry added to the OpenShift 4 web console application launcher.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConsoleLinkConfiguration"),
+						},
+					},
+					"exposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How syndesis is exposed outside of the cluster: route (default), ingress, loadbalancer, nodeport or none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalHostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hostname syndesis is reachable at, required when not exposed with a route.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alternateHostnames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional hostnames syndesis is reachable at, e.g. a vanity DNS name in front of the route. They are accepted as CORS origins and OAuth redirect URIs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"alternateHostnamesMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How the alternate hostnames are served: none (default) when they are routed to syndesis outside of the cluster, proxy to serve syndesis on them too, or redirect to send their visitors to the canonical hostname.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedOrigins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional origins allowed by the server CORS configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"notifications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Where the operator sends notifications of upgrades, backup failures and certificate expiry to.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.NotificationsConfiguration"),
+						},
+					},
+					"testSupport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enables the test support endpoints of syndesis-server, together with the access rules test runners need. Never enable it on a production installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"namespaceManagement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels the operator keeps on the namespace syndesis is installed into, e.g. monitoring or pod security labels.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.NamespaceManagementConfiguration"),
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log levels of syndesis-server and syndesis-meta.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.LoggingConfiguration"),
+						},
+					},
+					"connections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Connections created once syndesis is installed, so that environments can be provisioned without using the console.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConnectionConfiguration"),
+									},
+								},
+							},
+						},
+					},
+					"integrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports of integrations imported once syndesis is installed, so that they can be promoted from one environment to the next declaratively.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationsConfiguration"),
+						},
+					},
+					"telemetry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Opt-in reporting of anonymous usage data to the syndesis maintainers.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.TelemetryConfiguration"),
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time given to syndesis-server, syndesis-meta and the data virtualization server to start, before liveness probes apply. Only used on clusters running startup probes.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.StartupProbeConfiguration"),
+						},
+					},
+					"remediation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Watchdog restarting components stuck in CrashLoopBackOff, reporting a Degraded condition when that doesn't help.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.RemediationConfiguration"),
+						},
+					},
+					"integrationNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces integrations are built and deployed in besides the syndesis namespace. The syndesis pull secret is copied there and linked to their builder and deployer service accounts, the operator needs to be granted the management of secrets and service accounts in these namespaces, and of limit ranges and resource quotas when integration.quota limits the integrations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"hostAliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entries added to the hosts file of the component pods, e.g. for corporate names resolving differently inside the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.HostAlias"),
+									},
+								},
+							},
+						},
+					},
+					"dnsSuffix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS suffix of the cluster when it is not the one configured on the nodes, the component pods then search <namespace>.svc.<suffix>, svc.<suffix> and <suffix> to resolve short names.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time zone of the schedules of the scheduled operations like the database maintenance, e.g. Europe/Paris. Schedules are in UTC when not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"integration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tuning of how syndesis-server runs the integrations, for installations with many of them.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationConfiguration"),
+						},
+					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How the database is backed up, by the backup command and before upgrades.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.BackupConfiguration"),
+						},
+					},
+					"connectivityChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Probe the external dependencies, like the maven repositories or an external database, from the operator before declaring syndesis ready. Unreachable ones are reported by the Dependencies condition, the installation goes on.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"certificates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiry monitoring of the serving certificates of the route and the oauth proxy.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.CertificatesConfiguration"),
+						},
+					},
+					"connectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Connectors teams may use, e.g. to forbid ftp or plain http in a managed environment.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConnectorsConfiguration"),
+						},
+					},
+					"smokeTest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job exercising the installation once its deployments are ready, after installs and upgrades. Syndesis is only declared ready once the job passes.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SmokeTestConfiguration"),
+						},
+					},
+					"route": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels and annotations of the routes syndesis is exposed with, e.g. the labels selecting the ingress controller shard serving them on clusters with sharded routers.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.RouteConfiguration"),
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Run the installation as the warm standby of a primary one in another namespace or cluster, for disaster recovery: its database streams the one of the primary while syndesis-server, syndesis-meta and syndesis-ui stay scaled down. Setting standby.enabled back to false promotes the installation.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.StandbyConfiguration"),
+						},
+					},
+					"internalTLS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encrypt the traffic between syndesis-server, syndesis-meta and the database with certificates issued by the OpenShift service CA or cert-manager, for clusters requiring all in-cluster traffic to be encrypted.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.InternalTLSConfiguration"),
+						},
+					},
+					"imagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the components follow new releases of their images: pinned (default) runs the images of the operator release until they are explicitly bumped, track-minor follows new patch releases and track-latest new minor and patch releases, resolving the tags to their digests on a schedule.",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ImagePolicyConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.AddonsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ComponentsSpec"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.AddonsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.BackupConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.CertificatesConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ComponentsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConnectionConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConnectorsConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ConsoleLinkConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ImagePolicyConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationsConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.InternalTLSConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.LoggingConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.NamespaceManagementConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.NotificationsConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.RemediationConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.RouteConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SmokeTestConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.StandbyConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.StartupProbeConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.TelemetryConfiguration", "k8s.io/api/core/v1.HostAlias"},
 	}
 }
 
@@ -194,10 +590,158 @@ func schema_pkg_apis_syndesis_v1alpha1_SyndesisStatus(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"externalURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL the syndesis console is reachable at",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"testSupport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set when test support is enabled, flagging the installation as not fit for production",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pullSecretNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces holding a copy of the syndesis pull secret",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"syncedSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Copies of the secrets selected by integration.secretSync, as namespace/name",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outcome of each step of the installation, the last one reached tells where an install is stuck",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisCondition"),
+									},
+								},
+							},
+						},
+					},
+					"warnings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Problems worth the attention of an administrator that don't prevent the installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"provisionedConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the connections of the spec already created, they are not touched afterwards",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"importedIntegrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the integration imports of the spec already done, they are not repeated afterwards",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"remediations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remediations attempted on the components currently crash looping",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisRemediation"),
+									},
+								},
+							},
+						},
+					},
+					"forcedReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the syndesis.io/force-reconcile annotation last acted upon",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drift": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources last found changed outside of the operator, their configuration has been restored since",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisDrift"),
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set while the installation runs as the warm standby of a primary one",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"standbyPromotedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When the installation, a warm standby until then, was promoted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"republish": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress of the republication of the integrations, resumed from here when the operator restarts",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationRepublishStatus"),
+						},
+					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outcome of the last check of the registries for new releases of the component images",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ImagePolicyStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.ImagePolicyStatus", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.IntegrationRepublishStatus", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisCondition", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisDrift", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1.SyndesisRemediation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+// Both versions hold the same configuration, so that resources convert back and forth without losing any field
+
+// ConvertFrom fills the resource with a copy of the v1alpha1 one
+func (dst *Syndesis) ConvertFrom(src *v1alpha1.Syndesis) {
+	src = src.DeepCopy()
+	dst.APIVersion = SchemeGroupVersion.String()
+	dst.Kind = "Syndesis"
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	in := &src.Spec
+	dst.Spec = SyndesisSpec{
+		Profile:     in.Profile,
+		InstallMode: in.InstallMode,
+		Images: ImagesSpec{
+			StreamNamespace: in.ImageStreamNamespace,
+			Policy:          in.ImagePolicy,
+		},
+		Components: in.Components,
+		Addons:     in.Addons,
+		Exposure: ExposureSpec{
+			Type:                   in.Exposure,
+			Hostname:               in.ExternalHostname,
+			AlternateHostnames:     in.AlternateHostnames,
+			AlternateHostnamesMode: in.AlternateHostnamesMode,
+			AllowedOrigins:         in.AllowedOrigins,
+			Route:                  in.Route,
+			Certificates:           in.Certificates,
+			ConsoleLink:            in.ConsoleLink,
+		},
+		Integrations: IntegrationsSpec{
+			Namespaces:  in.IntegrationNamespaces,
+			Runtime:     in.Integration.Runtime,
+			Controller:  in.Integration.Controller,
+			Quota:       in.Integration.Quota,
+			SecretSync:  in.Integration.SecretSync,
+			Republish:   in.Integration.Republish,
+			Connectors:  in.Connectors,
+			Connections: in.Connections,
+			Import:      in.Integrations.Import,
+		},
+		Operations: OperationsSpec{
+			Backup:             in.Backup,
+			Remediation:        in.Remediation,
+			SmokeTest:          in.SmokeTest,
+			StartupProbe:       in.StartupProbe,
+			ConnectivityChecks: in.ConnectivityChecks,
+			Timezone:           in.Timezone,
+			Logging:            in.Logging,
+			Notifications:      in.Notifications,
+			Telemetry:          in.Telemetry,
+		},
+		Pods: PodsSpec{
+			HostAliases: in.HostAliases,
+			DNSSuffix:   in.DNSSuffix,
+		},
+		NamespaceManagement: in.NamespaceManagement,
+		TestSupport:         in.TestSupport,
+		Standby:             in.Standby,
+		InternalTLS:         in.InternalTLS,
+	}
+}
+
+// ConvertTo fills the v1alpha1 resource with a copy of the resource
+func (src *Syndesis) ConvertTo(dst *v1alpha1.Syndesis) {
+	src = src.DeepCopy()
+	dst.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	dst.Kind = "Syndesis"
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	in := &src.Spec
+	dst.Spec = v1alpha1.SyndesisSpec{
+		ImageStreamNamespace:   in.Images.StreamNamespace,
+		Profile:                in.Profile,
+		InstallMode:            in.InstallMode,
+		Components:             in.Components,
+		Addons:                 in.Addons,
+		ConsoleLink:            in.Exposure.ConsoleLink,
+		Exposure:               in.Exposure.Type,
+		ExternalHostname:       in.Exposure.Hostname,
+		AlternateHostnames:     in.Exposure.AlternateHostnames,
+		AlternateHostnamesMode: in.Exposure.AlternateHostnamesMode,
+		AllowedOrigins:         in.Exposure.AllowedOrigins,
+		Notifications:          in.Operations.Notifications,
+		TestSupport:            in.TestSupport,
+		NamespaceManagement:    in.NamespaceManagement,
+		Logging:                in.Operations.Logging,
+		Connections:            in.Integrations.Connections,
+		Integrations: v1alpha1.IntegrationsConfiguration{
+			Import: in.Integrations.Import,
+		},
+		Telemetry:             in.Operations.Telemetry,
+		StartupProbe:          in.Operations.StartupProbe,
+		Remediation:           in.Operations.Remediation,
+		IntegrationNamespaces: in.Integrations.Namespaces,
+		HostAliases:           in.Pods.HostAliases,
+		DNSSuffix:             in.Pods.DNSSuffix,
+		Timezone:              in.Operations.Timezone,
+		Integration: v1alpha1.IntegrationConfiguration{
+			Controller: in.Integrations.Controller,
+			Runtime:    in.Integrations.Runtime,
+			Quota:      in.Integrations.Quota,
+			SecretSync: in.Integrations.SecretSync,
+			Republish:  in.Integrations.Republish,
+		},
+		Backup:             in.Operations.Backup,
+		ConnectivityChecks: in.Operations.ConnectivityChecks,
+		Certificates:       in.Exposure.Certificates,
+		Connectors:         in.Integrations.Connectors,
+		SmokeTest:          in.Operations.SmokeTest,
+		Route:              in.Exposure.Route,
+		Standby:            in.Standby,
+		InternalTLS:        in.InternalTLS,
+		ImagePolicy:        in.Images.Policy,
+	}
+}
@@ -0,0 +1,74 @@
+package v1beta1
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Every field of the v1alpha1 spec, random values included, survives a round trip through v1beta1
+func Test_ConvertRoundTrip(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		value, ok := quick.Value(reflect.TypeOf(v1alpha1.SyndesisSpec{}), random)
+		require.True(t, ok)
+
+		alpha := &v1alpha1.Syndesis{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+			Spec:       value.Interface().(v1alpha1.SyndesisSpec),
+			Status:     v1alpha1.SyndesisStatus{Phase: v1alpha1.SyndesisPhaseInstalled, Version: "1.9"},
+		}
+
+		beta := &Syndesis{}
+		beta.ConvertFrom(alpha)
+		assert.Equal(t, "syndesis.io/v1beta1", beta.APIVersion)
+
+		back := &v1alpha1.Syndesis{}
+		beta.ConvertTo(back)
+		assert.Equal(t, "syndesis.io/v1alpha1", back.APIVersion)
+		assert.Equal(t, alpha.ObjectMeta, back.ObjectMeta)
+		assert.Equal(t, alpha.Status, back.Status)
+		assert.Equal(t, alpha.Spec, back.Spec)
+	}
+}
+
+func Test_ConvertFrom(t *testing.T) {
+	alpha := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			ImageStreamNamespace:  "openshift",
+			Exposure:              v1alpha1.SyndesisExposureIngress,
+			ExternalHostname:      "syndesis.example.com",
+			IntegrationNamespaces: []string{"integrations"},
+			Integration: v1alpha1.IntegrationConfiguration{
+				Runtime: v1alpha1.SyndesisIntegrationRuntimeCamelK,
+			},
+			Timezone:  "Europe/Paris",
+			DNSSuffix: "cluster.example",
+			Addons: v1alpha1.AddonsSpec{
+				Todo: v1alpha1.TodoConfiguration{Enabled: true},
+			},
+		},
+	}
+
+	beta := &Syndesis{}
+	beta.ConvertFrom(alpha)
+
+	assert.Equal(t, "openshift", beta.Spec.Images.StreamNamespace)
+	assert.Equal(t, v1alpha1.SyndesisExposureIngress, beta.Spec.Exposure.Type)
+	assert.Equal(t, "syndesis.example.com", beta.Spec.Exposure.Hostname)
+	assert.Equal(t, []string{"integrations"}, beta.Spec.Integrations.Namespaces)
+	assert.Equal(t, v1alpha1.SyndesisIntegrationRuntimeCamelK, beta.Spec.Integrations.Runtime)
+	assert.Equal(t, "Europe/Paris", beta.Spec.Operations.Timezone)
+	assert.Equal(t, "cluster.example", beta.Spec.Pods.DNSSuffix)
+	assert.True(t, beta.Spec.Addons.Todo.Enabled)
+
+	// The conversion works on copies
+	beta.Spec.Integrations.Namespaces[0] = "changed"
+	assert.Equal(t, "integrations", alpha.Spec.IntegrationNamespaces[0])
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The v1beta1 spec holds the same configuration as the v1alpha1 one, with the settings that were spread over
+// the top level grouped in sections next to the components and the addons. The components, the addons and the
+// status keep their v1alpha1 shape. v1alpha1 stays the stored version, resources are converted by the operator.
+
+// SyndesisSpec defines the desired state of Syndesis
+type SyndesisSpec struct {
+	// Set to "dev" for a small footprint installation fitting into CodeReady Containers or minikube.
+	Profile v1alpha1.SyndesisProfile `json:"profile,omitempty"`
+
+	// What gets installed: full (default), or infrastructureOnly to provision the database, prometheus, oauth
+	// and secrets without syndesis-server, syndesis-meta and syndesis-ui, e.g. to restore data before bringing them up.
+	InstallMode v1alpha1.SyndesisInstallMode `json:"installMode,omitempty"`
+
+	// Where the images of the components come from
+	Images ImagesSpec `json:"images,omitempty"`
+
+	// Components is used to configure all the core components of Syndesis
+	Components v1alpha1.ComponentsSpec `json:"components,omitempty"`
+
+	// Optional add on features that can be enabled.
+	Addons v1alpha1.AddonsSpec `json:"addons,omitempty"`
+
+	// How syndesis is reached from outside of the cluster
+	Exposure ExposureSpec `json:"exposure,omitempty"`
+
+	// How integrations are run, and what they are provisioned with
+	Integrations IntegrationsSpec `json:"integrations,omitempty"`
+
+	// Day two operations of the installation: backups, checks, remediation and reporting
+	Operations OperationsSpec `json:"operations,omitempty"`
+
+	// Settings shared by the pods of all the components
+	Pods PodsSpec `json:"pods,omitempty"`
+
+	// Labels the operator keeps on the namespace syndesis is installed into, e.g. monitoring or pod security labels.
+	NamespaceManagement v1alpha1.NamespaceManagementConfiguration `json:"namespaceManagement,omitempty"`
+
+	// Enables the test support endpoints of syndesis-server, together with the access rules test runners need.
+	// Never enable it on a production installation.
+	TestSupport bool `json:"testSupport,omitempty"`
+
+	// Run the installation as the warm standby of a primary one in another namespace or cluster, for disaster recovery.
+	Standby v1alpha1.StandbyConfiguration `json:"standby,omitempty"`
+
+	// Encrypt the traffic between syndesis-server, syndesis-meta and the database.
+	InternalTLS v1alpha1.InternalTLSConfiguration `json:"internalTLS,omitempty"`
+}
+
+type ImagesSpec struct {
+	// Namespace of the image streams the components are deployed from
+	StreamNamespace string `json:"streamNamespace,omitempty"`
+	// Whether the components follow new releases of their images: pinned (default), track-minor or track-latest
+	Policy v1alpha1.ImagePolicyConfiguration `json:"policy,omitempty"`
+}
+
+type ExposureSpec struct {
+	// route (default), ingress, loadbalancer, nodeport or none
+	Type v1alpha1.SyndesisExposure `json:"type,omitempty"`
+
+	// Hostname syndesis is reachable at, required when not exposed with a route.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Additional hostnames syndesis is reachable at, e.g. a vanity DNS name in front of the route.
+	// They are accepted as CORS origins and OAuth redirect URIs.
+	AlternateHostnames []string `json:"alternateHostnames,omitempty"`
+
+	// How the alternate hostnames are served: none (default), proxy or redirect.
+	AlternateHostnamesMode v1alpha1.SyndesisAlternateHostnamesMode `json:"alternateHostnamesMode,omitempty"`
+
+	// Additional origins allowed by the server CORS configuration.
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+
+	// Labels and annotations of the routes syndesis is exposed with
+	Route v1alpha1.RouteConfiguration `json:"route,omitempty"`
+
+	// Expiry monitoring of the serving certificates of the route and the oauth proxy.
+	Certificates v1alpha1.CertificatesConfiguration `json:"certificates,omitempty"`
+
+	// Entry added to the OpenShift 4 web console application launcher.
+	ConsoleLink v1alpha1.ConsoleLinkConfiguration `json:"consoleLink,omitempty"`
+}
+
+type IntegrationsSpec struct {
+	// Namespaces integrations are built and deployed in besides the syndesis namespace.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// springboot or camelk, with Camel K if the addon is enabled when empty
+	Runtime v1alpha1.SyndesisIntegrationRuntime `json:"runtime,omitempty"`
+
+	// Integration controller of syndesis-server, the server defaults are kept for the settings left empty
+	Controller v1alpha1.IntegrationControllerConfiguration `json:"controller,omitempty"`
+
+	// Limits protecting shared clusters from runaway integrations
+	Quota v1alpha1.IntegrationQuota `json:"quota,omitempty"`
+
+	// Secrets the integrations need in the integration namespaces
+	SecretSync v1alpha1.SecretSyncConfiguration `json:"secretSync,omitempty"`
+
+	// Republication of the published integrations in batches
+	Republish v1alpha1.IntegrationRepublishConfiguration `json:"republish,omitempty"`
+
+	// Connectors teams may use, e.g. to forbid ftp or plain http in a managed environment.
+	Connectors v1alpha1.ConnectorsConfiguration `json:"connectors,omitempty"`
+
+	// Connections created once syndesis is installed
+	Connections []v1alpha1.ConnectionConfiguration `json:"connections,omitempty"`
+
+	// Exports of integrations imported once syndesis is installed, in order, each one once
+	Import []v1alpha1.IntegrationImportConfiguration `json:"import,omitempty"`
+}
+
+type OperationsSpec struct {
+	// How the database is backed up, by the backup command and before upgrades.
+	Backup v1alpha1.BackupConfiguration `json:"backup,omitempty"`
+
+	// Watchdog restarting components stuck in CrashLoopBackOff
+	Remediation v1alpha1.RemediationConfiguration `json:"remediation,omitempty"`
+
+	// Job exercising the installation once its deployments are ready, after installs and upgrades.
+	SmokeTest v1alpha1.SmokeTestConfiguration `json:"smokeTest,omitempty"`
+
+	// Time given to the components to start, on clusters running startup probes.
+	StartupProbe v1alpha1.StartupProbeConfiguration `json:"startupProbe,omitempty"`
+
+	// Probe the external dependencies from the operator before declaring syndesis ready.
+	ConnectivityChecks bool `json:"connectivityChecks,omitempty"`
+
+	// Time zone of the schedules of the scheduled operations, e.g. Europe/Paris. UTC when not set.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Log levels of syndesis-server and syndesis-meta.
+	Logging v1alpha1.LoggingConfiguration `json:"logging,omitempty"`
+
+	// Where the operator sends notifications of upgrades, backup failures and certificate expiry to.
+	Notifications v1alpha1.NotificationsConfiguration `json:"notifications,omitempty"`
+
+	// Opt-in reporting of anonymous usage data to the syndesis maintainers.
+	Telemetry v1alpha1.TelemetryConfiguration `json:"telemetry,omitempty"`
+}
+
+type PodsSpec struct {
+	// Entries added to the hosts file of the component pods.
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+
+	// DNS suffix of the cluster when it is not the one configured on the nodes.
+	DNSSuffix string `json:"dnsSuffix,omitempty"`
+}
+
+// =============================================================================
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Syndesis is the Schema for the syndeses API
+// +genclient
+// +resourceName=syndeses
+// +kubebuilder:subresource:status
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type Syndesis struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyndesisSpec            `json:"spec,omitempty"`
+	Status v1alpha1.SyndesisStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SyndesisList contains a list of Syndesis
+type SyndesisList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Syndesis `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Syndesis{}, &SyndesisList{})
+}
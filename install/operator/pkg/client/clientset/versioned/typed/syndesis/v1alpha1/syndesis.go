@@ -0,0 +1,175 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	scheme "github.com/syndesisio/syndesis/install/operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SyndesisesGetter has a method to return a SyndesisInterface.
+// A group's client should implement this interface.
+type SyndesisesGetter interface {
+	Syndesises(namespace string) SyndesisInterface
+}
+
+// SyndesisInterface has methods to work with Syndesis resources.
+type SyndesisInterface interface {
+	Create(*v1alpha1.Syndesis) (*v1alpha1.Syndesis, error)
+	Update(*v1alpha1.Syndesis) (*v1alpha1.Syndesis, error)
+	UpdateStatus(*v1alpha1.Syndesis) (*v1alpha1.Syndesis, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Syndesis, error)
+	List(opts v1.ListOptions) (*v1alpha1.SyndesisList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Syndesis, err error)
+	SyndesisExpansion
+}
+
+// syndesises implements SyndesisInterface
+type syndesises struct {
+	client rest.Interface
+	ns     string
+}
+
+// newSyndesises returns a Syndesises
+func newSyndesises(c *SyndesisV1alpha1Client, namespace string) *syndesises {
+	return &syndesises{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the syndesis, and returns the corresponding syndesis object, and an error if there is any.
+func (c *syndesises) Get(name string, options v1.GetOptions) (result *v1alpha1.Syndesis, err error) {
+	result = &v1alpha1.Syndesis{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("syndeses").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Syndesises that match those selectors.
+func (c *syndesises) List(opts v1.ListOptions) (result *v1alpha1.SyndesisList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.SyndesisList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("syndeses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested syndesises.
+func (c *syndesises) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("syndeses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a syndesis and creates it.  Returns the server's representation of the syndesis, and an error, if there is any.
+func (c *syndesises) Create(syndesis *v1alpha1.Syndesis) (result *v1alpha1.Syndesis, err error) {
+	result = &v1alpha1.Syndesis{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("syndeses").
+		Body(syndesis).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a syndesis and updates it. Returns the server's representation of the syndesis, and an error, if there is any.
+func (c *syndesises) Update(syndesis *v1alpha1.Syndesis) (result *v1alpha1.Syndesis, err error) {
+	result = &v1alpha1.Syndesis{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("syndeses").
+		Name(syndesis.Name).
+		Body(syndesis).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *syndesises) UpdateStatus(syndesis *v1alpha1.Syndesis) (result *v1alpha1.Syndesis, err error) {
+	result = &v1alpha1.Syndesis{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("syndeses").
+		Name(syndesis.Name).
+		SubResource("status").
+		Body(syndesis).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the syndesis and deletes it. Returns an error if one occurs.
+func (c *syndesises) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("syndeses").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *syndesises) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("syndeses").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched syndesis.
+func (c *syndesises) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Syndesis, err error) {
+	result = &v1alpha1.Syndesis{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("syndeses").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,148 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	scheme "github.com/syndesisio/syndesis/install/operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SyndesisOperatorConfigsGetter has a method to return a SyndesisOperatorConfigInterface.
+// A group's client should implement this interface.
+type SyndesisOperatorConfigsGetter interface {
+	SyndesisOperatorConfigs() SyndesisOperatorConfigInterface
+}
+
+// SyndesisOperatorConfigInterface has methods to work with SyndesisOperatorConfig resources.
+type SyndesisOperatorConfigInterface interface {
+	Create(*v1alpha1.SyndesisOperatorConfig) (*v1alpha1.SyndesisOperatorConfig, error)
+	Update(*v1alpha1.SyndesisOperatorConfig) (*v1alpha1.SyndesisOperatorConfig, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.SyndesisOperatorConfig, error)
+	List(opts v1.ListOptions) (*v1alpha1.SyndesisOperatorConfigList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.SyndesisOperatorConfig, err error)
+	SyndesisOperatorConfigExpansion
+}
+
+// syndesisOperatorConfigs implements SyndesisOperatorConfigInterface
+type syndesisOperatorConfigs struct {
+	client rest.Interface
+}
+
+// newSyndesisOperatorConfigs returns a SyndesisOperatorConfigs
+func newSyndesisOperatorConfigs(c *SyndesisV1alpha1Client) *syndesisOperatorConfigs {
+	return &syndesisOperatorConfigs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the syndesisOperatorConfig, and returns the corresponding syndesisOperatorConfig object, and an error if there is any.
+func (c *syndesisOperatorConfigs) Get(name string, options v1.GetOptions) (result *v1alpha1.SyndesisOperatorConfig, err error) {
+	result = &v1alpha1.SyndesisOperatorConfig{}
+	err = c.client.Get().
+		Resource("syndesisoperatorconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of SyndesisOperatorConfigs that match those selectors.
+func (c *syndesisOperatorConfigs) List(opts v1.ListOptions) (result *v1alpha1.SyndesisOperatorConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.SyndesisOperatorConfigList{}
+	err = c.client.Get().
+		Resource("syndesisoperatorconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested syndesisOperatorConfigs.
+func (c *syndesisOperatorConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("syndesisoperatorconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a syndesisOperatorConfig and creates it.  Returns the server's representation of the syndesisOperatorConfig, and an error, if there is any.
+func (c *syndesisOperatorConfigs) Create(syndesisOperatorConfig *v1alpha1.SyndesisOperatorConfig) (result *v1alpha1.SyndesisOperatorConfig, err error) {
+	result = &v1alpha1.SyndesisOperatorConfig{}
+	err = c.client.Post().
+		Resource("syndesisoperatorconfigs").
+		Body(syndesisOperatorConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a syndesisOperatorConfig and updates it. Returns the server's representation of the syndesisOperatorConfig, and an error, if there is any.
+func (c *syndesisOperatorConfigs) Update(syndesisOperatorConfig *v1alpha1.SyndesisOperatorConfig) (result *v1alpha1.SyndesisOperatorConfig, err error) {
+	result = &v1alpha1.SyndesisOperatorConfig{}
+	err = c.client.Put().
+		Resource("syndesisoperatorconfigs").
+		Name(syndesisOperatorConfig.Name).
+		Body(syndesisOperatorConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the syndesisOperatorConfig and deletes it. Returns an error if one occurs.
+func (c *syndesisOperatorConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("syndesisoperatorconfigs").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *syndesisOperatorConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("syndesisoperatorconfigs").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched syndesisOperatorConfig.
+func (c *syndesisOperatorConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.SyndesisOperatorConfig, err error) {
+	result = &v1alpha1.SyndesisOperatorConfig{}
+	err = c.client.Patch(pt).
+		Resource("syndesisoperatorconfigs").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
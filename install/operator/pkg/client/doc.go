@@ -0,0 +1,9 @@
+// Package client holds the clientset generated from the API types, for automation managing Syndesis custom
+// resources, and in helpers the status handling built upon it.
+//
+// The clientset is generated, from a GOPATH checkout, with:
+//
+//	client-gen --input-base github.com/syndesisio/syndesis/install/operator/pkg/apis --input syndesis/v1alpha1 \
+//	  --clientset-name versioned --output-package github.com/syndesisio/syndesis/install/operator/pkg/client/clientset \
+//	  --fake-clientset=false
+package client
@@ -0,0 +1,93 @@
+// Package helpers gives automation written against the Syndesis custom resources the status handling the
+// operator itself relies on: condition lookups, readiness, status updates and waiting for an installation.
+// It works with the generated clientset of pkg/client/clientset/versioned.
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/client/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// Phases an installation doesn't leave without a change of its spec or an intervention
+var failedPhases = map[v1alpha1.SyndesisPhase]bool{
+	v1alpha1.SyndesisPhaseStartupFailed:      true,
+	v1alpha1.SyndesisPhaseUpgradeFailed:      true,
+	v1alpha1.SyndesisPhaseUpgradeQuarantined: true,
+}
+
+// Condition of the given type, nil when the operator hasn't reported it yet
+func GetCondition(status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType) *v1alpha1.SyndesisCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func IsConditionTrue(status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType) bool {
+	condition := GetCondition(status, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+// Whether the installation is up, its deployments being ready and its smoke test, if any, passed
+func IsReady(syndesis *v1alpha1.Syndesis) bool {
+	return syndesis.Status.Phase == v1alpha1.SyndesisPhaseInstalled &&
+		IsConditionTrue(&syndesis.Status, v1alpha1.SyndesisConditionReady)
+}
+
+// Whether the installation is stuck in a failed phase
+func IsFailed(syndesis *v1alpha1.Syndesis) bool {
+	return failedPhases[syndesis.Status.Phase]
+}
+
+// Applies a change to the status of the latest version of a syndesis resource, again when it changed meanwhile
+func UpdateStatus(cs versioned.Interface, namespace string, name string, mutate func(status *v1alpha1.SyndesisStatus)) (*v1alpha1.Syndesis, error) {
+	var updated *v1alpha1.Syndesis
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		syndesis, err := cs.SyndesisV1alpha1().Syndesises(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		mutate(&syndesis.Status)
+		updated, err = cs.SyndesisV1alpha1().Syndesises(namespace).UpdateStatus(syndesis)
+		return err
+	})
+	return updated, err
+}
+
+// Merges the given fields into the status of a syndesis resource, e.g. map[string]interface{}{"forceUpgrade": true}
+func PatchStatus(cs versioned.Interface, namespace string, name string, status interface{}) (*v1alpha1.Syndesis, error) {
+	data, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return nil, err
+	}
+	return cs.SyndesisV1alpha1().Syndesises(namespace).Patch(name, types.MergePatchType, data, "status")
+}
+
+// Polls a syndesis resource until it is ready, failing as soon as it reaches a failed phase or the context is done
+func WaitForReady(ctx context.Context, cs versioned.Interface, namespace string, name string, interval time.Duration) (*v1alpha1.Syndesis, error) {
+	var syndesis *v1alpha1.Syndesis
+	err := wait.PollImmediateUntil(interval, func() (bool, error) {
+		var err error
+		syndesis, err = cs.SyndesisV1alpha1().Syndesises(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if IsFailed(syndesis) {
+			return false, fmt.Errorf("syndesis %s/%s is %s: %s", namespace, name, syndesis.Status.Phase, syndesis.Status.Description)
+		}
+		return IsReady(syndesis), nil
+	}, ctx.Done())
+	return syndesis, err
+}
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/client/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const syndesisPath = "/apis/syndesis.io/v1alpha1/namespaces/syndesis/syndeses/app"
+
+func TestIsReady(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{Status: v1alpha1.SyndesisStatus{Phase: v1alpha1.SyndesisPhaseInstalled}}
+	assert.False(t, IsReady(syndesis))
+
+	syndesis.Status.Conditions = []v1alpha1.SyndesisCondition{{Type: v1alpha1.SyndesisConditionReady, Status: corev1.ConditionTrue}}
+	assert.True(t, IsReady(syndesis))
+	assert.Equal(t, corev1.ConditionTrue, GetCondition(&syndesis.Status, v1alpha1.SyndesisConditionReady).Status)
+	assert.Nil(t, GetCondition(&syndesis.Status, v1alpha1.SyndesisConditionDegraded))
+
+	syndesis.Status.Phase = v1alpha1.SyndesisPhaseUpgrading
+	assert.False(t, IsReady(syndesis))
+	assert.False(t, IsFailed(syndesis))
+
+	syndesis.Status.Phase = v1alpha1.SyndesisPhaseUpgradeFailed
+	assert.True(t, IsFailed(syndesis))
+}
+
+func newClientset(t *testing.T, server *httptest.Server) versioned.Interface {
+	cs, err := versioned.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return cs
+}
+
+func writeSyndesis(w http.ResponseWriter, phase v1alpha1.SyndesisPhase, conditions ...v1alpha1.SyndesisCondition) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v1alpha1.Syndesis{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "syndesis.io/v1alpha1", Kind: "Syndesis"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+		Status:     v1alpha1.SyndesisStatus{Phase: phase, Conditions: conditions},
+	})
+}
+
+func TestWaitForReady(t *testing.T) {
+	gets := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, syndesisPath, r.URL.Path)
+		gets++
+		if gets < 3 {
+			writeSyndesis(w, v1alpha1.SyndesisPhaseStarting)
+			return
+		}
+		writeSyndesis(w, v1alpha1.SyndesisPhaseInstalled, v1alpha1.SyndesisCondition{Type: v1alpha1.SyndesisConditionReady, Status: corev1.ConditionTrue})
+	}))
+	defer server.Close()
+
+	syndesis, err := WaitForReady(context.TODO(), newClientset(t, server), "syndesis", "app", time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, IsReady(syndesis))
+	assert.Equal(t, 3, gets)
+
+	failed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeSyndesis(w, v1alpha1.SyndesisPhaseStartupFailed)
+	}))
+	defer failed.Close()
+	_, err = WaitForReady(context.TODO(), newClientset(t, failed), "syndesis", "app", time.Millisecond)
+	assert.EqualError(t, err, "syndesis syndesis/app is StartupFailed: ")
+}
+
+func TestPatchStatus(t *testing.T) {
+	var patch map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, syndesisPath+"/status", r.URL.Path)
+		assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &patch))
+		writeSyndesis(w, v1alpha1.SyndesisPhaseInstalled)
+	}))
+	defer server.Close()
+
+	_, err := PatchStatus(newClientset(t, server), "syndesis", "app", map[string]interface{}{"forceUpgrade": true})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"status": map[string]interface{}{"forceUpgrade": true}}, patch)
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/audit"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type Audit struct {
+	*internal.Options
+	since  time.Duration
+	kind   string
+	output string
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Audit{Options: parent}
+	cmd := cobra.Command{
+		Use:   "audit",
+		Short: "lists the resources the operator created, updated and deleted, as kept by its audit ConfigMap",
+		Run: func(cmd *cobra.Command, _ []string) {
+			util.ExitOnError(o.audit(cmd.OutOrStdout()))
+		},
+	}
+	cmd.Flags().DurationVar(&o.since, "since", 0, "only list the entries more recent than the duration, e.g. 24h")
+	cmd.Flags().StringVar(&o.kind, "kind", "", "only list the entries of resources of this kind, e.g. DeploymentConfig")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "text", "output format, one of: text, json")
+	return &cmd
+}
+
+func (o *Audit) audit(out io.Writer) error {
+	if o.output != "text" && o.output != "json" {
+		return errors.Errorf("unsupported output format %s, use one of: text, json", o.output)
+	}
+	cl, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(o.Context, types.NamespacedName{Namespace: o.Namespace, Name: audit.ConfigMapName}, cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.Errorf("no audit entries in namespace %s, the operator keeps them when run with --audit-configmap-entries", o.Namespace)
+		}
+		return err
+	}
+	return o.print(out, filter(audit.Entries(cm), o.kind, o.since, time.Now()))
+}
+
+// Entries of the given kind, when set, more recent than the given duration, when set
+func filter(entries []audit.Entry, kind string, since time.Duration, now time.Time) []audit.Entry {
+	filtered := []audit.Entry{}
+	for _, entry := range entries {
+		if kind != "" && !strings.EqualFold(entry.Kind, kind) {
+			continue
+		}
+		if since > 0 && entry.Time.Before(now.Add(-since)) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
+
+func (o *Audit) print(out io.Writer, entries []audit.Entry) error {
+	if o.output == "json" {
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tOPERATION\tRESOURCE\tREASON\tCHANGES")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s/%s\t%s\t%s\n", entry.Time.Format(time.RFC3339), entry.Operation, entry.Kind, entry.Name,
+			entry.Reason, strings.Join(entry.Changes, ", "))
+	}
+	return w.Flush()
+}
@@ -17,14 +17,20 @@
 package backup
 
 import (
+	"fmt"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/notification"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"io/ioutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
 	"os"
 	"path/filepath"
@@ -35,6 +41,7 @@ import (
 type Backup struct {
 	*internal.Options
 	backupDir string
+	scope     string
 }
 
 func New(parent *internal.Options) *cobra.Command {
@@ -47,17 +54,41 @@ func New(parent *internal.Options) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&o.backupDir, "backup", "backup", "The directory to store the back up in")
+	cmd.Flags().StringVar(&o.scope, "scope", ScopeFull, "What to back up: full, or config for only the syndesis resource, its secrets and oauth client without the database")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 	return &cmd
 }
 
 func (o *Backup) Run() error {
+	err := o.run()
+	if err != nil {
+		o.notifyFailure(err)
+	}
+	return err
+}
+
+func (o *Backup) run() error {
+	switch o.scope {
+	case ScopeFull:
+	case ScopeConfig:
+		return o.backupConfig()
+	default:
+		return fmt.Errorf("invalid backup scope %s, use one of: %s, %s", o.scope, ScopeFull, ScopeConfig)
+	}
+
 	os.MkdirAll(o.backupDir, 0755)
 	err := o.backupResources()
 	if err != nil {
 		return err
 	}
+	method, class, err := o.method()
+	if err != nil {
+		return err
+	}
+	if method == v1alpha1.SyndesisBackupMethodSnapshot {
+		return o.backupSnapshot(class)
+	}
 	err = o.backupDatabase()
 	if err != nil {
 		return err
@@ -65,6 +96,34 @@ func (o *Backup) Run() error {
 	return nil
 }
 
+// Notify the recipients configured in the syndesis resources of the namespace about the failed backup
+func (o *Backup) notifyFailure(cause error) {
+	c, err := o.GetClient()
+	if err != nil {
+		return
+	}
+	if err := apis.AddToScheme(scheme.Scheme); err != nil {
+		return
+	}
+
+	list := &v1alpha1.SyndesisList{}
+	if err := c.List(o.Context, &client.ListOptions{Namespace: o.Namespace}, list); err != nil {
+		return
+	}
+
+	for _, syndesis := range list.Items {
+		config := configuration.NotificationsConfiguration{
+			SmtpSecret: syndesis.Spec.Notifications.SmtpSecret,
+			Recipients: syndesis.Spec.Notifications.Recipients,
+			WebhookURL: syndesis.Spec.Notifications.WebhookURL,
+		}
+		event := notification.Event{Namespace: o.Namespace, Subject: "Backup failed", Message: cause.Error()}
+		if err := notification.Send(o.Context, c, config, event); err != nil {
+			fmt.Println("could not send backup failure notification:", err)
+		}
+	}
+}
+
 func (o *Backup) backupDatabase() error {
 	api, err := o.NewApiClient()
 	if err != nil {
@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Everything needed to recover the installation, including the database
+	ScopeFull = "full"
+	// Only the configuration plane, e.g. to clone an installation into a new environment
+	ScopeConfig = "config"
+
+	// Directory of the backup holding the configuration plane
+	ConfigDir = "config"
+)
+
+// Kinds of the configuration plane, in the order they are restored: the secrets and the oauth client
+// must exist when the operator installs the syndesis resource, so that it reuses them
+var ConfigKinds = []metav1.TypeMeta{
+	{APIVersion: "v1", Kind: "Secret"},
+	{APIVersion: "oauth.openshift.io/v1", Kind: "OAuthClient"},
+	{APIVersion: "syndesis.io/v1alpha1", Kind: "Syndesis"},
+}
+
+// Backs up the syndesis resources of the namespace, the secrets of the installation and
+// the OAuthClients they authenticate with, without any data
+func (o *Backup) backupConfig() error {
+	c, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(o.backupDir, ConfigDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	secretsSelector, err := labels.Parse("syndesis.io/app=syndesis,syndesis.io/type=infrastructure")
+	if err != nil {
+		return err
+	}
+	secrets, err := o.list(c, ConfigKinds[0], secretsSelector)
+	if err != nil {
+		return err
+	}
+	syndesises, err := o.list(c, ConfigKinds[2], labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	resources := append(secrets, syndesises...)
+	for _, syndesis := range syndesises {
+		name := oauthClientName(syndesis)
+		if name == "" {
+			continue
+		}
+		oauthClient := unstructured.Unstructured{}
+		oauthClient.SetAPIVersion(ConfigKinds[1].APIVersion)
+		oauthClient.SetKind(ConfigKinds[1].Kind)
+		if err := c.Get(o.Context, types.NamespacedName{Name: name}, &oauthClient); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		resources = append(resources, oauthClient)
+	}
+
+	for _, res := range resources {
+		Portable(&res)
+		data, err := yaml.Marshal(res.Object)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, res.GetKind()+"-"+res.GetName()+".yaml"), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Backup) list(c client.Client, typeMeta metav1.TypeMeta, selector labels.Selector) ([]unstructured.Unstructured, error) {
+	options := client.ListOptions{
+		Namespace:     o.Namespace,
+		LabelSelector: selector,
+		Raw:           &metav1.ListOptions{TypeMeta: typeMeta, Limit: 200},
+	}
+	list := unstructured.UnstructuredList{}
+	list.SetAPIVersion(typeMeta.APIVersion)
+	list.SetKind(typeMeta.Kind + "List")
+
+	resources := []unstructured.Unstructured{}
+	err := util.ListInChunks(o.Context, c, &options, &list, func(items []unstructured.Unstructured) error {
+		resources = append(resources, items...)
+		return nil
+	})
+	return resources, err
+}
+
+// Name of the OAuthClient a syndesis resource authenticates with, if any: the one provided by
+// the administrator, or the one managed by the operator
+func oauthClientName(syndesis unstructured.Unstructured) string {
+	if name, _, _ := unstructured.NestedString(syndesis.Object, "spec", "components", "oauth", "client", "name"); name != "" {
+		return name
+	}
+	if managed, _, _ := unstructured.NestedBool(syndesis.Object, "spec", "components", "oauth", "client", "managed"); managed {
+		return "syndesis-" + syndesis.GetNamespace()
+	}
+	return ""
+}
+
+// Removes what the API server set on a resource, so that it can be created in another namespace or cluster
+func Portable(res *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(res.Object, "status")
+	for _, field := range []string{"namespace", "uid", "resourceVersion", "selfLink", "creationTimestamp", "generation", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(res.Object, "metadata", field)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func syndesisWithOauthClient(client map[string]interface{}) unstructured.Unstructured {
+	res := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "syndesis.io/v1alpha1",
+		"kind":       "Syndesis",
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"namespace":       "syndesis",
+			"uid":             "0a2b",
+			"resourceVersion": "42",
+			"finalizers":      []interface{}{"syndesis.io/cleanup"},
+		},
+		"spec": map[string]interface{}{
+			"components": map[string]interface{}{
+				"oauth": map[string]interface{}{"client": client},
+			},
+		},
+		"status": map[string]interface{}{"phase": "Installed"},
+	}}
+	return res
+}
+
+func Test_oauthClientName(t *testing.T) {
+	assert.Equal(t, "", oauthClientName(syndesisWithOauthClient(map[string]interface{}{})))
+	assert.Equal(t, "syndesis-syndesis", oauthClientName(syndesisWithOauthClient(map[string]interface{}{"managed": true})))
+	assert.Equal(t, "shared", oauthClientName(syndesisWithOauthClient(map[string]interface{}{"name": "shared", "managed": true})))
+}
+
+func TestPortable(t *testing.T) {
+	res := syndesisWithOauthClient(map[string]interface{}{"managed": true})
+	Portable(&res)
+
+	assert.Equal(t, map[string]interface{}{"name": "app"}, res.Object["metadata"])
+	assert.NotContains(t, res.Object, "status")
+	assert.Contains(t, res.Object, "spec")
+}
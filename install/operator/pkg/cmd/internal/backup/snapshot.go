@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/snapshot"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// File of the backup naming the volume snapshot of the database, replacing the dump
+const SnapshotFile = "syndesis-db.snapshot"
+
+// Time given to the CSI driver to take the snapshot
+const snapshotTimeout = 10 * time.Minute
+
+// Backup method and snapshot class configured in the syndesis resource of the namespace, a dump when not configured
+func (o *Backup) method() (v1alpha1.SyndesisBackupMethod, string, error) {
+	c, err := o.GetClient()
+	if err != nil {
+		return "", "", err
+	}
+	syndesises, err := o.list(c, ConfigKinds[2], labels.Everything())
+	if err != nil {
+		return "", "", err
+	}
+	for _, syndesis := range syndesises {
+		if method, _, _ := unstructured.NestedString(syndesis.Object, "spec", "backup", "method"); method != "" {
+			class, _, _ := unstructured.NestedString(syndesis.Object, "spec", "backup", "snapshotClass")
+			return v1alpha1.SyndesisBackupMethod(method), class, nil
+		}
+	}
+	return v1alpha1.SyndesisBackupMethodDump, "", nil
+}
+
+// Snapshots the database volume claim and waits for the snapshot to be restorable, its name is kept in the backup
+func (o *Backup) backupSnapshot(class string) error {
+	c, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+
+	name := "syndesis-db-backup-" + time.Now().UTC().Format("20060102150405")
+	if _, err := snapshot.Create(o.Context, c, o.Namespace, name, snapshot.DatabaseClaim, class); err != nil {
+		return err
+	}
+	err = wait.PollImmediate(2*time.Second, snapshotTimeout, func() (bool, error) {
+		taken, err := snapshot.Get(o.Context, c, o.Namespace, name)
+		if err != nil {
+			return false, err
+		}
+		return snapshot.Ready(taken)
+	})
+	if err != nil {
+		return fmt.Errorf("volume snapshot %s of the database is not ready: %v", name, err)
+	}
+	return ioutil.WriteFile(filepath.Join(o.backupDir, SnapshotFile), []byte(name), 0644)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+// Directory of the assets holding the GrafanaDashboard resources of the ops addon
+const dashboardsDir = "./addons/ops/"
+
+// Datasource the bundled dashboards query, which becomes a variable of the exported dashboards
+const bundledDatasource = "Prometheus"
+
+type Dashboards struct {
+	*internal.Options
+	outputDir  string
+	datasource string
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Dashboards{Options: parent}
+	cmd := cobra.Command{
+		Use:   "dashboards",
+		Short: "exports the monitoring dashboards as Grafana JSON, for a Grafana managed outside of the cluster",
+		Run: func(_ *cobra.Command, _ []string) {
+			util.ExitOnError(o.export())
+		},
+	}
+	cmd.Flags().StringVar(&o.outputDir, "output", "dashboards", "The directory to write the dashboards to")
+	cmd.Flags().StringVar(&o.datasource, "datasource", bundledDatasource, "The Prometheus datasource the dashboards select by default")
+	return &cmd
+}
+
+func (o *Dashboards) export() error {
+	dashboards, err := Export(o.datasource)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(o.outputDir, 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(dashboards))
+	for name := range dashboards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(o.outputDir, name)
+		if err := ioutil.WriteFile(path, dashboards[name], 0644); err != nil {
+			return err
+		}
+		fmt.Println("dashboard exported to", path)
+	}
+	return nil
+}
+
+// Exports the dashboards of the ops addon as Grafana JSON, by file name. The datasource of the panels
+// and variables is replaced by a datasource variable, defaulting to the given datasource.
+func Export(datasource string) (map[string][]byte, error) {
+	dir, err := generator.GetAssetsFS().Open(dashboardsDir)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	files, err := dir.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboards := map[string][]byte{}
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), "-dashboard.yml") {
+			continue
+		}
+		data, err := generator.AssetAsBytes(dashboardsDir + file.Name())
+		if err != nil {
+			return nil, err
+		}
+		resource := struct {
+			Spec struct {
+				Name string `json:"name"`
+				JSON string `json:"json"`
+			} `json:"spec"`
+		}{}
+		if err := util.UnmarshalYaml(data, &resource); err != nil {
+			return nil, errors.Wrap(err, file.Name())
+		}
+
+		dashboard := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(resource.Spec.JSON), &dashboard); err != nil {
+			return nil, errors.Wrap(err, file.Name())
+		}
+		templateDatasource(dashboard, datasource)
+
+		exported, err := json.MarshalIndent(dashboard, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		dashboards[resource.Spec.Name] = exported
+	}
+	return dashboards, nil
+}
+
+// Makes the datasource of a dashboard a variable, so that it can be imported into any Grafana
+func templateDatasource(dashboard map[string]interface{}, datasource string) {
+	replaceDatasource(dashboard)
+
+	// The id is the one of the Grafana the dashboard was designed in, Grafana assigns a new one on import
+	dashboard["id"] = nil
+
+	templating, _ := dashboard["templating"].(map[string]interface{})
+	if templating == nil {
+		templating = map[string]interface{}{}
+		dashboard["templating"] = templating
+	}
+	variables, _ := templating["list"].([]interface{})
+	templating["list"] = append([]interface{}{map[string]interface{}{
+		"name":    "datasource",
+		"label":   "Data source",
+		"type":    "datasource",
+		"query":   "prometheus",
+		"current": map[string]interface{}{"text": datasource, "value": datasource},
+		"hide":    0,
+		"options": []interface{}{},
+		"refresh": 1,
+		"regex":   "",
+	}}, variables...)
+}
+
+func replaceDatasource(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "datasource" && child == bundledDatasource {
+				v[key] = "$datasource"
+				continue
+			}
+			replaceDatasource(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			replaceDatasource(child)
+		}
+	}
+}
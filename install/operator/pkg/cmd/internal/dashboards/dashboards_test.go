@@ -0,0 +1,46 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	dashboards, err := Export("Central Prometheus")
+	require.NoError(t, err)
+	require.Contains(t, dashboards, "syndesis-infra-api-dashboard.json")
+	assert.Contains(t, dashboards, "syndesis-integrations-home-dashboard.json")
+
+	for name, data := range dashboards {
+		assert.NotContains(t, string(data), `"datasource": "Prometheus"`, name)
+
+		dashboard := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(data, &dashboard), name)
+		assert.Nil(t, dashboard["id"], name)
+
+		variables := dashboard["templating"].(map[string]interface{})["list"].([]interface{})
+		datasource := variables[0].(map[string]interface{})
+		assert.Equal(t, "datasource", datasource["name"], name)
+		assert.Equal(t, "Central Prometheus", datasource["current"].(map[string]interface{})["value"], name)
+	}
+	assert.Contains(t, string(dashboards["syndesis-infra-api-dashboard.json"]), `"datasource": "$datasource"`)
+}
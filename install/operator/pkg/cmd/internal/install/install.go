@@ -29,8 +29,10 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/compatibility"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/yaml"
 )
@@ -40,16 +42,24 @@ const RoleName = "syndesis-operator"
 type Install struct {
 	// cli parsed config
 	*internal.Options
-	wait           bool
-	eject          string
-	image          string
-	tag            string
-	addons         string
-	customResource string
-	devSupport     bool
+	wait            bool
+	eject           string
+	image           string
+	tag             string
+	replicas        int
+	addons          string
+	customResource  string
+	devSupport      bool
+	createNamespace bool
+	namespaceLabels string
+	resolveSecrets  bool
+	sealKey         string
+
+	checkIdempotency bool
 
 	// processing state
 	ejectedResources []unstructured.Unstructured
+	clusterChecked   bool
 }
 
 func New(parent *internal.Options) *cobra.Command {
@@ -65,6 +75,8 @@ func New(parent *internal.Options) *cobra.Command {
 
 			err := o.installClusterResources()
 			util.ExitOnError(err)
+			err = o.installNamespace()
+			util.ExitOnError(err)
 			err = o.installOperatorResources()
 			util.ExitOnError(err)
 			err = o.installApplication()
@@ -85,7 +97,9 @@ func New(parent *internal.Options) *cobra.Command {
 		Use:   "operator",
 		Short: "install the operator resources (requires namespace admin privileges)",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := o.installOperatorResources()
+			err := o.installNamespace()
+			util.ExitOnError(err)
+			err = o.installOperatorResources()
 			util.ExitOnError(err)
 		},
 	})
@@ -113,11 +127,28 @@ func New(parent *internal.Options) *cobra.Command {
 	forge.PersistentFlags().StringVarP(&o.addons, "addons", "", "", "a coma separated list of addons that should be enabled")
 	cmd.AddCommand(forge)
 
+	remote := &cobra.Command{
+		Use:   "remote",
+		Short: "render the complete syndesis installation, for applying it on a cluster the operator cannot run on",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := o.installRemote()
+			util.ExitOnError(err)
+		},
+	}
+	remote.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
+	remote.PersistentFlags().BoolVarP(&o.resolveSecrets, "resolve-secrets", "", false, "reuse the passwords and keys of the installation in the namespace of the current cluster")
+	remote.PersistentFlags().BoolVarP(&o.checkIdempotency, "check-idempotency", "", false, "render the resources twice and fail when both renderings differ, before relying on the manifest for GitOps diffs")
+	remote.PersistentFlags().StringVarP(&o.sealKey, "seal-key", "", "", "certificate or public key of the target cluster sealed secrets controller, secrets are rendered as sealed secrets")
+	cmd.AddCommand(remote)
+
 	cmd.PersistentFlags().StringVarP(&o.eject, "eject", "e", "", "eject configuration that would be applied to the cluster in the specified format instead of installing the configuration. One of: json|yaml")
 	cmd.PersistentFlags().StringVarP(&o.image, "image", "", pkg.DefaultOperatorImage, "sets operator image that gets installed")
 	cmd.PersistentFlags().StringVarP(&o.tag, "tag", "", pkg.DefaultOperatorTag, "sets operator tag that gets installed")
+	cmd.PersistentFlags().IntVarP(&o.replicas, "operator-replicas", "", 1, "sets the number of operator replicas, one being active while the others stand by")
 	cmd.PersistentFlags().BoolVarP(&o.wait, "wait", "w", false, "waits for the application to be running")
 	cmd.PersistentFlags().BoolVarP(&o.devSupport, "dev", "", false, "enable development mode by loading images from image stream tags.")
+	cmd.PersistentFlags().BoolVarP(&o.createNamespace, "create-namespace", "", false, "create the namespace syndesis is installed into when missing")
+	cmd.PersistentFlags().StringVarP(&o.namespaceLabels, "namespace-labels", "", "", "a comma separated list of name=value labels set on the created namespace, e.g. openshift.io/cluster-monitoring=true")
 	cmd.PersistentFlags().StringVarP(&o.customResource, "custom-resource", "", "", "path to a custom resource file to use when deploying (only used with install standalone)")
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 	return &cmd
@@ -136,6 +167,16 @@ func (o *Install) before(_ *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("unexpected argument: %s", args[0])
 	}
 
+	if _, err := labels.ConvertSelectorToLabelsMap(o.namespaceLabels); err != nil {
+		return fmt.Errorf("invalid namespace labels: %v", err)
+	}
+	if o.namespaceLabels != "" && !o.createNamespace {
+		return errors.New("namespace labels can only be set together with --create-namespace")
+	}
+	if o.replicas < 1 {
+		return fmt.Errorf("invalid number of operator replicas: %d", o.replicas)
+	}
+
 	if o.eject != "" {
 		o.ejectedResources = []unstructured.Unstructured{}
 	}
@@ -179,13 +220,36 @@ func (o *Install) Println(a ...interface{}) (int, error) {
 }
 
 type RenderScope struct {
-	Image         string
-	Tag           string
-	Namespace     string
-	DevSupport    bool
-	Role          string
-	Kind          string
-	EnabledAddons []string
+	Image           string
+	Tag             string
+	Replicas        int
+	Namespace       string
+	NamespaceLabels map[string]string
+	DevSupport      bool
+	Role            string
+	Kind            string
+	EnabledAddons   []string
+}
+
+// Refuses to install on a cluster the operator doesn't support, the operator checking again
+// the features enabled in the custom resource before installing syndesis
+func (o *Install) checkCluster() error {
+	if o.clusterChecked || o.ejectedResources != nil {
+		return nil
+	}
+	api, err := o.NewApiClient()
+	if err != nil {
+		return err
+	}
+	cluster, err := compatibility.Discover(api.Discovery())
+	if err != nil {
+		return err
+	}
+	if problems := compatibility.Check(cluster, nil); len(problems) > 0 {
+		return errors.Errorf("the cluster is not supported:\n  %s", strings.Join(problems, "\n  "))
+	}
+	o.clusterChecked = true
+	return nil
 }
 
 func (o *Install) install(action string, resources []unstructured.Unstructured) error {
@@ -234,14 +298,21 @@ func (o *Install) render(fromFile string) ([]unstructured.Unstructured, error) {
 		addons = strings.Split(o.addons, ",")
 	}
 
+	namespaceLabels, err := labels.ConvertSelectorToLabelsMap(o.namespaceLabels)
+	if err != nil {
+		return nil, err
+	}
+
 	resources, err := generator.Render(fromFile, RenderScope{
-		Namespace:     o.Namespace,
-		Image:         o.image,
-		Tag:           o.tag,
-		DevSupport:    o.devSupport,
-		Role:          RoleName,
-		Kind:          "Role",
-		EnabledAddons: addons,
+		Namespace:       o.Namespace,
+		NamespaceLabels: namespaceLabels,
+		Image:           o.image,
+		Tag:             o.tag,
+		Replicas:        o.replicas,
+		DevSupport:      o.devSupport,
+		Role:            RoleName,
+		Kind:            "Role",
+		EnabledAddons:   addons,
 	})
 	return resources, err
 }
@@ -11,6 +11,10 @@ import (
 )
 
 func (o *Install) installApplication() error {
+	if err := o.checkCluster(); err != nil {
+		return err
+	}
+
 	resources, err := o.render("./install/app.yml.tmpl")
 	if err != nil {
 		return err
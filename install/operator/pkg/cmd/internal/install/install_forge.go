@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/component"
 	conf "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 
@@ -215,30 +216,13 @@ func (o *Install) installForge() error {
 		addonArr = strings.Split(o.addons, ",")
 	}
 
-	addons := map[string]*bool{
-		"jaeger":  &configuration.Syndesis.Addons.Jaeger.Enabled,
-		"ops":     &configuration.Syndesis.Addons.Ops.Enabled,
-		"dv":      &configuration.Syndesis.Addons.DV.Enabled,
-		"camelk":  &configuration.Syndesis.Addons.CamelK.Enabled,
-		"knative": &configuration.Syndesis.Addons.Knative.Enabled,
-		"todo":    &configuration.Syndesis.Addons.Todo.Enabled,
-	}
-
 	for _, addon := range addonArr {
-		if *addons[addon] != true {
-			t := true
-			addons[addon] = &t
-		}
-
-		addonDir := "./addons/" + addon + "/"
-		f, err := generator.GetAssetsFS().Open(addonDir)
-		if err != nil {
-			fmt.Printf("unsupported addon configured %s: %s", "addon", addon)
-			return err
+		c, found := component.Addon(addon)
+		if !found {
+			return fmt.Errorf("unsupported addon configured: %s", addon)
 		}
-		f.Close()
 
-		addonResources, err := generator.RenderDir(addonDir, configuration)
+		addonResources, err := c.Render(configuration)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,20 @@
+package install
+
+// Creates the target namespace, with the labels monitoring or pod security admission
+// expect, so that bootstrap flows don't have to do it beforehand
+func (o *Install) installNamespace() error {
+	if !o.createNamespace {
+		return nil
+	}
+
+	resources, err := o.render("./install/namespace.yml.tmpl")
+	if err != nil {
+		return err
+	}
+
+	if o.ejectedResources != nil {
+		o.ejectedResources = append(o.ejectedResources, resources...)
+		return nil
+	}
+	return o.install("namespace was", resources)
+}
@@ -1,11 +1,20 @@
 package install
 
 import (
-	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (o *Install) installOperatorResources() error {
+	if err := o.checkCluster(); err != nil {
+		return err
+	}
+
 	resources, err := o.render("./install/role.yml.tmpl")
 	if err != nil {
 		return err
@@ -39,5 +48,87 @@ func (o *Install) installOperatorResources() error {
 		}
 	}
 
+	return o.installWebhook()
+}
+
+// Registers the admission and conversion webhooks of the operator, which needs cluster admin rights. Without them,
+// invalid syndesis resources are still reported by the operator when reconciling them, and defaults still applied,
+// but the v1beta1 version of the syndesis resources is not served.
+func (o *Install) installWebhook() error {
+	resources, err := o.render("./install/webhook.yml.tmpl")
+	if err != nil {
+		return err
+	}
+
+	if o.ejectedResources != nil {
+		o.ejectedResources = append(o.ejectedResources, resources...)
+		return nil
+	}
+	err = util.RunAsMinishiftAdminIfPossible(o.GetClientConfig(), func() error {
+		if err := o.install("admission webhooks were", resources); err != nil {
+			return err
+		}
+		return o.installConversionWebhook()
+	})
+	if err != nil && k8serrors.IsForbidden(errors.Cause(err)) {
+		o.Println("current user is not authorized to register the admission webhooks, syndesis resources are only validated and defaulted by the operator")
+		return nil
+	}
 	return err
 }
+
+// Serves the v1beta1 version of the syndesis resources, converted by the operator. The custom resource definition
+// is shared by the cluster, so the operator installed last converts the resources of all the namespaces.
+func (o *Install) installConversionWebhook() error {
+	c, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
+	crd.SetKind("CustomResourceDefinition")
+	if err := c.Get(o.Context, client.ObjectKey{Name: "syndesises.syndesis.io"}, crd); err != nil {
+		if k8serrors.IsNotFound(err) {
+			o.Println("syndesis custom resource definition not found, the conversion webhook was not registered")
+			return nil
+		}
+		return err
+	}
+
+	if err := setConversionWebhook(crd, o.Namespace); err != nil {
+		return err
+	}
+	if err := c.Update(o.Context, crd); err != nil {
+		return err
+	}
+	o.Println("conversion webhook was registered successfully")
+	return nil
+}
+
+// Points the conversions of the syndesis custom resource definition to the operator of the namespace, v1alpha1
+// staying the stored version. The serving certificate authority is injected by OpenShift.
+func setConversionWebhook(crd *unstructured.Unstructured, namespace string) error {
+	annotations := crd.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations["service.beta.openshift.io/inject-cabundle"] = "true"
+	crd.SetAnnotations(annotations)
+
+	versions := []interface{}{
+		map[string]interface{}{"name": "v1alpha1", "served": true, "storage": true},
+		map[string]interface{}{"name": "v1beta1", "served": true, "storage": false},
+	}
+	if err := unstructured.SetNestedSlice(crd.Object, versions, "spec", "versions"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(crd.Object, "Webhook", "spec", "conversion", "strategy"); err != nil {
+		return err
+	}
+	// The injected caBundle is kept
+	return unstructured.SetNestedMap(crd.Object, map[string]interface{}{
+		"namespace": namespace,
+		"name":      "syndesis-operator-webhook",
+		"path":      "/convert-syndesis",
+	}, "spec", "conversion", "webhookClientConfig", "service")
+}
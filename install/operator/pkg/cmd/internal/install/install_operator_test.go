@@ -22,8 +22,12 @@ import (
 
 	v12 "github.com/openshift/api/image/v1"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	v1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -86,3 +90,39 @@ func TestInstallOperator(t *testing.T) {
 		}
 	}
 }
+
+func TestSetConversionWebhook(t *testing.T) {
+	resources, err := generator.Render("./install/cluster.yml", nil)
+	require.NoError(t, err)
+	var crd *unstructured.Unstructured
+	for i := range resources {
+		if resources[i].GetName() == "syndesises.syndesis.io" {
+			crd = &resources[i]
+		}
+	}
+	require.NotNil(t, crd)
+	require.NoError(t, unstructured.SetNestedField(crd.Object, "Q0E=", "spec", "conversion", "webhookClientConfig", "caBundle"))
+
+	require.NoError(t, setConversionWebhook(crd, ns))
+
+	assert.Equal(t, "true", crd.GetAnnotations()["service.beta.openshift.io/inject-cabundle"])
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "v1alpha1", "served": true, "storage": true},
+		map[string]interface{}{"name": "v1beta1", "served": true, "storage": false},
+	}, versions)
+	conversion, _, err := unstructured.NestedMap(crd.Object, "spec", "conversion")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"strategy": "Webhook",
+		"webhookClientConfig": map[string]interface{}{
+			"caBundle": "Q0E=",
+			"service": map[string]interface{}{
+				"namespace": ns,
+				"name":      "syndesis-operator-webhook",
+				"path":      "/convert-syndesis",
+			},
+		},
+	}, conversion)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package install
+
+import (
+	"io/ioutil"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/component"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Renders the resources the operator would install, for applying them on a cluster the operator
+// cannot run on, like a disaster recovery standby. With --resolve-secrets, the passwords and keys
+// of the installation in the current namespace are reused, so that the standby can take over its
+// data. With --seal-key, secrets are rendered as SealedSecrets and can be stored safely. With
+// --check-idempotency, the resources are rendered twice and any difference between both fails the command.
+func (o *Install) installRemote() error {
+	syndesis, err := o.loadCustomResource()
+	if err != nil {
+		return err
+	}
+
+	var cl client.Client
+	if o.resolveSecrets {
+		if cl, err = o.GetClient(); err != nil {
+			return err
+		}
+	}
+
+	config, err := configuration.GetProperties(configuration.TemplateConfig, o.Context, cl, syndesis)
+	if err != nil {
+		return err
+	}
+
+	render := func() ([]unstructured.Unstructured, error) {
+		return o.renderRemote(config)
+	}
+	if o.checkIdempotency {
+		if err := generator.CheckIdempotent(render); err != nil {
+			return err
+		}
+	}
+	resources, err := render()
+	if err != nil {
+		return err
+	}
+
+	if o.sealKey != "" {
+		data, err := ioutil.ReadFile(o.sealKey)
+		if err != nil {
+			return err
+		}
+		key, err := util.ParseSealingKey(data)
+		if err != nil {
+			return err
+		}
+		for i, res := range resources {
+			if res.GetKind() != "Secret" {
+				continue
+			}
+			if resources[i], err = util.SealSecret(key, res); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.eject == "" {
+		o.eject = "yaml"
+	}
+	o.ejectedResources = resources
+	return nil
+}
+
+// Renders the enabled components and the resources exposing syndesis, in the order of generator.SortResources
+func (o *Install) renderRemote(config *configuration.Config) ([]unstructured.Unstructured, error) {
+	resources, err := component.RenderEnabled(config)
+	if err != nil {
+		return nil, err
+	}
+
+	exposureDir := "./exposure/" + config.Syndesis.Exposure + "/"
+	if config.ExposedWithRoute() {
+		exposureDir = "./route/"
+	}
+	if f, err := generator.GetAssetsFS().Open(exposureDir); err == nil {
+		f.Close()
+		exposure, err := generator.RenderDir(exposureDir, config)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, exposure...)
+	}
+
+	for i := range resources {
+		resources[i].SetNamespace(o.Namespace)
+	}
+	generator.SortResources(resources)
+	return resources, nil
+}
+
+// Reads the custom resource given with --custom-resource, an empty one otherwise
+func (o *Install) loadCustomResource() (*v1alpha1.Syndesis, error) {
+	syndesis := &v1alpha1.Syndesis{}
+	if o.customResource != "" {
+		data, err := ioutil.ReadFile(o.customResource)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(data, syndesis); err != nil {
+			return nil, err
+		}
+	}
+	syndesis.Namespace = o.Namespace
+	return syndesis, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package install
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func TestInstallRemote(t *testing.T) {
+	configuration.TemplateConfig = "../../../../build/conf/config-test.yaml"
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keyFile, err := ioutil.TempFile("", "seal-key")
+	require.NoError(t, err)
+	defer os.Remove(keyFile.Name())
+	require.NoError(t, pem.Encode(keyFile, &pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, keyFile.Close())
+
+	o := Install{
+		Options: &internal.Options{
+			Context:   context.TODO(),
+			Namespace: "standby",
+		},
+		sealKey:          keyFile.Name(),
+		checkIdempotency: true,
+	}
+	require.NoError(t, o.installRemote())
+	assert.Equal(t, "yaml", o.eject)
+	require.NotEmpty(t, o.ejectedResources)
+
+	// Secrets and the service accounts using them come first
+	assert.Equal(t, "ServiceAccount", o.ejectedResources[0].GetKind())
+
+	sealed := 0
+	for _, res := range o.ejectedResources {
+		assert.Equal(t, "standby", res.GetNamespace())
+		assert.NotEqual(t, "Secret", res.GetKind())
+		if res.GetKind() == "SealedSecret" {
+			sealed++
+		}
+	}
+	assert.NotZero(t, sealed)
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Kinds of the resources created by the OpenShift templates, adopted by the custom resource
+var legacyTypes = []metav1.TypeMeta{
+	{APIVersion: "v1", Kind: "ConfigMap"},
+	{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+	{APIVersion: "v1", Kind: "Secret"},
+	{APIVersion: "v1", Kind: "Service"},
+	{APIVersion: "v1", Kind: "ServiceAccount"},
+	{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+	{APIVersion: "image.openshift.io/v1", Kind: "ImageStream"},
+	{APIVersion: "build.openshift.io/v1", Kind: "BuildConfig"},
+	{APIVersion: "apps.openshift.io/v1", Kind: "DeploymentConfig"},
+	{APIVersion: "route.openshift.io/v1", Kind: "Route"},
+}
+
+type Migrate struct {
+	*internal.Options
+	name   string
+	dryRun bool
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Migrate{Options: parent}
+	cmd := cobra.Command{
+		Use:   "migrate-from-template",
+		Short: "hand over a syndesis installed with the OpenShift templates to the operator, which has to be installed in the namespace",
+		Run: func(_ *cobra.Command, _ []string) {
+			util.ExitOnError(o.migrate())
+		},
+	}
+	cmd.Flags().StringVar(&o.name, "name", "app", "name of the syndesis custom resource created")
+	cmd.Flags().BoolVar(&o.dryRun, "dry-run", false, "print the custom resource that would be created, without changing anything")
+	return &cmd
+}
+
+func (o *Migrate) migrate() error {
+	if err := apis.AddToScheme(scheme.Scheme); err != nil {
+		return err
+	}
+	c, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+
+	list := &v1alpha1.SyndesisList{}
+	if err := c.List(o.Context, &client.ListOptions{Namespace: o.Namespace}, list); err != nil {
+		return err
+	}
+	if len(list.Items) > 0 {
+		return fmt.Errorf("namespace %s is already managed by the syndesis resource %s", o.Namespace, list.Items[0].Name)
+	}
+	if !o.exists(c, "apps.openshift.io/v1", "DeploymentConfig", "syndesis-server") {
+		return fmt.Errorf("no syndesis installed with the OpenShift templates found in namespace %s", o.Namespace)
+	}
+
+	syndesis, err := o.customResource(c)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		data, err := yaml.Marshal(syndesis)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := c.Create(o.Context, syndesis); err != nil {
+		return err
+	}
+	// The kind is needed for the owner references, it isn't filled in by the client
+	syndesis.Kind = "Syndesis"
+	syndesis.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	fmt.Println("syndesis resource created", syndesis.Name, "namespace", o.Namespace)
+
+	return o.adopt(c, syndesis)
+}
+
+// Builds the custom resource matching the parameters the templates were processed with. The
+// database keeps its volume, user and name, its password is read from syndesis-global-config.
+func (o *Migrate) customResource(c client.Client) (*v1alpha1.Syndesis, error) {
+	params, err := configuration.GetSyndesisEnvVarsFromOpenShiftNamespace(o.Context, c, o.Namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the parameters of the installation")
+	}
+
+	syndesis := &v1alpha1.Syndesis{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Syndesis",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.name,
+			Namespace: o.Namespace,
+		},
+	}
+	spec := &syndesis.Spec
+	spec.ImageStreamNamespace = params["IMAGE_STREAM_NAMESPACE"]
+	spec.TestSupport = params["TEST_SUPPORT_ENABLED"] == "true"
+
+	components := &spec.Components
+	components.Database.User = params["POSTGRESQL_USER"]
+	components.Database.Name = params["POSTGRESQL_DATABASE"]
+	components.Database.Resources.MemoryLimit = params["POSTGRESQL_MEMORY_LIMIT"]
+	components.Database.Resources.VolumeCapacity = params["POSTGRESQL_VOLUME_CAPACITY"]
+	components.Server.Resources.MemoryLimit = params["SERVER_MEMORY_LIMIT"]
+	components.Meta.Resources.MemoryLimit = params["META_MEMORY_LIMIT"]
+	components.Meta.Resources.VolumeCapacity = params["META_VOLUME_CAPACITY"]
+	components.Prometheus.Resources.MemoryLimit = params["PROMETHEUS_MEMORY_LIMIT"]
+	components.Prometheus.Resources.VolumeCapacity = params["PROMETHEUS_VOLUME_CAPACITY"]
+
+	// A volume can't shrink, keep the size the database volume actually has
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(o.Context, util.NewObjectKey("syndesis-db", o.Namespace), pvc); err == nil {
+		if capacity, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+			components.Database.Resources.VolumeCapacity = capacity.String()
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// Addons installed together with the templates
+	spec.Addons.Todo.Enabled = o.exists(c, "apps.openshift.io/v1", "DeploymentConfig", "todo")
+	spec.Addons.DV.Enabled = o.exists(c, "apps.openshift.io/v1", "DeploymentConfig", "syndesis-dv")
+
+	return syndesis, nil
+}
+
+// Makes the custom resource the owner of the resources created by the templates, so that the
+// operator takes them over instead of creating new ones.
+func (o *Migrate) adopt(c client.Client, syndesis *v1alpha1.Syndesis) error {
+	selector, err := labels.Parse("syndesis.io/app=syndesis")
+	if err != nil {
+		return err
+	}
+
+	for _, typeMeta := range legacyTypes {
+		options := client.ListOptions{
+			Namespace:     o.Namespace,
+			LabelSelector: selector,
+			Raw: &metav1.ListOptions{
+				TypeMeta: typeMeta,
+				Limit:    200,
+			},
+		}
+		list := unstructured.UnstructuredList{
+			Object: map[string]interface{}{
+				"apiVersion": typeMeta.APIVersion,
+				"kind":       typeMeta.Kind,
+			},
+		}
+		err := util.ListInChunks(o.Context, c, &options, &list, func(resources []unstructured.Unstructured) error {
+			for _, res := range resources {
+				if len(res.GetOwnerReferences()) > 0 {
+					continue
+				}
+				operation.SetNamespaceAndOwnerReference(&res, syndesis)
+				if err := c.Update(o.Context, &res); err != nil {
+					return err
+				}
+				fmt.Println("resource adopted", typeMeta.Kind, res.GetName())
+			}
+			return nil
+		})
+		if err != nil && !util.IsNoKindMatchError(err) && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Migrate) exists(c client.Client, apiVersion string, kind string, name string) bool {
+	res := &unstructured.Unstructured{}
+	res.SetAPIVersion(apiVersion)
+	res.SetKind(kind)
+	return c.Get(o.Context, util.NewObjectKey(name, o.Namespace), res) == nil
+}
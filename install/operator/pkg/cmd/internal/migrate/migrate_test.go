@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMigrate(t *testing.T) {
+	require.NoError(t, apis.AddToScheme(scheme.Scheme))
+
+	labels := map[string]string{"app": "syndesis", "syndesis.io/app": "syndesis"}
+	server := &unstructured.Unstructured{}
+	server.SetAPIVersion("apps.openshift.io/v1")
+	server.SetKind("DeploymentConfig")
+	server.SetName("syndesis-server")
+	server.SetNamespace("syndesis")
+	server.SetLabels(labels)
+
+	cl := fake.NewFakeClient(
+		server,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "syndesis-global-config", Namespace: "syndesis", Labels: labels},
+			Data: map[string][]byte{
+				"params": []byte("POSTGRESQL_USER=syndesis\nPOSTGRESQL_DATABASE=syndesis\nPOSTGRESQL_VOLUME_CAPACITY=1Gi\nSERVER_MEMORY_LIMIT=800Mi\n"),
+			},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "syndesis-db", Namespace: "syndesis", Labels: labels},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+				},
+			},
+		},
+	)
+	// The fake client cannot list unstructured resources, adoption isn't covered here
+	legacyTypes = nil
+	o := Migrate{
+		Options: &internal.Options{Context: context.TODO(), Namespace: "syndesis", Client: &cl},
+		name:    "app",
+	}
+	require.NoError(t, o.migrate())
+
+	syndesis := &v1alpha1.Syndesis{}
+	require.NoError(t, cl.Get(o.Context, util.NewObjectKey("app", "syndesis"), syndesis))
+	assert.Equal(t, "syndesis", syndesis.Spec.Components.Database.User)
+	assert.Equal(t, "5Gi", syndesis.Spec.Components.Database.Resources.VolumeCapacity)
+	assert.Equal(t, "800Mi", syndesis.Spec.Components.Server.Resources.MemoryLimit)
+	assert.False(t, syndesis.Spec.Addons.Todo.Enabled)
+
+	// Once migrated the namespace is managed by the operator
+	assert.Error(t, o.migrate())
+}
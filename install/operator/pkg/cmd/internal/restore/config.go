@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// Restores the configuration plane of a backup into the namespace, which may be another one than the
+// backed up namespace. The secrets and the oauth client are restored before the syndesis resource,
+// so that the operator installs syndesis with them.
+func (o *Backup) restoreConfig() error {
+	c, err := o.GetClient()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(o.backupDir, backup.ConfigDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	resources := map[string][]unstructured.Unstructured{}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".yaml" {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return err
+		}
+		res := unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &res.Object); err != nil {
+			return fmt.Errorf("cannot read %s: %v", file.Name(), err)
+		}
+		resources[res.GetKind()] = append(resources[res.GetKind()], res)
+	}
+
+	for _, kind := range backup.ConfigKinds {
+		for _, res := range resources[kind.Kind] {
+			backup.Portable(&res)
+			if kind.Kind == "OAuthClient" {
+				// Cluster scoped and possibly shared by other installations, so never overwritten
+				if err := c.Create(o.Context, &res); err != nil {
+					if !k8serrors.IsAlreadyExists(err) {
+						return err
+					}
+					fmt.Printf("oauth client %s already exists, skipping it\n", res.GetName())
+				}
+				continue
+			}
+
+			res.SetNamespace(o.Namespace)
+			if _, _, err := util.CreateOrUpdate(o.Context, c, &res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -17,9 +17,12 @@
 package restore
 
 import (
+	"fmt"
+
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/spf13/cobra"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/backup"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/client-go/tools/remotecommand"
 	"os"
@@ -29,6 +32,7 @@ import (
 type Backup struct {
 	*internal.Options
 	backupDir string
+	scope     string
 }
 
 func New(parent *internal.Options) *cobra.Command {
@@ -41,12 +45,26 @@ func New(parent *internal.Options) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&o.backupDir, "backup", "/tmp/backup", "The directory where the backup is stored")
+	cmd.Flags().StringVar(&o.scope, "scope", backup.ScopeFull, "What to restore: full, or config for only the syndesis resource, its secrets and oauth client")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 	return &cmd
 }
 
 func (o *Backup) Run() error {
+	switch o.scope {
+	case backup.ScopeFull:
+	case backup.ScopeConfig:
+		return o.restoreConfig()
+	default:
+		return fmt.Errorf("invalid restore scope %s, use one of: %s, %s", o.scope, backup.ScopeFull, backup.ScopeConfig)
+	}
+
+	// Backups taken with volume snapshots name their snapshot instead of holding a dump
+	if file := filepath.Join(o.backupDir, backup.SnapshotFile); fileExists(file) {
+		return o.restoreSnapshot(file)
+	}
+
 	api, err := o.NewApiClient()
 	if err != nil {
 		return err
@@ -88,3 +106,8 @@ rm /var/lib/pgsql/data/syndesis.dmp;
 	})
 
 }
+
+func fileExists(file string) bool {
+	_, err := os.Stat(file)
+	return err == nil
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/snapshot"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Time given to the database pod to release the volume claim being replaced
+const claimReleaseTimeout = 5 * time.Minute
+
+// Replaces the database volume claim by a claim restoring the snapshot: the claim is deleted, the database pod
+// stopped so that the claim gets released, and the claim created again from the snapshot. The database pod
+// started again by its deployment config waits for the new claim. The operator should be scaled down meanwhile,
+// as it would otherwise create an empty claim.
+func (o *Backup) restoreSnapshot(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(string(data))
+
+	api, err := o.NewApiClient()
+	if err != nil {
+		return err
+	}
+	claims := api.CoreV1().PersistentVolumeClaims(o.Namespace)
+	source, err := claims.Get(snapshot.DatabaseClaim, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if err := claims.Delete(snapshot.DatabaseClaim, &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	err = api.CoreV1().Pods(o.Namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "syndesis.io/component=syndesis-db"})
+	if err != nil {
+		return err
+	}
+	err = wait.PollImmediate(2*time.Second, claimReleaseTimeout, func() (bool, error) {
+		_, err := claims.Get(snapshot.DatabaseClaim, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("volume claim %s of the database was not released: %v", snapshot.DatabaseClaim, err)
+	}
+
+	_, err = claims.Create(snapshot.RestoreClaim(snapshot.DatabaseClaim, name, source))
+	return err
+}
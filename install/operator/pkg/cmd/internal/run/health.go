@@ -0,0 +1,102 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// Time given to the API server to answer the health checks, the client timing out after it
+const healthCheckTimeout = 5 * time.Second
+
+// Set while this replica is the active one
+var leading int32
+
+// Outcome of a check of a dependency of the operator
+type healthCheck struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+type healthReport struct {
+	Status string        `json:"status"` // ok, or failing when a check the endpoint depends on failed
+	Checks []healthCheck `json:"checks"`
+}
+
+// Reports the health of the operator and of its dependencies. /healthz only fails when the operator cannot serve
+// it, restarting the operator not helping with its dependencies, while /readyz fails when the API server is
+// unreachable or the configuration cannot be loaded. Standby replicas are ready, waiting to take over.
+type health struct {
+	api        interface{ ServerVersion() (*version.Info, error) }
+	configFile string
+}
+
+func (h *health) checks() []healthCheck {
+	checks := []healthCheck{}
+
+	api := healthCheck{Name: "api", Healthy: true}
+	if info, err := h.api.ServerVersion(); err != nil {
+		api.Healthy = false
+		api.Message = err.Error()
+	} else {
+		api.Message = "reachable, version " + info.GitVersion
+	}
+	checks = append(checks, api)
+
+	config := healthCheck{Name: "config", Healthy: true, Message: "loaded from " + h.configFile}
+	if _, err := configuration.GetProperties(h.configFile, context.TODO(), nil, &v1alpha1.Syndesis{}); err != nil {
+		config.Healthy = false
+		config.Message = err.Error()
+	}
+	checks = append(checks, config)
+
+	leader := healthCheck{Name: "leader", Healthy: true, Message: "standing by"}
+	if atomic.LoadInt32(&leading) == 1 {
+		leader.Message = "active replica"
+	}
+	return append(checks, leader)
+}
+
+func (h *health) handler(failOnDependencies bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		report := healthReport{Status: "ok", Checks: h.checks()}
+		code := http.StatusOK
+		for _, check := range report.Checks {
+			if !check.Healthy && failOnDependencies {
+				report.Status = "failing"
+				code = http.StatusServiceUnavailable
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
+
+// Serves /healthz and /readyz on the address
+func (h *health) start(address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", h.handler(false))
+	mux.Handle("/readyz", h.handler(true))
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Serving health endpoints", "address", address)
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			log.Error(err, "Health endpoints stopped")
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,58 @@
+package run
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+type fakeServerVersion struct {
+	err error
+}
+
+func (f fakeServerVersion) ServerVersion() (*version.Info, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &version.Info{GitVersion: "v1.13.4"}, nil
+}
+
+func Test_health(t *testing.T) {
+	get := func(h *health, path string) (int, healthReport) {
+		recorder := httptest.NewRecorder()
+		mux := http.NewServeMux()
+		mux.Handle("/healthz", h.handler(false))
+		mux.Handle("/readyz", h.handler(true))
+		mux.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+		report := healthReport{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+		return recorder.Code, report
+	}
+
+	h := &health{api: fakeServerVersion{}, configFile: "../../../../build/conf/config.yaml"}
+	code, report := get(h, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", report.Status)
+	require.Len(t, report.Checks, 3)
+	assert.Equal(t, healthCheck{Name: "api", Healthy: true, Message: "reachable, version v1.13.4"}, report.Checks[0])
+	assert.Equal(t, healthCheck{Name: "leader", Healthy: true, Message: "standing by"}, report.Checks[2])
+
+	h = &health{api: fakeServerVersion{err: errors.New("connection refused")}, configFile: "missing.yaml"}
+	code, report = get(h, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "failing", report.Status)
+	assert.False(t, report.Checks[0].Healthy)
+	assert.Equal(t, "connection refused", report.Checks[0].Message)
+	assert.False(t, report.Checks[1].Healthy)
+
+	// Restarting the operator doesn't help with its dependencies
+	code, report = get(h, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", report.Status)
+}
@@ -0,0 +1,77 @@
+package run
+
+import (
+	"crypto/subtle"
+	"expvar"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Serves the pprof profiles and the expvar runtime metrics. Unless bound to the
+// loopback interface, requests must carry the bearer token read from tokenFile.
+func startProfiling(address string, tokenFile string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrap(err, "invalid profiling address")
+	}
+
+	token := ""
+	if tokenFile != "" {
+		data, err := ioutil.ReadFile(tokenFile)
+		if err != nil {
+			return errors.Wrap(err, "cannot read profiling token")
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token == "" && !isLoopback(host) {
+		return errors.Errorf("profiling on %s requires a token file", address)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Serving profiling endpoint", "address", address)
+	go func() {
+		if err := http.Serve(listener, requireToken(token, mux)); err != nil {
+			log.Error(err, "Profiling endpoint stopped")
+		}
+	}()
+	return nil
+}
+
+func requireToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
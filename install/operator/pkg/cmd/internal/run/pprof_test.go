@@ -0,0 +1,30 @@
+package run
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireToken(t *testing.T) {
+	handler := requireToken("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := httptest.NewRequest("GET", "/debug/vars", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	request.Header.Set("Authorization", "Bearer secret")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestStartProfilingRequiresTokenOffLoopback(t *testing.T) {
+	assert.Error(t, startProfiling("0.0.0.0:0", ""))
+	assert.True(t, isLoopback("localhost"))
+	assert.True(t, isLoopback("::1"))
+	assert.False(t, isLoopback(""))
+}
@@ -2,10 +2,10 @@ package run
 
 import (
 	"fmt"
+	"io"
 	"runtime"
 
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
-	"github.com/operator-framework/operator-sdk/pkg/leader"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/operator-framework/operator-sdk/pkg/metrics"
 	"github.com/operator-framework/operator-sdk/pkg/restmapper"
@@ -13,11 +13,17 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/audit"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/component"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"github.com/syndesisio/syndesis/install/operator/version"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -36,6 +42,9 @@ import (
 var (
 	metricsHost       = "0.0.0.0"
 	metricsPort int32 = 8383
+
+	// Replicas elect the active one with this lock, the others standing by while serving their metrics
+	leaderElectionID = "syndesis-operator-leader"
 )
 var log = logf.Log.WithName("cmd")
 
@@ -44,6 +53,7 @@ func printVersion() {
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
 	log.Info(fmt.Sprintf("Version of operator-sdk: %v", sdkVersion.Version))
 	log.Info(fmt.Sprintf("Syndesis Operator Version: %s", version.Version))
+	log.Info(fmt.Sprintf("Syndesis Operator Git Commit: %s", version.GitCommit))
 }
 
 func New(parent *internal.Options) *cobra.Command {
@@ -52,12 +62,28 @@ func New(parent *internal.Options) *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "run",
 		Short: "runs the operator",
-		Run: func(_ *cobra.Command, _ []string) {
+		Run: func(cmd *cobra.Command, _ []string) {
+			if options.schema != "" {
+				util.ExitOnError(options.printSchema(cmd.OutOrStdout()))
+				return
+			}
 			util.ExitOnError(options.run())
 		},
 	}
 
 	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
+	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfigMap, "operator-config-map", "", "", "ConfigMap of the syndesis namespace whose config.yaml key overrides the operator configuration file, its changes being applied to the installations.")
+	cmd.PersistentFlags().Float32VarP(&options.qps, "kube-api-qps", "", rest.DefaultQPS, "Maximum queries per second sent to the API server.")
+	cmd.PersistentFlags().IntVarP(&options.burst, "kube-api-burst", "", rest.DefaultBurst, "Maximum burst of queries sent to the API server.")
+	cmd.PersistentFlags().StringVarP(&options.pprofAddress, "pprof-address", "", "", "Address serving pprof profiles and expvar metrics, e.g. localhost:6060. Disabled when empty.")
+	cmd.PersistentFlags().StringVarP(&options.pprofTokenFile, "pprof-token-file", "", "", "File holding the bearer token required by the profiling endpoint, mandatory unless bound to localhost.")
+	cmd.PersistentFlags().StringVarP(&options.healthAddress, "health-address", "", ":8081", "Address serving the /healthz and /readyz endpoints, reporting the health of the operator dependencies. Disabled when empty.")
+	cmd.PersistentFlags().StringVarP(&options.webhookAddress, "webhook-address", "", ":8443", "Address serving the admission webhooks validating and defaulting the Syndesis resources. Disabled when empty.")
+	cmd.PersistentFlags().StringVarP(&options.webhookCertDir, "webhook-cert-dir", "", "/etc/syndesis-operator/webhook", "Directory holding the tls.crt and tls.key serving certificate of the admission webhooks.")
+	cmd.PersistentFlags().StringVarP(&options.auditLog, "audit-log", "", "", "File the resources created, updated and deleted by the operator are logged to. Disabled when empty.")
+	cmd.PersistentFlags().Int64VarP(&options.auditLogMaxSize, "audit-log-max-size", "", 10, "Size in MiB from which the audit log is rotated, the previous one being kept with a .1 suffix.")
+	cmd.PersistentFlags().IntVarP(&options.auditEntries, "audit-configmap-entries", "", 0, "Number of audit entries kept in the syndesis-operator-audit ConfigMap, read by the audit command. Disabled when 0.")
+	cmd.PersistentFlags().StringVarP(&options.schema, "schema", "", "", "prints the JSON Schema of the operator configuration file (config) or of the Syndesis custom resource (syndesis) instead of running the operator")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 
@@ -66,12 +92,73 @@ func New(parent *internal.Options) *cobra.Command {
 
 type options struct {
 	*internal.Options
+	qps            float32
+	burst          int
+	pprofAddress   string
+	pprofTokenFile string
+	schema         string
+	healthAddress  string
+	webhookAddress string
+	webhookCertDir string
+
+	auditLog        string
+	auditLogMaxSize int64
+	auditEntries    int
+}
+
+// Prints the schema editors and validators check the configuration files against
+func (o *options) printSchema(out io.Writer) error {
+	var schema configuration.Schema
+	switch o.schema {
+	case "config":
+		schema = configuration.ConfigSchema()
+	case "syndesis":
+		schema = configuration.CustomResourceSchema()
+	default:
+		return errors.Errorf("unsupported schema %s, use one of: config, syndesis", o.schema)
+	}
+	data, err := schema.JSON()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(data))
+	return err
+}
+
+// Serves the health endpoints, the API server being given a short time to answer their checks
+func (o *options) startHealth(cfg *rest.Config) error {
+	if o.healthAddress == "" {
+		return nil
+	}
+	healthConfig := rest.CopyConfig(cfg)
+	healthConfig.Timeout = healthCheckTimeout
+	api, err := discovery.NewDiscoveryClientForConfig(healthConfig)
+	if err != nil {
+		return err
+	}
+	h := &health{api: api, configFile: configuration.TemplateConfig}
+	return h.start(o.healthAddress)
+}
+
+// Serves the admission webhook validating and defaulting the Syndesis resources of the namespace
+func (o *options) startWebhook(namespace string, cl client.Client) error {
+	if o.webhookAddress == "" {
+		return nil
+	}
+	w := &webhook{namespace: namespace, configFile: configuration.TemplateConfig, client: cl}
+	return w.start(o.webhookAddress, o.webhookCertDir)
 }
 
 func (o *options) run() error {
 	logf.SetLogger(zap.Logger())
 
 	printVersion()
+	if o.pprofAddress != "" {
+		if err := startProfiling(o.pprofAddress, o.pprofTokenFile); err != nil {
+			return err
+		}
+	}
+
 	namespace, err := k8sutil.GetWatchNamespace()
 	if err != nil {
 		return errors.Wrap(err, "failed to get watch namespace")
@@ -82,40 +169,66 @@ func (o *options) run() error {
 	if err != nil {
 		return err
 	}
+	cfg.QPS = o.qps
+	cfg.Burst = o.burst
+	util.CountAPICalls(cfg)
+	if err := util.InjectFaults(cfg); err != nil {
+		return err
+	}
 
 	configuration, err := configuration.GetProperties(configuration.TemplateConfig, o.Context, nil, &v1alpha1.Syndesis{})
 	if err != nil {
 		return err
 	}
 
-	util.KnownDockerImages[configuration.Syndesis.Components.Server.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Meta.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.UI.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.S2I.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Database.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Oauth.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Database.Exporter.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Prometheus.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Components.Upgrade.Image] = true
-	util.KnownDockerImages[configuration.Syndesis.Addons.DV.Image] = true
+	for _, image := range component.Images(configuration) {
+		util.KnownDockerImages[image] = true
+	}
 
 	ctx := o.Context
 
-	// Become the leader before proceeding
-	err = leader.Become(ctx, "syndesis-operator-lock")
+	// Create a new Cmd to provide shared dependencies and start components, only
+	// once elected leader, the metrics being served by every replica
+	mgr, err := manager.New(cfg, manager.Options{
+		Namespace:               namespace,
+		MapperProvider:          restmapper.NewDynamicRESTMapper,
+		MetricsBindAddress:      fmt.Sprintf("%s:%d", metricsHost, metricsPort),
+		NewClient:               util.NewClient,
+		LeaderElection:          true,
+		LeaderElectionID:        leaderElectionID,
+		LeaderElectionNamespace: namespace,
+	})
 	if err != nil {
 		return err
 	}
 
-	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, manager.Options{
-		Namespace:          namespace,
-		MapperProvider:     restmapper.NewDynamicRESTMapper,
-		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
-	})
+	api, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		return err
 	}
+	if err := o.startHealth(cfg); err != nil {
+		return err
+	}
+	// ConfigMaps are read from the API server, the webhook can use the client before the cache is started
+	if err := o.startWebhook(namespace, mgr.GetClient()); err != nil {
+		return err
+	}
+	if err := mgr.Add(newActiveReplica(api.CoordinationV1beta1(), namespace)); err != nil {
+		return err
+	}
+	// Most secrets are only checked for existence or ownership, their metadata is all the operator keeps
+	metadata := util.NewMetadataCache(api.CoreV1().RESTClient(), namespace, "secrets")
+	if err := mgr.Add(metadata); err != nil {
+		return err
+	}
+	util.UseMetadataCache(metadata)
+	audit.Configure(audit.Options{
+		File:             o.auditLog,
+		MaxFileSize:      o.auditLogMaxSize * 1024 * 1024,
+		ConfigMapEntries: o.auditEntries,
+		Namespace:        namespace,
+		Client:           mgr.GetClient(),
+	})
 
 	log.Info("registering resource schemes.")
 	// Setup Scheme for all resources
@@ -0,0 +1,123 @@
+package run
+
+import (
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1beta1"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// Lease reporting the replica of the operator that is active, the others standing by
+	leaseName = "syndesis-operator"
+
+	// How often the active replica renews the lease, well within its duration
+	leaseRenewPeriod           = 10 * time.Second
+	leaseDurationSeconds int32 = 30
+)
+
+var operatorLeader = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "syndesis_operator_leader",
+	Help: "Whether this replica of the operator is the active one (1) or on standby (0).",
+})
+
+func init() {
+	crmetrics.Registry.MustRegister(operatorLeader)
+}
+
+// Reports which replica of the operator is active. Added to the manager, it only starts once the replica has
+// been elected leader, from then on keeping the lease renewed with the name of its pod.
+type activeReplica struct {
+	leases    coordinationclient.LeasesGetter
+	namespace string
+	identity  string
+	period    time.Duration
+}
+
+func newActiveReplica(leases coordinationclient.LeasesGetter, namespace string) *activeReplica {
+	identity := os.Getenv("POD_NAME")
+	if identity == "" {
+		identity, _ = os.Hostname()
+	}
+	return &activeReplica{
+		leases:    leases,
+		namespace: namespace,
+		identity:  identity,
+		period:    leaseRenewPeriod,
+	}
+}
+
+func (r *activeReplica) Start(stop <-chan struct{}) error {
+	log.Info("Operator replica is active", "identity", r.identity)
+	operatorLeader.Set(1)
+	atomic.StoreInt32(&leading, 1)
+	defer operatorLeader.Set(0)
+	defer atomic.StoreInt32(&leading, 0)
+
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+	for {
+		// The lease only reports the active replica, the operator works on without it
+		if err := r.renew(time.Now()); err != nil {
+			log.Error(err, "Cannot renew the operator lease", "lease", leaseName)
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Claims the lease for this replica, creating it when missing
+func (r *activeReplica) renew(now time.Time) error {
+	leases := r.leases.Leases(r.namespace)
+	renewTime := metav1.NewMicroTime(now)
+	duration := leaseDurationSeconds
+	transitions := int32(0)
+
+	lease, err := leases.Get(leaseName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = leases.Create(&coordinationv1beta1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      leaseName,
+				Namespace: r.namespace,
+				Labels: map[string]string{
+					"app":                   "syndesis",
+					"syndesis.io/app":       "syndesis",
+					"syndesis.io/type":      "operator",
+					"syndesis.io/component": "syndesis-operator",
+				},
+			},
+			Spec: coordinationv1beta1.LeaseSpec{
+				HolderIdentity:       &r.identity,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+				LeaseTransitions:     &transitions,
+			},
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != r.identity {
+		lease.Spec.HolderIdentity = &r.identity
+		lease.Spec.AcquireTime = &renewTime
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec.LeaseTransitions = &transitions
+	}
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.RenewTime = &renewTime
+	_, err = leases.Update(lease)
+	return err
+}
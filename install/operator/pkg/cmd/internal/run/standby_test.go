@@ -0,0 +1,67 @@
+package run
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1beta1"
+)
+
+// Leases of a namespace kept in memory
+type fakeLeases struct {
+	coordinationclient.LeaseInterface
+	lease *coordinationv1beta1.Lease
+}
+
+func (f *fakeLeases) Leases(string) coordinationclient.LeaseInterface {
+	return f
+}
+
+func (f *fakeLeases) Get(name string, _ metav1.GetOptions) (*coordinationv1beta1.Lease, error) {
+	if f.lease == nil {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Group: "coordination.k8s.io", Resource: "leases"}, name)
+	}
+	return f.lease.DeepCopy(), nil
+}
+
+func (f *fakeLeases) Create(lease *coordinationv1beta1.Lease) (*coordinationv1beta1.Lease, error) {
+	f.lease = lease.DeepCopy()
+	return lease, nil
+}
+
+func (f *fakeLeases) Update(lease *coordinationv1beta1.Lease) (*coordinationv1beta1.Lease, error) {
+	f.lease = lease.DeepCopy()
+	return lease, nil
+}
+
+func Test_activeReplica_renew(t *testing.T) {
+	leases := &fakeLeases{}
+	first := &activeReplica{leases: leases, namespace: "syndesis", identity: "syndesis-operator-1-abcde"}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, first.renew(start))
+	require.NotNil(t, leases.lease)
+	assert.Equal(t, leaseName, leases.lease.Name)
+	assert.Equal(t, "syndesis-operator-1-abcde", *leases.lease.Spec.HolderIdentity)
+	assert.Equal(t, leaseDurationSeconds, *leases.lease.Spec.LeaseDurationSeconds)
+	assert.True(t, leases.lease.Spec.AcquireTime.Time.Equal(start))
+
+	require.NoError(t, first.renew(start.Add(leaseRenewPeriod)))
+	assert.True(t, leases.lease.Spec.AcquireTime.Time.Equal(start))
+	assert.True(t, leases.lease.Spec.RenewTime.Time.Equal(start.Add(leaseRenewPeriod)))
+	assert.Equal(t, int32(0), *leases.lease.Spec.LeaseTransitions)
+
+	// A standby replica taking over
+	second := &activeReplica{leases: leases, namespace: "syndesis", identity: "syndesis-operator-1-fghij"}
+	takeover := start.Add(time.Minute)
+	require.NoError(t, second.renew(takeover))
+	assert.Equal(t, "syndesis-operator-1-fghij", *leases.lease.Spec.HolderIdentity)
+	assert.True(t, leases.lease.Spec.AcquireTime.Time.Equal(takeover))
+	assert.Equal(t, int32(1), *leases.lease.Spec.LeaseTransitions)
+}
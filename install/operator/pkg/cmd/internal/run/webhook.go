@@ -0,0 +1,268 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Paths the webhook configurations send the Syndesis resources to
+const (
+	validatePath = "/validate-syndesis"
+	mutatePath   = "/mutate-syndesis"
+	convertPath  = "/convert-syndesis"
+)
+
+// Rejects the Syndesis resources of the operator namespace whose configuration is invalid, at admission time
+// rather than when reconciling them. The configuration is built as the reconciliation does, without the values
+// only read from the cluster, so that the same problems get reported with the path of the field to fix.
+// Their specs also get the defaults of the configuration filled in, so that they show what is running.
+// v1beta1 resources are reviewed as their v1alpha1 conversion.
+type webhook struct {
+	namespace  string
+	configFile string
+	client     client.Client
+}
+
+func (w *webhook) validate(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	allowed := &admissionv1beta1.AdmissionResponse{UID: request.UID, Allowed: true}
+	if request.Namespace != w.namespace {
+		return allowed
+	}
+
+	syndesis, err := decodeSyndesis(request.Object.Raw)
+	if err != nil {
+		return denied(request, http.StatusBadRequest, err.Error())
+	}
+	if syndesis.DeletionTimestamp != nil {
+		return allowed
+	}
+	// The operator updates the status of invalid resources too, only spec changes get checked
+	if specUnchanged(request, syndesis) {
+		return allowed
+	}
+
+	syndesis.Namespace = request.Namespace
+	if _, err := configuration.GetProperties(w.configFile, context.TODO(), nil, syndesis); err != nil {
+		return denied(request, http.StatusUnprocessableEntity, err.Error())
+	}
+	return allowed
+}
+
+// Writes the defaults of the configuration into the spec, as a patch replacing it
+func (w *webhook) mutate(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	allowed := &admissionv1beta1.AdmissionResponse{UID: request.UID, Allowed: true}
+	if request.Namespace != w.namespace {
+		return allowed
+	}
+
+	syndesis, err := decodeSyndesis(request.Object.Raw)
+	if err != nil {
+		return denied(request, http.StatusBadRequest, err.Error())
+	}
+	if syndesis.DeletionTimestamp != nil || specUnchanged(request, syndesis) {
+		return allowed
+	}
+
+	syndesis.Namespace = request.Namespace
+	spec, err := configuration.DefaultedSpec(context.TODO(), w.client, w.configFile, syndesis)
+	if err != nil {
+		// Left to the validation, which reports the invalid fields
+		log.Error(err, "Cannot default the syndesis resource", "name", syndesis.Name)
+		return allowed
+	}
+	if reflect.DeepEqual(*spec, syndesis.Spec) {
+		return allowed
+	}
+
+	// The patch applies to the resource in the version it was sent with
+	var value interface{} = spec
+	if request.Kind.Version == v1beta1.SchemeGroupVersion.Version {
+		beta := &v1beta1.Syndesis{}
+		beta.ConvertFrom(&v1alpha1.Syndesis{Spec: *spec})
+		value = beta.Spec
+	}
+	patch, err := json.Marshal([]map[string]interface{}{{"op": "add", "path": "/spec", "value": value}})
+	if err != nil {
+		return denied(request, http.StatusInternalServerError, err.Error())
+	}
+	patchType := admissionv1beta1.PatchTypeJSONPatch
+	allowed.Patch = patch
+	allowed.PatchType = &patchType
+	return allowed
+}
+
+// Whether the request updates the resource without changing its spec
+func specUnchanged(request *admissionv1beta1.AdmissionRequest, syndesis *v1alpha1.Syndesis) bool {
+	if request.Operation != admissionv1beta1.Update || len(request.OldObject.Raw) == 0 {
+		return false
+	}
+	old, err := decodeSyndesis(request.OldObject.Raw)
+	return err == nil && reflect.DeepEqual(old.Spec, syndesis.Spec)
+}
+
+// Decodes a Syndesis resource of either version as a v1alpha1 one
+func decodeSyndesis(raw []byte) (*v1alpha1.Syndesis, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	syndesis := &v1alpha1.Syndesis{}
+	if typeMeta.APIVersion != v1beta1.SchemeGroupVersion.String() {
+		return syndesis, json.Unmarshal(raw, syndesis)
+	}
+	beta := &v1beta1.Syndesis{}
+	if err := json.Unmarshal(raw, beta); err != nil {
+		return nil, err
+	}
+	beta.ConvertTo(syndesis)
+	return syndesis, nil
+}
+
+// Converts the Syndesis resources stored as v1alpha1 to the version a client asks for, and the ones sent as
+// v1beta1 to the stored version
+func (w *webhook) convert(request *apiextensionsv1beta1.ConversionRequest) *apiextensionsv1beta1.ConversionResponse {
+	response := &apiextensionsv1beta1.ConversionResponse{
+		UID:    request.UID,
+		Result: metav1.Status{Status: metav1.StatusSuccess},
+	}
+	for _, object := range request.Objects {
+		converted, err := convertSyndesis(object.Raw, request.DesiredAPIVersion)
+		if err != nil {
+			response.ConvertedObjects = nil
+			response.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	return response
+}
+
+func convertSyndesis(raw []byte, apiVersion string) ([]byte, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+	if typeMeta.APIVersion == apiVersion {
+		return raw, nil
+	}
+
+	switch apiVersion {
+	case v1alpha1.SchemeGroupVersion.String():
+		syndesis, err := decodeSyndesis(raw)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(syndesis)
+	case v1beta1.SchemeGroupVersion.String():
+		if typeMeta.APIVersion != v1alpha1.SchemeGroupVersion.String() {
+			break
+		}
+		syndesis := &v1alpha1.Syndesis{}
+		if err := json.Unmarshal(raw, syndesis); err != nil {
+			return nil, err
+		}
+		beta := &v1beta1.Syndesis{}
+		beta.ConvertFrom(syndesis)
+		return json.Marshal(beta)
+	}
+	return nil, errors.Errorf("cannot convert %s %s to %s", typeMeta.Kind, typeMeta.APIVersion, apiVersion)
+}
+
+func denied(request *admissionv1beta1.AdmissionRequest, code int32, message string) *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: message,
+		},
+	}
+}
+
+func (w *webhook) handler(review func(*admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		admissionReview := admissionv1beta1.AdmissionReview{}
+		if err := json.Unmarshal(body, &admissionReview); err != nil || admissionReview.Request == nil {
+			http.Error(rw, "invalid admission review", http.StatusBadRequest)
+			return
+		}
+
+		admissionReview.Response = review(admissionReview.Request)
+		admissionReview.Request = nil
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(admissionReview)
+	}
+}
+
+func (w *webhook) conversionHandler() http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		conversionReview := apiextensionsv1beta1.ConversionReview{}
+		if err := json.Unmarshal(body, &conversionReview); err != nil || conversionReview.Request == nil {
+			http.Error(rw, "invalid conversion review", http.StatusBadRequest)
+			return
+		}
+
+		conversionReview.Response = w.convert(conversionReview.Request)
+		conversionReview.Request = nil
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(conversionReview)
+	}
+}
+
+// Serves the webhook over TLS on the address, with the tls.crt and tls.key serving certificate of certDir. The
+// webhook is not served when the certificate has not been provisioned, e.g. outside of OpenShift.
+func (w *webhook) start(address string, certDir string) error {
+	certFile := filepath.Join(certDir, "tls.crt")
+	keyFile := filepath.Join(certDir, "tls.key")
+	if _, err := os.Stat(certFile); os.IsNotExist(err) {
+		log.Info("Not serving the admission webhook, no serving certificate", "certificate", certFile)
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(validatePath, w.handler(w.validate))
+	mux.Handle(mutatePath, w.handler(w.mutate))
+	mux.Handle(convertPath, w.conversionHandler())
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Serving admission webhook", "address", address)
+	go func() {
+		if err := http.ServeTLS(listener, mux, certFile, keyFile); err != nil {
+			log.Error(err, "Admission webhook stopped")
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,186 @@
+package run
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+)
+
+func Test_webhook_validate(t *testing.T) {
+	w := &webhook{namespace: "syndesis", configFile: "../../../../build/conf/config.yaml"}
+	review := func(namespace string, operation admissionv1beta1.Operation, spec v1alpha1.SyndesisSpec, old *v1alpha1.SyndesisSpec) *admissionv1beta1.AdmissionResponse {
+		raw := func(spec v1alpha1.SyndesisSpec) runtime.RawExtension {
+			data, err := json.Marshal(v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}, Spec: spec})
+			require.NoError(t, err)
+			return runtime.RawExtension{Raw: data}
+		}
+		request := &admissionv1beta1.AdmissionRequest{UID: "1", Namespace: namespace, Operation: operation, Object: raw(spec)}
+		if old != nil {
+			request.OldObject = raw(*old)
+		}
+		return w.validate(request)
+	}
+
+	valid := v1alpha1.SyndesisSpec{}
+	assert.True(t, review("syndesis", admissionv1beta1.Create, valid, nil).Allowed)
+
+	invalid := v1alpha1.SyndesisSpec{ExternalHostname: "Syndesis_Example"}
+	invalid.Components.Server.Resources.Memory = "1024Zi"
+	response := review("syndesis", admissionv1beta1.Create, invalid, nil)
+	assert.False(t, response.Allowed)
+	assert.Equal(t, ktypes.UID("1"), response.UID)
+	assert.Contains(t, response.Result.Message, `spec.components.server.resources.memory: Invalid value: "1024Zi": not a quantity`)
+	assert.Contains(t, response.Result.Message, `spec.externalHostname: Invalid value: "Syndesis_Example"`)
+
+	// Resources of other namespaces are left to their operator, status updates to this one
+	assert.True(t, review("other", admissionv1beta1.Create, invalid, nil).Allowed)
+	assert.True(t, review("syndesis", admissionv1beta1.Update, invalid, &invalid).Allowed)
+	assert.False(t, review("syndesis", admissionv1beta1.Update, invalid, &valid).Allowed)
+
+	// v1beta1 resources are checked as their v1alpha1 conversion
+	beta := v1beta1.Syndesis{TypeMeta: metav1.TypeMeta{APIVersion: "syndesis.io/v1beta1", Kind: "Syndesis"}}
+	beta.Spec.Exposure.Hostname = "Syndesis_Example"
+	data, err := json.Marshal(beta)
+	require.NoError(t, err)
+	response = w.validate(&admissionv1beta1.AdmissionRequest{UID: "2", Namespace: "syndesis", Operation: admissionv1beta1.Create, Object: runtime.RawExtension{Raw: data}})
+	assert.False(t, response.Allowed)
+	assert.Contains(t, response.Result.Message, `spec.externalHostname: Invalid value: "Syndesis_Example"`)
+}
+
+func Test_webhook_mutate(t *testing.T) {
+	w := &webhook{namespace: "syndesis", configFile: "../../../../build/conf/config.yaml"}
+	spec := v1alpha1.SyndesisSpec{}
+	spec.Components.Server.Resources.Memory = "2Gi"
+	data, err := json.Marshal(v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}, Spec: spec})
+	require.NoError(t, err)
+
+	response := w.mutate(&admissionv1beta1.AdmissionRequest{UID: "1", Namespace: "syndesis", Operation: admissionv1beta1.Create, Object: runtime.RawExtension{Raw: data}})
+	assert.True(t, response.Allowed)
+	require.NotNil(t, response.PatchType)
+	assert.Equal(t, admissionv1beta1.PatchTypeJSONPatch, *response.PatchType)
+
+	patch := []struct {
+		Op    string
+		Path  string
+		Value v1alpha1.SyndesisSpec
+	}{}
+	require.NoError(t, json.Unmarshal(response.Patch, &patch))
+	require.Len(t, patch, 1)
+	assert.Equal(t, "add", patch[0].Op)
+	assert.Equal(t, "/spec", patch[0].Path)
+	assert.Equal(t, "2Gi", patch[0].Value.Components.Server.Resources.Memory)
+	assert.Equal(t, "512Mi", patch[0].Value.Components.Meta.Resources.Memory)
+	assert.Equal(t, "1Gi", patch[0].Value.Components.Database.Resources.VolumeCapacity)
+
+	// Defaulted resources are left as is
+	data, err = json.Marshal(v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}, Spec: patch[0].Value})
+	require.NoError(t, err)
+	response = w.mutate(&admissionv1beta1.AdmissionRequest{UID: "2", Namespace: "syndesis", Operation: admissionv1beta1.Create, Object: runtime.RawExtension{Raw: data}})
+	assert.True(t, response.Allowed)
+	assert.Nil(t, response.Patch)
+
+	// v1beta1 resources are patched with a v1beta1 spec
+	beta := v1beta1.Syndesis{TypeMeta: metav1.TypeMeta{APIVersion: "syndesis.io/v1beta1", Kind: "Syndesis"}}
+	beta.Spec.Exposure.Hostname = "syndesis.example.com"
+	data, err = json.Marshal(beta)
+	require.NoError(t, err)
+	response = w.mutate(&admissionv1beta1.AdmissionRequest{
+		UID:       "3",
+		Kind:      metav1.GroupVersionKind{Group: "syndesis.io", Version: "v1beta1", Kind: "Syndesis"},
+		Namespace: "syndesis",
+		Operation: admissionv1beta1.Create,
+		Object:    runtime.RawExtension{Raw: data},
+	})
+	assert.True(t, response.Allowed)
+	betaPatch := []struct {
+		Value v1beta1.SyndesisSpec
+	}{}
+	require.NoError(t, json.Unmarshal(response.Patch, &betaPatch))
+	require.Len(t, betaPatch, 1)
+	assert.Equal(t, "syndesis.example.com", betaPatch[0].Value.Exposure.Hostname)
+	assert.Equal(t, "512Mi", betaPatch[0].Value.Components.Meta.Resources.Memory)
+}
+
+func Test_webhook_convert(t *testing.T) {
+	w := &webhook{namespace: "syndesis"}
+	alpha := v1alpha1.Syndesis{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "syndesis.io/v1alpha1", Kind: "Syndesis"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+		Spec: v1alpha1.SyndesisSpec{
+			ExternalHostname:      "syndesis.example.com",
+			IntegrationNamespaces: []string{"integrations"},
+		},
+		Status: v1alpha1.SyndesisStatus{Phase: v1alpha1.SyndesisPhaseInstalled},
+	}
+	data, err := json.Marshal(alpha)
+	require.NoError(t, err)
+
+	response := w.convert(&apiextensionsv1beta1.ConversionRequest{
+		UID:               "1",
+		DesiredAPIVersion: "syndesis.io/v1beta1",
+		Objects:           []runtime.RawExtension{{Raw: data}},
+	})
+	assert.Equal(t, ktypes.UID("1"), response.UID)
+	assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+	require.Len(t, response.ConvertedObjects, 1)
+	beta := v1beta1.Syndesis{}
+	require.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, &beta))
+	assert.Equal(t, "syndesis.io/v1beta1", beta.APIVersion)
+	assert.Equal(t, "app", beta.Name)
+	assert.Equal(t, "syndesis.example.com", beta.Spec.Exposure.Hostname)
+	assert.Equal(t, []string{"integrations"}, beta.Spec.Integrations.Namespaces)
+	assert.Equal(t, v1alpha1.SyndesisPhaseInstalled, beta.Status.Phase)
+
+	response = w.convert(&apiextensionsv1beta1.ConversionRequest{
+		UID:               "2",
+		DesiredAPIVersion: "syndesis.io/v1alpha1",
+		Objects:           response.ConvertedObjects,
+	})
+	assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+	require.Len(t, response.ConvertedObjects, 1)
+	back := v1alpha1.Syndesis{}
+	require.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, &back))
+	assert.Equal(t, alpha, back)
+
+	response = w.convert(&apiextensionsv1beta1.ConversionRequest{
+		UID:               "3",
+		DesiredAPIVersion: "syndesis.io/v2",
+		Objects:           []runtime.RawExtension{{Raw: data}},
+	})
+	assert.Equal(t, metav1.StatusFailure, response.Result.Status)
+	assert.Contains(t, response.Result.Message, "cannot convert Syndesis syndesis.io/v1alpha1 to syndesis.io/v2")
+	assert.Empty(t, response.ConvertedObjects)
+}
+
+func Test_webhook_handler(t *testing.T) {
+	w := &webhook{namespace: "syndesis", configFile: "../../../../build/conf/config.yaml"}
+	body, err := json.Marshal(admissionv1beta1.AdmissionReview{
+		Request: &admissionv1beta1.AdmissionRequest{UID: "1", Namespace: "syndesis", Object: runtime.RawExtension{Raw: []byte("[]")}},
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	w.handler(w.validate).ServeHTTP(recorder, httptest.NewRequest("POST", validatePath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	review := admissionv1beta1.AdmissionReview{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &review))
+	assert.Nil(t, review.Request)
+	assert.False(t, review.Response.Allowed)
+	assert.Equal(t, int32(http.StatusBadRequest), review.Response.Result.Code)
+
+	recorder = httptest.NewRecorder()
+	w.handler(w.validate).ServeHTTP(recorder, httptest.NewRequest("POST", validatePath, bytes.NewReader([]byte("{}"))))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
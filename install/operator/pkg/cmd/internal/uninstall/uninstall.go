@@ -25,6 +25,8 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -83,5 +85,78 @@ func (o *Uninstall) uninstall() error {
 		}
 	}
 
+	if err := o.uninstallClusterResources(c, "console.openshift.io/v1", "ConsoleLink", "console link"); err != nil {
+		return err
+	}
+	if err := o.uninstallClusterResources(c, "admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", "admission webhook"); err != nil {
+		return err
+	}
+	if err := o.uninstallClusterResources(c, "admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", "admission webhook"); err != nil {
+		return err
+	}
+	return o.uninstallConversionWebhook(c)
+}
+
+// Stops serving the v1beta1 version of the syndesis resources when their conversions were left to this operator
+func (o *Uninstall) uninstallConversionWebhook(c client.Client) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
+	crd.SetKind("CustomResourceDefinition")
+	if err := c.Get(o.Context, client.ObjectKey{Name: "syndesises.syndesis.io"}, crd); err != nil {
+		if errors.IsNotFound(err) || errors.IsForbidden(err) {
+			return nil
+		}
+		return err
+	}
+	namespace, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhookClientConfig", "service", "namespace")
+	if namespace != o.Namespace {
+		return nil
+	}
+
+	unstructured.RemoveNestedField(crd.Object, "spec", "conversion")
+	versions := []interface{}{
+		map[string]interface{}{"name": "v1alpha1", "served": true, "storage": true},
+		map[string]interface{}{"name": "v1beta1", "served": false, "storage": false},
+	}
+	if err := unstructured.SetNestedSlice(crd.Object, versions, "spec", "versions"); err != nil {
+		return err
+	}
+	if err := c.Update(o.Context, crd); err != nil {
+		fmt.Println(err, "could not unregister", "conversion webhook", crd.GetName())
+		return nil
+	}
+	fmt.Println("conversion webhook unregistered", crd.GetName())
+	return nil
+}
+
+// ConsoleLinks and webhook configurations are cluster scoped, so they are not garbage collected together with the syndesis resource
+func (o *Uninstall) uninstallClusterResources(c client.Client, apiVersion string, kind string, description string) error {
+	selector, err := labels.Parse("syndesis.io/app=syndesis,syndesis.io/namespace=" + o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	list := unstructured.UnstructuredList{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind + "List",
+		},
+	}
+	if err := c.List(o.Context, &client.ListOptions{LabelSelector: selector}, &list); err != nil {
+		if util.IsNoKindMatchError(err) || errors.IsNotFound(err) || errors.IsForbidden(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, res := range list.Items {
+		if err := c.Delete(o.Context, &res); err != nil {
+			if !errors.IsNotFound(err) {
+				fmt.Println(err, "could not deleted", description, res.GetName())
+			}
+		} else {
+			fmt.Println("resource deleted", description, res.GetName())
+		}
+	}
 	return nil
 }
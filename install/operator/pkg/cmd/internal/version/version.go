@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/compatibility"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	operatorversion "github.com/syndesisio/syndesis/install/operator/version"
+)
+
+// Info is the build metadata and compatibility matrix of the operator
+type Info struct {
+	Version                 string   `json:"version"`
+	GitCommit               string   `json:"gitCommit"`
+	Image                   string   `json:"image"`
+	GoVersion               string   `json:"goVersion"`
+	SyndesisVersions        []string `json:"syndesisVersions"`
+	SupportedUpgradeSources []string `json:"supportedUpgradeSources"`
+	SupportedClusters       []string `json:"supportedClusters"`
+}
+
+type Version struct {
+	*internal.Options
+	output string
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Version{Options: parent}
+	cmd := cobra.Command{
+		Use:   "version",
+		Short: "prints the operator version, build metadata and the Syndesis versions and clusters it supports",
+		Run: func(cmd *cobra.Command, _ []string) {
+			util.ExitOnError(o.version(cmd.OutOrStdout()))
+		},
+	}
+	cmd.Flags().StringVarP(&o.output, "output", "o", "text", "output format, one of: text, json")
+	return &cmd
+}
+
+func GetInfo() Info {
+	return Info{
+		Version:                 operatorversion.Version,
+		GitCommit:               operatorversion.GitCommit,
+		Image:                   pkg.DefaultOperatorImage + ":" + pkg.DefaultOperatorTag,
+		GoVersion:               runtime.Version(),
+		SyndesisVersions:        operatorversion.SupportedSyndesisVersions(),
+		SupportedUpgradeSources: operatorversion.SupportedUpgradeSources(),
+		SupportedClusters:       compatibility.SupportedReleases(),
+	}
+}
+
+func (o *Version) version(out io.Writer) error {
+	info := GetInfo()
+	switch o.output {
+	case "json":
+		data, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "text":
+		upgradeSources := strings.Join(info.SupportedUpgradeSources, ", ")
+		if upgradeSources == "" {
+			upgradeSources = "any"
+		}
+		_, err := fmt.Fprintf(out, "Version: %s\nGit commit: %s\nImage: %s\nGo version: %s\nSyndesis versions: %s\nUpgrade sources: %s\nSupported clusters: %s\n",
+			info.Version, info.GitCommit, info.Image, info.GoVersion, strings.Join(info.SyndesisVersions, ", "), upgradeSources, strings.Join(info.SupportedClusters, ", "))
+		return err
+	default:
+		return errors.Errorf("unsupported output format %s, use one of: text, json", o.output)
+	}
+}
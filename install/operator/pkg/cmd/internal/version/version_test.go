@@ -0,0 +1,55 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	operatorversion "github.com/syndesisio/syndesis/install/operator/version"
+)
+
+func TestVersion(t *testing.T) {
+	defer func(commit, versions, from string) {
+		operatorversion.GitCommit, operatorversion.SyndesisVersions, operatorversion.UpgradeFrom = commit, versions, from
+	}(operatorversion.GitCommit, operatorversion.SyndesisVersions, operatorversion.UpgradeFrom)
+	operatorversion.GitCommit = "abc123"
+	operatorversion.SyndesisVersions = "1.9.0, 1.9.1"
+	operatorversion.UpgradeFrom = "1.8.0,1.8.1,"
+
+	out := bytes.Buffer{}
+	o := Version{output: "json"}
+	require.NoError(t, o.version(&out))
+
+	info := Info{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &info))
+	assert.Equal(t, "abc123", info.GitCommit)
+	assert.Equal(t, []string{"1.9.0", "1.9.1"}, info.SyndesisVersions)
+	assert.Equal(t, []string{"1.8.0", "1.8.1"}, info.SupportedUpgradeSources)
+
+	out.Reset()
+	o.output = "text"
+	require.NoError(t, o.version(&out))
+	assert.Contains(t, out.String(), "Git commit: abc123\n")
+	assert.Contains(t, out.String(), "Upgrade sources: 1.8.0, 1.8.1\n")
+
+	o.output = "yaml"
+	assert.Error(t, o.version(&out))
+}
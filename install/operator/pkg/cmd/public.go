@@ -22,12 +22,16 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/audit"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/dashboards"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/grant"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/install"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/migrate"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/restore"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/run"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/uninstall"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/version"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"os"
 )
@@ -68,6 +72,10 @@ func NewOperator(ctx context.Context) (*cobra.Command, error) {
 	cmd.AddCommand(uninstall.New(&options))
 	cmd.AddCommand(backup.New(&options))
 	cmd.AddCommand(restore.New(&options))
+	cmd.AddCommand(migrate.New(&options))
+	cmd.AddCommand(dashboards.New(&options))
+	cmd.AddCommand(audit.New(&options))
+	cmd.AddCommand(version.New(&options))
 
 	return &cmd, nil
 }
@@ -0,0 +1,134 @@
+package syndesis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	syndesisv1alpha1 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+// Reconciles the syndesis resources again when the template configuration changes, so that new images or
+// addon defaults are rolled out without restarting the operator. The configuration being read on every
+// reconciliation, nothing else needs to be reloaded.
+func watchTemplateConfig(mgr manager.Manager, c controller.Controller, r *ReconcileSyndesis) error {
+	events := make(chan event.GenericEvent)
+	watcher := &configFileWatcher{file: configuration.TemplateConfig, events: events}
+	if err := mgr.Add(watcher); err != nil {
+		return err
+	}
+	err := c.Watch(&source.Channel{Source: events}, r.prioritized(&handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.allSyndesisRequests),
+	}))
+	if err != nil {
+		return err
+	}
+
+	if configuration.TemplateConfigMap == "" {
+		return nil
+	}
+	// Only the template ConfigMaps are watched, the operator does not cache the other ConfigMaps of the cluster
+	return watchNamed(mgr, c, r.apis.CoreV1().RESTClient(), "configmaps", &corev1.ConfigMap{}, configuration.TemplateConfigMap, r.prioritized(&handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.templateConfigMapChanged),
+	}))
+}
+
+// Reconcile the syndesis resources of the namespace whose template ConfigMap changed
+func (r *ReconcileSyndesis) templateConfigMapChanged(o handler.MapObject) []reconcile.Request {
+	if o.Meta.GetName() != configuration.TemplateConfigMap {
+		return nil
+	}
+
+	list := &syndesisv1alpha1.SyndesisList{}
+	if err := r.client.List(context.TODO(), &client.ListOptions{Namespace: o.Meta.GetNamespace()}, list); err != nil {
+		log.Error(err, "Cannot list syndesis resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, syndesis := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name},
+		})
+	}
+	return requests
+}
+
+// Watches the directory of the template configuration file, mounted ConfigMaps being updated by swapping a
+// symbolic link of the directory, sending an event when a valid new configuration is found
+type configFileWatcher struct {
+	file   string
+	events chan<- event.GenericEvent
+
+	modTime time.Time
+	size    int64
+}
+
+func (w *configFileWatcher) Start(stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	w.changed()
+	if err := watcher.Add(filepath.Dir(w.file)); err != nil {
+		log.Error(err, "Operator configuration not watched, changes apply when the operator restarts", "file", w.file)
+		return nil
+	}
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case err := <-watcher.Errors:
+			log.Error(err, "Cannot watch the operator configuration", "file", w.file)
+			continue
+		case <-watcher.Events:
+		}
+		if !w.changed() {
+			continue
+		}
+		// An invalid configuration would fail every reconciliation, wait for it to be fixed
+		if _, err := configuration.GetProperties(w.file, context.TODO(), nil, &syndesisv1alpha1.Syndesis{}); err != nil {
+			log.Error(err, "Invalid operator configuration, the syndesis resources are not reconciled with it", "file", w.file)
+			continue
+		}
+		log.Info("Operator configuration changed, reconciling the syndesis resources", "file", w.file)
+		evt := event.GenericEvent{Meta: &metav1.ObjectMeta{Name: w.file}, Object: &corev1.ConfigMap{}}
+		select {
+		case w.events <- evt:
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// Whether the file changed since the last call, a missing file being left to the reconciliations to report. Every
+// event of the directory is checked against it, as the file is rarely the one the events are about
+func (w *configFileWatcher) changed() bool {
+	info, err := os.Stat(w.file)
+	if err != nil {
+		return false
+	}
+	if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+		return false
+	}
+	w.modTime = info.ModTime()
+	w.size = info.Size()
+	return true
+}
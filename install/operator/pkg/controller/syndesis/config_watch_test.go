@@ -0,0 +1,73 @@
+package syndesis
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func Test_configFileWatcher_changed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("Version: 1.8"), 0600))
+
+	w := &configFileWatcher{file: file}
+	assert.True(t, w.changed())
+	assert.False(t, w.changed())
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("Version: 1.9.0"), 0600))
+	assert.True(t, w.changed())
+
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(file, later, later))
+	assert.True(t, w.changed())
+	assert.False(t, w.changed())
+
+	require.NoError(t, os.Remove(file))
+	assert.False(t, w.changed())
+}
+
+func Test_configFileWatcher_Start(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config, err := ioutil.ReadFile("../../../build/conf/config.yaml")
+	require.NoError(t, err)
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(file, config, 0600))
+
+	events := make(chan event.GenericEvent)
+	stop := make(chan struct{})
+	defer close(stop)
+	go (&configFileWatcher{file: file, events: events}).Start(stop)
+	// The watch is set up once the initial state of the file is known
+	time.Sleep(100 * time.Millisecond)
+
+	// Invalid configurations are not sent, the valid one replacing them is
+	update := func(content []byte) {
+		next := filepath.Join(dir, "..config.yaml")
+		require.NoError(t, ioutil.WriteFile(next, content, 0600))
+		require.NoError(t, os.Rename(next, file))
+	}
+	update([]byte("Version: [1.9"))
+	select {
+	case <-events:
+		t.Fatal("an invalid configuration was sent")
+	case <-time.After(500 * time.Millisecond):
+	}
+	update(append(config, []byte("\n# updated\n")...))
+	select {
+	case evt := <-events:
+		assert.Equal(t, file, evt.Meta.GetName())
+	case <-time.After(10 * time.Second):
+		t.Fatal("the configuration change was not sent")
+	}
+}
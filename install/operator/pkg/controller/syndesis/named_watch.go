@@ -0,0 +1,36 @@
+package syndesis
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Watches the core objects of a resource having the given name in every namespace. Unlike the watches of the
+// manager cache, the API server filters the objects by name, so that e.g. the other secrets of the cluster are
+// neither listed nor kept in the memory of the operator.
+func watchNamed(mgr manager.Manager, c controller.Controller, api rest.Interface, resource string, obj runtime.Object, name string, h handler.EventHandler) error {
+	informer := namedInformer(api, resource, obj, name)
+	err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		informer.Run(stop)
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Informer{Informer: informer}, h)
+}
+
+func namedInformer(api rest.Interface, resource string, obj runtime.Object, name string) toolscache.SharedIndexInformer {
+	selector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := toolscache.NewFilteredListWatchFromClient(api, resource, metav1.NamespaceAll, func(options *metav1.ListOptions) {
+		options.FieldSelector = selector
+	})
+	return toolscache.NewSharedIndexInformer(lw, obj, 0, toolscache.Indexers{})
+}
@@ -0,0 +1,62 @@
+package syndesis
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+func Test_namedInformer(t *testing.T) {
+	var lock sync.Mutex
+	selectors := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/secrets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		lock.Lock()
+		selectors = append(selectors, r.URL.Query().Get("fieldSelector"))
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			// Nothing changes until the informer stops watching
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"kind": "SecretList", "apiVersion": "v1", "metadata": {"resourceVersion": "1"}, "items": [
+			{"metadata": {"name": "syndesis-pull-secret", "namespace": "syndesis", "resourceVersion": "1"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	api, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	informer := namedInformer(api.CoreV1().RESTClient(), "secrets", &corev1.Secret{}, "syndesis-pull-secret")
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+
+	timeout := make(chan struct{})
+	time.AfterFunc(10*time.Second, func() { close(timeout) })
+	require.True(t, toolscache.WaitForCacheSync(timeout, informer.HasSynced))
+	assert.Equal(t, []string{"syndesis/syndesis-pull-secret"}, informer.GetStore().ListKeys())
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.NotEmpty(t, selectors)
+	for _, selector := range selectors {
+		assert.Equal(t, "metadata.name=syndesis-pull-secret", selector)
+	}
+}
@@ -0,0 +1,201 @@
+package syndesis
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Priority of a reconcile request, urgent requests being handed to the controller first
+type reconcilePriority int
+
+const (
+	priorityNormal reconcilePriority = iota
+	priorityUrgent
+)
+
+var priorityLabels = map[reconcilePriority]string{priorityNormal: "normal", priorityUrgent: "urgent"}
+
+// Requests handed to the controller queue at most. Keeping it shallow lets urgent requests
+// overtake the ones of a fleet wide resync, which wait in the reconcile queue.
+const maxControllerQueueDepth = 1
+
+var queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "syndesis_reconcile_queue_depth",
+	Help: "Number of Syndesis resources waiting to be reconciled, by priority.",
+}, []string{"priority"})
+
+func init() {
+	metrics.Registry.MustRegister(queueDepth)
+}
+
+// Queue of reconcile requests in front of the controller queue, which cannot be replaced. Requests are handed
+// to the controller by priority and in order of arrival, a request already waiting taking the highest priority.
+type reconcileQueue struct {
+	priority func(reconcile.Request) reconcilePriority
+
+	lock    sync.Mutex
+	waiting map[reconcile.Request]waitingRequest
+	arrived uint64
+	target  workqueue.RateLimitingInterface
+	notify  chan struct{}
+}
+
+type waitingRequest struct {
+	priority reconcilePriority
+	arrival  uint64
+}
+
+func newReconcileQueue(priority func(reconcile.Request) reconcilePriority) *reconcileQueue {
+	return &reconcileQueue{
+		priority: priority,
+		waiting:  map[reconcile.Request]waitingRequest{},
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+func (q *reconcileQueue) Add(request reconcile.Request) {
+	priority := q.priority(request)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if waiting, found := q.waiting[request]; found {
+		if priority > waiting.priority {
+			waiting.priority = priority
+			q.waiting[request] = waiting
+			q.updateDepth()
+		}
+		return
+	}
+	q.arrived++
+	q.waiting[request] = waitingRequest{priority: priority, arrival: q.arrived}
+	q.updateDepth()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *reconcileQueue) AddAfter(request reconcile.Request, delay time.Duration) {
+	time.AfterFunc(delay, func() { q.Add(request) })
+}
+
+// Takes the request to reconcile next, false being returned when nothing is waiting
+func (q *reconcileQueue) next() (reconcile.Request, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	var next reconcile.Request
+	var best *waitingRequest
+	for request, waiting := range q.waiting {
+		if best == nil || waiting.priority > best.priority || waiting.priority == best.priority && waiting.arrival < best.arrival {
+			next, best = request, &waitingRequest{priority: waiting.priority, arrival: waiting.arrival}
+		}
+	}
+	if best == nil {
+		return next, false
+	}
+	delete(q.waiting, next)
+	q.updateDepth()
+	return next, true
+}
+
+func (q *reconcileQueue) updateDepth() {
+	depths := map[reconcilePriority]int{}
+	for _, waiting := range q.waiting {
+		depths[waiting.priority]++
+	}
+	for priority, label := range priorityLabels {
+		queueDepth.WithLabelValues(label).Set(float64(depths[priority]))
+	}
+}
+
+func (q *reconcileQueue) controllerQueue() workqueue.RateLimitingInterface {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.target
+}
+
+// Hands the waiting requests to the controller queue, while it is shallow enough. Run by the manager.
+func (q *reconcileQueue) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if target := q.controllerQueue(); target != nil && target.Len() < maxControllerQueueDepth {
+			if request, found := q.next(); found {
+				target.Add(request)
+				continue
+			}
+		}
+
+		select {
+		case <-stop:
+			return nil
+		case <-q.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Event handler adding the requests of the wrapped handler to the reconcile queue, instead of the controller queue
+type prioritizedHandler struct {
+	handler.EventHandler
+	queue *reconcileQueue
+}
+
+func (h prioritizedHandler) Create(evt event.CreateEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Create(evt, h.queue.adder(q))
+}
+
+func (h prioritizedHandler) Update(evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Update(evt, h.queue.adder(q))
+}
+
+func (h prioritizedHandler) Delete(evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Delete(evt, h.queue.adder(q))
+}
+
+func (h prioritizedHandler) Generic(evt event.GenericEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Generic(evt, h.queue.adder(q))
+}
+
+// Controller queue whose additions go to the reconcile queue. The controller queue is
+// only known once events flow, as the controller creates it.
+func (q *reconcileQueue) adder(target workqueue.RateLimitingInterface) workqueue.RateLimitingInterface {
+	q.lock.Lock()
+	q.target = target
+	q.lock.Unlock()
+	return queueAdder{RateLimitingInterface: target, queue: q}
+}
+
+type queueAdder struct {
+	workqueue.RateLimitingInterface
+	queue *reconcileQueue
+}
+
+func (a queueAdder) Add(item interface{}) {
+	if request, ok := item.(reconcile.Request); ok {
+		a.queue.Add(request)
+		return
+	}
+	a.RateLimitingInterface.Add(item)
+}
+
+func (a queueAdder) AddRateLimited(item interface{}) {
+	a.Add(item)
+}
+
+func (a queueAdder) AddAfter(item interface{}, duration time.Duration) {
+	if request, ok := item.(reconcile.Request); ok {
+		a.queue.AddAfter(request, duration)
+		return
+	}
+	a.RateLimitingInterface.AddAfter(item, duration)
+}
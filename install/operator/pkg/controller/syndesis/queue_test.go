@@ -0,0 +1,38 @@
+package syndesis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func request(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: name, Name: "app"}}
+}
+
+func TestReconcileQueue(t *testing.T) {
+	priorities := map[string]reconcilePriority{"upgrading": priorityUrgent, "degraded": priorityUrgent}
+	q := newReconcileQueue(func(r reconcile.Request) reconcilePriority {
+		return priorities[r.Namespace]
+	})
+
+	for _, name := range []string{"steady-1", "upgrading", "steady-2", "degraded", "steady-1"} {
+		q.Add(request(name))
+	}
+
+	// A steady resource turning unhealthy overtakes the other steady ones
+	priorities["steady-2"] = priorityUrgent
+	q.Add(request("steady-2"))
+
+	order := []string{}
+	for {
+		next, found := q.next()
+		if !found {
+			break
+		}
+		order = append(order, next.Namespace)
+	}
+	assert.Equal(t, []string{"upgrading", "steady-2", "degraded", "steady-1"}, order)
+}
@@ -5,8 +5,12 @@ import (
 	"reflect"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
@@ -18,14 +22,20 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	routev1 "github.com/openshift/api/route/v1"
 	syndesisv1alpha1 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/action"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/audit"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
 
 var log = logf.Log.WithName("controller")
 
 var (
 	actions []action.SyndesisOperatorAction
+	// Actions run while the syndesis resource is being deleted
+	finalizerActions []action.SyndesisOperatorAction
 )
 
 // Add creates a new Syndesis Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -44,11 +54,13 @@ func newReconciler(mgr manager.Manager) (*ReconcileSyndesis, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &ReconcileSyndesis{
+	r := &ReconcileSyndesis{
 		apis:   clientset,
-		client: mgr.GetClient(),
+		client: audit.NewClient(mgr.GetClient(), mgr.GetScheme(), "controller"),
 		scheme: mgr.GetScheme(),
-	}, nil
+	}
+	r.queue = newReconcileQueue(r.priorityOf)
+	return r, nil
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -59,16 +71,132 @@ func add(mgr manager.Manager, r *ReconcileSyndesis) error {
 		return err
 	}
 
+	// Requests go through the reconcile queue, so that urgent ones are reconciled first
+	if err := mgr.Add(r.queue); err != nil {
+		return err
+	}
+
 	// Watch for changes to primary resource Syndesis
-	err = c.Watch(&source.Kind{Type: &syndesisv1alpha1.Syndesis{}}, &handler.EnqueueRequestForObject{})
+	err = c.Watch(&source.Kind{Type: &syndesisv1alpha1.Syndesis{}}, r.prioritized(&handler.EnqueueRequestForObject{}))
+	if err != nil {
+		return err
+	}
+
+	// Watch the syndesis routes, so that their resolved hostname is looked up again when they change
+	err = c.Watch(&source.Kind{Type: &routev1.Route{}}, r.prioritized(&handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(routeChanged),
+	}))
 	if err != nil {
 		return err
 	}
 
+	// Watch the pull secret, so that rotated credentials are copied to the integration namespaces. Only the
+	// pull secrets are watched, the operator does not cache the other secrets of the cluster
+	err = watchNamed(mgr, c, r.apis.CoreV1().RESTClient(), "secrets", &corev1.Secret{}, action.SyndesisPullSecret, r.prioritized(&handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.pullSecretChanged),
+	}))
+	if err != nil {
+		return err
+	}
+
+	// On OpenShift 4, cluster wide networking changes must be reflected on the installed resources
+	for _, gvk := range clusterConfigKinds {
+		if err := watchClusterConfig(c, r, gvk); err != nil {
+			return err
+		}
+	}
+
+	// Changes of the operator configuration apply to the existing installations
+	if err := watchTemplateConfig(mgr, c, r); err != nil {
+		return err
+	}
+
 	actions = action.NewOperatorActions(mgr, r.apis)
+	finalizerActions = action.NewFinalizerActions(mgr, r.apis)
 	return nil
 }
 
+var clusterConfigKinds = []schema.GroupVersionKind{
+	{Group: "config.openshift.io", Version: "v1", Kind: "Proxy"},
+	{Group: "config.openshift.io", Version: "v1", Kind: "Ingress"},
+}
+
+func watchClusterConfig(c controller.Controller, r *ReconcileSyndesis, gvk schema.GroupVersionKind) error {
+	// Only watch kinds that exist and can be read by the operator, otherwise
+	// the controller would wait forever for the informer to sync
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.client.List(context.TODO(), &client.ListOptions{Raw: &metav1.ListOptions{Limit: 1}}, list); err != nil {
+		log.Info("Cluster configuration not watched", "kind", gvk.Kind, "reason", err.Error())
+		return nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return c.Watch(&source.Kind{Type: obj}, r.prioritized(&handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.allSyndesisRequests),
+	}))
+}
+
+// Reconcile the syndesis resource owning the syndesis route when it changes
+func routeChanged(o handler.MapObject) []reconcile.Request {
+	if o.Meta.GetName() != action.SyndesisRouteName {
+		return nil
+	}
+	configuration.InvalidateRoute(o.Meta.GetNamespace())
+
+	owner := metav1.GetControllerOf(o.Meta)
+	if owner == nil || owner.Kind != "Syndesis" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Namespace: o.Meta.GetNamespace(), Name: owner.Name},
+	}}
+}
+
+// Reconcile the syndesis resources of the namespace whose pull secret changed
+func (r *ReconcileSyndesis) pullSecretChanged(o handler.MapObject) []reconcile.Request {
+	if o.Meta.GetName() != action.SyndesisPullSecret {
+		return nil
+	}
+
+	list := &syndesisv1alpha1.SyndesisList{}
+	if err := r.client.List(context.TODO(), &client.ListOptions{Namespace: o.Meta.GetNamespace()}, list); err != nil {
+		log.Error(err, "Cannot list syndesis resources")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, syndesis := range list.Items {
+		if len(syndesis.Spec.IntegrationNamespaces) == 0 {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name},
+		})
+	}
+	return requests
+}
+
+// Reconcile all the syndesis resources, used when a cluster wide object they depend on changes
+func (r *ReconcileSyndesis) allSyndesisRequests(_ handler.MapObject) []reconcile.Request {
+	configuration.InvalidateClusterNetwork()
+
+	list := &syndesisv1alpha1.SyndesisList{}
+	if err := r.client.List(context.TODO(), &client.ListOptions{}, list); err != nil {
+		log.Error(err, "Cannot list syndesis resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, syndesis := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name},
+		})
+	}
+	return requests
+}
+
 var _ reconcile.Reconciler = &ReconcileSyndesis{}
 
 // ReconcileSyndesis reconciles a Syndesis object
@@ -78,6 +206,36 @@ type ReconcileSyndesis struct {
 	client client.Client
 	apis   kubernetes.Interface
 	scheme *runtime.Scheme
+	queue  *reconcileQueue
+}
+
+func (r *ReconcileSyndesis) prioritized(h handler.EventHandler) handler.EventHandler {
+	return prioritizedHandler{EventHandler: h, queue: r.queue}
+}
+
+// Syndesis resources being installed, upgraded, removed or failing are reconciled before the steady ones
+func (r *ReconcileSyndesis) priorityOf(request reconcile.Request) reconcilePriority {
+	syndesis := &syndesisv1alpha1.Syndesis{}
+	if err := r.client.Get(context.TODO(), request.NamespacedName, syndesis); err != nil {
+		return priorityNormal
+	}
+	if syndesis.GetDeletionTimestamp() != nil {
+		return priorityUrgent
+	}
+
+	switch syndesis.Status.Phase {
+	case syndesisv1alpha1.SyndesisPhaseMissing, syndesisv1alpha1.SyndesisPhaseInstalling, syndesisv1alpha1.SyndesisPhaseStarting,
+		syndesisv1alpha1.SyndesisPhaseStartupFailed, syndesisv1alpha1.SyndesisPhaseUpgrading, syndesisv1alpha1.SyndesisPhaseUpgradeFailureBackoff,
+		syndesisv1alpha1.SyndesisPhaseUpgradeFailed, syndesisv1alpha1.SyndesisPhaseUpgradeQuarantined:
+		return priorityUrgent
+	}
+	for _, condition := range syndesis.Status.Conditions {
+		if condition.Type == syndesisv1alpha1.SyndesisConditionDegraded && condition.Status == corev1.ConditionTrue ||
+			condition.Type == syndesisv1alpha1.SyndesisConditionReconciled && condition.Status == corev1.ConditionFalse {
+			return priorityUrgent
+		}
+	}
+	return priorityNormal
 }
 
 // Reconcile the state of the Syndesis infrastructure elements
@@ -87,6 +245,7 @@ type ReconcileSyndesis struct {
 func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.V(2).Info("Reconciling Syndesis")
+	defer util.ObserveReconcileAPICalls(util.APICalls())
 
 	// Fetch the Syndesis syndesis
 	syndesis := &syndesisv1alpha1.Syndesis{}
@@ -113,21 +272,34 @@ func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (reconcile.Resu
 		return reconcile.Result{}, err
 	}
 
-	for _, a := range actions {
+	reconcileActions := actions
+	if syndesis.GetDeletionTimestamp() != nil {
+		reconcileActions = finalizerActions
+	}
+	for _, a := range reconcileActions {
 		if a.CanExecute(syndesis) {
 			log.V(2).Info("Running action", "action", reflect.TypeOf(a))
 			if err := a.Execute(ctx, syndesis); err != nil {
-				log.Error(err, "Error reconciling", "action", reflect.TypeOf(a), "phase", syndesis.Status.Phase)
+				log.Error(err, "Error reconciling", "action", reflect.TypeOf(a), "phase", syndesis.Status.Phase, "class", action.ClassifyError(err))
+				r.recordOutcome(ctx, request, err)
 				return reconcile.Result{}, err
 			}
 		}
 	}
+	r.recordOutcome(ctx, request, nil)
 
-	// Requeuing because actions expect this behaviour
-	return reconcile.Result{
-		Requeue:      true,
-		RequeueAfter: 15 * time.Second,
-	}, nil
+	// Requeuing because actions expect this behaviour, through the reconcile queue so that
+	// the periodic reconciles of steady resources don't hold back the urgent ones
+	r.queue.AddAfter(request, 15*time.Second)
+	return reconcile.Result{}, nil
+}
+
+// Records the outcome of the reconcile in the status, a failure to do so only being logged
+// as the actions did their job and the next reconcile records it again
+func (r *ReconcileSyndesis) recordOutcome(ctx context.Context, request reconcile.Request, err error) {
+	if recordErr := action.RecordReconcileOutcome(ctx, r.client, request.NamespacedName, err); recordErr != nil {
+		log.V(2).Info("Cannot record reconcile outcome", "name", request.Name, "reason", recordErr.Error())
+	}
 }
 
 func (r *ReconcileSyndesis) isLatestVersion(ctx context.Context, syndesis *syndesisv1alpha1.Syndesis) (bool, error) {
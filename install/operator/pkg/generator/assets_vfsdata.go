@@ -25,6 +25,17 @@ var assets = func() http.FileSystem {
 			name:    "addons",
 			modTime: time.Time{},
 		},
+		"/addons/broker": &vfsgen۰DirInfo{
+			name:    "broker",
+			modTime: time.Time{},
+		},
+		"/addons/broker/syndesis-broker.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-broker.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2831,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\x4d\x8f\xe2\x46\x10\xbd\xfb\x57\x94\x34\x87\xbd\xc4\xcc\xb2\x52\xe6\xd0\x37\xb2\x33\x91\x56\x59\xb2\x04\xef\x24\xc7\xa8\x68\x17\x4c\x8b\xfe\xda\xea\x82\x88\x20\xff\xf7\xc8\x60\x83\xcd\x02\xcb\x48\x51\xa4\xac\x9b\x83\xdd\xf5\xe1\xf7\x5e\x55\x97\xb9\xcb\xee\xe0\x7d\xf0\x9e\xb4\x98\xe0\x21\x72\x88\xc4\x62\x28\x41\x98\x83\xbc\x10\xcc\x38\x2c\x89\x7f\xe8\xdc\x83\x3e\xfa\x87\x39\xa4\x8d\x2f\x29\x99\x04\x26\x81\x66\x42\xa1\x12\xe6\x1c\x5c\x1d\xe1\xb2\xbb\x2c\x07\x8c\xe6\x77\xe2\x64\x82\x57\xb0\x1e\x66\x00\x4b\xe3\x4b\x05\x05\x69\x26\xc9\x00\x1c\x09\x96\x28\xa8\x32\x00\x00\x8b\x33\xb2\x69\x7f\x0f\x80\x31\xaa\xc3\x2b\x9a\xbd\xf6\x71\x60\xc2\xfd\xb7\xec\xb2\x89\xa4\xc0\xf8\x39\x63\x12\x5e\x69\x59\x31\x9d\x71\xd3\xc1\xc5\xe0\xc9\xcb\x31\x59\xbe\x67\xbe\x73\xf6\xe8\xe8\x9c\x25\x09\x1b\xbf\x78\xdc\x61\xdf\x6e\x73\x30\x73\x18\xfc\xb4\x33\x7e\x8a\xc4\x28\x81\xab\x6a\x97\x60\x9f\xeb\x99\xad\x02\xd1\x51\xdd\xdf\x9f\xe4\xca\xd1\xda\xfc\x6d\x9e\xd6\x5a\x3d\x0c\x1f\x86\x0f\xbb\x6c\x64\x13\xdd\x18\xdf\x8d\xf2\x65\x13\xb4\x4a\xc4\x7b\xe4\xdb\xed\xa0\x68\x02\x06\xa3\xb2\x0c\x3e\x35\x30\x07\xcf\x89\x5a\x8c\x11\x53\xfa\x2b\x70\x79\xcd\x7d\xd2\xf8\x54\xd5\x65\xbe\xfd\x82\xef\x99\x0f\xd0\x7d\xa9\xcb\xb1\x7e\x87\x36\xbe\xe0\xb1\x09\x46\x5a\xcc\x9a\xc6\xbf\x8d\x58\xc8\x99\xf4\xff\xee\x86\x48\x7a\x8f\x14\x4b\x67\x7c\x2d\xed\x8d\xd2\xef\xfc\x27\xaf\xd3\xbf\x7e\x4f\x49\xd1\x86\x8d\x23\x2f\x13\x8b\xbe\x55\x29\x99\xbf\x49\xc1\xb0\x79\x62\xfa\xb2\x32\x4c\x1f\xc3\xc2\x78\x05\xc2\xab\x96\x71\xac\x4b\x94\x84\xbc\xa6\x27\x8f\x33\x4b\xa5\x82\x39\xda\xb4\xb7\xa3\xd6\x14\x25\x70\xa3\x7d\xde\xb0\x46\x6b\xdb\x70\x0e\x12\x74\xb0\xa9\xb7\x19\x58\x14\x7c\xd5\xc2\x57\x66\x00\xaf\x8d\xa6\xef\xa4\xec\x31\xb0\x5c\xd6\xab\x23\x4d\x5f\x41\x05\x9f\xdf\x4f\x1a\x2f\x41\x5e\x90\x4c\x4e\x7c\x13\x59\xd2\x12\xf8\xdf\x12\xe4\x0a\xd3\x7e\xa9\x30\xc6\x74\xdf\xa9\xd7\xe3\xa1\xe1\xbe\x93\x92\x31\x45\x6b\x34\xa6\xf6\xbc\x9c\x2a\xed\x50\xf4\xcb\xc7\x1e\xb5\xf3\xe4\xbe\x4d\xef\x55\xc8\x85\x5c\xb4\x28\x74\xc0\xd1\xd3\xfa\x6b\xbd\x2f\xc3\xba\x05\xd8\xcd\xda\xbf\x92\x45\x57\xe9\x7a\xe9\xe0\x05\x8d\xa7\x76\xaa\x74\x4f\x4a\x2f\xac\xfe\x19\x87\x0b\x52\xf0\xe6\xf2\x34\xfc\x50\x7b\x54\xd5\x9b\x4e\x10\xf9\xf5\x31\xf5\x31\xf9\x68\xfa\xf9\x69\xfc\xa1\xf8\xf3\xb9\x78\x9a\xfe\x3a\x1a\x3f\x75\x5c\x00\xd6\x68\x57\xf4\x33\x07\xd7\x8d\xac\x57\xda\xfd\x43\xf9\x85\x36\x53\x9a\x9f\xda\x2e\x37\x57\xff\x5a\xd2\x46\x1d\xbe\xc4\x57\x90\x4d\x46\x45\xf1\xc7\xa7\xe9\xe3\x7f\x8c\xac\xfd\xe8\x77\xac\x9d\x29\xd6\x42\x3d\xd4\xed\x74\x30\xb5\xd7\xe9\xb0\xab\x17\x13\x96\xc6\x53\x4a\x13\x0e\xb3\x43\x23\x37\x63\x4e\xc7\x22\xe8\x25\x49\x7f\xfb\xdc\x94\x6c\x9a\xc1\x1b\x31\x68\x1f\xc9\xe2\xa6\x20\x1d\x7c\x59\x1f\xd9\xb7\x1d\x1f\xa6\x14\x56\xac\xa9\x07\x1d\xc0\x1a\x67\xfa\x74\xea\xe5\xc8\x05\xde\x28\xf8\x71\xf8\x6e\x6c\xb2\xed\x36\x07\xf2\x65\x55\x65\xff\x0c\x00\x56\x0e\xd0\x07\x0f\x0b\x00\x00"),
+		},
 		"/addons/camelk": &vfsgen۰DirInfo{
 			name:    "camelk",
 			modTime: time.Time{},
@@ -34,21 +45,21 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 46563,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5d\x4d\x93\xe4\x36\x72\xbd\xcf\xaf\x60\xac\x2e\xbb\x11\xcb\xf2\x8c\x34\x92\xec\xb9\xd9\x0a\x1f\x1c\xeb\xb5\x37\x62\x15\xb6\xaf\x28\x32\x8b\x44\x17\x08\xa0\x01\xb0\x3e\x5a\xa1\xff\xee\x20\x09\x92\x20\xab\x3a\x49\x20\x7b\x75\xd1\x74\xe1\xe5\xc3\x43\x02\xc4\x47\x02\x20\xbf\xcb\xfe\xeb\xbf\x7f\xfd\xf7\x6f\xd9\xaf\x35\xb7\xd9\x89\x0b\xc8\xb8\xcd\x24\x40\x09\x65\x76\x52\x26\xfb\x85\x35\x20\xb2\xbf\x64\x9f\x0f\x3f\x1c\x6e\x7f\xce\x5a\x6d\x9d\x01\xd6\x64\x06\x04\x30\x0b\x36\x53\x52\xdc\x0f\xd9\xdf\x8c\x2a\xdb\xc2\xcd\x3f\x17\xaa\x81\xec\xca\x5d\x9d\x69\x03\x5c\x5a\xc7\x84\x80\x72\xa0\xfb\x85\x39\x26\x54\x75\xf8\xf4\xdd\xa7\xef\xb2\xe1\xbf\xff\xad\x41\x66\xad\xae\x0c\x2b\xb9\xac\x7c\xae\x17\x30\x96\x2b\x99\x71\x99\xfd\xfd\x2e\x4b\xb0\xdc\xfe\x39\x73\x9d\x50\x5b\xab\x56\x94\xd9\x11\xb2\xa2\x66\xb2\x82\x32\x63\x45\xa1\x4c\x67\x2b\xee\x87\x89\xf5\xd7\x1a\xb2\x42\x49\x07\xd2\x65\xea\x34\x98\x8e\x65\x64\xad\x53\x0d\x73\xbc\x60\x42\xdc\xb3\x0a\x24\x18\xe6\xa0\xcc\x8e\xf7\x4c\x1b\xf5\x02\x85\xcb\xb8\x3a\x58\x9f\xef\x81\x4b\x07\x95\x61\x8e\x2b\xf9\x2d\xf8\x77\x6e\x5a\xe9\x78\x03\x79\xd1\x29\x3e\x4f\x39\xff\x51\x28\x75\xce\xb8\x74\x2a\x73\x35\x64\x8e\x99\x0a\x5c\x56\x72\x03\x85\x53\xe6\xfe\xa7\xc3\xa7\x4f\xbf\xfd\x96\x67\xfc\x94\xfd\x11\x5e\xb3\xc3\x58\xbc\xc3\xbf\x96\xa5\x92\xf6\xd0\x97\xff\x2f\xc3\xff\xfe\xc7\x7b\xe1\x0f\xdf\x1f\xbe\xff\x72\xf8\x7c\x38\xb5\x16\xf2\x9f\x7f\xfa\xfc\xf9\xcb\x97\x3f\xfc\x29\xfb\xfd\xf7\x4f\x4c\x73\x8f\xf9\x96\xf5\x32\x0e\x4c\xb3\xa2\x86\x83\x32\xd5\x3f\x5d\xbe\x30\xa1\x6b\xf6\xe5\xd3\x99\xcb\xf2\xdb\xc2\xff\x9f\x1a\x70\xac\x64\x8e\x7d\xfb\x94\x65\x92\x35\xe0\xcd\xf3\x62\x48\xcf\x1f\x33\xfc\x94\x65\x82\x1d\x41\xd8\xce\x24\xcb\x98\xd6\xa3\xcd\xb9\xff\xe1\x21\x7b\x4f\x75\xb8\x8c\x02\x9f\x72\x22\x86\x42\xb1\x12\x0c\x6a\x6f\x35\x14\x9d\x1e\x3c\x0f\x66\x1c\x3f\xb1\xc2\x79\xe9\x83\x6a\x56\x17\xc3\x9f\x59\x56\x19\xd5\xea\xff\x28\xbf\x65\xca\x54\xa3\x90\x1e\xe4\xd3\x47\xfb\x0e\x32\x19\xfb\x34\x5b\xd4\xd0\x80\x1d\xa9\xf2\x8c\x97\xdf\xb2\x39\x39\xcb\x6a\xe7\xf4\xb7\xcc\x99\x16\xa6\x9f\x34\xb3\x96\x5f\xe0\x5b\x76\x62\xc2\xc2\x52\x53\x7e\xb5\x24\x59\xf9\xd5\x6e\x28\x9b\x11\x7b\xc5\x2d\x8d\xd7\xd6\x21\x0e\x2b\x5b\xf3\xaa\x09\x25\x6b\x5e\x35\x5a\xae\x39\x3d\x56\x97\x96\x14\x8f\x6b\x89\xfb\x7b\x4e\x8f\xd5\x65\xe5\x17\x82\x2e\x2b\xbf\xf8\xc4\xee\x31\x3f\x29\xd3\x30\x17\x68\x9b\xd2\x47\xb8\x03\xc3\xed\x99\x92\xe1\xc0\x80\x3a\x63\x89\x89\x75\x88\x6b\x14\xa5\xa6\x3a\x73\x54\x5d\x00\x48\x91\xa6\x6b\x30\x90\x5f\xe1\x68\x55\x71\x06\x47\x54\xba\x62\xdb\x14\xfe\x1c\x1f\xd9\xfd\x38\xd5\x50\x64\xab\x06\x97\x39\xa5\x47\xbb\x57\x35\xfc\x46\x53\xc6\x6f\x5b\xda\xf8\x2d\x6f\x58\x74\x27\xb2\x66\x00\x6b\x59\xc5\x65\x45\xe5\x69\x85\xe3\x74\x39\xaf\x2d\x04\x15\x9f\xc6\x61\x1f\x5a\x53\x2c\xc3\x85\x89\x78\x15\xbe\xea\x2e\x46\x11\x2a\xfe\x62\x14\x5a\xed\x73\x7a\x44\xd9\x9e\x77\xa8\x23\x95\xcf\x99\x34\x8c\x6f\x8c\xe1\x57\x9b\x17\xd7\xe4\x3a\xb9\xda\xbc\x2c\x8f\x44\xf3\x61\x29\x40\x21\x81\xe2\x7b\x8a\xf9\x99\xcb\x6e\xd6\xfc\x01\x14\xf9\x89\x1b\xa8\x95\x4d\x7f\x4e\x3a\xae\x86\x24\x45\xb0\xe6\x58\x32\x0a\x43\xf3\x4a\xb1\xb6\x3f\x90\xac\x69\xcd\xc9\x02\xc9\x77\x36\x7e\x86\xb5\x30\x7f\xa5\x99\x5f\x4f\x89\x3d\xdb\x5b\x6b\x80\xd0\x47\x74\xe6\x68\x2f\xd1\x01\xf2\xa3\x50\xe9\x75\xd3\x33\x24\x0d\x21\x83\xc6\x23\x33\x85\x2a\x09\x85\xf4\x04\x58\xbf\x1b\x42\x46\x23\x0b\x3f\x7d\xa5\x64\xda\xd9\xe3\x79\x4e\x08\x6f\x02\x4c\x76\xa3\x1c\x2f\x99\x53\x86\x90\xf5\x82\x07\xab\xdd\xa7\xc8\xd8\xea\x01\x26\x39\x61\x74\x1d\xec\x51\x47\xcd\x88\xd9\xc4\x3a\x26\x08\x0b\x8d\x89\x62\xcb\x3d\x21\x28\xd6\x33\x5c\x96\x77\x82\xc4\xce\x1c\xf5\x4b\x07\xc8\x0b\x7b\x59\xfd\x72\xe2\x37\x28\x57\xbf\x9d\x2f\x3a\x54\xa6\x24\x1f\xa2\x36\x89\xd2\x7a\x7b\xd4\x75\x21\x22\xd6\x6f\x4a\x49\x8a\x36\x25\x51\xaf\x8d\xe9\x23\x9c\xb0\x24\x38\x2a\x74\x3d\x30\x27\xc7\x7a\xc0\x30\x2e\x9d\x01\x4a\xa7\x37\x52\xa0\x02\x57\xa0\x48\x99\x45\xa7\x23\x5d\x62\x6f\x8e\xc9\x0b\x01\xd1\xd2\x4e\x27\xe0\x92\xa4\x6e\x60\xc0\x05\x0e\x98\x3c\x49\xe9\x03\x8b\x50\xac\x24\x95\xd9\x5a\x26\x4b\xc3\x5e\x05\xa5\xd8\x13\x09\x5a\xf2\x29\x39\x5e\x24\x69\x70\x1b\xec\xb1\x07\x3c\x40\x78\x93\xda\x28\xc9\x0b\x41\x69\x0c\x23\x05\xea\x93\x11\x94\x83\xac\xe6\x96\x13\xeb\xa0\xba\x95\x84\x81\xad\x37\xc7\x55\xce\x80\x58\x69\x4d\x6e\x1b\xc2\x8a\x74\xb0\x47\xc5\x85\x88\x68\x75\x9c\xa4\x8d\x6f\x28\x8b\x5f\x26\x7a\x66\xc5\x08\x21\xeb\xce\x1a\xd5\xa5\xe2\xc3\x3b\x23\x73\x03\xae\xa4\x28\xeb\xec\x71\x6d\x01\x22\xbe\x5b\xec\xad\x93\x9d\x2e\x6d\x4b\xe9\x06\x7b\x7b\xbc\x70\x01\x22\x5e\x9d\x83\x1b\x21\xb8\xeb\x09\x36\xf4\x05\x90\x68\x81\x94\x55\x65\x67\xbd\x35\xaf\xde\xa7\x2b\x88\x38\x7b\x53\x2e\xcb\xdd\x71\xd1\x47\x6b\xa3\xae\x7b\x23\x34\x0f\xc6\x85\x60\x7b\x77\xad\x1e\x6d\x95\x74\x46\x89\x63\x9b\x4a\x30\x8f\x74\x04\x82\xdd\x31\xd8\x47\xeb\x7e\xdf\x99\x64\x9c\x5f\x76\x06\xfa\x1e\xec\x4f\x5c\x24\xcf\xad\x04\x93\x55\xcb\xaa\xd4\x3a\x17\x2a\xb5\xa9\x35\xaa\xd8\x39\xca\x3e\x98\x6a\xa3\x34\x18\xc7\xf7\x06\xb4\x1e\x08\x0c\xec\x8c\x26\x3d\xb1\xb4\xb1\x9b\x3e\xa1\x69\xce\x34\x4f\xad\x29\xcb\xaa\xe4\xd0\x65\xd7\xcb\x94\xad\x80\xe8\x10\xc6\x44\x00\x7b\xe3\xa6\x0f\x2e\xb3\xae\xdd\x19\x1b\x7b\x30\x75\x8f\xde\xde\x6d\xca\x9b\xf4\xd2\x46\x46\x7c\x1e\x32\x4f\x7e\x92\x6f\x56\x24\xec\x02\x8d\xcf\xf0\x2a\x24\x34\xfd\xd1\x8d\xc4\x8e\x49\x37\xfd\x00\xb7\xe1\x50\xcf\xdf\x86\x07\xe9\x3e\x25\x04\x3d\x49\x9e\xd5\xc0\xca\xc9\x87\x79\xf0\xd0\xe4\x99\xe5\x8d\x0e\x90\x4e\x9d\x41\xf2\xb7\xf9\x87\x9b\x66\xae\x9e\xff\x5a\xa5\x3f\x5d\x95\x54\x6f\x5c\xcf\xfc\x60\x38\x13\xfc\xad\x3f\x01\x34\xff\xea\xcc\x3c\xb4\xe5\xd9\x68\x30\x8e\xa9\x6d\x51\x1f\x99\x25\x0d\xcb\x9e\x02\x9f\x36\x2c\x41\xd1\x13\x87\xb6\xa8\xcb\x23\x51\xe4\xb4\x13\xf0\xbe\xc4\xf8\xcd\x02\xcf\x6f\xee\xda\x11\xc2\x95\x83\x3d\x2a\x2f\x44\x50\x37\x04\x17\x64\x79\xa6\x2b\xfd\x50\x96\xbc\x20\xad\xc9\x26\x8e\xed\x32\x05\xa8\x58\xaf\xdb\x0b\x41\xe2\x14\xe1\x7c\xee\x22\x9f\xec\xc1\xb7\x13\x21\xa7\xdb\x09\xf5\xc2\x6d\x3d\xae\xee\x1d\x1c\x8b\xdb\xc9\xc4\x9e\x97\x1a\x24\x95\xbc\xe2\x8e\x09\x55\x00\x23\x04\x45\x43\x16\xac\x84\x4f\x70\x91\x35\x5d\x72\x6b\x5a\x4d\x0a\xf1\x4c\x14\xb8\xd2\x25\x28\x7a\x10\x9c\x08\x76\x4f\x4c\x1f\x8a\x4a\x39\xf2\x55\xe2\x27\xbe\xca\xd4\x03\x5f\xa5\x2a\xce\x40\x71\x7e\x6f\x8f\x4a\x0b\x11\xd1\xea\xde\x68\xe2\xde\xb6\xb4\xbd\x25\x4b\x33\x5c\x10\x02\x06\xbd\x39\x2a\x2d\x00\x44\x4b\x53\x9a\xb4\x4f\xe1\x09\x70\x79\x21\x24\x52\x20\xd4\xc4\xad\x00\x4f\x80\x09\x5c\x42\x62\x05\xbe\x10\xa6\x24\xf0\x82\x4e\x47\xe6\xe4\x58\x51\x82\x59\xc7\x0b\x0b\xcc\x14\x35\x41\x5e\x48\x83\x0a\x7d\x02\xa4\x48\xce\xbb\x25\xdf\x07\xe9\xce\x83\x95\xe7\xb6\xf8\xfc\xc9\x3a\x35\xa5\x04\x3f\x7e\x90\xfa\x1f\x77\x2b\xff\x31\x55\xb5\xa5\x6c\xea\xf4\xe6\xb8\x46\x9b\xba\xa1\x03\xae\x20\x04\x91\x3b\x6b\x54\xd8\x9c\x1e\xab\xeb\x02\xd2\xb1\xb2\xe1\x84\x69\xd3\xcc\x81\x6a\x5c\xa3\x62\x95\xde\x80\x70\x89\xa0\xb3\x46\xd5\xcd\xe9\x91\xba\x4e\xac\x80\xa3\x52\x84\xcd\xa8\x91\x01\xd3\xb7\xc2\x44\x6b\xb4\xee\xc5\x52\xce\x0b\x8c\x0c\x1e\xf0\x74\x89\xd1\xa5\x2f\x81\xde\xb6\xe6\x84\xd9\x4c\x67\x8d\x7a\x66\x4e\x8f\xf5\x8a\x60\x4e\xb3\x82\x52\x73\x9e\x01\xd5\xb7\xc4\x50\x97\xba\x0b\xba\x51\x05\xa7\xec\x85\xf6\xe6\x78\x09\x78\xea\x5e\xe8\x49\x11\x36\xf5\x4e\x0a\xdd\xd3\x9b\x93\x63\x45\x19\x80\x86\x19\xd2\x02\x60\xe6\x40\x25\xae\x51\xb1\x4a\x1d\xc5\x7d\x0e\x77\x9f\x4b\x3e\xf0\x7e\x72\x3a\xf9\x1c\xa7\x4d\xc8\x77\x28\x4f\xc5\x64\x25\x38\xe1\x3c\x96\x27\xc0\x9c\xb2\x84\xc4\x0a\x04\x55\xa8\x92\xd2\xaa\x46\x06\x54\xe2\x12\x13\xab\x91\x13\xe6\xa2\x15\x47\x27\x9f\x73\x72\xbc\xa8\xba\x25\x2c\x3d\x06\xfb\x0d\x69\xf5\xde\x0d\x88\x07\x75\x4a\x55\x02\xf2\x23\xaf\x5e\x5b\x30\x84\xa3\x8a\x2b\x22\x54\xef\x53\x68\x9a\xf0\x82\x09\x90\x25\xa3\x34\xcb\x25\xd1\x0e\xe1\x2b\x68\x74\x2f\xb1\xa2\xc9\xd3\xae\x40\x2c\xd4\x97\xa6\x4b\xa5\xfa\xa0\x67\xd9\xe1\x80\x10\x97\xa6\xb7\x61\x9c\xb0\x96\x09\x48\x76\xa8\x0d\x60\xa9\x55\xd5\x51\x7c\x44\x35\xe9\xf6\x68\x49\x7d\x41\x48\xb3\xa3\xe8\x0b\x60\x9a\x64\x5b\x03\x38\x42\x90\x73\x41\xb3\x43\xf2\x02\x98\x5a\x5f\x03\x09\xb1\xc6\x0c\x65\x34\x56\x06\x1f\x8a\xe7\xf4\x58\x5d\x86\x69\xca\x93\xde\x99\xa3\xca\x02\x40\xb4\x34\xa5\x2e\x94\x21\xa4\xb7\xf7\xc9\x4f\x76\x7c\x83\xf4\xd1\x40\x13\x56\xcd\x9d\x35\xee\x09\x9d\x76\xf9\xbe\x22\x2d\x46\xab\x3d\x0b\xd1\x6a\xb9\x08\xad\x5a\x76\x61\x43\xa8\xe2\xd8\x52\x1e\xd6\x05\x0f\xea\x9c\x67\xc8\xc8\xf6\x52\xb3\x37\x10\x05\xa3\x04\x10\x27\x0a\x4c\xeb\x04\x1a\xee\xef\x16\x49\xb7\x48\x1f\xc9\xfa\x17\x92\xc8\xe2\x03\x98\x04\x8f\x0f\x65\x3e\xb2\x10\x2e\xf9\x06\x24\xc4\xeb\xc2\x33\x13\xe9\xc6\xf0\x4c\x63\x40\x0b\x5e\x30\x07\xe5\x87\xa8\x32\x5c\x56\xc7\xf6\x74\x4a\x3f\x39\x33\x73\xed\x3f\x31\x84\xb3\x7c\x40\xdd\x3b\xa5\x79\x62\x88\x8f\x78\xa2\x64\xf3\x34\x09\xe1\x24\x49\x5d\x9e\x08\x9d\x59\x67\x8d\x0a\x9b\xd3\x13\x74\x7d\x4f\x13\xf6\xfd\x96\xb2\xe8\x3b\xcd\x9e\x1b\xac\xe5\x94\x63\x02\x9e\x00\x1b\x81\x42\x88\x37\xe2\xba\xa8\x19\xa5\x1f\x1f\x08\x50\xa7\x2c\x20\xb1\x6e\x11\x3f\x13\xc4\x89\x9f\xdf\x9f\x94\x38\x30\x16\xd0\xcb\x28\xa3\xb9\x27\x73\x94\xb0\x57\x67\x8d\x3a\xc9\xa5\x07\xbe\x3a\x78\xea\x03\xe1\x9c\x26\x5c\x94\xed\xcd\xb7\x8a\xf5\x95\x54\xae\x68\xeb\x41\x19\x3f\x32\x47\xb9\x52\x32\xd8\x63\x45\x5b\x20\x62\xd5\x15\x8c\xb0\x8a\xee\xac\xb1\x76\x3b\xa5\x7b\x38\x14\x3f\x7d\xfe\xe7\x9f\x3f\x13\x32\xf4\x0c\xa8\x3b\x3c\x26\x2f\x04\x07\x99\x3c\x32\x4e\x34\x16\xcc\x25\x35\x9c\xc8\x2b\xc9\x1d\x61\x68\x1c\xec\xd1\xd2\xf6\x08\xda\xd5\xc1\x91\x43\x35\xba\x75\x54\x96\x7e\x42\x9f\xbc\xfa\xf6\x24\xbc\xac\x20\x7a\x1f\x74\xc5\x41\x7e\xfd\x8d\xe7\x21\xcd\x42\x3d\x47\xc2\x0c\xcd\x37\x00\x79\xe2\x92\x5b\x4d\x19\x8f\x67\x0e\xb4\x21\xad\x51\xf1\x4a\x45\x7b\xa3\x1c\xda\x1d\x19\x36\x54\x86\x98\x58\x8d\x9a\x32\x1b\xec\xac\x51\x6d\x3a\x75\x36\xc8\x0d\x21\x08\xc1\x0d\x1a\x83\x98\x93\xa3\x45\x29\xd9\xbc\x52\x74\x75\xf6\xb8\xb4\x00\x11\xa9\xee\x85\x15\x67\x52\x90\xc4\x13\x60\xc3\x57\x1f\x27\x09\x71\x0b\xcb\x5b\x43\x18\x39\x67\x0e\x54\xc0\x12\x35\x9a\x5e\x98\xd5\xac\x20\x0c\x2b\x13\x05\x56\x3b\x6b\x50\x74\x05\xdd\x08\x7d\x41\x67\x8d\x7b\xc6\xa7\x7b\x38\xf1\xe4\xe0\xcb\xe6\xc1\xc1\x17\xc2\xb9\xc1\x97\x42\xa8\xb6\x24\xf4\x3c\x9e\x00\xd7\x17\x42\xa2\x05\x12\x76\xbf\x5e\x0a\x74\xc7\x6b\x4e\x8e\x15\x55\x1e\x09\xbd\x62\x67\x8d\xca\x9a\xd3\x63\x75\x81\x73\xf7\x7f\x21\x28\xeb\xed\x51\x6d\x1d\x62\x25\x6e\x5f\xdc\xf8\xa5\x97\x43\x69\x69\x03\x01\xaa\x6e\x01\x89\x75\x1e\x3f\x12\x4e\x20\x77\xd6\x68\xaf\x30\xa6\x8f\x70\x59\x51\x32\x9b\xa6\x8e\xcf\xfd\x90\x30\xb5\x1c\x99\x29\x1b\x43\x9d\x35\xae\x2b\x75\x63\xe8\x85\x72\x05\xe8\x05\xbf\xfb\xf3\x92\x7a\xe9\xe7\xa5\xa1\x34\x98\x06\x3d\xae\x3e\x27\xc7\x8a\x52\xb5\x7c\x23\xcd\x3e\x06\x82\xed\xd9\x47\x80\x1b\x2d\x05\x21\x4a\xd6\x59\xa3\x1e\x51\xf1\x77\x2b\x47\x66\xd2\xe1\xe2\xde\xdc\xa7\x3e\x89\x91\x8d\x89\x1e\xac\x29\xcf\x8f\xc6\x1f\x1f\x9d\xfa\xf4\x58\xca\xf9\xfb\xce\x1a\x93\x65\x8b\xc4\x63\x61\x7d\x33\xfa\x80\xf7\xc1\x2d\x79\x50\x0f\x3e\x43\x26\xa8\xd6\xcc\x91\x1c\x3a\x30\xbc\xdf\xa6\x16\x08\x6f\xe4\xbe\x7e\x26\x84\xa7\x7a\x73\xd4\x35\x01\x20\xd6\x23\x2d\x50\x1e\xaf\x16\x90\xa7\x0b\x16\x0f\xd7\x8d\xe8\xf8\xdb\x86\xdb\x6f\x2b\xa7\x9f\xa7\xaf\x17\xec\xdc\xfe\x3f\x9c\xdf\xcd\x7c\xcd\x35\x76\xb0\xa0\x41\x96\x20\x0b\xbe\x3a\x0d\x80\x96\x6d\xe3\x84\xc1\x3a\xc7\x1a\x98\xd8\xe1\xb8\x5d\xea\x07\xae\x0f\x57\x6f\xc1\x5c\x04\xb8\x6d\x8e\xc3\x19\x61\x99\x65\x86\x7c\xeb\xb4\x97\xcb\xf6\x0b\xbc\x77\xf9\xe2\x65\xba\x5a\xf9\x71\x8e\x98\x5e\x2e\xb3\xce\xec\xac\x9c\xd8\x71\xd9\x63\x97\xf0\x81\xeb\x69\x36\xde\x71\x1f\x93\xcf\xb2\x56\xff\x31\xad\x65\x9d\xe7\x9d\xed\x08\x7f\xec\x52\xdf\x31\x85\x59\xb0\xd3\x99\x30\xba\xf7\xe6\x58\x17\x1c\x02\x22\xbb\xe0\x33\x58\x67\x28\xbd\xb0\x27\x40\xe5\x2d\x20\xb1\x02\x25\x73\x7b\x4e\x71\x62\xf5\x32\x50\xa0\x12\x17\x90\xfd\x97\xd8\x3f\xae\x61\xca\x6e\x81\xfc\x75\xde\xca\xf4\xd2\x0d\x73\x9c\x50\x39\x9d\x39\x5a\xee\x00\x10\x5b\x31\xed\x11\x8c\x04\x07\x84\x05\xd6\xcc\x81\x8a\x5c\xa3\xa2\xb7\x2c\x66\x86\xbc\x50\xf2\xc4\xab\xbc\x61\xe9\xf7\x19\x02\xb6\x12\xb4\x50\xf7\x86\xb2\x3d\x14\xb0\x49\xd6\x40\x1f\x9e\xfc\x10\x32\x55\x7e\x08\x8f\x06\x63\xb9\x75\x20\x5d\x7e\x51\xa2\x6d\xfe\x41\xa4\x79\x21\x18\x4f\x7f\xcb\x7b\xc8\xad\xe2\x63\x86\x4f\x68\xc6\x23\x56\x5c\xc9\xdc\xbf\xa5\x4c\xc0\xc3\xbb\x2a\xd2\x98\xad\x6a\x4d\x01\x36\x7f\x6d\x55\xfc\xbb\x80\x9f\x30\x5a\x28\x0c\xe1\x7c\xf0\x82\xc9\x5c\x78\x01\x39\x2b\x0a\xd5\x7e\x4c\xab\xf6\x94\xc9\x54\x4a\x83\xb4\x35\x3f\xb9\xfc\xd8\x72\x51\xfa\x27\xf8\xa3\xe8\x12\x43\x38\xa2\xa4\xbc\xbb\xb2\xb3\xc6\x7a\xbc\x20\x3d\x5a\x17\x27\xbc\xe6\xa5\xb3\xc6\x75\xf1\xc4\xd7\xf1\x0b\x2e\xcf\x50\x52\xae\x1c\x8f\x0c\xa8\xbe\x25\x26\x56\x63\x5b\x00\xe5\xc5\xc8\x83\x3d\xaa\x2f\x44\x44\xab\x6b\x8e\x60\x5e\x48\x97\x57\x67\x0e\x5c\xe5\x0a\x15\xab\xf4\x8d\xd2\x04\xdf\xc6\x16\xf6\x34\x82\x38\x26\x0f\x60\xda\x85\x99\xad\x9b\x32\x94\x13\xbf\x9c\x32\xc1\xd0\x4a\x27\x7f\x30\xa4\xb3\x4d\xbf\xa9\xd9\xa4\x1f\x94\xeb\x6c\x13\x32\x7e\x5a\xcb\x4d\xb7\x88\xea\x0f\x5d\x6b\x66\xdc\xa2\xc2\xad\xa3\xdc\xb6\x1c\xec\xb1\x4a\x5f\x20\x22\x1b\x7e\x03\xce\xf0\x82\x30\x1f\xf6\x04\xa8\xbe\x05\x24\x56\x20\x17\x84\x37\xbc\x75\xd6\xa8\x34\x2e\x14\xf1\x84\x5a\x4f\x41\x39\x9d\xd6\x70\x49\x58\x69\x77\xd6\x78\x09\x65\xe2\x3a\xbb\xb3\x24\x1c\x8e\xee\xcd\xb7\x94\x25\x1e\x8e\x6e\x84\x20\x4c\x65\x3a\x6b\x54\xd8\x9c\x1e\xab\x4b\xc9\x4a\x51\x0e\x5f\x79\x02\x54\xdd\x02\x92\x26\x30\xaf\x0c\x27\x1d\xc9\x5f\xf2\xec\x90\xbb\x44\xa6\xa9\xfe\x81\xac\xf7\x87\x1d\x4a\xa3\x87\x31\x9f\xc3\xab\x23\x6c\x08\x76\xd6\xa8\xb6\x39\x3d\x56\x17\xe5\x6d\x8d\xcd\xf4\xb6\xc6\xe7\xaa\xa6\xe4\x58\x51\xad\x75\xb4\xa3\x42\x23\x03\x2a\x6f\x89\x89\xd5\x78\xa1\xc4\x18\x3b\x6b\x54\xdb\x25\x3e\xba\xf8\x74\x1f\x67\x22\xf2\xf9\xde\x89\x07\xdd\x3d\x01\xaa\xfd\x4e\x38\xea\x2e\x59\xc5\x29\x1f\x6d\x1d\xec\x31\x79\x0b\x44\xb4\x3a\xca\x15\xe9\xce\x1a\x57\x16\x1f\x9d\x08\x42\xad\x04\x61\xc1\x51\xa6\xe7\xca\x9e\x9c\x75\x8a\x91\x46\xbc\x0d\x33\x73\x6c\x8a\xcc\x9f\xdc\x8c\xd9\x77\x2a\x6b\x08\x57\x13\x45\xa2\xb7\x5b\x16\x88\x14\x37\x7e\xfd\x08\x3f\x7e\xdd\xe7\xc8\xaf\xe9\x9e\x54\x95\x24\x74\x8d\x9d\xf5\xfb\xfd\xd9\x94\xea\xc1\x82\xcb\x4a\x11\xa6\xa1\x9e\x00\x73\xc7\x12\x12\x59\x6f\x83\x31\xa1\x59\x79\x82\x6d\x81\x89\x0d\x6b\x0a\x1e\x12\x24\x8e\x14\xa8\xc8\x15\x28\x45\xa6\x23\x85\x8a\x26\x8a\x4d\x99\x1d\x28\x2f\xb8\x4c\x78\x41\xd1\x23\x4f\x25\x28\x97\xc7\x67\x9e\x33\xdc\xad\x53\xf1\xb1\xb6\x47\x26\x09\xad\x33\x2a\xf9\xe6\x4e\x40\xa4\x2e\xc9\xb1\xfe\x99\xc5\x5e\x09\xad\xc2\x31\x2d\x98\x94\x94\x08\x4a\x40\x82\xb7\x8c\x35\x2c\x52\xac\x66\x35\x21\x54\xd1\x59\x63\xf2\x82\xf4\x68\x5d\x37\xa1\xaa\x8a\x74\x0c\x77\xe6\xc0\x35\xae\x50\xb1\x4a\x4b\x42\x18\x56\x97\xe8\x46\xc0\x9c\x1c\x2b\xaa\xea\x2f\xd3\x11\x84\x0d\x04\xa8\xb8\x05\x24\x56\xa0\xe1\x92\x14\x62\xf4\x04\x98\x40\xa1\x53\x1f\x0a\xa3\x9c\x3a\xb6\x94\x7a\xf5\x0c\x1e\xf0\x34\xfa\xba\xc0\x78\xb3\xf6\x28\x29\xaf\x68\x1a\xec\xd1\x4a\x0b\x11\x91\x6e\x79\x6d\x99\x71\x6f\xe9\xea\x06\x7b\x4c\xdd\x02\x91\xa4\x8e\x30\xeb\xf2\x04\xdb\xfa\x12\x67\x5d\xaf\x2d\x2f\xce\x27\x4e\x38\x93\x3e\x32\xe0\x12\x17\x98\x48\x8d\x86\x1d\x8f\xdc\x51\xee\xee\x8d\x0c\x98\xc6\x15\x26\x56\x23\xb0\xc2\xf1\x0b\xf8\x37\x6c\x11\xd6\xbe\x6b\x26\x54\xf3\x73\x6c\xb4\x76\xeb\x72\x7b\x65\x55\x45\xe9\xfb\x42\x16\x5c\xf3\x03\x2e\x41\xef\x9e\xc3\x86\xa8\xd4\xf9\x80\xe1\xbb\x2a\xc5\xc3\x2d\xe7\x7d\xab\x3a\xd3\x10\x8e\x6d\x99\x06\x3d\xb4\x35\x27\xc7\x3a\x4d\xb5\x0e\x48\xdf\x4b\x18\x19\x50\x79\x4b\x4c\xac\x46\x4b\x79\x6e\x2c\xfe\xa8\xec\xfd\x4c\xe1\xe6\x06\xe5\xc8\x34\x64\x6b\x99\x00\x7b\x52\x86\x72\x7f\x75\xe6\xc0\x4a\xf0\x80\x8a\xf4\xae\x65\x3a\x97\xe0\xae\xc0\x2e\x94\xe7\x7c\x41\x83\xeb\x7d\x04\x46\x4b\xbe\x51\x6e\x25\xf5\xe6\x98\xc4\x5b\xd2\xeb\x56\x9f\x86\x5b\x02\x2a\x9f\x79\x97\x21\xeb\x96\x8f\x84\x02\x4c\x1c\xa8\xa3\xd7\xa8\x58\x2f\x17\x86\x6b\x42\x77\x3a\xd8\xbf\xef\x9a\x17\x76\x61\x7f\x0f\x31\x79\xa6\xeb\xf9\xbb\x6f\xfa\xee\xea\xe0\x83\x6f\xa6\x3d\x2e\xdc\x38\x9c\x20\x93\xea\x4a\x10\x38\x71\xa0\x6e\x5c\xa3\x62\xdd\xb8\xf3\x0c\x3c\x2a\x73\x63\x58\x5a\x42\xe2\x86\x25\xcb\x09\x01\x51\xcb\xd1\x40\xe8\x9c\x1c\x7f\x76\x84\xa7\x5e\xbe\xb5\xa4\x0b\x9e\x76\xe3\x86\xa7\x4d\xb8\xe2\x19\xda\xe6\x47\xe6\x52\xbf\x28\xd3\xd9\x13\x16\x0d\xbd\xf9\x56\xd9\x12\x17\x0c\x56\x90\x42\x8b\xbd\x39\x2a\x4d\x24\x9f\x3d\xb3\x8d\xa6\xb4\xf0\x46\xe3\x4d\x7c\x4e\x4f\x38\x1f\xa5\x93\x1b\xb9\x64\x67\xd8\x75\x3b\xe5\xfd\x92\x8d\x14\xd8\xac\xa6\x4b\x5f\x21\x47\xeb\x86\xe2\x55\xd9\xe0\x5e\x9d\xd3\x63\x1d\x43\xfa\x82\xbc\x9d\xbf\x10\xff\xd4\x1d\x5d\xfa\xea\xa5\x1c\x56\x09\xca\xb4\x49\x09\x7c\xb6\x34\xa7\xc7\x37\x2f\x25\xd2\x0f\xa7\x77\xc6\xbf\x08\xd5\x26\x7e\x51\xc8\x6a\x66\x28\xfd\x41\x67\x8e\xfa\x25\x00\xa4\x48\x23\x7e\x8f\x6b\xe6\xd8\x14\xf9\xec\xfb\x5b\x3b\x47\x66\x2d\x5a\xca\x27\x5d\x06\x7b\x5c\x60\x80\x88\x76\xa3\x21\x45\xbc\x07\x7b\x5c\x5d\x87\xc8\x93\x22\xb7\xcf\xaf\xd7\xeb\xc5\x59\x0e\xcf\xdf\x0f\xc8\xd4\x72\x2c\x86\x75\xac\x34\x94\xe1\x7f\x60\xe0\xd2\x41\x65\xfa\x5b\x28\x64\xd5\x01\xd7\x0e\xed\x8f\xe8\xb4\x12\xd0\x6e\x4a\x04\x24\x3b\x34\xa7\xdf\x9b\xf0\x04\x06\x4a\xca\x79\x9f\x90\x65\x87\xdc\x10\x97\xa6\xf7\x4a\x17\x7b\xdd\xa3\xf4\x9a\xf6\x8d\x5e\xd2\xeb\x33\x36\xbe\xcc\x97\xf0\x5d\xbe\xc0\x34\xb7\x4e\x19\x48\x1d\xef\x2c\xa5\x0b\xb1\x78\xcf\x61\x53\x3b\x0c\xc7\x08\x81\xbd\xce\x1a\x95\x35\xa7\x47\xeb\x52\xa4\xc9\x63\x67\x8e\x2b\x53\xc9\xd3\xc7\x3e\x62\x4e\xd1\x16\x7c\x49\xe3\x1d\x71\xe9\xdf\xda\x18\xbe\x7a\xef\xa0\xd1\x82\x51\x5e\xb8\xba\xe4\xd9\x50\xdb\x3d\xec\x2b\x68\xac\xec\xbb\x15\x8a\x32\x55\xe8\xed\xd1\x79\xf9\x8c\x18\x4c\x1c\xab\xac\x6a\x09\x8d\xcc\x13\x60\x99\x3a\x5e\xde\xff\xca\xcc\xb9\xd5\x8b\x8c\xcd\x89\x0b\x42\xdd\x78\x02\x34\xe3\x00\xe2\x8d\x40\x74\x23\x33\xa1\xe1\x8e\x0c\x58\x63\x58\x61\x22\x5b\x81\xab\x0d\xe9\x94\xd4\x60\x8f\xea\x0b\x11\xd4\x78\x7e\x40\xe6\xf3\xe7\x94\x37\x36\x74\xd6\xa8\x76\x9e\xfa\xbe\x06\x77\xe5\x82\x13\x0e\xc6\x0c\xf6\xa8\xb6\x10\x11\xaf\xce\x91\x0e\x4f\x78\x82\x0d\x7d\x2e\xe1\x86\xd6\xca\x3c\x2f\xb9\x81\xc2\x0d\x2f\x54\x4e\x3e\x25\x36\x92\xa5\x7d\x39\xfa\x81\xa5\x1f\x2c\x08\x6f\x77\x1e\x89\x1c\x6f\x40\xf0\xd4\x8b\xa6\xad\x2c\xc1\x38\x4a\xac\x7d\x64\xc0\xaa\x71\x85\x89\x9b\x57\xb6\x92\x5f\x54\xc1\xdd\x3d\xd7\xcc\x58\x30\x84\x59\xf0\x03\x15\xd6\x4d\xcc\xe0\x62\xba\xad\x11\xfe\x7a\xe2\xb7\x69\x4e\x19\xfe\xee\x3c\x7a\xc8\xf2\x02\xa2\xff\x39\x5d\xf4\xc8\x80\x39\x78\x85\x89\x6c\x04\x17\x30\x8e\x30\x9d\xec\xcd\x71\x75\x33\x20\x52\xda\x15\x98\xab\x29\x9d\x8c\x27\xc0\xe4\x2d\x21\xd1\x02\x8f\x56\x15\x67\xca\x36\xd0\x44\x81\x8b\x5c\x82\xe2\x9e\xa1\xab\x32\xa5\x36\x40\xd9\x6e\x9f\x28\x50\x95\x2b\x50\xa4\x33\x6f\x45\xcd\x64\x45\x98\x62\x79\x02\x4c\xe2\x12\x12\x2b\xb0\x11\x47\x60\x92\xe0\xc6\x91\x01\xeb\x79\x6e\x8d\xf8\xb7\x09\x33\x99\xd1\x3e\xf0\xed\x09\x36\xb2\x5d\x7d\xd9\xfb\xd6\x08\x0b\x45\x6b\x48\xfd\x57\x40\x82\xd6\xcb\x03\x8c\x3a\xcf\xeb\xe9\xe0\xff\xfe\xfa\x9f\x8b\x12\x51\xb6\x8c\x6e\x1b\x5b\x46\xb7\x84\x2d\x23\xcf\x4c\x5d\x9e\xde\x16\xab\xcf\xf7\x5f\xc8\xba\xc4\xe5\x59\xf8\xf7\xc0\x74\x67\x4d\x43\xe9\x71\x07\x7b\xcc\x4d\x0b\x44\xa4\xa3\xde\x40\x96\x60\x09\xb1\x73\x4f\x80\xe9\x5b\x42\x62\x05\x72\x4d\x5b\x26\x7a\x02\xac\x26\x43\x88\x37\x52\xea\x0c\xa0\x29\xf5\x36\x51\xa0\xae\x59\x81\x62\x9d\x33\x9a\x93\x5f\xee\xb0\x66\xda\x25\x3a\x8f\x7f\xe1\xc3\x6f\xbf\xe5\x19\xc8\xf2\xf7\xdf\x3f\xfd\x7f\x00\x00\x00\xff\xff\x2b\x3c\xfc\x21\xe3\xb5\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x9d\x41\x93\xe3\xb8\x75\xc7\xef\xfd\x29\x58\xde\x8b\x5d\x65\x76\x66\x66\x67\x77\x92\xb9\x25\x5b\x39\xa4\x1c\x27\xae\xf2\x56\x92\x2b\x44\x3e\x51\x68\x81\x00\x1a\x00\xd5\x52\x6f\xed\x77\x4f\x81\x04\x45\x90\x52\x3f\x12\x78\xb2\x2f\xde\x69\xfc\xdf\x1f\x3f\x3c\x50\x20\x08\x82\xe4\x0f\xc5\x7f\xfd\xf7\xaf\xff\xfe\xbd\xf8\xf5\xc0\x6d\xb1\xe7\x02\x0a\x6e\x0b\x09\x50\x43\x5d\xec\x95\x29\x7e\x61\x2d\x88\xe2\x2f\xc5\xa7\xe7\x1f\x9f\xcf\x7f\x2e\x3a\x6d\x9d\x01\xd6\x16\x06\x04\x30\x0b\xb6\x50\x52\x5c\x9e\x8b\xbf\x19\x55\x77\x95\x9b\xfe\x5c\xa9\x16\x8a\x37\xee\x0e\x85\x36\xc0\xa5\x75\x4c\x08\xa8\x07\xbb\x5f\x98\x63\x42\x35\xcf\x4f\x3f\x3c\xfd\x50\x0c\xff\xfb\xdf\x03\xc8\xa2\xd3\x8d\x61\x35\x97\x4d\xa8\xf5\x04\xc6\x72\x25\x0b\x2e\x8b\xbf\x5f\x64\x0d\x96\xdb\x3f\x17\xce\x83\xda\x83\xea\x44\x5d\xec\xa0\xa8\x0e\x4c\x36\x50\x17\xac\xaa\x94\xf1\xb1\xe2\xf2\x7c\x75\xfd\xf5\x00\x45\xa5\xa4\x03\xe9\x0a\xb5\x2f\x5c\xdc\x46\xd6\x39\xd5\x32\xc7\x2b\x26\xc4\xa5\x68\x40\x82\x61\x0e\xea\x62\x77\x29\xb4\x51\x2f\x50\xb9\x82\xab\x67\x1b\xea\x7d\xe6\xd2\x41\x63\x98\xe3\x4a\x7e\x8f\xfe\xbb\x34\x9d\x74\xbc\x85\xb2\xf2\xc4\xc7\x6b\xcd\x7f\x14\x4a\x1d\x0b\x2e\x9d\x2a\xdc\x01\x0a\xc7\x4c\x03\xae\xa8\xb9\x81\xca\x29\x73\xf9\xd3\xf3\xd3\xd3\x6f\xbf\x95\x05\xdf\x17\x7f\x84\xd7\xe2\x79\x6c\xde\xf3\xbf\xd6\xb5\x92\xf6\xb9\x6f\xff\x5f\x86\xff\xfb\x9f\x90\x85\x3f\x7c\x79\xfe\xf2\xf9\xf9\xd3\xf3\xbe\xb3\x50\x7e\xfb\xf9\xd3\xa7\xcf\x9f\xff\xf0\xa7\xe2\xf7\xdf\x9f\x98\xe6\x41\xf3\xbd\xe8\x31\x9e\x99\x66\xd5\x01\x9e\x95\x69\xfe\xe9\xf4\x99\x09\x7d\x60\x9f\x9f\x8e\x5c\xd6\xdf\x67\xf9\x7f\x6a\xc1\xb1\x9a\x39\xf6\xfd\xa9\x28\x24\x6b\x21\x84\x97\xd5\xd0\x3f\xe5\x6d\x85\x4f\x45\x21\xd8\x0e\x84\xf5\x21\x45\xc1\xb4\x1e\x63\x8e\xfd\x1f\x6e\xaa\x0f\x56\xcf\xa1\x2b\xbf\x17\x77\x3d\x91\x40\xa1\x58\x0d\x06\x8d\xb7\x1a\x2a\xcf\x83\xd7\xc1\x8c\xe3\x7b\x56\xb9\x80\xde\x57\x58\xb2\x43\x1f\xe9\xd1\x1b\xa3\x3a\xfd\x1f\xf5\xf7\x42\x99\x66\x04\xe9\x45\xa1\x7c\x8c\xf7\x92\x6b\x70\x28\xb3\xd5\x01\x5a\x08\xce\x45\x51\x16\xbc\xfe\x5e\x4c\xc5\x45\x71\x70\x4e\x7f\x2f\x9c\xe9\xe0\xfa\x27\xcd\xac\xe5\x27\xf8\x5e\xec\x99\xb0\x30\x67\x2a\xdf\x2c\x09\xab\x7c\xb3\x2b\x64\x93\x62\x2b\xdc\x3c\x78\x19\x1d\xeb\xb0\xb6\xb5\xaf\x9a\xd0\xb2\xf6\x55\xa3\xed\x9a\xca\x53\xb9\xb4\xb4\x04\x2e\x2d\x2d\xca\xa5\x65\x6e\xbe\xac\xfc\x4c\xe0\xb2\xf2\x73\xe0\xf2\x3f\xf3\xbd\x32\x2d\x1b\x8f\x7f\x9f\xb3\x6b\xf9\x28\x77\x60\xb8\x3d\x52\x2a\x1c\x1c\xd0\x64\xcc\x35\xa9\x09\x71\xad\xb2\x04\x40\x1f\x8e\xd2\x45\x82\x1c\x34\x7d\x00\x03\xe5\x1b\xec\xac\xaa\x8e\xe0\x88\xa4\x0b\xb7\x55\xf0\xfb\xfa\xad\xbf\xf0\xb1\x62\xd5\x52\xb0\x55\x8b\x63\xaa\x76\x81\xb5\x39\xbd\xaa\xe5\x67\x1a\x19\x3f\xaf\xb1\xf1\x73\xd9\xb2\xe4\x41\x64\xe9\x00\xd6\xb2\x86\xcb\x86\xea\xd3\x09\xc7\xe9\x38\xaf\x1d\x74\x40\xf4\xb0\xe0\x88\x0e\x27\x26\x3a\xc8\xec\xfa\x93\x51\x84\x8e\x3f\x19\x85\x76\xfb\x54\x9e\xd0\xb6\xfb\x03\xea\x68\x15\x6a\x26\x9d\xc6\x57\xce\xe1\x6f\xb6\xac\xde\xd2\xb9\xa7\xe8\xba\xde\x11\xc3\x87\x4b\x01\x8a\x09\x54\x5f\x28\xe1\x47\x2e\xfd\xac\xf9\x01\x16\xe5\x9e\x1b\x38\x28\x0b\x24\xaf\x96\x84\x22\x58\xbb\xab\x19\xc5\xa1\x7d\xa5\x44\xdb\x1f\x49\xd1\xb4\xc3\xc9\x02\x29\x77\x56\xd2\xc2\x5f\x69\xe1\x6f\xfb\xd4\xf0\xf0\x23\x7f\xef\x0c\x10\xc6\x08\x1f\x8e\x8e\x12\x5e\x50\xee\x84\xca\xef\x9b\xde\x21\xeb\x14\x32\x30\xee\x98\xa9\x54\x4d\x68\x64\x30\xc0\xc6\xdd\x58\x32\x06\x59\xf8\xf9\x2b\xa5\x52\x1f\x8f\xd7\x79\x55\x84\x10\x60\xb2\x3c\x31\xc1\x6b\xe6\x94\x21\x54\x3d\xf3\xc1\x7a\xf7\xae\x32\xb5\x7b\x80\x49\x4e\x38\xbb\x7a\x06\xae\xd0\x44\x4d\x8a\x29\xc4\xaf\x07\x11\x2e\x34\xae\x16\x6b\xe9\x89\x45\xa9\x99\xe1\xb2\xbe\x10\x10\x7d\x38\x9a\x17\x2f\x28\x2b\x7b\x5a\xfc\x65\xcf\xcf\x50\x2f\xfe\x76\x3c\xe9\x98\x4c\x49\xee\x18\x01\xad\x8f\x47\x53\x17\x2b\x52\xf3\xa6\x94\xa4\xb0\x29\x89\x66\x6d\x2c\x1f\xe5\x84\x4b\x82\x9d\x42\xaf\x07\xa6\xe2\xd4\x0c\x18\xc6\xa5\x33\x40\x19\xf4\x46\x0b\x14\x70\x21\x4a\xc4\xac\x3c\x47\x3e\x62\x1f\x8e\xe1\xc5\x82\x64\xb4\xfd\x1e\xb8\x24\xd1\x0d\x0e\x38\xe0\xa0\x29\xb3\x48\x6f\x5c\xfc\x52\x25\xa9\xcd\xd6\x32\x59\x1b\xf6\x2a\x28\xcd\xbe\x9a\xa0\x2d\x7f\x15\xd9\x90\xa4\x93\xdb\x10\x8f\xfd\xc0\x23\x45\x08\x39\x18\x25\x79\x25\x28\x07\xc3\x68\x81\xe6\x64\x14\x95\x20\x1b\x2e\x73\x7b\xf1\xd0\x49\xc2\x89\xad\x0f\xc7\x29\x27\x41\x2a\x5a\x5b\xda\xd6\x12\xd8\xfa\x78\x14\x2e\x56\x24\xd3\x71\x12\x1b\x5f\x21\xe3\xb9\x5c\x8a\x11\x96\xac\x2b\xc5\x34\xca\x35\x95\x27\x73\xb5\xe0\x6a\x0a\x99\x8f\xc7\xd9\x22\xc5\x66\xba\x79\x74\x76\xd2\xa5\xed\x28\xc3\x60\x1f\x8f\x37\x2e\x52\xa4\xd3\x39\x38\x13\x16\x77\x83\xc1\x0a\x5f\x24\x49\x06\xa4\x5c\x55\x56\x0a\xbf\xa8\xf4\xf3\xea\x6d\x5c\xd1\x8a\x73\x08\xe5\xb2\xde\xbc\x2e\x7a\x1b\x6d\xd4\x9b\x85\xcc\xe0\x4a\x30\x6b\x73\x63\x95\x74\x46\x89\x5d\x97\x6b\x30\x9d\xe9\x08\x06\x16\xb2\xa3\xfb\xfb\xce\xa4\xe0\xf2\xd4\x66\xc6\xfb\x1b\xee\xdb\x42\xe3\x3f\x0f\xb1\x82\xc9\xa6\x63\xcd\xc6\xf8\x9b\xaa\xfd\x4d\xee\xbc\xc8\x56\x55\xc7\xcc\x50\x6d\x94\x06\xe3\x38\xd8\x4c\x03\x03\xfb\xec\x48\xbb\xec\xe5\x48\x13\x45\xc6\x86\x53\x68\xc9\x34\xdf\x56\xf3\x6d\xbc\x65\x0d\xcb\x8e\xad\x0e\x50\x77\x02\x4c\xb6\x01\xd4\x2c\x33\x65\xd6\x75\xbb\xcc\x50\x77\x9b\xed\xcd\xa1\xbc\xcd\x6f\x6d\xe2\x8a\xcf\x4d\xe5\xd9\xbf\xe4\xb3\x15\x1b\x1b\x1c\xff\x79\xfc\x0d\x47\x67\x91\xe8\x0c\x52\xfa\xc1\xd5\x3a\x26\x47\xe7\xb2\x80\xf3\xb0\xa9\xe7\x6f\xc3\x0f\x69\x5c\x44\x29\xe3\x91\xa4\x2c\x0e\xe0\x37\x86\x5c\xff\x39\xfd\x68\xca\xc2\xf2\x56\x47\x4a\xa7\x8e\x20\xf9\xfb\xf4\x87\xb3\x66\xee\x30\xfd\x6b\x51\x7e\xf7\xaa\xa4\x79\xe7\xe3\x3c\xad\x2c\x2c\x18\xce\x04\x7f\xef\x77\x00\x4d\x7f\x75\x66\x3a\xb5\x95\xc5\x18\x30\x9e\x53\xbb\xea\xe0\x97\x0c\x29\xa7\xe5\x60\x81\x9d\x9b\x97\xa2\xcd\xfd\x14\xd5\x51\xef\x88\x90\xf5\x6e\x15\xb1\xde\x65\x02\x9a\x8b\x76\x84\xe5\xca\x21\x1e\xc5\x8b\x15\x9b\xe9\x3e\xba\x98\x8d\xcd\xca\x42\x37\xb3\x63\xa2\x2f\x2c\x2b\xd2\x35\xd9\xd5\x63\xbd\x4d\x91\x2a\x35\xeb\xf6\x44\x40\xb4\x27\x34\x45\xf6\x14\xd7\x74\xde\x13\x6a\x3a\xef\xd1\x2c\x9c\xf7\x8b\xe6\x47\x23\xdc\x07\xbd\x7a\x8d\x34\x36\x31\x76\x40\xaa\x79\xc3\xfd\x0e\xb8\x0a\x18\x61\x51\x34\x76\xc1\x5a\x78\x47\x37\xe2\xc6\x8d\x42\x79\xad\xe9\x34\x69\x89\xe7\x6a\x81\x93\xce\x45\x9b\x31\x6f\x0c\x36\x4f\x4c\x6f\x9a\x4a\xd9\xf2\x55\x4b\x8b\x36\x4f\xda\x4c\x28\xbf\x71\x87\x92\xfc\x3e\x1e\x45\x8b\x15\xc9\x74\xef\x34\xb8\xf7\x35\xb6\xf7\x6c\x34\xc3\x05\x61\xc1\xa0\x0f\x47\xd1\x22\x41\x32\x9a\xd2\xa4\xfb\x14\xc1\x00\xc7\x8b\x25\x89\x80\x70\x20\xde\x0a\x08\x06\x18\xe0\x5c\x92\x0a\xf8\x42\x98\x92\xc0\x0b\x3a\x1d\x99\x8a\x53\xa1\x04\xb3\x8e\x57\x16\x98\xa9\x0e\x04\xbc\xd8\x06\x05\xbd\x23\xa4\x20\x97\xfe\x92\xef\x41\xdc\x65\x74\xe5\xb9\x0e\x1f\xab\x29\x2d\xf8\xe9\x41\xf4\x3f\x6d\x26\xff\x29\x97\xda\x52\x6e\xea\x80\xb0\xf8\xed\x9c\x58\x90\x8a\xe6\x2a\xc2\x22\x32\xb8\x0a\x5d\x42\x8e\xca\x53\xb9\x4e\x20\x1d\xab\x5b\x4e\x98\x36\x4d\x1e\x28\xe3\x52\x95\x4a\x7a\x06\xc2\x43\x04\x70\x86\x0a\xa5\x9b\xca\x13\xb9\xf6\xac\x82\x9d\x52\x84\x9b\x51\xa3\x03\xc6\xb7\xd0\x24\x33\x5a\xf7\x62\x29\xfb\x05\x46\x07\xec\x12\xc3\x97\xcf\x85\x21\xf6\xc0\x09\xb3\x99\xfd\x81\xa3\x93\x99\xa8\x3c\x35\x2b\x82\x39\xcd\x2a\x4a\xcf\x05\x07\x94\x6f\xae\xd9\xcc\xf8\x41\x92\x67\x55\x8e\x14\x9c\x72\x2f\xb4\x0f\xc7\x5b\xc0\x65\x32\x7e\xf0\x56\x84\x9b\x7a\x7b\xa5\x51\x2c\xa5\x33\xa1\x0c\x40\xcb\x0c\xe9\x02\x60\xf2\x40\x11\x97\xaa\x54\x52\x47\x49\x9f\xc3\xd3\xe7\x74\x2a\x54\x14\x6a\x73\x63\x6d\x46\xbd\x43\xb7\x35\x4c\x36\x82\x13\xf6\x63\x05\x03\x2c\x29\x73\x49\x2a\x20\x28\xbf\x05\x93\x70\x54\x8d\x0e\x28\xe2\x5c\x93\xca\xc8\x09\x73\xd1\x86\xa3\x93\xcf\xa9\x38\x1d\xea\xd0\x11\x2e\x3d\x86\xf8\x15\xb4\x49\x91\x4a\xa7\x54\x23\xa0\xdc\xf1\xe6\xb5\x03\x43\xd8\xaa\xb8\x30\x42\x79\xef\x4a\xf3\xc0\x2b\x26\x40\xd6\x8c\x72\x58\xce\x8d\x36\x80\x2f\xa4\x9b\xc1\x3f\xb0\x29\xf3\x1e\x81\x98\xd1\xd7\xc6\xd7\x45\xcd\x41\xef\xb2\x21\x01\xb1\x2e\x8f\xb7\x65\x9c\x70\x2d\x13\x99\x6c\xa0\x8d\x64\x9b\x61\xef\x58\x3c\xa2\x9b\x74\xb7\xb3\xa4\xb1\x20\xb6\xd9\xd0\xf4\x99\x30\x0f\xd9\x1e\x00\x9c\x25\x23\x0f\x36\x1b\x90\x67\xc2\xcd\xc8\x77\x4d\x88\x3d\x66\x28\x67\x63\x65\x18\xde\x58\xc3\x32\xb9\x0c\xd3\x94\x5f\xba\x0f\x47\xc9\x22\x41\x32\x9a\x52\x27\xca\x29\xa4\x8f\xff\xf8\x8e\x6f\x54\x3e\x06\x68\xc2\x55\xb3\x8f\xc6\x33\xa1\xab\x45\x22\xb6\xdd\xaf\x69\x48\x17\xa3\xcd\x96\x0b\xd1\xab\x28\xc4\x74\xec\xc4\x86\xe5\x8e\x5d\x67\x09\x75\xcf\x7c\xd0\xe4\xdc\x53\x26\x1e\x2f\x07\xf6\x0e\xc2\x6f\xd5\xcd\x07\xbe\x5a\x60\xac\x57\xd1\xf0\xfc\x6f\x95\xf5\x14\xe9\xad\x59\xff\x42\x12\x59\x3d\xc0\x49\x70\xeb\xe8\x2e\x84\x87\x7c\x23\x13\xe2\xe3\xc2\x93\x13\xe9\x89\xe1\xc9\xc6\x80\x16\xbc\xf2\x6f\x55\x79\x08\x95\xdf\x5e\xb1\xeb\xf6\x7b\x30\x74\xaf\xed\x3b\x86\x70\x97\x07\xf4\xbd\x53\x9a\x57\xa9\x36\xe1\x27\x44\xdb\x51\xb2\xba\x9b\x84\xb0\x93\xe4\x50\xef\x2d\x81\xac\xde\x5b\x14\x6c\x2a\xcf\xe0\xfa\x42\x03\xfb\xb2\x46\xf6\x25\x13\x0d\xac\xe5\x94\x6d\x02\xc1\x00\x3b\x03\xc5\x92\x10\xc4\x75\x75\x60\x94\x71\x7c\x30\x40\x93\x32\x93\xa4\xa6\x45\x7c\x23\xc0\x89\x6f\x1f\x4f\x4a\x1c\x18\x0b\x06\x4d\x97\xf8\x16\x93\x38\xca\xb2\x97\x6f\x34\x9a\xa4\xa9\x7c\x73\x86\xe2\xd8\xdc\x1f\x84\x73\x9a\xf0\xa0\xac\xaf\xeb\xeb\x5a\xb3\xbe\xa6\xa2\xcd\xda\x95\x1c\x3d\x74\x3d\xdf\x31\x47\x79\xa4\x64\x88\xc7\x9a\x36\x53\xa4\xd2\x55\x8c\x70\x15\xed\xdf\x5a\x86\x1d\xb7\xd7\xf2\x20\x87\xea\xe7\x4f\xff\xfc\xed\x13\xa1\xc2\xe0\x80\xa6\x23\x68\xca\x4a\x70\x90\x2e\x35\x2f\x37\x36\x16\xcc\x29\xfd\x5c\x1f\x78\x1b\xc9\x1d\xe1\xd4\x38\xc4\xa3\xad\xed\x15\xb4\x47\x07\x47\x0f\xd5\xea\xce\x51\x5d\xfa\x4b\x08\x4b\x34\xe1\x75\x03\x92\xe8\x41\x7e\xfd\x4d\xf0\x21\xcd\x42\x83\x47\xc6\x0c\x2d\x1c\x00\x72\xcf\x25\xb7\x9a\x72\x3e\x9e\x3c\xd0\x03\x69\xa9\x4a\x27\x15\xdd\x99\xb2\x69\x77\x74\x58\xa1\x8c\x35\xa9\x8c\x9a\x32\x1b\xe4\x1a\x9f\x0d\x46\xe5\xa9\x5c\x86\xb0\x08\xc1\x4d\x85\x52\x99\x2a\x17\x4a\xc9\xf6\x95\xc2\xe5\xe3\x71\xb4\x48\x91\x48\xf7\xc2\xaa\x23\x69\x91\x24\x18\x60\xa7\xaf\x7e\x9d\x24\xd6\xcd\x22\xcf\x2d\xe1\xcc\x39\x79\xa0\x00\x73\xd5\x18\x7a\x62\x56\xb3\x8a\x70\x5a\xb9\x5a\x60\xbd\xb3\x14\x25\x77\xd0\x99\x30\x16\xbc\xb0\xf3\x0e\xcf\xcc\x79\x17\x57\x46\xdc\x39\xf8\x12\x9f\x3f\xef\x67\x23\xeb\x0c\x3b\xba\x0b\xd5\xd5\x96\x82\xd7\x1b\xe0\x7c\xb1\x24\x19\x90\x70\xf7\xeb\xa5\x32\x38\x58\xe6\xdc\xe9\xa5\xde\x11\x46\x45\x1f\x8d\x62\x4d\xe5\xa9\x5c\xe0\xdc\xe5\x5f\x08\x64\x7d\x3c\xca\xe6\x15\x0b\xb8\x6d\xeb\xc6\x2f\x3d\x8e\x25\xc0\x0d\x06\x28\xdd\x4c\x92\x9a\x3c\xbe\x23\xec\x40\x7e\xe1\xbb\x33\x3a\x2a\x8c\xe5\xa3\x5c\x36\x94\xca\x64\x83\xe6\x21\x63\x6a\x39\x3a\x53\x6e\x0c\xf9\x68\x9c\x2b\xf7\xc6\xd0\x0b\xe5\x11\xa0\x17\xfc\xd9\x9f\xa9\x38\x19\x8a\x72\xc0\xb4\x67\x1c\xea\x9c\x09\xa5\x0e\xf2\x9d\x34\xfb\x18\x0c\xd0\x83\xb9\x9f\x7d\x44\xba\x31\x52\x10\x56\xc9\x7c\x34\x9a\x91\xa9\x3c\x39\x25\xa4\xcd\xc5\x2f\x6a\xda\x3b\x7c\x67\x8d\x6c\x2c\x0c\x62\x4d\xf9\xfd\x68\x86\x66\x40\xb3\xcc\x04\x58\xca\xfe\xfb\x17\x8b\x6f\xbb\xb7\x95\xce\xc5\x52\xd1\xdb\xd9\x28\x80\xb1\x0f\x86\x7a\x57\x99\x41\xed\x1f\x8f\xa5\xf1\x46\x0f\xd8\xde\x39\xa6\x66\x8a\x10\xe4\xbe\x7e\x22\x2c\x4f\xf5\xe1\x68\x6a\x22\x41\x6a\x46\x3a\xa0\xfc\xbc\x3a\x10\x1f\x67\x02\x44\x5c\xd1\x99\x98\xf8\xf3\x4a\xda\xa7\xf2\xf0\xce\xfe\xeb\xd7\x0b\x36\xde\xfe\x7f\x3e\x7e\x58\xf9\xd2\x2b\x08\x6b\xd0\x20\x6b\x90\x15\x8f\x49\xd6\xda\x76\xaf\x82\x9b\x1d\x04\x53\x8d\x07\x60\xc2\x1d\x1e\x43\x3f\x78\x3d\x9c\xde\xaf\x65\x0a\x70\xeb\x1e\xcf\x47\xc4\x65\xc2\x8c\xfd\x96\x65\x2f\xa7\xf6\x31\xb9\x78\x39\xb5\x0f\x4f\xc4\xf5\xe5\x32\xcb\xca\x8e\xca\x09\x2e\x1f\x03\x3e\x78\xdd\xad\x26\x24\xee\x31\xf5\xc4\xbd\xf0\x8f\x3a\x5a\x96\x75\x5e\x58\x2b\x1e\x43\xef\x9d\xe2\x2a\xd8\xfe\x48\x38\xbb\xf7\xe1\xd8\x10\x1c\x0b\x12\x87\xe0\x23\xf8\xed\x60\x84\x51\x38\x18\xa0\x78\x33\x49\x2a\xa0\x64\x6e\xcb\x2e\x4e\xac\x5f\x06\x0b\x14\x71\x26\xd9\x7a\x81\xfa\xc8\x03\x53\xfa\x0b\xe4\xaf\xd3\xad\xcc\x80\xee\xbf\x8b\xb3\xda\xf6\xa7\x8f\x4c\xfb\x70\xb4\xdd\x91\x20\xb5\x63\xba\x1d\x18\x09\x0e\x2c\x81\xef\xea\x81\x42\x2e\x55\x9b\x49\x6f\x1d\xfc\x1b\xc6\xf6\xbc\xf1\x9f\x22\x78\x84\x5b\x0d\x5a\xa8\x4b\x4b\xb9\x3d\x14\xb9\xf9\x2f\x05\xf5\xcb\x93\x0f\x31\x53\xf5\x43\x12\xa6\xfd\xb7\x7f\xac\xff\xd0\x53\x79\x52\xa2\x6b\xff\x41\xa6\x65\x25\x18\x6f\x1f\xe2\xad\xea\x47\xd8\x8c\x5b\xac\xfc\xa7\xa8\xc2\x5b\xca\x04\x98\xc7\x38\x5b\xd5\x99\x0a\x6c\xf9\xda\x29\xc7\x1e\xe0\x68\xa1\x32\xe0\x1e\xc1\xe6\xcf\x8f\xbc\x82\xd2\x7f\xf4\xab\x93\x8f\xb4\xcc\xb6\x52\x1a\xa4\x3d\xf0\xbd\x2b\x77\x1d\x17\x75\xf8\x05\x3f\xca\x2e\xd9\x67\x18\xb9\x44\x4d\x79\x77\xa5\x8f\xc6\x46\xbc\xa8\x3c\x99\x8b\x13\x5e\xf3\x22\x6a\x8e\xbe\xe7\x25\x2a\x4f\xe5\xe2\xf2\x08\x35\xe5\x91\xe3\xd1\x01\xe5\x9b\x6b\x52\x19\xbb\x0a\x28\x2f\x46\x1e\xe2\x51\xbe\x58\x91\x4c\xd7\xee\xc0\xbc\x90\x1e\x5e\x9d\x3c\x70\xca\x85\x2a\x95\xf4\x9d\x72\x08\xbe\xef\xb1\x15\xc4\xb1\x78\x68\x0f\xed\x81\x99\xb5\x27\x65\x28\x3b\x7e\x39\x65\x82\xa1\x95\xce\xfe\x60\x88\x8f\xcd\xae\xd8\xb6\xf9\x1b\xe5\x7c\x6c\x46\xc5\x77\x7b\xb9\xf5\x17\x51\xfd\xa6\x6b\xcd\x4c\x7c\xb1\xd6\xf6\xdf\x41\xa3\x74\xb9\x8f\xc7\x3a\x7d\xa6\xd8\xdc\x8e\xe0\x0e\xce\xf0\xca\x12\xf0\x06\x03\x94\x6f\x26\x49\x05\xe4\x82\xf0\x86\xb7\x96\x0b\x85\xa2\x71\xa1\x88\x3b\xd4\x7c\x15\xa4\xdd\x69\x2d\x97\x84\x2b\x6d\x1f\x8d\xb7\x50\xb2\x7c\x2e\xc2\xe6\xe8\x3e\x7c\x8d\x2c\x73\x73\x74\x2b\x04\x61\x2a\xe3\xa3\x51\xb0\xa9\x3c\x95\x4b\xc9\x46\x51\x36\x5f\x05\x03\x94\x6e\x26\xc9\x03\x2c\x1b\xc3\x49\x5b\xf2\xe7\x3e\x1b\x70\xe7\xca\x3c\xea\x1f\xc9\xbc\x3f\x6e\x20\xfd\x31\x93\xf1\xd5\x11\x6e\x08\xb6\xaf\xce\xa1\x6c\x53\x79\x2a\x17\xe5\x6d\x8d\xad\x3d\xa1\x54\xf6\x94\x09\xd5\x59\x47\xdb\x2a\x34\x3a\xa0\x78\x73\x4d\x2a\xe3\x89\xb2\xc6\xd8\x9e\x40\xa0\x6c\x53\xf9\x66\xae\xbb\xf7\x71\xae\x15\x85\x7a\x2f\xc4\x8d\xee\xed\x65\x75\xa7\xfb\x5c\x92\x98\x56\xc9\x1a\x4e\xf9\x68\xeb\x10\x8f\xe1\xcd\x14\xc9\x74\x8e\xc4\xe6\x56\xc8\x5c\x2e\x97\x5f\x6a\x25\x80\x45\x5b\x99\xee\x93\xdd\xd9\xeb\x94\x82\x46\x7c\x1a\x66\xf2\x58\x85\x8c\x55\x5b\x17\xbd\xa3\x4a\x08\x0f\xb7\x0c\xf1\xab\x80\x99\x0f\xa8\x44\x8b\xe9\x54\xc4\x6d\x89\xfc\x9a\x9f\x49\xd5\x48\xc2\xd0\xe8\xa3\x3f\x1e\xcf\xae\xa5\x41\x2c\xb8\x6c\x14\x61\x1a\x1a\x0c\xb0\x74\xcc\x25\x89\xfd\x36\x04\x13\x0e\xab\x60\xb0\x0e\x98\x79\x60\x5d\x17\x0f\x09\x88\xa3\x05\x0a\xb9\x10\xe5\x60\x3a\xd2\x52\xd1\xd5\x62\x15\xd3\xd7\x53\x56\x5c\x66\xbc\xa0\xe8\xd6\xa7\x11\x94\x87\xc7\x27\x9f\x23\x5c\xac\x53\xf2\x01\x4e\x12\x3a\x67\x94\x7c\x80\x91\x3a\x31\xba\x8b\x7d\x23\x1c\x15\x8e\x69\xc1\xa4\xa4\xac\xa0\x44\x26\xf8\x91\xb1\x94\x25\xc2\x6a\x76\x20\x2c\x55\xf8\x68\x0c\x2f\x2a\x4f\xe6\x3a\x0b\xd5\x34\xa4\x6d\xb8\x93\x07\xce\xb8\x50\xa5\x92\xd6\x84\x65\x58\x5d\xef\x51\xb6\x7a\x9f\x09\xd5\xf4\x0f\xd3\x11\xc0\x06\x03\x14\x6e\x26\x49\x05\x34\x5c\x92\x96\x18\x83\x01\x06\x28\x74\xee\x8f\xc2\x28\xa7\x76\x1d\xa5\x5f\x83\x03\xb6\xfa\x3a\xd3\x84\xb0\x6e\x27\x29\xaf\x68\x1a\xe2\xb1\x9c\xcc\x14\x89\x69\x79\xed\x98\x71\xef\xf9\x74\x43\x3c\x46\x37\x53\x64\xd1\x11\x66\x5d\xc1\x60\x9d\x2f\x73\xd6\xf5\xda\xf1\xea\xb8\xe7\x84\x3d\xe9\xa3\x03\x8e\x38\xd3\x24\x32\x1a\xb6\xdb\x71\x47\x79\x76\x6f\x74\xc0\x18\x17\x9a\x54\x46\x60\x95\xdf\x45\x14\xde\xb0\x65\x09\xac\x0b\x27\x94\xf9\xbe\x36\x99\xdd\xba\xd2\xbe\xb1\xa6\xa1\x8c\x7d\xb1\x0b\xce\x7c\xa3\xcb\xe0\xdd\xb2\xd9\x10\x45\x9d\x36\x18\x7e\x48\x39\x49\xd2\xae\xea\x4c\xcb\x09\x6c\x2d\xba\x69\xcb\xb4\x7c\xc1\xb4\x35\x69\xaa\x73\x40\xfa\x5e\xc2\xe8\x80\xe2\xcd\x35\xa9\x8c\xd6\x12\xf0\xac\x45\xc9\xac\x4d\x85\xfa\xe0\x14\x39\x56\x14\x36\x95\x32\x01\x76\xaf\x0c\xe5\xf9\xd5\xc9\x03\x6b\xc1\x8d\x2a\x31\xbb\x96\x69\xbf\xc6\xf4\x06\xec\x44\xf9\x9d\xcf\x6c\x70\xde\x5b\x61\x32\xf2\x99\xf2\x54\x92\x65\x67\x25\x31\xc4\x73\xd6\xeb\x56\xef\x2e\xb7\x44\x56\xa1\x72\x5f\x21\xf3\x97\x8f\x84\x06\x5c\x3d\xb0\x56\xdc\xa8\x52\xb3\x5c\x19\xae\x09\xc3\xe9\x10\xff\x71\x6a\xfc\x93\xd7\x7f\x8f\x35\x65\xa1\x0f\xe3\x82\x59\x59\xe8\x8b\x3b\x5c\xd1\xcb\xc2\x74\xbb\x59\x1a\x87\x4d\x69\x52\xbd\x11\x00\xaf\x1e\x68\x1a\x97\xaa\xd4\x34\x6e\xdc\x03\x8f\x62\xae\x9c\x96\xe6\x92\xb4\xd3\x92\xe5\x84\x85\x65\xcb\x35\xca\xc5\xf5\x82\x69\x35\x69\x51\x68\xf2\xc8\x1c\x98\x48\x0f\x78\xda\x95\x27\x3c\xa3\xf2\xf4\x36\xbd\xb4\xb6\xdc\x31\x97\xfb\x45\x19\x5f\x37\xe1\xa2\xa1\x0f\x5f\x6b\x5b\xe6\x05\x83\x15\xa4\xa5\xc5\x3e\x1c\x45\x8b\x04\xa9\x68\xad\xa6\x1c\xe1\xad\xc6\x0f\xf1\xa9\x7c\x33\x57\x1c\x6b\x33\x1b\x25\xd9\x11\x36\x3d\x9d\xf2\x71\xcb\x46\x0b\x6c\x56\xe3\xcb\x17\xca\x31\xba\xa5\x64\x55\xb6\x78\x56\x65\x9b\x9c\xd5\xe0\x4c\xfa\x82\xbc\x9d\xbe\x10\x7f\x37\x1d\xbe\x7c\xf1\x52\x0e\xab\x04\x65\xda\xa4\x84\x41\x13\x31\x95\x6f\x4e\x44\x1c\x9b\x3f\x56\x29\x61\x7e\xf1\x2f\xdb\x48\x35\x08\xed\xd2\xcc\x50\xc6\x03\x1f\x8e\xe6\x25\x12\xe4\xa0\x11\xbf\xc7\x35\x79\xac\x42\xde\xfb\xfe\xd6\xc6\x33\xb3\x16\x1d\xe5\x93\x2e\x43\x3c\x0e\x18\x29\x92\xd3\x68\x48\x2b\xde\x56\x47\x5f\xf0\xfd\x80\xce\x2b\xca\xac\x95\xdb\xbb\x33\x4e\xab\x41\xdc\xb4\x60\x38\x21\x53\xdb\x31\x3b\xad\x63\xad\xa1\x9c\xfe\x07\x07\xbf\x96\xdc\xf8\x87\xb4\x48\x97\x10\x37\x5e\x1b\xd8\x6f\xd5\x79\x2d\xa0\x3d\x29\x11\x99\x6c\x60\xce\x7f\x6e\x22\x18\x18\xa8\x29\xfb\x7d\x62\x97\x0d\xb8\xb1\x2e\x8f\xf7\x8d\x0e\xfb\xb6\x85\xf4\x6d\x89\xb9\x71\x50\xa3\xbc\x3e\x63\x7a\x79\xc6\x7d\xb2\x57\xb1\x60\x5a\x4d\x5d\x14\x5a\x5a\xa7\x0c\xe4\x9e\xef\x2c\x65\x08\xb1\xf8\xc8\x61\x0f\x99\x50\x8e\x11\x16\xf6\xac\x63\xe8\xa2\x5e\x54\x9e\xcc\xa5\x48\x93\x47\xa7\x56\x66\x8f\x91\x20\x19\xcd\xaf\x98\x53\xd8\xa2\x2f\x69\x7c\x00\x97\xff\xad\x8d\xe1\xab\xf7\x0e\x5a\x2d\x18\xe5\x85\xab\x73\x9f\x15\x5a\xff\x63\x5f\x48\x53\xb1\x2f\x56\x28\xca\x54\xa1\x8f\x47\xe7\xe5\x93\x62\x68\xa1\x63\x8d\x55\x1d\xe1\x20\x0b\x06\x58\xa5\x8e\xd7\x97\xbf\x32\x73\xec\xf4\xac\x62\xb3\xe7\x82\xd0\x37\xc1\x00\xad\x38\x92\x84\x20\x10\xd0\x18\xca\x81\x3b\x3a\x60\x07\xc3\x42\x93\x78\x14\xb8\x83\x21\xed\x92\x1a\xe2\x51\xbe\x58\xb1\x99\xee\xa3\x24\x4f\x66\xa1\x7e\x4e\x79\x63\x83\xe3\xf8\x0b\x1b\xa2\xf2\xd4\xbc\xbe\x71\xc1\x09\x1b\x63\x86\x78\x94\x2d\x56\xa4\xd3\x39\xd2\xe6\x89\x60\xb0\xc2\x17\x49\x36\x03\x2e\xc2\xcb\x9a\x1b\xa8\xdc\xf0\x42\x65\xa0\x9a\xe5\x7d\x39\xfa\xc6\xa5\xbf\x6d\x9c\xb1\xdf\x67\x69\xe4\x5f\xc7\x22\x78\xee\x83\xa6\x9d\xdf\xc9\xe7\x28\x6b\xed\xa3\x03\xd6\x8d\x0b\x4d\xda\xbc\xb2\x93\xfc\xa4\x2a\xee\x2e\xa5\x66\xfe\x43\x03\x96\xc2\xba\xb0\xc2\x86\x89\x49\x5c\xd9\xd3\x9d\xbf\xee\xf9\x19\xea\x3b\x7f\x77\x41\x3d\xd0\x9f\x40\xf4\x26\xf9\xd0\xa3\x03\x96\xe0\x85\x26\xf1\x20\x38\x81\x71\x84\xe9\x64\x1f\x8e\xd3\x4d\x82\x44\xb4\x37\x60\xee\x40\x19\x64\x82\x01\x86\x37\x97\x24\x03\xee\xac\xaa\x8e\x94\xdb\x40\x57\x0b\x1c\x72\x2e\x4a\xfb\x0d\xbd\x29\x53\x6b\x03\x94\xdb\xed\x57\x0b\x94\x72\x21\x4a\x4c\xe6\xb9\x3a\x30\xd9\x10\xa6\x58\xc1\x00\x43\x9c\x4b\x52\x01\x5b\xb1\x03\x26\x2d\x81\x30\x38\x60\x23\xcf\xb9\x15\xff\x76\xd5\x5c\xc3\x68\x1f\xf8\x0e\x06\x2b\xd5\x5e\x25\xd7\x20\x0b\x55\x67\x48\xe3\x57\x64\x82\xf6\xcb\x8d\x6c\x73\xdf\x7c\xd0\x9e\xde\x0e\xfe\xef\xaf\xff\x39\x6b\x11\xe5\x96\xd1\x79\xe5\x96\x51\x54\x9e\x7a\x60\x51\x2f\x4f\x83\x01\x96\x0f\xdf\xb9\x0b\x5d\x59\xc4\xff\x1e\x9c\x2e\xac\x6d\x29\x23\xee\x10\x8f\xa5\x69\xa6\x48\x4c\xd4\xbb\x7f\x81\x9e\x25\xac\x9d\x07\x03\x8c\x6f\x2e\x49\x05\xe4\x9a\x76\x99\x18\x0c\xb0\x9e\x8c\x25\x21\x48\xa9\x23\x80\xa6\xf4\xdb\xd5\x02\x4d\xcd\x42\x94\x9a\x9c\x31\x3c\xbc\x9c\xe1\x01\xb4\xc1\x69\x13\xf4\x5c\xbb\x89\xfd\xb7\xdf\xca\x02\x64\xfd\xfb\xef\x4f\xff\x3f\x00\x2b\x3c\xfc\x21\xe3\xb5\x00\x00"),
 		},
 		"/addons/camelk/maven-settings.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "maven-settings.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1750,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x6f\xd3\x4c\x10\xbd\xfb\x57\x8c\xac\x1e\x3f\xef\x26\x1f\x17\x64\x6d\x5c\x89\x42\x11\x52\xcb\x81\x14\xc4\x75\x6a\x4f\x92\x55\xd7\xbb\xd6\xce\x26\x71\x14\xf2\xdf\x91\x9d\x3a\xb5\x9d\x52\x7a\x40\x9c\x38\xfa\xcd\x9b\xf7\x66\xe6\x49\x6b\xac\xf4\x37\xf2\xac\x9d\x4d\x61\x33\x8d\x0a\x0c\x98\x46\x00\x4c\x21\x68\xbb\x64\x51\x97\x26\x85\x1f\x49\x04\x00\xa0\x2e\xeb\xd2\xc0\xe6\x48\x9f\xc5\x53\x31\x89\x81\x6c\xee\x0a\x6d\x97\xb3\xf8\xeb\xdd\x75\xf2\x36\xbe\xcc\x8e\xd4\x4e\x00\xea\xd2\x58\x9e\xc5\xab\x10\xaa\x54\xca\x12\x37\x64\x05\x56\x98\xaf\x48\x38\xbf\x94\xf3\x0f\x77\x77\x9f\x3e\x7f\x9c\xcb\xa9\x98\x34\x7a\x2d\x3d\xad\x59\x9f\x5a\xb6\xdb\xad\xd8\xbe\x69\xc9\xff\x4f\x26\x53\xf9\xfd\xf6\x66\x9e\xaf\xa8\xc4\x44\x5b\x0e\x68\x73\x8a\xa1\x66\x9d\x72\x0b\xde\xb8\x1c\x43\x3b\xde\xeb\x1c\xa1\xa1\xf1\x73\xbc\x9a\x0b\xd9\x6d\x91\xb4\x5c\x51\x73\x11\x1f\xf7\x03\x50\xc6\xe5\x68\xbe\x50\xe5\x58\x07\xe7\x77\x99\x92\x63\xa4\x63\x56\xde\x2d\xb4\x21\xee\x80\x27\xe8\x09\x01\x50\xba\xc8\xda\x21\x92\xce\x55\x49\x5d\x0c\x18\x98\x07\xbd\x69\xd7\xeb\xc3\x5d\x81\xde\xed\xde\xd3\x02\xd7\x26\x64\xc1\xaf\x49\xc9\x31\xda\x97\x92\x7d\xad\xc7\xc2\x7e\x9f\x80\x5e\x80\x98\xef\x6c\x41\xac\x59\x5c\xb9\xb2\x72\x96\x6c\x60\x31\x27\xbf\x21\x2f\xae\x09\xc3\xda\x13\x8b\xdb\x66\xd2\xd3\xae\x9a\xf8\x70\xe8\xcb\xfb\x5e\x65\x38\x6b\x63\xe2\xd1\x2e\x09\x2e\x74\xf1\x1f\x5c\xac\xbd\x81\x74\xf6\x27\x4c\x87\xc6\xbb\x6c\x54\x3a\x5e\x78\xbf\x6f\x7c\xe1\x70\x18\x1f\xf7\x91\xb2\xf6\xa6\xe5\x34\x63\x35\xa4\xe6\xfb\x9c\xc5\x16\x2b\x5e\xb9\xc0\xe7\x35\x00\x45\x16\xef\x0d\x15\xd9\x02\x0d\x93\x92\xdd\xe7\xb9\x8c\x7c\x41\x47\x79\x32\x84\x3c\x3e\xdf\xc8\xe2\x18\xf4\x0b\x0e\xbf\x52\x69\x2a\x4f\x97\x3a\x4b\x88\x6c\x73\xa3\xe8\x59\xfe\x28\x52\x55\x99\xf5\x52\x0f\x62\xf9\xab\x89\x8f\xec\xff\xe5\xfe\x9b\xdc\xcf\xef\xf5\x9a\xf4\x9f\x0b\x39\x1a\x74\xf4\x1a\x94\x1c\xbd\x6f\x27\xe0\x71\x1e\x75\x7a\x59\xb3\xe8\x41\xdb\x22\x85\x2b\x67\x17\x7a\x79\x8b\x55\x54\x52\xc0\xee\x37\x64\xf0\x9e\x0c\xa7\x6d\x0f\x56\x55\x0a\x39\x96\x64\x92\x87\x08\xc0\x62\x49\xa7\xef\x64\xf8\x70\x46\x3f\x03\x00\x00\xff\xff\x62\xd5\xd9\x0b\xd6\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xc1\x6e\xd3\x40\x10\xbd\xfb\x2b\x46\x56\x8f\xd8\x9b\xc0\x05\x59\x1b\x57\xa2\x50\x84\xd4\x72\x68\x0a\xe2\x3a\xb5\x27\xc9\xaa\xeb\x5d\x6b\x67\x93\x38\x0a\xf9\x77\x64\xa7\x76\x6d\x87\xb4\x1c\xe0\xc6\xcd\xfb\xe6\xcd\x7b\xb3\xf3\xa4\x35\x96\xea\x3b\x39\x56\xd6\x24\xb0\x99\x06\x39\x7a\x4c\x02\x00\x26\xef\x95\x59\x72\x5c\x15\x3a\x81\x9f\x51\x00\x00\x20\x2f\xab\x42\xc3\xe6\x48\x9f\x85\xd3\x78\x12\x02\x99\xcc\xe6\xca\x2c\x67\xe1\xb7\xfb\xeb\xe8\x7d\x78\x99\x1e\xa9\xad\x00\x54\x85\x36\x3c\x0b\x57\xde\x97\x89\x10\x05\x6e\xc8\xc4\x58\x62\xb6\xa2\xd8\xba\xa5\x98\x7f\xba\xbf\xff\xf2\xf5\xf3\x5c\x4c\xe3\x49\xad\xd7\xd0\x93\x8a\x55\xd7\xb2\xdd\x6e\xe3\xed\xbb\x86\xfc\x76\x32\x99\x8a\x1f\xb7\x37\xf3\x6c\x45\x05\x46\xca\xb0\x47\x93\x51\x08\x15\xab\x84\x1b\xf0\xc6\x66\xe8\x95\x35\x5d\xfb\x2b\x8e\x50\xd3\xf8\x77\xbc\x8a\x73\xd1\xde\x22\x6a\xb8\x71\xc5\x79\x78\xbc\x1f\x80\xd4\x36\x43\x7d\x47\xa5\x65\xe5\xad\xdb\xa5\x52\x8c\x91\x96\x59\x3a\xbb\x50\x9a\xb8\x05\x9e\xa1\x67\x04\x40\xaa\x3c\x6d\x86\x8d\x5a\x57\x29\x54\x3e\x60\x60\xe6\xd5\xa6\xb9\x5e\x1f\x6e\x0b\xf4\x61\xf7\x91\x16\xb8\xd6\x3e\xf5\x6e\x4d\x52\x8c\xd1\xbe\x94\xe8\x6b\x3d\x15\xf6\xfb\x08\xd4\x02\xe2\xf9\xce\xe4\xc4\x8a\xe3\x2b\x5b\x94\xd6\x90\xf1\x1c\xcf\xc9\x6d\xc8\xc5\xd7\x84\x7e\xed\x88\xe3\xdb\x7a\xd2\xee\xae\x8a\xf8\x70\xe8\xcb\xbb\x5e\x65\x38\x6b\x6d\xe2\xd0\x2c\x09\x2e\x54\xfe\x06\x2e\xd6\x4e\x43\x32\xfb\x1b\xa6\x43\xe3\x5d\x3a\x2a\x1d\x37\xbc\xdf\xd7\xbe\x70\x38\x8c\x97\xfb\x44\x59\x3b\xdd\x70\xea\xb1\x6a\x52\x7d\x3e\x65\xb1\xc1\x92\x57\xd6\xf3\x69\x0d\x40\x92\xc1\x07\x4d\x79\xba\x40\xcd\x24\x45\x7b\x3c\x95\x11\x2f\xe8\x48\x47\x9a\x90\xc7\xeb\x1b\x59\x1c\x83\x7e\xc1\xe1\x9c\x8a\x14\xfd\x4d\x0d\x8a\x75\x42\x64\x72\x18\x26\x2a\xce\x45\x2a\x4b\xbd\x5e\x2a\x73\x77\xa6\xfc\xcf\x13\x1f\xd9\xff\xcf\xfd\x95\xdc\x4f\xf7\xf5\x27\xe9\x8f\xba\x9a\x90\x83\x41\x47\xaf\x41\x8a\xd1\xfb\xd6\x01\x4f\xf3\xc8\xee\x65\x4d\x83\x47\x65\xf2\x04\xae\xac\x59\xa8\xe5\x2d\x96\x41\x41\x1e\xdb\xdf\x90\xc6\x07\xd2\x5c\x7f\x01\x60\x59\x26\x90\x61\x41\x3a\x7a\x0c\x00\x0c\x16\xd4\x9d\xa3\xe1\xc3\x19\xfc\x1a\x00\x62\xd5\xd9\x0b\xd6\x06\x00\x00"),
 		},
 		"/addons/camelk/platform.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "platform.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 589,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x31\x6b\xc3\x30\x10\x85\x77\xff\x8a\xb7\xa5\x1d\xac\x92\xd5\x5b\x09\x1d\x82\x29\x84\x14\xba\x9f\xad\xb3\x23\x22\x9d\x84\xa5\x84\xa6\xc6\xff\xbd\xd4\x76\xdc\x84\x52\xe8\x66\xee\xbe\x77\xef\x3d\x2b\x07\x05\xf3\xce\x5d\x34\x5e\x0a\xd4\xe4\xd8\x2a\x0a\x54\x1f\x58\xf9\xae\x7d\x3a\xaf\xc9\x86\x03\xad\x33\xe0\x68\x44\x17\xd8\x4a\xe2\xb6\xa3\x64\xbc\xec\x2c\xa5\xc6\x77\x2e\x03\x1c\x27\xd2\x94\xa8\xc8\x00\xc0\x52\xc5\x36\x4e\xdf\x00\x85\x30\x1f\xce\x8f\xe3\x48\xc8\xf1\xed\x24\x06\xae\x27\xb8\xef\x73\x98\x06\xea\xed\x22\x9a\xa3\x89\xea\x59\x6b\x2f\x51\x95\x42\xc9\x9c\x59\xbd\x08\x55\x96\x35\x86\x61\xc4\x43\xe7\x1b\x63\xb9\xc0\xbc\x5f\x6e\xb0\xe8\x19\xa9\x4e\xc6\xea\x6b\x92\x8a\x22\x6f\x1d\xb5\x5c\x60\xd5\xf7\xbf\x6d\x36\xdf\x91\x4a\x35\x22\x18\x86\xd5\x2c\x9b\x53\x3d\x88\x4f\x50\xbb\xce\xeb\x53\x9d\xcc\x27\xeb\xc7\xd9\x03\x53\x97\xe5\x27\xf6\xfd\x5f\x97\x37\x37\xdc\x22\xee\x4e\x92\x8c\xe3\xff\xca\xcb\xfd\xc4\x2f\xfa\xfb\xc2\x80\xa3\x33\xcb\xb5\x32\x10\x39\x25\x23\x6d\xfc\x99\x00\xb5\x97\xc6\xb4\xaf\x14\x4a\xbe\xec\xb9\xb9\x5d\x01\x47\xbe\x14\x8b\x4a\x7d\x38\x7b\xb7\xbd\x7b\xbc\x7c\xf4\xca\xaf\x70\xf6\x15\x00\x00\xff\xff\x41\x00\xc0\x66\x4d\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x31\x6b\xc3\x30\x10\x85\x77\xff\x8a\xb7\xa5\x1d\xac\x92\xd5\x5b\x09\x1d\x82\x29\x84\x14\xba\x9f\xad\xb3\x23\x22\x9d\x84\xa5\x84\xa6\xc6\xff\xbd\xc4\x76\x4c\x42\x29\x14\x3c\x98\xbb\xef\xdd\xbb\xa7\xcb\x41\xc1\x7c\x72\x17\x8d\x97\x02\x35\x39\xb6\x8a\x02\xd5\x07\x56\xbe\x6b\x5f\xce\x6b\xb2\xe1\x40\xeb\x0c\x38\x1a\xd1\x05\xb6\x92\xb8\xed\x28\x19\x2f\x3b\x4b\xa9\xf1\x9d\xcb\x00\xc7\x89\x34\x25\x2a\x32\x00\xb0\x54\xb1\x8d\xd3\x3f\x40\x21\xcc\x83\xf3\xe3\x58\x12\x72\x7c\x5f\x89\x81\xeb\x09\xee\xfb\x1c\xa6\x81\xfa\xb8\x88\xe6\x68\xa2\x7a\xd5\xda\x4b\x54\xa5\x50\x32\x67\x56\x6f\x42\x95\x65\x8d\x61\x18\xf1\xd0\xf9\xc6\x58\x2e\x30\xf7\x97\x19\x2c\x7a\x46\xaa\x93\xb1\xfa\xb6\x49\x45\x91\xb7\x8e\x5a\x2e\xb0\xea\xfb\xdf\x36\x9b\x6b\xfa\x52\x8d\x08\x86\x61\x35\xcb\xe6\xad\x9e\xc4\x27\xa8\x5d\xe7\xf5\xa9\x4e\xe6\x9b\xf5\xf3\xec\x81\x29\xcb\xf2\x88\x7d\xff\xd7\xe4\xcd\x1d\xb7\x88\xbb\x93\x24\xe3\xf8\xbf\xf2\x72\x3f\xf1\x8b\xfe\x31\x30\xe0\xe8\xcc\x72\x8b\x0c\x44\x4e\xc9\x48\xbb\x9c\xe3\xfa\xd5\x5e\x1a\xd3\xbe\x53\x28\xf9\xb2\xe7\xe6\xbe\x05\x1c\xf9\x52\x2c\x2a\xf5\xe5\xec\x43\xf7\xe1\x78\xf9\xe8\x95\x47\x4e\xc9\x48\x1b\xb3\x9f\x01\x00\x41\x00\xc0\x66\x4d\x02\x00\x00"),
 		},
 		"/addons/dv": &vfsgen۰DirInfo{
 			name:    "dv",
@@ -57,20 +68,20 @@ var assets = func() http.FileSystem {
 		"/addons/dv/addon-dv-server.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "addon-dv-server.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4520,
+			uncompressedSize: 5363,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5f\x6f\xda\x48\x10\x7f\xcf\xa7\x18\x71\x3a\xd1\x3e\x60\x92\xb4\x69\x5a\x4b\x79\x70\x03\x49\x39\x05\x70\x31\xe9\x9d\xee\x05\x4d\xed\xc1\x6c\x62\xef\xee\xed\xae\xe9\x21\x94\xef\x7e\xb2\x8d\xc1\x86\x25\x69\xa5\xd3\xe9\xca\x13\xde\xf9\xbb\xf3\x67\xe7\x37\x1d\x40\xc9\xbe\x90\xd2\x4c\x70\x17\x96\x67\x27\x00\x8f\x8c\x47\x2e\x04\xa4\x96\x2c\xa4\x13\x80\x94\x0c\x46\x68\xd0\x3d\x01\x00\x48\xf0\x2b\x25\xba\xfc\x0f\x80\x52\xba\xa0\x57\x3c\x22\xcd\xf4\xe6\xac\xfa\x74\x98\xe8\xbe\x44\x37\x2b\x49\x2e\x30\x3e\x57\xa8\x8d\xca\x42\x93\x29\xb2\xb0\x85\x22\x95\x82\x13\x37\x3b\x65\x9d\x68\x59\x30\x72\x4c\x69\xff\x54\x4b\x0a\x4b\x0f\xa5\x50\x66\xe3\x6c\xa7\xf8\x70\xe1\xfd\xe9\xc6\x80\x54\xc2\x88\x50\x24\x2e\x4c\xaf\xfd\xcd\x99\x41\x15\x93\xf1\x37\x8c\x1b\x56\x4d\x09\x85\x46\xa8\x7f\xeb\xd2\x47\x6e\xd3\x4c\x05\x4a\xa9\x1d\x21\x89\xeb\x05\x9b\x9b\x5c\xac\x96\x9c\x1e\xc9\x44\xac\x52\xe2\xe6\x5a\xf0\x39\x8b\x7f\xf2\x2c\x29\x92\x09\x0b\x51\xbb\x70\xf6\x5f\x06\xbc\x60\x33\x0a\x0d\xc5\xab\xca\x94\x22\x2d\x32\x15\xd2\x36\x76\x00\x09\x4b\x99\xa9\x7d\xe7\xb1\x4e\x85\x5a\xb9\xd0\x3a\xbf\x78\x37\x64\xad\x2d\x45\xd1\x5f\x19\xe9\x63\xbc\xa7\x3b\xd6\x32\xa0\x13\x0a\x15\xa1\x29\x43\x69\x28\x95\x09\x1a\xaa\x64\x9b\xf9\x3c\xcc\xe9\xb1\xb8\x7c\x4f\x6c\x7e\x20\xbf\x3f\x10\xca\x7a\x46\xcb\x2c\x16\x2f\x88\x17\x86\x22\xe3\x66\xd4\xac\x80\x9c\x48\x6a\xcb\x1b\x0a\x6e\x90\x71\x52\xb5\xfb\x75\xac\x55\x53\xfd\x88\x2f\xeb\xa1\xa8\x98\x7f\xf3\xbe\x78\x33\xcf\xf7\x67\xbd\xc1\xa4\x46\x06\x58\x62\x92\x91\x0b\xdd\x68\xdb\x3a\xfa\x98\xf8\xd8\x9f\x0e\xc6\xa3\xc0\x26\xde\xea\xf4\x1e\x70\x89\x0e\x27\xe3\x48\x45\x73\x52\x03\x7f\xf9\x36\x30\x18\x3e\x5e\x19\x95\x11\x74\x7a\x99\x26\xe5\x2c\x44\x4a\x57\x5d\x93\x4a\xb0\x0a\x78\x51\xa4\x48\x6b\xd2\x95\x50\x22\xe2\xb7\x0f\x4e\x22\xe2\x98\x94\x23\x54\xec\xa0\xc4\x70\x41\xce\xc2\x18\x79\xd5\xeb\x7f\xbc\xbf\x6d\x59\xbc\x1d\x79\xc3\x7e\xe0\x7b\xd7\xfd\x43\x57\x6f\x94\x48\xdd\xc6\x31\xc0\x9c\x51\x12\x4d\x68\xbe\x7f\xbe\xa1\xf8\x68\x16\xee\xb6\xee\x9c\xdc\x84\x96\x18\x92\xc5\xf0\xed\xf5\x6c\xe8\xfd\x31\x1b\xf6\xa7\x5e\x61\x7f\x16\x0c\xfe\xb4\x38\xe1\x42\xeb\xe2\xec\xdc\xe6\xf9\xc7\xfb\xc1\x5d\x6f\x36\x18\x7a\xb7\xfd\x59\x30\x9d\xf4\xbd\xa1\x4d\x7a\x57\x2d\xe7\xcc\x5d\xaf\xc1\x60\x3c\x9e\x83\x13\x54\xf5\x78\x5d\x15\xa3\x76\x82\xf3\x81\x33\x48\x31\x26\x78\x7a\xb2\xd8\xf3\xc7\xc1\xf4\x76\xd2\x0f\x3e\xdf\xcd\x7c\x2f\x08\x7e\x1f\x4f\x7a\x36\x83\xeb\xb5\x55\x79\x0f\x0d\x7e\x45\x4d\x8e\x8f\x5a\x7f\x13\x2a\x7a\xc9\xc6\x7d\xd0\xb7\x56\xdf\x4b\xfa\xef\x35\xa9\x97\x74\xf7\xbc\xa9\xf7\xd1\x0b\xac\xe1\x7e\x49\x7f\xde\x84\x56\xfd\x63\xbf\x3f\x0a\x3e\x0d\x6e\xa6\xb3\xa1\x37\xf2\x6e\xfb\xc3\xfe\x68\x3a\xbb\x9f\xdc\xcd\x6e\xc6\x93\x37\xc1\xb5\x77\x67\x35\xd7\x3e\x62\x2f\x28\x9a\xdb\xb9\x21\xcc\x5f\x12\xed\x0c\x91\x63\x4c\x79\xcf\xdd\xab\xe4\x46\xa8\x37\x3a\xc4\x84\x9e\x9e\xda\x27\xeb\x35\x9b\x83\xd3\xa3\x65\x90\xc9\x7c\x3c\x5b\x9d\x2b\x9a\xb2\x68\x02\x6b\x89\xe5\x2d\xd4\x3a\x59\xaf\x89\xe7\x79\x79\x56\x23\xcb\x2b\xc4\x85\x36\xe4\x96\x29\xd1\x64\xa5\xae\xd7\xb5\x1a\xf3\xa2\x48\x70\xed\xf4\xbe\x6c\xcb\xab\xbd\xb5\xb5\x27\xea\x67\x49\xe2\x8b\x84\x85\x2b\x17\x06\xf3\x91\x30\xbe\x22\x4d\xdc\xd4\xf8\x12\xb6\x24\x4e\x5a\xfb\x4a\x7c\xa5\x66\x1f\xe6\xad\x7e\x4b\x66\xbf\x39\x65\x13\x8b\xd4\x08\x45\xbb\xb6\xba\xd1\xb2\xbb\x3c\xeb\xea\x6f\x58\xbc\x1d\x0f\x5a\xf0\xd6\x1e\x67\xae\xf9\x13\x61\xd4\x78\x5d\x9b\x21\xf6\xc2\x90\xa4\x39\x78\x17\xaa\x3c\xa3\x2c\x06\xb4\x61\x82\x77\x73\x0b\xed\x06\x27\xe3\xcc\x30\x4c\x7a\x94\xe0\x2a\xa0\x50\xf0\x48\xbb\xf0\xae\xe9\xaf\x24\xc5\x44\xb4\xa5\x9e\x37\xa9\x86\xa5\x24\x32\xb3\x25\x5f\xd4\xa8\x8a\x30\x62\x3f\x18\xb3\xef\x0e\xcd\xd1\xe8\xfe\xdc\x31\xab\xc1\xde\xca\xe7\xed\x84\xf5\xed\x57\x2e\xef\x94\x5f\xfb\x39\xb1\x0f\x97\x97\x1f\x2c\x62\x52\x89\x94\xcc\x82\x32\xfd\xac\xcd\xcb\xcb\xf7\x16\xe1\x07\x91\x88\x47\x86\x35\xca\x37\xa1\x1e\x19\x8f\x7b\x4c\x1d\x1d\xd8\x4b\x91\x64\x29\x0d\x73\x64\xa1\x6d\x38\x20\x2c\x70\x71\xa7\x64\x6b\x18\x4d\x73\x99\x72\xd6\xd5\x75\x77\xc3\x0a\x49\x57\xbf\x5f\x20\x20\x03\x9f\x45\x00\x61\x82\x5a\x83\x11\xd0\xba\xcd\x50\x21\x37\x44\x51\x0b\x5e\x95\xc0\x10\xae\xae\xb6\xc0\xef\x75\x43\x7c\xba\x60\x1a\x22\x41\x9a\xb7\x4d\x71\x27\x10\x1c\xc6\xc1\x18\x50\x83\x59\x90\x22\x60\x1a\x10\xe6\xec\x6f\x8a\x40\xe5\x95\xd2\x10\x9f\x2b\x91\x96\xe0\x33\x37\x5d\x01\x53\x78\xf5\xfe\xf4\x57\x08\x33\xa5\x88\x9b\x64\xf5\xda\x81\x76\x65\xbd\x9d\xeb\x63\x31\x17\x8a\xa2\xd2\x40\xa3\x8d\x0e\x80\x2d\x58\xc1\x2d\xd4\x40\x6b\xfd\x7d\xdf\x3d\x84\x93\x4a\x95\x33\x2c\xf8\x1a\x2f\x61\xfe\x0b\x65\xe6\xc2\xe5\xc5\x69\xda\x38\xb7\xa1\xe3\xba\xb1\x02\x4c\xdb\x34\xbd\xa9\x6b\x2a\x33\x6a\x81\x89\xc7\x32\x5e\x9e\x0f\x51\xba\x96\xda\xdb\xc3\xa3\x9d\x5a\x11\x18\xc5\xf2\x67\x63\xbb\x3d\x96\x20\xb9\xdc\xb7\xae\x17\xc8\x63\x3a\x36\x6b\x3a\xe5\x38\x28\x99\x7c\x54\x98\xd6\xdc\xc5\xcc\x88\x14\x0d\x0b\x5d\xc8\x07\xd7\x21\x02\xce\x67\x74\xe3\x7a\x36\xfc\x3b\xdf\x83\x77\xe5\x5a\x58\x4c\xa8\xc0\x28\xc2\x74\x8a\xf5\x52\x3e\x00\xd2\x6e\xbe\x6a\x68\xb3\xc7\x52\xc0\xbd\x22\xeb\x63\x49\x3c\xc8\x17\x4f\x5f\x89\x07\x0a\x77\x53\xb4\x8c\xc2\x60\x77\xbf\x93\xbd\xbd\xb5\xb8\xfa\xd1\xc5\xb5\xe6\xe1\xc1\xce\x6a\x47\xfb\xff\xaf\x4d\x76\xb7\xe1\x18\x8c\xb7\xa5\x51\x7a\xde\x2a\x63\x5a\x8d\x98\x66\x8a\x9e\x4d\x50\x29\xdf\x3e\xcc\xcf\x0e\x6a\xfc\x13\x00\x00\xff\xff\x8a\x3b\x47\x14\xa8\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xd1\x73\xda\x38\x13\x7f\xe7\xaf\xd8\xe1\x9b\x6f\x68\x1f\x30\x49\xda\x34\xa9\x67\xf2\xe0\x02\x49\xe9\x04\x70\x31\xe9\xf7\xcd\xbd\x30\xaa\xbd\x80\x12\x5b\xd2\x49\x32\x39\xc6\xc3\xff\x7e\x23\x1b\x1b\xdb\x31\x49\xdb\xeb\xdd\x5c\x47\x2f\xa0\xdd\xfd\xed\x6a\x77\xb5\xde\x55\x17\x88\xa0\x5f\x50\x2a\xca\x99\x0d\x9b\xd3\x16\xc0\x03\x65\x81\x0d\x1e\xca\x0d\xf5\xb1\x05\x10\xa1\x26\x01\xd1\xc4\x6e\x01\x00\x84\xe4\x2b\x86\x2a\xfb\x0d\x40\x84\xb0\x41\x6d\x59\x80\x8a\xaa\xfd\x5e\xfe\xd7\xa2\xbc\xf7\x12\x5d\x6f\x05\xda\x40\xd9\x52\x12\xa5\x65\xec\xeb\x58\x62\x03\x9b\xcf\x23\xc1\x19\x32\x7d\x00\xeb\x06\x9b\x94\x91\x91\x08\xeb\xbb\x4a\xa0\x9f\x59\x28\xb8\xd4\x7b\x63\xbb\xe9\x1f\x1b\x2e\x4f\xf6\x0a\x84\xe4\x9a\xfb\x3c\xb4\x61\xde\x77\xf7\x7b\x9a\xc8\x15\x6a\x77\xcf\xb8\x67\x55\x18\xa2\xaf\xb9\xfc\x59\x87\x3e\x72\x9a\x6a\x28\x88\x10\xca\xe2\x02\x99\x5a\xd3\xa5\x36\x62\xa5\xe0\x0c\x50\x84\x7c\x1b\x21\xd3\x7d\xce\x96\x74\xf5\x8b\x47\x49\xa2\x08\xa9\x4f\x94\x0d\xa7\xff\xa4\xc3\x53\x36\x2d\x89\xc6\xd5\x36\x57\x25\x51\xf1\x58\xfa\x58\xf8\x0e\x20\xa4\x11\xcd\x93\x28\x5b\x11\x46\x5c\x6e\x6d\x68\x9f\x9d\xbf\x1b\xd3\x76\x41\x91\xf8\x7b\x8c\xea\x18\xef\xc9\x81\x35\x4b\xfb\x19\xfa\x12\x89\xce\x5c\xa9\x31\x12\x21\xd1\x98\xcb\x56\xe3\xf9\x34\xa6\xc7\xfc\xf2\x2d\xbe\xf9\x8e\xf8\x7e\x87\x2b\xcb\x11\x35\x4b\x65\x15\xc4\xf1\x7d\x1e\x33\x3d\xa9\x66\x80\x21\xa2\x6c\x25\x49\x17\x1e\xa9\x5e\x83\xe5\xed\x29\x96\x13\x04\x9c\x29\x6b\xf0\xc5\x72\x79\xa0\x76\xbb\x94\x87\x2e\xc1\x9a\xf0\x00\xbd\x7d\x66\xec\x76\x85\x1a\x56\xda\xb6\x21\x49\x34\xff\xa4\x38\x7b\xc2\x6e\x50\x90\x05\x25\xbc\x39\x0f\x51\x12\x4d\x39\x53\x25\x38\x7d\xd8\x2d\xa3\x55\x99\xab\x60\xd9\xaf\x1c\xc0\xe7\x4c\x13\xca\x50\x96\x42\xd5\x6d\xbc\x00\xf9\x42\xb6\x39\xb0\x1e\x98\x3f\x39\x5f\x9c\x85\xe3\xba\x8b\xc1\x68\x56\x22\x03\x6c\x48\x18\xa3\x0d\xbd\xa0\xa8\x02\xea\x98\xf8\xd4\x9d\x8f\xa6\x13\xaf\x49\xbc\xdd\x1d\xdc\x93\x0d\xb1\x18\x6a\x4b\x48\x5c\xa2\x1c\xb9\x9b\xb7\x9e\x26\xfe\xc3\x95\x96\x31\x42\x77\x10\x2b\x94\xd6\x9a\x47\x78\xd5\xd3\x91\x80\x46\x01\x27\x08\x24\x2a\x85\x2a\x17\x0a\xf9\xea\xed\xbd\x15\xf2\xd5\x0a\xa5\xc5\xe5\xca\x22\x82\xf8\x6b\xb4\xd6\x5a\x8b\xab\xc1\xf0\xc3\xdd\x4d\xbb\xc1\xda\x89\x33\x1e\x7a\xae\xd3\x1f\x3e\x35\xf5\x5a\xf2\xa8\xec\x1f\xb3\x96\x14\xc3\x60\x86\xcb\xfa\xfe\x9e\xe2\x12\xbd\xb6\x8b\x2b\x64\x19\x15\x4a\x10\x1f\x1b\x14\xdf\xf4\x17\x63\xe7\xff\x8b\xf1\x70\xee\xa4\xfa\x17\xde\xe8\xb7\x06\x23\x6c\x68\x9f\x9f\x9e\x35\x59\xfe\xe1\x6e\x74\x3b\x58\x8c\xc6\xce\xcd\x70\xe1\xcd\x67\x43\x67\xdc\x24\x7d\x48\xfc\x33\x6a\x27\x09\x68\xb2\x9a\x2e\x4b\x59\xdf\xcf\xef\x95\xb2\xbc\xb3\x91\x35\x8a\xc8\x0a\x61\xb7\x6b\xd0\xe7\x4e\xbd\xf9\xcd\x6c\xe8\x7d\xbe\x5d\xb8\x8e\xe7\xfd\x6f\x3a\x1b\x34\x29\x4c\x92\x46\xf0\x01\xd1\xe4\x2b\x51\x68\xb9\x44\xa9\x47\x2e\x83\x97\x74\xdc\x79\xc3\xd9\x8f\xe0\xdf\x29\x94\x2f\x61\x0f\x9c\xb9\xf3\xc1\xf1\x86\x3f\x82\x6f\xea\x49\x23\xfe\xd4\x1d\x4e\xbc\x8f\xa3\xeb\xf9\x62\xec\x4c\x9c\x9b\xe1\x78\x38\x99\x2f\xee\x66\xb7\x8b\xeb\xe9\xec\x8d\xd7\x77\x6e\x1b\xd5\x75\x8e\xe8\x33\x6d\x10\x4a\xeb\x1a\x89\x29\x8a\xca\x1a\x13\x46\x56\x68\xbe\xbc\x77\x32\xbc\xe6\xf2\x8d\xf2\x49\x88\xbb\x5d\xa7\x95\x24\xa6\x48\x0d\x70\xe3\xc5\xc2\x74\x1a\x8d\xc6\xa5\x97\x32\xbd\x04\x4d\x46\xb4\xcd\x15\x6a\xb7\x92\x24\xaf\x2d\xcf\x20\x52\x93\x21\x36\x74\xc0\x68\xc6\x50\x61\x23\x35\x49\x1a\x2b\x6b\x9e\x5e\x9d\x42\x57\x4d\xd4\x8d\xc3\xd0\xe5\x21\xf5\xb7\x36\x8c\x96\x13\xae\x5d\x89\x0a\x99\x2e\x8a\xa7\xa7\x89\xd4\xb1\x70\x25\xff\x8a\xe5\xf2\x69\xbe\xa8\x07\x4a\xf5\x7a\x9a\x0a\x70\x83\xba\x7e\x67\x45\xb5\xdb\x3a\x2c\x91\xde\xe2\x76\x2f\xd8\xf4\x36\xa7\x3d\xf5\x48\xd2\x92\x72\xaf\x38\x2b\xdf\xc3\x1c\xf9\x23\x92\xa0\x52\x74\xab\x9e\x77\x7c\x1f\x85\xae\x11\x0f\xe1\x27\x22\x6d\x41\x4c\x81\xef\x19\x0d\x9d\x0a\xa7\x40\x49\x79\xe0\xa1\xcf\x59\xa0\xaa\xb9\x59\x76\x84\xe5\x96\xf9\x2a\x5e\x01\x58\x12\x1a\xc6\x12\xe7\x6b\x89\x6a\xcd\xc3\xe0\x19\x98\xeb\x1a\x6b\xe3\x77\xc6\x34\x26\x1b\x64\xa8\xd4\x2f\xef\xea\x7d\x52\x31\xae\x9f\x4b\x2c\x00\xca\xa8\xa6\x24\x1c\x60\x48\xb6\x45\x30\xde\x9d\x34\x3a\xe7\x49\xd0\xce\xaa\x67\xd6\x34\x42\x1e\xeb\x82\x7c\x5e\xa2\x4a\x24\x01\xfd\x4e\xcf\x7e\xb3\x03\x8f\xc6\xe0\xef\x4e\xe2\x63\xde\xfb\x39\x3e\x2b\x4d\x5a\xb9\xcd\x45\x27\x54\x9b\xa7\xf2\x95\x65\x8b\x39\xf6\x73\x62\xef\x2f\x2e\xde\x37\x88\x09\xc9\x23\xd4\x6b\x8c\xd5\x73\xc2\x97\x17\x17\x97\x0d\xc2\xf7\x3c\xe4\x0f\x94\x94\x28\x8f\x5c\x3e\x50\xb6\x1a\x50\x79\xb4\xb1\xda\xf0\x30\x8e\x70\x6c\x9a\xd9\xda\x41\xb3\x83\xf8\xe9\x28\xd6\xcd\xd8\x4a\x74\x80\xc8\xc8\x64\x3d\x49\x19\xbb\xe7\xe7\xc3\x5b\xbe\xfe\x03\x1e\x6a\xf8\xcc\x3d\xf0\x43\xa2\x14\x68\x0e\xed\x9b\x98\x48\xc2\x34\x62\xd0\x86\x57\xd9\x2c\x02\x57\x57\xc5\xac\xf1\xba\x22\x3e\x5f\x53\x05\x01\x47\xc5\x3a\x3a\x3d\x13\x70\x06\x53\x6f\x0a\x44\x81\x5e\xa3\x44\xa0\x0a\x08\x2c\xe9\x1f\x18\x40\xda\xcf\x56\xc4\x97\x92\x47\xd9\xbc\x63\x54\xe7\xb3\x10\xbc\xba\x3c\xf9\x2f\xf8\xb1\x94\xc8\x74\xb8\x7d\x6d\x41\x27\xd7\xde\x31\x78\x74\xc5\xb8\xc4\x20\x53\x50\xc2\x6b\x98\xa5\x9a\xe7\xa9\xf2\x9c\x94\x24\x5c\x36\x7e\xb3\xcc\x34\x60\xcd\x72\x48\x6b\x9c\xce\x60\xb7\xa9\xad\x4d\xec\x75\xce\x5a\x71\x00\xf0\x45\xfc\xed\xda\xfa\xee\x5d\xa6\xaa\x7d\x71\x7e\x12\xb5\x6b\x60\xb9\x37\x7e\xce\x99\x66\x19\x5a\x3e\x5d\xfe\x65\xc3\x0b\xbc\x37\x35\xd3\xb3\x4c\x2d\x59\xfd\x52\x26\x67\xfb\x63\x22\xaa\x07\xad\xcd\x36\xd9\x68\xd7\x2d\x25\xb7\x96\xd4\x94\xc3\xbd\xa6\xee\x7e\xfc\xcd\x9e\x2e\xfa\x6b\xc2\x56\x78\xac\xd7\xe9\x66\xed\x48\xc6\xe4\x12\x49\xa2\x92\xb9\x24\xd6\x3c\x22\x9a\xfa\x36\x98\xc6\xa9\xd8\x2f\x6a\x80\xe9\x11\x4b\xfc\xdd\xc6\xf9\x6b\x59\x1b\x2f\xb2\xe7\xaf\xb4\x43\xf2\xb4\x44\x12\xcd\xc9\xaa\x75\xf4\xb0\xc1\xc6\x36\x53\xbb\x2a\x17\xe5\x62\xdc\x30\x61\xb2\xa6\x02\x99\x67\xde\x70\x5c\xc9\xef\xd1\x3f\x74\x71\x99\x17\x46\x87\xf3\xb5\x6a\x4f\x40\xe9\xd1\x8f\xbe\x01\x95\x2c\x7c\xf2\xfc\xf3\xc4\xc8\x7f\xe1\xa3\xd0\xe1\xb1\x40\x93\xd5\xde\xaa\x3c\x01\xdb\x99\x4f\xdb\xad\xa6\x10\x3d\x1b\xa0\x4c\xbe\xf3\x34\x3e\x9d\x56\x92\x20\x0b\x76\xbb\xd6\x9f\x03\x00\xac\x09\x32\x8d\xf3\x14\x00\x00"),
 		},
 		"/addons/jaeger": &vfsgen۰DirInfo{
 			name:    "jaeger",
 			modTime: time.Time{},
 		},
-		"/addons/jaeger/syndesis-jaeger.yml": &vfsgen۰CompressedFileInfo{
-			name:             "syndesis-jaeger.yml",
+		"/addons/jaeger/syndesis-jaeger.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-jaeger.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 940,
+			uncompressedSize: 2476,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x52\xb1\x8e\xdb\x30\x0c\xdd\xfd\x15\x0f\xc8\x5a\xa7\x0d\x7a\x08\x0a\xad\x9d\xda\xa5\x07\xf4\xd0\x9d\x51\x18\x5b\x8d\x2c\xa9\x24\x7d\x38\xff\x7d\x61\x3b\x76\x92\xc3\x15\x5d\x4f\x93\xc8\xf7\x44\xbc\xc7\xa7\x1a\x54\xc2\x2f\x16\x0d\x39\x39\xfc\x26\x6e\x58\x4c\xc8\x87\xd4\x6c\x43\xfe\xf8\xbc\xab\x80\x73\x48\x47\x87\xef\x13\x56\x01\x1d\x1b\x1d\xc9\xc8\x55\x00\x10\xe9\xc0\x51\xe7\x3b\x40\xa5\x38\xe8\x90\x8e\xac\x41\x2f\xbd\xa5\x1c\xe7\xfd\x0f\xb7\xa1\xb0\x43\x48\x27\x21\x35\xe9\xbd\xf5\xc2\x6f\xd0\x7c\xee\x4a\x4e\x9c\x6c\x91\x3c\x71\x12\x75\x7c\x9d\x5e\xaf\x88\x16\xf6\xb3\x40\x35\x21\xe3\x66\x70\xa0\x18\xbf\xa5\x1f\x69\x1e\xbe\x14\x8b\x8b\x5c\x2c\xe4\xb4\x9a\x1a\x2d\x77\x59\x86\x6b\x0d\x74\xf4\x52\x8f\x7b\x62\x75\xd8\x7d\x1a\xcf\x05\x0c\xa9\x11\xd6\x9b\xb7\x9c\xe8\x10\xf9\xe8\x70\xa2\xa8\x5c\x6d\xaa\x0d\x9e\xda\xa0\x08\x0a\x65\x79\x0e\x9e\xc7\x6b\xcb\xc2\x20\x05\xa1\x25\x7f\x86\x65\x74\x59\x18\x4c\x1a\xe2\x00\xf2\x9e\x55\x61\x2d\xe3\x4f\xcf\x32\x8c\xa1\xe1\x24\xb9\x5b\xdd\x7e\xc0\x61\x28\xa4\x1a\x52\x53\x6d\x26\x62\xa6\xde\x5a\x14\xc9\x2f\xc3\x76\x5b\xdd\xe7\x7c\x13\xeb\xcf\x59\xc3\xfb\xcf\x35\x4d\x86\xea\x57\xf1\xd6\xd3\x3e\xee\x42\x2e\x59\x6c\x15\x5e\x5f\x5e\x2f\xb4\xf9\x8c\x14\x87\x87\x87\xcf\xd7\x8e\x64\xcb\x3e\x47\x87\xa7\xaf\x8f\x6b\xd7\x48\x1a\xb6\xc7\x89\xbd\xdb\xef\xbf\xec\xe7\x5f\xc4\x91\xbd\x65\xb9\x5b\xce\x8d\xde\xa9\xb3\x3d\xf7\x07\x96\xc4\xc6\xaf\x9d\x51\x8c\x75\x48\x75\x4e\xfc\x4f\xf6\xdb\x3f\xf9\x6f\x00\x00\x00\xff\xff\x3f\x58\x56\x5e\xac\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4b\x6f\xa4\x46\x10\xbe\xcf\xaf\x28\xad\x2d\xed\xc5\x10\x5b\x59\x59\x11\x52\x0e\x2b\xef\x1e\xbc\xca\x63\xb4\x33\x9b\x4b\x14\x45\x65\x28\x98\xce\x34\xdd\xb8\xba\x71\x8c\x10\xff\x3d\xea\x06\x1a\x98\xd7\x26\x39\xad\xec\x03\xf5\xfa\xaa\xbe\x2a\xa8\x9a\x08\xb0\x12\xbf\x11\x1b\xa1\x55\x02\x7f\x21\x15\xc4\x96\x31\x15\xaa\x88\x85\xfe\xee\xe5\x6e\x05\xb0\x17\x2a\x4b\xe0\x93\xb7\xad\x00\x4a\xb2\x98\xa1\xc5\x64\x05\x00\x20\xf1\x89\xa4\xe9\x9f\x01\xb0\xaa\x12\x30\x8d\xca\xc8\x08\x33\xe8\x46\xd1\xe1\x7d\xcd\x6e\x9b\x8a\x12\x10\x2a\x67\x34\x96\xeb\xd4\xd6\x4c\x27\xdc\x52\x5d\x56\x5a\x91\xb2\x63\xc9\xde\x47\x61\x49\x13\x7a\x14\x2c\xa6\xa2\xb4\x2f\xd0\x58\x46\x4b\x45\x93\x00\x4a\xf9\xa8\x7e\x55\x3d\xf8\x28\x8c\x2c\x74\x65\x85\x56\x81\x94\xa3\x5c\x6a\x6e\x26\x19\xa0\xc4\xd7\xc8\xf5\x89\x4c\x02\x6d\x2b\x72\xa0\x67\x88\x37\x63\x89\x6b\xd6\xb9\x90\x04\x6f\x32\x7a\x79\xd3\x75\x77\xb7\xb7\xb7\xb7\x6d\x4b\xd2\xd0\x20\x38\x49\x65\x5d\x37\x20\x0a\x55\x30\x99\x59\x42\x52\xf8\x24\x29\x4b\x20\x47\x69\x68\xd5\xb6\x11\xfc\x2d\xec\x6e\x96\xe2\x7d\x96\x69\x65\xe2\x7e\x2a\xf1\x5a\x67\xa6\xeb\xbc\x9f\xc8\x21\xfe\x45\x67\xb4\x21\x49\xa9\xd5\x1c\x92\x60\x9e\x0b\x25\xec\x8c\x86\xd2\x19\xbd\x3f\xd2\x02\x30\x3d\xd7\x82\x29\xfb\x50\xb3\x50\xc5\x26\xdd\x51\x56\x4b\xa1\x8a\xc7\x42\xe9\xa0\xfe\xf8\x4a\x69\xed\xfa\x34\x8f\x04\x50\xb3\xd4\x5b\xe2\x72\x46\xca\xfd\x47\x50\xa2\x4d\x77\x1f\x5f\x2b\x47\x78\xec\xb2\xab\x9b\x51\x15\x04\xd7\x7b\x6a\x6e\xe0\xfa\x05\x65\x4d\x90\xfc\x78\x86\xca\xf8\x17\xc1\x9e\x1a\x37\x00\xab\x3f\x19\xad\x7c\xf4\x91\x93\x9b\x27\x31\x5a\xcd\x09\x3c\xaa\x23\xa3\x4f\x65\x12\xf8\x7d\x42\xf1\xaa\xae\xfb\x63\xa8\xac\x9f\xd4\xf2\xc9\x75\x79\xab\xa5\xc3\x75\x24\x42\x52\x3b\xe9\x66\x75\x1d\xb8\x2e\xa1\xfa\xc1\x7e\x26\xa3\x6b\x4e\x69\x36\x46\xcd\x10\x3f\xac\xbf\x7c\xa6\xe7\x9a\x8c\x85\xf8\x67\xff\x16\x06\xf1\x61\xfd\xe5\x27\x51\x8a\x60\xf0\x42\x28\x84\x47\xbc\xe4\x00\xee\x42\x0c\x80\x74\x72\x18\x89\xc8\xa7\x88\x99\x13\x40\x5a\xd5\x8e\xdd\xd2\x38\xb1\x9a\xa2\x4f\x67\x09\x1f\x94\xc3\x38\x74\xb9\xd0\xf1\x8b\x0d\x99\xe1\xbb\xf7\x97\xcc\x11\x8f\x63\xc7\x05\x93\xb9\xf9\x3c\x97\x53\x20\x47\x6c\x4e\x43\xfd\xdb\x27\x07\x69\xb0\xac\xdc\x27\x77\x76\x25\x65\x94\x63\x2d\xed\x9f\x61\xa5\x05\x0b\x40\xbf\x45\xdb\xf6\xdc\xb6\xd8\x0c\xe0\xf1\x87\x1e\x24\xde\x36\x15\x2d\x18\x55\xc8\x58\xfe\x27\x88\xb5\x8b\x18\x68\xb8\x6e\x7d\x2d\xee\x51\x59\x2a\xa6\x4f\x62\x4c\x6c\x88\x5f\x44\x4a\x23\x2d\x41\x87\xfb\xc1\x2d\xf9\x1b\xb8\x1e\x69\xfb\x1d\xf1\x3f\x73\x45\x63\xb6\x04\xde\xb6\xad\x47\xee\xba\xb7\x27\xfa\x18\xb2\x5d\x68\xd4\xe4\x33\x76\xe2\xf4\xe8\xaf\x56\x57\xb0\xdd\x09\x03\xc2\x8c\xe9\xdd\xe3\x8e\x98\x00\x0d\x20\xec\x30\xdd\x83\xd5\x50\x6a\x26\x20\x34\x42\x36\x80\x69\x4a\xc6\x80\xdd\x11\x3c\xd7\xc4\x8d\x3b\xda\x90\xb3\x2e\xc3\xb5\xbb\x81\xa7\xa6\x42\x63\x84\x2a\x56\x57\xde\x51\x63\x6d\x77\x50\xb1\x7e\x6d\xe2\x78\xb5\xbc\xf3\xb3\xb3\xbe\xe9\x6b\xf8\xf6\xef\xba\xf2\x84\xa2\x83\xf3\x1e\xf9\x7e\x2c\x8e\x7c\xa5\xd9\x86\xc2\xa3\x21\x7a\x74\x1b\xa6\xa6\xd9\x26\xf0\xee\xdd\xf7\x93\x86\xb5\xd5\xa9\x96\x09\x6c\x1f\xd6\x41\x6b\x91\x0b\xb2\x6b\xef\x7d\x77\x7f\xff\xc3\xbd\xb7\x98\xe1\x1c\x2d\x9a\x33\xab\xd7\x6b\xe2\x7d\xfd\x44\xac\xc8\xd2\x21\x33\x94\x32\x12\x2a\xd2\x8a\xce\x7a\x9f\xfe\x25\xf3\xcf\x00\x07\x24\xac\x2c\xac\x09\x00\x00"),
 		},
 		"/addons/knative": &vfsgen۰DirInfo{
 			name:    "knative",
@@ -90,98 +101,98 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 102726,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5d\x6f\xdb\x38\xd6\xc7\xef\xf3\x29\xf8\x08\x83\x07\x9d\x45\xd2\xd8\x4e\x9c\xb6\x01\xf6\x22\xf3\xb6\x28\xd0\xdd\xcd\xb6\xb3\x05\x76\xdb\x20\x60\x24\xc6\x66\x23\x4b\x1a\x92\x4a\xe3\xed\x66\x3e\xfb\x82\xf2\x8b\x44\x9a\xb2\xe4\xa8\x63\x4b\xee\xbf\x17\x33\x0d\x49\xc9\x3a\x87\x87\xe4\xf9\x9f\x5f\x6a\xd1\x84\xbf\x67\x42\xf2\x38\x3a\x27\x3c\x52\x6c\x24\x18\x55\xe1\xf4\x79\x2c\x46\xc7\xf7\x7d\x1a\x26\x63\xda\x3f\xb8\xe3\x51\x70\x4e\xfe\x22\xe8\x2d\x8d\xe8\x4f\x54\x8e\x6f\x62\x2a\x82\x83\x09\x53\x34\xa0\x8a\x9e\x1f\x10\x12\xd1\x09\x3b\x27\x72\x1a\x05\x4c\x72\x79\xc4\xa3\x5b\x41\x8f\x68\xc2\x8f\x82\xe5\x70\x42\x42\x7a\xc3\x42\xa9\x87\x13\x42\x93\x24\x1f\x9f\xb5\x2c\x7e\x78\xce\xe3\xe3\xf5\xbd\x6a\x9a\x30\xfd\xb8\xb7\x82\x4a\x25\x52\x5f\xa5\x82\x1d\xc8\x84\xf9\xf5\x9e\xe4\xf9\x27\x19\x47\x07\x84\xe8\xff\x9d\x93\xff\x66\xb7\xff\x92\xfd\x97\x10\x8f\x46\x51\xac\xa8\xe2\x71\x24\xbd\xf3\x65\x33\x21\x5e\xc8\xa5\xf2\xce\xc9\x87\x65\x0b\x29\xf4\x66\x23\x6e\x52\x1e\xaa\xd7\x91\x77\x4e\xfa\x87\x66\x8f\xf6\x92\x8c\x53\xe1\x33\xef\x9c\x78\x47\x47\x0b\x5f\x92\xa3\x23\xcf\x1a\xca\x22\x7a\x13\xea\x61\x4a\xa4\xcc\xea\x1b\xf3\xa0\xa4\x87\xfb\x71\xf4\x63\x1c\xc6\x42\xdf\x5f\x8c\x6e\xe8\xb3\xde\x21\x19\xf4\xfb\x87\x64\x30\x1c\x1e\x92\xfe\xf7\xf6\xc7\x68\x2f\xe9\xb1\x17\xb9\xb9\xe4\xff\xc9\x45\xc8\x84\x92\xf6\x58\xed\x6e\x3d\x76\xe9\x40\xaf\xd0\xff\xb8\xfc\xfb\xd5\xfc\x6f\x8f\x8b\xcb\x3d\x16\x70\xe5\xb0\xc6\x1b\x45\x4c\xbd\x0e\xbc\x73\x12\xa5\x61\x98\xb7\x0a\x9a\x8c\x7f\x8d\xe3\x50\xf1\xc4\x3b\x27\xbd\x65\x07\xd7\x43\x07\x85\x9f\x15\x13\xd9\x23\x6b\x4f\x0f\xcf\x06\x67\xbd\x57\x67\x67\xbd\xfe\xc9\xd2\xeb\x5e\xc8\xa3\x3b\x69\x4c\x56\x71\xaa\x32\x6f\x69\x83\xd8\x83\x62\x22\xa2\x21\xd1\xe3\x0d\xab\x3d\x1e\xf9\x61\x1a\xb0\xf7\x54\x48\x87\xc7\x3d\x45\x47\xd2\x0a\x06\x42\xbc\x45\xd0\xd9\x0e\xcc\x62\xb0\xe8\xb4\x2b\xf3\x66\xb6\x7f\x65\x3e\x76\xe1\xde\xe5\x15\x5e\x42\x23\x16\xae\x31\xce\x8f\xc3\x90\x26\x92\x69\xa7\xdd\xd2\x50\x9a\x0f\x3e\x12\x3c\xb8\x8c\xcd\xd0\xce\x3a\xc6\x8e\xb0\xfd\xac\xfd\x7e\x6a\x35\x3e\x14\xe7\x66\xde\x36\xd5\x6d\xc5\xa0\x30\x7d\x99\xcd\x9f\xd1\x94\x1b\x61\xba\x42\xb0\x84\x51\xbd\xcc\xbc\x54\x70\x73\x46\xa4\x1f\x27\x2c\x98\x4f\x88\xf5\xf0\x7a\xb0\xdd\xa8\x2f\x61\x21\xf3\x95\xdb\x13\xd9\x00\xc5\x1e\xb2\x0f\x3b\xf6\xe3\x28\x62\xfe\x6c\xd5\xaf\x8c\xba\xa7\x61\xca\x56\x86\x19\xa3\x1e\x4b\x8d\x57\x5c\x65\x0b\xc0\xfb\xf0\x21\x15\xfc\xea\xca\x73\x4e\xbd\x88\x3f\x17\xe6\xfc\xd0\x3d\xb3\x34\xe4\x54\x66\xab\x3c\x73\x81\xf9\x39\x37\x33\xc7\xac\xce\xb8\x8e\xaa\x37\x2c\x1a\xa9\x6c\x86\x7b\x2b\x7d\xac\xec\xb2\xe2\x96\x75\x29\xe2\x09\x53\x63\x96\x9a\xee\xf1\x6e\x79\x18\xda\x81\xb3\x3e\xc6\xce\x1c\x31\xd6\x1f\xd4\x8c\xb1\xfe\xfa\x18\x33\xef\xe3\x85\x6c\xc4\xa2\x60\xf5\x31\x68\xc8\x47\xd1\x85\xfc\x75\xbe\x35\x39\x42\xc3\xa3\xf7\xa3\x92\x1e\x3f\x15\x82\x45\xaa\xa4\x57\xef\xd0\x3f\x4f\x12\x35\x5d\xd3\xff\x6f\x26\xe2\x92\xee\x09\x7d\x28\xeb\xe1\x51\x49\x8f\xe0\xa3\xb1\x7a\x37\x3b\x19\x5c\xfd\x72\x1c\x7f\x76\x1f\x1a\x2a\x56\x34\x2c\xb9\x2a\x8b\xf9\x65\x64\x94\xba\x3d\xe4\x11\x73\x6e\x90\xba\xe3\x33\x0f\xd4\xca\xb6\x92\xef\xcd\xe6\xca\xd7\x47\xc1\x65\xcc\x23\xf5\xd7\x38\x33\x25\x6b\x30\x83\x2d\x61\xc2\x67\x91\xa2\x23\xa7\xa9\x5e\xa2\xaf\x16\x34\xe0\xa9\xbe\xfd\x70\xb5\xcf\x1d\xe8\x82\x45\x01\x13\x2c\x3b\x39\x6f\xc3\x58\x79\xee\x0d\xa9\x78\x54\x15\x7a\x7e\xe2\x62\xb6\x21\xe8\xcb\xc7\x7b\xb1\x63\x49\x26\x38\x93\x7f\xbf\x67\x42\xf0\x80\x39\xa6\x4a\x26\xd4\x67\x65\x5b\x8a\x54\xd4\xbf\x73\x3a\x5a\x2a\x96\x24\x2c\x78\xc3\x23\xf7\xfc\x29\x2a\x46\x4c\xad\x1e\xab\x2b\x4e\x62\x0f\x49\x36\x5b\x32\x9d\x3c\x13\x54\xb1\x67\x63\xa5\x92\x6b\xc9\xc4\x3d\x13\xd7\x82\xfd\x96\x32\xa9\xe4\xb5\x64\x7e\x1c\x05\xf2\xda\x8f\xd3\x48\x7d\xd1\xf9\x4e\xf6\xdc\x7f\xfe\xe8\x7d\xb7\xfc\xe1\xa3\x77\xf8\x29\xbe\xd1\x4d\x9f\xe2\x9b\x8f\xde\x61\x2a\xb8\xfe\x21\x15\xfc\xa3\xf7\xf8\x61\x38\xb9\xfa\xde\x4e\x9c\xf4\x9e\x17\x8b\xc9\xec\x8c\x52\x7c\xc2\xae\x67\xee\x5a\x1d\xa6\xb3\x69\x71\x4f\xc3\x5f\xa8\xaf\xb2\xbc\xac\xbf\x32\x64\xb6\x41\xfd\xb2\xbc\xdf\xe2\xd9\x49\xc2\x04\x99\x3d\xff\xea\x7d\x05\xbb\xcd\x72\x27\xef\xc2\x9a\xd0\xc3\x36\x78\xed\x50\x2a\xaa\x52\xf9\x7f\xbf\x7f\xf4\x06\xcf\x9f\xef\xc4\x8b\x4c\x88\x58\xd4\xf4\xe1\x0f\xe5\x8b\xc2\xca\xd1\xc6\x82\xc9\x71\x1c\x06\x8e\xf5\xa0\x0d\xf8\x45\xc4\x13\xd7\x2e\xa1\xfb\xde\xb2\xd1\x5c\x4e\x38\x2e\x7c\x37\xe6\xb7\xce\xfd\x65\x99\x38\xbc\xad\x88\x0a\x4f\x2d\x73\xe6\x2f\xf6\xd6\x4f\x45\xb6\x97\x38\x36\x7f\x19\x0b\xe5\x38\x65\xb3\x9d\xe3\x7a\x91\x96\xf0\x28\xe0\xf7\x3c\x48\x69\xe8\x95\x27\x38\x82\x46\x32\xa1\x65\x87\xe2\x32\xc5\xc9\xd2\x7b\xf3\xc1\x1f\xe8\x03\x77\xec\x8d\x37\xa9\x7f\x37\xdb\x08\x6c\xa7\xe8\x83\x70\x7e\x3c\x68\xdf\x95\x88\x1a\xc7\x55\xe5\x87\xe0\xf2\xa0\xfb\x70\x55\x6a\xe2\x94\x3e\xb0\x1a\xfb\x52\xc0\x7c\x3e\xa1\x59\x5a\xdb\x5b\x13\xef\x72\xac\x5d\xbf\x1a\xc6\x5a\x1c\xeb\x7e\x47\x57\x3c\xfa\x81\x4a\xe6\x8c\xfe\x59\xca\xb0\x6a\xf2\x32\x67\xf0\x7a\xab\x37\x2c\xb8\x63\xa3\x3d\x64\x67\x46\x38\xbb\x4a\xad\x28\x5d\xc5\x53\x77\xc0\x65\x09\x61\x59\x26\xa8\xfb\xde\xb0\xfb\xcc\x2c\xfd\x18\x07\xae\x0f\xea\x5e\xca\xde\xfb\x03\x52\xf6\x95\xc6\x3a\x39\xfb\x09\x72\x76\xe4\xec\x7f\x40\xce\x8e\xcc\x7c\x77\x99\xf9\xb3\x7a\x49\xa6\x4c\x27\x4f\x4f\xcc\xc9\x31\xd9\x8a\x00\xf8\x9e\xfc\x89\xf4\x7b\x3d\xc7\x29\x5a\x33\x85\x1d\x97\xae\xf3\xa7\xe5\xb7\xf4\x9e\x09\xbd\x9e\xbe\xba\x32\x18\x73\xa9\xe2\x91\xa0\x93\xeb\xdf\x52\x1a\x29\x1e\xb2\x67\xbd\xe7\xc3\xc3\x9a\x6e\x9e\x65\x6d\x0d\xe6\xf3\x66\x4a\x9e\x85\xac\x7d\xfe\x1e\xf6\xd4\x98\xcc\xf7\x31\x1e\xae\xf3\xfb\x8f\x5f\xd1\xef\xaf\x7a\x9d\x73\xfc\x13\x7c\xfb\xaa\xb6\x6f\x6d\xa5\xf6\x74\xdf\xf6\xbf\x05\xc7\xea\xac\xa4\xdc\x99\x3f\x77\x40\xf6\xbe\xa1\x8a\x45\xfe\x14\x52\xb7\xc3\x52\x77\xe2\x08\x66\xe8\xdc\x3d\xd4\xb9\xbb\x82\x8e\x2f\x2a\xc4\xe5\xa9\xa9\x28\xaa\xa8\x63\x49\x91\xff\x75\x15\x70\x2e\x8c\xbd\xd4\x9f\xf1\x7a\x95\x78\x6e\x4b\x72\x1c\x7f\xe1\xc1\x63\x4d\xdd\x31\x1b\x5b\x53\x7c\x00\x64\xe6\x1d\x8d\x41\xe6\xcb\x8a\xb8\x1d\xa2\x28\x82\xa2\x48\x6b\x40\x66\x93\x5d\xd0\x62\xf2\xb3\x6e\x16\xfc\x30\x7d\xeb\x9c\xcd\xee\xef\x93\x9d\x29\xd2\x00\x9f\x1a\x13\x5a\x53\x50\x02\x9f\x02\x9f\x42\x53\x9a\x06\x03\x9f\x3a\xcc\x86\xac\x6c\x2e\x2b\xdb\x2b\x14\xb6\x86\x4f\xab\x94\xc2\x19\x94\x02\x94\xc2\x96\xf1\x69\x23\x3d\x70\x02\x3d\xd0\x4e\x3d\x00\x68\x5b\x18\x06\x68\x0b\x68\xbb\xb9\xdf\x01\x6d\xc9\x5a\x7d\xf8\x74\xdf\x02\xda\x02\xda\x42\x60\x97\x98\x08\x68\x0b\x75\xdd\x21\x68\xdb\x3f\xad\xd0\xb4\x2f\x4c\x21\xb3\xaf\xd4\x96\xdd\xb3\x48\x1d\x0b\xa6\x8f\x32\x5a\xf9\xfb\xa2\x8e\xd1\x35\x15\x08\xc8\x6d\xde\xd1\xfc\x9f\xa0\x0e\x2b\x82\xf7\x25\x0a\x32\x28\xc8\x00\xdd\x76\x75\xb3\xec\x4c\xb9\x06\xf8\xd6\x98\xd0\x9a\xd2\x12\xf8\x16\xf8\x16\xea\xd2\x34\x18\xf8\xd6\x61\x36\x04\x66\x73\x81\xd9\x5e\xb5\xb0\xbd\x7f\xfd\x5a\x25\x17\x5e\x41\x2e\x40\x2e\x80\xdf\x16\x9b\x21\x0a\x96\x86\x82\xe1\xb6\x88\x29\x82\xe1\x82\xe1\xb6\xd2\xf1\x60\xb8\xed\x71\x2c\x18\x6e\xa1\x0f\x2a\x9b\xec\x46\x65\x83\xe1\x6e\x68\x44\x57\x25\xf6\xae\x18\xee\xa0\xe2\x6b\x9d\x06\x66\x0a\xbc\xbf\x0c\xf7\x41\xb1\x48\x56\x2a\x8f\x7c\x54\x4d\xc5\x01\x66\x9b\x77\x34\x66\xb6\x83\x41\x45\xb0\xf6\x51\x84\x41\x11\x06\xcc\xb6\x2b\x9b\x63\x67\xca\x31\x60\xb4\xc6\x84\xd6\x94\x8e\x60\xb4\x60\xb4\x50\x8f\xa6\xc1\x60\xb4\x0e\xb3\x21\x20\x9b\x0b\xc8\xf6\xaa\x83\xad\x31\xda\x4a\x79\x80\xd7\x8a\x40\x1e\x80\xd1\x1a\xcd\x10\x01\x60\xb2\x86\xb5\x60\xb2\xad\x24\x58\x60\xb2\xdd\x41\x87\x60\xb2\x60\xb2\x60\xb2\x7b\xab\xaa\xc1\x64\x37\x34\xa2\xab\x92\x7a\x67\x4c\xb6\xe2\xbb\xa2\x06\xa6\x2c\xd8\x5b\x26\x3b\x7b\x8f\x75\xf5\xef\x83\x1a\xe3\x6a\xaa\x0e\x70\xd9\xbc\xa3\x39\x97\x7d\x55\x11\xb0\xa7\x28\xbc\xa0\xf0\x02\x2e\xdb\xa5\x0d\xb2\x33\x65\x19\xb0\x59\x63\x42\x6b\x4a\x48\xb0\x59\xb0\x59\xa8\x48\xd3\x60\xb0\x59\x87\xd9\x10\x92\xcd\x85\x64\x7b\x15\xc2\xf6\xd8\x6c\x95\x44\xc0\x9b\x52\x20\x11\xc0\x66\x8d\x66\x08\x01\xf0\xd9\xa7\x66\xcc\xe0\xb3\xe0\xb3\x9b\xfb\x1d\x7c\x96\xac\x15\x86\x4f\xf7\x2d\xf8\x2c\xf8\x2c\x94\x75\x89\x89\xe0\xb3\x90\xd5\x1d\xe2\xb3\x27\x15\xdf\x05\x35\x30\xdf\xe5\xf3\x4d\xf0\xd9\xca\xd7\xae\xac\x0e\xae\xa9\x3f\x40\x6a\xf3\x8e\xc6\xa4\xf6\xe4\xac\x22\x74\x5f\xa0\x0c\x83\x32\x0c\x48\x6d\x37\xb7\xca\xce\x94\x6a\xc0\x6c\x8d\x09\xad\x29\x2b\xc1\x6c\xc1\x6c\xa1\x2c\x4d\x83\xc1\x6c\x1d\x66\x43\x5c\x36\x17\x97\xed\xd5\x0a\x5b\x63\xb6\x95\x62\x01\xaf\x48\x81\x58\x00\xb3\x35\x9a\x21\x09\xe6\x66\x82\xde\xb6\x88\x26\x82\xde\x82\xde\xb6\xd2\xf1\xa0\xb7\xed\x71\x2c\xe8\x6d\xa1\x0f\x1a\x9b\xec\x46\x63\x83\xde\x6e\x68\x44\x57\x05\xf6\xae\xe8\xed\x69\xd5\xb7\x44\x99\x6f\xf2\xf9\x76\xe8\xed\x71\xc0\x92\x30\x9e\x4e\x98\x96\x6e\x9b\x68\x11\xe3\xc2\x9a\xba\x04\x54\x37\xef\x68\x4c\x75\x4f\x4f\xd6\x87\xf4\x49\x0f\x85\x1a\x14\x6a\x40\x75\xf7\x6b\x0b\xed\x4c\x69\x07\xb4\xd7\x98\xd0\x9a\x32\x14\xb4\x17\xb4\x17\x4a\xd4\x34\x18\xb4\xd7\x61\x36\xc4\x68\x73\x31\xda\x5e\x0d\xb1\x35\xda\x5b\x29\x22\xf0\x72\x15\x88\x08\xd0\x5e\xa3\x19\x52\x01\x14\x18\x14\x18\x14\xd8\xed\x6f\x50\x60\x50\xe0\xce\x39\x16\x14\xb8\xd0\x07\xed\x4d\x76\xa3\xbd\x41\x81\x37\x34\xa2\xab\xc2\x7b\x67\x14\xb8\xe2\xfb\xa8\x4e\x4c\xce\xb0\xb7\x14\x78\xc2\x94\xe0\xbe\xac\xfd\x0d\x42\xce\xf1\x35\x55\x08\x98\x6f\xde\xd1\x98\xf9\x0e\x7b\x15\x01\x7c\x82\x72\x0d\xca\x35\x60\xbe\x5d\xde\x30\x3b\x53\xb6\x01\xe1\x35\x26\xb4\xa6\xc4\x04\xe1\x05\xe1\x85\xca\x34\x0d\x06\xe1\x75\x98\x0d\xa1\xd9\x5c\x68\xb6\x57\x31\x6c\x8d\xf0\x56\x4a\x06\xbc\xa6\x05\x92\x01\x84\xd7\x68\x86\x30\x68\x24\x0c\xc0\x73\x0b\xc3\xc0\x73\xc1\x73\x37\xf7\x3b\x78\x2e\x59\x2b\x14\x9f\xee\x5b\xf0\x5c\xf0\x5c\x28\xed\x12\x13\xc1\x73\x21\xb3\x3b\xc4\x73\x87\x15\xdf\x55\x75\x62\x09\x9a\xbd\xe5\xb9\x71\xc4\x55\x2c\x78\x34\xaa\x2f\x44\x4a\x2e\xa9\xa9\x45\x40\x75\xf3\x8e\xe6\x54\xf7\x45\x45\x18\x9f\xa1\x44\x83\x12\x0d\xa8\x6e\xf7\xb7\xcd\xce\x94\x70\xc0\x76\x8d\x09\xad\x29\x37\xc1\x76\xc1\x76\xa1\x38\x4d\x83\xc1\x76\x1d\x66\x43\x74\x36\x17\x9d\xed\xd5\x0d\xdb\x63\xbb\x55\xc2\x01\x2f\x76\x81\x70\x00\xdb\x35\x9a\x21\x0f\x40\x78\x41\x78\x41\x78\x41\x78\x37\x76\x3c\x08\x6f\x7b\x1c\x0b\xc2\x5b\xe8\x83\xde\x26\xbb\xd1\xdb\x20\xbc\x1b\x1a\xd1\x55\xb1\xbd\x2b\xc2\x7b\x56\xf5\xfd\x54\xe6\xdb\x88\xf6\x96\xf0\xea\x0c\x5e\x1e\x07\x4c\xfa\x82\x27\xfa\x8c\x58\x23\x42\x56\xc6\xd6\x54\x1f\x60\xba\x79\x47\x63\xa6\x7b\x76\x5a\x11\xb8\xaf\x50\x9a\x41\x69\x06\x4c\xb7\x8b\x1b\x65\x67\xca\x34\xa0\xb8\xc6\x84\xd6\x94\x94\xa0\xb8\xa0\xb8\x50\x95\xa6\xc1\xa0\xb8\x0e\xb3\x21\x2c\x9b\x0b\xcb\xf6\x2a\x85\xad\x51\xdc\x2a\xa9\x70\x8a\x17\xb9\x40\x2a\x80\xe2\x1a\xcd\x10\x04\x4f\x12\x04\xe0\xb6\x85\x61\xe0\xb6\xe0\xb6\x9b\xfb\x1d\xdc\x96\xac\x15\x88\x4f\xf7\x2d\xb8\x2d\xb8\x2d\x14\x76\x89\x89\xe0\xb6\x90\xd7\x1d\xe2\xb6\x2f\x2a\xbe\x75\xea\xd4\x54\x10\x7b\xcb\x6d\x53\xc9\x84\xfc\x7d\x9d\xe6\x98\x8f\xa8\xa9\x34\xc0\x68\xf3\x8e\xc6\x8c\xf6\x45\xbf\x22\x48\x07\x28\xbc\xa0\xf0\x02\x46\xdb\xf6\x4d\xb1\x33\xe5\x17\xf0\x58\x63\x42\x6b\x4a\x45\xf0\x58\xf0\x58\xa8\x45\xd3\x60\xf0\x58\x87\xd9\x10\x8c\xcd\x05\x63\x7b\x55\xc1\xd6\x78\x6c\xa5\x2c\xc0\x4b\x56\x20\x0b\xc0\x63\x8d\x66\x24\xff\x8b\x3f\x60\xaf\x60\xaf\xed\x23\x55\x60\xaf\xdd\x41\x84\x60\xaf\x60\xaf\x60\xaf\x7b\xab\xa6\xc1\x5e\x37\x34\xa2\xab\x52\x7a\x67\xec\xb5\xe2\x5b\xa1\x4e\xcd\x37\xfe\xec\x2d\x7b\xbd\x67\x42\xea\xc7\x5b\x23\x35\x16\x43\x6a\x6a\x0d\xd0\xd7\xbc\xa3\x39\x7d\x7d\x59\x11\xa6\x43\x94\x59\x50\x66\x01\x7d\x6d\xff\xb6\xd8\x99\x12\x0c\xf8\xab\x31\xa1\x35\xe5\x22\xf8\x2b\xf8\x2b\x14\xa3\x69\x30\xf8\xab\xc3\x6c\x88\xc6\xe6\xa2\xb1\xbd\xba\x60\x7b\xfc\xb5\x4a\x18\xe0\x75\x28\x10\x06\xe0\xaf\x46\x33\xd2\xff\xfc\x0f\x08\x2c\x08\x6c\xfb\x78\x15\x08\x6c\x77\x40\x21\x08\x2c\x08\x2c\x08\xec\xde\xea\x69\x10\xd8\x0d\x8d\xe8\xaa\x98\xde\x15\x81\x7d\x59\xf5\x8d\x4e\xe6\x7b\x79\xf6\x96\xc0\x7e\xe1\xc1\xe3\x3a\xa1\x91\xf5\xd7\x54\x19\x60\xaf\x79\x47\x63\xf6\xfa\x72\x58\x11\xa0\x2f\x51\x62\x41\x89\x05\xec\xb5\xcd\x1b\x62\x67\xca\x2e\xa0\xae\xc6\x84\xd6\x94\x88\xa0\xae\xa0\xae\x50\x89\xa6\xc1\xa0\xae\x0e\xb3\x21\x14\x9b\x0b\xc5\xf6\x2a\x82\xad\x51\xd7\x4a\x49\x80\x17\x96\x40\x12\x80\xba\x1a\xcd\x48\xfc\x09\x78\x2b\x78\x6b\x2b\xe9\x14\x78\x6b\x77\xb0\x20\x78\x2b\x78\x2b\x78\xeb\xde\x2a\x69\xf0\xd6\x0d\x8d\x68\xbd\x8c\x3e\xb0\x3e\x4e\xef\x29\x7a\x8b\xd0\xbe\x38\xe9\xe5\xd3\xed\x49\x7f\xcc\x26\xf4\xfd\xfc\x17\x0a\xcf\x49\xff\x2c\xef\x52\xd3\xd9\x12\x0f\xa8\xb8\xcb\xaf\x50\x74\x64\x46\x9e\x27\xa7\x51\xc0\x24\x2f\x06\x91\xc7\xa3\x5b\x41\xbd\x95\xc7\x50\x6c\x92\x84\x54\xf1\x68\x64\x98\xed\x85\x5c\x2a\x2b\x9c\x57\x9c\x12\xbe\x9f\x67\xe3\x8e\xd5\x94\x0b\xd3\x75\x1a\x60\x75\xb1\x15\x24\xc0\x64\x9a\x88\xf8\x13\xf3\x1d\x61\xb4\x94\x01\xf9\x98\x75\x71\x5a\xaf\x80\x90\x2d\xcf\x5b\x1e\xf1\x85\xb4\xca\x82\xf5\x7a\xb6\xfa\x9f\xa5\xc9\x17\x7d\xde\xf1\xd9\x71\x96\x9d\x5d\x8f\x87\x64\x79\xac\xd9\x85\xe0\x45\x36\x65\x6f\x9e\x3c\xf2\xc3\x34\x60\x17\x61\x99\xd4\x5d\xae\x90\xbf\x2d\x6e\x6d\xdf\x79\x92\x86\x8a\x97\x5c\x3d\xdf\xde\xbc\xa8\xec\xe2\x38\x51\xce\xc3\x85\x10\xef\xb7\x94\x89\x69\x63\xbb\xf3\xb8\xb6\x7f\x21\x40\xb0\x11\x7b\x70\xac\x7d\x4f\xde\xf1\xe4\x9f\x22\x7c\x37\x8d\xfc\xb2\xaa\xc1\xec\x24\xb2\xef\xa8\xe8\x28\x0b\x41\xf9\x8f\xc5\xa3\xdb\xb7\x5e\xac\x0d\xdb\x56\xdd\x5e\x7e\xd1\xfc\x38\x9a\x39\xc4\xea\x4c\x25\xfb\x75\x76\xd3\xb5\xb5\x88\x6d\x2f\x96\xc5\x92\x3f\x9a\x25\x65\x6b\x96\x8c\x3d\x72\xed\xc2\x69\x1c\xc5\x17\x49\x12\x72\x7f\x56\x8b\x78\x42\x1c\x7f\x8a\x6f\xd6\x44\xb0\x65\xa3\xed\xbc\x1a\xf5\x06\x97\x07\x27\x4c\xd1\x15\xff\x39\x3d\x98\x8d\xb4\x06\x3e\xda\x57\xae\x7f\x2a\xd7\xa4\x6e\x30\xad\xb5\x27\xd6\x3c\xf5\xac\x73\xaf\xb8\xf8\x0d\xe3\x0e\xc9\xfa\x07\xa8\xb1\x74\x17\x8b\xc9\x4f\xa5\x8a\x27\xa5\x39\xe2\xd7\x5a\x2f\x0b\xc7\x5d\x58\xe5\x3a\xc3\x55\x76\xe0\x10\xe2\x7d\x77\x7d\x4d\xc3\xd0\xf6\xd9\xd5\x1f\x7d\xaa\xd4\x28\xc0\x14\xd5\xd2\xe3\x21\x49\x05\x2f\x3b\x6b\xec\x22\xb5\xb1\x4a\x1d\x79\xed\x72\x91\xbe\x65\x73\x33\x4a\x56\xa8\xe3\xda\xc5\x02\x4d\x05\x6f\x7c\xc4\x7c\x1d\x27\x7c\xc3\x07\xcf\xf2\xef\x8b\x78\x7d\xcc\xf3\x3b\x9e\xcd\x53\x21\xb3\xbb\x9d\x49\x4f\x2f\x8a\x3f\x1f\x9d\x14\xd5\x96\xa7\xe2\x79\xbb\xe7\xbc\x4d\xc2\xfd\xbb\xac\x30\x5d\xb8\xd9\xdc\xe9\xd7\x0b\xa5\x6d\x6f\xcb\xde\xd0\x82\x34\xfd\x9e\xd5\x70\x62\x37\xf4\x27\xe6\xcf\x43\xeb\xe7\xbe\xdd\x70\xd2\xb3\x47\x58\x1a\x72\x60\xfd\xdc\x0f\xf2\x75\x7e\x55\xb4\x9f\x4f\xd8\xb5\xfb\x78\xa9\x7e\x0a\xfb\x43\xcf\xec\x0f\xb5\x9f\x62\x70\x6a\x37\x58\xf4\xfb\x45\x60\xdb\x59\x7c\x6e\xe7\x14\xfd\x27\xce\x2a\xd1\x5e\x41\x1c\x2c\xca\x02\xef\xe6\x1b\x39\x39\x22\xaf\xb5\x14\x20\x47\xe4\xe2\xf2\x75\x3e\x30\xcd\x98\x95\xf7\xaf\xcb\xe8\xf2\xae\x17\xf2\x82\xbe\xb8\x5f\x6a\x91\xd9\xd7\xe4\x3c\x1e\xfc\x2f\x00\x00\xff\xff\x0f\xef\x44\x8b\x46\x91\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5f\x6f\xdb\x38\xd6\xc6\xef\xfd\x29\xf4\x12\x2f\x16\xe9\xc2\x49\xfc\xa7\x4e\x1b\x03\x7b\xd1\xce\x4c\x17\x05\xba\xd8\xa2\x33\x98\x8b\x6d\x83\x80\x91\x4e\x6c\xb6\x32\xa9\x21\x29\x37\x46\xd7\xfb\xd9\x17\x94\x1d\x47\xb4\x29\x3b\x13\x55\x69\xbb\x7a\x10\x60\x26\x26\x29\x89\x3c\x3c\x3a\x3c\xcf\xf9\xb5\x2e\xcf\xc4\xef\xa4\x8d\x50\x72\x1c\x09\x69\x69\xa2\x89\xdb\x74\x71\xa2\xf4\xe4\x74\xde\xe7\x69\x36\xe5\xfd\xce\x27\x21\x93\x71\xf4\x77\xcd\xaf\xb9\xe4\x3f\x73\x33\xbd\x52\x5c\x27\x9d\x19\x59\x9e\x70\xcb\xc7\x9d\x28\x92\x7c\x46\xe3\xc8\x2c\x64\x42\x46\x98\x63\x21\xaf\x35\x3f\xe6\x99\x38\x4e\x36\xc3\xa3\x28\xe5\x57\x94\x1a\x37\x3c\x8a\x78\x96\xdd\x8d\x2f\x5a\x6e\x3f\x9c\x08\x75\xba\xbf\xd7\x2e\x32\x72\xd3\xbd\xd6\xdc\x58\x9d\xc7\x36\xd7\xd4\x31\x19\xc5\xf7\x9b\xc9\xc9\x47\xa3\x64\x27\x8a\xdc\xff\xc6\xd1\xbf\x8b\x87\x7f\x29\xfe\x1b\x45\x8c\x4b\xa9\x2c\xb7\x42\x49\xc3\xc6\x9b\xe6\x28\x62\xa9\x30\x96\x8d\xa3\xf7\x9b\x96\xbb\x8b\xd6\x23\xae\x72\x91\xda\xd7\x92\x8d\xa3\x7e\xd7\xef\x71\x56\x32\x2a\xd7\x31\xb1\x71\xc4\x8e\x8f\x6f\x6d\x19\x1d\x1f\xb3\xad\xa1\x24\xf9\x55\xea\x86\x59\x9d\xd3\x56\xdf\x54\x24\x15\x3d\x22\x56\xf2\x27\x95\x2a\xed\xee\xaf\x27\x57\xfc\xa8\xd7\x8d\x06\xfd\x7e\x37\x1a\x8c\x46\xdd\xa8\xff\x64\xfb\x31\xce\x4a\x6e\xec\x8b\xbb\xe5\x46\x7f\x89\x5e\xa4\xa4\xad\xd9\x1e\xeb\xcc\xed\xc6\x6e\x0c\xc8\x4a\xfd\xcb\xcd\xef\x17\xeb\xdf\x96\xb7\x97\x33\x4a\x84\x0d\xac\x86\x4d\x24\xd9\xd7\x09\x1b\x47\x32\x4f\xd3\xbb\x56\xcd\xb3\xe9\x6f\x4a\xa5\x56\x64\x6c\x1c\xf5\x36\x1d\xc2\x0d\x1d\x94\x3e\x5b\xd2\xc5\x94\x9d\xa5\x47\x67\x83\xb3\xde\xf9\xd9\x59\xaf\x3f\xdc\x58\x9d\xa5\x42\x7e\x32\xde\x66\x95\xb7\xaa\xb0\x96\x5b\x10\xdd\x58\xd2\x92\xa7\x91\x1b\xef\xad\x9a\x09\x19\xa7\x79\x42\xbf\x73\x6d\x02\x16\x67\x96\x4f\xfc\xfb\xbb\x1f\x76\xeb\x74\xdb\x06\x2c\x3c\xb5\x6c\xb4\x8b\x6e\x67\x9f\x7d\x0d\xeb\x6c\x9b\x77\x73\x05\xcb\xb8\xa4\x74\xcf\xe2\x62\x95\xa6\x3c\x33\xe4\x8c\x76\xcd\x53\xe3\x4f\x7c\xa2\x45\xf2\x56\xf9\xae\xed\x7e\xd8\x34\xe0\xb6\x9f\x9d\xdd\x9f\x6e\x35\xde\x94\xf7\x66\xdd\xb6\x70\x6d\xa5\xa6\x8d\x0b\x94\xf6\xcf\x6b\xba\x5b\x84\x6f\x0a\x4d\x19\x71\xf7\x9a\xb1\x5c\x0b\x7f\x47\x4c\xac\x32\x4a\xd6\x1b\xb2\x35\x79\x37\x78\xbb\xd1\xed\x07\xa5\x14\xdb\xb0\x25\xdc\x0f\xb3\x74\x53\x3c\xec\x34\x56\x52\x52\xec\x7c\x6a\x7b\xf3\xa2\x88\xcd\x79\x9a\xd3\xce\x30\x6f\xd4\xb2\x72\xf1\x56\xd8\xe2\x05\x60\xef\xdf\xe7\x5a\x5c\x5c\xb0\xe0\xd6\x6b\xf5\x99\x75\x02\x37\x28\x2f\x89\xf1\x54\x70\x53\xbc\xe5\xc5\xfe\xf9\xcf\xb9\x5a\x19\x66\x77\xc7\xdd\x5b\xfb\x86\xe4\xc4\x16\x3b\xec\xed\x5c\xe1\x71\x54\x75\x59\x39\x64\xbd\xd5\x6a\x46\x76\x4a\xb9\x6f\x1e\x76\x2d\xd2\x74\xdb\x71\xf6\xfb\xd8\x59\xc0\xc7\xfa\x83\x7b\xfa\x58\xbf\xd4\xe4\x2f\x5f\x24\x3b\xf7\x61\x29\x4d\x48\x26\xbb\xd3\xe0\xa9\x98\xc8\x17\xe6\xb7\x75\x68\xda\x59\xbb\x1b\x32\x9f\x54\xf4\xc4\xb9\xd6\x24\x6d\x45\xaf\x8b\xd0\xbf\xcc\x32\xbb\xd8\xd3\xff\x2f\xd2\xaa\xa2\x7b\xc6\x6f\xaa\x7a\x84\xac\xe8\xd1\x62\x32\xb5\xbf\xae\x4e\x86\x50\xbf\x99\xaa\xcf\x81\x10\xe6\xbc\x5f\x59\x9e\x56\x5c\x55\xf8\xfc\xc6\x33\x2a\xcd\x9e\x0a\x49\xc1\x00\xe9\x3a\x3e\x8b\xc4\xee\x84\x95\xbb\xd8\xec\xbf\xf9\xee\x28\x78\xab\x84\xb4\xff\x50\xc5\x52\x8a\x06\xdf\xd9\x32\xd2\x31\x49\xcb\x27\xc1\xa5\xb2\xcc\x5d\xad\x79\x22\x72\x37\xa3\xd1\x6e\x5f\xd8\xd1\x35\xc9\x84\x34\x15\x27\xe7\x75\xaa\x2c\x0b\x07\xa4\xf2\x51\x55\xea\xf9\x59\xe8\x55\x40\x70\x97\x4f\xff\x27\x22\x96\x21\x2d\xc8\xfc\x73\x4e\x5a\x8b\x84\x02\x5b\x65\x32\x1e\x53\x55\x48\x31\x96\xc7\x9f\x82\x86\x36\x96\xb2\x8c\x92\x37\x42\x86\xf7\xcf\x72\x3d\x21\xbb\x7b\xac\xee\x18\x89\x6e\xb2\x62\xb7\x4c\x3e\x3b\xd2\xdc\xd2\xd1\xd4\xda\xec\xd2\x90\x9e\x93\xbe\xd4\xf4\x47\x4e\xc6\x9a\x4b\x43\xb1\x92\x89\xb9\x8c\x55\x2e\xed\x17\x97\xef\x14\xf3\xfe\xdb\x07\xf6\xff\x9b\x0f\x1f\x58\xf7\xa3\xba\x72\x4d\x1f\xd5\xd5\x07\xd6\xcd\xb5\x70\x1f\x72\x2d\x3e\xb0\xe5\xfb\xd1\xec\xe2\xc9\x76\xe2\xe4\x62\x9e\xd2\xb3\xc2\x25\x98\x15\x33\x72\xcf\x15\x14\xd8\x03\x97\x4d\xeb\x39\x4f\x5f\xf1\xd8\x2a\xbd\xfd\x1a\x94\x02\xd4\xab\xcd\xfd\x6e\xe7\x1e\x65\xa4\xa3\xd5\xfc\x77\xef\xab\xe9\xba\xc8\x9d\xd8\x8b\xad\x0d\xed\x7e\x0f\x56\xeb\x1a\xcb\x6d\x6e\xfe\xef\x3f\x1f\xd8\xe0\xe4\xe4\x9b\x58\x91\xb4\x56\xfa\x9e\x36\x7c\x59\xfd\x52\xf8\x3e\x6f\xa7\x9a\xcc\x54\xa5\x49\xe0\x7d\x70\x6e\xf0\x4a\xab\x59\x28\x4a\xb8\xbe\x77\x34\x59\xcb\x89\xc0\x85\xbf\x4e\xc5\x75\x30\xbe\x6c\x12\x87\x77\x07\xbc\x82\xd9\x4d\xce\xec\x6f\x3a\x33\x53\xae\x29\x09\x84\x67\xd7\xa7\xb4\x0d\x9c\xb2\x45\xe4\xb8\xbc\x4d\x4b\x84\x4c\xc4\x5c\x24\x39\x4f\x59\x65\xb8\xb0\x9a\x4b\x93\xf1\xaa\x43\x71\x93\xe2\x14\xe9\xbd\x3f\xf1\x1b\x7e\x23\x02\xb1\xf1\x2a\x8f\x3f\xad\x02\xc1\xb6\x51\xdc\x11\xb9\x3e\x1e\x9c\x61\x2b\x44\x4d\xe0\xaa\xea\x43\x70\x73\xd0\xbd\xbf\xa8\x5c\xe2\x82\xdf\xd0\x3d\xe2\x52\x42\xb1\x98\xf1\xd4\xec\x9a\xd5\xf3\x77\x33\x75\xa6\xdf\x19\x50\x88\x63\x67\xf4\x40\x97\x9a\xbc\xe4\x86\x82\xde\xbf\x4a\x19\x76\x97\xbc\xc9\x19\x58\x6f\xf7\x86\x25\x73\x78\x5d\xcb\xee\xfe\x15\x7e\xb3\x45\x04\xbb\x2a\x57\x51\xfa\xe4\xbf\x70\x8b\xb0\xc3\x15\x09\x61\xc8\x79\x6f\xfb\xde\xd0\xbc\x58\x96\x9b\x46\x27\x14\x2e\x4a\x86\xfb\x41\x52\xf6\x5e\x03\x29\x7b\x7f\xf0\x80\x9c\x7d\x88\x9c\x1d\x39\x7b\x03\x39\x3b\x32\xf3\x6f\x97\x99\x1f\xdd\x2f\xc9\x34\xf9\xec\xe1\x89\x79\x74\x1a\x35\x9b\xca\xae\x9f\xf3\x24\xfa\x6b\xd4\xef\xf5\x7a\x6c\xcf\x91\xbe\x37\x85\x5d\x57\x6d\x77\xcc\xfa\xd0\xfc\x96\xcf\x49\xbb\xf7\xa9\x3a\xab\x7d\xa0\x32\x98\x0a\x63\xd5\x44\xf3\xd9\xe5\x1f\x39\x97\x56\xa4\x74\xd4\x3b\x19\x75\xef\x69\xe6\x55\xd6\x56\x63\x3f\xaf\x16\xd1\x51\x4a\xdf\x9f\xbd\x47\x3d\x3b\x8d\xd6\x71\x4c\xa4\xfb\xec\xfe\xd3\x57\xb4\xfb\x79\xef\x87\x33\xfc\x03\x6c\x7b\x7e\x6f\xdb\x6e\x2b\xb5\x87\xdb\xb6\xdf\x06\xc3\xba\x8c\xba\xda\x98\xbf\x6c\x19\xb3\xf4\xe9\xbb\x91\xbd\x6f\xb8\x25\x19\x2f\x20\x75\x7f\x60\xa9\x3b\x33\x8f\x27\x11\xa1\x73\xbf\xa1\xce\xfd\x56\xd0\xf1\x59\xa7\x62\x53\xd7\xe2\xd2\xbb\x4f\x09\x9d\x5e\xfc\xa9\x22\xff\xeb\x43\xc0\xb9\x34\xf6\xad\x7b\xc6\xeb\x5d\xe2\xf9\x58\x92\xe3\xf4\x8b\x48\x96\xf7\xd4\x1d\xab\xb1\x95\x3e\xb5\x0c\x07\x66\x80\xcc\xfa\x20\xf3\x79\xa9\xc9\x5f\xfe\xca\x6f\x47\x28\x8a\xa0\x28\xd2\x40\x51\xe4\x61\x20\xb3\x4e\x14\xec\x0f\x02\xdd\x94\xbc\x5c\xbc\x0b\xee\xe6\x8f\x1f\x27\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x6b\xe2\xd3\x43\x4a\xc1\xbb\xfb\x3a\x98\x43\x29\x40\x29\xd4\x55\x0a\x8d\xe9\x81\x21\xf4\xc0\xf7\xa9\x07\x00\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x1b\x81\xb6\xfd\xa7\x9d\x8a\x5d\x5d\x6b\xda\x67\xed\xa0\xb6\x34\x27\x69\x4f\x35\xb9\xa3\x6c\xfd\x37\xce\xf7\xe8\x8f\xc0\xe8\x4a\xc7\x5a\x86\xa3\x33\xc8\x6d\x7d\x72\xdb\x1f\x95\xda\xfc\xf5\xaf\x9c\xf7\x39\x0a\x32\x28\xc8\x34\x50\x90\x69\x39\xba\x7d\xac\x60\x09\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x5b\x13\xdf\x1e\x94\x0b\xe7\x90\x0b\x90\x0b\x0d\xc8\x85\x56\xf0\x5b\x88\x82\x2d\x51\x00\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\xdb\x08\xc3\x1d\x1c\xf8\x5a\xa7\x41\xaf\x25\x0c\xf7\xc6\x92\x34\x07\x95\xc7\xdd\xa8\x4a\x47\x5a\x86\xa3\x31\x98\x6d\x7d\x66\x3b\x18\x94\xda\xfc\xf5\xaf\x9c\xb5\x8f\x22\x0c\x8a\x30\x0d\x14\x61\xda\xce\x6c\x1b\x0a\x8e\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x35\x19\xed\x41\x79\xe0\x5d\xb4\x8e\xe6\x90\x07\x90\x07\x75\xe5\x41\x3b\x18\x6d\xdb\x45\x00\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x6c\x33\x4c\xf6\xc0\x77\x45\x0d\x86\xed\x60\xb2\xee\xe8\x9d\xe8\xc3\x7f\x1e\xd4\x1b\x57\xe9\x4c\xcb\x70\x44\x06\x97\xfd\x0a\x5c\xf6\xbc\xd4\xe6\xaf\x5f\x24\x3b\x9e\xbf\x3e\xd6\x51\x78\x41\xe1\xa5\x6e\xe1\xa5\xe5\x5c\xb6\xc9\x00\x09\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x5b\x97\xcd\x1e\x92\x08\x23\x48\x04\x48\x84\x06\x24\x42\x2b\xd8\x6c\xcb\x85\xc0\x4a\x08\x80\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x36\xc2\x67\x87\x07\xbe\x0b\x6a\x70\xd6\x3e\x3e\x7b\xf0\x9f\x5d\xd9\x1d\x5c\xe9\x56\xcb\x70\x6c\x06\xa9\xad\x4f\x6a\x87\x67\xa5\x36\x7f\xfd\x2b\xd7\x7d\x86\x32\x0c\xca\x30\x0d\x94\x61\x40\x6a\x37\xa4\xb6\xb9\x50\x09\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x5b\x93\xd9\x1e\x14\x0b\xcf\xc1\x6c\xc1\x6c\xc1\x6c\xeb\x33\xdb\x96\x4b\x02\x27\x09\x40\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x1b\xa1\xb7\x4f\x0f\x7d\x4b\xd4\x79\x4b\xe9\xed\x69\x42\x59\xaa\x16\x33\x72\xd2\xed\xcf\x68\x11\xef\xc2\x4a\x77\x5b\x86\x63\x36\xa8\x6e\x7d\xaa\xfb\x74\x58\x6a\xf3\xd7\x5f\xb8\xf4\xb0\x87\x42\x0d\x0a\x35\x0d\x14\x6a\x40\x75\x7d\xaa\xfb\xa8\x21\x14\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\xb7\x26\xed\x3d\x28\x22\x3c\x77\x58\x47\x73\x88\x08\x88\x88\xba\x22\xa2\x9d\xb4\x17\x52\x21\x24\x15\x40\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x9b\xa1\xc0\x07\xbe\x8f\x6a\x38\x68\x07\x05\x9e\x91\xd5\x22\x36\x9e\xcc\xd8\x27\x47\x82\xe3\x2b\x9d\x6b\x19\x8e\xd0\x60\xbe\xf5\x99\xef\xa8\x57\x6a\xf3\xd7\xbf\x72\xe0\x21\xca\x35\x28\xd7\x34\x50\xae\x69\x39\xf3\x7d\xcc\x80\x09\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x5b\x93\xf0\x1e\x94\x0c\x9e\x78\x5e\x47\x73\x48\x06\x48\x86\xba\x92\xa1\x15\x84\x17\xc2\x20\x20\x0c\xc0\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\x1b\xe1\xb9\xa3\x03\xdf\x55\x35\x1c\xb5\x84\xe7\x2a\x29\xac\xd2\x42\x4e\xee\x2f\x44\x2a\x2e\xa9\x74\xb1\x65\x38\x4e\x83\xea\x7e\x05\xaa\xfb\xac\xd4\xe6\xaf\x7f\xe5\xc6\xde\xdd\xd7\x87\x3d\x4a\x34\x28\xd1\xd4\x2d\xd1\xb4\x9d\xea\x3e\x7a\xd8\x04\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\xad\xc7\x76\xff\xcb\xde\x99\xf4\xb6\xad\x03\x71\xfc\xee\x4f\x21\x10\xef\xf0\x0e\x36\x9e\xd7\xd8\xc9\xcd\xc7\x5c\x1e\xd2\xa6\x0d\xd0\x43\x61\xa8\x32\xe3\x32\x96\x65\x55\x4b\x5b\x37\x50\x3f\x7b\xa1\xcd\x22\xc7\x5a\x9c\xc8\xca\xa1\xfc\x03\xbe\x88\xa2\x28\x2e\x33\x1c\xfe\xe7\x97\xaa\xcd\xc2\x61\x0e\xb6\x0b\xb6\x0b\xb6\xfb\x4a\xb6\x0b\x79\x50\x25\x0f\x40\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x3b\x21\xbc\x57\x4d\xdf\xa7\x5a\xe8\x41\x78\xe3\x04\xbf\xff\xdf\x9a\xfb\x96\x27\xdc\x38\x46\xd4\x88\x90\x93\xba\x95\x46\x15\x95\xef\xcc\x60\xba\xed\x99\xee\xd5\x54\x2a\x53\xc7\x9f\x1a\xee\x35\x52\x33\x48\xcd\x74\x90\x9a\xd1\x9c\xe9\xbe\xc5\x46\x09\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\xdb\x92\xe2\x36\x49\x85\xe9\x10\x52\x01\x52\xa1\x03\xa9\xa0\x05\xc5\x85\x20\x90\x04\x01\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x6d\x27\xdc\x76\xde\xf0\xd5\xa9\xe9\x48\x0f\x6e\x1b\xfa\xdc\xf3\x7f\xd7\x69\x8e\xac\x46\xa5\x01\x45\xe5\xbb\x30\x18\x6d\x7b\x46\x3b\x1f\x49\x65\xea\xf8\x53\x23\x1d\x23\xf1\x82\xc4\x4b\x07\x89\x17\xcd\x19\xed\xa5\x37\x45\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\xd8\x96\x3c\xb6\x51\x16\x4c\x20\x0b\x20\x0b\x3a\x90\x05\x5a\xf0\x58\x6d\x0f\xff\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\xdd\xb0\xd7\x86\xaf\x42\x4d\xa7\x7a\xb0\xd7\xef\xdc\xf3\xe3\xee\xd5\x48\x8d\xbc\x4a\xa5\x09\x45\xe5\xfb\x30\xe8\xeb\x05\xe8\xeb\x42\x2a\x53\xc7\x9f\x9a\xe9\x0c\x69\x16\xa4\x59\x3a\x48\xb3\x68\x4e\x5f\x2f\xbf\x2d\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\xb6\xe5\xaf\x4d\xc2\x40\x69\x3e\xdb\xcd\x21\x0c\x20\x0c\xda\x0a\x03\x2d\xf8\xab\xc6\xc7\x7f\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\xd8\x4e\x08\xec\xa2\xe9\x8b\x4e\x73\x3d\x08\xec\xb3\x58\x47\x75\x42\x23\xb9\x5f\x69\x3c\x51\xf9\x0e\x0c\xf6\xda\x9e\xbd\x2e\x66\x52\x99\x3a\xfe\xd4\x40\x17\x48\xb1\x20\xc5\xd2\x41\x8a\x45\x73\xf6\x7a\xc9\x0d\x11\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\xb5\x25\x75\x6d\x94\x04\xd7\xa0\xae\xa0\xae\xa0\xae\xaf\xa3\xae\x5a\x1e\xfc\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xff\x16\xde\xda\x23\xaf\x8b\xf7\x14\x8f\xfb\xb1\x20\x61\x93\x61\xb1\xdc\xcc\xb7\xbe\xf2\x9d\xf9\x90\xfd\x41\xe1\x8d\x31\x3a\x8a\x54\xe6\x07\x87\xd4\xc5\xd7\xa6\xb7\x2d\x9e\x08\xcc\x8d\x6a\x79\xcc\x3f\x38\x6b\xee\x0b\xd9\x88\x98\x70\x1e\x3d\x93\x9d\x74\x23\xe0\x3b\xd7\x36\x03\xe1\x6c\x94\x61\x33\x5b\xf8\x01\x31\xe7\x93\x49\xb1\x1f\xb2\xd3\xf8\xe9\xec\x4b\xc2\xb4\x4e\x03\x9c\x3a\x9b\x24\x01\x76\x07\xd7\xdb\x3f\x71\x2b\xa8\x91\x01\x45\x9d\x3a\x3b\x3d\x2f\x81\x10\xd7\xe3\x8f\xc2\x11\xb9\xb4\x4a\x3c\x6e\x95\x7a\xff\xbf\xa1\xfb\x1c\xc7\x3b\x91\x86\xb3\xe4\x50\x10\xf5\x8d\x63\x58\xa3\x89\xe0\xfc\x34\x45\x37\x4f\xe1\x58\x76\xb8\xe6\x4b\xbb\x4a\xea\x1e\x3d\xe4\xff\xbc\x69\xda\xf2\x2e\xb4\x03\x51\xf1\x74\xb6\xbd\x31\xa7\xea\xe1\xbd\x1b\x94\x06\x17\xc3\x60\xdf\x42\xee\x1d\x5a\x8f\xbb\xb0\x6b\xe2\xc6\xcc\xe3\x1b\xfe\xb3\xc4\xf7\x99\xbf\x15\xee\x47\xcf\xbe\x3f\x38\x56\xc5\xb0\xb2\x48\x44\x5b\x0c\xcc\x4d\x62\x82\xfe\xbb\xbc\xeb\xb4\xe9\xdc\x37\xe8\x58\xe3\xf2\xea\x87\xb2\x70\x94\x4e\x08\xb9\x19\xfa\xfc\x83\xb9\x69\xcc\x45\xbc\xb5\xb3\xe4\x2e\x3f\x88\x8d\x94\xd7\xfd\x9f\x3d\xb4\x66\xad\xe3\xb4\xb6\xe2\xa5\xeb\xda\xc2\x4a\x73\x11\xaf\xb0\xe3\xa7\xfd\x97\x1a\x0b\x56\x6e\xd0\x39\xa7\xd3\x57\xf2\x9a\xf2\x19\xdc\xf1\xc0\x24\x2f\xad\x98\xc1\xa4\x26\xa9\x18\xf5\x5f\xd4\xab\xb2\x45\x7d\xc1\xb2\x9e\xbd\xb0\x72\x30\x52\x22\x00\x75\xfe\x63\x2b\xf1\xe0\xfa\x46\x7d\x07\xce\x70\xdd\xdc\x99\xac\xd0\x0f\xf6\x3b\xd6\xab\x98\xa9\x4b\xf9\x4b\x3e\x71\x4b\x92\xae\x53\xa6\x4a\x8e\x6a\xd9\xad\x7f\x56\x2b\xd3\x56\x8e\xb0\xf1\xef\x73\xaf\x66\x69\x2f\x11\x55\x6a\x75\x54\x9a\x80\x91\xd5\x52\xd4\x37\x42\x4f\x54\xc5\x9a\x71\x9d\x97\x9e\x1a\x5a\xe1\xa4\xef\x79\x36\x8c\x0a\x0f\x2d\x79\x36\x77\xd0\x38\xcf\x57\xed\xa0\xe7\x85\x98\xcb\x4c\x82\xc6\x81\xa7\x47\xed\xf5\x68\xa9\x89\xa0\x54\xfc\x84\x3d\xa6\xd2\x93\x39\xfb\x1f\x83\x89\xac\xb6\x58\xb0\xcf\xca\x59\x69\x33\xae\xb0\xb6\xdc\x53\x1b\xcb\x26\x7d\x95\x2b\x6d\xba\x2d\xb3\x99\xea\x0d\x6c\x24\x9d\x75\xe3\x9f\x72\xf8\x8d\x7f\x6c\xb4\x53\xaf\x67\xe4\x7a\x44\x0b\x26\x43\x5a\x83\x68\xc8\x31\xb9\x1e\xad\x0b\x3f\x97\xd6\x27\x99\xab\x55\x79\x78\x69\xee\x05\x7d\xe9\x15\x7d\x29\xed\xc5\x78\x4a\x0b\x08\xfd\x9e\x93\xeb\xc9\x50\xee\x77\xe9\x12\xfd\xda\x3b\x5c\x31\xac\x22\x2d\x70\x9f\x6d\xe4\xc6\xc0\xb8\x8d\xa5\x80\x31\x30\x96\x77\xb7\x45\xc5\x30\xf9\x33\x36\xf6\xe9\xce\xb9\xdb\x0e\x6d\x21\xe9\x8b\xfc\x1f\x37\xdd\x18\xf3\x9e\x61\x18\x46\xd4\xfb\x33\x00\x0f\xef\x44\x8b\x46\x91\x01\x00"),
 		},
 		"/addons/ops/addon-ops-db-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-db-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 1501,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\xc1\x6a\xdb\x40\x10\xbd\xfb\x2b\x06\xa7\x87\x04\x22\xab\x39\xf4\xb2\xe0\x40\x21\x2d\xf4\xd4\xd0\x43\xaf\x66\xa4\x1d\xcb\xdb\x48\x3b\xcb\xcc\xc8\x89\x08\xf9\xf7\x22\x59\x96\x65\x68\x8b\xa1\x3d\xd4\xa7\xd5\x68\xe6\xbd\x37\x6f\x9f\x85\x29\x7c\x27\xd1\xc0\xd1\x41\xc3\x31\x18\x4b\x88\xd5\xaa\x64\x21\xd6\x55\xc9\x4d\xbe\xbf\x5b\x3c\x85\xe8\x1d\x3c\x0a\x37\x64\x3b\x6a\xf5\x5b\x5b\xd3\xa2\x21\x43\x8f\x86\x6e\x01\x50\x63\x41\xb5\xf6\x27\x00\x4c\xc9\x81\x76\xd1\x93\x06\x1d\x2a\xc7\x87\x55\xe0\xfc\xcf\x6f\xad\x4b\xe4\x20\xc4\xad\xa0\x9a\xb4\xa5\xb5\x42\x43\x53\x9a\xb8\x5d\x4f\x50\x87\x12\x2d\x70\xcc\x4e\x92\x87\x36\xe1\x9a\x1c\x60\x4d\x62\x99\xb4\x35\x1d\x18\x4e\x4d\xd9\x13\x75\x0e\x9a\xe0\x7d\x4d\xcf\x38\x62\xff\x1a\xcf\xc1\xd2\xa4\xa5\xe5\x02\x20\x62\x43\x27\xd1\xd9\x20\x2f\xf3\x45\x36\xf0\xf4\xa8\x07\x2a\x4d\x54\xf6\x16\x54\xc2\x6d\x1a\xcd\xc8\x2e\x1d\x86\xe1\x37\x9c\xdd\xf8\x00\x70\x05\x1f\xfb\x2e\xd8\xb2\x00\xc6\xee\x84\xe2\x0b\x08\x51\x0d\x63\x49\x60\x3b\x34\x08\x0a\x9e\x9f\xe3\x34\x99\x1d\x4c\x70\xf0\xb9\x55\xfa\x1a\xeb\x10\xe9\x01\x0d\x0b\x54\xfa\x32\x0e\x3e\xcc\xfb\x01\x30\x46\xb6\xc1\x84\x99\x80\xc1\x3d\x52\xc5\x8a\x1c\xdc\x67\x67\x75\x18\xb0\xe1\x00\x0e\x8f\xac\x56\x09\xe9\x49\xd7\xeb\xeb\xbb\x43\x2c\x56\x89\xfd\xdb\x1b\x84\x38\x78\xa1\x09\xcf\x5e\x4e\xb5\xbe\xe5\xb0\xc5\xea\x8c\x47\x39\x6d\x5a\xa9\x1d\xec\xcc\x92\xba\x3c\xaf\x82\xed\xda\x62\xc8\xe6\xd1\x90\xc0\xd3\x31\x2f\x6a\x2e\xf2\x06\xd5\x48\x72\xcf\x65\xde\x60\xc4\x2a\xc4\x6a\x43\x71\x1f\x84\x63\x43\xd1\x34\x37\x4e\xa1\xd4\xfc\x78\x0b\x1b\xe5\xb4\x42\xcf\xe5\xd5\xb6\x55\xe2\x61\x27\x3f\x1a\x76\xdc\xc8\x9f\x1b\x46\x2f\x49\x1c\xdc\x9f\x6b\x6d\x9b\xeb\x54\x6d\xda\xf4\xfa\x83\x8b\xf5\x72\x76\x5f\xcb\xb7\x1b\x28\xba\xeb\x69\xdb\x5b\x48\xec\x6f\x27\xb7\x6e\x60\xbd\x86\xf7\x33\xac\x2d\x8b\x83\x0f\xcd\xac\x32\xff\x8f\x4d\x7c\xb4\x27\x09\xd6\x39\x28\x25\x58\x28\xb1\xbe\x28\x3b\xd3\x65\x7d\x7a\x49\x2c\x46\x72\x71\x88\x8e\x93\xc7\xc1\xbf\x0f\xd1\x5c\xd7\x0e\x15\x7c\x50\x4c\x89\x50\xc8\xc3\x56\xb8\x99\x7d\x76\xc0\x50\x2a\xb2\xbe\xa5\xe4\x3d\x49\xf7\x9f\x04\x25\x8d\xa6\xd0\x68\xca\x05\x41\xc1\x42\x29\xda\xf5\x6f\x82\xb2\x5e\xc3\xdd\xbf\xc8\xc2\xcf\x00\x00\x00\xff\xff\x22\x92\xd5\xa4\xdd\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\xc1\x6a\xdb\x40\x10\xbd\xfb\x2b\x06\xa7\x87\x04\x22\xab\x39\xf4\xb2\xe0\x40\x21\x2d\xf4\xd4\xd0\x43\xaf\x66\xa4\x1d\xcb\xdb\x48\x3b\xcb\xcc\xc8\x89\x08\xf9\xf7\x22\x59\x92\x6d\x68\x8b\xa1\x3d\x54\xba\xec\xce\xce\xbc\x79\xf3\xf6\x49\x98\xc2\x77\x12\x0d\x1c\x1d\x34\x1c\x83\xb1\x84\x58\xad\x4a\x16\x62\x5d\x95\xdc\xe4\xfb\xbb\xc5\x53\x88\xde\xc1\xa3\x70\x43\xb6\xa3\x56\xbf\xb5\x35\x2d\x1a\x32\xf4\x68\xe8\x16\x00\x35\x16\x54\x6b\xbf\x02\xc0\x94\x1c\x68\x17\x3d\x69\xd0\x21\x32\x6d\x56\x81\xf3\x3f\x9f\x5a\x97\xc8\x41\x88\x5b\x41\x35\x69\x4b\x6b\x85\x06\x88\x34\xf7\x76\x7d\x83\x3a\x94\x68\x81\x63\x76\xa4\x3c\xa4\x09\xd7\xe4\x00\x6b\x12\xcb\xa4\xad\xe9\xd0\xe1\x98\x94\x3d\x51\xe7\xa0\x09\xde\xd7\xf4\x8c\x23\xf6\xaf\xf1\x1c\x2c\x4d\x5a\x5a\x2e\x00\x22\x36\x74\x24\x9d\x0d\xf4\x32\x5f\x64\x43\x9f\x10\xab\xb1\x95\x26\x2a\x7b\x09\x2a\xe1\x36\x8d\x62\x64\x97\x16\xf7\x3c\x01\x86\xb5\x1b\x37\x00\x57\xf0\xb1\xcf\x82\x2d\x0b\x60\xec\x8e\x28\xbe\x80\x10\xd5\x30\x96\x04\xb6\x43\x83\xa0\xe0\xf9\x39\xce\x95\xd9\x41\x04\x07\x9f\x5b\xa5\xaf\xb1\x0e\x91\x1e\xd0\xb0\x40\xa5\x2f\x63\xe1\xc3\x69\x3e\x00\xc6\xc8\x36\x88\x30\x52\x9f\xde\x86\x54\xb1\x22\x07\xf7\xd9\x59\x1c\x06\x6c\x38\x80\xc3\x23\xab\x55\x42\x7a\xe4\xf5\xfa\xfa\xee\x60\x8b\x55\x62\xff\xf6\x06\x21\x0e\x5a\x68\xc2\xb3\xc3\x39\xd6\xa7\x1c\xa6\x58\x9d\xf5\x51\x4e\x9b\x56\x6a\x07\x3b\xb3\xa4\x2e\xcf\xab\x60\xbb\xb6\x18\xbc\x39\x09\x12\x78\x5e\xe6\x45\xcd\x45\xde\xa0\x1a\x49\xee\xb9\xcc\x1b\x8c\x58\x85\x58\x6d\x28\xee\x83\x70\x6c\x28\x9a\xe6\xc6\x29\x94\x9a\x4f\xb7\xb0\x51\x4e\x2b\xf4\x5c\x5e\x6d\x5b\x25\x1e\x66\xf2\xa3\x60\xd3\x44\x67\x02\x03\xd0\x4b\x12\x07\xf7\xe7\x5c\xdb\xe6\x3a\x55\x9b\x36\xbd\xfe\xe0\x62\xbd\x9c\x38\x65\xbe\x58\xbe\xdd\x40\xd1\x5d\xcf\xd3\xde\x42\x62\x7f\x3b\xab\x75\x03\xeb\x35\xbc\x3f\xc1\xda\xb2\x38\xf8\xd0\x9c\x44\x4e\xbf\xb1\xe9\x51\xda\x93\x04\xeb\x1c\x94\x12\x2c\x94\x58\x5f\xe4\x9d\xf9\xb2\x3e\xbd\x24\x16\x23\xb9\xd8\x44\x53\xe5\x54\xf8\xf7\x26\x3a\xe5\xb5\x43\x05\x1f\x14\x53\x22\x14\xf2\xb0\x15\x6e\x4e\x7e\x3b\x60\x28\x15\x59\x9f\x52\xf2\x9e\xa4\xfb\x4f\x8c\x92\x46\x51\x68\x14\xe5\x02\xa3\x60\xa1\x14\xed\xfa\x37\x46\x59\xaf\xe1\xee\x5f\x78\xe1\xe7\x00\x22\x92\xd5\xa4\xdd\x05\x00\x00"),
 		},
 		"/addons/ops/addon-ops-db-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-db-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 88655,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xff\x73\xdb\x36\xb2\xff\xdd\x7f\x05\x86\xcd\xbb\x49\x7b\x72\x2c\xca\xdf\x33\x69\x66\x9c\xb8\x79\xd7\x99\xb4\x4d\xe3\x5c\xdf\xbc\x6b\xfc\x34\x10\x09\x49\x88\x49\x82\x05\x40\xdb\x4a\xce\xf7\xb7\xbf\x01\x44\x52\x22\x08\x52\x94\x44\x4a\x94\x8d\xfe\xd0\x58\x04\x88\x05\xb0\x8b\xdd\xcf\x2e\x80\x25\x0c\xf1\x1f\x88\x32\x4c\x82\x97\x00\x07\x1c\x8d\x28\x82\xdc\x9b\xbc\x20\x74\x74\x70\x6b\x43\x2f\x1c\x43\x7b\xef\x06\x07\xee\x4b\xf0\xdf\x14\x0e\x61\x00\x2f\x21\x1b\x0f\x08\xa4\xee\x9e\x8f\x38\x74\x21\x87\x2f\xf7\x00\x08\xa0\x8f\x5e\x02\x36\x09\x5c\xc4\x30\xdb\xc7\xc1\x90\xc2\x7d\x77\xb0\xef\xa6\xb5\x01\xf0\xe0\x00\x79\x4c\xd4\x06\x00\x86\xe1\xac\xba\x7c\x92\xfc\x78\x81\xc9\x41\x79\x29\x9f\x84\x48\xf4\x76\x48\x21\xe3\x34\x72\x78\x44\xd1\x1e\x0b\x91\x53\xa9\x23\x2f\xbe\x30\x12\xec\x01\x20\xfe\x79\x09\xfe\x2d\x5b\xff\x26\xff\x0f\x80\x05\x83\x80\x70\xc8\x31\x09\x98\xf5\x32\x7d\x0c\x80\xe5\x61\xc6\xad\x97\xe0\xcf\xf4\x09\x98\x2b\x95\x35\x06\x11\xf6\xf8\xcf\x81\xf5\x12\xd8\x9d\x6c\x89\x98\x23\x46\x22\xea\x20\xeb\x25\xb0\xf6\xf7\x93\x99\x04\xfb\xfb\x96\x52\x15\x05\x70\xe0\x89\x6a\x9c\x46\x48\x29\x1b\x63\xb7\xa0\x04\x3b\x24\x78\x4b\x3c\x42\x45\xfb\xdf\x9d\x20\xb7\x8b\xba\x6a\xcb\x1e\xf6\xb1\x18\x81\xdd\xed\x2a\x25\x62\xc6\xc4\x8b\x17\xb3\xb1\x83\xbf\x81\x0b\x0f\x51\xce\xd4\x56\xd8\x98\xdc\xc9\x31\xaa\x8d\x70\x38\x12\x33\xf6\xe7\xb5\xfa\x7c\x12\xca\xc6\xd3\xe9\xb7\xe6\xca\x1f\xd2\xbf\xaf\xe3\xbf\x1e\x92\xd7\x2d\x17\x31\x87\xe2\x50\x74\x47\xbc\x9f\x76\xc4\x42\x2e\xe6\x9a\x49\xb2\x46\x01\xe2\x3f\xbb\xd6\x4b\x70\x78\x38\x1b\xa2\x35\xa2\x30\x1c\x7f\x22\xc4\xe3\x38\x9c\x67\x8d\x85\x45\xd5\x5e\x6f\xf6\x9b\x23\x0a\x63\x6a\xf6\xf1\x49\xef\xa4\x7b\x74\x74\x7c\x78\x72\x3a\xab\xe1\xe1\xe0\x86\x65\x64\x60\x5e\x02\x24\x13\x44\x4f\xd1\x3d\x47\x34\x80\x1e\x10\xf5\x33\xf3\x67\xe1\xc0\xf1\x22\x17\xfd\x01\x29\xd3\x30\x32\x9d\x43\x65\xca\x63\x51\x56\x59\x21\x25\x7b\x7e\x36\xaf\xb3\x8d\xa9\x13\xcf\x66\x75\x93\x79\x4f\xdf\xb0\x42\x18\x20\xaf\x64\x70\x0e\x09\x38\x0a\x84\xfc\x58\xaf\xc6\xf6\xeb\x57\x83\xd7\xaf\x86\x24\xe0\xc0\x11\x52\xf7\xe3\x77\xe8\xe4\x0c\x76\xbb\xaf\x5f\x39\x28\xe0\x88\xbe\x7e\x76\x3b\x55\x29\xaf\x0e\xe2\x07\xaf\x0e\x44\xed\xd7\xaf\x0e\x06\xaf\x5f\x1d\x8c\xed\xd7\xd9\x69\x29\x62\x75\xcc\x40\xec\x7e\x20\xd9\xd5\x28\x0b\xc6\x82\x7f\xca\x9c\xdc\x09\xf6\x2b\xcf\xee\x35\xd2\x3a\x11\xcf\xe6\x05\x31\xcb\x26\x21\x1a\x27\xc7\x99\x67\x29\xf3\xb3\xb3\xec\x13\xb9\x26\xad\x31\xf7\xbd\x6c\xbf\x39\xe6\x52\x46\xad\x58\xbd\x5a\x5a\xee\x70\x74\xcf\xe7\xf8\xd2\x29\x98\x7d\xe8\x8c\xd1\x27\xec\x23\x12\x09\x16\x04\x91\xe7\x75\xb2\xdc\xf1\x08\x7d\x03\x9d\x9b\x11\x25\x51\x20\x7a\x3f\x84\x1e\x43\xf9\x3a\x7f\x40\x2f\x42\xc5\xc5\x1a\xe9\xfb\xae\x77\x7e\xee\x1c\x9d\xa8\xc2\x47\x47\x03\xf8\xbc\x77\x78\xda\x01\x76\xef\xbc\x03\x8e\xba\x1d\xd0\x7d\x71\x76\xfe\xbd\x5a\xef\x3b\xf7\xe8\x08\x1e\x96\x88\x69\x56\x37\x7e\xa0\xc4\x47\x7c\x8c\x22\xa6\x4a\x88\x83\x7d\x28\x05\xb4\x5b\x5d\x74\x86\x84\xfa\x50\xca\x2c\x1b\x13\xca\x15\xb9\x82\xd1\x08\xe5\xa5\xca\x87\xf7\xc9\x24\xe5\x35\xa5\x8f\x83\xa4\x50\x2d\x12\x8a\x51\x37\xb1\x82\xd7\x63\x8a\xd8\x98\x78\xee\x7b\x69\xff\x16\xd5\xfa\x05\xd2\x1b\x94\x2a\x88\x42\x21\xad\x65\x5d\xa8\xcf\x2a\xac\x8b\xb3\x13\x45\xa5\x71\x44\x6f\xa1\xa7\x13\xcb\xa2\x35\x03\xc3\x10\x07\xa3\x4f\xd3\x25\x60\x17\x95\xe5\x85\x31\x3b\xd0\x39\xcb\x75\x2b\x98\x02\x38\x01\x72\x39\x75\xd4\x6a\xb7\x09\x43\x33\x05\x0f\x9d\x6a\x8d\x53\x18\x8c\x2a\x34\xde\xcb\x36\x5e\x28\xf0\x3e\xbc\xbf\x84\x1c\x7e\x20\x38\xe0\x2c\x2f\x65\x96\x98\x45\x59\xf8\x4b\xac\x5a\x1c\x12\x04\xc8\xe1\xc8\xb5\x72\xf5\x3e\x89\x1e\x69\x26\x3e\x24\x8c\x0f\xf1\x7d\x7e\x45\xc4\x05\xef\x48\xc0\xaf\xf0\x57\xd9\xfc\x71\xf7\xbf\x94\x3a\x14\xe9\xdf\x95\xcf\x4b\x5f\x95\x53\xf5\x0b\x0c\x2b\xb0\x6e\x48\x89\x2f\xda\x10\x7d\xcf\x4f\x2a\x9f\x0e\xcc\xfa\xf5\xe0\x42\x53\x48\xd2\x17\x2b\xce\x39\x0b\x21\xbd\xf1\x70\xa0\x59\xef\x43\xec\x79\x29\x74\x92\x5a\xed\xd0\xee\x00\xdb\x3e\xeb\x00\xfb\xec\x5c\x68\x35\xfb\x2c\xa7\xd5\x86\x82\xb6\x7e\x19\x0b\x2a\xf3\xed\x4d\x9b\xeb\x75\x3b\xc0\x3e\x3f\xcc\x35\x34\xaf\x35\x0a\x97\x9d\x44\x3b\x6f\x89\x17\xf9\x1a\x1d\xc7\x21\x1d\x21\x5e\x61\xc2\xd1\x7d\x28\xfb\xe4\xc3\xfb\xe7\x3e\xbc\xef\x93\x5b\x44\xfb\x1c\xfb\xe8\x79\x38\xea\x33\xc4\x39\x0e\x46\xac\x2f\x4a\x62\x81\x13\x38\xf0\xdb\x17\x32\xf8\xf1\xb3\xf5\xec\x0b\x19\x7c\xb6\x3a\x62\x45\xb0\x10\x3a\x48\x3c\x4a\x7f\x7c\xb6\x1e\xfe\x7c\x96\xa8\x81\xeb\xef\xbf\x07\x84\x7e\x0e\x1a\xa1\x72\xec\x5f\x7f\xaf\x4e\x61\x46\xcd\x0b\x42\x7d\x86\x28\x46\x2a\x5a\xca\x6a\x2a\x2b\xed\x6f\x71\xb5\x77\xd0\xe1\x92\x8b\x76\xae\x8a\x87\x46\x28\x70\xdf\xa5\x64\xf3\x8d\x50\x34\x94\x50\xd4\xba\xa8\x2a\xa2\xa9\xfa\x67\x1a\x1e\x27\x50\xe2\x17\x78\x0f\xde\xce\xe6\x4d\x0f\x29\x18\x0e\x46\x1e\x62\x1c\x2a\x06\x4f\x2a\xaa\xf9\xe5\x7b\xa6\x2e\x5f\x59\xa3\xda\xf2\x25\x02\x4c\x5b\x3f\x2e\xb9\x72\x13\x5d\xb9\xd4\xe2\x95\x2f\xfd\x1a\x2b\x63\x27\xa2\x54\xe0\x50\x03\x99\x96\x80\x4c\xbd\x55\x20\xd3\x60\xc2\x95\x55\xf4\xf4\x20\xd3\x91\x06\x32\x9d\xac\xe0\x4a\x9c\x1a\xc8\xa4\x6b\xdc\x40\x26\x03\x99\x76\x1f\x32\xb1\x31\xa4\xc8\xed\x0f\xa2\xe1\x10\x51\xd6\x97\x7a\xb3\x21\xdc\xb4\x0e\xa9\xb6\x80\xa7\x86\xa0\xd1\x95\x9c\x1a\xf0\x66\x3a\x35\x06\x19\x19\x64\x64\x90\xd1\x86\x91\x91\xbd\x4a\x94\xf5\xcc\x40\x23\x5d\xe3\x06\x1a\x19\x68\xb4\xfb\xd0\xe8\x0e\x7a\x1b\xc1\x45\x2b\xd3\x79\xe4\xa0\xe8\x12\xb3\x9b\xfd\x0f\x70\x84\x0c\x2e\x32\xb8\xc8\xe0\xa2\x6d\xe1\x22\xf5\x61\x15\x5c\x74\x6e\x70\x91\xae\x71\x83\x8b\x0c\x2e\x7a\x04\xb8\x88\xd0\x9b\xbe\x8f\xfc\x66\x41\xd1\x4a\x44\xea\x41\x44\xad\xdc\x3c\x43\x3e\xa1\x13\x20\x96\x15\x18\x12\x0a\x10\x74\xc6\xe0\x2a\x77\x2e\xc4\x80\x22\x03\x8a\xe6\x6b\x19\x50\xd4\xc8\xc9\x23\xfb\x6c\x79\x50\x74\xda\x35\xa0\x48\xd7\xb8\x01\x45\x06\x14\xed\x3e\x28\x42\xc3\x21\x72\x38\xbe\x45\x7d\x69\x74\xfa\x0c\x7f\x45\x8d\x02\xa4\xf5\x09\xb6\x25\x7c\xb4\x35\x4c\x75\x89\xd9\x0d\x78\x2b\x66\x4f\xe2\x2a\x83\xa4\x36\x88\xa4\x72\xd7\x07\x0a\x81\x54\x8c\x84\x56\x05\x52\x31\x0e\x5b\x1b\x48\x55\xc2\x4b\x01\x09\x90\x81\x4b\x99\x67\xc2\x20\xf6\xd4\x3b\x45\x55\x4e\x6a\x1f\x1b\xb8\xa4\x6b\xdc\xc0\x25\x03\x97\x56\x85\x4b\xa5\x2b\x7d\x93\x68\x09\x46\x9c\xdc\x42\x27\x8a\xfc\x86\xd0\xd1\xf2\x04\xda\x82\x86\xd6\xc5\x3a\xdd\x8e\x5d\x00\x77\x2e\xd2\x39\x79\x54\x48\x67\x81\x7a\x5d\x4c\xf0\xb7\x12\x7a\xdd\x9a\x89\xfd\xef\x4f\x57\x25\xd4\xec\x86\x41\x1c\xf1\x3c\x18\x32\xa4\x87\x67\xa5\x96\x5e\x35\xe0\x42\x9b\xf4\x74\xdb\x45\xba\x53\x34\xbd\x72\x4b\x7f\x7a\x94\xd5\xff\xe9\x65\xce\x6b\xbd\x1c\x2f\xbc\x43\x40\xc9\xdd\xe2\xc9\x80\x1e\x86\xec\x6d\x02\x3a\x95\x21\x7f\x22\x1c\x7a\x40\xb4\xf5\xdd\x60\x68\x0f\xba\x19\x31\xc8\x0e\x61\x30\xbd\x0e\x9b\x9f\x4f\x17\xb2\xf1\x7b\x14\x8c\xf8\x38\x77\xba\x48\x96\xa1\xa2\xd7\xd6\xba\x52\x28\xec\x4c\x2e\xda\x57\xca\x5a\x35\x94\x25\x58\x6b\xab\xc8\xae\x88\xb5\x87\xe5\xac\xed\x1d\x66\x81\x9a\xf4\xf4\xf2\xdd\x80\x1e\x1e\x05\x17\xec\x53\xf1\xed\x71\x78\x3b\xd2\x17\x24\xc2\xaf\xb7\x92\x63\xec\xa2\x7f\x21\x4a\x0a\x8a\x7d\x78\xaf\x6f\xd5\xc7\x81\xbe\x60\xce\x90\xaa\x25\x84\xca\x15\x2e\x7a\xaa\x29\xba\x44\xcc\xd1\xbf\xc8\x85\xac\x15\x74\x50\xae\xf3\x85\x18\x5d\x60\x01\xed\x95\x6c\x51\x70\x87\x5d\x9e\xc3\xea\x45\x90\x39\x07\x0a\x73\xb0\xc9\x0a\x11\x75\x50\xc0\xe1\x48\x1f\x0f\x0f\xc5\xdb\x14\xba\x38\x12\xcd\x1f\xe7\xcb\xf4\x52\x4f\x51\xe0\x22\x8a\x24\x74\x18\x7a\x44\xb1\x40\x53\xab\xfb\xdb\x2d\xa2\x14\xbb\x55\x00\xbb\x5c\xdc\xa2\x2d\xb9\x90\xf3\x1a\xd7\x49\xd3\x0d\xc4\xcb\x3b\x6f\xf7\xa7\xeb\xa8\x5b\x1d\xf9\x39\xa8\x68\xb5\x33\x0e\x9d\x1b\xed\xb0\x19\x47\x61\x88\xdc\xf7\x53\xc8\x98\x2f\x5f\x1a\x76\xb1\xc8\xd7\xa0\x22\x0e\x79\x1f\x3a\x1c\xdf\x62\x3e\xe9\x3b\x24\x0a\x78\x16\x15\xb9\x90\x0b\x2c\xf4\xe3\x7f\x3e\x5b\xcf\xdc\x32\x98\x94\xab\x99\x01\x66\x09\x2e\xdb\x20\x75\x89\xda\xc0\x60\x02\x9e\x0b\x32\x68\x75\x00\x17\xa7\xa7\xd0\x2c\xc2\x46\x63\x5d\xdf\xbe\xc9\x7e\x3f\x3c\x2c\x11\xf4\x5a\x80\x41\x9e\xb4\x28\xec\x1a\xff\x0b\xf4\x93\x8f\x38\xc5\xc2\x5e\x58\xa1\x6a\x4d\xe6\x25\xe3\x6d\xbe\x4c\x68\x94\x25\xa2\x04\x19\xdf\x21\x07\xb8\x7c\xf4\x6e\xea\x3e\xe7\x3c\x7f\x51\xf6\x11\x8d\xe2\x4c\x33\x9a\x17\xaf\xc6\x78\xa8\x8d\x19\xa4\x30\xee\x03\x61\x7c\x44\xd1\xd5\xef\xef\x4b\x6e\x85\xa6\xa9\x4f\xbe\xa9\x76\x18\x52\x89\x63\x8b\x2d\xb1\x0a\x57\xa4\x2d\xed\x27\x18\x11\x07\x2e\xbe\xc5\x6e\x04\xbd\x62\x68\x97\xd4\x95\x59\x58\xb2\x1d\xbb\x87\xf7\x58\x83\xa7\x06\x91\x73\x33\xd5\xd7\xea\xb8\xe7\xb2\x6d\x88\xe9\x51\x21\x42\x1c\x04\xd2\xbc\x55\x8c\x38\x52\x70\xf0\xe7\x75\xe1\x10\x26\xf0\xbe\x8a\xbd\x2c\x82\x93\x00\x2c\x48\x44\x21\x2b\xc8\xcc\x48\xda\xde\x8b\x42\x32\x7a\x03\x59\x2e\x02\x07\x66\xf8\x4b\xfb\xda\x14\x81\x59\x1a\xcb\xac\x8f\x65\x2c\xd6\x8b\x5b\x1c\x86\xb6\xa8\x60\x0b\xab\x6c\xb5\x4e\xf4\x52\x27\xe1\x73\x01\x82\x94\x65\xef\xd1\x6d\x3a\xb2\x3d\x1d\xa1\x95\x9c\xa4\x5f\xe0\x3d\xf6\x23\x1f\x38\xc4\xf7\x93\xd5\x6b\x3c\xa6\xe4\xe1\xbd\xee\x61\x05\x97\xe9\xf0\x68\xbb\x2e\x93\xf1\x89\x1e\xbb\x4f\x34\x5b\xb7\x7a\xab\x0b\x1e\xb5\x87\xa4\x8f\x1b\x0b\x60\x2a\xf4\xc8\x00\x32\xd4\x0f\x22\x7f\x00\x9d\x1b\x14\xb8\x6c\x45\x78\xaa\xc4\xab\x85\x7f\x12\xbf\x2b\x90\x31\x28\x8a\x5e\xd7\xde\x8b\x99\x7b\x94\x90\x6f\x41\x84\x3b\xef\x03\xc5\x9d\x6b\xc2\x0b\x5a\x23\x95\xcb\xaa\xdc\x2e\x61\x70\xdd\x74\x77\xd2\xe7\xa9\xa4\x7f\x52\xbe\xbf\x59\x69\x1b\x64\xb3\xae\xcc\x85\x3c\x88\x63\xdc\x18\xe3\xc6\x2c\x8f\xff\x8d\x1f\x93\x3e\x6e\xce\x8f\xd9\xd6\xce\x97\x6d\x97\x83\xfd\xd3\x6c\x3a\xc2\x85\x5b\x5f\x9f\xa2\xd0\x53\x0f\x64\xaf\xb3\xeb\xd5\x06\xc7\x6c\xc3\x3b\x56\xf6\x82\xdd\xc8\xc3\x13\xe3\x7f\x19\xff\x6b\x25\xff\xab\x9d\x0e\x0f\x8b\xfc\xe7\x14\x72\x8d\x87\xc1\xa3\xb0\x3f\x44\xdc\x19\x23\xb7\x46\xe4\x2b\xe8\xe1\xe6\x08\xb6\xe5\x9c\x8e\x8a\x6a\xdf\x4d\xc7\xd5\xcc\x3e\x4e\xf1\x74\x52\xc4\x23\x1a\xd4\xc7\xc0\x85\xfc\x5b\x9b\x60\x5b\x19\xf8\x31\x1e\xd8\x12\xbe\x48\x2d\x1c\xc4\x01\x43\x94\x6f\x90\x83\x6b\x13\x6c\x2b\x07\x7f\x8e\x07\x56\xba\x63\xd6\x00\x07\xa3\xd0\x85\x9b\x64\xe0\xba\xf4\xda\xca\xbf\x7f\x4e\xc7\x55\xc2\xbe\xcb\x26\xd8\xe7\x22\x0f\x6d\x92\x7d\xeb\xd2\x6b\x2b\xfb\x2e\xa7\xe3\x2a\x61\xdf\x4f\x3b\x10\xcb\xd1\xba\x58\x26\x7e\xb3\x91\xf8\x8d\xd9\x69\x7e\xb2\x11\x9a\x16\x06\x26\x0a\x2f\xcf\x6f\x7a\xc7\x78\x51\xc8\xa2\x67\xb6\x8c\x4d\xc8\x02\x3c\x99\x90\xc5\x53\x73\x79\xb7\xb4\x4d\xf7\x91\xdc\x31\x90\x0c\x1d\x0c\x26\xe0\xaf\xa8\xa0\x83\x73\x11\x8e\xbc\xed\xd1\x9e\x47\xac\x05\xb7\xd7\x1c\xba\x6a\x79\xe4\x6a\x9b\x52\x10\x8f\xbc\x9a\x10\xbc\xd9\xe5\x43\xa9\x1f\x11\x74\x81\x74\x01\xc0\x45\x7c\x4e\xd9\xb8\x02\xdb\x75\x05\x48\xa8\x91\x36\xe3\x08\x18\x47\xe0\x51\x3b\x02\xda\x7b\x94\x0b\x52\x26\xf4\x8e\x8d\x1f\x60\xfc\x80\x27\xe3\x07\xac\xbd\x8f\x61\xfb\x4f\x7d\xc7\x44\xa2\xbb\x64\x74\xa5\xf0\x6e\xfe\x46\x52\x7e\x86\xea\xb8\xa3\x54\x8b\x4f\x60\xb6\x62\x6a\x14\x8c\x78\x70\xcd\xc8\xc5\xe5\x46\xe5\xc2\xec\xf1\xd4\x28\x17\xf1\xe0\x9a\x91\x8b\x9f\x76\xd9\x7d\x9c\x6e\x1e\x81\xb7\x63\x18\x8c\x90\x0b\x42\x44\x81\x7e\xfe\x8d\x1f\x69\xfc\xc8\x82\x91\x19\x3f\x72\xbe\x6c\xe7\x8e\xfc\xf6\xce\xca\x9d\xb4\xd3\xec\x37\xa3\x16\x1f\xf9\xa5\x30\x60\xb0\xee\x74\x37\xc6\xad\x9e\x3e\x93\x1c\x3b\x5f\xe0\x56\x9b\x13\xc1\xc6\xad\x06\x4f\xc2\xad\xbe\x87\x0e\xef\x3b\xc4\xf7\xf1\xaa\x89\x39\x96\x3c\x11\x5c\x07\xc1\xb6\x62\xe5\xb7\x72\x58\xcb\x61\xe3\xb9\xf9\x68\xc5\x3e\x9b\xec\x0f\x25\x9e\x37\x80\xce\xcd\x46\xbc\xa7\x7a\x28\xb6\x55\x26\x3e\xc6\x03\x6b\x42\x2a\x76\x7a\xe3\xad\x04\xe6\x18\x4f\xe9\x71\x7b\x4a\xe6\x76\xa4\xd9\x72\x6b\x83\x6f\xa0\x3d\x7b\xb7\xc8\x39\xc8\x7e\x09\xc6\x38\x07\xc6\x39\xd8\x71\xe7\xa0\x38\x33\x49\x9a\xb8\x4f\x14\xf3\xfb\xbe\x1b\x51\x28\xec\xf5\x4a\x29\xbc\x67\x29\x1b\x4b\xb2\x79\xd7\x41\xb5\xb6\x0c\x91\x5b\x49\x02\x99\x07\x85\x45\xf3\x51\x87\xbb\xd0\x46\x60\x78\x19\x8f\x0f\x90\x21\x30\x20\xb1\x3d\x20\xf1\x31\x04\xd3\x0d\x3a\x6c\x71\x20\xfd\xf0\xb4\x1c\x79\x9d\x65\x4d\xe4\xe2\x40\x3a\xf2\x43\xf0\x0e\x3f\xba\xfc\x19\x8b\x12\x1b\xda\x0d\x40\x65\x2d\xbf\x16\x6c\x7c\x1c\x19\xa4\xbc\x31\xa4\x6c\x3f\x79\xa4\x9c\x9b\x9e\x6d\x24\x12\xe4\xc8\x0f\xfb\x43\xa1\x72\xb6\x98\x47\xb0\x86\x4e\x3c\xe2\x34\x82\x6d\x84\xbc\xbf\x46\xfe\x00\x51\x09\x78\x8b\x6c\x96\x81\xbb\x4f\x3a\xa1\x9c\x39\x5c\xb2\x5d\x4c\xdc\x6e\x18\xa8\x8d\x98\x36\x01\x03\xf5\xf9\xad\x17\xe1\xc0\x73\x83\x03\x0d\x0e\x5c\x09\x07\x96\x2b\xb2\x34\xc1\x35\x0e\x1c\x8a\x20\x43\xe0\x87\xbc\x32\x8b\x17\x67\x9e\x25\x73\x53\xba\x8c\xea\x69\x7f\xd8\x36\x0b\x04\x35\x5f\x88\xde\x3c\x1a\x5d\xaf\x13\x35\xa2\xd1\xad\x7d\xf5\xa7\xc1\x8c\xd7\x05\x87\xd2\x6b\x65\xbe\x8c\xd9\x17\x1d\x7f\xaf\x87\xc3\xeb\xf2\x54\xbb\xc2\x9b\x63\x69\xaa\x73\xaa\xf1\x76\x17\xb2\x5a\x5f\xe1\xaf\xc8\x38\x21\xad\x72\x42\x7a\x25\x8b\x42\x2e\x39\xe3\x84\x3c\x1d\x27\x64\x5b\x81\xf9\xa3\x93\x72\x80\x7f\x96\xf5\x3f\xaa\x7c\xcf\x75\xe8\x61\x87\x33\xf0\x37\xf0\x9e\xa8\x67\xe3\x1e\x53\x7c\x3e\x3b\x31\xc8\xc5\xbc\xc0\xbb\xb1\x10\xa5\xd2\xf0\xe4\x89\x16\xba\x73\xf9\xf1\x36\x17\xeb\x3f\x5a\xb0\x37\xb3\xe5\xcf\xbe\x3e\x25\x17\x6f\xb5\x43\x31\x05\x1f\xfb\x6f\x89\x9f\xd7\x2e\x17\x2b\xf5\x2b\x9d\x44\x51\xe5\xcd\x93\xcb\x3e\xc5\x6a\x2a\xd4\xab\x01\x00\x2a\x9d\xb7\x76\x11\x74\x3d\xa1\x03\x37\x73\xfc\x7e\x7d\x72\xeb\x1d\xb4\x1e\x51\x12\x85\x6f\x26\x39\x1e\xe8\xf8\x00\xa6\xc6\x84\x42\x3f\xcf\xb3\xb4\x7c\x06\xea\x35\xe5\xd7\x2a\x7d\x30\x6f\x5f\x24\xf2\xcb\x55\x78\xc8\xbf\xb3\x52\xc7\xf2\x1f\xae\xaf\xd2\x27\xa9\xec\xf3\x7d\xda\x5b\xd0\x46\xa3\x49\x5e\x63\x89\xd1\x9d\x55\x82\x2c\xa2\xc8\x9f\xea\x65\x2b\x9c\x7e\xdf\x93\xfd\xa5\x21\x1c\x12\x0f\x3b\x82\xed\x96\x8b\x86\x30\xf2\x96\xbc\xec\x40\x11\x8b\x3c\xfe\xae\x9a\x8c\x31\xe4\x21\x87\x6b\x39\xa3\xe3\x95\x8e\xbb\x8b\xf9\x0b\xe6\xb5\x83\xb6\x86\x96\xd1\x19\x56\x23\xcf\xd5\xbd\xaa\x91\xc0\x0a\xbd\x5c\x44\xce\x47\x30\xd8\x1c\x35\x17\x0f\x87\x88\xa2\xc0\xd1\xac\xb0\xbc\x3c\x03\x70\xbd\x50\xc2\x93\x03\x6c\xb9\x02\x0e\x47\x7a\x36\x69\x97\xed\x0d\x92\x52\x38\x26\x2c\x2f\x82\xb2\x02\x09\x11\x85\xd3\xc5\x61\xfd\xf8\x1f\x7d\x1d\x69\xcd\x45\x85\x83\xff\x7b\x26\x5a\x7a\x76\x50\x61\xcd\x2e\xe5\x1e\xa5\x26\xc8\x2d\x5e\x7e\x75\x99\xa0\x54\x8e\x37\x63\x82\xd6\x27\x67\x4c\x50\xb5\x8e\x3d\x16\x13\xf4\xb6\x18\x86\x35\x64\x82\x34\x37\xab\x5a\x69\x82\x66\xda\xc1\x98\x20\x95\x9a\x31\x41\x0b\x4c\xd0\x5e\xc1\x58\xb7\x19\x82\x4e\x97\xfa\x81\x1e\x77\x16\xc7\xa0\x7d\xf6\x11\x31\xe2\x45\xf2\x68\xbc\xde\x09\x5f\x3b\x4c\xed\x44\x7e\xe4\x41\x8e\x6f\x91\x09\x53\x6f\xf1\xfe\x60\xfe\x64\x8e\x89\x42\x9b\xa3\x30\xba\x13\xd1\x6b\x45\x5c\xf3\x57\x0e\x9b\x8a\xb8\x6a\x8f\xd5\x2c\x0a\xb9\x9e\xd8\x26\xe6\x6a\x62\xae\x69\x91\x89\xb9\xc6\xb5\x4a\x0f\xc8\x48\x44\xd1\x77\x48\x14\xd4\x94\xef\x64\x30\x01\xcf\x85\x8d\xee\x80\xb9\x93\x31\x05\x07\x63\xea\x20\x3e\x3b\x0b\x93\xa5\x6a\xdc\xe1\x27\xe3\x0e\x7f\xfb\x26\x58\xff\xf0\x00\xf6\xcb\xcf\x9e\x98\xf0\xac\x09\xcf\x16\x50\x33\xbe\xf1\x0e\xfa\xc6\xb3\x5b\x22\xba\x43\x13\xc6\x2f\x4e\xcb\xda\x73\x7c\xcb\xdc\x21\x31\x8e\x73\xfa\xf6\xb6\x8e\x6f\x1d\x1f\x17\x4a\xf4\xf4\xf8\x56\xf6\x6b\x72\x0b\x8f\x6f\xbd\x89\x84\xf1\x60\xe0\x6f\xe0\x8d\x44\xb4\xe0\x37\xa9\x93\x4d\xbe\xd2\xa6\x0e\x5f\x1d\x2f\x38\x7f\x77\xdc\x35\x91\x80\x76\x47\x02\x76\xfd\x82\xcd\xc1\xff\x50\xcc\x11\xf8\xe1\x20\xaf\xe8\x13\x35\x5a\x35\xb3\xcc\xb6\x03\x0d\x8b\xf7\xc3\x07\xde\x4d\x9f\x22\xe8\x4a\xbf\xbd\x91\x9b\x33\xa5\xfb\xe3\xf5\x90\xdf\x89\x1b\xdc\xf2\xe3\x6b\x8d\xde\x8d\x29\xe7\xf2\x9d\x90\xea\x2d\xb2\xb9\x06\xfa\x3b\xc1\xe7\xa9\xf6\x78\x3c\x17\x65\x66\x80\x07\xdc\x61\x3e\x8e\x71\x90\xb9\x2c\xd3\x62\x6f\x8b\x96\x6d\x51\x5a\x9a\xa2\xb6\x78\x5a\x15\xc7\x77\xd7\xe2\x8f\x5d\xb4\xc7\x05\xdb\x25\x27\x63\x97\xf7\x2e\x17\x79\x2c\xbd\xed\x3a\x2c\x63\xec\xa2\x7f\x21\x4a\x6a\xf3\x67\x28\x1e\x8d\xf9\x55\xe1\xdd\xe2\x27\xe7\xef\x98\x9d\xcf\x15\x76\x3e\x93\xb8\xca\x85\xe7\x11\x07\xaa\x93\x27\xeb\x2e\xb7\x03\x9a\x01\x9e\x83\x91\x44\x9b\xb4\x3f\x98\x92\xe9\x43\x41\x66\x85\x54\xae\xd3\x03\xbe\xb8\xc6\xc6\xd7\xba\xfd\x6d\xf6\x2f\x77\x6d\xff\xb2\x44\xbe\x9f\xf8\x96\x65\x66\xf5\x98\x6d\xcb\x1c\x35\xb3\x6d\x59\xd3\xad\x92\x0d\x9b\x9a\x01\x74\x6e\x50\xe0\xf6\x87\x6c\x12\xac\x68\x72\x16\x5a\x9c\x95\x68\x18\xcb\x53\xa9\x63\x8f\xc5\xf2\xbc\x13\xb2\x01\x1c\xe8\x79\x0c\x0c\x26\x00\x82\x58\x68\xcc\xbd\x12\xa5\x86\x31\x44\xc6\x10\x3d\x62\x43\xd4\xac\x09\x32\xc6\x47\xfe\x67\x8c\x4f\x7e\x13\x84\xa3\x00\xb8\x98\x22\x87\x7b\x93\xed\x58\x20\xcd\xd7\xf9\x8d\x05\xaa\xd4\x53\x63\x81\xaa\x0c\xc2\x58\xa0\x72\x1b\xe1\x78\x08\xae\xf2\x01\xa5\x4a\x51\xb7\xa5\x1a\x37\xe6\xa7\x52\xc7\x1e\x9b\xf9\x19\x4c\x00\x1f\x23\x69\x77\x04\x13\x03\x17\x4c\x85\x68\x63\x16\xe8\xd2\x58\x20\x63\x81\x8c\x05\xda\x9e\x05\x1a\x23\xe7\x46\x6e\xa7\x2d\x63\x29\xaa\xf8\x3f\xab\xb5\x6c\x6c\xd0\x93\xb3\x41\x6e\x44\x71\x30\x02\x33\x79\xd9\x5c\x66\x97\x9f\x8c\xf5\x31\xd6\xe7\x29\x58\x9f\xbd\x82\xb1\x6e\xf3\xdc\x64\x6c\xe9\xcc\xcd\xb5\x96\x9e\xa5\xdc\xe2\xad\x2e\x93\xd3\x65\xee\x95\x27\x73\x35\xed\xe4\xa8\x50\x64\xe5\x39\xc1\xd3\x25\x33\x8b\xff\xc6\xc7\x79\xfd\xf2\x78\x6e\xa1\x29\x2d\x23\xe6\x50\x1c\xc6\xea\xd1\x12\x62\xeb\x02\x12\x80\xe2\x8c\x8b\xe0\x16\xa3\xbb\x6c\xeb\x1b\xbe\xca\x76\xb2\xe0\x2e\x62\xcf\x7c\x33\xb4\xe5\x47\x3b\x5b\x7b\x95\xad\x5d\xa7\x3a\x97\x48\xbb\x3a\xfd\x2b\xf6\xa2\x43\x1c\xd4\x95\x86\xb5\x5a\x16\xd6\xda\xa8\xaf\x97\x95\xb5\x39\xa7\xf3\x03\x0e\x02\xe4\x82\x81\x06\x7b\x02\xa0\xfd\xe2\xfe\xe2\x49\x5a\xee\xac\xa1\xfe\xdb\xfb\x0b\x60\xcc\xf2\x02\x94\x24\x03\xdd\x8a\xfc\xac\x4d\xbc\xad\xe2\x53\x9a\xff\xbc\x56\xc9\xd1\x1c\x0e\xda\x90\xe4\x6c\x4d\x6a\x1e\xa5\xc4\xbc\x27\xce\x0d\x10\xb4\x49\xa4\x0f\x6c\xd5\x2a\x35\x9a\x0d\xfd\x0d\x49\x0d\x0b\x60\xc8\xc6\xa4\xa6\x44\x6a\x4b\x4a\xce\xda\xc4\xdb\x2a\x3d\xbf\x79\x2e\x48\x06\xd7\xbc\xf4\x68\x36\xe3\x36\x24\x3d\xf2\x6e\x9b\xe4\xc6\x56\xe4\xa7\x06\xf2\x6d\x95\xa0\x4b\x4a\x04\x86\x05\xb3\x21\x32\xd0\xb8\x20\x69\xe2\xea\x7a\x41\x2a\x0c\x7a\x6c\x33\xeb\x34\x0c\x1c\xe4\x21\x17\xfc\x1e\xe5\xb9\x65\x6e\x72\xb7\xeb\x26\xf7\x86\x93\x4d\x3f\x81\xac\x59\xe6\xc6\x76\x5a\xf4\x64\x6f\x6c\x2f\x0a\xcc\xd9\x47\x26\x30\xd7\xaa\xc0\x9c\x8f\x83\xab\x10\x8a\xb1\x1f\x95\x07\xec\xaa\xdc\xc5\xce\x75\x27\x1b\xb4\xb3\xeb\x09\xda\x51\x14\x22\xa8\xb5\xc4\x3b\x15\xce\xd3\xa5\xf2\x61\xfd\x31\x5e\xd9\x17\x51\x12\x07\x1d\x7c\x0e\x9e\x6f\x82\xce\xdf\xe3\xef\x34\xe9\xe9\x50\x04\xdd\x7a\x08\xb5\x11\x23\xbf\x85\xce\x18\x81\x31\xe6\x40\xb8\x0b\x8f\x28\x35\xd1\x74\x60\xff\xc0\x1c\x7c\x84\x1c\x13\xb3\xd5\xde\x52\xb0\x3b\x5b\x01\xb1\x22\x8e\x02\x5c\x3f\x20\xb4\x6c\x8d\xe3\xd7\x20\xac\x8d\xc7\x52\x3c\x8e\xba\x33\x2d\xed\xec\x8e\x7b\x0b\x71\xed\x86\xb7\x82\x4f\x0f\xcb\x11\x67\xaf\x05\x49\x82\x7e\xf2\x43\x3e\xd9\x54\x12\xa1\x47\x0e\x58\xcd\x4e\xf2\x7a\xd0\x53\x7f\xf6\x7a\x76\x86\xb6\xcf\x26\x81\xa3\x49\x26\xb9\xf6\xf5\xd6\x75\x48\xac\x75\xc0\x7b\x5c\x98\x50\xab\xd9\x2c\x01\xd8\x43\x0c\x5c\x4d\x02\x67\x4c\x49\x80\xbf\xca\x4c\x8f\x80\x13\xe0\x62\x76\x53\x25\x90\x5a\x3a\x73\x1b\xdc\x3a\x5e\xc8\xce\xa2\xfc\xa3\x75\x8a\xcc\xf2\x34\x76\x51\x66\x92\x93\xed\x53\xd9\x59\x53\x56\x66\x53\x56\xc7\x6e\x71\x2b\xbd\x94\x74\xb0\x40\xcc\x82\x09\xb8\x3f\xad\x0f\x38\x36\xe3\x7c\xf8\x1b\x1d\x83\xf1\x3c\x32\x65\x6d\xf5\x3c\xb4\xc1\xee\x45\xae\xc7\xd9\xa9\x71\x3d\x92\x12\xe3\x7a\x18\xd7\x43\xfb\x35\xc3\x34\x5c\xcc\xf0\xd7\xa6\xf2\xe7\x37\x48\xb8\xc6\xc4\xf9\x5b\xc2\x9c\x97\xf1\x3c\x00\x31\x0f\x8b\x3e\x05\xb8\x71\x1f\xa5\x8d\xb0\x33\x33\x63\x06\x73\x6e\x17\x73\xba\xc8\x19\x4c\xb8\x6e\x3d\xb5\xe5\x14\x84\x41\x9e\x2b\x22\xcf\x3d\x85\x9c\x50\x1f\x62\xd1\x8b\xb9\x3a\xec\xce\x26\xcb\x62\xce\x18\xf9\xf0\x0f\x44\xd9\x74\xe3\xc9\x3e\x99\x15\xf1\xc9\x74\xd1\xba\x90\xce\x3c\xe9\xfc\x65\x5c\x8b\x4d\x02\x17\x31\x3c\xcf\x02\x0b\x07\x43\x0a\xad\x5c\x37\x38\xf2\x43\x0f\x72\x1c\x8c\x32\xc3\xb6\x3c\xcc\xd4\x2b\xdd\xdf\x8a\xf0\x5a\x4e\x0c\x38\xba\x9f\x1e\xe9\x89\xfb\xb1\xef\x0e\xf2\xd2\x90\x5e\xe8\x9d\xaf\x55\x26\x6d\x89\x51\x51\xe1\x6b\xb1\x58\x25\x2b\xdb\xfa\x42\xd4\x0e\x58\x24\xe4\x89\xde\x54\x7a\x96\xbf\xc3\x1c\x5f\x71\x2f\xd0\x79\x95\x87\x5c\x69\xd0\xea\x2d\x66\xe5\x56\xb6\xf5\x57\x84\xe8\xa4\x9c\x92\xc5\x6e\x70\xf8\x4f\xea\x5d\x4d\x02\xa7\x40\x6a\xd3\x1d\x48\x12\x30\x0e\x03\x5e\xa8\x87\x73\x2b\xc1\xfb\x23\x19\xc2\x8b\x1f\x54\xba\x15\x44\xc2\x9f\x84\x94\x7c\x41\x8e\x66\x43\x2c\x9d\x9b\x59\x9d\x52\x71\xa8\xe4\x48\xc9\xc3\x49\x43\x1c\xe0\xe4\x4e\xa0\x94\x96\xfe\x54\x7d\x3f\x8f\xc2\x18\x2c\xcd\x4d\xe6\x67\xeb\xa1\x03\x52\x5c\xa4\x42\xa0\x44\x08\x55\x03\x87\x03\xc7\x8b\x5c\x74\xe1\x15\x01\xfc\x74\xbb\xef\xd7\xa4\x69\xb5\x65\x3f\xf2\x38\x2e\x78\x3b\x11\xe4\xa0\xe8\xe5\x39\x71\x2e\x94\x98\x75\x87\x3e\x53\x59\xea\x0d\x57\x8a\x46\xe8\x5e\xb3\x97\x59\x41\x12\x63\xc0\xa0\xb6\xc8\xe1\x48\x0a\x1a\xfb\x3d\xe9\xbd\xda\x74\xa2\xf6\xd4\xe1\x8a\xe7\xc5\x2f\xc5\x72\x3f\x9d\x13\xa5\x30\x62\xe8\xd3\xb4\x51\xd5\x66\x54\x5d\x12\xcf\x93\xc9\xfc\x37\x83\x7e\xe8\x21\x77\x90\x9b\xc4\xb2\x35\xa2\x1f\xd1\xdc\xea\xb9\xf0\x34\x38\x39\x5d\x37\xcf\xfa\x7d\xa8\xe6\x37\x29\xd0\xa1\xa5\xe2\xab\x81\x3b\xa9\xf4\x26\x78\x71\x15\xe1\xcd\x2b\xaa\x55\x95\xb0\xd6\xaf\x58\x30\x4f\x8b\x66\x4a\x93\x96\x63\xbd\x4e\x24\x22\x50\x6e\x07\x92\x4a\xeb\xf5\xa5\xa2\x55\xaa\x64\x92\x56\xb5\x47\xf1\x48\x3a\x05\xc4\x96\xb1\x4a\x11\xe3\xc4\xaf\x6c\x93\x22\x4e\x0a\x62\x4d\x11\x27\x7d\x87\x44\x72\xb9\xf5\xba\xaa\xd0\xcb\xe2\x38\xfc\x6a\xe7\xba\x5b\xc1\x98\x49\xca\xe5\xeb\x51\x90\x48\x1c\xd9\xbe\x3e\x99\x52\xc5\x25\x9a\x2e\xc1\x9f\xf5\x1e\x73\xba\xcc\x0a\x3c\xea\xa6\x10\x8f\x76\x12\x56\x98\x86\xda\xd7\x5f\x8e\xa5\xd9\x6e\xd9\x79\x41\xaf\x97\xfe\x71\x39\xfd\xe3\xa6\xe9\xdb\x7e\xf9\xf8\xfd\xa6\xc7\x5f\x4e\xff\xb8\x69\xfa\xf6\xb8\x7c\xfc\xe3\x86\xe9\x9f\x94\xd3\x3f\x69\x9a\xbe\x9d\xcf\x9a\x97\x19\x7f\x2e\x11\x56\x45\x45\x6f\xb3\xce\x31\xeb\xd8\x7e\xe7\xd8\xef\xd8\xe3\xce\xc9\xb8\x93\xa3\x34\x07\x15\x55\x6f\xad\xba\x15\xd0\xa9\x89\x8a\x40\x4c\xe3\x0a\x56\x50\xe7\xe7\x2f\x8e\x5f\xd8\x47\x25\x0a\x3d\xae\xd0\x80\x57\x52\xe0\x65\xa8\x93\x57\xd9\xcb\xd0\x45\xc0\x2a\x20\xb4\xdb\x38\xec\xb0\xae\x73\x11\x87\x54\xb1\xd3\x89\x5b\x2c\xf1\x25\xd4\x31\xa6\xbe\xc4\x01\x78\xfe\x67\x77\xff\xfc\xf3\xe7\x17\xd7\x7f\xff\x5e\xfd\xcc\x72\x75\xcf\x42\x6d\xbf\x65\x9e\x45\xfa\x77\x12\x47\x7c\x98\xc5\x66\xb0\xe4\xca\x5c\x54\x66\x38\x0d\x04\x5b\x01\xb9\xdb\x3f\x9c\x57\x2f\x96\x84\x40\xe2\xb9\xa5\x6d\x26\xc4\xce\x8d\xdc\x73\x99\x6b\x6c\x8c\x5d\x17\x69\xc2\x5a\x09\x6f\x52\x3b\xad\x42\x06\xd5\xb2\x59\x76\x57\x79\x70\xa8\x3e\x50\x6c\x91\x6a\x1b\x2c\x5b\x7d\x70\xd8\x55\x6b\x28\x41\xe0\x9e\xf2\x7b\x5e\x9d\x5d\xcf\x4f\x0c\xf6\x51\x5f\x0f\x7d\x16\xf7\x42\x25\x7a\xa2\x12\x55\x7b\xd1\x3b\x52\x1f\x28\x77\x4c\x4e\x5d\x75\x9c\xf3\xfd\xd6\xf2\xee\x2b\x91\x9b\x56\xd6\x80\x92\x3b\x36\x97\x95\x78\x16\xc4\xbf\x8a\x11\x37\xd8\x07\x3f\x07\x43\x0a\xc1\x3e\xb8\x9c\x1d\x86\xb1\x22\x3c\xdd\xa7\xf8\x3a\xfc\xc7\xaf\xe7\xe7\xde\x5f\xe1\xac\xe8\x36\x8d\x33\x4e\x3f\x4f\xf9\xb0\xf7\xff\x01\x00\x00\xff\xff\x31\x5c\xce\x96\x4f\x5a\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xfb\x73\xdb\xb6\x93\xff\x5d\x7f\x05\x06\xc9\x7d\x27\xe9\xc8\xb1\x28\x3f\x62\x7b\x9c\xcc\xf8\x51\xdf\x74\x26\x69\x73\x89\xaf\x37\xd7\xc4\xa7\x81\x48\x48\x42\x4d\x12\x2c\x00\xda\x72\x7d\xee\xdf\xfe\x1d\x50\x14\xcd\x07\x48\x51\x12\x29\x51\x16\xaa\x4c\x13\x11\x20\x16\xd8\x5d\xec\x7e\x76\xf1\x10\xf2\xc8\xef\x98\x71\x42\xdd\x13\x40\x5c\x81\x87\x0c\x23\x61\x3f\xbc\xa3\x6c\xb8\x7b\x67\x20\xdb\x1b\x21\xa3\x75\x4b\x5c\xeb\x04\xfc\x27\x43\x03\xe4\xa2\x4b\xc4\x47\x7d\x8a\x98\xd5\x72\xb0\x40\x16\x12\xe8\xa4\x05\x80\x8b\x1c\x7c\x02\xf8\x83\x6b\x61\x4e\xf8\x0e\x71\x07\x0c\xed\x58\xfd\x1d\x2b\xaa\x0d\x80\x8d\xfa\xd8\xe6\xb2\x36\x00\xc8\xf3\x9e\xab\x07\x4f\xa6\x5f\xde\x11\xba\x5b\x5c\x2a\x1e\x3c\x2c\x7b\x3b\x60\x88\x0b\xe6\x9b\xc2\x67\xb8\xc5\x3d\x6c\x96\xea\xc8\xbb\x3f\x39\x75\x5b\x00\xc8\xbf\x4e\xc0\xff\x07\xb4\x1f\x83\xff\x03\x00\x91\xeb\x52\x81\x04\xa1\x2e\x87\x27\xd1\x63\x00\xa0\x4d\xb8\x80\x27\xe0\x7b\xf4\xe4\xf9\xa5\xb0\x46\xdf\x27\xb6\xf8\xc5\x85\x27\xc0\x68\x27\x4b\x24\x8f\x38\xf5\x99\x89\xe1\x09\x80\x3b\x3b\x53\x4e\x82\x9d\x1d\x98\xaa\x8a\x5d\xd4\xb7\x65\x35\xc1\x7c\x9c\x2a\x1b\x11\x2b\xa7\x84\x98\xd4\xbd\xa0\x36\x65\xb2\xfd\x57\x87\xd8\xea\xe0\x4e\xba\x65\x9b\x38\x44\x8e\xc0\xe8\x74\x52\x25\x92\x63\xf2\xc5\xb3\xe7\xb1\x83\x7f\x81\x33\x1b\x33\xc1\xd3\xad\xf0\x11\xbd\x0f\xc6\x98\x6e\x44\xa0\xa1\xe4\xd8\xf7\x9b\xf4\xf3\x07\x4f\x76\x1a\x46\xec\x87\xb1\xf2\xa7\xe8\xdf\x37\xe1\xbf\x9e\xa6\xaf\x43\x0b\x73\x93\x11\x4f\x8a\x42\xbe\x1f\x75\x04\x62\x8b\x08\x05\x93\xe0\xd0\xc5\xe2\x17\x0b\x9e\x80\xbd\xbd\xe7\x21\xc2\x21\x43\xde\xe8\x9a\x52\x5b\x10\x2f\x2e\x1a\x48\x64\xd5\x6e\xf7\xf9\xbb\xc0\x0c\x85\xd4\x8c\x83\xc3\xee\x61\x67\x7f\xff\x60\xef\xf0\xfd\x73\x0d\x9b\xb8\xb7\x3c\xa1\x03\x71\x0d\x08\x84\x20\x7b\x8a\xc7\x02\x33\x17\xd9\x40\xd6\x4f\xf0\x0f\x12\xd7\xb4\x7d\x0b\xff\x8e\x18\x57\x08\x32\xe2\x61\xec\x19\x00\x70\xaa\xca\x89\xa6\x24\x3d\xa9\xd9\x71\x6e\x26\x58\x9f\x65\x3c\x87\xad\x34\xdf\xa3\x37\xa0\x87\x5c\x6c\x17\x0c\xce\xa4\xae\xc0\xae\xd4\x1f\x78\x3a\x32\x3e\x9e\xf6\x3f\x9e\x0e\xa8\x2b\x80\x29\xb5\xee\xc3\x2b\x7c\x78\x84\x3a\x9d\x8f\xa7\x26\x76\x05\x66\x1f\x5f\xdf\x4d\x4c\xca\xe9\x6e\xf8\xe0\x74\x57\xd6\xfe\x78\xba\xdb\xff\x78\xba\x3b\x32\x3e\x26\xd9\x92\x27\xea\x50\x80\xc4\xfa\x42\x93\xb3\x51\x7e\xe0\x48\xca\x2f\x5e\x15\x00\x78\x2f\xc5\x9f\x7a\x36\x56\x68\xeb\x83\x7c\x16\x7b\x14\xa9\xdd\xb3\x6a\x1c\x1e\x24\x9e\x45\xc2\x4f\x72\xd9\xa1\xc1\x9c\x84\x23\xe1\xd8\xc9\x7e\x0b\x22\x02\x1d\x85\xa1\x79\x85\x4a\xe9\x08\x3c\x16\x31\xb9\xb4\x73\xb8\x8f\xcc\x11\xbe\x26\x0e\xa6\xbe\x14\x81\xeb\xdb\x76\xa2\xb5\x40\x0a\xe7\xc8\xbc\x1d\x32\xea\xbb\xb2\xf7\x03\x64\xf3\xa4\x72\x05\x75\x7e\x47\xb6\x8f\xf3\x8b\x15\xda\xf7\xaa\x7b\x7c\x6c\xee\x1f\x26\x7a\x0f\x00\x64\xc3\x3e\x7a\xd3\xdd\x7b\xdf\x06\x46\xf7\xb8\x0d\xf6\x3b\x6d\xd0\x79\x77\x74\xfc\x36\x5d\xef\x95\xb5\xbf\x8f\xf6\x0a\xd4\x34\x69\x1b\xbf\x30\xea\x60\x31\xc2\x7e\x52\xdb\xa1\x85\x4d\xe2\x20\x9b\xa7\x45\x59\xac\x3a\x03\xca\x1c\x24\x19\x06\xf9\x88\x32\x91\x2c\x1c\x22\x7f\x88\xb3\x5a\xe5\xa0\xf1\x94\x49\x59\x4b\xe9\x10\x77\x5a\x98\x2e\x92\x86\x51\xc5\x58\x39\xad\x47\x0c\xf3\x11\xb5\xad\x4f\x81\xff\x9b\x55\xeb\x33\x62\xb7\x38\x32\x10\xb9\x4a\x5a\xc9\xbc\xd8\x9b\x7f\x5e\x1c\x1d\x26\x9f\xc9\xf9\x7d\x87\x6c\x95\x5a\xe6\xcd\x19\xe4\x79\xc4\x1d\x5e\x4f\x0c\x94\x91\x57\x96\x55\xc6\xe4\x40\x63\x9e\xeb\x4e\x0a\x05\x08\x0a\x82\xe9\x14\x6f\x50\x7e\xe0\xdd\x54\xa0\x89\x82\xa7\x76\xb9\xc6\x19\x72\x87\x25\x1a\xef\x26\x0a\x9e\x72\x15\xde\x41\xe3\x4b\x24\xd0\x17\x4a\x5c\xc1\xb3\x5a\x06\x5d\xdf\xb6\x83\xc2\xcf\xa1\x69\x31\xa9\xeb\x62\x53\x60\x2b\xa9\xbf\xb2\xde\x35\x1e\x2b\xed\x81\x47\xb9\x18\x90\x71\x76\x46\x84\x05\x57\xd4\x15\xdf\xc8\xdf\x41\xf3\x07\x9d\xff\x48\xd5\x61\x58\xfd\x2e\xc3\xb3\x5e\x0d\x58\xf5\x19\x79\x25\x44\x37\x60\xd4\x91\xe4\x65\xdf\x13\x8d\xc8\x3f\x30\x90\xe3\x09\x80\xbf\xee\x9e\x29\x0a\x69\xf4\x62\x49\x9e\x73\x0f\xb1\x5b\x9b\xb8\x8a\xf9\x3e\x20\xb6\x1d\x41\xa7\xc0\xaa\xed\x19\x6d\x60\x18\x47\x6d\x60\x1c\x1d\x4b\xab\x66\x1c\x65\xac\xda\x40\x76\x5a\x3d\x8d\x25\x95\x78\x7b\x93\xe6\xba\x9d\x36\x30\x8e\xf7\xde\xc2\x02\xab\xd1\xca\x51\x4d\x18\xa0\x9d\x0b\x6a\xfb\x8e\xc2\xc6\x09\xc4\x86\x58\x94\x60\x38\x1e\x7b\x41\x9f\x1c\x34\x7e\xe3\xa0\x71\x8f\xde\x61\xd6\x13\xc4\xc1\x6f\xbc\x61\x8f\x63\x21\x88\x3b\xe4\x3d\x59\x12\x2a\x9c\xc4\x81\x8f\x7f\xd2\xfe\x87\x1f\xf0\xf5\x9f\xb4\xff\x03\xb6\xe5\x8c\xe0\x1e\x32\xb1\x7c\x14\x7d\xf9\x01\x9f\xbe\xbf\x9e\x9a\x81\x9b\xb7\x6f\x01\x65\x3f\xdc\x5a\xa8\x1c\x38\x37\x6f\xd3\x2c\x4c\x98\x79\x39\x9c\x1e\xc7\x8c\xe0\x34\x5a\x4a\x5a\x2a\x18\xf5\x37\xbf\xda\x15\x32\x05\x65\x69\x03\x25\x3f\xd0\xc6\x43\xec\x5a\x57\x11\xd9\x6c\x23\x0c\x0f\x02\x28\x0a\xcf\xca\xaa\x68\x64\xfe\xb9\x42\xc6\x53\x28\xf1\x19\x8d\xc1\xc5\x33\xdf\xd4\x90\x82\x13\x77\x68\x63\x2e\x50\xd2\x58\x4d\x0c\x55\x7c\xfa\x1e\xa5\xa7\x6f\x50\xa3\xdc\xf4\xa5\x12\x4c\xc3\x0f\x73\xce\xdc\xa9\xad\x9c\x6b\xf2\x06\x2f\xfd\x1a\x1a\x63\xd3\x67\x4c\xe2\xd0\x96\x62\xaa\x68\xc8\x94\x03\x99\xba\x8b\x40\xa6\xfe\x83\xc0\x7c\xcb\x21\xd3\x7e\xea\x99\xf4\x8a\x87\xf3\x43\xa6\xc3\xf7\xed\x96\xc2\xc2\x68\xc8\xa4\x21\x93\x86\x4c\x1b\x0f\x99\xf8\x08\x31\x6c\xf5\xfa\xfe\x60\x80\x19\xef\x05\x76\xb3\x26\xdc\xb4\x0c\xa9\xa6\x80\xa7\x9a\xa0\xd1\xb7\x80\x35\xe0\x7c\xc2\x1a\x8d\x8c\x34\x32\xd2\xc8\x68\xc5\xc8\xc8\xe8\x2c\x00\x8d\x8e\xda\x2d\x85\xfd\xd0\xd0\x48\x43\x23\x0d\x8d\x36\x1e\x1a\xdd\x23\x7b\x25\xb8\x68\x61\x3a\x2f\x1c\x14\x5d\x12\x7e\xbb\xf3\x05\x0d\xb1\xc6\x45\x1a\x17\x69\x5c\xb4\x2e\x5c\xb4\xbf\x00\x2e\x3a\x6e\xb7\x14\x26\x44\xe3\x22\x8d\x8b\x34\x2e\xda\x7c\x5c\x44\xd9\x6d\xcf\xc1\x4e\xbd\xa0\x68\x21\x22\xd5\x20\xa2\x46\x2e\x9e\x61\x87\xb2\x07\x20\xa7\x15\x18\x50\x06\x30\x32\x47\xe0\x5b\x66\x5f\x88\x06\x45\x1a\x14\x69\x50\x54\x29\x28\x52\xed\x3c\x32\x8e\xe6\x07\x45\xef\x3b\x1a\x14\x69\x50\xa4\x41\xd1\xcb\x04\x45\x78\x30\x90\xfb\x81\xee\x70\x2f\x70\x3a\x3d\x4e\xfe\xc6\xb5\x02\xa4\xe5\x09\x6e\xfd\x86\x24\x99\x60\x02\x17\x52\x5c\x01\xae\xd2\x48\x6a\x85\x48\x2a\x73\x7c\x20\x17\x48\x85\x48\x68\x51\x20\x15\xe2\xb0\x5c\x7e\x94\x05\x52\xa5\xf0\x92\x4b\x5d\xac\xe1\x52\x06\x2e\x75\x8d\xf9\xe1\xd2\x51\xf2\x04\x43\x64\x61\x34\x5c\xd2\x70\x49\xc3\xa5\xc5\xe0\x52\xe1\x4c\x5f\x25\x5a\x42\xbe\xa0\x77\xc8\xf4\x7d\xa7\x26\x74\x34\x3f\x81\xa6\xa0\xa1\x65\xb1\x4e\xa7\x6d\xe4\xc0\x9d\xb3\x88\x27\x2f\x0a\xe9\xb4\x97\x25\xf8\x5b\x01\xbd\x4e\xc5\xc4\xfe\xf7\xe7\x6f\x05\xd4\x8c\x14\xb5\x5c\x91\x2f\x08\xe2\xa8\x6d\x23\x8f\x63\x35\x3c\x2b\xf4\xf4\x29\x3d\x0d\x3c\x7d\x57\xb5\x5c\x94\x06\x2c\x0f\x29\x97\xa5\x4a\x8c\x24\xda\x89\x1d\xe6\xbc\x51\xeb\xf1\xcc\x33\x04\x8c\xde\xcf\x66\x06\xb2\x09\xe2\x17\x53\xd0\x99\x1a\xf2\x35\x15\xc8\x06\x52\x96\xaf\xfa\x03\xa3\xdf\xe9\xc0\xdc\x21\xf4\x27\xc7\x61\xb3\xfc\x94\x67\x86\x3f\x61\x77\x28\x46\x99\xdd\x45\xc1\xb1\x56\x9c\xf7\xda\x9c\x89\xbd\x64\xcb\x32\x2c\x4f\x23\xb3\x62\xd1\xa6\x53\x59\x52\xb4\x46\xb7\xa4\x68\xf7\x72\xf9\x12\x88\xb6\x9b\x40\x83\x61\xa4\x97\xed\x06\xb2\xc9\xd0\x3d\xe3\xd7\xf9\xa7\xc7\xd1\xdd\x50\x5d\x30\x55\x7e\x05\x2b\xc3\x43\xe7\x7f\x60\x46\x73\x8a\x1d\x34\x56\xb7\xea\x10\x57\x5d\x10\x73\xa4\xe9\x12\x99\x8b\x3f\x99\xf4\x54\x51\x74\x89\xb9\xa9\x7e\x51\x48\x5d\xcb\xe9\x60\x30\xcf\x67\x62\x74\x99\x3a\x99\x56\xca\x14\xdc\x13\x4b\x64\xb0\x7a\x1e\x64\xce\x80\xc2\x0c\x6c\x82\x1e\x66\xf2\x58\x34\x1a\xaa\xf3\xe1\x9e\xc4\x9b\x0c\x59\xc4\x97\x3d\x4a\x62\x78\x6f\x8a\x45\xb3\xaf\x31\xec\x5a\x98\x61\xe9\x12\xe1\xc0\xa6\x29\x0f\x34\xf1\xba\xbf\xdd\x61\xc6\x88\x55\x06\xb0\x07\x93\x5b\xb6\x15\x4c\xe4\x44\x63\xcf\xc1\x66\x7c\x7a\x67\x6a\x84\xf3\xa8\x53\xd2\x20\x07\x98\x22\x6f\xb6\x73\x81\xcc\x5b\xe5\xb0\xb9\xc0\x9e\x87\xad\x4f\x13\xc8\x98\x2d\x9f\x1b\x76\x71\xdf\x51\xa0\x22\x81\x44\x0f\xc9\x64\x11\x11\x0f\x3d\x93\xfa\xae\x48\xa2\x22\x0b\x09\x09\x8c\x3e\xfc\xf3\x03\xbe\xb6\x8a\x60\x52\xa6\x66\x02\x98\x4d\x71\xd9\x0a\xa9\x07\xa8\x0d\xf4\x1f\xc0\x1b\x49\x06\x2f\x0e\xe0\xc2\xeb\x29\x32\x22\xa8\x39\xd7\xf5\xf8\x18\xf4\xfb\xe9\x09\x96\x07\x82\x6d\xad\x0a\xb9\xaa\xb0\x69\xf2\xcf\xb1\x4f\x0e\x16\x8c\x48\x7f\x01\xbd\x61\x81\x66\x5c\x64\xcb\xa4\x45\x99\x23\x4b\x90\x88\x1d\x52\xce\x40\xf2\xeb\x6a\x12\x3e\x67\x22\x7f\x59\xf6\x15\x0f\xc3\x9b\x66\x14\x2f\x7e\x1b\x91\x81\x32\x67\x10\xc1\xb8\x2f\x94\x8b\x21\xc3\xdf\xfe\xeb\x53\xc1\xa9\xd0\xe8\xea\x93\xa4\x9a\xc3\xc9\x59\x92\x42\x4f\x9c\x86\x2b\x81\x2f\xed\x4d\x31\x22\x71\x2d\x72\x47\x2c\x1f\xd9\x30\xd7\xaf\x4e\xeb\x06\xb7\xb0\x24\x3b\x36\x46\x63\xa2\xc0\x53\x7d\xdf\xbc\x9d\xd8\xeb\xf4\xb8\x63\xb7\x6d\x48\xde\x25\x5a\x7b\x4e\x02\x29\xde\xca\x47\x1c\x11\x38\xf8\x7e\x93\x3b\x84\x07\x34\x2e\xe3\x2f\xf3\xe0\x64\x6a\x06\x65\x2f\xa2\x90\x1f\x18\xdc\x8c\xa4\xec\xbd\x2c\xa4\xc3\x73\xc4\x33\x19\xb8\x18\xfe\x52\xbe\x36\x41\x60\x50\xe1\x99\x63\x0c\x49\x14\x3d\xb5\x8b\xc7\xb8\xc6\x61\x28\x8b\x72\x96\xb0\x8a\x66\xeb\x83\x5a\xeb\x02\xf8\xac\x36\x5e\x93\xb2\x4f\xf8\x2e\x1a\x59\x4b\x45\x28\xc6\xbb\xf2\x41\xd2\x67\x34\x26\x8e\xef\x00\x93\x3a\xce\x74\xf6\xea\x88\x29\x1e\x31\x19\xdd\xf9\x43\xa6\xbd\xfd\xf5\x86\x4c\x3a\x26\x7a\xe9\x31\xd1\xf3\xbc\x55\x7b\xdd\x97\x1d\x21\xa9\xf3\xc6\x32\x42\x92\x76\xa4\x8f\x38\xee\xb9\xbe\xd3\x47\xe6\x2d\x76\x2d\xbe\x20\x3c\x4d\x60\xe1\x49\x7c\x12\xbe\x2b\x2f\x18\x01\x3f\xdc\x15\xf5\xe2\xc0\x49\x93\x87\xed\x7c\xbf\x58\x08\x90\xeb\xc3\xc0\x8f\x8f\x61\xe7\xea\x88\x82\x8a\x97\x09\x0a\xaf\x72\x99\x83\xcf\x09\x69\x17\x08\xb8\x6a\xba\x1b\x19\xf3\x94\xb2\x3f\x91\xdc\xcf\x53\x72\xcf\x35\x30\x6b\x0c\x65\xce\x64\x48\x8b\x75\x18\xa3\xc3\x18\x75\x18\xa3\x2c\x8a\x71\x24\x51\xf4\xd4\x2e\x1e\xa4\x8e\x63\xe6\x8f\x63\xd6\xb5\xf2\x65\x18\xad\x1c\xc1\x86\x4b\x5f\x87\xf3\x2d\x7d\x5d\xfb\x9e\x8d\x2b\x5c\xf5\x6a\x42\x60\x56\x63\xfc\xa5\x14\xc9\x8c\xd5\xc8\xbd\x43\x1d\x7f\xe9\xf8\x6b\xa1\xf8\xab\x99\x01\x8f\x5c\x12\x62\x48\x28\x22\x0c\xe1\x7b\xbd\x01\x16\xe6\x08\x5b\x15\x22\x5f\x49\x8f\xd4\x47\x70\x39\xc8\x5b\x1f\xaa\xbd\x9a\x30\xb2\x00\xc9\x2e\xb1\x8e\x93\xcf\x4e\x86\x85\xcf\xdc\xea\x04\x38\x53\x7e\x4b\x13\x6c\xaa\x00\xbf\x86\x03\x2b\x90\x60\x3a\x16\xa9\x44\x82\xc4\xe5\x98\x89\x15\x4a\x70\x69\x82\x4d\x95\xe0\x2f\xe1\xc0\x0a\x24\x78\x51\x87\x04\x7d\xcf\x42\xab\x14\xe0\xb2\xf4\x9a\x2a\xbf\xff\x9e\x8c\xab\x40\x7c\x97\x75\x88\xcf\xc2\x36\x5e\xa5\xf8\x96\xa5\xd7\x54\xf1\x5d\x4e\xc6\x55\x20\xbe\x9f\x53\xe2\x8b\x7d\x6b\x4c\x2e\x47\x19\x62\xe9\xfc\xcd\x4a\xf2\x37\x6b\x4c\x6d\xe8\x95\xe6\x46\xad\x34\x37\x21\x31\x11\xcb\x26\x76\x57\x95\xb1\x30\xba\x0b\xa4\x2c\x92\xe9\xa8\xd0\x2e\xeb\x94\x85\x4e\x59\xbc\xcc\x94\xc5\xb6\x85\xbc\x6b\x5a\xa6\xfb\x4a\xef\x39\x98\x0e\x5d\x2e\x61\xff\xe5\xe7\x74\x30\xc2\x77\x67\x65\xf7\x23\xb6\xab\xd0\x83\x8a\x53\x57\x0d\xcf\x5c\xad\x53\x0b\xc2\x91\x97\x53\x82\xf3\xb2\x4a\x10\xfb\xd6\x18\xf4\xff\x15\x23\x0b\x04\x21\x00\x38\x0b\xf7\x29\xeb\x50\x60\xbd\xa1\x00\xf5\xf8\x2a\x11\xb4\x0e\x04\x74\x20\xd0\x80\x40\x20\x6d\x2b\xe4\xd2\x65\x37\xbe\xd7\x2e\xc9\x98\x49\x1c\x70\xa0\xe3\x00\x1d\x07\x6c\x4d\x1c\xb0\xf4\x3a\x86\xe1\x6c\xfb\x8a\x49\x80\xf1\xa7\xa3\x2b\x84\x77\xf1\x13\x49\x59\x0e\x15\xc0\xc1\xd2\x67\x94\xda\x55\xe8\x84\x5e\x8a\xa9\x66\x29\x26\x50\x8c\x70\x70\xf5\xe8\xc5\xe5\x4a\xf5\x62\xd9\x35\x97\x79\xf5\x62\x59\x7a\x8d\xd6\x8b\x70\x70\xf5\xe8\xc5\xcf\x65\xf5\x22\xf6\xad\x31\xe1\x63\x10\x39\x72\x70\x31\x92\x77\x39\x59\xc0\xc3\x0c\xa8\xf9\xaf\xe3\x48\x1d\x47\xea\x38\xb2\xce\x38\x72\x5d\x5b\x7e\xbb\x47\xad\x1c\xc1\x86\x5b\x7e\x8f\xe6\xdc\xf2\xcb\x90\xcb\x91\xf2\x54\xc1\x86\x6f\xfc\x6d\x4c\x58\x7d\x1c\x7b\x96\x64\x4c\x20\xb1\xae\xde\x11\xac\x77\x04\x6f\xc7\x8e\xe0\x31\x32\x45\x4f\x9e\xe7\x26\xa2\x2a\xa8\x5c\xbc\x23\xb8\x0a\x82\x4d\xc5\xca\x17\xc1\xb0\xe6\xc3\xc6\x31\x7e\x14\x60\xe4\xd5\xad\xb3\x05\xfd\x61\xd4\xb6\xe5\x51\xd4\x95\x44\x4f\xd5\x50\x6c\x6e\xfc\x34\x61\x65\x1d\x5a\xb1\xd1\x0b\x6f\x05\x30\x47\x47\x4a\x2f\x3b\x52\x52\x16\xc5\x86\x9c\x28\x7a\x6a\x97\x1d\x85\x5e\x72\xd3\x4b\x6e\x73\x2d\xb9\x19\xdd\x05\x82\x83\xe4\x2f\xc1\x84\xf6\x5e\x07\x07\x3a\x38\xd8\xd4\xe0\x40\x7d\x71\x45\xe2\xe2\x3e\x59\x2c\xc6\x3d\xcb\x67\x48\xa6\x25\x92\x20\xad\x24\x0a\x7c\xbe\xb2\x71\x7a\x55\x60\x4d\x54\x2b\xbb\x21\xb2\x3e\x50\x58\x70\x09\x64\x16\x14\xe6\xf1\xa3\x00\x18\x96\x0e\x17\x62\xdf\x1a\x03\x0c\x2f\xc3\xf1\x01\x3a\x00\x1a\x24\x36\x07\x24\xf2\x55\x42\xab\x9a\x92\xe9\xab\x1c\x82\xb2\x68\x13\xd0\xe1\xba\x12\xe9\x7b\xef\x5b\x39\x42\x9d\x24\xd2\x8f\x3a\x73\x26\xd2\xb1\xe3\x81\x2b\x62\xe3\x97\x9b\x46\xef\xa8\xa0\xb2\x51\x5e\x5e\xa5\xa1\xb2\x52\x5e\x33\x16\x3e\xf6\x35\x52\x5e\x19\x52\x36\xb6\x1e\x29\x67\xd8\xb3\x42\xa0\x1c\xe5\xed\x04\x76\xbc\xde\x40\x9a\x9c\x85\x13\x99\x19\xd0\x1c\xbe\x1b\xa6\xd6\x57\xd3\x89\x17\x7c\x8d\x60\xec\x5b\x63\x20\xef\xaf\xbe\xd3\xc7\x2c\x00\xbc\x79\x3e\x4b\xc3\xdd\x95\xc0\xdd\x3c\xdf\xba\xee\x54\xa3\xde\x5c\xb2\xde\xcd\x25\x1b\x98\x31\xad\x03\x06\x1a\xdd\x45\x70\xe0\xb1\xc6\x81\x1a\x07\x2e\x84\x03\x8b\x0d\x59\x74\xc1\x35\x71\x4d\x86\x11\xc7\xe0\xa7\x44\x8b\xf1\xc9\x99\x15\x49\x8c\xa5\xf3\x98\x9e\xe6\xa7\x6d\x93\x40\x50\xf1\x0b\xd1\x73\x00\xc1\x8a\xd0\xe8\x72\x9d\xa8\x10\x8d\xae\xe9\x50\x6d\xad\x37\x5e\xab\xb7\x55\x54\x2b\xfc\x20\x67\x4f\x6a\xa3\x24\x25\xbc\xac\x4c\x95\x33\xbc\x3e\x91\x46\x36\xa7\x9c\x6c\x37\xe1\x56\x6b\xf9\xcb\xbd\x3a\x08\x69\x54\x10\xd2\x2d\x98\x14\xc1\x94\x5b\x25\x7a\xd7\x3b\xdc\xb7\x73\x87\xfb\xfe\x61\x2b\x47\xb0\x61\x62\xbe\x3b\x5f\x62\xfe\x82\xba\x03\x9b\x98\x82\x83\x7f\x81\x4f\x34\xbd\x37\xee\x25\xe5\xe7\x93\x8c\xc1\x16\x11\x39\xd1\x0d\xc4\x8c\x51\xa6\x24\x9a\x1b\xce\x65\xc7\x5b\x4d\x90\xa7\x54\x81\x19\x6b\x33\x7b\x3a\xc4\x5b\x55\x88\xd7\x5d\x28\xc4\x0b\x7f\x7b\x03\x5b\x8d\x8c\xf3\x9a\x15\x62\x45\x71\xa5\x39\x35\x54\x89\x01\xc8\x3f\xd0\xe2\xd7\xa1\x99\xf2\xd4\x66\xa0\xe4\x7e\x6b\x0b\x23\xcb\x96\x36\xb0\x0a\x94\x3e\x7b\xfb\xfd\xf2\xe4\x96\xdb\x68\x3d\x64\xd4\xf7\xce\x1f\x32\x32\x50\xc9\x21\x74\x26\x0c\x39\x59\x99\x4d\xff\x8b\x81\x7a\x45\xf9\x4d\x5b\xf1\x30\xf2\x2f\x12\x14\xc7\xe1\xa6\x62\x46\x2e\xd1\xb1\xec\x0f\xd7\x97\xe9\xd3\x80\xa8\xde\x7a\x6a\xcd\x68\xa3\xc6\x38\xe7\x72\xaa\x31\xd9\xd6\x1c\x8c\xb8\xcf\xb0\x33\x49\xbd\x41\x6f\xf2\xfb\x9e\xfc\x2f\x05\x61\x8f\xda\xc4\x94\x62\x87\x16\x1e\x20\xdf\x16\x05\xa1\x92\x62\xf7\x12\xc3\xdc\xb7\xc5\x55\x39\x1d\xe3\xd8\xc6\xa6\x50\x4a\x46\x25\x2b\x95\x74\x67\xcb\x17\xc4\xad\x83\xb2\x86\x52\xd0\x09\x51\x63\xdb\x52\x69\xc8\x53\x7b\x91\x5e\xce\x22\xe7\x60\xe4\xae\x8e\x9a\x45\x06\x03\xcc\xb0\x6b\x2a\x66\x58\x56\x9f\x01\xb8\x69\xcd\x60\x5e\xb4\x81\x2d\x53\x20\xd0\x50\x2d\x26\xd5\x20\xe0\x2d\x0e\xb4\x70\x44\x79\x56\x05\xe5\x07\x52\x0f\x33\x34\x49\x02\xc0\x0f\xff\xa8\xeb\x04\xde\x5c\x36\xb3\xfb\x7f\xaf\x65\x4b\xaf\x77\x61\x6b\xd6\x18\x6f\x5a\x05\x5c\xcf\x77\x41\x91\xc1\xae\xcf\x05\x45\x7a\xbc\x1a\x17\xb4\x3c\x39\xed\x82\xb6\xcb\x05\x45\xf1\xe2\xca\x5c\xd0\xf9\x86\xb8\xa0\x67\xeb\xa0\xac\xa1\x14\xb4\x76\x41\xda\x05\x05\x2e\xa8\x95\x33\xd6\x75\xa6\xa0\xa3\xa9\xbe\xab\xc6\x9d\xf9\x39\x68\x87\x7f\xc5\x9c\xda\x7e\xb0\x35\x5e\x11\xfe\x55\x91\xa6\x36\x7d\xc7\xb7\x91\xfc\xe9\x47\xd8\xca\x51\xdf\x2d\x4a\x53\xaf\xed\xfc\x60\x4a\x48\xc9\xf1\x26\x8a\x9e\xda\x65\x87\xa0\xb7\xc2\xbc\x8c\xad\x30\x9d\xea\x32\xae\xdd\x95\x65\x5c\x8d\xee\x02\x29\xd7\xc3\x84\xd2\x85\xa0\x49\xe7\x5c\x75\xce\x55\xe7\x5c\x83\x9c\xab\x72\x6f\x4a\x80\x28\x7a\x26\xf5\xdd\x8a\xee\x3b\x91\xbb\x52\xa4\x8f\x6e\x83\xb0\x85\x82\xe3\x8d\x55\x10\x3f\x70\xd4\x54\x61\x3b\xdf\xb7\xe9\x70\xf8\x45\x85\xc3\x8f\x8f\x52\xf4\x4f\x4f\x60\xa7\x78\xef\x89\x4e\xcf\xea\xf4\xac\x4e\xcf\x2e\x96\x9e\x6d\xe5\x8c\xb5\x19\xa7\x44\x3e\xe9\xb8\xb8\xc1\x71\x71\x5e\x34\xb2\xee\x88\x53\x6f\xdf\xda\xce\xed\x5b\x07\x07\xad\x1c\xc1\x86\xdb\xb7\xf6\xe7\xdb\xbe\x75\xee\xcb\xc4\xaa\xdc\xbc\x75\x1e\x20\x5a\xf0\x5b\x60\x93\x15\x77\x34\xbc\xd4\x8d\x5c\xb9\x09\x82\xe5\x53\x01\x4a\x01\xce\xd8\x7f\x77\xd0\xd1\x99\x80\x66\x67\x02\x36\xfd\x80\xcd\xee\xff\x30\x22\x30\xf8\x69\x37\xd1\x60\xc2\x8c\x96\xbd\x59\x66\xdd\x89\x86\xd9\xeb\xe1\x7d\xfb\xb6\xc7\x30\xb2\x82\xb8\xbd\xba\x2c\x41\xf8\xee\xcc\xfb\x30\xab\x21\x5f\xe1\x99\x99\xfa\x22\xd9\xe0\xc7\xd7\x6a\x3d\x1b\x53\x2c\xe5\x7b\xa9\xd5\x6b\x14\x73\x05\xf4\x37\x42\xce\x13\xeb\xf1\x72\x0e\xca\x3c\x03\x1e\x70\x4f\xc4\x28\xc4\x41\x25\x03\x32\x7d\x58\xa6\xae\xc3\x32\x9d\x02\xcd\x67\x45\x4b\x94\x10\x56\x1b\xa2\x54\x19\x69\x95\x1c\xdf\xbd\xc7\x1b\x1b\x4b\xc6\xbe\xad\x39\x04\xdb\x9a\xd3\x22\x6b\x5e\xbb\x9c\x15\xb1\x24\xde\x09\xdd\xc5\x0a\x03\x16\x79\x0a\xf8\x0f\xcc\x68\x65\xf1\x0c\x23\xc3\x91\xf8\x96\x7b\xb6\x38\x3e\x35\xb6\x22\xde\xd1\x2b\x9f\x0b\xac\x7c\x4e\xf3\x2a\x67\xb6\x4d\x4d\x94\x66\xde\x02\x2b\xa0\x09\xe0\xd9\x1f\x06\x68\x93\xf5\xfa\x13\x32\x3d\x24\xc9\x24\x71\x67\x29\x98\x3b\x39\x63\x42\x2a\x6c\xfc\xc0\xb9\xd1\xeb\x97\xdb\xb3\x7e\x59\xa0\xdf\x5b\xbe\x64\x99\x98\x3d\x2a\x51\xe7\x08\x5b\x2f\x5b\xea\x65\xcb\x60\xd9\xb2\xdd\x2a\xea\xea\xda\x5c\x8d\xfc\x01\x14\xec\x5a\xbd\x01\x7f\x70\x17\x74\x39\x33\x3d\xce\x42\x34\xb4\xe7\xd9\x2a\xcf\x73\x25\xf5\x0f\x98\xc8\xb6\xb9\xcc\xd5\x21\x10\x2a\xcd\xca\x1c\xd1\xa6\x9c\x2b\xd1\x8e\x48\x3b\xa2\x17\xec\x88\xea\x75\x41\xda\xf9\x68\xe7\xa3\x70\x3e\x72\x11\x44\x60\x17\x58\x84\x61\x53\xd8\x0f\xeb\xf1\x40\x17\xda\x03\x69\x0f\xa4\x3d\xd0\xda\x3c\x90\x69\x63\xe4\xd6\x95\x75\x9b\xab\xf1\x4d\x74\x3f\xff\x66\xef\x6a\x9e\x1a\xb7\xa1\xf8\x3d\x7f\x85\x46\xd3\x03\x74\x0d\x8b\x03\x09\xa5\x37\x0a\x74\x76\x2f\xbb\xdd\xd2\xee\xa5\x30\x19\xc7\x16\x58\x8b\xbf\xd6\xb2\x97\x0d\x2d\xff\x7b\x47\x8e\x3f\x65\x49\x51\x62\x27\x36\xac\x67\x72\x88\x2d\x5b\x4f\xef\xe9\x27\xbd\x0f\xe9\xc9\xdc\xa1\x30\xa8\x9f\x75\xd4\xcf\x7c\x01\x22\x1b\x25\x9e\x0f\x3d\x09\xc1\xb3\xc0\x12\x44\x3b\xd3\x40\x97\x83\x06\x1a\x34\xd0\xa0\x81\xba\xd3\x40\x36\x32\x1f\x92\xe5\xb4\x75\x34\x85\x8a\xff\xb3\x59\xcd\x83\x0e\xfa\xe1\x74\x90\x15\x87\xd8\xbb\x07\x05\x5e\xc8\xce\xb4\xcf\xd5\xa0\x7d\x06\xed\xf3\x23\x68\x9f\x91\x80\xd7\x2e\xf7\x4d\xa6\x9a\x4e\x71\xa3\xe4\x90\xb9\xb6\xeb\xcc\xb5\x0e\xb3\xba\x98\x6e\xaa\x72\x5c\x29\x7a\xd6\x7a\xcb\x04\xb7\x68\x48\x4d\x13\xa7\xa6\x4d\x4f\x46\x82\x8e\x5d\xa6\xa6\x9d\x8e\xd7\x4b\x4d\xfb\x18\xd9\xf5\xf9\xe5\xf5\x64\xa1\x31\x35\x23\x62\x86\x38\x48\xa7\x47\x48\x37\xf3\x5a\xc0\xf7\x40\x2d\xd5\x20\xcf\x84\x07\xdf\x30\x7a\x84\x6a\xfb\x45\x9b\xef\x0c\xe5\x76\xf8\x8a\x5c\xc4\x71\xa5\xf6\xd4\x80\xdc\xe1\xce\xd0\x21\x95\xed\xc5\xa6\xb2\xf5\x6b\x57\xe7\xea\x04\xa4\x7c\x54\x2e\xff\xa5\x5e\x74\x80\x99\x00\xee\x1a\xb9\x40\xb9\xb3\x76\xbb\xbf\x32\xfd\xa8\x7d\xea\xcd\x4e\x65\xdd\x9e\xd3\xf9\x07\xf6\x3c\x64\x81\xd4\xa1\x52\xf9\xe2\xfe\x6a\x21\x49\x1c\xcc\x73\x28\xf4\x2c\x2a\xf7\x9f\xb5\xb6\x01\x94\x1d\x06\xda\x09\x7e\x1a\x13\xef\x2b\x7c\xa4\xe7\x9f\xb7\x8a\x9c\xdf\x3a\x43\x4e\x67\xa8\x79\x95\x88\xa1\xa7\xb3\x00\x4a\xdb\x8f\xa3\xed\xa3\xe6\xa2\x33\xd4\x10\xcf\x08\x88\xed\x47\x9d\x20\xa7\x31\xf1\xbe\xa2\xe7\xa3\x63\x81\x8c\xb9\xed\xa3\xe7\xb2\x33\xf4\x24\x5f\x42\x4a\x7a\xa3\x13\xfc\xb4\x40\xbe\xaf\x08\xba\x0c\x7d\x6a\xc3\x82\x82\x45\x02\xb6\x0e\xa4\x2b\x55\x20\x95\xae\x7a\x13\x97\xbc\x30\x3c\x13\x39\xc8\x02\x9f\xe2\x7a\x6f\x0d\x99\xdc\xfd\xca\xe4\xf6\x7b\x9c\xe9\xac\xc9\x39\xec\x69\x68\xb2\xce\x47\xe9\xaa\xe3\xc8\xe4\x90\xb1\xbd\x9b\x8c\xed\x55\x81\x39\xfd\x64\x08\xcc\xf5\x2a\x30\xe7\x62\xef\x3a\x30\x28\xef\x27\xf2\x80\x9d\x4a\x2e\x76\xad\x39\xd5\xa0\x9d\xde\x4e\xd0\x2e\x44\x01\x32\xb8\x9a\xf8\x45\x85\xf3\x6a\xf6\xd2\xdc\x79\x20\x33\x1b\x6f\xec\x8b\x30\x07\x07\xbd\xbd\xf1\xf6\x76\x41\xe7\x4d\xfa\x9d\x26\x3e\x1d\x7a\x04\x55\x3b\x84\xfa\x68\x23\x5f\x18\xa6\x8d\x80\x8d\x23\x10\x1a\xaf\xea\x68\xa2\x25\x63\xef\x70\x04\xfe\xa4\x27\x14\x29\x5a\xb2\xc3\x52\x7b\x77\x4b\xed\xe9\x44\x1c\x7b\xb8\x7d\x83\x10\xea\x70\xa7\x66\x6d\xca\x8b\x98\x8f\xb6\x4f\x5a\xe2\x16\xbd\x84\x15\xf7\x1e\xda\xb5\x3b\x5e\x0a\x3e\x3d\x2e\xdd\xab\xf2\x9f\x58\x9c\xe3\x71\xb7\x16\x27\x3d\x24\xe8\xca\x0d\xa2\xc5\xae\x0e\x11\x2a\x0d\xbf\xd7\x68\xb0\x0e\x2b\xc9\xcd\x56\x92\xf9\x7b\xaf\x8b\x3d\xb4\x33\x9a\xdc\xce\x39\x4c\x52\x29\x7e\x2a\xdb\xde\xdd\x84\x44\xa3\x0d\xde\xb6\xf0\x40\xad\xad\xda\x86\xbf\x63\x07\x11\x70\xbd\xf0\x4c\x3b\xf4\x3d\xfc\x44\xed\x28\x0f\x44\x3e\xb0\x30\x79\x50\x09\xa4\x4a\x25\x27\xb1\x2f\xdb\x5e\x3a\x5e\xd9\x9d\xa2\xf3\x47\xdb\x84\xcc\xfa\x34\x5e\x22\x66\xb2\x9d\xed\x4b\xec\x34\xc4\x4a\x21\x32\x09\x58\x94\x57\x8b\x4b\x57\xfd\xf1\x52\x72\x66\x01\x95\x02\x51\x74\x53\x86\x80\x7b\xab\x01\xf7\x57\x16\x53\x77\x77\xca\x03\xb7\x68\xf0\x3c\xfa\xe6\x79\xe8\xe3\x0d\x5c\x8f\x5f\x4e\x07\xd7\x63\x70\x3d\x06\xd7\x23\x73\x3d\xb8\x5f\x33\xcc\xc3\xc5\x04\x3f\x31\xb6\x9d\x7a\xa4\xb8\x6c\x50\x32\xe1\x69\xe1\x67\x14\xdb\x21\xdc\xe2\xc1\xf9\x1d\xd9\x9c\x97\xa9\x1c\x00\xed\x00\x79\x08\xbb\x03\x1f\xa5\x74\xd5\x1b\xb3\xb3\x22\xb1\xc1\xe6\xec\xd6\xe6\xb4\x90\x39\x5f\x44\xa8\x37\x46\xdb\x60\x79\xb6\x66\x79\x8e\x18\x72\x74\xfa\xa0\x83\x9e\x76\xfb\xf1\x51\x21\x2c\x48\x4c\x1b\xb9\xc6\x67\x14\x92\x65\x12\x93\x3e\x2d\x8a\xa2\x45\x12\xd0\x85\x96\x11\x16\x9e\x74\x3d\x19\x17\x92\x85\x67\x21\x82\xcb\x5d\x00\xb1\x77\x17\x1a\xb0\xd6\x8c\x08\xb9\x01\x5d\x82\xf2\xee\x2b\x6c\x43\x07\x13\x36\xa5\xfb\x5f\x91\xbd\x56\x2d\xa0\x2d\x42\xdf\xe9\xcb\x79\x3b\x0e\xac\x39\xd4\xd8\x87\xf2\x84\xde\xf2\x53\x32\xb4\x65\x81\x0c\xd6\x7c\x15\xc3\x2a\x1b\xd9\xf0\x8b\xcf\x36\x00\xfa\x49\x96\x58\x7d\x78\xd6\x51\x9d\xa7\xb8\x0b\xe6\x3c\x65\x96\x95\x98\x2e\xa3\xa6\xd2\x55\x69\x0d\x5f\x63\x14\x2e\xe4\x94\x20\x79\xc0\xc1\xdf\xa1\x43\xe3\x75\x02\xd4\x66\xb3\xab\xe9\x7b\x24\x32\xbc\x08\x8e\x04\x62\xaf\x0a\x03\x1a\x8e\xf3\x39\x63\xe1\xf0\x67\xa8\xad\x0d\x09\x77\x11\x84\xfe\x17\x7a\x5e\x80\x36\x12\xc9\xa6\x78\x46\x0a\x07\x25\x47\x8a\x3e\x87\xee\xb0\x87\xb3\x9c\xc0\x04\x2d\xb3\x04\x7c\x64\x2f\x0e\x52\x63\xa9\x24\xcc\x1b\xf8\xac\x81\xdc\x2e\xda\x87\x7c\x10\xb2\x0a\x0e\x7b\xa6\x13\x5b\xe8\xdc\x11\x19\xf8\x19\x4a\xe1\x87\xac\x6a\xb6\x66\x37\x76\x22\x2c\x78\x3b\x03\xb2\x27\x7a\xb9\x04\x67\x21\x62\x9a\xb2\x5e\x4c\x59\xcc\x14\x0d\x43\x74\x8f\xbe\x73\xd6\x32\x15\x90\x98\x1a\x0c\x6c\x8d\x91\x71\x9f\x00\x8d\x7c\xca\x5a\xcf\x56\x9d\x4d\x7b\x2c\xbb\xf4\xbe\xf8\xa5\x14\xf7\x4b\x99\x30\x85\x31\x41\x7f\x2d\x2b\x65\x75\x86\xea\x90\xd8\xcb\x84\xf9\x1f\x31\xdc\xc0\x41\xd6\x7c\x7f\x9d\x31\xc2\xe7\xa8\x34\x7a\xce\x1d\x47\x32\x6e\x7e\x9a\xcd\x0c\xc7\x91\x0f\x1a\x15\xf8\x72\xcc\x9d\x1c\xbd\x99\xbd\xb8\x09\x78\xad\xb9\x04\xb5\x95\x82\x55\x93\x30\x87\xca\x4a\x39\xad\x92\x54\x4d\x56\x4d\x1b\x91\x41\x40\xda\x92\xfc\xa1\x66\x6d\x51\xd4\x4a\xf2\xa6\x64\x0f\x6d\xa8\x8f\x52\x4e\x34\x01\xb1\x75\xb4\x52\x4c\x22\xdf\x85\x23\x81\x38\xaa\xa2\x80\x46\x1c\xf9\x7c\xd4\xd2\x92\x99\xe9\xc7\xc9\x70\x1b\x1f\xb1\xa0\x4f\x8a\xd3\xf0\xab\x4e\xd6\x19\xa8\xa9\x58\x69\x05\xcc\x6b\x65\x81\x52\x94\x51\x12\x99\x23\x9b\xff\x81\x23\x49\x57\x8b\x86\x68\x3e\x04\xdf\x67\xb5\x08\x86\x19\x16\x94\x6f\x3a\xd8\x56\x60\x8b\x2b\x84\x0d\xc4\x50\x13\x44\xd3\xf1\xa7\x13\x69\xb3\xf4\x3a\xd0\xdb\xa5\x3f\x91\xd3\x9f\x6c\x9b\xbe\xee\xca\xf9\x77\xb7\x4c\x7f\x22\xa7\x3f\xd9\x36\x7d\xdd\x86\x9c\xd2\x82\x7f\x7b\xcb\xf4\xa7\x72\xfa\xd3\x6d\xd3\xd7\x2d\x29\x7d\xdd\xda\x70\xa2\xd7\x89\x36\x21\x9a\xee\x6a\x13\x57\xd3\x6d\x6d\x6a\x6b\xba\x25\x36\x15\xc7\x1b\x6b\x01\xde\x34\x51\x11\x91\xd8\x10\xe3\xb8\x82\x0a\xd3\xf9\xd9\xe1\xe4\x50\x3f\xa9\x09\xad\x10\x59\xfa\xc0\x48\xd2\x69\x1b\x7a\x25\x90\xaf\x03\xc6\x32\x33\x8d\x27\x3b\x89\x2f\xac\x62\xa1\x7d\x4b\xc3\x0e\x62\xcd\xa1\xe6\x5c\xa4\x21\x55\x6c\x6a\x69\x8d\xfb\xea\x00\xc9\x7d\x89\xb7\x60\xef\x9f\xa3\x83\xb3\x9b\x9b\xc3\xdb\x37\xfb\x6f\xe1\xda\x38\x4a\x3d\x8b\x71\xbf\x3d\x8b\xfc\xff\xed\x88\x01\xd4\x32\x56\x59\xc6\x2b\xbc\x5b\x1e\x44\x06\x3d\xff\xf1\xe0\xb8\x3c\xbd\xc0\xc4\x04\xa2\xf7\x21\xb7\x9a\x00\x9b\x0f\x28\xac\x56\x66\x63\xcb\x42\x9c\xb0\x56\xd6\x37\xb9\x9e\x66\x4d\x06\x56\xb3\x41\xbd\x14\xc0\xa2\xbf\x4a\x44\x8b\xfe\x58\x5d\xc4\xea\x06\xa8\xb3\x37\x8e\x8f\xd8\x27\xaa\xb3\x29\x1c\x33\xd7\xe5\xe9\xac\xd4\x71\x89\x10\x67\x7c\xd3\x67\x75\x2b\x58\xa2\xcc\x94\x0e\x75\xb6\x15\xe3\x13\xf6\x46\x75\x6a\x84\xa7\xcc\xf5\xf1\x51\xb9\xdd\xdc\xbe\x7b\xf2\x93\x45\x2b\x38\x0f\xfd\x47\x52\x3a\x95\xb8\x08\xe2\x5f\xa7\x16\x37\x38\x00\xef\x69\x98\x0f\x1c\x80\xcb\x62\x33\x0c\x8c\x93\x15\x5c\x78\xfe\x74\xf7\xee\xc3\xd9\x99\xf3\x35\x28\x8a\xb2\x01\xff\x2b\x98\x8e\x00\x00\xe0\x79\xf4\xff\x00\x31\x5c\xce\x96\x4f\x5a\x01\x00"),
 		},
 		"/addons/ops/addon-ops-home-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-home-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 45748,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\x5b\x73\xdb\xb8\x15\xc7\xdf\xf3\x29\x50\x6c\xdb\xc9\xb6\xb2\x4d\xca\x97\xd8\x9e\xc9\x83\x93\x34\xdd\xec\xd4\xa9\xbb\xc9\xa6\xd3\xae\x33\x1a\x88\x84\x24\x44\x24\xc0\x00\xa0\x22\x67\xeb\xfd\xec\x1d\x50\x14\x2f\x20\xa8\x4b\x7c\x93\x57\xe7\x25\xb1\x70\xc7\x01\xce\xc1\xcf\x10\xfe\x26\x09\xfb\x40\xa5\x62\x82\x9f\x22\xc6\x35\x1d\x4a\x4a\x74\x74\xb5\x2b\xe4\x70\x6f\xe2\x93\x28\x19\x11\xff\xc9\x98\xf1\xf0\x14\xfd\x5d\x92\x01\xe1\xe4\x15\x51\xa3\xbe\x20\x32\x7c\x12\x53\x4d\x42\xa2\xc9\xe9\x13\x84\x38\x89\xe9\x29\x52\x57\x3c\xa4\x8a\xa9\x1d\xc6\x07\x92\xec\x8c\x44\x4c\x77\xc2\xa2\x3c\x42\x11\xe9\xd3\x48\x99\xf2\x08\x91\x24\x29\x2b\x64\x29\xf3\x0f\xbb\x4c\xec\x2d\xce\xd5\x57\x09\x35\xe3\x1d\x48\xa2\xb4\x4c\x03\x9d\x4a\xfa\x44\x25\x34\x58\x71\x28\xbb\x9f\x94\xe0\x4f\x10\x32\xff\x9d\xa2\xff\x65\xed\xff\x9a\xfd\x8b\x10\x26\x9c\x0b\x4d\x34\x13\x5c\xe1\xd3\x22\x19\x21\x1c\x31\xa5\xf1\x29\xfa\xa5\x48\x41\x95\xdc\xac\x44\x3f\x65\x91\x7e\xc3\xf1\x29\xf2\x3b\xf5\x1c\x63\x27\x25\x52\x19\x50\x7c\x8a\xf0\xce\xce\xdc\x9a\x68\x67\x07\x5b\x45\x29\x27\xfd\xc8\x14\xd3\x32\xa5\x56\xde\x88\x85\x2d\x39\x2c\x10\xfc\xa5\x88\x84\x34\xed\xcb\x61\x9f\x3c\xf5\x3a\xa8\xeb\xfb\x1d\xd4\x3d\x3c\xec\x20\xff\x7b\xbb\x1b\x63\x26\x53\xf6\xac\x9c\x2e\xfa\x33\x3a\x8b\xa8\xd4\xca\x2e\x6b\xec\x6d\xca\x16\x06\xc4\x95\xfc\xeb\xe2\xe7\x8f\xf9\x4f\xd7\xf3\xea\x98\x86\x4c\x3b\x66\x83\x87\x9c\xea\x37\x21\x3e\x45\x3c\x8d\xa2\x32\x55\x92\x64\xf4\x5e\x88\x48\xb3\x04\x9f\x22\xaf\xc8\x60\xa6\xa8\x7f\x5c\x7e\xd6\x54\x66\x43\x36\xc9\x87\x47\xdd\x23\xef\xe0\xd0\xdb\xf7\x8e\xf6\x8b\x12\x11\xe3\x63\x55\x5b\xac\xea\x52\x65\xd6\x32\x13\xa2\x53\x4d\x25\x27\x11\x32\xe5\x6b\xb3\xc6\x9a\x0c\x95\xb5\xda\x08\xe1\xf9\xb6\xb2\x2d\x94\xed\xb2\xaa\x55\x3e\xd6\x1b\xb3\x0d\xa8\xca\xb2\x73\xfb\x15\x35\x70\x42\x38\x8d\x16\x8c\x7e\x28\x59\x78\x21\xea\xbb\x33\xcb\x18\x39\x76\xde\x17\x7c\x8a\xba\x07\x56\xe2\xb4\x6a\xde\x3c\xed\xca\xa4\x55\xd7\xb5\x36\x83\x6c\x09\xba\xf5\x49\x31\x9d\xad\x2c\x7e\x97\x1b\x05\xbd\xa3\x72\x42\x25\x76\x4e\x5d\x8a\x2f\x95\x39\x77\xdc\x33\x0b\x48\x30\xa2\xef\x59\x4c\x45\xaa\xad\xdd\x31\xcb\x37\x3b\xfc\x05\x09\xc6\x43\x29\x52\x6e\x86\x34\x20\x91\xa2\xcd\x32\x1f\x48\x94\xba\x3c\x65\x96\xeb\x58\xd8\xef\xc2\x83\x03\xb2\x4f\xec\x75\xcd\x3c\xa9\xbb\xff\xac\x83\xfc\xee\x49\x07\x1d\x78\x1d\xe4\xed\x1e\x9f\x34\xbc\xe9\xbb\xee\xc9\x49\x70\x70\xd4\xbe\x03\x06\x42\xc6\xc4\xcc\x09\x73\xc1\x69\xdd\x44\x43\x92\x0e\x69\x73\x35\x63\x32\x9d\x4f\xc3\xf7\xec\xd5\x8a\x19\x9f\x67\xda\x59\x6a\x24\xbe\xb8\x2c\x63\x16\x63\x24\xa9\x1a\x89\x28\xfc\x47\x16\x89\x97\x95\x3a\x27\x72\x4c\x33\x6b\x19\x3b\xb6\xee\x8d\x85\xfb\x71\xdf\xb1\x1f\x57\xdd\x8e\xfe\xe2\xed\x78\x50\x4f\xe2\x9a\xca\x09\x89\x5c\xfb\xa6\x19\x0e\x50\x23\x7a\xa3\x6a\x7c\xab\xee\xea\x1d\xf4\xc6\x78\x37\xda\x41\x3f\x7e\x38\xb7\x16\x3e\xeb\x37\x88\xd2\x90\x7e\x20\x85\xa1\x1a\x45\xc6\x94\x26\x66\x57\xbb\xed\x9d\x79\xbc\x24\xb1\xa9\x8e\x27\x44\xee\x7c\x12\xfd\xe7\xc5\xf9\xa5\x9a\x3e\x85\x66\xf1\x49\x0e\xa9\x7e\x11\x11\x3e\x6e\x6d\xb6\xe9\xa1\x4b\xe6\xd2\x0c\xf4\x8d\x22\xa9\x34\x16\xc6\x7b\xe1\x1e\x3f\x39\x98\x4e\x9f\x45\x31\xd9\xb3\x0e\xdb\x4f\x93\x18\xd7\xaa\x5d\xb7\xba\x45\x4c\x92\x84\xf1\xe1\xfb\x59\xbf\x7e\x5b\xde\x0a\x6b\x37\x3f\xcf\x26\xc6\x2b\x90\x16\x48\xd3\xa9\x6e\x0e\x7f\x32\xf7\xa8\xfa\x00\x3b\xab\x35\x2e\x09\x1f\xae\xd0\x78\x77\xe5\xd9\x4f\x5f\x11\x4d\x2e\x04\xe3\x5a\x35\xdd\x1c\x9b\x7d\x9c\x65\x9e\x8b\xec\xdc\xc7\x81\xe0\x9c\x06\x9a\xd6\xd7\x25\x2b\xf7\xde\x8c\xc8\xb1\xf5\x45\x52\xa0\x4c\xdd\x85\x92\x28\x1d\x32\x9e\xd3\x9f\x69\xfc\x68\xb7\xbb\x7b\x58\x6f\x38\x11\x4a\x0f\x98\x71\x4f\xec\xcc\x78\x2d\xb8\x7e\xc7\xbe\x66\x63\x3b\xf4\xfe\x64\x95\x91\xd4\x5d\x37\x4b\x5f\x58\x35\xb3\xf3\x39\x49\x56\x58\xf7\x81\x14\x71\x16\x56\xd3\x28\x72\x6c\xe8\x99\x55\xf0\xdb\xbd\x33\x47\xa6\x28\x2a\xae\xb8\x60\x2a\x21\x72\x1c\x31\xee\x88\xd6\x03\x16\x45\x75\x00\xdb\xf7\x3b\xc8\xf7\x8f\x3b\xc8\x3f\x3e\x31\xc7\x86\x7f\xdc\x38\x36\x06\xa6\x6f\x77\x10\x36\xbd\x54\xdb\x9b\x35\xd7\xf5\x3a\xc8\x3f\xd9\x6f\x34\x54\x8d\xf9\xad\x41\x33\x43\xb1\x97\x22\x4a\x63\xde\x5c\x95\x59\x3c\x59\xc1\xe0\x74\x9a\x64\x63\x4a\x93\x5f\x8d\x5b\xa8\x84\x04\xf4\xf9\x25\xfe\x63\xf1\xe1\x12\x77\x4c\x04\xbb\xc4\x56\x0c\xbb\xc4\xd7\xcd\x35\x28\xcf\x45\xcd\x62\xda\x53\x54\x32\x6a\xd3\x55\x16\x64\x95\x26\x5c\xb7\x05\xd8\x79\xec\x7f\x4d\x02\x9d\x59\xcc\x6f\x14\x89\xe8\x90\xf2\xf0\x75\xd1\x5d\xb3\x0f\x49\x07\x19\x93\xe2\xb3\x55\xb7\x43\x71\x50\x66\xa1\xdb\xef\xf8\x96\x49\x59\x4c\x5f\xcf\xb6\x67\xc3\x2d\x4d\xde\xbb\x11\x1b\x38\x7d\xb6\x8c\xdb\x9a\xe8\x54\xb9\x81\x4a\x31\x3e\x8c\xa8\xd2\xa4\x1e\x88\x66\x41\xa8\xea\x5d\xc7\xb6\x77\x65\x25\x56\xf3\x2e\x61\x40\x1c\x3f\x6f\x77\xac\x57\xff\xfc\xf7\xdb\xf6\x40\xe8\x72\xae\x25\xa1\xf6\xa6\x3d\x7a\xb7\xdc\xdd\xcf\x17\x0b\x3a\xf3\x57\xdd\x29\x59\x8d\xb7\xf9\x29\x12\xa4\x52\x52\xae\x1f\x00\x87\x5b\xb2\x5d\x3c\x9c\x03\xed\xb7\xf2\x70\x8e\xd3\x2b\xf0\x70\x42\x65\x60\xac\x71\x0f\x48\xec\xf8\xbd\x79\x83\x89\xd8\x4e\x5b\x81\x88\x8f\xd6\x27\x62\xe0\xb1\xea\x0c\xef\x9e\xc7\x00\xa9\x00\xa9\xda\x90\x4a\xa5\xf1\xd3\x4f\x93\xb8\x17\xd3\x58\xc8\xab\x5e\xaa\x68\xd8\xeb\x5f\x69\xaa\xd6\x26\xad\x0e\x91\x94\x3c\xbf\xc4\x23\x4a\x92\x4b\x7c\xfd\xfd\x5f\x7c\xcf\xdb\xb3\x9a\x8f\xc9\xf4\x96\x5a\xbf\x01\xd6\x2d\xe5\xb6\x9b\x52\xd9\x33\xaf\x73\xe2\xd9\x5c\x96\xe3\xd5\x8f\x1f\xce\xd1\x0f\x94\x24\x9b\x0c\x58\x4e\x37\x5b\xc4\x57\xc0\x20\xf3\xea\xc0\x20\x37\x66\x90\xe3\xf5\x19\xe4\x19\x30\x88\xab\x71\x60\x10\x60\x90\xed\x64\x10\x2e\xf8\xdd\x62\x48\xa5\x83\xc7\x4c\x22\x6f\x05\xdf\x01\x1a\xc9\xeb\x6d\x1f\x8d\x84\x34\x60\x31\xc9\x8e\x7f\x7f\x31\xa6\xa4\x9c\x01\xaa\xd4\xd2\xcc\x71\xe4\x77\xd7\x67\x95\x63\x60\x15\x57\xe3\xc0\x2a\xc0\x2a\x8f\x81\x55\x12\x29\x02\xaa\x54\x2f\x48\xd2\x5e\xaa\xc8\x90\xfe\xda\x46\x0a\x6d\x68\xf1\x90\xdf\x48\xdd\x25\x4f\xbc\xbc\xf8\x19\x30\x02\x30\xc2\x8d\x11\xf0\x02\xc9\x0d\x10\x47\xeb\x03\x84\xef\xad\x4f\x10\x8b\x7d\x67\xd9\x1b\xa4\xb3\x8b\x37\x9b\xf3\x06\x69\xc1\xfb\x22\xe7\x38\xd7\x7a\x5f\xf4\x9f\x0b\x7e\x31\xf6\x22\x36\xb6\xdf\x17\x91\x84\xc1\xfb\xa2\xd5\x66\xff\x90\xef\x8b\x4a\x70\x43\x92\x7e\xde\x53\x80\x6f\x80\x6f\x08\x59\x57\x4d\x92\x68\xfa\x74\xa4\x75\xd2\x9b\x05\x99\x9e\xa4\x9f\x53\xaa\xb4\xea\x29\x1a\x08\x1e\xaa\x5e\x20\x52\xae\xd7\x7f\x6a\xf4\xcb\x61\xfc\xf1\xfb\x8d\xbe\x0f\xba\xed\xc7\x42\x3f\xe5\x96\x43\x09\x95\x68\x66\xbd\x2d\x64\x40\x32\x19\xde\x23\xff\x5d\xcc\x23\xd4\xad\xbd\x43\x7f\x78\x3c\x54\x23\x21\xef\xe5\x7e\xe9\x91\xf1\x61\xf7\x1b\x9e\xa8\xd7\x2f\xa5\xe0\x86\xe9\xd1\x12\x0c\x95\x52\x48\x60\x18\x04\x0c\x33\x2b\x78\x1f\x0c\xd3\x51\xd9\xf3\xdf\x3f\xfc\x76\x89\xbb\xbb\xbb\xb7\xc1\x34\xf3\x2b\x2b\xf7\x6f\x80\xf7\xc0\x3c\xde\xae\xdf\xf1\x76\xbb\xb7\x8d\x3e\x7f\x33\xde\xf9\x58\xc0\xc7\x7b\x38\xec\x11\x51\x44\x12\x45\xdd\x40\x73\x77\xb2\xc2\x83\x25\x87\x64\x5d\xc8\x55\xca\x1f\x3f\xba\x57\xbb\xb8\x6d\x38\xa7\x9a\xb8\x57\x1a\xd4\x86\x70\xd7\xb7\x88\xe5\x5c\xbb\xf4\x70\xc9\x2e\x3d\xbc\xef\xab\xbe\x87\x90\x1b\xc6\xb6\x4b\x21\x04\x62\x43\x40\x69\xab\x1c\x88\x0d\x01\xb3\x37\x55\x6c\x68\x22\x18\x48\x0d\xad\x3c\x90\x1a\x82\xd4\xd0\x1e\xe4\xb6\x7c\x23\x0e\xcf\xfc\x8b\xb4\x75\xa4\x86\xcb\x70\x18\xb4\x86\xce\xc6\x37\x09\xc7\x80\xa8\x80\xa8\xda\x88\xea\xe6\xef\xfc\x67\xa0\x75\x37\x4a\x43\x77\xdb\x9b\xfc\x6d\x2e\xe8\x0c\x6b\x95\x00\x40\xca\x02\x00\x20\x45\xda\x3a\x3a\xc3\x65\x00\x02\x42\x43\x67\xe3\x00\x20\x00\x20\xdb\x08\x20\xb7\x2e\x33\x6c\x6d\xfe\x31\x63\x08\x88\x0c\xb7\x1a\x45\x40\x64\x78\x07\x22\xc3\x65\xa0\x02\x2a\x43\x67\xe3\x00\x2a\x00\x2a\x8f\x01\x54\x96\xaa\x0c\x73\x50\x00\x8d\x21\x50\x44\xa5\xd6\x76\x53\x04\xbc\x3b\x5a\x5d\x63\xb8\x8c\x1f\x4e\xd6\xe7\x87\xc5\xbe\xb3\x89\x1a\xc3\x96\x87\x47\xa0\x30\xdc\x7a\x36\x03\x85\x21\xa0\xdb\x3c\x73\x03\x5e\xe7\xe7\xef\x8b\x40\x5f\x08\xcf\xec\xef\x9a\xfc\x40\x5d\xb8\x3d\x64\xe8\x54\x17\x2e\x21\xc3\xee\x37\xfc\xf5\x09\xa0\x97\xea\x0c\x37\x84\x5e\x40\x5d\x58\xd6\x00\x7e\xb9\x0f\x7e\x01\x6d\x21\x68\x0b\x7f\x17\xda\xc2\xe3\x25\x47\x64\x5d\x80\xbf\xba\xb6\xf0\xd5\x0b\xf7\x3a\x83\xb2\x10\x6e\xf8\x16\x71\x9c\x6b\x8f\x9e\x2c\xd9\xa3\xfb\xf7\x7d\xc1\x67\x6d\xee\xbc\xdb\xdb\xbc\xdf\x73\x84\xa8\x1b\x6a\x08\x5d\x63\x5e\xeb\xb6\xef\xec\xeb\xe0\x87\xb7\x27\x27\xd1\xe7\xc4\xbe\xee\x0b\xfb\x70\xdb\xb7\xda\xec\x41\x42\x08\x2c\xfd\x48\x59\x7a\x0d\x09\x61\xd8\x07\x01\xa1\x95\x07\x02\x42\x10\x10\xda\x83\xdc\x96\xaf\xbb\x4b\xbf\x0f\x69\x90\xbd\x21\x05\xee\xad\xa5\xb5\x49\x08\x97\x71\xef\x37\xfc\x45\x0d\xc0\xb1\xea\x0c\x01\xc7\x00\xc7\x1e\x29\x8e\xc5\x64\xfa\x34\x26\xd3\x9e\x98\x50\xd9\x33\x84\xf1\x34\x19\xf6\x42\xa2\x49\x9f\x28\xda\x53\xec\x2b\x6d\xc7\x35\xe4\xe0\xb5\x0e\x0a\x89\x36\x85\x2a\x19\x1b\x71\xad\x79\xf7\x30\x77\xdb\x24\xf7\x2a\x5f\x05\x64\x56\x61\x93\x81\x0e\x9e\xf8\xdd\xc7\x37\xb9\x1e\x5c\x00\xde\x54\xca\xb8\x0c\x84\x40\xca\xe8\x6c\x1c\x40\x08\x40\x68\x3b\x41\xc8\x9c\xac\x25\x0d\xf1\x34\xee\x93\x60\x4c\x79\xb8\x40\xbd\x08\x50\x74\xa7\x50\x74\x16\x68\x36\xa1\x28\x8f\x1c\x59\x28\x00\x32\xda\x46\x32\x02\x00\xca\xd3\xd6\xd2\x48\x2e\x23\xa0\x6f\xd0\x38\x00\x01\x55\x67\x08\x04\x04\x04\xf4\xbb\x22\x20\xaa\x35\xe3\x43\x95\xfd\xe1\x86\xca\xa9\xbb\xde\x97\x78\x40\x3a\xeb\x93\xce\x39\x99\x02\xe6\x6c\x3d\xe6\x80\xc6\xb3\xda\xd0\x8d\x35\x9e\x4b\xf8\x67\xdf\x07\xfe\x71\x35\xfe\x38\xf8\xa7\xf2\x92\x3f\x10\x31\xbc\xe3\x47\x40\x38\xb3\x82\x73\xc2\xc9\xde\xf0\x37\xae\x75\xa6\x24\xd0\xbd\x40\xc4\x31\x5b\xfd\xf5\x7e\x76\xab\xd3\x7e\xa9\x73\x03\xd2\x79\x74\x7a\xc4\x97\x99\xe5\xe0\x65\x7e\x51\x07\x20\xa5\xc8\x00\x48\xc9\xd3\xd6\x92\x1b\x2e\x83\x94\x6f\x78\xa7\x0e\x90\x52\x9d\xe1\x86\x40\x8a\x14\x11\x40\x0a\x02\x48\x99\x15\x5c\x01\x52\xa4\x88\xa2\x3e\x09\xc6\x80\x29\xeb\x63\xca\x4f\xb9\xed\x00\x54\xec\x3a\x45\x79\xb3\x66\x66\x09\x4c\xc9\x7d\xaf\x5c\x76\xac\x82\x11\x8d\x49\x79\xb3\x5c\xfe\x6a\x8d\x95\xbe\x8a\x72\xed\x8f\x1c\x97\x35\x34\x19\xd6\x8d\x50\xec\xbd\xca\xb4\x70\x26\xfb\xc1\x8d\x61\x68\x1a\x27\x11\xd1\x8c\x0f\x6b\xe1\x00\x47\x4c\x69\xcb\xb2\x56\xb0\x20\x51\x34\xe7\x03\x7b\x27\xa0\xf2\x3e\xc9\x8e\x31\x15\xab\xc7\x57\x89\x14\x9f\x68\xb0\xe0\x98\xa9\x94\x59\x74\x9a\x61\xe3\xba\x4a\xa4\x32\xc8\x2a\x5d\x48\x11\x53\x3d\xa2\xa9\xed\x4d\x38\xa4\x03\xc6\x99\xce\xef\xec\x23\x83\x28\xbd\xac\x2f\xf5\x34\x4d\xb2\xbf\x71\xf7\x5b\xc5\xa3\x77\xff\x7a\x89\xaf\x3b\xa8\xf0\xf9\x46\xc4\x1a\xb1\xd0\x85\x47\xb9\xac\xec\xac\x3d\x2e\x9a\x8e\xb3\x90\x3e\x6f\xda\x6e\x39\x4e\x23\xcd\x5a\x6a\xcf\x8f\x6a\xde\x56\xb9\x3c\x10\xeb\x2c\x82\x10\xfe\x9c\x52\x79\x75\x2b\x73\x2f\xf7\xaf\xad\x65\x95\x74\x48\x9b\x47\xa6\xd9\x96\x63\x96\xfc\x2c\xa3\x77\x57\x3c\x68\x99\x9a\x12\x52\x3b\x5a\xd4\x64\x98\x6d\x35\xf5\xaf\xf9\xf0\xed\xa6\xe7\x3e\x60\xcf\xd7\xa4\xb7\x57\xca\x83\xd0\xcc\x28\x56\x66\xaa\xe8\xfb\x59\xa3\x8d\x03\xa9\xf8\xf9\x63\xfe\xd3\x75\xe9\x4e\x33\xa9\x60\xc5\x91\x8a\xb3\x5d\x7c\xd9\x39\x8c\xab\x1e\x99\x1f\xdd\xa5\xb8\xb6\xde\x4c\xc2\x82\x31\x95\xf5\xc6\x72\xa3\xf7\xe6\x67\x82\x1d\xfc\xf0\xa1\xc5\x38\xbe\x67\x25\xec\xdb\x09\x7e\x5c\xff\x7c\x68\x7d\xf6\xed\x84\x7d\xcf\x2e\x31\xaa\x7f\xee\x5a\x9f\xfd\xb0\x74\xde\x8f\xd5\xf9\x9b\x53\xaf\xb2\x57\xd7\x1a\x85\xdd\xe9\x91\xdd\xa9\x3d\x8a\xee\x81\x9d\x60\x1d\x49\xcf\x42\x7b\x9e\xd5\x71\x3b\x97\xe8\xab\xc8\x28\x0a\x57\x62\xf1\x02\x35\xe7\x0f\x22\x2e\x5d\x15\xa7\x6c\x76\x62\x07\x1f\x5e\xbe\x78\xfd\xee\xbf\x5f\xcb\x9c\x49\x19\xfb\x67\x4a\xef\xeb\x27\xff\x0f\x00\x00\xff\xff\xd6\x45\x7f\x8a\xb4\xb2\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\xeb\x72\xdb\xb8\x15\xc7\xbf\xeb\x29\x50\x6c\xdb\x49\x5a\xca\x26\x25\x5f\x35\x93\x0f\x4e\xd2\x74\xb3\x53\xa7\xee\x26\x9b\x4e\xbb\xf6\x68\x60\x12\x92\x10\x91\x00\x03\x80\x8e\x9c\xad\xf7\xd9\x3b\xa0\x24\x5e\x40\x52\x37\xcb\xb7\xe4\x8c\x3d\xbb\x16\x00\x02\xe0\xc1\x39\x07\xbf\xc0\xf8\x9b\xc4\xec\x23\x95\x8a\x09\xde\x43\x8c\x6b\x3a\x94\x94\xe8\xf0\x7a\x47\xc8\xe1\xee\x95\x47\xc2\x78\x44\xbc\xd6\x98\xf1\xa0\x87\xfe\x2e\xc9\x80\x70\xf2\x9a\xa8\xd1\xa5\x20\x32\x68\x45\x54\x93\x80\x68\xd2\x6b\x21\xc4\x49\x44\x7b\x48\x5d\xf3\x80\x2a\xa6\xda\x8c\x0f\x24\x69\x8f\x44\x44\xdb\x41\xd6\x1e\xa1\x90\x5c\xd2\x50\x99\xf6\x08\x91\x38\xce\x1f\x48\x4b\xe6\x1f\x76\x98\xd8\x5d\x5c\xab\xaf\x63\x6a\xe6\x3b\x90\x44\x69\x99\xf8\x3a\x91\xb4\xa5\x62\xea\xaf\x38\x95\x9d\x4f\x4a\xf0\x16\x42\xe6\x7f\x3d\xf4\xbf\x74\xf4\xdf\xd2\xff\x22\x84\x09\xe7\x42\x13\xcd\x04\x57\xb8\x97\x15\x23\x84\x43\xa6\x34\xee\xa1\x5f\xb3\x92\xfc\xa1\x59\x8b\xcb\x84\x85\xfa\x2d\xc7\x3d\xe4\x39\xe5\x1a\x63\x27\x25\x12\xe9\x53\xdc\x43\xb8\xdd\x9e\x5b\x13\xb5\xdb\xd8\x6a\x4a\x39\xb9\x0c\x4d\x33\x2d\x13\x6a\xd5\x8d\x58\xd0\x50\xc3\x7c\xc1\x5f\x89\x50\x48\xd3\xbf\x1c\x5e\x92\x67\xae\x83\x3a\x9e\xe7\xa0\xce\xfe\xbe\x83\xbc\xe7\xf6\x30\xc6\x4c\xa6\xed\x49\xfe\xba\xe8\xcf\xe8\x24\xa4\x52\x2b\xbb\xad\xb1\xb7\x69\x9b\x19\x10\x17\xea\x6f\xb2\x9f\x2f\x66\x3f\xdd\xcc\x1f\xc7\x34\x60\xba\xe6\x6d\xf0\x90\x53\xfd\x36\xc0\x3d\xc4\x93\x30\xcc\x4b\x25\x89\x47\x1f\x84\x08\x35\x8b\x71\x0f\xb9\x59\x05\x33\x4d\xbd\xa3\xfc\xb3\xa6\x32\x9d\xb2\x29\xde\x3f\xe8\x1c\xb8\x7b\xfb\x6e\xd7\x3d\xe8\x66\x2d\x42\xc6\xc7\xaa\xb4\x58\xc5\xa5\x4a\xad\x65\x5e\x88\x4e\x34\x95\x9c\x84\xc8\xb4\x2f\xbd\x35\xd6\x64\x58\xee\xc0\x7c\xe1\xb9\x5b\xd9\x16\x4a\x7d\xb1\x68\x95\x0b\xa7\xb5\xc8\x80\x0a\xb7\x6c\xfb\x65\x4f\xe0\x98\x70\x1a\x2e\x98\xfd\x50\xb2\xe0\x4c\x94\xbd\xd3\x7c\xe1\x51\x8d\xe7\x7d\xc1\x3d\xd4\xd9\xb3\x0a\x27\x45\xf3\xce\xca\xae\x4d\x59\xa1\x28\x5b\xc5\x7c\x09\x3a\xa5\x22\xcd\x74\xba\xb2\xf8\xfd\xcc\x28\xe8\x3d\x95\x57\x54\xe2\xda\x57\x97\xe2\x4b\xe1\x9d\x9d\xfa\x37\xf3\x89\x3f\xa2\x1f\x58\x44\x45\xa2\x2d\xef\x30\x5f\xd8\x37\x1e\xfe\x92\xf8\xe3\xa1\x14\x09\x37\x53\x1a\x90\x50\x95\x62\x61\xda\xe6\x23\x09\x93\xba\x48\x99\xd6\xd6\x2c\xec\x0f\xc1\xde\x1e\xe9\x12\x7b\x5d\xd3\x48\xea\x74\x0f\x1d\xe4\x75\x8e\x1d\xb4\xe7\x3a\xc8\xdd\x39\x3a\xae\x44\xd3\x0f\x9d\xe3\x63\x7f\xef\xa0\xd9\x03\x06\x42\x46\xc4\xbc\x13\xe6\x82\xd3\xd2\xe3\x78\x48\x92\x21\xad\xae\x66\x44\x26\xf3\xd7\xf0\x5c\x7b\xb5\x22\xc6\xe7\x95\x76\x95\x1a\x89\x2f\x75\x96\x31\x4b\x36\x92\x54\x8d\x44\x18\xfc\x23\xcd\xc4\xcb\x5a\x9d\x12\x39\xa6\x52\xcd\xec\xd8\xe8\x1b\x0b\xfd\xb1\xeb\x94\xcb\xcc\xd4\x56\x75\x47\xaf\x71\x48\x16\xd8\xdd\x60\xb3\x7d\xc9\x2b\x12\xd6\xf9\x4d\x35\x1d\xd8\xae\x37\xcb\xd2\xf3\xfc\x56\xf4\xea\x36\x7a\x6b\xa2\x1b\xb5\xd1\x4f\x1f\x4f\xad\x85\x4f\xc7\xf5\xc3\x24\xa0\x1f\x49\x66\xa8\x4a\x93\x31\xa5\xb1\xf1\xea\x7a\x7b\x23\x84\x63\x22\x49\x64\x1e\xc7\x57\x44\xb6\x3f\x89\xcb\x17\xd9\xfe\xa5\xaa\x31\x35\xcb\x4f\x72\x48\xf5\xcb\x90\xf0\x71\x63\xb7\xd5\x08\x5d\xf2\x2e\x95\x3c\x55\x6d\x92\x48\x63\x61\xbc\x1b\xec\xf2\xe3\xbd\xc9\xe4\x30\x8c\xc8\x6e\x36\x59\x66\x0c\xd5\xfe\x74\x15\x15\x03\xa1\xb8\x45\xd8\x61\x11\x91\x38\x66\x7c\xf8\x61\x3a\xae\xd7\x54\xb7\xc2\xda\xcd\xf7\xb3\x2b\x13\x15\x48\x0b\xa4\xe9\x44\x57\xa7\x7f\x35\x8f\xa8\x52\xc5\x8d\xb3\x5a\xe7\x92\xf0\xe1\x0a\x9d\x77\x56\x7e\xfb\xc9\x6b\xa2\xc9\x99\x60\x5c\xab\x6a\x98\x63\x93\xff\xd2\xca\x53\x11\x98\x49\x63\x5f\x70\x4e\x7d\x4d\x83\xd2\xd8\x69\xbb\x0f\x74\x52\x9b\x32\x45\x9c\xa1\x4c\x39\x84\xe2\x30\x19\x32\x3e\xa3\x3f\xd3\xf9\xc1\x4e\x67\x67\xbf\xdc\x71\x2c\x94\x1e\x30\x13\x9e\xb8\xb6\xe2\x8d\xe0\xfa\x3d\xfb\x9a\xce\x6d\xdf\xfd\x93\xd5\x46\xd2\xfa\x67\x25\x5d\xf6\x68\x6a\xe7\x53\x12\xaf\xb0\xee\x03\x29\x22\x33\xbc\x79\xf1\x52\x27\xe6\x1b\xa7\x4e\xd0\x43\xf8\xdd\xee\x49\x4d\xa5\xc8\x1e\x5c\x71\xc1\x54\x4c\xe4\x38\x64\xbc\x26\x5b\x0f\x58\x18\x96\x01\xac\xeb\x39\xc8\xf3\x8e\x1c\xe4\x1d\x1d\x9b\x6d\xc3\x3b\xaa\x6c\x1b\x03\x33\xe9\xda\xe8\xc5\x66\x94\x62\x7f\xd3\xee\x3a\xae\x83\xbc\xe3\xee\x73\xbc\x20\xe7\xb7\x1a\xfc\x1a\xa7\x28\xf6\x4a\x84\x49\xc4\xab\xab\x32\xcd\x27\x2b\x18\x9c\x4e\xe2\x74\x4e\x49\xfc\x9b\x09\x0b\x15\x13\x9f\xbe\x38\xc7\x7f\xcc\x3e\x9c\x63\xc7\x64\xb0\xf3\x0c\x96\x66\x39\xec\x1c\xdf\x58\xd3\x2e\xed\x8b\x9a\x45\xb4\xaf\xa8\x64\xd4\xa6\xab\x34\xc9\x2a\x4d\xb8\x6e\x4a\xb0\xf3\xdc\xff\x86\xf8\x5a\x48\x3b\x93\x98\x2f\x1c\xd2\x21\xe5\xc1\x9b\x6c\xb8\xea\x18\x92\x0e\x52\x26\xc5\x27\xab\x66\xaf\x6c\xa3\x34\x66\xc3\x9e\xe3\x95\x3a\x4d\xdf\xe8\xcd\xd4\x3d\x2b\x61\x69\xea\xde\x8f\xd8\xa0\x36\x66\xf3\xbc\xad\x89\x4e\x54\x3d\x50\x29\xc6\x87\x21\x55\x9a\x94\x13\xd1\x34\x09\x15\xa3\xeb\xc8\x8e\xae\xb4\xc5\x6a\xd1\x25\x0c\x88\xe3\x17\xcd\x81\xf5\xfa\x9f\xff\x7e\xd7\x9c\x08\xeb\x82\xcb\xb9\xdb\x11\xdd\x2d\x0f\xf7\xcb\xd9\x82\xc1\x3c\x6b\xb0\x46\x4f\x49\x9f\x78\x37\xdb\x45\xfc\x44\x4a\xca\x35\x6e\xd5\x4c\xb2\x38\xc1\xed\xe3\x70\x43\x75\xd5\x11\x32\xa0\xdd\x94\x87\x67\x38\xdd\x68\x90\x3c\xee\x63\x2a\x7d\x63\x8d\x7b\x40\xe2\x6a\xee\x78\xcc\x44\xbc\xb7\x3e\x11\x1f\x38\xad\x9a\xac\xb8\x90\x88\x2f\x9a\x98\x0b\x78\xec\x4e\x78\x0c\x90\x0a\x90\xaa\x09\xa9\x54\x12\x3d\xfb\x74\x15\xf5\x23\x1a\x09\x79\xdd\x4f\x14\x0d\xfa\x97\xd7\x9a\xaa\xb5\x49\xcb\x21\x92\x92\x17\xe7\x78\x44\x49\x7c\x8e\x6f\x9e\xff\xc5\x73\xdd\x5d\xab\xfb\x88\x4c\xb6\xd4\xfb\x2d\xb0\x6e\x29\xb7\xdd\x96\xca\x0e\x5d\xe7\xd8\x2d\x8d\x9c\xe3\xd5\x4f\x1f\x4f\xd1\x8f\x94\xc4\x8f\x19\xb0\x6a\xc3\x6c\x11\x5f\x35\xda\x05\x18\x04\x18\x64\x5d\x06\x39\xb2\xca\x56\x60\x90\x43\x60\x10\x60\x10\x60\x10\x60\x90\x39\x83\x70\xc1\xef\x16\x43\x0a\x03\x3c\x65\x12\x79\x27\x78\x1b\x68\xe4\xbb\xa5\x91\x80\xfa\x2c\x22\xa1\xb2\x7d\xaf\x8a\x29\x09\x67\x80\x2a\x15\x54\xf1\x3a\xeb\xb3\xca\x11\xb0\x0a\xb0\x0a\xb0\xca\x53\x65\x95\x58\x0a\x9f\x2a\xd5\xf7\xe3\xa4\x9f\x28\x32\xa4\xbf\x35\x91\x42\x13\x5a\x3c\xe4\x6f\xa4\xee\x92\x27\x5e\x9d\xfd\x02\x18\x01\x18\x51\x8f\x11\x70\x03\xa9\xf6\x06\x92\x77\xb0\x3e\x40\x78\xee\xfa\x04\xb1\x38\x76\x96\xdd\x41\x3a\x39\x7b\xfb\x78\xee\x20\x2d\xb8\x5f\x54\x3b\xcf\xb5\xee\x17\xfd\xe7\x8c\x9f\x8d\xdd\x90\x8d\xed\xfb\x45\x24\x66\xab\xa6\x07\xb8\x5f\xf4\x70\xf7\x8b\x72\x70\x43\x92\x7e\xde\x55\xb8\xae\x7a\x21\x83\x01\xbe\x7d\xd3\xf8\x66\x8e\x9a\x24\xd1\xf4\xd9\x48\xeb\xd8\x9c\xcc\x5c\x51\xd9\x97\xf4\x73\x42\x95\x56\x7d\x45\x7d\xc1\x03\xd5\xf7\x45\xc2\xf5\xda\x67\x43\x37\xbf\xee\x47\x17\xcf\x9f\xdf\x02\xef\xee\x9c\xdf\xb6\x7d\x59\xe8\xe7\x99\xe5\x50\x4c\x25\x9a\x5a\xef\x3b\x64\x40\x72\x35\xc4\xad\x1a\xef\xbd\x1b\xfe\x3b\x9b\x67\xa8\x86\xfa\x39\x21\xd9\x5b\xf2\x63\xc6\x43\x35\x12\x52\x03\x1f\x56\xf8\xb0\xe3\x6e\xc0\x87\x9d\xf5\xf9\xf0\xa2\x89\x52\x80\x60\x1e\x8e\x60\xa8\x94\x42\x02\xc3\x00\xc3\xdc\x23\xc3\x38\x66\x8b\x4e\xd4\x1f\x7e\x3f\xc7\x9d\x9d\x9d\x6d\x30\xcd\xfc\xc8\xaa\xc6\xd4\xf7\xc3\x3c\xee\x8e\xe7\xb8\x3b\x9d\x6d\xa3\xcf\xdf\x4c\x74\x3e\x15\xf0\x29\x9f\xd9\xe5\xdd\xdf\x03\xf6\x88\x30\x24\xb1\xa2\xf5\x40\xb3\x70\x93\xb4\x5c\x61\x3d\x59\xe1\x5e\x63\x30\x4e\x37\xc9\x52\x3f\x05\xf9\xe3\x45\xfd\x6a\x67\xa7\x0d\xa7\x54\x93\xfa\x95\x06\xb5\x21\xa8\x0d\xd7\x55\x1b\xee\x37\x0e\x39\xf5\xd2\x7d\xa7\x55\x93\x2c\x17\xa2\x5c\xa1\x70\x83\xa3\xbe\x87\x90\x1b\x46\x76\x48\x81\xd8\x10\xc4\x86\x20\x36\x04\xb1\xe1\x93\x11\x1b\x9a\x0c\x06\x52\x43\x90\x1a\x82\xd4\x10\xa4\x86\x20\x35\xdc\x5c\x6a\xb8\x0c\x87\x41\x6b\x08\x5a\x43\xd0\x1a\x7e\xc7\x5a\xc3\x29\x68\xcd\x2f\xe1\x6f\xf7\x8a\x7f\x7d\xdf\xb7\x60\xba\x07\xbd\x8d\x07\x3a\x43\xd0\x19\x82\xce\x70\x5d\x9d\xe1\x32\x00\x39\x04\x00\x01\x00\x01\x00\x01\x00\x99\x02\xc8\xd6\x65\x86\x8d\xdd\x3f\x65\x0c\x01\x91\x21\x88\x0c\x41\x64\xb8\x5d\x91\xe1\x32\x50\x01\x95\x21\xa8\x0c\x41\x65\xf8\xed\xaa\x0c\x67\xa0\x00\x1a\x43\xd0\x18\x82\xc6\x10\x34\x86\x6b\x6b\x0c\x97\xf1\xc3\xb1\xd3\xaa\x89\xfa\x85\xfc\xb0\x38\x76\x1e\xa3\xc6\xb0\xe1\xe2\xd1\x82\x4b\x45\xa0\x30\x04\x85\x21\x28\x0c\x41\x61\x78\xcf\x0a\xc3\xd9\xfd\x22\xd0\x17\x3e\x72\x7d\x61\x19\x5b\xf3\xee\x6f\x45\x7f\xa0\x2e\x04\x75\xe1\x3d\xaa\x0b\x97\x90\x61\xc7\x5d\x9f\x0c\x2f\x9a\x08\x05\xe8\xe5\xe1\xe8\x05\xd4\x85\xa0\x2e\xbc\x2f\x75\xe1\xec\xb4\x0a\xb4\x85\xa0\x2d\xfc\x16\xb4\x85\x47\x8d\xa1\x38\xdd\x22\xcb\x02\xfc\xd5\xb5\x85\xaf\x5f\xd6\xaf\x33\x28\x0b\x41\x59\xb8\xae\xb2\xf0\xb8\x71\xc8\xa9\x8f\x76\xd7\xc7\xb8\x42\xe1\x06\x07\x7c\x96\x73\xdf\xc1\xf9\xde\xf6\x35\x84\x75\x73\x5e\xeb\xb4\xef\xe4\xeb\xe0\xc7\x77\xc7\xc7\xe1\xe7\xd8\x3e\xee\x0b\x2e\x57\xcd\x8b\x20\x21\x04\x09\x21\x48\x08\xbf\x75\x09\x61\x70\x09\x02\x42\x10\x10\x82\x80\x10\x04\x84\x96\x80\x30\xa0\x7e\xaa\x63\x01\xee\xad\x70\xef\xde\x06\xdc\xbb\xc1\x5f\xd4\xb8\x68\x42\x2e\xc0\x31\xc0\x31\xc0\xb1\xa7\x83\x63\x11\x99\x3c\x33\xba\x40\x61\x7e\x25\x6b\x8e\x16\x9f\xc5\xc3\x7e\x40\x34\xb9\x24\x8a\xf6\x15\xfb\x4a\x9b\x71\x0d\xd5\xf0\x9a\x83\x02\xa2\x4d\xa3\x42\xc5\xa3\x38\xd6\xbc\x7b\x98\xdb\x36\xc9\xbd\x9e\xad\x02\x32\xab\xf0\x98\x81\xae\x36\x2c\x17\xf1\x5c\xa3\x41\xe1\x8a\x5f\xf3\x15\x3f\xb7\x01\x86\xe0\x00\xb0\xf6\x00\xf0\x68\x03\x10\x3a\x04\x10\x02\x10\x02\x10\x02\x10\x9a\x83\x90\xd9\x59\x73\x1a\xe2\x49\x74\x49\xfc\x31\xe5\x81\x02\x28\x7a\x20\x28\x3a\xf1\x35\xbb\xa2\x68\x96\x39\xd2\x54\x00\x64\xf4\x3d\x92\x11\x00\xd0\x12\x00\xf2\x3a\x1b\x10\xd0\x06\x1a\x87\x8b\x26\xca\x01\x02\x02\x02\x02\x02\x7a\xea\x04\x44\xb5\x66\x7c\xa8\xd2\x3f\xdc\x50\xd8\x75\x9b\x01\xa8\x86\x7f\x80\x74\x36\x20\x9d\x53\x32\x01\xcc\xf9\xee\x31\x67\x85\xab\xfc\xa0\xf1\x5c\x5d\xe3\xb9\x84\x7f\xba\x1e\xf0\xcf\xd3\xe5\x9f\x9c\x6d\x90\x2f\x22\xb8\xc7\x0f\xf7\xf8\xad\x7b\xfc\xe9\x1d\xfe\xca\xb1\xce\x84\xf8\xba\xef\x8b\x28\x62\xab\xdf\xde\x37\x57\x93\x9c\xe6\x43\x9d\x5b\x90\xce\x52\x92\x79\x6c\x9c\xf2\x2a\xb5\x1c\xdc\xcc\x5f\x7a\x33\x1f\x20\x05\x20\xc5\x86\x94\x8e\xbb\x01\xa4\x74\x01\x52\xbe\x09\x48\x91\x22\x04\x48\x01\x48\x59\x1d\x52\xa4\x08\x43\xf3\x1b\x28\xc0\x94\xf5\x31\xe5\xe7\x99\xed\x00\x54\x32\x50\x69\x59\xed\xcd\x11\x98\x59\x02\x33\x44\xd7\xcd\x97\x1d\x2b\x7f\x44\x23\x92\x9f\x2c\xe7\xff\xb4\xc6\x4a\x5f\x87\x33\xed\x8f\x1c\xe7\x4f\x68\x32\x2c\x1b\x21\xf3\xbd\xac\x4d\xea\x46\x03\x49\x70\x65\x1a\x9a\x46\x71\x48\xcc\xb1\x63\x29\x1d\xe0\x90\x29\x6d\x59\xb6\x6c\x57\x4c\xc2\x70\xce\x07\xb6\x27\xa0\xfc\x3c\xc9\xce\x31\x05\xab\x47\xd7\xb1\x14\x9f\xa8\xbf\x60\x9b\x29\xb4\x29\x35\x29\xe5\x04\x73\x72\x43\x34\x51\x22\x91\xbe\x99\x0d\x3e\x93\x22\xa2\x7a\x44\xad\xab\xf9\xa6\x1d\x1d\x30\xce\xf4\xec\xcc\x3e\x34\xe7\x28\xfd\x74\xd1\xd4\xb3\x24\x4e\xff\xc6\xdd\xef\x85\x88\xde\xf9\xeb\x39\xbe\x71\x50\x16\xf3\x95\x8c\x35\x62\x41\x1d\x1e\xcd\x64\x65\x27\xcd\x79\xd1\x0c\x6c\x66\xf0\x6e\xde\xb5\xdd\x73\x94\x84\x9a\x35\x3c\x3d\xdf\xaa\x79\xd3\xc3\xf9\x86\x58\x66\x11\x84\xf0\xe7\x84\xca\xeb\xad\xbc\x7b\xee\xbf\x56\x3e\xc2\x92\x0e\x69\x75\xcb\x34\x6e\x39\x66\xf1\x2f\x32\x7c\x7f\xcd\xfd\x86\x57\x53\xe6\x0f\x65\x54\x7b\xd4\x64\x98\xba\x9a\xfa\xd7\x7c\xfa\x76\xd7\xf3\x18\xb0\xdf\xd7\x94\x37\x3f\x34\x4b\x42\x53\xa3\x58\x95\x89\xa2\x1f\xa6\x9d\x56\x36\xa4\xec\xe7\x8b\x96\xe5\x8e\xe9\x71\x73\x39\x90\xb2\xbd\x5d\x7c\x69\xef\x47\x85\x51\xe6\x5b\x77\x2e\xae\x2d\x77\x13\x33\x7f\x4c\x65\xb9\xb3\x99\xd1\xfb\xf3\x3d\xc1\x4e\x7e\x78\xbf\xec\xf2\xd8\x2b\xa4\x16\xf3\x5d\xca\x35\xe6\x1b\x7b\xc5\x39\x99\x1e\xac\xcf\x9e\x5d\xd0\x75\xed\x16\xa3\xf2\xe7\x8e\xf5\xd9\x0b\xf2\xe0\x2d\xac\x4f\x6a\xab\x7e\xc1\x57\xd7\x9a\x85\x3d\xe8\x81\x3d\xa8\x3d\x8b\xce\x9e\x5d\x60\x6d\x49\x87\xd6\xe7\xae\x5b\x9c\x77\xed\x12\x7d\x35\xff\xd0\x2a\x3a\xd6\x42\x35\xe7\x8f\x22\xca\x43\x15\x27\xe9\x21\x28\x3e\xf1\x3f\xbe\x7a\xf9\xe6\xfd\x7f\xbf\xe6\x35\x57\x79\xee\x3f\x6a\x21\x84\xd0\x4d\xeb\xff\x03\x00\xd6\x45\x7f\x8a\xb4\xb2\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 1174,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x41\x6b\xdc\x3c\x10\xbd\xef\xaf\x18\x9c\xef\x90\x40\x76\xfd\xf5\x90\x8b\x20\x81\x1e\x1a\xda\x5b\x09\xa5\x97\x52\x96\x59\x79\x6c\xab\x91\x34\x62\x34\xde\x64\x59\xfc\xdf\x8b\xbd\xbb\x5e\x2f\x84\x86\xf8\x24\x8d\x9e\xde\x7b\x7a\x23\x19\x93\xfb\x49\x92\x1d\x47\x03\x81\xa3\x53\x16\x17\x9b\x95\x65\x21\xce\x2b\xcb\xa1\xdc\x7e\x5a\x3c\xbb\x58\x19\xf8\x2e\x1c\x48\x5b\xea\xf2\x53\xe7\x69\x11\x48\xb1\x42\x45\xb3\x00\xf0\xb8\x21\x9f\x87\x11\x00\xa6\x64\x20\xef\x62\x45\xd9\xe5\xb1\x72\x9a\xac\x1c\x97\xff\x5e\xd5\x5d\x22\x03\x2e\xd6\x82\x59\xa5\xb3\xda\x09\x8d\xa0\x34\x69\x9b\x41\xc0\x3b\x8b\xea\x38\x2e\xcf\x96\x47\x98\xb0\x27\x03\xe8\x49\x74\x29\x9d\xa7\x83\xc2\x19\xb4\x7c\xa6\x9d\x81\xe0\xaa\xca\xd3\x0b\x1e\xb9\xdf\xe6\x33\x50\xa8\x74\x54\x2c\x00\x22\x06\x3a\x9b\x5e\xba\xa8\xd4\xc8\x88\xcf\xcb\x51\x6b\x60\x3e\xc8\xe5\x44\x76\x88\xa1\x11\xee\xd2\x31\x90\xe5\x47\x08\x60\xfc\xc6\xb1\x39\x4e\x00\xae\xe0\xf3\x80\x82\x9a\x05\x30\xee\x60\x46\x00\x2f\x4e\x5b\x40\x68\x5d\xd3\x82\xa0\x12\x70\x0d\x35\x3a\x4f\x15\xd0\xab\x6d\x31\x36\x13\xeb\x60\x65\x94\x33\xf0\xed\xcc\xf0\xe5\x84\xfa\xea\x9a\xf6\x11\x9d\xef\x84\x9e\x50\x69\xda\x04\x80\x31\xb2\x1e\xfc\x9a\x59\x19\x20\x50\xce\xd8\x90\x81\x62\x46\x08\xfb\xfd\x7f\x87\x0b\xb1\xb2\x1c\x95\x5e\xb5\xef\xc1\x45\x48\x5c\xcd\x96\x12\x57\x7d\x7f\x3b\x46\x93\x13\x5a\x9a\x2d\x4d\xb5\x01\xd0\x62\x7e\xef\x78\x66\xd8\xbb\x45\xdf\x51\xdf\x17\x17\xfe\x32\xa7\x75\x27\xde\x40\xab\x9a\xb2\x29\xcb\xc6\x69\xdb\x6d\xc6\x7b\x7d\x6a\x87\xe3\x69\x58\x6e\x3c\x6f\xca\x80\x59\x49\xca\x8a\x6d\x19\x30\x62\xe3\x62\xb3\xa6\xb8\x75\xc2\x31\x50\xd4\x5c\x2a\x27\x67\x73\x79\xea\xdc\x3a\x73\x5a\x61\xc5\xf6\x6a\xd6\x97\xc9\xdc\xe0\xbc\x3e\xa4\x2a\x97\xa9\xd2\x6b\x12\x03\x0f\x17\x86\xaf\x07\xcc\x35\x4b\xb3\xc6\x84\xb6\xa5\xb5\xc5\x40\x7e\x3d\xf5\xe8\x71\x3c\xfa\xfe\x0f\x6f\xee\x8b\x37\xef\x53\x71\x0b\xc3\x23\xba\x2f\x8e\xd1\x17\xfd\xaf\xbb\xf0\xfb\x06\xca\x0b\x99\x77\x54\x7e\xb0\xa2\xff\xb8\xc8\x0d\x3c\xc0\xff\xab\xbb\x99\x52\xcd\x62\xe0\x2e\xcc\x2a\xf3\x3f\xc5\xd4\x25\xda\x92\x38\xdd\x19\x78\x41\x89\xc3\x5b\xfe\x1b\x00\x00\xff\xff\xa4\xce\x7e\xbf\x96\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6b\xdb\x40\x10\xbd\xfb\x57\x0c\x4a\x0f\x09\xc4\x56\x7b\xc8\x65\x21\x81\x1e\x5a\xda\x5b\x09\xa5\x97\x52\xcc\x78\x35\x5e\x4d\xb3\xbb\xb3\xcc\xae\x9c\x98\xa0\xff\x5e\xa4\xd8\xb2\x0c\xa1\x21\xd2\x65\x3f\xde\xbe\xf7\x76\xde\x48\x98\xf8\x17\x69\x66\x89\x06\x82\x44\x2e\xa2\x1c\xdd\xca\x8a\x92\xe4\x95\x95\x50\xef\x3e\x2d\x1e\x38\x36\x06\x7e\xa8\x04\x2a\x2d\x75\xf9\xbe\xf3\xb4\x08\x54\xb0\xc1\x82\x66\x01\xe0\x71\x43\x3e\x0f\x23\x00\x4c\xc9\x40\xde\xc7\x86\x32\xe7\x71\xe5\x38\x59\xb1\xd4\xff\xdf\x2d\xfb\x44\x06\x38\x6e\x15\x73\xd1\xce\x96\x4e\x69\xa4\x48\x93\xb6\x19\x04\x3c\x5b\x2c\x2c\x71\x79\xb2\x3c\xc2\x54\x3c\x19\x40\x4f\x5a\x96\xda\x79\x7a\x51\x38\x81\x96\x0f\xb4\x37\x10\xb8\x69\x3c\x3d\xe2\x81\xfb\x75\x3e\x03\x55\xd1\x8e\xaa\x05\x40\xc4\x40\x27\xd3\x4b\x8e\x85\x9c\x8e\xf8\xbc\x1c\xb5\x38\xba\x83\x5c\x4e\x64\x87\x32\x38\x95\x2e\x1d\x0a\xb2\x7c\x0f\xc1\xe0\x17\x60\x1c\x9b\xc3\x04\xe0\x02\x3e\x0f\x28\xd8\x8a\x02\xc6\x3d\xcc\x08\xe0\x91\x4b\x0b\x08\x2d\xbb\x16\x14\x0b\x81\x6c\x61\x8b\xec\xa9\x01\x7a\xb2\x2d\x46\x37\xb1\x02\x2c\x5f\x6a\x63\xe0\xfb\x89\xe1\xcb\x11\xf5\x8d\x5d\xfb\x15\xd9\x77\x4a\xf7\x58\x68\x3a\x04\x80\x31\x4a\x19\xe5\x0e\x57\x3a\xbe\x81\x72\x46\x47\x06\xaa\x19\x21\x3c\x3f\x7f\x78\x69\x88\x95\x95\x58\xe8\xa9\xf4\x3d\x70\x84\x24\xcd\x6c\x2b\x49\xd3\xf7\xd7\x63\x69\x72\x42\x4b\xb3\xad\x69\x6d\x00\xb4\x98\xdf\xba\x9e\x19\xce\xee\xd0\x77\xd4\xf7\xd5\x99\xbf\x2c\x69\xdd\xa9\x37\xd0\x96\x92\xb2\xa9\x6b\xc7\xa5\xed\x36\x63\x5f\x1f\xe3\x60\x99\x86\xf5\xc6\xcb\xa6\x0e\x98\x0b\x69\xdd\x88\xad\x03\x46\x74\x1c\xdd\x9a\xe2\x8e\x55\x62\xa0\x58\x72\x5d\x24\xb1\xcd\xf5\x31\xb9\x75\x96\xb4\xc2\x46\xec\xc5\x2c\x97\xc9\xdc\x10\xcc\x60\xb8\x53\xd2\xf3\xaa\xd2\x53\x52\x03\x77\x67\x86\x2f\x07\xcc\xa5\xa8\x5b\x63\x42\xdb\xd2\xda\x62\x20\xbf\x9e\x32\x1a\xf2\xa1\xe6\xf9\xaf\x6c\x6e\xab\x57\xfb\xa9\xba\x86\xe1\x23\xba\xad\x0e\xa5\xaf\xfa\xdf\x37\xe1\xcf\x15\xd4\x67\x32\x6f\xa8\xfc\x94\x82\xfe\xfd\x22\x57\x70\x07\x1f\x57\x37\x33\xa5\xad\xa8\x81\x9b\x30\x5b\x99\xff\x29\x8e\x4f\xa6\x1d\x29\x97\xbd\x81\x47\xd4\xc8\xd1\x2d\xfe\x0d\x00\xa4\xce\x7e\xbf\x96\x04\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-camel-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-camel-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 22099,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5b\x6f\xdb\x38\xf6\x7f\xcf\xa7\x20\x38\xf3\xff\xa3\x03\xd8\xad\xe5\xdc\x0d\xf4\x21\xed\x4c\x76\x0b\xb4\xdd\xee\x24\xd3\x05\xb6\x29\x02\x46\x3a\x96\x59\x53\xa4\x96\xa4\x1c\xbb\xdd\x7c\xf7\x05\xa9\x8b\x25\x8a\x72\xec\xb4\xe8\xee\xa4\x9a\x87\x69\xcc\xc3\x73\xc8\x73\xff\xe9\x46\x92\xd2\xf7\x20\x15\x15\x7c\x82\x28\xd7\x10\x4b\x20\x9a\xad\x9e\x0a\x19\x3f\x5b\x04\x84\xa5\x33\x12\xec\xcd\x29\x8f\x26\xe8\x2f\x92\x4c\x09\x27\xbf\x12\x35\xbb\x11\x44\x46\x7b\x09\x68\x12\x11\x4d\x26\x7b\x08\x71\x92\xc0\x04\xa9\x15\x8f\x40\x51\x35\xcc\x45\x11\x4d\x05\x57\xc3\x90\x24\xc0\x86\x51\xc5\x87\x10\x23\x37\xc0\x94\xe1\x43\x88\xa4\xe9\x9a\xd1\x8e\x94\x3f\x9e\x52\xf1\x6c\x33\x55\xaf\x52\x30\xfb\x9e\x4a\xa2\xb4\xcc\x42\x9d\x49\xd8\x53\x29\x84\x3b\x6e\xe9\xe9\x27\x25\xf8\x1e\x42\xe6\x9f\x09\xfa\xb7\x5d\xe7\x8b\xfd\x3f\x42\x98\x70\x2e\x74\xce\x87\x27\xd5\x30\x42\x98\x51\xa5\xf1\x04\x7d\xa8\x46\x50\x8d\x6a\x67\xdc\x64\x94\xe9\x57\x1c\x4f\x50\x30\x68\x52\x8c\xdd\x94\xc8\x64\x08\x78\x82\xf0\x70\x58\x5a\x17\x0d\x87\xd8\x99\x0a\x9c\xdc\x30\x33\x4d\xcb\x0c\x1c\xda\x8c\x46\x1d\x14\x1a\x0a\xfe\x52\x30\x21\x8d\x7c\x19\xdf\x90\x27\xa3\x01\x1a\x07\xc1\x00\x8d\x0f\x0f\x07\x28\xf8\xc5\x5d\xc6\x98\xcb\xcc\x3d\x5b\xab\x8b\xfe\x1f\x9d\x31\x90\x5a\xb9\x73\x8d\xdd\xcd\xdc\xca\x80\xb8\x46\xbf\xab\xfe\xfe\x58\xfc\x75\x57\xb2\xe3\x08\x54\x28\x69\x6a\xa4\x1b\xfe\x4a\x2e\x86\x88\x6a\x8f\x9a\x38\xe6\xa0\x5f\x45\x78\x82\x78\xc6\xd8\x7a\x54\x92\x74\x76\x29\x04\xd3\x34\xc5\x13\x34\xaa\x08\xd4\x4c\x1d\x1f\xac\x7f\x6b\xc8\x5d\x6e\x5c\x70\x78\x34\x3e\x1a\x1d\x1c\x1e\x8f\xc6\x47\x47\xd5\x0c\x46\xf9\x5c\x35\xbc\x58\xf7\xa1\x35\xa3\xd9\x29\x2c\x35\x48\x4e\x18\x32\xf3\x1b\xe6\xc0\x94\x87\x2c\x8b\xe0\x3d\x91\xca\xe3\x0a\x3c\x07\x48\x2f\x69\xe2\x73\x13\xd6\x24\x56\x4e\x04\x21\x84\xcb\x90\x75\xad\x5e\x8f\xe0\xba\xc1\x3f\x36\x65\xba\xbe\xa9\xcd\x2d\x5d\x53\x71\xe0\x94\x70\x60\x1b\xf4\x0f\x05\xd7\xc0\x4d\x98\xe3\x9f\xd0\xcf\xa9\x88\x9a\xba\xc7\x92\x46\xef\x44\x33\x2f\x2c\x61\x66\xfc\xe0\xec\xff\xb6\xe1\x9b\x62\x70\x59\xf7\x5f\x31\xb6\x32\x63\xf5\x88\x6a\xda\xdb\xf8\xb8\x21\x66\xed\xc4\xa6\x29\x12\x61\xb3\x03\x27\x44\xce\x23\x71\xcb\x9b\x7b\x17\x69\x95\xd3\xcd\x05\x34\xd5\x36\x10\x71\x73\xbe\x96\x84\xab\x94\xc8\xdc\x1c\x6d\x5f\x16\x76\xd7\xb0\xd4\x35\x8b\x0f\x3a\xec\x4a\xc2\x19\x98\xb0\x10\x99\x76\xa2\xbb\xb0\x3b\x13\xf2\x05\x09\xe7\xb1\x14\x19\x37\x1a\x4f\x09\x53\xd0\x9e\xf3\x9e\xb0\x0c\xba\xc9\x9e\xf0\xfa\x69\x7c\x7a\x1a\x1e\x1c\xb9\xd1\x65\x6b\xc4\x78\xff\x78\x80\x82\xf1\xe9\x00\x1d\x8c\x06\x68\xf4\xf4\xe4\xb4\x55\x27\x7e\x8a\x0e\x0e\xc8\x3e\xe9\x0e\xc0\x08\x42\x9a\x10\x1b\x54\x8d\xaa\x87\xa7\x42\x26\xc4\x86\x92\x72\xa2\x88\x64\x31\xb4\x63\x28\x21\xcb\x52\xbb\x60\xe4\xc6\x48\x42\x79\x49\x74\x49\x6a\x26\x6e\x7d\x16\x31\x4e\x9a\x49\x50\x33\xc1\xa2\xd7\xb6\x03\xdd\x37\xeb\x0d\x91\x73\xa8\xd2\xba\x33\x22\x37\x66\xc1\xbe\x27\x0b\xb6\x4d\x82\xf1\xe6\x24\x38\x76\xea\x90\x06\xb9\x20\xcc\x17\x4e\x5d\x09\x42\xd2\x94\xf2\xf8\x32\x0f\xdd\xa0\x8b\xd6\x0e\xa2\xa6\x9e\xb5\xee\xb1\x30\x3e\x41\x5a\x20\x9b\x06\x03\x77\xda\xa2\xf4\x67\x83\x70\x37\xd8\x4e\xb8\x24\x3c\xde\x42\xf8\xb8\x29\xbc\x33\x50\x13\xb2\xfc\x95\x68\xf2\x4e\x50\xae\x55\x3b\xc8\xb0\xb1\xa2\x25\xbe\x29\xea\x48\x28\x38\x87\x50\x83\x53\x04\xcd\xbc\x4b\xb3\x23\x8f\xe1\x3b\x8b\x4c\x2a\x94\x9e\xd2\x65\xbb\xcc\x14\x84\x73\xc1\xf5\x05\xfd\x6c\xd7\x3d\x1c\xfd\x9f\x33\x47\x82\x9f\xd7\x8e\x6f\x64\xb5\x36\x7c\x43\xd2\x2d\x7c\x3a\x95\x22\x31\x32\x8c\x52\x6d\x6b\xeb\x5c\x63\xfc\xf6\xd9\x99\x87\x28\x2a\xc6\x2d\x9d\x61\x2a\xeb\x9c\x51\xee\xa9\x03\x53\xca\x58\x13\xca\xec\x07\x03\x14\x04\x27\x03\x14\x9c\x9c\x9a\x32\x15\x9c\xb4\xca\xd4\xd4\xac\xed\x4f\x6f\xb3\x4a\x5d\x5e\x2e\x6e\x3c\x1a\xa0\xe0\x74\xbf\x25\xa8\x5e\x4d\x3a\xd3\xd1\x62\x97\x97\x82\x65\x09\xf7\x34\x0e\x22\x63\xd0\x5b\x18\x1c\x96\xa9\xdd\x93\xa6\x09\x3c\xf9\x05\x0d\xd1\x13\x2a\xae\x4b\x34\x70\x6d\x31\xeb\xf5\x85\x26\x52\x9b\xc6\xa1\x34\x49\xd2\x2f\x26\x35\x54\x4a\x42\x78\x7e\x85\x7f\xae\x7e\x5c\xe1\x01\x4a\x45\x64\xc6\x52\x11\x5d\xe1\x3b\xf4\xcc\x44\xf7\xc8\x55\xae\x51\x94\xcd\xaa\xd7\x0a\x24\x05\x17\x79\xd8\xe2\xa2\x34\xf1\x36\x3e\x54\xaf\x3d\xe7\x24\xd4\xd6\xae\x41\x6b\x0a\x83\x18\x78\x74\x5e\x2d\xd7\x5e\x43\xc2\xd4\x42\x3d\x7c\xb6\x6d\xd0\x54\x85\x5a\x79\xac\x5e\x76\xf1\x3f\x52\xa3\x19\xf6\x36\x6b\x45\x79\xcc\x8c\x29\x9b\xe5\x24\x2f\x25\xf5\x3c\x3a\x71\xf3\xc8\xce\xd8\x2e\x8f\x84\xc1\xa8\xf8\xf9\x8e\x29\x54\x56\xb3\x9d\xb2\xc8\x32\xbd\x2d\xca\x65\x98\x49\x0b\x56\x7a\x30\x62\x3b\x7d\x4b\xad\x1a\x1e\x99\x09\xa9\x7b\x4c\xd2\x18\x5b\x7a\xc6\xb6\xc0\x24\x27\x3d\x26\xf1\x09\xef\x31\x49\x8f\x49\xfe\xd4\x98\x44\xc8\xf8\x9a\xa4\xa6\x51\x14\x40\xe4\xb7\x65\x38\x33\x7e\x53\xaf\xf8\x94\xd1\x78\xa6\xb7\xc4\x22\x03\x64\x9a\xef\xf3\x2b\x2c\x45\xa6\x41\x5d\xe1\xbb\x1f\x11\x94\x94\x46\x43\x09\x28\x45\x62\x47\xbb\x1e\x9f\xf4\xf8\xa4\xc7\x27\xeb\xb1\x2e\x7c\x72\xb2\x3b\x3e\x39\xed\xf1\x89\x4f\x78\x8f\x4f\x7a\x7c\xf2\xb8\xf0\xc9\x39\xa1\x2c\x93\xa0\xfe\x4a\x78\xc4\x20\xea\xd1\x49\x61\xd8\x6d\xd0\x49\x69\x3b\x34\xcb\x8d\xd7\x83\x93\x1e\x9c\xf4\xe0\x64\x47\x70\x12\xb8\xcf\x3f\xb7\x40\x27\x41\xd0\xc3\x13\x9f\xf0\x1e\x9e\xf4\xf0\xe4\x71\xc1\x93\xdf\x21\x02\x46\x17\x16\x3b\xf4\xd8\xa4\xb0\xea\x36\xd8\xa4\x6e\xb8\x1e\x97\xf4\xb8\xa4\xc7\x25\xbb\xe2\x92\xa3\x07\xe0\x92\x71\x8f\x4b\x7c\xc2\x7b\x5c\xd2\xe3\x92\xc7\x85\x4b\x2e\x67\x12\x48\xa4\x5e\x30\x11\xce\xb7\xbe\x6b\xf2\x43\x02\x91\xc2\x52\xe8\x26\x37\x55\x8f\x45\x7a\x2c\xd2\x63\x91\x1d\xb1\xc8\xf8\x01\x6f\xbd\x06\xa3\x1e\x8b\xf8\x84\xf7\x58\xa4\xc7\x22\x8f\x12\x8b\xbc\x32\x19\x2e\xb3\x54\xf7\x78\x64\x1b\x3c\x42\xd7\xe6\xea\x31\x49\x37\x26\x21\x8c\x12\xf5\xb2\x44\x0d\x4e\x99\xba\xc9\x3f\xe6\x6a\xc3\x8c\x88\xa8\xd9\x6b\xe0\xb1\x9e\xb5\x3b\x91\xa1\x81\x9f\xcd\xe4\x72\xab\xd5\x6c\xec\xa0\xc7\x9e\x0e\xda\x7a\xa2\xd0\xf5\xdd\xc8\xe1\xe6\x0e\xda\xbc\x98\xcf\x03\xb6\xbd\x0b\xc2\x68\xcc\xcf\xd4\x65\xf1\x59\x9e\xaf\xa4\x90\x45\xdc\x41\x29\xdd\xd0\xf9\xd5\xe2\x6f\x49\xaa\x57\x1d\xcc\x86\xfe\x4f\x90\xa2\x83\x9c\x90\xa5\x5f\x6e\x42\x79\x07\x8b\xa4\xf1\x4c\x5f\xe4\x1f\x4b\xfa\xe8\x45\xb5\xf3\xc8\xd4\x42\x93\xae\x8a\x6a\x03\xef\x5e\xa0\x64\xea\xae\xf7\xcb\x40\x43\xb8\xa5\x51\x1e\x4a\xdb\xe0\x96\x56\x67\x6e\xb5\xa8\x0d\x8d\x17\x64\x08\x5c\x93\xd8\x0f\x9f\x53\x23\x56\x92\x88\x66\x86\xf7\xb0\x4d\xf3\xc7\xb5\x04\x1e\x81\x04\x5b\xbe\xa7\x4c\x38\x05\x25\x2f\xb2\x7f\x5b\x80\x94\x34\x02\x8f\x42\xb6\x78\x77\xa5\x93\xd2\x24\x9c\x7b\x57\x55\x1a\xd2\x14\xa2\xd7\x79\xdb\x6c\xd3\x77\xee\x3c\x92\x68\x78\xd2\xfd\x86\xe3\xa5\x89\x81\x07\xdf\xa4\xff\x70\x98\x7c\xfc\x9a\x0f\x2f\x76\xee\x38\x76\xbb\x3b\xb4\x9d\x7b\x60\xe8\x96\x46\x3a\x27\xf4\x6b\x5e\xb3\xf8\xee\x56\xca\xf7\xbb\xc1\x4c\x2f\x1e\xd4\x9d\x9d\x08\x37\xbb\x3e\xcf\x01\x65\x0b\x24\x1b\xda\xef\x10\x17\xf9\xea\x61\xbc\x98\xd1\xa9\x17\x5e\x57\x3d\xff\x4d\xf1\xf6\x28\x4a\x41\x22\x05\xa1\xe0\x6e\xcf\xaf\x3e\x92\xfe\xe2\x56\x3c\x22\x21\xf2\xd7\x3c\x65\x2e\x56\xdb\x9d\xc5\x96\xbb\xeb\x12\x45\x50\x1e\xd1\x05\x8d\x32\xd2\xe8\xd3\x77\x5e\xc4\x61\xbf\xd7\x6e\x6e\x6c\x49\x96\xd4\xd3\xfa\x6e\xb2\x70\x9e\xa7\xae\xab\x74\xed\x7b\xde\xd6\xf7\x3c\xeb\x8b\x25\x0f\x57\x77\x6d\xaf\xea\xf7\x87\x8f\x9d\x2a\xac\xc8\x72\x9b\x0b\xc1\x0d\xb7\x01\xd0\x7d\xb7\x02\xec\x04\x7b\x24\x42\x17\x37\x13\xf1\x0b\xa2\x5a\x17\xab\x68\xdd\x0d\xbd\x6c\x79\x3f\xc4\xa3\xf6\x6a\x35\x9b\xec\x54\x0b\xfe\x8b\x6a\x78\x49\x9d\x7a\x74\x26\xeb\xca\x1f\x77\x16\xec\x74\xa1\x1c\x43\x7b\x0d\x8b\x4a\xb1\x3d\xdf\x42\x8f\x1d\x67\x7a\x5f\x67\xb9\x07\x68\x1e\x6e\x07\x34\x1f\x86\x22\x1f\x00\x03\x7b\x98\xd7\xc3\xbc\xf6\x5d\x06\xe3\xfa\x77\x52\x84\xa0\xcc\x95\xf1\x25\x4d\xe0\xeb\x50\x0c\x7a\x86\x1e\x17\xa0\x7c\x03\x84\xa3\xb4\xb2\x10\xf2\xf4\xdf\xaf\xbf\xad\xf1\xbd\x81\xd3\x3d\x2a\xf5\xc8\xe9\x7f\x0e\x39\x25\x9e\x68\xee\x61\xd3\x9f\x1d\x36\xed\x39\xcb\x99\x3a\x62\xca\x82\xb1\xd6\xfe\x68\xed\x72\xac\xc2\x19\x24\xa4\x38\xad\xac\xf1\x1e\x0b\x56\x7a\x95\xa7\x75\x44\xe4\xfa\x74\xa6\xf6\xf1\x4a\xbe\xa3\x95\xbc\xc7\x2a\xad\x77\xa3\x21\x49\x19\xd1\x94\xc7\xbb\x1e\xfe\x45\x18\x2b\x1f\xd6\x79\x72\x6b\x0d\x6d\x5a\xd1\x52\xde\x21\x4d\x56\xa9\x14\x9f\x20\xdc\xf0\xcc\xa7\x36\x67\x53\x40\x3a\xc7\x8d\xbd\x93\x22\x01\x3d\x83\xac\x75\xc4\x54\x04\x53\xca\x69\x79\x3c\x97\x8d\xca\xeb\x3c\xd7\x9f\x64\xe9\x97\x4f\xe2\xe6\xf9\x15\xf6\x9e\xa8\x76\x85\xef\x06\xa8\xea\x61\xad\xa7\x09\xc5\x39\x65\x6e\x5d\x2c\x8e\xce\x3a\xeb\x7e\x66\x51\xe4\x05\x7e\x5b\x8a\x76\x25\x27\x19\xd3\xb4\x83\xbb\x7c\x80\xc6\xbb\x98\xd7\x30\xaa\xd9\x44\x10\xc2\xff\xca\x40\xae\xbe\xad\x11\xd6\x81\xed\x9e\x06\x27\x21\x86\xf6\x73\x2d\x13\xaf\x73\x9a\xfe\x21\xd9\xc5\x8a\x87\x5d\x90\x36\xef\x38\xae\x44\x4d\x62\x1b\x7c\xea\xef\xa5\x1e\xae\xe8\x32\x39\x5c\xc5\xcd\x78\x37\x53\xd1\x96\x72\xeb\x38\xc4\x4c\xc1\x65\x2e\x74\xe3\x53\xa3\x6f\x9d\x26\x74\x48\x3a\xfe\x1b\xee\x0f\xd9\xe9\x71\x38\xdb\x90\x3f\x5b\x30\x7f\x87\xc4\xea\x44\x82\xf7\x05\x5b\x2a\xa2\x6f\x9f\x6b\xef\x84\x7b\x5b\x6c\xab\x2c\x73\x0f\x87\x7b\x60\x7e\x7d\x53\x5b\xfc\xc0\x29\x57\xfd\xdd\x3e\x85\x51\xe7\xc7\x11\xd6\xba\x59\xf5\xcc\x5b\xdc\x0e\xf7\xeb\xf9\x52\x3e\xd2\x16\xb7\xd8\x2b\x26\xa5\xe1\xdc\x5e\x17\xd6\x84\x15\x46\xbf\x2e\xaf\x34\x5c\x10\x88\x0f\x9d\x17\xb7\x83\x91\x33\xb0\xef\x0e\x04\x49\xf3\xf7\xa1\xf3\x3b\x70\x07\xf6\x47\xee\x0c\x07\x45\x8f\x9d\xdf\x41\xed\x0c\xcb\x8f\x75\xfd\xcd\xb5\x54\x2d\x8e\x77\xda\x85\xbb\xe8\x91\xbb\xa8\xbb\x8b\xf1\x81\x3b\xe0\xdc\xcf\x3d\x8e\x5c\x3d\xeb\xfb\xf6\xba\xe8\xb3\xb0\xd7\xcf\xb5\xc3\x36\xab\xab\xa0\x8b\x22\x99\xd0\x10\xbd\xaa\xa5\x13\x1a\xa2\x97\xe6\x82\x75\xcd\x91\xd9\xdb\x39\x98\xef\xcb\x70\x01\xef\xff\xf1\x79\x4d\x59\xac\xf1\x98\x1d\xba\xdb\xfb\x4f\x00\x00\x00\xff\xff\x6a\x9f\x66\x88\x53\x56\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x7d\x6f\xdb\xb8\x19\xff\xdf\x9f\x82\xe0\xdd\x86\x16\xb0\x5b\xcb\x8e\xf3\x62\xa0\x7f\xa4\xbd\xeb\x56\xa0\xbd\x75\x97\x5c\x07\xac\x29\x02\x46\x7a\x2c\xb3\xa6\x48\x8d\xa4\x1c\xbb\x5d\xbe\xfb\x40\xbd\x8b\xa2\x1c\x3b\x57\x74\x77\xad\xce\xc6\x35\xe2\xc3\x87\xe4\xf3\xfe\x93\x64\x92\xc4\xf4\x1d\x48\x45\x05\x9f\x23\xca\x35\x84\x12\x88\x66\xdb\x27\x42\x86\x4f\xd7\x1e\x61\xf1\x92\x78\x83\x15\xe5\xc1\x1c\xfd\x4d\x92\x05\xe1\xe4\x27\xa2\x96\x37\x82\xc8\x60\x10\x81\x26\x01\xd1\x64\x3e\x40\x88\x93\x08\xe6\x48\x6d\x79\x00\x8a\xaa\x51\x36\x14\xd1\x54\x70\x35\xf2\x49\x04\x6c\x14\x94\x7c\x08\x31\x72\x03\x4c\x19\x3e\x84\x48\x1c\x57\x8c\x69\x4b\x71\xf1\x84\x8a\xa7\xbb\xa9\x7a\x1b\x83\x59\xf7\x42\x12\xa5\x65\xe2\xeb\x44\xc2\x40\xc5\xe0\x1f\xb8\xa4\x27\x1f\x95\xe0\x03\x84\xcc\x3f\x73\xf4\xdf\x74\x15\x9f\xd3\xff\x23\x84\x09\xe7\x42\x67\xa2\xe0\x79\xd9\x8c\x10\x66\x54\x69\x3c\x47\xef\xcb\x96\x8a\x29\xef\x71\x93\x50\xa6\x5f\x71\x3c\x47\xde\xb0\x49\x31\x7a\x53\x22\x91\x3e\xe0\x39\xc2\xa3\x51\xa1\x5d\x34\x1a\x61\xab\x2b\x70\x72\xc3\x4c\x37\x2d\x13\xb0\x68\x4b\x1a\x74\x50\xa8\x2f\xf8\x0b\xc1\x84\x34\xe3\xcb\xf0\x86\x3c\x1a\x0f\xd1\xc4\xf3\x86\x68\x32\x9b\x0d\x91\xf7\xd8\x9e\xc6\xa8\xcb\xf4\x3d\xaf\xc4\x45\x7f\x45\xe7\x0c\xa4\x56\x76\x5f\xa3\x77\xd3\xb7\x54\x20\xae\xd1\xef\xca\xbf\x3f\xe4\x7f\xdd\x15\xec\x38\x00\xe5\x4b\x1a\x1b\x65\x1a\xfe\x72\x5c\x0c\x01\xd5\x0e\x31\x71\xc8\x41\xbf\x0a\xf0\x1c\xf1\x84\xb1\xaa\x55\x92\x78\x79\x29\x04\xd3\x34\xc6\x73\x34\x2e\x09\xd4\x74\x9d\x1c\x55\xd7\x1a\x32\x93\x1b\x13\xcc\x8e\x27\xc7\xe3\xa3\xd9\xc9\x78\x72\x7c\x5c\xf6\x60\x94\xaf\x54\xc3\x8a\x75\x1b\xa6\x6a\x34\x2b\x85\x8d\x06\xc9\x09\x43\xa6\x7f\x43\x1d\x98\x72\x9f\x25\x01\xbc\x23\x52\x39\x4c\x81\x57\x00\xf1\x25\x8d\x5c\x66\xc2\x9a\x84\xcd\xb9\xcd\x07\x17\x2e\xdb\x98\xc6\xac\xa5\xe6\xc1\x75\x85\x7f\x18\x0e\x76\xd9\x46\xe1\x81\x6d\x9a\x92\x03\xc7\x84\x03\xdb\x21\xbf\x2f\xb8\x06\x6e\xdc\x1c\xff\x80\x7e\x8c\x45\xd0\x94\x3d\x94\x34\x78\x2b\x9a\x71\x61\x3e\x78\x69\xec\x50\xef\x8a\x10\xbe\x6d\xd8\x26\x6f\xdc\xd4\xed\x97\xb7\x6d\x4d\x5b\xad\xa9\xf4\x9f\xca\xc6\x8d\x61\x2a\x23\x36\x55\x11\x89\x34\x3a\x70\x44\xe4\x2a\x10\xb7\xbc\xb9\x76\x11\x97\x31\xdd\x9c\x40\x53\xcd\xa0\xe1\x9e\xe6\x8b\xb5\x24\x5c\xc5\x44\x66\xea\x68\xdb\x32\xd7\xbb\x86\x8d\xae\x69\x7c\xd8\xa1\x57\xe2\x2f\xc1\xb8\x85\x48\xb4\xe5\xdd\xb9\xde\x99\x90\xcf\x89\xbf\x0a\xa5\x48\xb8\x91\x78\x41\x98\x6a\xce\xe8\x9b\xf0\x7e\x47\x58\x02\xdd\x64\x87\x7b\xfd\x30\x39\x3b\xf3\x8f\x8e\x1b\xc2\xa1\x3c\x47\x4c\xa6\x27\x43\xe4\x4d\xce\x86\xe8\x68\x3c\x44\xe3\x27\xa7\x67\xad\x3c\xf1\x43\x70\x74\x44\xa6\xa4\xdb\x01\x03\xf0\x69\x44\x98\xb2\xb3\x1e\x5e\x08\x19\x11\x23\x2d\x6e\xba\x36\x0e\x49\x12\x42\xdb\x87\x22\xb2\x29\xa4\xf3\xc6\xb6\x8f\x44\x94\x17\x44\x9b\xa4\x96\xe2\xd6\xa5\x11\x63\xa4\xa5\x04\xb5\x14\x2c\x78\x9d\x56\xa0\xfb\x7a\xbd\x21\x72\x05\x65\x58\x77\x7a\xe4\xce\x28\x98\x5a\x83\xdf\xda\xde\xbb\x23\x08\x26\x9d\x53\xa6\x89\xee\xa4\xd9\xc4\x35\xc8\x35\x61\x2e\x77\xea\x0a\x10\x12\xc7\x94\x87\x97\x99\xeb\x7a\x5d\xb4\xb6\x13\x35\xe5\xac\x55\x8f\xb5\xb1\x09\xd2\x02\xa5\x61\x50\x1f\xd0\x7c\xf0\xba\xb0\x67\x83\x70\x37\xdc\x6f\x70\x49\x78\xb8\xc7\xe0\x75\xad\xd5\xcb\x91\xed\xa8\x11\xd9\xfc\x44\x34\x79\x2b\x28\xd7\xaa\xed\x64\x98\x27\x8c\xa5\xc4\x37\x79\x1e\xf1\x05\xe7\xe0\x6b\xb0\x92\xa0\xe9\x77\x09\x1b\x67\x1c\x77\x26\x99\x58\x28\xbd\xa0\x9b\x76\x9a\xc9\x09\x2f\x05\xd7\x17\xf4\x53\x3a\xef\x6c\xfc\x17\xab\x8f\x04\x37\xaf\x84\xfb\x58\x53\x1d\xbe\x21\xf1\x1e\x36\x5d\x48\x11\x99\xe9\x8d\x50\x8d\x41\xcc\x17\xa7\x06\x9e\x23\xfc\xcb\xd3\x73\x07\x51\x94\x8c\x7b\x1a\xc3\x64\xd6\x15\xa3\xdc\x91\x07\x16\x94\xb1\x26\x94\x99\x7a\x43\xe4\x79\xa7\x43\xe4\x9d\x9e\x99\x34\xe5\x9d\xb6\xd2\xd4\xc2\x2c\xda\x1d\xde\x66\x96\xfa\x78\xd9\x70\x93\xf1\x10\x79\x67\xd3\xc7\x78\x47\x36\x19\x74\xf8\x2c\x4e\xb1\xcb\x0b\xc1\x92\xa8\x89\x6b\x72\xa2\x0c\x41\xef\xa1\x70\xd8\xc4\xe9\x9a\x34\x8d\xe0\xd1\x63\x34\x42\x8f\xa8\xb8\x2e\xd0\xc0\x75\x8a\x59\xaf\x2f\x34\x91\xda\x14\x0e\xa5\x49\x14\x7f\x36\xa1\xa1\x62\xe2\xc3\xb3\x2b\xfc\x63\x79\x71\x85\x87\x28\x16\x81\x69\x8b\x45\x70\x85\xef\xd0\x53\xe4\x8d\xc7\x63\x5b\xb8\x46\x52\x36\xb3\x5e\x2b\x90\x14\x6c\xe4\x91\x26\x17\xa5\x89\xb3\xf0\x35\x72\xcf\x4b\xe2\x6b\x21\xed\x5c\x62\x3e\x98\x41\x08\x3c\x78\x59\x4e\xd7\x9e\x43\xc2\x22\x85\x7a\xf8\x7c\x5f\xa7\x29\x13\xb5\x72\x68\xbd\xa8\xe2\xbf\xc5\x46\x32\xec\x2c\xd6\x8a\xf2\x90\x81\xd2\xa4\x99\x4e\xb2\x54\x52\x8f\xa3\x53\x3b\x8e\xd2\x1e\xfb\xc5\x91\x30\x18\x15\x3f\x3b\x30\x84\x8a\x6c\x76\x50\x14\xa5\x4c\xbf\xe4\xe9\xd2\x4f\x64\x0a\x56\x06\x0e\x9f\xfd\x0e\xc1\x48\x4b\xac\x1a\x1e\x59\x0a\xa9\x7b\x4c\xd2\xc2\x24\x47\x87\x63\x92\xd3\xe1\xc0\x91\x17\x7a\x4c\xd2\x63\x92\x1e\x93\xfc\x99\x31\x89\x90\xe1\x35\x89\x4d\xa1\xc8\x81\xc8\xcf\x1b\x7f\x69\xb0\xa4\x7a\xc5\x17\x8c\x86\x4b\xbd\x27\x16\x19\x22\x53\x7c\x9f\x5d\x61\x29\x12\x0d\xea\x0a\xdf\x7d\x8f\xa0\xa4\x50\x1a\x8a\x40\x29\x12\x82\xea\xf1\x49\x8f\x4f\x7a\x7c\x72\x20\x3e\x39\x3d\x1c\x9f\x9c\x0d\x07\x8e\x14\xd1\xe3\x93\x1e\x9f\xf4\xf8\xe4\x9b\xc2\x27\x2f\x09\x65\x89\x04\xf5\x77\xc2\x03\x06\x41\x8f\x4e\x0e\x40\x27\x85\xee\xd0\x32\x53\x5e\x0f\x4e\x7a\x70\xd2\x83\x93\x03\xc1\x89\x37\x39\x1c\x9d\x78\x5e\x0f\x4f\x7a\x78\xd2\xc3\x93\x6f\x1f\x9e\xfc\x0a\x01\x30\xba\x4e\x9f\x6c\xf4\xd8\xe4\x00\x6c\x52\x57\x5c\x8f\x4b\x7a\x5c\xd2\xe3\x92\x43\x71\xc9\xb1\xd5\xb8\x0f\x2e\x99\xf4\xb8\xa4\xc7\x25\x3d\x2e\xf9\xf6\x71\xc9\xe5\x52\x02\x09\xd4\x73\x26\xfc\xd5\xde\x4f\x4d\xbe\x4b\x20\x92\x6b\x0a\xdd\x64\xaa\xea\xb1\x48\x8f\x45\x7a\x2c\x72\x20\x16\x99\x8c\x1f\x80\x45\xc6\x3d\x16\xe9\xb1\x48\x8f\x45\xbe\x1b\x2c\xf2\xca\x80\x00\x99\xc4\xba\xc7\x23\xfb\xe0\x11\x5a\xa9\xab\xc7\x24\xdd\x98\x84\x30\x4a\xd4\x8b\x02\x35\x58\x69\xea\x86\x48\x67\x9d\x4c\x77\x55\xbd\x06\x1e\xea\x65\xbb\x12\x19\x1a\xb8\xd9\xcc\x4f\xd8\x5b\xa5\x66\x67\x05\x3d\x71\x54\x50\x6f\xb2\xe7\xbe\x91\x59\xad\xa9\x29\x58\x5a\x41\x9b\x37\xf3\x19\x80\x6e\xaf\x82\x30\x1a\xf2\x73\x75\x99\x6f\xcb\x6b\x09\x65\xb6\x46\xae\xc3\x0e\x4a\x61\x06\x57\x1c\xa5\xbb\x16\x7f\x8e\x62\xbd\xed\x60\x36\xf4\x7f\x83\x14\x1d\xe4\x88\x6c\xdc\xe3\x46\x94\x77\xb0\x48\xf3\x5b\xab\x8b\x6c\xb3\xa4\x8b\x9e\x67\x3b\xc7\x98\x5a\x68\xd2\x95\x51\x53\xc7\xbb\x17\x28\x99\xed\x06\x45\xa7\x16\xe1\x96\x06\x99\x2b\xed\x83\x5b\x5a\x95\xb9\x55\xa2\x76\x14\x5e\x90\x3e\x70\x4d\x42\xa7\x0a\x70\x6c\x0a\xbe\x24\x01\x4d\xcc\x52\x67\x6d\x9a\xdb\xaf\x25\xf0\x00\x24\x98\x3b\x33\xbc\x60\xc2\x4a\x28\xd9\x4d\xdf\x3f\xd6\x20\x25\x0d\xc0\x21\x50\x9a\xbc\xbb\xc2\x49\x69\xe2\xaf\x9c\xb3\x2a\x0d\x71\x0c\xc1\xeb\xac\x6c\xb6\xe9\x07\x57\x1e\x49\x34\x3c\xea\xfe\x85\xe3\xa5\xf1\x81\x07\x3f\xa4\x7f\x3f\x8b\x3e\x3c\xfe\x1d\x05\xe9\xe0\x8a\x93\x2e\xf7\x80\xb2\x33\xfc\x22\x4a\x32\xbf\x19\x80\xe0\xcf\xa3\xa5\x6c\xbd\x3b\xd4\xf4\xdc\x52\x53\xed\x6a\x47\x75\xb6\x3c\xdc\xac\xfa\x65\x06\x28\x5b\x20\xd9\xd0\x7e\x85\x30\x8f\x57\x07\xe3\xc5\x92\x2e\x9c\xf0\xba\xac\xf9\x6f\xf2\x5f\x8f\xa2\x18\x24\x52\xe0\x0b\x6e\x81\x74\x5d\x6e\x92\x6e\xda\x15\xab\x25\x91\x10\x38\xd2\x92\xa1\x99\xdd\x10\xed\xca\x92\xa6\xbb\xeb\x02\x45\x50\x1e\xd0\x35\x0d\x12\xc2\x70\x67\xea\x2b\xfa\xa6\xfb\xb5\x9b\x0b\xdb\x90\x0d\x75\x94\xbe\x9b\xc4\x5f\x65\xa1\x6b\x0b\x5d\xdb\xcf\xdb\xda\xcf\x53\xdd\x2c\x39\xb8\xba\x73\x7b\x99\xbf\xdf\x7f\xe8\x14\x61\x4b\x36\xfb\xdc\x08\xee\x78\x0c\x70\xef\xa3\x00\xf3\xc5\xe9\x91\x08\x5d\xdc\x4c\x84\xcf\x89\x6a\xdd\xac\xd6\xaa\xa1\x93\x2d\xab\x87\x78\xdc\x9e\xad\xa6\x93\x06\xe9\x6e\xb8\x5b\xcc\xff\xa3\x18\x4e\x52\xa7\x1c\xb5\xab\x66\x5c\x6d\xdd\x7e\x97\x82\x1d\x57\x31\x29\x68\xaf\x61\x5d\x0a\x36\x70\xa1\xd2\x9a\xea\xbe\x49\x9c\xe9\x4d\x0e\x07\x9a\xb3\xfd\x80\xe6\xc3\x50\xe4\x03\x60\x60\xcd\x5d\x7a\x98\xd7\xc3\xbc\x0c\xe6\xa5\x70\xe9\xad\x14\x3e\x28\x73\x67\x6c\xf6\x41\xfe\x3e\x14\x83\x9e\xa2\x6f\x0b\x50\xbe\x01\xc2\x51\x5c\x6a\x08\x39\xea\xef\x2e\x7c\x59\xbb\xfa\x03\x01\xa7\x7b\x44\xea\x91\xd3\x1f\x0e\x39\x45\xea\x6b\xe2\x8d\x1e\x36\x7d\x1d\xd8\x34\xb0\xa6\x33\x79\xc4\xa4\x05\xa3\xad\xe9\xb8\x32\x39\x56\xfe\x12\x22\x92\x9f\x56\xd6\xf8\x1d\x0b\x56\x7a\xcb\xf2\x43\x8f\x64\x75\x3a\x53\xfb\x78\x25\xd7\xd1\x4a\xce\x63\x95\xaa\xd5\x68\x88\x62\x46\x34\xe5\xe1\xa1\x87\x7f\x11\xc6\x8a\x97\x75\x6d\x23\xd4\xa0\xcd\xe7\xae\x27\xa4\xd1\x36\x96\xe2\x23\xf8\x7a\xc7\x73\xd2\xaa\xcf\x2e\x87\xb4\x8e\x1b\x7b\x2b\x45\x04\x7a\x09\x49\x5d\x0f\x79\x30\x2e\x28\xa7\xc5\xf1\x5c\xe9\x3d\xc9\x75\x9a\xbc\xd4\xa3\x24\xfe\xfc\x51\xdc\x3c\xbb\xc2\xce\x13\xd5\xae\xf0\xdd\x10\x95\x35\xcc\xae\x4e\xc5\x39\x65\x76\x5e\xcc\x8f\xce\x3a\xef\x7e\x67\x91\xc7\x05\xfe\xa5\x18\xda\x1e\x39\x4a\x98\xa6\x1d\xdc\x79\x66\xc3\xbc\x8b\xb9\x82\x51\xcd\x22\x82\x10\xfe\x4f\x02\x72\xfb\x65\x95\x50\x39\xb6\x15\xc7\x58\x42\x08\xed\xf7\x5a\xc6\x5f\x57\x34\xfe\x4d\xb2\x8b\x2d\xf7\x3b\x64\xcc\x2b\x8e\x3d\xa2\x26\x61\xea\x7c\xea\x9f\x85\x1c\xf6\xd0\x45\x70\xd8\x82\x9b\xf6\x6e\xa6\xbc\x2c\x65\xda\xb1\x88\x89\x82\xcb\x6c\xd0\x9d\x6f\x8d\xbe\x74\x98\xd0\x11\xe9\xf8\x6f\x34\x1d\xb1\xb3\x13\x7f\xb9\x23\x7e\xf6\x60\xfe\x0a\x81\xd5\x89\x04\xef\x73\xb6\x58\x04\x5f\x3e\xd6\xde\x8a\xe0\x21\x51\x66\x1f\x0e\xf7\xc0\xf8\xfa\xa2\xba\xf8\x8e\x43\xae\xfc\xfb\xc3\xc0\x72\xdd\x0c\x05\xd6\x23\xaa\x7a\xe7\x2d\x6e\x47\xd3\x7a\xbc\x14\xaf\xb4\xc5\x2d\x76\x0e\x13\x53\x7f\x05\xb2\x39\x58\xae\xf4\xeb\xe2\x4e\xc3\x06\x81\x78\xd6\x0c\x0f\xec\xd5\xca\xbc\xf9\x36\xea\xbe\xf9\x62\x2f\x6a\x5e\xcf\xac\x6b\xcf\x6e\x98\x8e\xed\x1e\xcd\x2c\x80\x27\xd6\xb5\x17\x54\x15\xb4\x66\x9f\x54\x57\xd7\x35\x3f\x3e\x68\x15\xf6\xa4\xc7\xf6\xa4\xf6\x2a\x26\x47\x76\x43\x33\xa6\xf0\x89\x75\x3d\x1d\xd7\xd7\xed\x34\xd1\x27\xc1\x9b\xa7\x19\x56\x77\x41\x17\x79\x30\xa1\x11\x7a\x55\x0b\x27\x34\x42\x2f\xcc\xc3\xfd\x8a\x23\x49\xdf\x1b\x62\x3e\x95\xfe\x1a\xde\xfd\xeb\x53\x45\x59\x57\x78\x6c\x80\x10\x42\x77\x83\xff\x0d\x00\x6a\x9f\x66\x88\x53\x56\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-home-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-home-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 13331,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x4f\x1b\x3b\x16\xff\x9f\x4f\x71\xe4\xde\xbd\x82\x55\x02\x99\x40\x60\x89\x54\xad\x28\xdc\xde\x76\x25\x7a\x59\xf1\xb8\xaa\x0a\x8a\xcc\xcc\x21\x33\xc5\x63\xcf\xda\x1e\x0a\x65\xf9\xee\x2b\x3b\x93\x79\x78\x26\x21\x49\xd3\x5e\xe8\xf2\x47\xdb\x8c\xcf\xf1\xeb\x3c\x7f\x3e\x76\x69\x12\x9d\xa1\x54\x91\xe0\x7d\x88\xb8\xc6\xa1\x44\xaa\xd9\xdd\xba\x90\xc3\x8d\x1b\x8f\xb2\x24\xa4\xde\xca\x75\xc4\x83\x3e\xfc\x2e\xe9\x15\xe5\xf4\x80\xaa\xf0\x52\x50\x19\xac\xc4\xa8\x69\x40\x35\xed\xaf\x00\x70\x1a\x63\x1f\xd4\x1d\x0f\x50\x45\xaa\x3d\x1a\x8a\xea\x48\x70\xd5\x0e\x45\x8c\xed\x20\xef\x06\xc0\xe8\x25\x32\x65\xba\x01\xd0\x24\x29\xfa\xd9\x96\xf1\xc7\x7a\x24\x36\xa6\x53\xf5\x5d\x82\x66\xd9\x57\x92\x2a\x2d\x53\x5f\xa7\x12\x57\x54\x82\xfe\x7c\x2b\x5a\xff\xac\x04\x5f\x01\x30\xff\xf4\xe1\xbf\x76\x9a\x7b\xfb\x37\x00\xa1\x9c\x0b\x3d\xea\x46\xfa\x79\x33\x00\x61\x91\xd2\xa4\x0f\x9f\xf2\x16\x28\x51\x2d\xc7\x65\x1a\x31\xfd\x9e\x93\x3e\x78\xad\x2a\xc5\x48\x4d\x89\x54\xfa\x48\xfa\x40\xda\xed\xb1\x6c\xa1\xdd\x26\x0e\x2b\x72\x7a\xc9\x0c\x9b\x96\x29\x3a\xb4\x30\x0a\x26\x50\x22\x5f\xf0\x7d\xc1\x84\x34\xe3\xcb\xe1\x25\x5d\xed\xb4\xa0\xeb\x79\x2d\xe8\xf6\x7a\x2d\xf0\xd6\xdc\x69\x8c\xb4\x0c\xef\x5e\xb1\x5d\xf8\x15\xf6\x18\x4a\xad\x5c\x5e\x23\x76\xc3\x9b\x0b\x90\x94\xe8\x0f\xf9\xef\x8b\xec\xd7\xc3\xb8\x3b\xc1\x20\xd2\x0d\xbb\x21\x43\x8e\xfa\x7d\x40\xfa\xc0\x53\xc6\x8a\x56\x49\x93\xf0\x44\x08\xa6\xa3\x84\xf4\xa1\x93\x13\x22\xc3\xda\xdd\x2c\xbe\x35\x8e\x14\x6b\x24\xdd\xdb\xee\x6d\xee\x76\x37\x77\x7a\x5d\x6f\x37\xe7\x60\x11\xbf\x56\x15\x65\x95\x55\x65\xa5\x65\x36\x84\xb7\x1a\x25\xa7\x0c\x0c\x7f\x65\xd7\x24\xe2\x3e\x4b\x03\x3c\xa3\x52\x35\x48\x9c\x5c\x23\x26\x27\x51\xdc\xa4\x0d\xa2\xe9\x50\x39\x86\x02\x40\xc6\x86\xe9\x0a\xb7\x6c\xa7\x65\xb9\x5e\x54\xc7\x74\x55\x50\xe2\x1d\x6b\x20\xef\x41\x12\xca\x91\x4d\xd9\xbf\x2f\x58\x1a\x5b\xfb\xfe\x54\x9d\xe6\x4a\x70\x7d\x1c\x7d\xb5\x53\x79\x9d\xce\xdf\xaa\x32\x19\xca\x28\x38\x12\x55\xb7\xb0\x84\x90\xf4\x61\xdb\xd9\xd7\x17\xa3\x9c\xae\xd3\x78\x5b\xd6\x6b\xd6\x76\x67\xda\xca\x06\x55\xd5\x83\xd1\xfd\x76\xaf\xd2\x96\x6b\xb7\xba\x78\x91\xe4\x5e\x5b\x1d\x23\xa1\x43\xcc\x76\x55\x36\x38\x4b\x53\xbe\x14\x8c\x91\x3e\x5c\x51\xa6\xaa\x7a\x54\xa1\xf8\xf2\x0e\x69\x80\xb2\x49\xcb\x4a\x48\x5d\x97\x84\x2f\x58\xc3\x16\x03\x54\x7e\x36\xc6\xc4\x8d\x2a\x7d\xc7\xb0\x6e\x37\xd5\xf1\x4d\x7c\x62\x11\x35\x6c\xc4\x9a\x5f\xcb\x25\x07\x54\xe3\x5b\x21\x63\x6a\x16\x47\x3e\x7e\xfc\xf8\xb1\x7d\x78\xd8\x3e\x38\x80\x77\xef\xfa\x71\xdc\x57\xae\xfd\x59\xf1\x68\xe3\x06\x93\xc7\x1c\x1b\x5f\x18\x05\x01\x72\x52\x21\x3f\xb4\x66\x5c\xee\xfb\xc2\xce\x21\x11\x41\x7d\x16\xdf\x84\xaf\x43\x11\x34\xa9\xa9\x60\xa8\x4b\xc8\xd2\x6c\xc8\xeb\x6e\xf5\x5a\xd0\xdb\x1a\xfd\xe9\xac\xef\xba\x41\xaf\xc4\xb9\xb9\xd3\x02\xaf\xbb\xdb\x82\xad\x8e\x61\xfd\xc7\x14\xde\x5e\xa7\x05\xde\x4e\xb7\x05\x66\xf4\xce\xfa\xee\xce\x1a\x71\x38\x2f\xea\x5a\x40\x3f\x8a\xa9\x75\x41\xaf\x53\xa3\xda\x68\xd3\x14\xc6\x33\x5a\x11\x03\xc9\xef\x02\xb4\x80\x7f\x9d\x1d\x42\x11\x7c\x1b\xfb\x9c\x4a\x56\x89\x0f\x1b\xc1\xe5\x46\x73\x2e\xfc\x7c\x13\xff\xf3\x86\xca\xb6\xc9\x00\x2a\xa1\x3e\xbe\xfe\x25\xff\xf9\xab\x21\x24\x22\x78\xfd\xcb\xfd\x60\xe0\x23\x63\x0f\x53\xcd\xa5\x51\x8f\x3a\x94\xa8\x42\xc1\x82\xba\x8b\x42\xd9\x9a\x94\x96\x11\x1f\xd6\xfb\xa7\x3c\xb2\xb6\x9b\xa0\xf4\x91\xeb\x05\xcd\x6d\xff\xe8\xf4\x27\x37\xb1\xf9\x1d\xbd\x64\x95\xdd\x1a\x31\xa6\x49\x12\xf1\xe1\xc9\x48\x3b\xde\x34\xb5\x9f\x51\x96\x36\x85\x89\x19\x15\xcf\xd3\xf8\x12\xe5\xbc\x8a\x9f\x9c\x1a\xa9\x1c\xa2\x9e\x21\x72\xe2\x6d\x62\xe1\x91\x89\xdc\xab\x5a\x24\xd7\xab\xbd\x16\xa8\x34\x5e\x95\x54\xe3\x6a\x22\x85\x8f\x4a\x0d\xfc\x24\x1d\x28\xf4\x05\x0f\xd4\x40\x0b\x4d\xd9\x7d\xe1\x28\xe7\xa4\x70\x95\x73\xd2\x82\xcf\xe2\xf2\xf5\x39\x69\x74\xb3\x73\xf2\xf0\xc9\x8b\x2f\xd6\xd6\xe0\xf2\x0e\x56\x13\x11\xac\xad\xd5\xf5\x4f\xae\x72\x05\x8e\x70\x52\x8d\x21\xe2\x4a\x53\xae\x27\x45\x0b\x33\xa3\xbc\xa1\xec\x2d\xf5\xb5\xc5\x7e\x75\xbd\x31\x1c\x22\x0f\x0a\x4b\xb9\xbf\x4f\x44\xf0\xd0\xe0\xd7\x12\xaf\x2c\x26\x23\x7b\x33\x4b\x3e\x8a\xf1\xad\x14\x71\x53\x5e\x35\xb4\xe3\x30\xba\xd2\xcd\x44\x6d\x41\x21\x39\x11\x09\xf4\x8c\x84\xf6\x8f\x4e\x21\x55\x74\x58\x15\x01\xd1\x92\x72\x65\x84\xd4\x2c\xa2\xdc\xa4\x46\xa4\x02\x11\xb5\x9e\x20\xee\xa9\x35\xce\x00\x7c\x7a\xdb\x2f\xc0\x67\xcc\xf7\x02\x7c\x9e\x43\x56\x9a\x96\x62\xe6\xc6\x3d\xfb\x34\x46\xb6\x3c\xe4\xe3\x9b\xe1\x9e\x05\xf6\x51\xa1\x71\xb2\xc5\xec\xed\x37\x29\x85\x04\x93\xd3\xa6\x9b\x1a\xb9\x69\x4e\xe3\x8f\x5a\x5b\x83\x5d\x2c\xc3\xdc\xea\x46\xfc\xec\x31\x50\x7d\xa7\x9d\xf5\x8e\xd7\x24\x01\xdb\xfe\xe8\x7e\x9f\x14\x82\x3a\xe7\x00\x19\x8a\x32\x3f\xc1\x42\xa9\xd1\x2f\xb0\xe6\xb7\x2a\xe4\x70\x40\x13\xea\x87\x38\xb0\xae\x37\xf8\xed\xd6\x0f\x29\x1f\xa2\x7a\x4b\x23\x86\xc1\xa2\xc8\xaa\x05\x46\x10\xaf\xcf\x89\x14\xa9\x46\x0b\xb5\x7a\xf1\xc5\xda\x78\xee\x8d\x19\x17\x71\xf2\x2d\xe8\x6e\xea\x1a\x0a\xd0\x07\x7f\x07\xaf\xd3\x31\xad\x6b\xe7\xfc\x05\x00\x16\x44\x17\x00\xc6\xa8\x0c\xfc\x03\x34\xd1\x4b\x3d\x3b\x14\xe8\x6d\x35\xc0\xc0\xae\xdb\x38\xa9\xfc\xb5\x3d\x11\x1c\x85\x51\x80\x06\x9b\xfc\x71\x83\x52\x8e\xaa\xbf\x75\xbc\x66\xa1\xe2\xd6\x0f\xa8\x91\xd5\xb1\xe0\xc2\x48\xb1\x56\x21\x1f\x21\x45\xbb\xb9\xe5\x41\xc5\x63\x4d\x75\xda\x90\x05\x96\x92\x07\x9f\x10\xea\x5a\x72\x1e\xcc\x80\x5a\xcd\xd2\x60\x91\x24\x09\xaf\xde\x34\x00\x29\x89\x0a\xe5\x4d\xb1\xea\xe6\xb9\x14\xe5\x91\x1e\x59\x65\x33\xc3\x63\xf9\xb6\x31\xd9\xce\x91\x69\x67\x03\x6a\x35\xb2\x35\xa9\x43\x9a\x34\xaf\xca\xb5\xd7\xd1\x8c\x78\x6b\x47\x3c\x3d\x6a\x58\xf2\x78\x48\x1b\x99\xdc\xd5\xd7\xb0\xe1\xa3\x73\x1c\xfc\xf1\xe7\x87\x47\x66\xe9\x34\xcc\xe2\x4a\x6d\x31\x7c\x7a\x38\x8a\xf4\x0a\x36\x40\xa1\xff\x93\x1f\x87\xfe\x62\x80\x0a\xaf\xf6\xbe\x5f\x9d\xee\x5b\x0e\x29\xff\x5f\x87\xe2\xef\x13\x9e\x27\x9f\xa3\x2d\x9e\x7f\xc3\xe8\x94\x28\xfe\x3c\xce\xdb\x73\xd8\xfb\xcb\x79\xfc\xe5\x3c\x0e\xaf\x0e\x9e\xf8\x91\xdc\x7e\x2e\x66\x47\xcd\x25\x72\x78\x09\x96\xcb\xb6\xa1\xfd\x1f\x7d\xb5\x35\xab\x05\xbc\x28\xde\xed\xb2\x24\xc5\x4f\xa8\xfe\xcf\xa8\xf4\xec\x76\x60\xae\xe4\xb1\x32\x41\x12\x0b\xd4\xe2\xd2\x78\x35\x4d\x16\xbe\xa4\x2c\x4a\x55\x0b\x14\xa7\xc2\x09\x15\x09\xf8\x3e\x95\xab\x29\x25\xab\x37\xf3\xb9\x54\x59\x78\x3f\xe6\xf6\xf7\xe9\x49\x37\x97\xdd\xfe\x37\xca\xee\xc7\x56\x58\x9f\x85\x48\xdd\x0a\xea\xf2\x44\xfa\x5d\x2a\xe7\xb0\xf1\x57\xd4\xca\x9f\x85\x26\x0f\x66\xaf\x85\x67\x25\xed\x3d\xc6\xa0\xf2\xa8\x73\x29\xb5\xec\x15\x67\x4e\xb3\x42\x93\x9e\x0c\xef\x66\xa7\x98\x84\x28\x3f\xc4\x98\x66\x6f\xbc\xcd\xde\xb6\x0b\x92\xbe\x63\xd9\x2b\x52\x59\x3c\x77\xad\xbf\x57\x6d\x7a\xab\xda\xf8\x4e\xb5\x58\x8d\xc6\x38\x61\x54\x9b\x43\xd4\x9c\x8f\xa6\x29\x63\x67\x59\xd1\xa9\x8e\x60\x88\x9f\x4a\x89\xbc\x5e\x45\x2e\x15\xb3\x26\x3c\xac\x2d\x17\xb3\x72\x96\x69\x5e\xe9\x3c\xd2\x3e\x92\x22\x46\x1d\x62\xad\x7c\x4c\x02\xbc\x8a\x78\x94\xbd\x3f\x26\xf6\x6d\xfb\xc0\x4e\xa5\x4c\x22\x9e\x9e\x15\x5a\x90\xbb\x4d\xed\x49\x76\x66\xd2\xee\x05\x41\xf6\x12\x79\xaf\xf9\xb1\x06\x64\x2b\x30\x4b\xf9\x30\x1e\xda\x1d\x39\x4e\x99\x8e\x26\xf4\x1e\x3f\x04\xe7\x93\x3a\x17\x57\x06\x2e\x06\x22\xff\x49\x51\xde\x2d\x57\x08\x85\x59\xbb\x37\x04\x12\x87\x78\xdb\x00\x03\x88\xba\x8e\x92\x53\xc9\x8e\xef\xb8\x3f\x61\x8f\xd9\x45\x84\x3b\xa2\xa6\x43\x6b\x7a\xea\xdf\xe3\x7d\xd4\x5e\xbe\x67\xae\xe1\x6e\xdc\xb4\x4f\xee\x94\xb9\xf0\x48\x3a\x0e\x31\x55\x78\x32\x1a\xb4\x76\xd5\x91\xff\xae\x3f\xa4\xd7\xa3\xa7\xe6\x25\xc7\xba\x1a\x5d\xbb\x11\x2e\xbe\xb4\x37\xc3\xb2\xa3\x6a\x91\xb5\x93\xc6\x61\x92\xc8\xbf\xb6\xd7\x35\xa5\xc1\x32\xa1\x0f\xc6\x81\xd1\x85\x9f\xa4\xe7\x44\x31\xaf\xe3\x34\x6c\xba\x0d\x5e\x5c\xfd\xee\x39\xdf\x9e\xdb\xb0\xd9\x71\x39\xc2\xea\x77\xd7\xf9\xf6\x4a\xff\x0d\xe1\xa2\xbc\xff\x28\xc6\x41\xc9\x68\xe7\x5a\x85\x3b\xe9\xb6\x3b\xa9\xbb\x8a\xee\x96\xdb\x50\x2d\x43\x91\x9d\xc0\xdd\x67\x79\xdd\x8d\x2a\xfa\x2a\x38\x56\x0c\xab\x48\x2e\xc7\x99\x4b\x41\x1b\x4a\x25\x54\xf3\xf9\x4e\x94\xce\x30\x24\xb5\x77\x82\xa4\x77\xad\xdb\x1f\xb6\xcf\xfe\xfc\x5a\x50\x6e\xf2\xcc\xd0\xb5\x4d\x0f\x2b\xff\x0b\x00\x00\xff\xff\xed\xf8\x51\xd1\x13\x34\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x7b\x6f\xdb\x38\x12\xff\xdf\x9f\x62\xc0\xdd\x5b\x24\x07\x39\xb1\x9c\x38\xbd\x1a\x28\x0e\x69\xb2\xdd\xf6\x80\x74\x7b\xc8\x63\x51\x34\x81\xc1\x48\x13\x5b\x0d\x45\xea\x48\x2a\x4d\x9a\xcb\x77\x3f\x50\x96\xf5\xa0\x28\x3b\x76\xd2\x5e\xb3\x1b\x38\xbb\x95\xc8\xe1\x6b\xe6\x37\xc3\x1f\x47\xa4\x49\x74\x82\x52\x45\x82\x0f\x21\xe2\x1a\xc7\x12\xa9\x66\x37\x1b\x42\x8e\x37\xaf\x7c\xca\x92\x09\xf5\x3b\x97\x11\x0f\x87\xf0\x9b\xa4\x17\x94\xd3\x7d\xaa\x26\xe7\x82\xca\xb0\x13\xa3\xa6\x21\xd5\x74\xd8\x01\xe0\x34\xc6\x21\xa8\x1b\x1e\xa2\x8a\x54\x77\xda\x15\xd5\x91\xe0\xaa\x3b\x11\x31\x76\xc3\xa2\x19\x00\xa3\xe7\xc8\x94\x69\x06\x40\x93\xa4\x6c\x97\x95\xcc\x5e\x36\x22\xb1\x39\xbf\x56\xdf\x24\x68\xa6\x7d\x21\xa9\xd2\x32\x0d\x74\x2a\xb1\xa3\x12\x0c\x96\x9b\xd1\xc6\x67\x25\x78\x07\xc0\xfc\x33\x84\xff\x66\x93\xb8\xcd\xfe\x0f\x40\x28\xe7\x42\x4f\x17\x42\x86\x45\x31\x00\x61\x91\xd2\x64\x08\x9f\x8a\x92\xb2\x51\x2e\x71\x9e\x46\x4c\xbf\xe3\x64\x08\xbe\x57\xaf\x31\x5a\x53\x22\x95\x01\x92\x21\x90\x6e\x77\xa6\x5b\xe8\x76\x89\x25\x8a\x9c\x9e\x33\x23\xa6\x65\x8a\x56\xdd\x24\x0a\x5b\x6a\xa2\x40\xf0\x3d\xc1\x84\x34\xfd\xcb\xf1\x39\x5d\xeb\x79\xd0\xf7\x7d\x0f\xfa\x83\x81\x07\xfe\xba\x3d\x8c\xd1\x96\x91\xdd\x2d\x97\x0b\xbf\xc0\x2e\x43\xa9\x95\x2d\x6b\xd4\x6e\x64\x0b\x05\x92\x4a\xfd\x5d\xf1\x7c\x96\x3f\xdd\xcd\x9a\x13\x0c\x23\xed\x58\x0d\x19\x73\xd4\xef\x42\x32\x04\x9e\x32\x56\x96\x4a\x9a\x4c\x8e\x84\x60\x3a\x4a\xc8\x10\x7a\x45\x45\x64\x44\xfb\x5b\xe5\xbb\xc6\xa9\x61\x8d\xa6\x07\x3b\x83\xad\x97\xfd\xad\x17\x83\xbe\xff\xb2\x90\x60\x11\xbf\x54\x35\x63\x55\x4d\x95\x69\xcb\x2c\x08\xaf\x35\x4a\x4e\x19\x18\xf9\xda\xaa\x49\xc4\x03\x96\x86\x78\x42\xa5\x72\x68\x9c\x5c\x22\x26\x47\x51\xec\xb2\x06\xd1\x74\x5c\x1f\xdb\xfc\xc8\x0c\x98\xb6\x72\xab\x38\xad\xea\xf5\xcc\xeb\xcc\x33\x41\x45\xf6\xae\x63\xb5\x20\x09\xe5\xc8\xe6\xac\x3f\x10\x2c\x8d\x33\x7c\x7f\xaa\x0f\x73\x21\xb8\x3e\x8c\xbe\x66\x43\xf9\xbd\xde\xdf\xea\x3a\x19\xcb\x28\xfc\x20\xea\x6e\x61\x7e\x64\x42\x86\xb0\x53\x15\x05\x20\x5f\x8c\x71\xfa\x56\xe1\x75\xd5\xae\x79\xd9\x8d\x29\xab\x14\x15\xf0\x29\x6d\xbf\x33\xa8\x95\x15\xd6\xad\x4f\x5e\x24\x85\xd7\xd6\xfb\x48\xe8\x18\xf3\x55\x55\x01\x67\x7e\x44\x05\x52\x30\x46\x86\x70\x41\x99\xaa\xdb\x51\x4d\xc4\x97\xb7\x48\x43\x94\x2e\x2b\x2b\x21\x75\x53\x13\x81\x60\x8e\x25\x86\xa8\x82\xbc\x8f\xd6\x85\x2a\x7d\xc3\xb0\x89\x9b\x7a\xff\x26\x3e\xb1\x88\x1a\x31\x92\xc1\xaf\x3e\x10\x18\x1f\xd5\xf8\x46\xc8\x98\x9a\xc9\x91\x8f\x1f\x3f\x7e\xec\x1e\x1c\x74\xf7\xf7\xe1\xed\xdb\x61\x1c\x0f\x95\x8d\xbf\x4c\x3d\xda\xb8\x41\x7b\x9f\x33\xf0\x4d\xa2\x30\x44\x5e\x05\xa9\xb5\x88\x79\xd3\x7d\x57\xe2\x1c\x12\x11\x36\x47\x09\x4c\xf8\x3a\x10\xa1\xcb\x4c\xa5\x40\x53\x43\xe6\x37\x0d\x79\xfd\xed\x81\x07\x83\xed\xe9\x7f\xbd\x8d\x97\x76\xd0\xab\x48\x6e\xbd\xf0\xc0\xef\xbf\xf4\x60\xbb\x67\x44\xff\x31\x47\x76\xd0\xf3\xc0\x7f\xd1\xf7\xc0\xf4\xde\xdb\x78\xf9\x62\xbd\xae\x01\xcb\x55\x73\x83\x07\x51\x4c\x33\x17\xf4\x2d\x30\xe4\xf8\x75\x40\xaa\xa8\x2b\x63\x20\xf9\x4d\x80\x16\xf0\xaf\x93\x03\x28\x83\xaf\xb3\xcd\xb1\x64\xb5\xf8\xb0\x19\x9e\x6f\xba\xf7\xc2\xcf\x57\xf1\x3f\xaf\xa8\xec\x9a\x1d\x40\x25\x34\xc0\x57\x3f\x17\x8f\xbf\x98\x8a\x44\x84\xaf\x7e\xbe\x1d\x8d\x02\x64\xec\x6e\x2e\x5c\x9c\x76\xd4\x13\x89\x6a\x22\x58\xd8\x74\xd1\x1a\x9a\x94\x96\x11\x1f\x37\xdb\xa7\x3c\xca\xb0\x9b\xa0\x0c\x90\xeb\x15\xe1\xb6\xf7\xe1\xf8\x4f\x0e\xb1\xe5\x1d\xbd\x82\x4a\x2b\x30\x03\x90\x98\x26\x49\xc4\xc7\x47\x53\xeb\xf8\xf3\xcc\x7e\x42\x59\x8a\xab\x1b\x9e\xa7\xf1\x39\xca\x65\x0d\xdf\x69\x51\x06\xd1\x54\x8e\x51\x37\x8d\xd6\xc0\x06\x5e\x27\x26\x92\x67\x91\x7b\x4d\x8b\xe4\x72\x6d\xe0\x81\x4a\xe3\x35\x49\x35\xae\x25\x52\x04\xa8\xd4\x28\x48\xd2\x91\xc2\x40\xf0\x50\x8d\xb4\xd0\x94\xdd\x96\x8e\x72\x4a\x4a\x57\x39\x25\x1e\x7c\x16\xe7\xaf\x4e\x89\xd3\xcd\x4e\xc9\xdd\x27\x3f\x3e\x5b\x5f\x87\xf3\x1b\x58\x4b\x44\xb8\xbe\xde\xb4\x3f\xb9\x28\x0c\x38\xe5\x49\x0d\x81\x88\x2b\x4d\xb9\x6e\x8b\x16\x66\x44\x79\x45\xd9\x1b\x1a\x68\x21\x9d\x76\x63\x38\x46\x1e\x96\x48\xb9\xbd\x4d\x44\x78\xe7\xf0\x6b\x89\x17\x19\x27\x23\xbb\xf7\xd6\x7c\x14\xe3\x1b\x29\x62\x87\x37\x11\x1d\xc5\x78\x38\x89\x2e\xb4\xbb\x52\x67\xa4\x90\x1c\x89\x04\x06\x46\x43\x7b\x1f\x8e\x21\x55\x74\x5c\x57\x01\xd1\x92\x72\x65\x94\xe4\x56\x51\x01\xa9\x69\x55\xc7\x11\x25\xaa\x28\xf8\xff\xf2\x1e\xbf\xbf\x3c\xf1\x19\xd4\x7a\x7f\x26\x3e\xcf\xc4\xe7\xe9\x10\x9f\xbe\x93\xa7\x38\x10\xd5\xce\x7b\xf6\x68\x8c\xec\xf1\x98\x4f\x60\xba\x7b\x12\xdc\x47\x4d\xcc\xe9\x62\x35\xbc\xfd\x2a\xa5\x90\x60\xf6\xb4\xf9\x50\x23\x57\xee\x6d\x7c\x21\xda\x1c\xb8\xf0\x5a\x44\x97\x81\x5b\x13\xc4\x8b\xd1\xf6\x83\x73\x20\xab\x16\x80\xf4\x36\x7a\x3e\xf1\x5a\xca\x17\xae\xf7\x87\x62\x50\xa7\x1c\x20\x67\x51\xe6\x11\x32\x2a\x35\x7d\x82\x0c\x7e\x6b\x42\x8e\x47\x34\xa1\xc1\x04\x47\x99\xeb\x8d\x7e\xbd\x0e\x26\x94\x8f\x51\xbd\xa1\x11\xc3\x70\x55\x66\xe5\x81\x51\xc4\xab\x53\x22\x45\xaa\x31\xa3\x5a\x83\xf8\x6c\x7d\x36\xf6\xe6\x3d\x27\x71\xf4\x10\x76\x37\x77\x0e\x25\xe9\x83\xbf\x83\xdf\xeb\x99\x09\xad\x9f\xf2\x67\x02\xd8\x4e\x00\x63\x54\x86\xfe\x01\x9a\xe8\xa5\x9e\x1c\x0b\xf4\xb7\x1d\x34\xb0\xbf\x7d\xcf\xf4\xd7\x4e\xa7\x25\xce\x1b\xe6\x81\x26\x29\xf3\xfb\x15\x4a\x39\xcd\xfe\x36\xf9\x5a\x96\x1f\xdd\xfe\x0e\x39\x32\x1b\x82\x0f\x60\x8a\xbe\x9b\x29\x66\x8b\x6b\xd5\xc6\xd2\x54\xf1\x50\x53\x9d\xaa\x6f\xb4\x0f\xfe\x40\xac\xeb\x91\xf7\xc1\x9c\xa8\x35\x90\xb6\xd2\x26\x09\x3f\xbd\x6e\x0e\x9f\x48\x54\x28\xaf\xca\x59\xbb\xc7\x52\x94\x47\x7a\xea\x91\x6e\x81\x45\xfb\xad\x73\xb3\x5d\x62\xa7\xbd\x1f\x51\x6b\x54\x67\x90\x3a\xa0\x89\x7b\x56\x36\x5e\xcd\x8f\x68\xbc\x36\x7a\x20\xc7\x1f\x1a\xdd\x95\x5d\x92\xa1\x63\xf6\x96\x97\xdc\x63\x8c\xfd\xdf\xff\x78\xbf\x60\x94\x9e\x63\x14\xab\xe4\xac\x53\xab\xf5\x3a\xf3\xc6\x2f\x7d\xf2\x60\x1a\xe9\x15\x6c\x82\xc2\x60\xbe\x6f\xda\xa1\xe8\xaf\xee\x98\xcb\xfb\xde\x2e\x59\xe0\x33\xab\xb3\xcc\x87\x1c\x52\xfe\x5a\x87\xe2\x6f\x13\x9e\xed\xfd\xb6\xa8\x3b\xca\xf8\xfc\x6b\x46\xe7\x44\xf1\xa7\x71\xde\x5e\x02\xef\xcf\xe7\xf1\xe7\xf3\x38\xfc\xb4\xbf\x08\x03\x2d\x47\x6f\xaf\xa5\x7c\xe1\x92\x97\x3c\x92\x67\xaf\xab\xe1\xc8\x9d\x22\x7f\x0e\x96\x0b\x82\xe5\xf2\x18\xda\x5b\x84\xa1\x07\xa3\x60\x45\x04\x3c\x1b\xfe\x1b\x19\xbe\x25\xfb\x7f\x4f\xa3\xe7\x5f\x07\x5a\x8d\xee\xda\x3c\x3a\x2d\x9a\x58\x21\x17\x97\xc6\x6b\x69\xb2\x6a\x1a\xeb\xae\x4c\x55\xad\x90\x9c\xca\xef\xa3\xb9\x19\xc6\xe3\x67\xae\xe6\xa4\xac\x5e\x5b\xfa\xf5\xee\xaf\xbc\xef\xf3\xf5\xf7\xc7\xd3\x6e\xa1\xbb\xbd\x07\xea\xee\xfb\x66\x58\x9f\x84\x4a\xed\x0c\xea\xe3\xa9\xf4\x9b\x64\xce\x61\x13\x9e\x2d\xe9\xb6\xe4\xbe\x65\xc9\xf6\xc0\x3d\x4b\x69\xef\x32\x06\xb5\x4b\x9d\x8f\x92\xcb\xee\x58\x63\x9a\x6c\xbd\xd9\x9e\x4c\x37\x5b\xbd\x72\x10\xa2\x82\x09\xc6\x34\xbf\xe3\x6d\x12\x74\xc5\x15\x82\x69\xfa\xd4\x34\x08\xa9\x2c\xaf\xbb\x36\xef\xab\x16\x76\x2c\x64\x72\xa5\x15\x4b\x6a\xcc\x46\x63\x9c\x30\xaa\xcd\x21\x6a\xc9\x4b\xd3\x94\xb1\x93\x3c\xe9\xd4\x64\x30\x24\x48\xa5\x44\xde\xcc\x22\x57\x92\x59\x8e\xc9\xda\xc9\xac\x42\x64\x9e\x57\x5a\x97\xb4\x3f\x48\x11\xa3\x9e\x60\x23\x7d\x4c\x42\xbc\x88\x4c\x5a\x72\x7a\x87\x38\xbb\xdb\x3e\xca\xce\x6e\xca\x6c\xc4\x73\x3d\xe1\xce\x83\xc2\x6d\x6c\xd4\xcf\x20\x6d\x7f\x20\xc8\x6f\x22\xef\xba\x2f\x6b\x40\x3e\x03\x33\x95\xf7\xb3\xae\xed\x9e\xe3\x94\xe9\xa8\xa5\xf5\xec\x22\x38\x6f\x6b\x5c\x7e\x32\xb0\x39\x10\xf9\x4f\x8a\xf2\xe6\x71\x95\x50\xc2\xda\x72\x4a\x22\x71\x8c\xd7\x0e\x1a\x40\xd4\x65\x94\x1c\x4b\x76\x78\xc3\x8b\x2f\x08\xb6\x84\x21\x60\xcd\x1e\x35\x1d\x67\xd0\x53\xff\x9e\xad\xc3\xee\x7a\xe6\x1a\xf6\xc2\x4d\x79\x7b\xa3\xdc\x85\xa7\xda\xb1\x2a\x53\x85\x47\xd3\x4e\x1b\x9f\x3a\x3a\x76\x62\xb5\x00\x68\xf6\x31\xad\xee\x58\x17\xd3\xcf\x6e\x84\x8b\x2f\xdd\xad\x49\x65\x14\xa2\x45\x5e\x4e\x9c\xdd\x24\x51\x70\x89\xb2\xde\x59\xae\xf4\xd1\x2c\x30\xda\xf4\x93\x0c\xea\x4e\x40\xfc\x4a\xc4\x31\x7f\xb5\x10\x64\xfe\x88\x1f\xd7\xdf\x07\xd6\xbb\x6f\x17\x6c\xf5\x6c\x89\xea\xaa\x00\x48\xdf\x7a\xf7\xc3\x32\x3c\x56\xec\x93\xe9\x6a\x54\x01\xed\x52\xb3\xb0\x07\xdd\xb1\x07\xb5\x67\xd1\xdf\xb6\x0b\xea\x69\x28\xf2\xc2\x7a\xdf\xea\x55\xe7\xed\x34\xd1\x57\xc1\xb1\x06\xac\x72\x73\x39\xcc\x5d\x0a\xba\x50\x49\xa1\x2a\xe8\xc2\x5b\x51\x39\xc3\x90\x34\xfb\x26\x48\x06\x97\xba\xfb\x7e\xe7\xe4\x8f\xaf\x65\xcd\x55\xb1\x33\xf4\x3b\x00\x00\x77\x9d\xff\x0d\x00\xed\xf8\x51\xd1\x13\x34\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-jvm-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-jvm-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 73951,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x6b\x6f\xdb\x3a\x9a\xc7\xdf\xf7\x53\x10\xc2\xee\xa2\x1d\xc4\xad\xe5\x4b\x6e\x40\x5f\xf4\xa4\xdb\x99\x33\x68\xce\x76\x26\x3d\xdd\x17\x6d\x61\x30\x12\x2d\xb3\x91\x44\x2d\x49\xb9\xce\x74\xf3\xdd\x07\xa4\x2c\x59\x17\xca\x92\x1d\xc7\x97\xf8\x31\x30\x73\x1a\x51\xa4\xc4\x87\x7c\x1e\xfe\xfe\x14\x29\xe1\x88\x7e\x21\x5c\x50\x16\x5e\x22\x1a\x4a\xe2\x71\x82\xa5\x7f\xff\x9a\x71\xef\xcd\xd4\xc6\x7e\x34\xc1\xf6\x8b\x3b\x1a\xba\x97\xe8\xaf\x1c\x8f\x71\x88\xdf\x63\x31\xb9\x65\x98\xbb\x2f\x02\x22\xb1\x8b\x25\xbe\x7c\x81\x50\x88\x03\x72\x89\xc4\x7d\xe8\x12\x41\x45\x27\x29\x0a\x4b\xca\x42\xd1\xf9\x31\x0d\x3a\x6e\x96\x0b\x21\x1f\xdf\x12\x5f\xa8\x5c\x08\xe1\x28\x5a\x64\xd3\x47\xd2\x3f\x5e\x53\xf6\x66\x79\xaa\xbc\x8f\x88\xba\xeb\x31\xc7\x42\xf2\xd8\x91\x31\x27\x2f\x44\x44\x9c\x95\x6e\xe8\xf5\x0f\xc1\xc2\x17\x08\xa9\xff\x5c\xa2\xff\xd7\x57\xf9\xa5\xff\x1f\x21\x0b\x87\x21\x93\x49\x2e\xeb\x32\x3b\x8c\x90\xe5\x53\x21\xad\x4b\xf4\x35\x3b\x82\x72\xa9\xfa\x8c\xdb\x98\xfa\xf2\xf7\xd0\xba\x44\xf6\x49\x31\x45\xd9\x4c\xb0\x98\x3b\xc4\xba\x44\x56\xa7\x93\x5a\x16\x75\x3a\x56\xe9\x54\x12\xe2\x5b\x5f\x9d\x26\x79\x4c\x4a\x69\x13\xea\xd6\xa4\x50\x87\x85\x57\xcc\x67\x5c\x95\xcf\xbd\x5b\xfc\xb2\x7b\x82\x7a\xb6\x7d\x82\x7a\xc3\xe1\x09\xb2\x5f\x95\x2f\xe3\xd3\x80\xaa\xea\xd8\xdd\x6e\x29\x45\x99\x51\x95\xf2\x6e\x61\x08\xf4\x5f\xe8\x9d\x4f\xb8\x14\xe5\x52\xc4\x84\xfd\xd4\x15\x2e\x17\xa2\x1a\x4a\x15\x92\xd9\xdc\xca\xa5\x3f\x64\xff\xfe\x3e\xff\xd7\x43\x9a\xdd\x72\x89\x70\x38\x8d\xd4\x65\x55\xfe\xec\x82\x16\x71\xa9\x34\x58\xc6\xf2\x42\x22\x7f\x77\xad\x4b\x34\x38\xeb\xda\x8b\xa3\x1c\x47\x93\xcf\x8c\xf9\x92\x46\xf9\xf6\xb0\xa8\x3a\xb5\x37\x5c\xfc\x2d\x49\xd2\x47\xd4\x59\xc3\xd3\xde\x69\x77\x70\x6a\x9f\x0d\x4f\x2f\xb2\x33\x7c\x1a\xde\x89\x42\xc3\xe7\x9b\x5d\x5b\x5e\xdd\x29\x99\x49\xc2\x43\xec\x23\x75\x7e\xc1\x4e\x16\x0d\x1d\x3f\x76\xc9\x17\xcc\x85\xa1\xf5\xac\x3b\x42\xa2\xcf\x34\x30\xb5\xac\x25\xb1\x27\x4a\x9d\x4e\x99\x7d\xde\xc7\xcb\xcd\x91\xef\xf2\x79\x83\x7f\x2f\x96\x59\x6e\x9b\xdc\xb9\x69\xd3\x64\x39\xac\x08\x87\xc4\x5f\x52\x7f\x87\xf9\x3e\x8e\x04\x51\x76\x1d\x63\x5f\x14\xef\xdf\xe3\xd4\xfd\xc4\x8a\x9e\xa4\x13\x26\x06\x2f\xf9\xa9\x9a\x66\x50\x3a\x38\x33\xf4\xae\x7b\x75\x2c\xdf\xa1\x8a\xe6\x56\xb7\x62\x2f\xda\xb8\x54\x8d\xa2\x31\x38\x89\x08\x56\x8e\x10\xc6\xbe\x5f\x34\x13\x95\xba\xb7\x59\xff\x88\xa9\x73\x87\x3e\x60\xa7\xd4\xff\x33\x43\x72\xf6\x33\x67\xc1\x93\x1a\x3b\x61\x67\x42\x54\x33\xb3\xd8\x78\x39\x47\x79\xef\x6f\xd8\xb9\xf3\x38\x8b\x43\xb3\x35\xf5\x39\x5f\xb0\x1f\x1b\xfb\x8a\x4e\x35\xf4\x16\x1d\x0e\x7a\x83\xe1\x09\x1a\x0e\x92\xff\x75\x5f\x5f\x54\x02\x42\x72\x56\xff\xec\x04\xd9\xbd\x8b\x13\x34\xe8\xaa\xd3\xce\x6b\xce\x1b\x76\x4f\x90\x7d\xd6\x3b\x41\xaa\xd4\xee\xeb\x8b\xb3\x57\xf5\xdd\xad\x18\xfb\x3e\x71\x16\x10\x39\x21\x71\xc9\x96\x2e\x71\x68\x80\x75\x0b\x15\xba\x45\x9d\xdf\x27\x69\x9c\xeb\x88\x57\xb5\xd4\x98\xf1\x40\x37\xab\x55\xba\x8e\x87\x63\x8f\x54\xbb\x63\x80\x67\xa9\x5d\xab\x11\x31\xa0\x61\x9a\x58\x4e\x52\x01\xd0\x74\x03\xaa\x77\x4c\x38\x11\x13\xe6\xbb\x1f\xf5\xe0\xd7\x74\xd6\x35\xe6\x77\x24\x0b\x10\xb5\x9d\x7b\xa9\x43\xf5\x0d\x0e\x75\xda\xd2\x9f\xec\xda\x4b\x4e\x08\xf5\x26\xb2\x10\x8d\x51\xe6\x67\xa7\xfd\x52\xa8\x93\x84\x4f\xb1\x6f\xea\xe1\x59\x20\x2d\xf6\x8f\x00\x47\x11\x0d\xbd\xcf\x89\x33\xd9\x75\x69\xd5\x8e\x5d\x34\x40\x6e\xe4\x9a\xaa\xc6\x42\x92\x21\x49\x66\xb2\xd4\x7f\xd1\x3c\xb9\x54\xe3\x52\x9d\x97\x14\xce\x71\xe8\xb5\x28\xbc\x57\x2c\xbc\xd6\x3b\x02\x3c\x7b\x8f\x25\xfe\xc4\x68\x28\x45\xb5\xf7\x59\xca\x8a\x3a\xf1\x9a\xe9\xb1\xdf\x72\x58\x18\x12\x47\x12\xd7\xaa\x9c\xf7\x59\xdd\x91\xc1\xf0\x2c\xca\x70\xa6\xd8\xb2\x11\x13\x72\x4c\x67\xd5\xa6\x9d\x27\x7c\x60\xa1\xbc\xa1\xff\xd2\xd7\x1d\x76\xff\xb3\x74\x0e\x27\xe6\xbc\xfa\x78\x3e\xeb\x59\x39\xab\xb6\xe1\x35\x8e\x5a\xb4\xe9\x98\xb3\x40\x95\xa1\x2a\x55\xb5\xb6\x4c\x6a\x6c\xfd\xf1\xe6\x9d\x21\x91\x65\x19\x5b\x36\x86\x88\x30\xbf\xf3\x69\x68\x08\x10\x63\xea\xfb\x45\xc0\xea\xdb\x27\xc8\xb6\xcf\x4f\x90\x7d\x7e\xa1\x82\xa0\x7d\x5e\x89\x95\x63\x75\x6d\xb3\xdf\xab\xab\xe4\xcb\x4b\x8a\xeb\xa9\xb8\x7a\xd1\xaf\x14\x94\x0f\x33\xb5\x7e\xaa\xc3\xe4\x15\xf3\xe3\x20\xac\xb6\x8a\xc4\xdc\x23\xb2\x85\xc1\xc9\x2c\xd2\xf7\x24\x69\x40\x5e\xbe\x42\x1d\x14\x71\xe6\x10\x21\x46\x42\x62\x2e\x47\xea\xf0\x48\x10\x87\x85\xae\xf8\xa5\x7c\x42\x44\xd8\x21\x6f\xbf\x59\xff\x91\xfd\xf1\xcd\x3a\x41\x11\x73\xd5\xb1\x88\xb9\xdf\xac\x87\x6a\xd3\x2c\xc2\xf3\xbc\x3c\x4e\x49\x99\x66\x74\x34\x11\x12\x87\xd2\x88\xbc\xb9\x60\xa3\x86\x66\x6d\xc8\x5e\xe5\x14\x9f\x78\x24\x74\x3f\x64\x97\xab\x5e\x23\x20\x92\x53\xc7\x9c\xc6\xc9\x58\xa3\xa5\x65\xe8\x5d\x42\x12\x4d\x96\x83\x41\xb7\xdb\xb2\x7b\x65\xb1\x5e\x18\xda\x27\xa5\x8d\x3f\x23\x65\x12\x33\x68\x08\x1a\x7a\x3e\x11\x12\x17\x03\x4f\x12\x74\xf2\x1e\x77\x5e\xf6\x38\x7d\x46\x3b\x8f\x63\xaa\x5a\xd6\xdb\x15\x9d\x2d\x8d\x7b\x2b\xf9\x9b\xce\xf4\xc7\x3c\xb0\x3a\x31\xe7\x24\x94\x40\x52\x9b\x23\xa9\x8a\x69\x1e\x0b\x53\x2e\x96\xda\xf2\xef\xc4\xef\x82\x01\x58\x15\x8e\xcd\x0c\xc7\x1e\x01\x56\x17\x3d\x00\x2b\x53\xe1\x00\x56\x00\x56\x07\x0d\x56\x8f\xe7\x29\xf4\x17\xd5\x9b\xbb\x80\x55\xf3\x16\x68\x83\x55\x37\xca\xda\x68\x03\x68\x55\xf1\x39\x40\xab\x8d\xa1\x95\x01\x86\x1e\xc3\x56\x55\x52\xdb\x1c\x5a\xf5\x36\xca\x55\x11\xe1\x8e\x6a\x20\x20\xaa\xfc\xb1\x99\x9e\xc7\x5d\x09\xa9\x92\x39\xa9\x21\xa0\x93\xa9\x70\x40\x27\x40\xa7\x83\x46\x27\x11\x07\x2f\x7f\x4c\x83\x51\x40\x02\xc6\xef\x47\xb7\xf7\x92\x88\x51\x2c\x88\xdb\x92\x9c\x4e\x10\xe6\x04\xbf\xfd\x66\x4d\x08\x8e\xbe\x59\x0f\xaf\xfe\x62\x77\xbb\x6f\x8c\xa5\x06\x78\xb6\x6e\xa1\x8f\xe0\xb2\x0d\x80\xd7\x93\xc2\xd5\x59\xf7\xe4\xa2\x5b\x43\x58\x7f\x23\x38\x42\xaa\x31\x60\xee\x0a\x00\x0b\x00\xab\x90\xb4\xcf\x80\x75\xbe\x3a\x60\x9d\x6d\x18\xb0\x7a\x00\x58\x00\x58\xb9\x53\x9f\x02\xb0\x1a\x5a\x3a\xbd\x64\xe7\xa2\xe1\xb7\xd6\xfd\x74\x81\xf6\x76\x48\x7b\x21\x0b\x9f\x04\xf8\x72\xe5\x6e\x60\x2e\xce\xd0\x46\xc5\xd8\x6a\x80\xbd\xc3\x26\xc6\x3f\x58\xd8\x79\x9e\xd4\xd8\x10\x6c\x1a\x2f\x38\x5b\x72\xb9\x27\x06\xd4\x1d\x2d\xe7\x1b\x2c\x27\x0e\xbb\x77\x56\x1c\x6f\xd6\x5f\xce\xf7\xf7\x2f\xd7\xe8\x5a\x7b\xfe\x23\x56\xf3\x61\x9f\x62\x71\x95\xa2\x76\x69\x84\xbd\x4d\x16\x7a\x56\xcd\xe7\x62\x31\xf9\x48\x42\x4f\x6a\x73\x75\x2b\x69\xa4\x2e\x5b\x1b\x76\x5e\x13\x91\xa9\x1e\x20\xec\x4a\x33\x57\xdb\xd8\x27\x63\xf9\x91\x79\xbf\x61\x51\x99\x14\x9b\xa7\x5e\xe3\x99\xc1\xf4\x69\x22\x0d\xcd\x89\x9c\x7a\x93\x25\x05\xeb\xe4\xda\x92\x93\xd4\xac\xe8\xf5\x58\xf9\xcc\xd0\x73\x2b\x13\x8f\x75\x3d\x77\xb8\xbc\xe7\x16\x3d\x60\x1e\x8e\xab\xb7\x81\x7d\xea\x85\xef\xc4\xe7\x79\x0b\x9a\x06\x6c\x3c\xf5\x6a\x52\x52\xef\x36\x2e\x0d\x0f\xb4\xe9\x4c\x09\xda\x6a\xa6\xf2\xe6\x83\xbd\x21\x8f\x64\x12\xd7\x01\x85\x0e\x38\x8d\xa2\x45\x61\x87\x71\x19\xb4\x4a\xf8\x49\x5d\x59\x09\x25\x75\x1a\xa2\x42\xc9\x15\x5c\x5c\x02\xc1\x89\x1e\xc4\x9e\xd1\xd8\x56\xa4\x8a\xe5\xd8\xa5\xb1\xca\x3b\xac\xa6\x99\x1d\x95\x93\xd0\x25\x9c\x68\x7a\x19\xfb\xac\x34\x66\x25\x08\xf0\x3f\x53\xc2\x39\x75\x89\xa1\x42\x9a\x36\xea\xe2\x83\x90\xd8\xb9\x33\x5e\x55\x8d\xd1\x11\x71\x3f\x26\xd4\x58\x4d\xdf\xfd\x34\xdb\x36\x67\xc4\x2a\x2c\xa1\xcf\x7a\xe4\xe3\xc8\x5e\x05\x7f\x1a\x46\xf8\xa5\xa6\x74\x58\x10\x50\x29\x0f\xc3\x9e\xd9\xcd\x2e\x31\xdc\x6f\x5b\x32\xdc\x81\x4c\xca\xaa\x88\x5b\x6f\xac\xab\x15\x8c\xd5\x0e\xb2\x4b\x61\x44\x55\xe7\x43\x22\x67\x0d\x14\x14\x90\x7f\x12\x6f\x1e\x14\x0d\x19\x6f\x26\x74\xdc\xcc\x4f\x8a\xda\x4b\xf4\x94\xed\xb5\x29\xcf\xa6\x89\x7f\x12\xc1\xfc\x78\xbe\xc7\xc6\x3c\xdc\x60\xae\x81\xd3\x30\xe0\x08\xc6\xa5\x61\xe0\xd5\x63\xcd\x28\x45\x36\x27\x0e\x62\x1f\x4b\x3a\x25\x56\xed\xb8\x93\x9e\xab\x77\x06\x15\xef\x7d\xd6\xc1\x33\x6a\x1c\x93\x66\xf3\x84\xca\x46\x2f\xe7\x2e\x89\xa8\x06\x22\x09\xe6\xa3\x51\x65\xad\xc1\x62\x3e\xc9\x90\xab\x7e\xc8\xcd\x86\xd5\xaf\xdf\x6b\x2b\x77\x5f\x5b\x83\xfb\x51\xd2\xcf\x0d\x33\xc2\x81\x76\x2a\x83\xd0\xe7\xb2\x7e\x1e\xf7\x1e\xcf\xda\xcc\xca\x2d\x9c\xcb\x74\x11\xe5\x33\xf8\x96\x98\x26\x0c\x93\xc4\x3a\x18\xcc\x70\xc6\x98\x2d\x01\x9a\x6e\xd5\xbd\x16\xb6\x5d\x29\x16\xe5\x36\x91\x68\xa3\x6c\xb1\x12\xc6\xa4\xda\x7a\x2c\x6b\x2d\x53\xff\xd5\xb0\x59\x47\x99\x2a\xed\x23\x99\x66\x15\x7b\x61\xba\x10\xe8\xa2\xc5\x51\xd0\x45\xe6\x55\x1a\x4d\xc2\x68\xd8\x4e\x18\x81\xea\x01\xd5\x73\x50\xaa\x67\x33\xd3\xc2\x1b\x9e\xf6\x7d\xce\xb2\x68\x93\x06\x3f\x1e\x65\xb4\x23\xab\x3d\x7f\x71\x94\x3e\xd6\x00\x81\x04\x02\x29\xfd\x81\x40\x02\x81\xa4\x73\xef\xea\xf9\x9a\xdd\x5b\x4e\xe3\x76\xef\xbc\xc8\x7b\x8f\x7c\xc0\x46\x85\x03\x8f\xd7\x40\x46\xd6\xca\xc8\xf2\xaa\xb3\xda\x7e\xdb\x6f\xe8\xb7\xdd\x53\x90\x91\x20\x23\xf7\x47\x46\x72\x2c\xc9\xcb\x74\x8f\x9f\x13\xc5\xe9\xe6\xbe\x91\x6e\xfd\xb6\x5b\xfc\xbe\xda\xc1\xf7\xf5\xb1\x7c\xfe\x9e\xb0\xe5\x2b\x8a\x32\x66\xaf\x8e\xbe\x65\x66\x9f\x57\x67\x0b\xfa\x71\x1f\x99\xfe\xea\xd3\x9f\x80\xf2\x07\x8e\xf2\x26\x3e\xdd\x10\xc9\xd7\xbd\xb9\x0a\x21\xd3\x0a\xf4\x38\xa4\x4b\x38\xd9\xe0\x46\xcd\x94\x6c\xd9\x06\xc7\x04\xd2\xdf\x35\xe9\x03\xc3\x1e\x23\xc3\x1a\x77\x53\x34\x30\x6c\xbf\x07\x08\x0b\x08\xbb\x3f\x08\xfb\x63\x1a\x8c\x14\x6f\x61\x57\x8c\xe6\x5d\x6b\x0b\xaf\xfa\x5a\x79\x26\xd9\x57\x0c\xf4\xf4\x48\xda\x30\x32\x9a\x8c\xe6\x62\x12\xb0\x70\x1f\x6d\x96\xdc\xd9\x9a\x56\x7b\x82\x87\x19\x79\xab\x45\x04\xdf\xed\xa3\xcd\xd4\x7d\x3d\xe3\x47\x16\x9f\x13\xf3\x83\xc4\x01\x89\x33\xff\x2d\x93\x38\x55\x49\xb1\x43\x69\x00\xfa\x66\x91\x03\xf4\x0d\xe8\x9b\x2d\xe8\x1b\xdb\xf8\x8a\xc3\x06\x81\x73\x6a\x83\xc0\x01\x81\xb3\x3f\x02\x27\x9d\x9e\x67\x11\x09\x47\xe3\x2d\xbc\xc8\x78\xb5\x09\xf9\x66\x24\x55\x37\xbe\x3f\xd2\x27\x35\x67\x80\x67\x07\x69\x4d\xe3\x92\xa4\x8d\x2b\xa2\x7d\x64\xff\x0f\xd4\x27\xe8\xfd\xfc\x93\x2b\x6a\x20\x07\x11\x00\x22\x20\xf9\xed\x48\x04\x54\x8b\x06\x15\xb0\xcf\x2a\x60\x57\xeb\x99\x7a\x4d\xdf\xff\xe9\xb7\xfb\xfe\x8f\xe1\xb5\x00\xe8\x13\x63\xbe\x40\x2f\xff\x46\x70\xf4\x0a\x16\x31\x81\x40\x7a\xf4\x22\xa6\x5e\xc3\xfb\xb4\xec\xfe\x59\x3b\x81\xb4\x4f\x2f\x09\xd0\x56\xbd\xa9\x45\x31\xd0\x58\xc7\xad\xb1\x72\x5b\x14\x22\xc6\xfc\x75\xf6\xd3\xa8\x7c\x6f\xbf\x59\x9f\x6e\xd0\x7f\xbb\x24\x44\x37\xc9\x59\x5b\x9d\xfb\xdf\xaf\x6d\x33\x66\x9b\xae\xbe\x6f\x66\x0f\x0c\xbb\xa3\xcd\x33\x66\x0b\xae\xb2\x7b\x66\x0f\x6c\xf7\xbc\xb7\xd0\x14\x2c\x0b\x82\xf4\xc0\x05\x29\xec\xa1\x01\xcd\x09\x4f\x9e\x40\x58\x3d\xcd\xca\xba\x46\x61\x35\x68\xb9\xb4\x0e\x84\xd5\xdc\x40\x20\xac\x40\x58\x2d\xae\x09\xc2\x0a\x84\x15\x08\x2b\x10\x56\x20\xac\x40\x58\xe5\x4f\x00\x61\x05\xc2\x2a\x4b\x00\x61\x75\xd8\xc2\xca\xb8\xa4\xaf\xf9\x91\xd5\x05\x28\xab\x24\x0d\x94\x15\x28\xab\x2a\xc4\xde\xc4\x7c\x4a\xa7\x8c\x83\xba\x7a\x02\x75\xb5\x53\xe3\x3e\x03\x85\xb5\x53\xfb\x3d\x7b\x95\x55\xb4\x2e\x28\x2d\x50\x5a\xe9\x0f\x94\x16\x28\x2d\x9d\x7b\x67\xcb\x26\xcf\x1b\xb0\x7e\x50\x44\xd0\x35\x96\x4d\xa6\xaf\xbf\x84\xa5\x93\x20\x44\x37\xb0\x74\xf2\xa2\xa1\xc3\xf6\xcf\x41\x87\x26\x69\xa0\x43\x41\x87\x96\x50\xff\x9a\x48\x2c\x40\x7f\x6e\x56\x7f\xee\xca\xa8\x87\xae\x3b\x77\x65\xb7\xe7\xad\x37\x33\xab\x82\xce\x04\x9d\x99\xfe\x40\x67\x82\xce\x44\x20\xa4\x8e\x55\x48\x19\x97\x4a\x36\x09\xa9\x41\x1f\x84\x54\x92\x06\x42\x0a\x84\x54\x89\x5d\xaf\x58\x10\x71\x22\x04\x71\xd1\x95\x8f\x85\x80\xa7\x7a\x9b\x56\x55\x7b\x61\xe1\x43\x97\x58\x7b\x61\xc4\xe7\xad\xb7\xcc\x26\x06\xf1\x05\xe2\x2b\xfd\x81\xf8\x02\xf1\x85\x40\x7c\x1d\xab\xf8\x32\x2f\xa7\x6c\x54\x5f\x03\x50\x5f\x49\x1a\xa8\x2f\x50\x5f\x15\xac\x75\x09\xba\xc2\xce\x04\x14\xd7\x46\x15\xd7\x8e\xac\x7a\xf8\x2a\x6b\x47\x86\x7b\xee\xca\x2a\x35\x2b\xa8\x29\x50\x53\xe9\x0f\xd4\x14\xa8\x29\x9d\x7b\x57\x4b\x26\xfb\xa7\x0d\xe8\xde\x6f\xb7\x64\xb2\xc5\x97\x73\xff\x8a\xf9\x2d\xf6\x08\xba\x62\xbe\x4f\x1c\x1d\xd6\x8c\x81\xe6\x50\xd7\x50\xd6\xea\xc8\x95\x44\x97\xdd\x6b\xdb\x72\x67\xcb\x5b\xee\xa2\xe5\xd2\xc1\x16\x82\xca\x94\x9a\x78\xa2\x31\xe5\xa9\x5e\x4c\xaf\x13\x41\x33\x1d\xae\x66\xd2\x5f\x8f\x55\xa0\xea\x39\x23\x27\x8b\x02\xd9\x37\x64\x1d\x16\xb7\xff\x16\xd7\x36\xbf\x21\xdb\xcc\xae\xbf\x7e\x79\xce\x83\x81\x96\x73\x82\xe9\x10\x10\x35\x6d\x92\xb6\xaf\x4a\x7f\x34\x80\xd2\xd0\xa5\x53\xea\xc6\xd8\x5f\x0f\x40\x0f\x05\x33\x57\x85\x3f\x16\x6d\x15\xfd\xac\xae\x41\x5d\x3d\x35\xfc\xad\xf5\x39\xd8\x67\x88\x7e\xc7\x85\x32\x95\x83\x6d\x58\xc6\xee\x6e\xee\x1b\x3b\x00\x33\x00\x33\x5b\x80\x19\x11\x07\x2b\xa1\xcc\x9b\x83\x81\x23\x21\x71\x9d\x23\xad\xf7\x01\x7e\x3c\xf5\xd0\xb3\x00\xa8\x4f\x38\x16\x04\xbd\x8f\x39\x06\x88\xda\x03\x88\x02\x84\x5a\xb5\x12\x87\x8a\x50\xbb\x9a\x3d\x1b\x0c\x1a\xb8\xa5\xf4\xf1\xf3\x47\xcc\x9e\xe9\xe5\x58\x3e\xc3\x2e\x0d\xbd\xe7\x35\x6f\x06\xab\x36\x36\xba\x6a\xa3\xf5\x04\xe2\x60\xb8\xbc\xf3\xb6\xfd\x6c\x0b\x30\x37\x30\xf7\x16\x17\x5d\x38\x2a\x10\x12\x31\x52\xb1\xb0\xf5\xb2\x80\xed\x7e\xba\x5f\xdf\xd9\xc6\x57\x56\xd8\xbd\x83\x78\xce\x9d\x34\x0f\x32\x18\x01\x9e\x75\x2f\x2a\x7c\x28\xcf\xba\x9f\xf0\x93\x8b\xf0\x69\xf5\xe3\x84\x75\x40\xd0\xa3\x44\x50\xe3\xc4\x6f\x23\x83\xc2\x43\xec\x45\x02\x30\xe8\x3e\x30\xa8\x4b\x7c\x89\x5f\xae\x4f\xa2\x5f\x87\x4f\xfe\xe0\xda\x8c\x98\x6b\x90\xac\xae\xeb\x31\x83\x2c\xd2\x16\x40\x2f\x87\x41\xf9\x85\x6f\x80\xb2\x69\x85\x0f\x05\x65\xab\xcf\xd4\x37\xff\xed\x70\x23\x93\x1d\xc8\x74\x2e\x90\xee\x73\x99\x96\x1e\xf6\x96\x53\x95\xdd\x2f\xbe\x0d\xe3\x11\xd3\xd2\xbf\xc5\xe3\x31\xe1\xc9\xdb\x31\x61\x5a\x1a\x34\x41\xad\x26\x28\xef\x1b\xac\xed\xbb\xfd\x06\x45\xd0\xf2\x45\x2e\xa0\x08\x40\x11\x6c\x71\x56\xfa\x56\xc7\xc1\x64\xeb\x55\x2c\x88\x9b\xec\xbf\x5a\x75\xef\x95\x4b\x39\x71\xe4\xd1\x6f\x03\xcc\x1b\xd3\xc1\x11\x76\xa8\xbc\x3f\x20\x83\xa6\xb7\xbc\xa6\x51\x57\xd9\x1c\x58\xcb\x3a\x3b\x14\x4e\xef\xb5\xcd\x51\x42\x06\x6d\x17\xc1\x80\x6c\xca\xa5\xed\x95\x6c\xda\xca\x13\x00\xd8\xec\x76\x4c\xba\x08\x68\xff\x18\x69\xdf\xf4\xe6\x90\x26\xda\x3f\x07\xda\x5f\x24\x00\xed\xef\x2f\xed\x27\xb0\x73\x18\x78\xca\xe2\xd0\x30\x4c\x6d\x1c\xf8\x81\x4d\xe7\x69\xc0\xa6\x07\xc8\xa6\xb9\x49\xfd\x2a\x2b\xc2\xd2\x15\x00\x57\x00\xd7\x63\x01\x57\xe3\xca\x95\x46\x72\x1d\x02\xb9\x22\x20\xd7\xfd\x27\xd7\x75\xa6\x55\x03\xac\xee\x0c\xe6\xa9\x13\x63\x6a\xef\xa9\x4c\x54\x1f\x82\x49\x8f\x7c\xa6\xfa\x5a\xdb\x1c\xd4\x00\xa8\x01\x98\xa9\x06\xe0\x37\x98\x0d\x80\xff\x38\x81\xdf\xf4\x89\xa1\x46\xe0\x6f\xf9\x8e\x6b\x00\x7e\x00\xfe\x03\x9c\xaa\xde\x0d\x9f\x1e\xf1\x54\x35\xc0\xe9\xfc\x30\xc0\x29\x4c\x55\x03\xb9\xd6\x92\xeb\x8b\xd2\xe5\x54\x04\x54\x41\x4b\x59\xab\xdf\x5d\x04\x0e\x4b\x38\x13\x12\xe0\x2f\x84\x8b\x24\x38\x2c\x3e\xe5\x61\x09\x79\x9f\x04\x1d\x17\xf3\xbb\x45\x0e\x89\xbd\x62\xf7\xb3\xc4\x7d\xe8\x12\x41\xf3\xe1\x48\xc7\x75\x2f\x7d\xe5\x51\xe5\x6e\x24\x09\x22\x15\x2d\x42\xaf\x50\x7b\xcb\xa7\x42\x96\xba\x76\xc5\x36\xfe\x17\xe5\x8d\x66\x17\x5e\xd0\x52\xa5\xb7\x48\x32\x4b\x5e\x28\x7f\x1f\x71\xf6\x83\x38\x86\xfe\x32\x9d\x17\x9c\x3b\x67\x59\x87\x6c\x87\xe6\xda\x15\xc7\x34\xa4\xf3\xe8\x9b\xf4\xca\x51\x12\x52\x5e\xc6\xd1\xaf\x1f\xec\xf6\xed\xb7\xcc\x86\x9d\xbc\xe1\xbe\x59\x0f\x27\x28\x1b\x85\xcb\xfb\xc3\xd2\x6d\x5f\xe5\xc0\x4c\x43\xc7\x8f\x5d\xf2\xce\xaf\x63\xb9\x6c\x67\xc9\x1f\x69\xd1\xe5\x92\x83\xd8\x97\xb4\x26\xf7\x3c\x80\x5a\x61\x5d\xe6\x05\x96\x15\x87\x38\x84\xac\xff\x8b\x09\xbf\xdf\xac\x11\x16\x1d\xbb\xa2\x1d\x88\x47\x66\x06\x1e\xb0\xc4\x1d\x8d\xfe\xe4\xfe\xcd\x7d\xe8\xd4\x0d\x87\xc9\x90\x57\x2e\x51\x62\x4f\x77\x3e\xf1\x8f\xb4\x1e\xe5\xa2\x53\xe7\x28\x57\x5c\x1d\xaf\xcf\x34\x1f\x17\x13\xeb\x94\x12\x63\x41\x3e\x27\x85\x2e\x25\xef\x4d\xbb\x09\xed\xe0\x9a\x5f\xa7\xdf\xf1\x2f\xce\x9c\xc9\x12\xff\x69\x91\x79\x0b\x8e\x55\xcf\xb2\x4d\xbd\x2d\x62\xee\xe6\x9d\xed\x13\x2b\x4f\x54\xb7\x72\xb3\xa8\x9a\x6d\x1d\x07\xdb\xac\x31\x9e\xd2\xe9\xca\x16\xde\x33\xa7\xcb\xfe\x9d\xa2\xe0\xc3\x62\x54\xa3\xba\xc9\x72\xe3\xd9\x38\x51\x02\x56\xc8\x7e\x76\xfa\x79\x8f\xb1\x24\x9b\x1f\xb7\x8c\xc5\x44\xd4\xb9\xd3\x4a\x33\x57\x58\x68\xc0\xd3\xb4\x25\x46\xa9\x8e\x2a\x93\xa1\x35\x2c\xa9\x04\xbb\x5b\x3a\xd0\x2f\x1f\xb0\x83\xe2\xdf\xc3\xd2\xdf\x76\xf9\x40\xbf\x5b\x3e\xa3\x44\xf7\xbd\xd2\xdf\xb6\xbb\xf0\xff\xef\x79\xa3\x28\xa5\x98\xeb\xdd\x2b\xdd\x45\xf9\xa2\xa7\xe5\x8b\x96\xef\xa2\x37\x28\x1f\x28\xbd\x99\xe6\xcc\x2d\xd7\x33\x7f\xdf\xc6\x76\xfb\x17\xd3\x32\xdd\xba\xe5\xec\xa7\x20\x3c\x47\x4d\xa9\x82\xbb\x99\x3b\x1a\xea\xa0\xdf\x73\xae\x86\x3a\xe8\xef\x5f\xae\x17\xe7\xc7\x7a\xfa\xc8\x3a\xbb\x9a\x75\xa7\x64\xf0\xbf\x39\xfe\x9a\x2e\x58\x4d\x1f\x7a\x78\xf1\xef\x00\x00\x00\xff\xff\x19\x0b\x08\xd6\xdf\x20\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x6b\x73\xdb\xb8\xd5\xc7\xdf\xeb\x53\x60\x30\xcf\xd3\x49\x76\xe8\x44\x94\x2c\xf9\x32\x93\x17\x89\xd3\x6c\xb7\x93\x6c\xdd\x75\x36\x6f\x92\x8c\x07\x26\x21\x09\x6b\x92\xe0\x00\xa0\x23\x37\xd5\x77\xef\x80\x37\xf3\x02\xca\x14\x2d\x5b\x92\x75\x9a\xb4\x8d\x78\x48\x5c\x0e\x80\x83\xdf\x1f\x04\x24\x12\xb2\x2f\x54\x48\xc6\x83\x53\xc4\x02\x45\xa7\x82\x12\xe5\xdd\xbe\xe2\x62\xfa\xfa\xc6\x26\x5e\x38\x23\x76\xef\x9a\x05\xee\x29\xfa\x55\x90\x09\x09\xc8\x7b\x22\x67\x57\x9c\x08\xb7\xe7\x53\x45\x5c\xa2\xc8\x69\x0f\xa1\x80\xf8\xf4\x14\xc9\xdb\xc0\xa5\x92\xc9\x83\x24\x29\xa2\x18\x0f\xe4\xc1\x5f\x37\xfe\x81\x9b\x3f\x85\x90\x47\xae\xa8\x27\xf5\x53\x08\x91\x30\xbc\x7b\x2c\xbe\x92\x7d\x78\xc5\xf8\xeb\xe5\x56\x75\x1b\x52\x5d\xea\x89\x20\x52\x89\xc8\x51\x91\xa0\x3d\x19\x52\x67\xa5\x02\xbd\xfa\x4b\xf2\xa0\x87\x90\xfe\xbf\x53\xf4\xdf\xb8\x0c\x3f\xe3\xff\x45\x08\x93\x20\xe0\x2a\xa9\x06\x3e\xcd\x2f\x23\x84\x3d\x26\x15\x3e\x45\x5f\xf3\x2b\x77\x0f\xa5\x77\x5c\x45\xcc\x53\xbf\x05\xf8\x14\xd9\x56\xd9\xa2\x7d\x26\x79\x24\x1c\x8a\x4f\x11\x3e\x38\xc8\x3c\x8b\x0e\x0e\x70\xe5\x56\x1a\x90\x2b\x4f\xdf\xa6\x44\x44\x2b\xb6\x19\x73\x1b\x2c\xcc\xe1\xc1\x19\xf7\xb8\xd0\xe9\x8b\xe9\x15\x79\xd1\xb7\xd0\xc0\xb6\x2d\x34\x18\x8d\x2c\x64\xbf\xac\x66\xe3\x31\x9f\xe9\xea\xd8\xfd\x7e\xc5\xa2\xdd\xa8\x53\x79\x7b\xe7\x08\xf4\x37\xf4\xd6\xa3\x42\xc9\x6a\x2a\x72\xc6\x7f\xc4\x15\xae\x26\xa2\x1b\x4a\x27\x92\xfb\x1c\x17\xec\x8b\xfc\xdf\xdf\xd3\x7f\x2d\xb2\xc7\xb1\x4b\xa5\x23\x58\xa8\xfd\xaf\x9f\xcf\x33\xc4\xd4\x65\xca\xe0\x19\x3c\x0d\xa8\xfa\xcd\xc5\xa7\xe8\xf0\xa8\x9f\xbb\x1d\x4f\x05\x09\x67\x9f\x39\xf7\x14\x0b\x8b\xed\x81\x99\xbe\x75\x30\xba\xfb\xac\x68\xd2\x47\xf4\x5d\xa3\xf1\x60\xdc\x3f\x1c\xdb\x47\xa3\xf1\x49\x7e\x87\xc7\x82\x6b\x59\x6a\xf8\x62\xb3\xc7\x9e\xd7\x25\xa5\x73\x45\x45\x40\x3c\xa4\xef\x2f\xf9\x09\xb3\xc0\xf1\x22\x97\x7e\x21\x42\x1a\x5a\x0f\x5f\x53\x1a\x7e\x66\xbe\xa9\x65\xb1\x22\xd3\x72\xde\xfa\x0f\xce\xfa\x78\x29\x1b\x5d\x96\x42\x97\x2f\x3a\xfc\xbb\xd5\x5b\xd6\x36\x12\xf7\xaa\x4d\x93\x3f\x81\x43\x12\x50\x6f\x49\xfd\x1d\xee\x79\x24\x94\x54\xfb\x75\x42\x3c\x59\x2e\xff\x54\x30\xf7\x9c\x97\x47\x92\xfe\x83\x67\x86\x51\xf2\x43\x37\xcd\x61\xe5\xe2\xdc\xd0\xbb\x6e\xf5\xb5\xc2\xa5\xbc\xfb\xdc\x35\xb1\x7d\xd7\xc6\x95\x6a\x94\x9d\x21\x68\x48\x89\x1e\x08\x41\xe4\x79\x25\x8b\x62\x2a\xee\x6d\xf8\xdf\x11\x73\xae\xd1\x07\xe2\x54\xfa\x7f\xee\x48\xc1\x7f\x14\x3c\x68\x35\xf8\x89\x38\x33\xaa\x9b\x99\x47\xc6\xec\x1c\x3d\x7a\xdf\x11\xe7\x7a\x2a\x78\x14\x98\xbd\x19\xdf\xf3\x85\x78\x91\xb1\xaf\xc4\x56\x43\x6f\x89\xc3\xc1\xe0\x70\x64\xa1\xd1\x61\xf2\xdf\xfe\xab\x93\x5a\x40\x48\xee\x1a\x1e\x59\xc8\x1e\x9c\x58\xe8\xb0\xaf\x6f\x3b\x6e\xb8\x6f\xd4\xb7\x90\x7d\x34\xb0\x90\x4e\xb5\xff\xea\xe4\xe8\x65\x73\x77\x2b\xc7\xbe\x73\xc1\x7d\xaa\x66\x34\xaa\xf8\xd2\xa5\x0e\xf3\x89\x27\xab\xdd\xa2\x69\xdc\xeb\x3f\x98\x0a\xc1\x85\xd1\x53\x13\x2e\xfc\xb8\x59\x71\x25\x9f\x29\x89\xa6\xb4\xde\x1d\x7d\x32\xcf\xfc\x5a\x8f\x88\x3e\x0b\x32\x63\xd5\xa4\x03\xa0\xa9\x00\xba\xff\xcc\x04\x95\x33\xee\xb9\x1f\xe3\xc9\xef\xbe\xbb\x3e\x11\x71\x4d\xf3\x00\xd1\xd8\xb9\x97\x0e\xa8\x61\x25\x71\x3d\xa0\xc6\x2d\xc7\x93\xdd\x98\xe5\x8c\xb2\xe9\x4c\x95\xa2\xf1\xdd\x38\x1b\x97\xf2\x8c\x23\x90\xb8\x21\x9e\xa9\x87\xe7\x81\xb4\xdc\x3f\x7c\x12\x86\x2c\x98\x7e\x4e\x06\x93\xdd\x64\xab\x77\xec\xb2\x03\x0a\x33\xd7\x8d\x6e\x2c\xa4\x38\x52\x74\xae\x4a\x65\xd6\x7f\xf1\x4d\xd6\xd0\x25\xc3\xc2\x6a\x97\xb8\x20\xc1\xb4\x45\xe2\x83\x92\x61\xd1\x38\x3a\x7c\x32\x7f\x4f\x14\x39\xe7\x2c\x50\xb2\xde\xfb\x70\x10\x79\x5e\x6c\xfc\xc4\xe3\xb9\x1f\x3b\x3c\x08\xa8\xa3\xa8\x5b\xca\x3b\xbe\xef\x33\x9d\x1b\x43\x0b\x0f\x73\x9c\x29\xb7\x6c\xc8\xa5\x9a\xb0\x79\xbd\x69\x53\xc3\x07\x1e\xa8\x0b\xf6\x9f\x38\xdf\x51\xff\xff\x2b\xf7\x08\x6a\x7e\x56\xd0\xca\xa3\x47\xd5\x47\x63\x1f\x7e\x22\x61\x8b\x36\x9d\x08\xee\xeb\xec\x75\xa5\x4a\x89\xe8\xbf\x38\x6e\xe0\x53\x84\x7f\x7f\xfd\xd6\x60\xe4\xf9\x83\x2d\x1b\x43\x86\x44\x5c\x7b\x2c\x30\x04\x88\x09\xf3\xbc\x32\x60\x0d\x6d\x0b\xd9\xf6\xb1\x85\xec\xe3\x13\x1d\x04\xed\xe3\x5a\xac\x9c\xe8\x42\x9b\xc7\xbd\xce\xa5\x98\x5e\x92\xdc\xa0\x6f\x21\xfb\x64\xf8\x12\x2f\x09\x33\xbd\x86\x3e\x8b\xe3\x30\x79\xc6\xbd\xc8\x2f\xa3\x53\x6a\x14\x53\xaa\x5a\x38\x9c\xce\xc3\xb8\x4c\x8a\xf9\xf4\xc5\x4b\x74\x80\x42\xc1\x1d\x2a\xe5\xa5\x54\x44\xa8\x4b\x7d\xf9\x52\x52\x87\x07\xae\xfc\xa9\xc7\x84\x0c\x89\x43\xdf\x7c\xc3\xff\x97\x7f\xf8\x86\x2d\x14\x72\x57\x5f\x0b\xb9\xfb\x0d\x2f\x2a\xb5\x29\x85\xe7\x34\x3d\xc1\x68\x39\x50\xa7\xd1\x44\x2a\x12\x28\x43\xe8\x2f\x05\x1b\x3d\x35\xc7\xf3\xc0\xa0\x76\x8b\x47\xa7\x34\x70\x3f\xe4\xd9\xd5\xf3\xf0\xa9\x12\xcc\x31\xdb\x04\x9d\xc4\x68\x89\x0d\xbd\x4b\x2a\x1a\x93\xe5\xe1\x61\xbf\xdf\xb2\x7b\xe5\xb1\x5e\xd6\xb2\xbb\xa3\x8d\x3f\x43\xed\x12\x33\x68\x48\x16\x4c\x3d\x2a\x15\x29\x07\x9e\x24\xe8\x14\x47\xdc\x71\x75\xc4\xc5\x77\xb4\x1b\x71\x5c\x57\x0b\xbf\x59\x71\xb0\x65\x71\x6f\xa5\xf1\x16\x3f\xf4\x7b\x1a\x58\x9d\x48\x08\x1a\x28\xdc\x33\xf4\x6e\x20\xa9\x4e\x24\x55\x73\xcd\x43\x61\xca\x25\x2a\x66\xd8\xb7\xf2\x37\xc9\x01\xac\x6a\x60\x35\x5e\x1f\x58\x9d\x0c\x00\xac\x00\xac\x00\xac\x9e\x1d\x58\x3d\x9c\xa7\xd0\x2f\xba\x37\xf7\x01\xab\x56\xc0\xaa\x0b\x4d\xaf\x68\x0d\x68\x75\x04\x68\xf5\x68\x68\x65\x80\x21\xcb\x70\x5b\x5b\xb6\xaa\x93\x5a\xa3\xbb\x56\x46\xab\xc1\x5a\xb9\x2a\xa4\xc2\xd1\x0d\x04\x44\x55\x25\x2a\x7b\xb0\x12\x52\xc5\xe8\x34\x1e\x01\x3a\x01\x3a\x01\x3a\x3d\x3b\x74\x92\x91\xff\xe2\xaf\x1b\xff\xd2\xa7\x3e\x17\xb7\x97\x57\xb7\x8a\xca\xcb\x48\x52\xb7\x25\x39\x59\x88\x08\x4a\xde\x7c\xc3\x33\x4a\xc2\x6f\x78\xf1\xf2\x17\xbb\xdf\x7f\x6d\x4c\xd5\x27\xf3\xae\x89\x3e\x80\xcb\xd6\x00\x5e\x8f\x0a\x57\x47\x7d\xeb\xa4\xdf\x40\x58\xff\xa0\x24\x44\xba\x31\x1e\x08\x58\xc7\x00\x58\x00\x58\x00\x58\x4f\x06\x58\xc7\xab\x03\xd6\xd1\x9a\x01\x6b\xd0\x64\x03\xc0\x02\xc0\x5a\x13\x60\x59\xed\xb2\x3c\x38\xb9\xe7\x3f\x9d\xca\xd3\xaf\x14\xa6\xb1\x67\x00\xed\x3d\x02\xed\x05\x3c\x78\x14\xe0\x2b\xa4\xfb\x00\xe6\xcb\xd6\xe2\x0c\x6d\x54\x8e\xad\x18\x37\x5a\x77\x93\x18\x7f\xe7\xc1\xc1\xf3\xa4\x46\xeb\x81\x19\xce\x97\x64\xf7\xc8\x80\xba\xa1\xed\x7c\x87\xbd\x06\xf7\x65\xdb\xf9\x8e\xd6\xb5\x9d\xef\x9f\x5f\x3e\xa1\x4f\xf1\xc8\x37\x77\xb9\x56\xbb\xf9\x88\xc7\x88\x3c\xcb\x50\xbb\x32\xc3\x5e\x25\x1b\x3d\xeb\xee\xd3\xbb\x61\x3f\xd2\x60\xaa\x62\x77\x95\xdc\x10\xef\xc6\xa4\x4d\x8f\xb5\x61\xe7\x8e\x88\xcc\x3c\xaf\xda\x76\x71\x33\xd7\xdb\xd8\xa3\x13\xf5\x91\x4f\xdf\x11\x59\x5b\x14\x4b\xad\x9f\xc8\xdc\xe0\xfa\xcc\xc8\x02\xb3\x51\xe8\xb7\xa0\xcd\x09\xc7\xe6\xc6\x94\x13\x6b\x9e\x74\xc1\xb8\x68\xdf\x73\x8f\x0c\x3d\xd7\x1e\xb4\xec\xb9\xa3\xc6\x3c\x99\x5b\x1b\x01\x69\x38\xae\x17\x83\x78\x6c\x1a\xbc\x95\x9f\xd3\x16\xac\x35\x94\xee\x73\x37\xd3\x06\x4b\x36\xba\xeb\x2d\x9f\xc0\x66\x83\x81\x05\x0d\xe9\xa5\x93\xbd\xe1\x19\xc5\x15\x69\x02\x8a\x38\xe0\xdc\x2b\x5a\xf4\xc6\xa7\xec\xa6\x9a\xe1\x07\x73\x55\x2d\x94\x34\x69\x88\x1a\x25\xd7\x70\x71\x09\x04\x27\x7a\x90\x4c\x8d\xce\xc6\xa1\x86\x6f\x41\x5c\x16\xe9\xa2\x96\xd5\x4e\x98\x51\x7b\xfd\x31\x41\x03\x97\x0a\xaa\x47\x1a\x9e\x78\xbc\x32\x67\x25\x08\xf0\xaf\x1b\x2a\x04\x73\xa9\xa1\x42\x31\x7a\x34\xc5\x07\xa9\x88\x73\x6d\xcc\x55\xaf\xea\x84\xd4\xfd\x98\x50\x63\xdd\xbe\xf9\x65\xb6\x07\xd0\xd1\xca\x7c\x53\x63\x89\x75\xbc\x8e\x1c\xd4\xf0\xc7\x7a\x80\x2b\x1d\xee\xfb\x4c\xa9\xdd\xf0\x67\x5e\xd8\x25\x8e\x7b\xf7\x44\x8e\xdb\x91\x45\x59\x9f\xcc\x97\x38\xeb\x6c\x05\x67\x15\x3e\x2d\x81\xec\x4a\x18\xd1\x3d\xe0\x43\x22\x67\xab\x13\x66\x5c\xd5\x3f\xe8\x34\x0d\x8a\x86\x07\x2f\x66\x6c\x72\x3f\x3f\x69\x6a\x2f\x55\x03\xab\xfc\xac\x4d\xb9\x49\xb1\x2f\xff\xa0\x92\x7b\x51\x7a\xc6\xa6\x16\x9e\xf4\x20\x9b\x11\x41\x5d\xc3\x9c\xa0\x6d\x5c\x28\xc3\xc4\x1b\xcf\x35\x97\x19\xb2\x39\x91\x1f\x79\x44\xb1\x1b\x8a\x7b\x0d\x5d\x2d\xc7\xbb\xf8\x64\x50\xb9\xec\xf3\x03\x32\x67\xc6\x39\x69\x9e\x1a\x2a\x75\xba\x8a\x9c\xeb\x24\xa2\x56\xdd\xa4\x2b\x9c\xce\x46\xb5\xbd\x06\x77\xeb\x49\x86\xa7\x9a\xa7\xdc\x7c\x5a\xfd\xfa\xbd\xb1\x72\xb7\x8d\x35\xb8\xbd\x4c\x42\x43\x3d\xfc\x63\x3f\x8e\x46\xd5\x22\xca\x99\xf6\x78\xe1\x5a\xb9\x8f\xdc\x92\x79\x9b\xd7\x9e\x77\x83\xcb\x94\x89\x1e\x33\x7a\x21\xd4\xe8\x0a\x6d\x6c\x82\xc1\x1c\x67\x8c\x8f\xf9\xcc\x70\x14\xad\xec\xdb\x92\x69\x61\xb5\xad\x44\xe2\x94\x27\xac\x84\xd1\xd4\x58\x8f\x65\xad\x65\xea\xbf\x31\x6c\x36\x0c\xc6\xd8\xf6\x91\xde\xe4\x15\xeb\x99\xc4\x66\xc1\x75\xc5\xb4\x41\x17\xed\xa7\x2e\xb2\x07\x1d\x84\xd1\xa8\x9d\x30\x02\xd5\x03\xaa\x67\xa7\x54\xcf\x7a\x96\x85\xd7\xbc\xec\xfb\x9c\x65\xd1\x3a\x1d\xbe\x3f\xca\x68\x43\x5e\x7b\xfe\xe2\x28\x7b\xad\x01\x02\x09\x04\x12\x08\x24\x10\x48\x25\x81\xb4\xa9\xaf\xcb\xb0\x07\xbd\x86\x76\xcd\x5e\xb0\x1d\xaf\xf5\x05\x1b\x93\x0e\x36\x86\x17\x78\xbd\xb6\xe7\xaf\xd7\x92\xd7\x6b\xc7\x6d\xfb\xed\xb0\x31\xcf\xa4\xdf\xf6\x4b\x9b\xda\x52\xdc\xa8\x97\x03\x5e\x9e\xc1\xcb\xb3\x27\x79\x79\x26\x88\xa2\x2f\xb2\x33\x7e\x4e\x18\x65\x87\xfb\x2e\xe3\xd6\x6f\x09\xe9\x8b\xaf\xb6\xff\xbd\x3b\x96\xa7\xdf\x13\x56\xab\x8d\x99\xd9\xed\x7b\x99\x3d\xad\xce\x13\xe8\xc7\xc2\xa7\xad\x61\xfa\xb3\xf3\x3f\x01\xe5\x77\x1c\xe5\x4d\x7c\xba\x26\x92\x2f\xec\x59\xb7\x97\x0c\xd8\x34\x68\x46\x01\x5b\xc2\xc9\x18\x77\xa0\x64\x6c\x63\x20\xfd\xed\x23\x7d\x60\xd8\x7d\x64\xd8\xe3\x0e\x0c\x3b\x1c\x00\xc2\x02\xc2\x6e\x0f\xc2\xea\xd5\x65\xcd\x5b\xc4\x95\x97\x69\xd7\x6a\xcb\xad\xd8\x6a\x9e\x39\xd6\xbd\x92\xec\x69\x06\x7a\x7c\x24\xb5\x56\x77\x9a\x4b\xa8\xcf\x83\x6d\xf4\x59\x52\xb2\x8e\x5e\x7b\xf7\xb8\x5e\x0b\x29\xb9\xde\x46\x9f\xe9\x72\x2d\xf1\xca\xae\xbf\xb2\xf8\x9c\xb8\x1f\x24\x0e\x48\x9c\x16\x12\xa7\xbf\x64\xe0\x3d\xb9\x34\xa8\x17\xa6\xe0\xf7\x92\x69\x61\x2d\xaf\xe1\x06\x2b\x61\x34\x35\xd6\x63\x59\x53\x82\xbe\x01\x7d\xf3\xd8\xfa\xc6\x1e\x77\x10\x38\xe3\x52\x85\xd2\x09\x16\x04\x0e\x08\x9c\x0d\x09\x9c\x6c\x79\x9e\x87\x34\xb8\x9c\x3c\xc1\x17\x19\xaf\xb6\x20\x7f\x3f\x92\xea\x82\x77\x84\xf8\x47\x90\x3e\x99\x3b\x7d\x32\xdf\x49\x6f\x1a\xb7\x24\xad\x5d\x11\x15\x3e\x6d\x0d\xfb\x7f\x60\x1e\x45\xef\xd3\x9f\x5c\xd1\x13\x39\x88\x00\x10\x01\x1b\x15\x01\xfd\xd5\x01\xba\xfe\x48\xc1\xf1\x25\xd3\xc2\x5a\x5e\xc5\xb5\xd5\x62\xf5\x4a\x18\x4d\x8d\xf5\x58\xd6\x96\xfb\xb1\x9f\x69\xd0\xef\x35\xb4\x6b\xba\x9f\x69\x38\x6a\xb5\x9f\xc9\xf0\xb5\x00\xe8\x9c\x73\x4f\xa2\x17\x7a\x0f\xe7\x4b\x6c\x0c\x38\xb0\x89\x09\x36\x31\xad\xb0\x89\x69\x70\xcf\xf7\x69\xd9\xc3\x52\xea\x29\xa0\x6c\xf9\x97\x04\xc4\x5e\xbd\x68\x44\x31\xd0\x58\xfb\xad\xb1\x0a\x47\x14\x42\xce\xbd\x2e\xe7\x69\xf4\x73\x6f\xbe\xe1\xf3\x0b\xf4\x77\x97\x06\xe8\x22\xb9\xab\xbb\x6e\xd8\xf9\x63\x33\x66\x9f\xae\x7e\x6e\x66\x0b\x1c\xbb\xa1\xc3\x33\x66\x0f\xae\x72\x7a\x66\x0b\x7c\xf7\xbc\x8f\xd0\x94\x3c\x0b\x82\x74\xc7\x05\x29\x9c\xa1\x81\x33\x34\xeb\x3e\x43\x03\xc2\x6a\x1f\x85\xd5\x71\x17\x61\x75\x58\x9a\x4d\xd3\x99\x14\x84\x15\x08\x2b\x10\x56\x20\xac\x40\x58\x81\xb0\x02\x61\x05\xc2\x0a\x84\x15\x08\x2b\x10\x56\x20\xac\xf6\x54\x58\xd9\xe3\x2e\xca\x6a\x78\x02\xca\x0a\x94\x15\x28\xab\x26\x65\x75\x11\x89\x1b\x76\xc3\x05\xa8\xab\x47\x50\x57\x1b\x75\xee\x33\x50\x58\x1b\xf5\xdf\xb3\x57\x59\x65\xef\x82\xd2\x02\xa5\x05\x4a\x0b\x94\x56\x49\x69\x6d\x6c\xdb\xe4\x71\xaf\xa1\x5d\xb3\x17\x26\xf6\x43\xb7\x4d\x66\x5f\x7f\x09\x5b\x27\x61\xeb\xe4\x1a\xb6\x4e\x9e\x34\xe6\x99\xea\xd0\x52\x4a\x29\x6c\x80\x0e\x05\x1d\xba\xe7\x3a\x34\xd6\xa1\x9f\xa8\x22\x72\x03\x88\xff\xac\xf5\xe7\xa6\x9c\xba\xeb\xba\x73\x53\x7e\x7b\xde\x7a\x33\xf7\x2a\xe8\x4c\xd0\x99\xa0\x33\x41\x67\x96\x74\x26\x08\xa9\x7d\x14\x52\xc7\x5d\x84\xd4\xe1\x10\x84\x14\x08\x29\x10\x52\x46\x21\x75\xc6\xfd\x50\x50\x29\xa9\x8b\xce\x3c\x22\x25\xba\x00\x55\xb5\x5e\x55\xb5\x15\x1e\xde\x75\x89\xb5\x15\x4e\x7c\xde\x7a\xcb\xec\x62\x10\x5f\x20\xbe\x40\x7c\x81\xf8\x02\xf1\xb5\xf7\xe2\xcb\x1e\x77\x52\x5f\xa5\x37\xb8\xe9\x9c\x0a\xea\x0b\xd4\x17\xa8\x2f\xad\xbe\x5c\x8a\xce\x88\x33\x03\xc5\xb5\x56\xc5\xb5\x21\xaf\xee\xbe\xca\xda\x90\xe3\x9e\xbb\xb2\xca\xdc\x0a\x6a\x0a\xd4\x14\xa8\x29\x50\x53\x25\x35\xb5\xa9\x2d\x93\xc3\x71\xaf\xa1\x5d\x53\x74\x1f\xb6\xdb\x32\xd9\xe2\x97\x73\x7f\x25\xe2\x8a\x4c\x29\x3a\xe3\x9e\x47\x1d\xcd\x9c\xd8\x18\x68\x76\x75\x0f\x65\xa3\x8e\x5c\x49\x74\xd9\x83\xb6\x2d\x77\x54\xb8\x56\xae\x7f\xdc\x72\x27\x25\xf9\x96\xce\xb6\xf5\x72\xb4\x11\x54\x26\x6b\x32\x12\x8d\x96\x46\x49\x55\x18\x87\x5d\x24\x53\x9c\x59\x63\xa5\x41\x33\x6d\xbd\x66\x8a\x7f\x3d\x56\x73\xfe\xd4\xb9\x74\xf2\x28\x90\xff\x86\xac\xc3\xa3\x40\x6d\xe3\x6f\xc8\xde\xcf\xae\x3f\x7f\x4e\x9d\xc5\x62\x09\xbe\xbe\xc5\x2d\x67\x9e\x8d\x22\x6a\xd6\x24\xb2\x25\xa3\x3e\x18\x40\x59\xe0\xb2\x1b\xe6\x46\xc4\xc3\x8d\x03\x7b\x19\x80\xee\x0a\x66\xae\x0a\x7f\x3c\x94\x4f\x49\x4d\xb8\x5f\xcf\xad\x50\xe3\x92\x69\x61\xb5\xad\xc4\x3d\xf0\x87\xf1\x7a\x2b\x61\x34\x35\xd6\xa2\xf0\x69\xc3\xe8\xb7\x5f\x28\x63\x0f\x3a\xb0\x8c\xdd\xb7\x01\x66\x00\x66\x76\x09\x66\x64\xe4\xaf\x84\x32\xaf\x77\x06\x8e\xa4\x22\x81\x6a\x0f\x4f\xf6\xbd\xf0\x44\x6e\xa6\xe8\x59\x00\xd4\x39\x89\x24\x45\xef\x23\x41\x00\xa2\xb6\x00\xa2\x64\x33\x7b\x00\x42\x3d\x2b\x84\xda\xd4\xea\xd9\xe1\x61\xaf\xa1\x55\xb3\xd5\xb3\xc1\xba\x56\xcf\xe2\x8d\x99\x1e\x27\x2e\x0b\xa6\xd8\x38\xb4\xe1\xec\xf1\x9e\x9f\x3d\x4e\xce\x1e\xdb\x83\xb6\x9d\x77\xd4\x98\x69\xdc\x79\x87\x47\xc0\xdc\xc0\xdc\x5b\xc5\xdc\x7a\xed\xd0\xd1\x81\x90\xca\x4b\x1d\x0b\x5b\x6f\x0b\x30\x90\x65\x4b\x18\xee\xb0\x18\x98\x94\x6c\xed\x3b\x2b\xec\xc1\x4e\xbc\xe7\x4e\x9a\x07\x19\x9c\x00\xef\xba\x77\xef\x5d\xb7\x09\x40\xd3\x8b\x85\x6b\xdf\x1f\x08\xea\xcb\x29\x77\xfd\xb0\x5e\xe9\x29\x65\xd7\x96\x4c\x0b\x6b\x6b\x2b\x61\x34\x35\xd6\x63\x59\x6b\xed\xfd\x7a\x27\x20\xe8\xe3\x23\xa8\x3d\xe8\xc2\xa0\xf0\x12\x1b\x5e\x62\x6f\xd7\x4b\x6c\x97\x7a\x8a\xbc\xe8\x4e\xa2\x5f\x47\x8f\xfe\xe2\xda\x8c\x98\x1d\x48\x36\xae\xeb\x3e\x83\x2c\x8a\x3d\x80\x5e\x8c\xfc\x97\x80\xb2\x3b\x8e\xb2\xf5\x77\xea\x29\xb3\x15\xae\x75\x02\xd9\xc2\x6f\x87\xd7\x6b\xba\x0a\x23\x62\xbc\x49\x42\xb4\x96\xd7\xb2\x75\x2d\x80\x74\x33\xd2\xdd\xd4\xb2\xf4\x68\xd0\x6b\x68\xd7\x6c\x59\x7a\xb8\xae\x65\xe9\x77\xd1\x64\x42\x45\xf2\xa3\xe2\xd8\x18\x80\x60\x59\x7a\xcf\x97\xa5\x93\x65\xe9\x71\xdb\xbe\x3b\x6c\xcc\x33\xee\xbb\xc3\x21\x28\x02\x50\x04\x5b\xa5\x08\xb4\x16\xb8\x8a\xe3\x60\x72\x72\x4d\x9f\xab\x4b\xce\x5f\xb5\xd4\x04\xf9\xd9\x2b\x97\x09\xea\xa8\xbd\x3f\x06\x58\x74\xa6\x43\x42\xe2\x30\x75\xbb\x43\x0e\xcd\x8a\xdc\xd1\xa9\xab\x1c\x0e\x2c\x7c\xda\x1a\xe1\xf4\x3e\xee\xc4\x28\x21\x03\x09\xb2\x69\xc7\x65\x93\x89\xf6\xd3\x8b\x85\x6b\x9d\x84\x13\x1c\x76\xdb\xcf\xc3\x6e\x40\xfb\xfb\x48\xfb\xe3\x0e\xb4\x7f\x0c\xb4\x0f\xb4\xbf\x0b\xb4\x9f\xc0\xce\x6e\xe0\xa9\xde\x49\xde\x91\x4d\x57\x01\xfe\xc2\x27\x60\x53\x60\xd3\x1d\x63\xd3\xc2\xa2\x7e\x7f\xc9\xe0\x7c\x72\xe6\xab\x17\xa6\xe0\xf7\x92\x69\x61\x2d\xaf\xe1\x06\x2b\x61\x34\x35\xd6\x63\x59\x53\x56\xfa\xf6\xff\xd8\xbb\x9e\xdf\xa6\x61\x28\x7c\xef\x5f\x61\xbd\x13\x87\x45\x4a\x9a\x95\xc2\x81\x03\xe2\x04\xd2\x10\x68\x50\x2e\x43\x55\x96\x78\x59\xd6\xfc\x22\x4e\xba\x06\xb4\xff\x1d\xd9\xf9\x65\xbf\x26\x6b\x89\x02\x6c\xaa\xa7\x1c\x66\xcf\x7e\xb6\x5f\xde\xf7\xfc\xf9\x5b\x93\x76\xe4\x54\x13\x57\x4d\x5c\x27\x22\xae\xd6\x7c\x0c\x73\x5d\x68\xe6\xaa\x99\xeb\x33\x60\xae\x63\x64\xd5\xc8\xe1\x33\xd3\x3a\x75\xa5\x53\x0b\xf4\xec\x09\xd5\xcf\xc1\xa5\x27\xae\x54\x5f\x08\x9f\xeb\xd3\x80\x3e\x0d\x1c\x3a\x0d\x68\xa5\x5a\x2b\xd5\x5a\xa9\x3e\x15\xa5\xda\x7a\x35\x86\xf0\x2b\x9f\xce\xaa\xb7\x5a\x4d\xf8\x35\xe1\x7f\x6a\x84\x7f\x9c\x54\xfd\x7f\xf8\xe9\x09\x4b\xd5\x9a\x9c\x6a\x72\x7a\x1c\x39\xd5\x52\xf5\x29\x4b\xd5\x33\x34\x1c\x57\x3d\x78\xd2\xe2\xde\xb2\xcd\x2e\x71\x00\x73\x6f\x69\xe4\xac\x68\xc6\xaa\xe4\xd0\x7d\x95\x07\xb0\xbc\x14\x0a\x32\x78\x4e\xb6\xe9\x7a\xe4\x8e\xaf\x86\x1f\xb0\x32\xf6\x28\x0b\xe4\x74\x24\x74\x07\xbf\x79\xe5\xd1\xde\x6c\x72\x1a\xa5\xfc\x1f\x5b\xb1\xaf\xac\x1e\xc2\x80\xe5\x28\xb4\xf7\x7c\x13\xae\x78\xe2\xe9\xbd\x09\x12\x5b\x52\xbb\x89\x21\x77\xdc\x34\x44\x65\x9a\x25\x77\xd4\xed\x89\x97\x6d\x6d\x58\x6a\xf3\x58\x40\x1e\x47\xcd\x79\x3b\x7a\x13\xc4\x41\x9d\x7d\xab\xa8\x5c\x8b\xb1\xd8\x8b\x22\xfd\x75\x97\x5c\xbf\xb9\x6a\x7d\x68\xc8\x8e\xbb\x82\x87\x33\xd2\xee\xc2\xf8\xf9\xb0\xe6\xb1\x2f\x84\x28\x08\x62\x37\x2c\x3c\xfa\x36\x1c\xe2\x72\x0d\x2e\xe0\x63\x63\x1a\x5b\x8e\x8a\x30\x0f\x06\x7a\xd7\x09\x14\xe2\xa1\xce\x1d\x2d\x53\xb7\x38\x42\xe0\x47\x41\xb3\x72\x5a\x27\x74\x81\x8d\x70\x0c\x19\xf5\xe9\xae\x87\x0f\x00\xdb\x04\xe9\xd7\x2c\xbc\x2c\x63\x77\x60\x8d\xf5\x96\x87\x2d\xe6\x8e\x2f\x82\x8f\x7d\x6e\xd6\x81\x4d\x37\xe0\xc0\x0b\xe7\xf5\xc3\x9d\xea\x7d\xb1\xf2\x0e\xfa\x63\xc1\xe8\x17\xc7\x3f\xc8\xbc\xa7\x86\x49\x60\x38\x03\x3f\x86\x6d\x84\xaf\x97\xee\xed\x23\xf8\x39\xa2\xf3\x3f\x00\xd6\x30\x97\x3d\x14\x6d\x69\xe2\x4d\x0f\xb6\x4f\x89\x37\x06\x66\x69\xe2\x4d\x01\xb0\x69\x9d\xf1\x37\x41\x67\x3e\x6d\xd0\xb5\xbf\x7f\x9f\xa1\xe0\x15\x14\x5e\xc1\x14\xdc\x54\xaf\x54\x81\x38\xb9\x37\x6c\x19\x31\x90\x27\x75\x3d\xf4\x9a\x49\x03\x77\x43\x33\xd5\x58\xdc\xd2\x8b\xb3\x19\xbe\x13\xeb\xe6\x1c\x85\x99\x21\x2c\x54\xd4\x80\x25\xed\xfe\xfc\x52\xe8\x00\xbf\xc0\x8a\xd4\xf2\x02\x95\x2d\x5c\x61\x9b\xb8\x85\x9a\x1c\x60\x8e\xca\x96\xd7\xac\x5a\x22\x05\xf5\xca\xd7\x52\x74\xff\xd1\x2c\xf0\xa0\x2f\xf1\xa0\x78\x16\xf3\x73\x5c\xa1\x22\x0d\x96\xa8\x6c\x9b\xf2\xbc\x7b\xef\xdb\xcf\x44\x1c\xd3\xe1\x3a\x4b\xee\x19\xcd\xda\xfe\xdd\x09\xee\xb2\x06\x1a\x31\xc8\x7b\x09\x6a\xc4\x20\x1f\x56\x17\x5d\xfb\x42\x88\x70\xb0\x7c\xb7\x33\xb7\xf4\xfc\x9b\xc4\xbf\xb6\x1d\x57\x9b\x11\x42\xc8\xc3\xec\xf7\x00\x19\x0b\x08\xd6\xdf\x20\x01\x00"),
 		},
-		"/addons/ops/addon-ops-integrations-service.yml": &vfsgen۰CompressedFileInfo{
-			name:             "addon-ops-integrations-service.yml",
+		"/addons/ops/addon-ops-integrations-podmonitor.yml": &vfsgen۰CompressedFileInfo{
+			name:             "addon-ops-integrations-podmonitor.yml",
 			modTime:          time.Time{},
-			uncompressedSize: 372,
+			uncompressedSize: 854,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\xc1\x4a\x04\x41\x0c\x44\xef\xfd\x15\xf5\x03\xab\x78\x5a\xb6\xaf\x9e\xbc\x88\x20\x78\x8f\xbd\xa5\x04\x67\xd2\x4d\x92\x59\xd8\xbf\x97\x71\x76\x51\x10\x3c\x79\x4b\x52\x0f\xf2\x4a\x86\xbe\xd0\x43\xbb\x55\x9c\xee\xca\x87\xda\xb1\xe2\x99\x7e\xd2\xc6\x32\x33\xe5\x28\x29\xb5\x00\x26\x33\x2b\xe2\x6c\x47\x86\xc6\x4e\x2d\xf9\xee\x92\xda\x2d\x0a\x30\xc9\x2b\xa7\x58\x39\x40\xc6\xf8\x06\xbf\x2e\xd7\xe5\x46\xfb\xed\xdf\x69\xeb\xf3\xe8\x46\xcb\x8a\x1f\x1f\x7e\x61\x79\x1e\x5c\x89\x37\x97\x48\x5f\x5a\x2e\xce\x12\x83\x6d\x35\xd8\xc2\xfb\x69\x89\xa4\x3f\x3c\x15\xa0\x5d\xe7\x8a\xc7\x6e\x2c\xc0\xe8\x9e\x17\xdd\xdd\xa5\xda\xcc\x74\x6d\x9b\xd2\x06\x54\x1c\xf6\xfb\x43\x01\x82\x13\x5b\x76\xaf\xff\xd3\xe6\x33\x00\x00\xff\xff\x9a\x79\x38\x20\x74\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x31\x8b\xdc\x40\x0c\x85\x7b\xff\x0a\x41\x6a\xef\x91\xea\xb8\xe9\xd3\xe5\xe0\xaa\x34\x21\x18\x79\x46\xf1\x2a\x6b\x4b\x83\x24\x27\xec\xbf\x0f\x9e\xf5\xe6\x16\xc2\x1e\x09\x5c\x69\xcf\x9b\xa7\xef\xe9\x0d\x56\xfe\x42\xe6\xac\x92\x60\x51\xe1\x50\x63\x99\x0e\x59\x8d\xd4\x0f\x59\x97\x87\x9f\x1f\xbb\x13\x4b\x49\xf0\xa2\xe5\xf9\xa2\xe8\x16\x0a\x2c\x18\x98\x3a\x00\xc1\x85\x12\xf8\x59\x0a\x39\x7b\xcf\x12\x34\x19\x06\xab\x78\x07\x30\xe3\x48\xb3\x6f\x3a\x00\xac\xf5\x55\xd8\xfe\x5c\x3f\x0e\xac\x0f\x6f\x9f\x66\x5d\xaa\x0a\x49\x24\xa8\x5a\xfa\x1d\xf5\x2f\x59\x9c\x2b\x25\x60\xf9\x6e\xe8\x61\x6b\x8e\xd5\xa8\x89\x5e\xb3\xf5\x27\x3a\x27\x58\xb8\x94\x99\x7e\xa1\x51\xe7\x95\xf2\x06\x58\xb5\x3c\x53\x18\x67\xff\x24\xa5\x2a\x4b\x34\xee\x1e\x02\x6d\xa2\x78\x51\x8b\x04\x4f\x8f\x8f\x4f\xcd\xd0\xa8\x45\x63\x99\xf6\x74\x1f\xa0\xa0\x1f\x47\x45\x2b\x0e\x28\x05\x70\x26\x0b\x96\x09\x6c\x9d\xc9\xc1\x69\xa6\x1c\x70\xbb\x1f\x18\xcf\x10\x47\x76\xf8\xa1\x63\xf3\xe8\x01\xf3\xb6\xb9\x04\x46\x75\xc6\x7c\x61\x87\x9d\xe0\xf3\x36\x30\xfd\x11\xc3\x55\xb4\xb4\xb5\xdc\x6b\x00\xa0\x07\xd7\xd5\x32\xb5\xfb\x9e\xe0\xeb\x30\x6c\x05\x0e\xa7\x75\x24\x13\x0a\xf2\xa1\x6a\x19\x5a\x9e\xe1\x6a\x33\xb0\x0e\x37\x4e\xdf\xf6\x91\xff\xc0\x77\x73\xeb\xdd\xc6\x0f\x5c\xfe\x83\xe0\xbe\x49\x07\x7b\x0f\x6a\x97\xd6\x16\x8c\x7c\xdc\xc9\x76\xab\xb7\x1f\xe5\xdd\x67\x79\x33\xa8\xfb\x3d\x00\xe0\xd8\x02\x3d\x56\x03\x00\x00"),
 		},
-		"/addons/ops/addon-ops-integrations-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
-			name:             "addon-ops-integrations-servicemonitor.yml",
+		"/addons/ops/addon-ops-integrations-slo-rules.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-ops-integrations-slo-rules.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 406,
+			uncompressedSize: 6396,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x41\x4e\x03\x31\x0c\x45\xf7\x39\x85\x2f\x30\xad\xd8\xe6\x0c\xb0\x42\x62\x1f\x92\x4f\xb1\x3a\xb1\x23\xdb\x2d\x9a\xdb\xa3\x99\x01\xb5\x12\x02\x96\xb6\xdf\xe2\x3d\x97\xc1\x2f\x30\x67\x95\x4c\x5d\x85\x43\x8d\xe5\x74\xa8\x6a\x50\x3f\x54\xed\xc7\xeb\x43\x3a\xb3\xb4\x4c\xcf\xb0\x2b\x57\x3c\xed\x54\xea\x88\xd2\x4a\x94\x9c\x88\xa4\x74\x64\xf2\x45\x1a\x9c\x7d\x62\x09\x9c\xac\x04\xab\x78\x22\x9a\xcb\x2b\x66\x5f\x39\xa2\x32\xc6\x0d\xdc\x36\xdf\xc3\x81\xf5\xf8\xf7\xb5\x6a\x1f\x2a\x90\xc8\xe4\xbb\xcb\xf4\xa5\xfc\x03\x8d\x65\x20\x13\xcb\x9b\x15\x0f\xbb\xd4\xb8\x18\x36\xe8\xd6\x38\x9d\xb1\x64\xea\xdc\xda\x8c\x8f\x62\x48\x3e\x50\x57\x49\x48\x1b\xca\x12\x9b\xf1\x44\x43\x2d\x32\x75\x84\x71\x5d\x9d\x1c\x33\x6a\xa8\xed\x3d\xbd\x44\x7d\x7f\xbc\x0b\xfc\x2f\xe8\xd7\xa4\xbb\xa7\xa5\xcf\x00\x00\x00\xff\xff\x31\x2d\x51\x0e\x96\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xef\x6f\xdb\x36\x10\xfd\x9e\xbf\xe2\xe0\x6c\x80\x33\xc4\x92\x15\xd7\x06\x42\xa0\x01\xd6\xa1\xc3\x06\xb4\x68\xd1\x04\xfb\x32\x0c\xea\x49\xba\x48\x6c\xf8\x43\x23\x29\x27\x86\xe1\xff\x7d\x20\xfd\x4b\x46\xdc\x24\x9d\xb3\x4c\x18\x66\x7f\x91\x65\xde\xdd\x7b\x8f\xf7\x28\x52\x58\xf3\xdf\xc8\x58\xae\x15\x03\xa9\x15\x77\xda\x70\x55\x46\xb9\x36\xa4\x6d\x94\x6b\x19\x4f\x93\xa3\x1b\xae\x0a\x06\x1f\x8d\x96\xe4\x2a\x6a\xec\xa7\x46\xd0\x91\x24\x87\x05\x3a\x64\x47\x00\x02\x33\x12\xd6\x5f\x01\x60\x5d\x33\xb0\x33\x55\x90\xe5\x36\xdc\x59\xff\x88\xb8\x8e\x1f\xfe\xd7\xcd\x6a\x62\xc0\xd5\xb5\x41\xeb\x4c\x93\xbb\xc6\x50\x48\x51\x6f\x6a\x33\x5f\x40\xf0\x1c\x1d\xd7\x6a\xb0\x85\x1c\x86\x19\x2d\x88\x01\x0a\x32\x6e\x60\x1a\x41\xcb\x0a\xdb\x41\x83\x1b\x9a\x31\x90\xbc\x28\x04\xdd\xe2\x2a\xf7\xfe\x7c\x0c\x7a\xce\x34\xd4\x3b\x02\x50\x28\x69\x0b\x7a\xc0\x95\xa3\xd2\x84\xf1\x76\x60\x85\x5e\x55\xb2\x35\xe5\x5e\x81\xd2\xe8\xa6\x5e\x69\x31\x78\x3c\x96\x72\x6d\x0a\xae\xca\x16\x5e\x80\x70\xcd\x56\x3f\x00\x06\xb0\x1c\xc6\xa0\x15\xcf\xe8\x2e\xaf\x50\x95\x64\x53\xdb\xe4\x39\x59\xcb\x02\xa8\xd4\xa0\xa3\xb1\xdc\x04\x03\xd0\x5d\x6d\x18\x24\x30\xf8\x4a\xf8\x35\x72\x41\xc5\xfe\xe8\xc7\x4a\x3f\x14\xbb\xae\x7c\xd1\xba\x03\x60\x1b\x09\xd9\x0c\xfa\x5e\x19\x5b\x63\x4e\xa7\xed\xd4\x27\xd0\xf7\xf8\xfb\xda\x94\x29\xd6\x98\x57\x94\xe6\x28\x49\xa4\x6f\xd7\x25\x7f\x0e\x15\xe7\x5f\x74\xf6\xba\xb7\x57\xd7\xde\x29\xf8\x3e\x7a\xdd\xcb\xb5\x72\x74\xe7\x7a\x8b\xdf\xc7\xf2\x8f\x93\x93\x1d\x14\xf1\xb3\x62\xba\xd2\x0e\xc5\x21\x90\xbe\x59\xe6\xd1\xb0\x8b\x3a\x8f\x86\xdd\x13\x7a\x34\x3c\x4c\xe9\xa4\xea\xa0\xd0\x49\xd5\x39\x9d\x93\xea\x20\x99\xcf\xba\x28\xf3\x59\xf7\x64\x3e\x3b\x4c\xe6\x49\x17\x65\x9e\x74\x4f\xe6\xc9\x61\x32\x27\x45\x07\x65\x4e\x8a\xce\xc9\x9c\x14\x07\xc9\x3c\xea\xa2\xcc\xa3\xee\xc9\xbc\x0b\xe9\x18\x7e\xf2\x2c\x41\x2b\x31\xf3\xcd\xa9\x2d\x59\x70\x15\x81\x24\x54\x50\x1b\xed\x37\x93\x5c\x95\xe0\xb8\xa4\x53\xa8\xc9\xe4\xa4\x1c\x17\x64\x01\x0d\x81\xc3\x1b\x52\xa0\xa7\x64\x80\x3b\x0b\x16\x65\xbd\xdd\xb9\x7e\x6d\x12\xb7\x59\x53\x9f\x35\x95\x96\xd5\xe3\x61\xba\x67\xb7\x28\xf1\xee\x61\x79\xfe\x6c\x30\xa0\x49\x3d\x84\x90\xad\x3f\x8c\xc6\xa7\x70\x4f\xb1\xf7\x84\xea\xe3\xa6\xee\x15\x97\xf4\xfc\x5b\xb4\x7d\xbc\xce\xc7\xcf\xc7\xeb\xbc\x53\xc4\xce\x9f\x91\xd8\xf9\x3f\x4a\xec\xf1\x93\x57\x38\x25\x3e\x72\xf0\x3a\x86\xf7\x8d\x70\x1c\x6e\xb9\x2a\xf4\x2d\x64\x8d\x51\xe0\xdd\xb9\x3c\x62\xda\xd3\xe0\x1a\x32\x46\x1b\xc8\x9a\xa2\x24\x07\x19\x79\xe3\xdc\x56\xe8\xc2\x7f\xab\x93\xd9\x32\x48\x67\x5f\x28\x77\x7c\x4a\x20\x08\xa7\x3b\x96\x09\xf9\x18\xfc\xba\x05\xf9\xd6\x67\x7d\x13\x92\xbe\x69\x8c\xda\x8c\x05\x40\xa5\xb4\x5b\x4a\xb0\x45\xea\xbf\x92\xac\xc5\x92\x18\xf4\x5a\x79\x60\x3e\xff\x3c\x9f\x7f\xb7\x3c\xa1\x47\x2d\x15\x16\x8b\xcf\x8b\x05\x70\x05\x9b\x79\xdb\x1d\xba\xb9\xbd\x1a\x68\x03\x7d\xcf\xce\xbb\x7e\x87\xf4\x35\x5a\xc7\x56\xd1\x53\x14\xcd\x2a\x44\x5f\x87\x05\x62\xb3\x7e\x83\x5f\xbf\x7b\x3b\x90\xad\xae\xd3\xc6\x08\x06\x95\x73\xb5\x65\x71\x5c\x72\x57\x35\x59\x78\xf1\xb0\x9e\x3a\xae\x37\x97\x71\x26\x74\x16\x4b\xb4\x8e\x4c\x5c\xe8\x3c\x96\xa8\xb0\xe4\xaa\x4c\x49\x4d\xb9\xd1\x4a\x92\x72\x36\x76\xba\xe6\xb9\x8d\xd7\x33\x9c\x5a\x5d\x47\x58\xe8\xfc\xb8\x45\x3f\x10\x58\xe2\xcf\x76\xf5\xdd\xf7\x1c\xe9\x3f\xf1\x81\x94\x54\x70\x01\xfd\xe4\x55\xf4\x0a\x7e\x80\xbe\x3f\x72\xcf\xe7\xd1\xe5\x0a\x7d\xf4\x63\x51\x68\x65\xa3\x0f\xb5\x8d\x2e\xdf\x7d\x88\x2e\x97\xcd\xf1\x09\x1d\x2d\x16\x10\x43\x32\x1c\x9e\x9c\x00\xaa\x02\x9e\x58\x6d\x2c\x0f\xad\xb6\x5d\x05\xfc\x57\x9b\xbf\x45\x7a\x12\x48\x4f\x5e\x82\xf1\x68\x28\x0f\xaa\xd5\xe6\x7b\xad\x0d\x83\xb3\xf6\x7a\xd6\x7e\x8d\xb5\xfe\x58\x9a\x92\xe1\x6e\xc6\x20\x37\xdc\xf1\x1c\xc5\x7f\xc5\xb6\xff\x3b\x36\x38\xb6\xf0\x0d\x35\x7a\x89\xe6\x3d\xab\x0e\x2a\xf5\x2c\x5e\x1d\x05\xba\xc9\x4b\xd0\x9d\x54\x07\x95\xba\x67\xd5\x64\xfc\x74\xaf\xde\x62\xe8\xf9\x07\xad\xfa\x0b\x2f\xab\x77\xe8\x48\xe5\xb3\x7f\xdd\xa6\x7e\x4f\x6d\xef\xf9\x51\x5a\x70\x7a\xbd\x21\x87\xf3\xf1\xf7\xf7\x0c\xda\x15\x6f\x56\xbc\xac\xc4\x3d\x2d\x97\xbe\x7c\x74\x57\xe9\xb7\xcb\x70\xf1\x50\x83\xac\xa6\xe9\xaa\x32\x64\x2b\x2d\x8a\xc5\xa2\x55\x65\xd9\x1c\xc3\x6f\x6f\x8e\xbf\x06\x00\x3c\x08\x61\x64\xfc\x18\x00\x00"),
 		},
 		"/addons/ops/addon-ops-jvm-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-jvm-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 100534,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x73\xdb\x38\x96\xfd\x9e\x5f\x81\x62\xcf\x6e\x39\x3b\x76\x47\xd4\xc3\xaf\xaa\xde\xaa\xc4\x4e\xba\xd3\x1b\x67\x32\xed\x74\x3e\x6c\x27\xa5\x82\x49\x48\x42\x4c\x12\x5c\x00\x92\xe5\xf1\xfa\xbf\x4f\x01\x7c\x88\x22\x41\x89\x7a\x8b\xd1\x75\x55\xcf\x44\x04\x08\x02\x07\xc0\xc5\x39\x97\x97\x00\x0e\xe9\x17\xc2\x05\x65\xc1\x25\xa2\x81\x24\x7d\x4e\xb0\xf4\x1e\x7f\x66\xbc\xff\x6a\x64\x63\x2f\x1c\x60\xfb\xc5\x3d\x0d\xdc\x4b\xf4\x2b\xc7\x3d\x1c\xe0\x6b\x2c\x06\x77\x0c\x73\xf7\x85\x4f\x24\x76\xb1\xc4\x97\x2f\x10\x0a\xb0\x4f\x2e\x91\x78\x0c\x5c\x22\xa8\x38\xa1\x41\x8f\xe3\x93\xef\x23\xff\xc4\x4d\xb3\x23\xe4\xe1\x3b\xe2\x09\x95\x1d\x21\x1c\x86\x93\xfc\xfa\x4a\xf2\xe3\x67\xca\x5e\xcd\x4e\x95\x8f\x21\x51\xd5\xed\x71\x2c\x24\x1f\x3a\x72\xc8\xc9\x0b\x11\x12\xa7\x5a\x4d\x7e\xfe\x2e\x58\xf0\x02\x21\xf5\x7f\x97\xe8\xff\x75\xf1\x4f\xfa\x7f\x11\xb2\x70\x10\x30\x89\x25\x65\x81\xb0\x2e\xd3\xcb\x08\x59\x1e\x15\xd2\xba\x44\x7f\xa5\x57\x50\x26\x55\xe7\xb8\x1b\x52\x4f\xbe\x0f\xac\x4b\x64\x1f\x4f\xa7\x28\x94\x04\x1b\x72\x87\x58\x97\xc8\x3a\x39\x49\xb0\x44\x27\x27\x56\x2e\x2b\x09\xf0\x9d\xa7\xb2\x49\x3e\x24\xb9\xb4\x01\x75\x4b\x52\xa8\xc3\x82\x2b\xe6\x31\xae\xca\xe7\xfd\x3b\x7c\xd4\x38\x46\x4d\xdb\x3e\x46\xcd\x4e\xe7\x18\xd9\x2f\xf3\x8f\xf1\xa8\x4f\x55\x73\xec\x46\x23\x97\xa2\xf0\x53\xa5\xbc\x9e\x00\x81\xfe\x13\xbd\xf6\x08\x97\x22\x5f\x8a\x18\xb0\x07\xdd\xe0\x7c\x21\xaa\x87\x54\x21\x29\xe6\x56\x26\xfd\xf9\xb8\x1c\xc3\x69\xa4\x3e\x71\xe6\x13\x39\x20\xc3\xc2\x93\x67\xc1\x44\xc6\x61\x84\x03\x11\x44\x8a\xa3\x90\x33\x87\x08\xd1\x1d\x86\x92\xfa\xa4\x2b\x88\xc3\x02\x57\x3c\x7d\x67\x77\xbf\x7c\xb5\xfe\xf6\x9d\xdd\x7d\xb5\x8e\x55\xa3\x45\x88\x1d\xa2\x2e\xa5\x3f\xbe\x5a\xcf\x7f\xd9\xfe\xb7\x97\xe8\xbf\x51\x23\x5f\x81\x22\xe2\x1a\xe9\x8b\xd3\xe8\xbf\x22\xe2\x09\xae\x7f\x10\x21\x31\x97\xe8\x9a\x48\xe2\x28\x78\x2b\x83\x2a\x24\x09\x55\x09\xb6\x9f\xbf\x45\xe2\xfe\xff\x90\x47\x11\x37\x5a\x15\x7f\x22\x71\xbf\x90\x8b\x8c\xe5\x3b\xc6\x7d\xac\x3a\xde\x8a\xe0\x40\x1a\xa4\x42\x4e\x2a\x3d\x32\xc9\x1a\x57\x79\xaa\x0f\xd3\x7f\x7f\x7b\x91\xeb\x55\xcb\x25\xc2\xe1\x34\xd4\x6d\xbb\x44\x56\x5a\xb8\x45\x5c\x2a\x0d\xdd\x66\xf5\x03\x22\xdf\xbb\xd6\x25\x6a\x9f\x35\xec\xc9\x55\x8e\xc3\xc1\x67\xc6\x3c\x49\xc3\xec\x9c\xb2\xa8\xca\xda\xcc\xfc\x96\x84\xe3\xf8\x69\x76\xe7\xb4\x79\xda\x68\x77\x5a\xed\x4e\xb3\x95\xe6\xf0\x68\x70\x2f\xa6\x26\x6f\x76\xd8\xe9\xbe\x54\x35\x25\x63\x49\x78\x80\x3d\xa4\xf2\x4f\x61\x62\xd1\xc0\xf1\x86\x2e\xf9\x82\xb9\x30\x0c\x3a\xd5\x01\x22\x67\x1c\x54\x87\xc5\x46\xa8\x30\x76\x94\x4d\xca\xa2\xf9\x6d\xba\xb0\xfc\xe4\x11\x93\xbc\x09\xee\xe9\x1d\x56\x88\x03\xe2\xcd\x68\x9c\xc3\x3c\x0f\x87\x82\x28\xd0\x7a\xd8\x13\xd3\x15\xef\x73\xea\x7e\x62\xd3\xa6\x4e\x27\x0c\x0c\x66\xec\x41\xe1\xde\xce\x5d\x1c\x1b\x46\xea\xa3\xba\x56\x36\xe3\xa3\xfe\xb3\x9b\x9d\xa9\x8b\x93\x66\x4c\x83\xc1\x49\x48\xf4\x28\x0c\x86\x9e\x37\x0d\x93\x1a\xa4\x0a\xa7\x7f\x0e\xa9\x73\x8f\xde\x61\x27\x67\xa0\x52\x20\x39\x7b\xc8\x20\x78\x5c\x82\x13\x76\x06\xe4\x33\xf5\x09\x1b\x1a\x1f\xe7\xa8\xc9\xfe\x06\x3b\xf7\x7d\xce\x86\x81\x19\x4d\x9d\xe7\x0b\xf6\x86\x26\xcb\x14\xa5\x1a\x86\x49\x64\x3d\xda\x9d\x63\xd4\x69\x47\xff\x35\x7e\xbe\x28\xd8\x8f\x28\x57\xeb\xec\x18\xd9\xcd\x8b\x63\xd4\x6e\xa8\x6c\xe7\x25\xf9\x3a\x8d\x63\x64\x9f\x35\x8f\x91\x2a\xb5\xf1\xf3\xc5\xd9\xcb\xf2\xe1\x56\xc9\xe4\x5a\x2e\x71\xa8\x8f\x75\x0f\x4d\x0d\x8b\xb2\x49\x1d\xa5\x71\xae\x0d\x64\x11\xa9\x5e\x6a\x5c\x72\xcf\xe9\xe3\x61\x9f\x14\x87\xa3\x8f\xc7\x09\xae\xc5\x25\xcb\xa7\x41\x92\x58\xb0\x99\x03\xf6\x60\xaa\x80\x1a\x1d\x03\x4e\xc4\x80\x79\xee\x07\x4d\x4b\xe6\xe5\xba\xc1\xfc\x9e\xa4\xb3\xbf\x74\x70\xcf\x9c\x50\x2d\xc3\x84\x3a\xad\x38\x9f\xec\xd2\x47\x0e\x08\xed\x0f\xe4\x94\xa9\x45\xe9\x3c\x3b\x6d\xe5\xec\x98\x24\x7c\x84\x3d\xd3\x08\x4f\xad\xe4\xf4\xf8\xf0\x71\x18\xd2\xa0\xff\x39\x9a\x4c\x76\x59\x5a\x71\x60\x4f\x03\x90\x59\x02\x47\xaa\xb3\x90\x64\x48\xad\x48\xb9\xf1\x8b\xe2\xe4\x5c\x8b\x73\x6d\x9e\x51\x38\xc7\x41\xbf\x42\xe1\xcd\xe9\xc2\x4b\x67\x87\x8f\xc7\xd7\x58\xe2\x4f\x8c\x06\x52\x14\x47\x9f\xa5\x50\xd4\x89\x37\x4c\x93\x33\xcb\x61\x41\x40\x1c\x49\x5c\xab\x90\xef\xb3\xaa\x91\x01\xf8\x90\x09\xd9\xa3\xe3\x62\x0f\xc6\x09\xef\x58\x20\x6f\xe9\xbf\x74\xf1\x9d\xc6\x7f\xe4\xf2\x70\x62\xbe\x57\x5f\xcf\xde\x7a\x96\xbf\x55\x43\x75\x83\xc3\x0a\x5d\xd7\xe3\xcc\x57\x65\xa8\xba\x17\x41\x95\x51\xc3\xac\x8f\xaf\x5e\x1b\x12\x59\x7a\x63\x45\xcc\x45\x88\xf9\xbd\x47\x03\x83\x1d\xe8\x51\xcf\x9b\xa6\x5d\x2d\xfb\x18\xd9\xf6\xf9\x31\xb2\xcf\x2f\x94\xad\xb3\xcf\x0b\x26\xb1\xa7\x9e\x6d\x9e\xde\xea\x29\xd9\xf2\xa2\xe2\x9a\xca\x7c\x5e\xb4\x0a\x05\x65\xad\x49\xe9\x74\xd4\xd6\xf0\x8a\x79\x43\x3f\x28\xf6\x8a\xc4\xbc\x4f\x64\x05\xc0\x13\x12\xbb\x12\x7b\x2d\x76\xc6\xc4\xee\xc6\xc5\x71\x4a\xf2\xfc\x44\x9b\x09\x21\x71\x20\x8d\xfc\x3a\x63\x45\xd4\x9a\xab\xa1\x6b\x16\xb2\x78\xa4\x4f\x02\x77\xc2\x21\x8b\xcf\xf0\x89\xe4\xd4\x31\xa7\x71\xd2\xd3\x84\xd0\x32\x8c\xa7\x98\x06\xdb\xed\x76\xa3\x51\x71\x40\xa5\x46\x5c\x18\x7a\x24\xa1\x11\x7f\x6a\x84\x73\x89\x1c\x07\x6a\x34\x12\x8d\x45\x71\x19\x4b\x18\x86\xa0\x41\xdf\x53\x2c\x79\xda\xe2\x44\xd6\x26\x3b\x07\xcf\xf3\x73\x50\xe7\xa8\x36\x07\x99\x66\xff\xbf\x2c\x38\xfd\x12\x83\xb7\xd0\x0c\xd4\x37\x7d\x8c\x2d\xaa\x33\xe4\x1a\x00\xa0\x50\x65\x70\x2d\x4c\xa1\x0a\xd0\xac\xca\xa2\x5c\x2c\x35\xf2\xaf\xc5\x7b\xc1\x80\x51\x4d\x5d\x1b\x1b\xae\xad\xc0\xa8\x2e\x9a\xc0\xa8\x4c\x85\x03\xa3\x02\x46\x55\x27\x46\xa5\x7d\x5a\xdd\x25\x58\xd5\x7f\xd9\x8d\x46\xde\x1f\x08\xd4\x6a\x16\xb5\xba\xd5\x1e\xcf\x2d\xd0\xab\xc2\x84\x04\x7a\xb5\x36\x7a\x65\x20\x44\xab\xf0\xab\x22\x5b\x5b\x1f\xbd\x6a\xae\x95\x5b\x85\x84\x3b\xaa\x83\x80\x55\x65\xaf\x8d\xb5\x13\x77\x21\x5a\x15\x39\xa4\x3a\x40\x9f\x4c\x85\x03\x7d\x02\xfa\x54\x07\xfa\x24\x86\xfe\xd1\xf7\x91\xdf\xf5\x89\xcf\xf8\x63\x77\x28\x88\xdb\xbd\x7b\x94\xa4\x22\x7b\x3a\x46\x98\x13\xfc\xcb\x57\x6b\x40\x70\xf8\xd5\x7a\x7e\xa9\xd8\xd4\xab\x5c\xa1\x3e\x1e\xaf\x54\xe6\x0a\xe4\x6c\x0d\xec\x6b\xa3\x0c\xeb\xac\x71\x7c\xd1\x28\xa1\x59\xbf\x11\x1c\x22\xd5\x21\xe6\xd7\x60\xe0\xa4\x02\x16\x05\x2c\x6a\x0f\x59\xd4\xf9\xe2\x2c\xea\x6c\xcd\x2c\xaa\x09\x2c\x0a\x58\xd4\x7a\x58\xd4\x9c\x0e\x4d\x1e\x79\x72\x31\xe7\x6f\xa9\xfa\x34\x80\xd2\xed\x8e\xd2\x05\x2c\xd8\x04\xab\xcb\x14\x7b\xa0\xc4\xee\x23\x0b\x4e\x7e\x4c\x72\x37\xc7\x58\xcc\x7d\xe0\x78\xc6\xe3\x36\xcc\x23\x77\x14\x57\xd7\x9e\x4d\x0c\xec\xe6\xd9\xf4\x7a\xb1\x7c\x5c\xdd\xef\x5f\x6e\xd0\x8d\x9e\xba\x2b\x84\xd5\x61\x8f\x62\x71\x95\x30\xe2\xa7\xe9\x1a\xdf\x45\xe1\x94\x45\xf8\x5c\x2c\x06\x1f\x48\xd0\x97\x1a\xae\x46\x21\x8d\x94\xdd\x56\x85\xe2\x2e\xc9\x64\xa9\x36\xf0\x76\xa1\x9b\x8b\x7d\xec\x91\x9e\xfc\xc0\xfa\x6f\xb0\x28\x38\xa8\xe2\xd4\x1b\x3c\x36\x40\x9f\x24\xd2\xc0\x9c\xc8\x69\x7f\x30\xa3\x60\x9d\x5c\x5a\x72\x94\x9a\x16\xbd\x1c\xa5\x3d\xab\x48\x69\x4d\x03\xb7\x33\x7b\xe0\x4e\x4f\x80\xd8\x1a\x17\x6b\x81\x47\xfd\x92\x65\x36\x99\xb9\xc6\x98\x70\x5f\xc3\x62\x4a\xd0\x88\x98\xca\x8b\x17\x62\xc3\x3d\x92\x49\x5c\xb6\xd8\x6b\x63\x32\x57\x37\x28\x4a\x60\x0c\x24\x56\x09\x0f\xd4\x95\x05\x33\x51\x46\xe3\x0b\x44\xb5\x40\xe5\x12\xe5\x85\xb5\xd2\x2a\x0e\xec\x50\xdd\xcd\xb1\x4b\x87\xaa\xf8\x4e\x31\xcd\x3c\xd7\x38\x09\x5c\xc2\x89\x26\x10\x3d\x8f\xe5\x96\x9d\x68\x1d\xfe\xc7\x88\x70\x4e\x5d\x62\xa8\xb7\x5e\xe4\xcb\xa6\xb8\x90\xd8\xb9\x37\x3e\x55\x2d\xb3\x21\x71\x3f\x44\xc4\xcd\xf0\xc6\x68\xd7\xee\xac\x6d\x32\x94\x02\x1b\xd0\xb9\x56\x7c\xf9\xd7\x2c\x10\x98\x39\x6b\x74\x09\x92\x0e\xf3\x7d\x2a\x65\x8d\xe0\x4c\x6b\x3c\x03\xb7\x37\x1b\xc7\xad\x4e\xbe\x4f\x65\x55\xcb\xb1\xba\x5a\x00\xab\x6a\x24\x39\x67\x43\x54\x73\xde\x45\x72\xd2\xc0\x62\x7c\xf2\x07\xe9\xc7\xdf\x71\x19\x6e\xbc\x1d\xd0\xde\x7c\xfe\xa3\x58\x77\x8e\xfd\xa4\x5f\xa4\xe4\x9d\x56\xe2\x0f\x22\x98\x37\x8c\xbf\x44\x31\x2f\x29\x98\x6b\xc2\x68\x58\x54\x04\xe3\xd2\xb0\x72\xea\xf5\xa4\x9b\x50\x2e\x67\xe8\x0f\x3d\x2c\xe9\x88\x94\x7e\x50\x95\xd2\x33\xfd\xfd\xcc\x74\xdd\xc7\x27\x78\x4c\x8d\xeb\xce\x38\x4e\x28\x7c\xd2\xe6\xdc\x47\xe6\xd4\xc0\x28\xfc\x78\xc5\x29\xbc\xd7\x9f\xb8\x6d\x0c\x77\x95\x2f\xab\xe9\xd2\xf9\xd7\xb7\xd2\xc6\x3d\x96\xb6\xe0\xb1\x1b\x8d\x73\x83\xe3\xd5\xd7\xf3\xc9\x20\xb4\xa7\xbf\x69\x9a\x1e\x23\x8f\x78\x5c\xc5\xf9\x35\x99\x5c\xa6\x87\xa8\x39\x83\xef\x88\xc9\x2f\x17\x25\x96\x91\xb9\x94\xb2\x18\x6f\x8b\x48\x4b\xa3\x38\xbd\x26\xd8\x2e\x64\x8a\x32\x5f\x63\x68\x50\xb6\xd8\x08\x63\x52\x69\x3b\x66\xf5\x96\x69\xfc\x62\x8f\xf6\xcb\x26\xa3\x4e\xfb\x40\x46\x69\xc3\x5e\x98\x1e\x04\xba\x66\x72\x15\x74\x8d\x29\x8c\x74\x9e\xae\xe9\x80\xae\x01\x5d\x13\x27\xd6\x4f\xd7\xac\xd3\xf9\x6a\xd6\x24\x20\x7c\xf6\xc0\xd9\x5d\x6f\xed\xb3\x23\xd0\x7e\x7c\xf9\x93\xbc\x78\x00\x09\x04\x12\x28\xf9\x03\x09\x04\x12\x08\x81\x04\x3a\x54\x09\x64\x8c\xf9\x9e\xa7\x81\x4e\xab\x69\x20\x35\x1c\x5f\x8b\xcf\x71\x0f\x1a\x47\x2c\xc8\x24\x90\x49\xfb\x23\x93\xe0\x7d\xcf\x6a\xb2\x07\x44\xce\x9e\x41\xb4\x3e\x49\x53\x11\x9c\xe4\x6b\xcc\x18\x9c\x91\x10\x0b\x81\xb3\x34\x36\xa5\x7b\xe9\x2d\x87\xdb\x48\x18\x9e\x51\x69\x66\x5e\x6f\x1c\x53\xbe\x2d\x4c\x97\xc0\x8d\x9b\x70\x4b\xb1\x79\xbb\x71\x6c\xc2\x3d\xc6\x26\x9c\x89\xcd\xbb\x8d\x63\x23\x1e\x70\xb8\xb7\xe0\xa8\xca\xcd\x40\xe7\xd7\xad\xa0\xb3\xcf\xa3\x27\xae\xdf\x0c\x8c\x7e\xdb\xbb\xd9\x85\xfe\x8e\xea\x34\x06\xc3\xc1\xa3\x40\x47\xa1\x10\x7f\x57\xd5\x34\xac\xd3\x29\xd4\xef\x7f\x00\x5f\xe0\x67\x2d\x7b\xa6\xd3\xc1\x11\x98\x34\x18\x1c\x81\x0b\x38\x02\x0d\x7c\x08\xdc\x80\x75\x75\x03\xee\x2a\x10\xde\x6e\x96\xce\x8c\x38\x12\xfe\x7c\xda\x99\xb1\x62\x24\x3c\x15\x0e\xc4\xc1\x83\xb3\x74\xe5\x38\x78\xbb\x35\x67\xdc\x36\x2a\x7a\x4b\xc1\x15\x3a\x75\x19\x5c\xa1\xd3\xd6\x69\x61\x57\xe8\xa3\x90\xc4\xef\x3a\xe1\xb0\x3b\x14\xb8\x4f\xb6\xe4\x81\x31\x0c\x1d\x13\x1b\x2f\x2e\xa8\x05\xc1\xa3\xeb\xbf\x3f\xfe\xd1\x44\xc9\xd4\x16\xd0\xb8\x01\x33\x9d\xa5\x4b\x21\x83\x47\xfd\x2e\x1b\x11\xae\x37\x5f\x3b\x5a\x16\xa7\xbf\xec\xc1\xb7\xe5\x7d\xa3\x1b\x02\xeb\xc4\x1e\xcc\xf4\x9f\x56\x24\x71\x3b\x94\x7a\x57\x9f\xfe\x04\x91\x57\x73\x91\x67\xd2\x2e\x6b\xd2\x78\x65\xe7\x00\x20\x64\xda\xed\x63\x18\xd0\x19\x1a\x6a\x29\x19\x68\xd9\x06\x13\x0f\x2a\x70\xd7\x2a\x10\xf4\xcd\x21\xea\x1b\xe3\xb6\xca\x73\xf4\xcd\x45\x0b\xe4\x0d\xc8\x9b\x38\x71\xeb\xf2\xc6\x63\xd8\xed\xe2\x11\xe1\xb8\x4f\xba\xb6\xbf\x87\x6f\x15\xa2\x8a\x9e\x14\x4e\xf9\xda\xa1\x94\xd9\x64\xf3\x57\x15\x13\x19\xd9\xea\xb0\x61\x20\xf7\xb0\x47\x9d\x70\x58\x73\x51\xf0\x81\xe1\xfc\xbe\x33\xa0\x0a\x92\x06\x83\x2a\xa8\xaa\x0a\xe6\xb0\x69\x78\x2d\x64\x68\x04\x08\x82\x6c\x1a\x08\x82\xfd\x16\x04\xe6\x1d\xc1\xe7\x28\x82\x56\x13\x14\x01\x28\x82\x38\x71\x7b\x8a\xe0\xfb\xc8\xef\x2a\x4e\x85\x5d\xd1\xf5\xe8\xa8\xaa\xdf\x19\x31\x8e\xf2\xb7\x26\x3f\xf6\x90\x7c\xaa\xea\xed\x8f\x92\xc8\x02\xe7\x62\xe2\xb3\x60\x49\xd4\xa3\x9b\xf7\x18\xf7\xa8\x82\x4b\x22\xbf\x81\x28\xf4\x2c\x78\x21\xc1\xf7\x4b\xe2\xae\x6e\xdd\x63\xd4\x55\xf5\x76\x13\xc0\xbe\x65\x4c\xcc\x03\x68\x19\xc4\xe6\xbe\xe5\x5b\x24\x42\xbd\x94\x7f\xee\x50\xbc\x7e\x8e\x7a\x06\xf4\x2b\xe8\xd7\xf8\x6f\x96\x7e\x2d\x8a\xc6\x1d\x8a\x3f\x50\xb0\x93\x3b\x76\xad\x60\x73\x53\xca\x63\xce\xbd\x36\x02\xd6\x4f\x77\x3d\xfb\xae\x70\x22\x9c\x15\x90\x07\x9d\xda\x73\x48\xd3\x2d\xcc\x2c\x3e\x0c\x82\x58\x8b\x5a\x3f\x9d\x91\xbb\xe6\x69\xfe\xab\x34\x4b\x12\xee\xd3\x00\xcb\xf8\x29\x1d\xdb\x3e\x6b\xe7\x77\x45\xd7\xb3\xd6\x3d\x79\xc0\x54\xd2\xa0\xaf\xf3\x39\x76\xc7\xb1\xcf\xf2\xf9\xb2\x39\x08\xbe\x3b\x6f\x5d\x94\x9b\x9e\xdd\xa8\xf3\x52\x9d\xbd\xba\x28\x35\x1e\xb0\x30\x2f\x0c\xaf\xea\x86\xb4\xfb\xf4\xd1\xb2\xd6\xfa\xb7\xa5\xa1\x2d\x20\x6c\x0f\x4e\xd8\x0a\x89\x25\xd9\x3a\x3b\x5d\x80\x7f\x3e\x3d\xe9\x2a\x3e\x1b\x1e\x9a\x11\xa2\x75\x61\x99\xe8\x56\xe3\x5d\x91\x6b\xae\x4c\x24\x69\xe0\xd2\x11\x75\x87\xd8\x5b\x8e\x48\xd6\x85\x2e\x2e\xfa\xfd\xc9\x9e\x73\x1c\xe0\x6a\x5b\xe5\x6a\x2e\xb9\x1b\x46\xcc\xc7\x7e\x77\x76\x7e\x95\x0f\x20\x4b\x7d\xff\xd6\x4f\x6f\xde\xd9\x6f\x8a\x4c\x8e\x06\x3d\x16\x51\xb0\xc6\xf9\x69\xbb\x59\xa0\x60\x1c\x47\xcc\xe6\xa7\xd3\xb7\xd7\x8d\xb7\x85\xdb\x1f\x30\xd7\xa7\xb4\xfc\xf4\xf6\xf5\x9b\x3d\xe4\x5d\xf0\x56\x64\xad\x6f\x45\x0a\x64\xb3\xec\x3b\x90\x66\xa3\xf4\xa1\xb3\xcf\x9f\xb7\x77\xcc\x4b\x07\xd4\x25\x6f\xfd\x50\x3e\x96\xdc\xac\xd2\xff\x97\x70\x56\x92\x0c\xb4\xb6\x22\xad\x2d\x3c\x71\x5b\xac\x76\xf6\xea\xa4\x4d\xad\x2a\x2b\x32\x0e\x85\xd5\x22\xb1\xfd\x8b\x9d\xf3\x96\x96\xaa\xad\x65\xd5\x42\x4b\x17\xa0\x7d\xa1\xe2\x34\x70\x38\xc1\x82\x1c\x79\xac\x7f\x87\x9d\xfb\x2e\x19\x91\x40\x8a\xae\x1e\x97\x95\x3f\x74\xf0\x57\xf8\xd0\x61\xed\x0e\xe3\xa7\x27\x4f\xad\xd2\x26\xc2\xbe\xea\xb9\xf3\xcd\x3a\xb8\x93\x3f\xb0\x3e\x7a\xab\x7b\x11\x1d\xd9\xfe\xcb\x3d\x72\x2b\x57\x52\x03\x15\x8f\xdc\x07\xb7\x73\xe6\x91\x87\xe6\x76\xb6\xf2\x1c\x16\xc4\x0c\x84\x4e\x81\x48\x58\x9f\x48\xa8\xec\xa5\x9e\x21\x12\x34\x8a\xa7\x15\xc5\x00\x84\x4e\xad\x44\xc5\xc1\xc3\x1c\x65\xcc\x07\x3d\xb0\x90\x04\xdd\xde\xe6\xfd\xca\x8b\x7d\xad\x3b\x9f\xc3\xaa\x7a\xef\x4f\x50\x54\xba\xe7\x15\x1e\xd7\x11\x4c\xe3\x9e\x91\xbb\x0a\x73\x4a\xb0\xec\x51\x8f\x44\xe3\x73\x81\x40\xa7\xe2\xcd\xd1\x3f\xf7\xf0\xb5\x89\x79\x04\x97\x7e\x54\xb2\x14\x7a\x3e\x1e\x2f\x0d\x9e\x1e\xcb\x7b\x8a\xdd\xec\x4d\x4e\xaf\x6b\xf0\xb2\xe9\x1d\xf5\x08\xba\x26\xc2\xe1\x34\x94\x8a\x26\xee\x8f\x08\x85\xd8\xa6\x83\x14\x99\xc5\xa2\x21\xb8\x69\x9f\x35\xe6\xae\x76\x6d\x6b\x9e\xcd\x16\x34\x76\xf3\x62\x9a\x4b\xcf\xdb\xb5\x4d\x11\x75\x41\x85\x24\x81\x43\xa2\x2f\x40\xf3\xd6\xb0\x78\x9e\x39\xfa\xc4\x98\x27\xd0\xd1\x6f\x24\xbf\x6d\x27\x6c\xea\x36\xb9\x08\x42\xfd\x12\x55\x7e\x99\x77\x3e\x7b\x58\x57\xdc\xf3\x00\x62\xc9\x62\x80\xd6\xa6\xf4\x7d\x1a\xdc\x86\x38\xc8\x77\xe5\xbe\x7a\x00\x26\x66\x2d\x73\x62\x40\xc8\x98\xd7\x1d\x14\x8e\xe1\x12\x0e\x0b\x89\xfb\x05\x9b\x42\x1e\x8c\x77\xe7\x73\x69\x8f\x83\x47\x1c\x69\x5e\x05\xa2\xbe\x24\x63\x5d\x9d\x4f\xb7\xe8\xad\x4b\x02\x74\xab\xf8\x7a\x71\x81\xd5\xdd\x5a\xcc\x57\xba\xfa\x3d\xd7\xd3\xef\xb1\xd2\x89\x8a\xd4\xd5\xb9\x0c\x3d\xb3\x71\x35\xbf\xb6\x97\x7f\x1b\x39\x82\xc4\x3e\x5f\xe1\x9b\xa6\xd5\x4e\x5d\xfc\x11\xfa\x24\x6a\xfa\xd2\xfd\x52\xee\x84\x59\x47\xbf\x2c\x7c\xa4\xe3\x8f\xd0\x23\xcb\x7b\xc4\xca\x3f\x50\x2b\x76\x46\xa9\x98\xd8\xa1\x63\xc2\xd8\x71\xe0\x9d\xa8\xb9\x77\x62\x2f\x36\x8d\x87\xaf\xab\x8c\x8d\xa8\xab\x03\x02\xb4\xf3\x21\x6a\x67\xe3\x3b\xee\x39\xda\xd9\x6e\x9d\x83\x7a\x8e\xd2\x40\x3d\x57\x53\xcf\x05\x82\x12\xa5\xbc\x97\x84\xe3\x98\x5e\xd8\x9d\xd3\xe6\x69\xa3\xdd\x69\xb5\x3b\xcd\x96\x21\xef\x27\x1c\x10\xef\x7d\xd1\x77\xb3\x6d\xd5\xfd\x0f\xcf\x45\xbf\x9a\xde\xf5\x65\x25\x77\x92\xa9\xd4\xd8\x83\xde\xae\xa7\x92\x00\xbd\xbd\x7f\x7d\x02\x7a\x7b\xdf\x7a\x04\xf4\x36\xe8\xed\xe8\x32\xe8\x6d\xd0\xdb\xa0\xb7\x0d\xb0\x81\xde\x3e\x48\xbd\x6d\x9b\x76\xe8\x9f\x2f\xb8\x2f\x40\x70\x47\x69\x20\xb8\x0f\x4e\x70\xdf\x0e\xf9\x88\x8e\x18\xaf\xf2\xaa\x3b\x97\xb7\xd4\xf6\x83\xfc\xae\xa7\xb0\x00\xf9\xbd\x7f\x7d\x02\xf2\x7b\xdf\x7a\x04\xe4\x37\xc8\xef\xe8\x32\xc8\x6f\x90\xdf\x20\xbf\x0d\xb0\xed\x2c\xde\xbe\xd5\x99\xa7\xf5\xa6\xd9\xe5\xaa\xa7\xa4\x4f\xc5\xd7\x7f\x64\xc1\x09\xc4\xd8\xc7\x29\xe0\xb7\x58\x31\xc6\xbe\x75\x3a\x7b\x28\x9f\x41\x98\x40\x9c\x06\x5e\x8b\x25\x83\xec\x03\x16\xac\x16\x67\x9f\x14\xb0\x8a\x0f\xe2\x86\x48\x2c\xe6\xf8\x1e\x26\x79\x4a\x17\x3c\xf0\x39\xe4\x3a\xa5\x3e\x82\xea\x30\xdc\x0e\xb5\xeb\x96\x43\xf0\x3c\xd4\xae\x53\xc0\xf9\x30\x7b\xf5\x02\xff\x43\xda\x60\xf0\x3f\x80\xff\xe1\x50\xfd\x0f\x20\xa3\x0f\x51\x46\x9b\xc2\xed\xe7\xc9\x68\xbb\xdd\x00\x1d\x1d\xa5\x81\x8e\xde\xc1\xdb\xff\x9c\x1b\x67\xdb\xea\xfb\x8a\xf9\x21\x27\x42\x10\x17\x5d\x79\x58\x88\xb9\x61\x00\x25\x37\x94\x2e\x04\xa0\xcb\x6b\xab\x35\x40\x97\xef\x65\xb7\x80\x2e\xdf\xc3\x4e\x01\x5d\x0e\xba\x3c\xbd\x0c\xba\x1c\x74\x39\xe8\x72\x03\x6c\xa0\xcb\x0f\x52\x97\x1b\xc3\xf2\xe7\x0b\xf3\x5d\x9f\xfc\x04\xc2\x1c\x84\xf9\xa4\xe7\xb6\x2e\xcc\x5d\x82\xae\xb0\x33\x98\x2d\xc6\xd3\x4c\xa5\x16\x1f\x04\x78\x6d\x45\x05\x08\xf0\xbd\xec\x16\x10\xe0\x7b\xd8\x29\x20\xc0\x41\x80\xa7\x97\x41\x80\x83\x00\x07\x01\x6e\x80\x6d\x67\x81\xf9\xed\xd6\x1c\xb5\xd7\x9a\x56\x26\x2b\x04\xe6\xff\x8a\xf9\x1d\xee\x13\x74\xc5\x3c\xc5\xb5\x95\x59\x33\x1a\x9a\xba\xc6\xe3\x97\xba\x1e\x36\x12\x86\xde\x6e\xcf\xee\xb8\x8b\x8a\x61\xe8\x15\x24\xb8\x29\x35\x9a\x88\xc6\x94\x4d\x1d\xca\xa6\x13\x4b\x1b\x0d\xa7\xb2\xed\x8b\x42\xe4\x58\x92\x23\xc5\x7d\xfa\x4e\x37\xc4\x43\xa1\x98\xa8\xc3\x02\x57\x44\xe7\x62\x6c\xe5\xb8\xe1\x75\x1f\x2e\xf6\xf4\x84\xb5\xc5\x7a\x7e\x46\x47\x4f\x4f\x8e\x6a\xd5\xf3\xb3\xa1\x7a\x19\x9d\xb7\xff\x04\x75\x62\x88\xab\x9e\xdc\xb4\x9d\xb3\x81\x67\x10\xce\xba\xd0\xca\x45\xc9\x1e\x0b\xb7\x4a\xf5\x76\x72\xb2\xae\x41\xeb\x1d\x24\xd5\x3b\x28\xea\x62\x0a\xfd\x9b\x47\x5d\xec\xc6\xfa\x8e\x93\x05\xee\x02\xdc\x65\x2d\xdc\x45\x0c\xfd\x45\x98\xcb\xab\x1a\xb0\x20\x21\x71\xd9\x1c\x31\xb1\xa4\xa2\x89\xce\xb3\x24\x3c\xea\xa3\x95\x99\xd2\x9c\xe5\x26\xeb\x5a\x2d\x40\x5b\xfd\xa8\xd0\xda\x60\xea\xe3\xf1\xe2\x98\xbe\xa9\x01\xfb\xfc\xa4\x9a\x81\xae\x87\xd1\x2b\x40\x60\xa0\x3b\x66\xa0\xc0\x3f\x17\x6d\x04\xf0\xcf\x6c\xda\x9e\xf2\x4f\x63\x88\xcb\x5c\xdf\x59\xc5\x8d\x27\x81\x7f\x02\xff\xdc\x1c\xff\x8c\x5f\x1d\x62\xcf\x63\x0e\x4e\xdf\xe9\x77\x75\x7f\x6f\x85\x3e\x2e\xf8\x2e\xb8\x02\x3f\x4c\x9a\xb2\x7e\x3a\x68\x00\x2e\xe4\xcc\x67\x3f\x04\x6e\x49\x4b\x6a\xce\xf8\x5e\x27\xdd\xff\xea\x93\xa9\x41\x40\xfa\xb6\x4e\xfa\xb6\xfe\x8e\x79\x27\xc4\x0f\xde\x32\x27\x77\xef\xea\x2d\x73\xc7\x9e\x4d\xb8\xec\x56\x73\x7a\xa1\x5f\xfe\x2d\xb3\xfe\xf8\xd1\x63\xd8\xa5\x41\xff\xc7\x7a\xbf\x0c\x01\xf1\x6b\x0d\x88\xb7\x9b\x55\x07\x6f\x73\xf6\xe0\x6d\x9d\x81\x5a\x40\xa0\x16\xd2\xc4\xed\xc6\x62\x3b\xca\xde\x11\xd1\x55\x26\x8f\xb8\x15\xf9\x2d\x62\x1c\x15\x6f\x4e\x7e\x6e\xda\x85\xba\xc4\xfb\xf7\xa8\x82\xeb\x8f\xb9\x6e\xd6\x21\x90\xf4\x2a\xea\x16\x64\x00\x01\xc2\x47\x27\x0d\xae\x4b\xf8\xa8\x89\xad\xae\x3d\x7a\x74\xeb\x94\x18\xa2\x47\x27\x77\x80\x4b\x17\xd8\xea\x36\xd8\x6a\xe1\x62\x25\xba\x0a\x81\xa1\x93\x04\xa0\xab\x5b\xa4\xab\x2e\xf1\x24\x3e\x5a\x9a\xb4\xfe\xd5\xf1\xbf\xbd\x54\xcc\xb5\xac\x9c\x05\xf9\xab\x2e\xaf\x26\x5f\x51\xe9\x26\x1f\x32\xfd\x8d\x3a\x1d\x1d\x75\xfc\xfc\x2e\xfe\x40\x80\x93\x06\xd7\x85\x00\x17\x83\x5d\xd7\x44\x7f\x5d\xe2\x50\x1f\x7b\x66\x7c\xea\x13\x2a\x00\xfc\xf8\x87\xf1\x7b\x5f\xcc\xe6\x62\x76\x6b\x7a\xaf\x83\x15\xfc\xde\x6f\x86\xbd\x1e\xe1\xd1\x91\x27\xe0\xf7\x06\x25\x51\xaa\x24\xf2\x01\x31\x65\x63\xf7\xb4\x31\x47\x47\xb4\x40\x47\x20\xd0\x11\x69\xe2\x76\xdd\xde\x77\xda\xdc\xad\xb8\x13\x89\x4b\x39\x71\xe4\x96\x5d\xd9\x1b\xd9\x3c\xa4\xd9\x5e\x61\x87\x8a\x18\x4b\x3d\x47\xba\x0e\x0e\xb1\x43\xe5\x63\x5d\xe0\x4c\xea\xbb\xf6\x4d\x3f\x8a\x90\xee\xa3\x38\xba\xd6\x98\xa3\x68\xf5\xaf\x1a\x44\x0d\xd2\x28\x93\xb6\x57\xd2\x68\x2b\xef\x06\x60\x67\x89\x43\xd2\x3e\xc0\xe8\x0f\x91\xd1\x9b\xc2\xde\xe7\x31\xfa\x73\x60\xf4\x93\x04\x60\xf4\xbb\x61\xf4\x0b\x7c\x1d\x99\xa7\x9d\x49\x54\x4b\xb6\xa4\xf8\x47\x1d\x88\xac\xaa\xee\x16\x84\x01\xb0\xd8\x38\x0d\x58\x6c\x0d\x59\x6c\xc6\xc5\x5f\x64\x95\x10\xfe\x02\x14\x17\x28\xee\xa1\x50\x5c\x63\xf4\xcb\x5c\x8e\xdb\x01\x8e\x8b\x80\xe3\xa6\x89\x35\xf4\x5a\xfb\x58\x55\x0c\xbc\xd6\x6b\xf2\x5a\xef\x04\xce\x03\xf7\x5a\xdf\x68\xcc\x81\xef\x03\xdf\x07\xaf\x35\x50\x7a\x03\x6c\x40\xe9\x0f\x93\xd2\x9b\xb6\x0b\x9c\x4b\xe9\xdb\x40\xe9\x11\x50\xfa\x34\xb1\x26\x6e\xeb\x94\x77\xae\xcd\x6d\xbd\x1b\x26\x7b\xc0\x6e\x6b\xa0\xb1\xf1\x65\xa0\xb1\xe0\xb6\x06\x8e\x5b\x97\xa8\xf4\xd3\xb3\xd9\x84\xca\x6e\xe5\x16\xf2\x92\xa8\xf4\xd4\x0e\xfe\x7e\xfd\xe6\xaa\x2e\x31\xe7\xeb\xd9\x6a\xb0\xf2\xe6\x21\xa7\xe7\xf3\xb0\xae\xb8\x7b\xc8\x7a\x4e\xd3\x04\x82\x0b\x04\x77\x91\x2f\x36\xb1\xc4\xb7\x5a\x18\x77\x1d\x16\x04\xf1\x61\x11\x5d\xec\x28\x6a\xf1\x54\x4a\x47\xb3\xa4\x75\x15\x32\xba\xfe\x0d\xaa\x75\xc5\x67\x30\xd2\xab\xe5\x76\x1f\x2c\xc1\xc9\xc7\xe3\xcd\x83\xb4\xcc\x8e\xd2\x35\xdf\x48\xf0\x1a\x4b\x7c\x87\x05\x41\x19\xb0\x61\x2b\xc1\xf5\x12\xe8\x7a\xf3\x5a\xd8\x67\x70\x3b\xcc\xf6\x45\xee\x71\xca\x8e\x28\xb3\xa0\xd0\x6a\x35\x26\x93\xd2\x12\xce\x80\xf8\xf8\x0b\xe1\x22\x3e\xef\xf9\x74\x92\x24\x1f\xa3\x69\xed\x62\x7e\x3f\xb9\x43\xe2\xfe\xf4\x00\xb4\xc4\x63\xe0\x12\x41\xb3\x53\xdd\xa2\x41\x8f\x63\xab\x50\x0d\x49\xfc\x50\x09\xe0\xa0\x3f\xd5\x6c\xcb\xa3\x42\xe6\x46\x75\x01\x14\xef\x4b\x7c\x8c\xb3\x61\x52\x4d\x98\x54\x61\x98\x24\x07\x44\xfb\x8f\x21\x67\xdf\x89\x63\x18\x28\xe9\xf9\xd0\x93\x3c\xb3\x46\x62\x35\xbf\xb4\x9e\x85\x3d\x1a\xd0\xd8\xa1\x10\x0d\xc7\x6e\x34\xc9\x8f\x86\xe1\x93\x20\x7c\x44\xa3\xf5\x27\x5a\x6c\x8e\x51\xba\x0e\xe5\xf7\x3f\x48\xb6\x35\xc8\xdb\x40\x1a\x38\xde\xd0\x25\xaf\xbd\x32\x6a\x97\x7e\x39\xfd\x31\x29\x3a\x5f\xb2\x3f\xf4\x24\x2d\xb9\x3b\xb6\x62\x56\x50\x76\x33\x0b\xa5\x71\xc1\x40\xc8\xfa\xbf\x21\xe1\x8f\x2b\xb7\x7b\x32\x6e\x0b\xee\x71\xd2\x27\x63\x83\x23\xcb\x12\xf7\x34\xfc\x93\x7b\xb7\x8f\x81\x53\xc6\x92\xa3\x05\x25\x5f\xa2\xc4\x7d\x3d\xc4\xc4\x3f\x93\xaa\xe7\x8b\x4e\xc6\x7e\xbe\xad\xea\x7a\xf9\x4d\xf1\xaa\x13\x01\x92\x4b\x1c\x0a\xf2\x39\x2a\x74\x26\xf7\x5e\xd7\x64\xc8\x1c\xa7\x5e\x5c\x80\x32\x93\x25\x99\xd2\x27\x3e\x31\xed\x6f\x91\x4e\x98\xe9\x7c\x33\x27\xcd\xca\x23\xf8\x75\x18\x7a\xd4\xc1\x85\x03\x2a\x2b\x8e\xe1\xef\xec\x6e\xc6\xe8\xcd\xb5\x30\x0f\xdc\x7c\xe8\x2a\x83\x57\x11\xbe\x02\x80\xf3\x2a\x65\x24\xe3\xa6\x5a\xa9\xf9\x47\x78\xb5\x7a\xc5\x79\xf3\x35\x9b\xfa\x5d\x3a\xf1\xa7\x5a\x77\x8c\x66\x57\xa0\xc2\xb4\x9d\x78\x51\x85\x64\x7e\x29\xcd\x2b\xce\x95\x09\xc7\xee\x7b\xc5\x51\xb0\xf2\xca\xf2\x3a\xa7\x5d\xa7\xb1\xfc\x5b\xb7\x8b\x3d\x6f\xe3\x0b\x4a\xe6\x50\xeb\x85\xe3\x97\x30\x27\xf8\x97\xaf\x56\x7c\x80\xf9\x31\x75\x97\x5a\x7e\x0c\x8c\x36\x9d\xbb\xbf\x7f\xb9\x41\x37\xba\x76\xd1\x4e\x08\xe8\xb7\xfc\x81\xdb\x73\x66\xb1\x4e\x9b\xdd\x8f\xe9\x44\xcf\x1d\xef\x5d\x38\xdb\x7b\x99\x75\x6b\xb3\xf0\xc2\x2a\xa7\xff\x60\xe6\x2e\x39\xb4\xe2\x13\xec\xb7\x35\x79\x3f\xb2\xe0\x64\x8b\x13\xd8\x74\x3e\xff\x8e\xe6\xf0\x6c\xa0\x37\x39\x8d\xf3\x8e\xed\x3d\x9b\xc6\xe9\xbf\x13\xd7\xc5\xf3\x44\xf3\x51\xdd\xad\x19\xb5\xd7\x8b\x5c\x50\x56\xc0\x1e\x4e\x5a\x59\x47\x8c\x25\x59\x7c\xdd\x32\x16\x13\x52\xe7\x5e\x3b\x6f\x33\x85\x05\x06\x77\x4a\xd2\x13\xdd\xc4\x0d\x97\xe7\x78\x56\x27\xe7\x98\xb2\x1b\xb9\x0b\xad\xfc\x05\xdb\x9f\xfe\xdd\xc9\xfd\xb6\xf3\x17\x5a\x8d\x7c\x8e\x9c\xcf\xa9\x99\xfb\x6d\xbb\x13\x5b\xf3\x2d\x0b\x0a\xf5\x49\xd7\xcc\x55\xe7\xd7\x22\xff\xd0\xd3\xfc\x43\xf3\xb5\x68\xb6\xf3\x17\x72\x5b\x1b\x9f\xb9\xf9\x76\x66\xeb\x6d\xec\xb7\x7f\x31\xed\xf9\xb6\xee\x38\x7b\x10\x19\xea\x37\x71\x1d\xde\xc6\xe4\x10\x9d\xa0\xf7\x41\x8f\x63\x74\x82\x7e\xff\x72\x33\xc9\x38\xd4\x6f\x65\xac\xe0\xa2\x3d\x1e\x9f\x79\xfe\x84\x56\x5b\xa3\xd4\x77\xd1\xd1\x97\x9e\x5f\xfc\x3b\x00\x00\xff\xff\x1c\xd0\xdc\x9a\xb6\x88\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6d\x73\xdb\xb8\x76\xfe\xae\x5f\x81\x41\xda\x4e\xdc\x4b\x67\x45\x59\x6f\xf6\xcc\x76\x26\x71\xe2\xbd\x69\x93\xbd\xe9\x3a\x37\x1f\x9a\x64\x34\x10\x09\x49\x88\x49\x80\x03\x80\x8a\xdc\x54\xff\xbd\x03\x92\x92\x45\x12\xa4\x29\x4a\xb2\x24\x0b\x9b\xd9\xdd\x50\x07\xaf\x0f\x80\x83\xe7\x39\x04\x49\x14\x90\x2f\x98\x0b\xc2\xe8\x15\x20\x54\xe2\x31\xc7\x48\x7a\xf7\xaf\x18\x1f\xff\x36\xb5\x91\x17\x4c\x90\xdd\xb8\x23\xd4\xbd\x02\x7f\x70\x34\x42\x14\xbd\x45\x62\x32\x64\x88\xbb\x0d\x1f\x4b\xe4\x22\x89\xae\x1a\x00\x50\xe4\xe3\x2b\x20\xee\xa9\x8b\x05\x11\xe7\x84\x8e\x38\x3a\xff\x31\xf5\xcf\xdd\x65\x72\x00\x3c\x34\xc4\x9e\x50\xc9\x01\x40\x41\xf0\x90\x3e\xfa\x65\x71\xf1\x8a\xb0\xdf\xca\xad\xf2\x3e\xc0\xaa\xb9\x23\x8e\x84\xe4\xa1\x23\x43\x8e\x1b\x22\xc0\x4e\xb5\x96\xbc\xfa\x21\x18\x6d\x00\xa0\xfe\x77\x05\xfe\x2f\xaa\xfc\x57\xf4\x5f\x00\x20\xa2\x94\x49\x24\x09\xa3\x02\x5e\x2d\x7f\x06\x00\x7a\x44\x48\x78\x05\xbe\x2e\x7f\x79\xc8\x94\xa4\x18\x86\xc4\x93\xef\x29\xbc\x02\xb6\x95\xb6\x28\x94\x04\x0b\xb9\x83\xe1\x15\x80\xe7\xe7\x0b\x2c\xc1\xf9\x39\xcc\x24\xc5\x14\x0d\x3d\x95\x4c\xf2\x10\x67\x6c\x13\xe2\x16\x58\x88\xc3\xe8\x35\xf3\x18\x57\xe5\xf3\xf1\x10\xbd\x6c\x5a\xa0\x65\xdb\x16\x68\x75\x3a\x16\xb0\xcf\xb2\xd5\x78\xc4\x27\xaa\x3b\x76\xb3\x99\xb1\x28\xfc\x54\x29\xaf\x1f\x80\x00\xff\x06\x5e\x7b\x98\x4b\x91\x2d\x45\x4c\xd8\xcf\xa8\xc3\xd9\x42\xd4\x08\xa9\x42\x96\x98\xc3\x15\xfb\xdc\x2a\xc6\x30\x8d\xd4\x27\xce\x7c\x2c\x27\x38\x14\xeb\xc0\x84\x67\x41\x8c\x03\x16\x58\x8a\x97\x01\x67\x0e\x16\x62\x10\x06\x92\xf8\x78\x20\xb0\xc3\xa8\x2b\x7e\xfd\x60\xc3\xdf\xbf\xc1\x7f\xf9\xc1\x86\xdf\xa0\xa5\x3a\x2d\x02\xe4\x60\xf5\xd3\xf2\xe2\x1b\x9c\x7f\xb5\xfd\xef\x67\xe0\x3f\x40\x13\x3e\x8a\x78\x84\xf4\x65\xd7\x02\x97\x5d\x1d\xe2\x0b\x5c\xff\xc2\x42\x22\x2e\xc1\x5b\x2c\xb1\xa3\xe6\x59\x65\x50\x85\xc4\x81\x2a\xc1\xf6\xb3\x59\x24\x1a\xff\x17\xbe\x17\x49\xa7\x55\xf1\xe7\x12\x8d\x73\xa9\xf0\x4c\xde\x30\xee\x23\x35\xf0\x30\x86\x03\x44\x20\xe5\x52\x12\xe9\xe1\x87\xa4\x49\x93\x53\x63\xb8\xfc\xfb\xf7\x46\x66\x54\xa1\x8b\x85\xc3\x49\x10\xf5\xed\x0a\xc0\x65\xe1\x10\xbb\x44\x6a\x86\x0d\x8e\x29\x96\xef\x5d\x78\x05\xda\xbd\xe6\x72\xe9\xc0\x31\x47\xc1\xe4\x33\x63\x9e\x24\xc1\xea\x9a\x82\x44\x25\x6d\xad\x5c\x4b\xcc\x51\x52\x9b\xdd\xe9\xb6\xba\xcd\x76\xe7\xa2\xdd\x69\x5d\x2c\x53\x78\x84\xde\x89\xd4\xe2\x5d\x9d\x76\xd1\x58\xaa\x7e\xe2\x99\xc4\x9c\x22\x0f\xa8\xf4\x29\x4c\x20\xa1\x8e\x17\xba\xf8\x0b\xe2\x42\x33\xe9\xa0\x44\xe3\x74\xf9\xea\x0f\x5c\x38\xa1\x54\x51\xaa\x3e\xe5\x93\x56\xd1\xfc\x6e\x35\xca\x16\x8f\x78\x48\x3b\x6f\x64\x72\xc0\x00\x51\xec\x95\x74\xce\x61\x9e\x87\x02\x81\x15\x68\x23\xe4\x89\x74\xc3\xc7\x9c\xb8\x9f\x58\xda\xd5\xa9\x3f\x70\xa2\x71\x63\x3f\x15\xee\xed\xcc\x8f\x33\xcd\x4c\xbd\x57\xbf\x15\xad\xf8\x78\xfc\xec\x56\x27\xf5\xe3\x43\x37\xd2\x60\x70\x1c\xe0\x68\x16\xd2\xd0\xf3\x52\x96\x68\x92\xaa\x61\xfb\xef\x90\x38\x77\xe0\x06\x39\x19\x07\xb5\x04\x92\xb3\x9f\x2b\x08\x5a\x05\x38\x21\x67\x82\x3f\x13\x1f\xb3\x50\x5b\x9d\xa3\xdc\xeb\x1b\xe4\xdc\x8d\x39\x0b\xa9\x1e\xcd\x28\xcd\x17\xe4\x85\x3a\xcf\x14\x5b\x35\xd3\x24\xf2\xd7\xad\x76\xc7\x02\x9d\x76\xfc\x6f\xf3\xd5\x65\xce\x7f\xc4\xa9\x2e\x7a\x16\xb0\x5b\x97\x16\x68\x37\x55\xb2\x7e\x41\xba\x4e\xd3\x02\x76\xaf\x65\x01\x55\x6a\xf3\xd5\x65\xef\xac\x78\xba\x55\x72\xb9\xd0\xc5\x0e\xf1\x91\x27\xb2\xd3\xa2\x68\x51\xab\x3f\x10\x73\xce\xb8\x16\xa9\xd1\xd2\xb9\x64\xea\x19\xa3\x70\x8c\xf3\xd3\xd1\x47\xb3\x05\xae\xf9\x2d\xcb\x27\x74\x61\xcc\x9a\x94\x33\xd5\x35\x40\xcd\x9f\x09\xc7\x62\xc2\x3c\xf7\x43\x44\x4b\x1e\x4b\xf5\x11\xf1\x3b\xbc\x5c\xfd\x85\x93\xbb\x74\x41\x5d\x58\xe9\xdf\xd4\x82\xea\x56\x5c\x4f\x76\x61\x95\x13\x4c\xc6\x93\x08\xcb\x34\x94\xd1\x3a\xeb\xa6\xea\x84\x8a\xe3\xf1\x29\xf2\x74\x33\x7c\xe9\x25\xd3\xf3\xc3\x47\x41\x40\xe8\xf8\x73\xbc\x98\xec\x22\x5b\x7e\x62\xa7\x01\x58\xd9\x02\xa7\x6a\xb0\x80\x64\x40\xe2\x59\x76\xef\x01\x89\x39\xd3\xe3\x4c\x9f\x4b\x0a\xe7\x88\x8e\x2b\x14\xde\x4a\x19\xe6\x85\xab\xc3\x47\xb3\xb7\x48\xa2\x4f\x8c\x50\x29\xf2\xb3\x0f\xd2\xd0\xf3\x22\xe3\x47\x16\x91\x33\xe8\x30\x4a\xb1\x23\xb1\x9b\xaa\x3b\x4a\xf7\x19\xcf\xb4\xae\x25\x60\x42\x8e\xc8\x2c\x3f\x82\x89\xe1\x86\x51\x79\x4b\xfe\x37\x2a\xbe\xd3\xfc\xd7\x4c\x1a\x8e\xf5\x79\x39\xce\x64\xed\x65\xb3\x46\x50\x7d\x44\x41\x85\xa1\x1b\x71\xe6\xab\xea\x55\xdb\x53\x85\x2c\x99\x85\xb2\xfe\xf9\xdb\x6b\x8d\x91\x2d\x33\x56\xc4\x5c\x04\x88\xdf\x79\x84\x6a\xfc\xc0\x88\x78\x5e\x9a\x76\x5d\xd8\x16\xb0\xed\xbe\x05\xec\xfe\xa5\xf2\x75\x76\x3f\xe7\x12\x47\xaa\xd1\xfa\xe5\xad\x6a\x59\x2d\x2f\x2e\xae\xd5\xb4\x80\x7d\x79\x71\x06\x4b\xbc\x49\xa3\x60\x6a\xc2\xc8\x1b\x5e\x33\x2f\xf4\xd3\xf4\x27\x31\xf2\x31\x96\x15\x00\x5f\x90\xd8\x8d\xd8\x6b\xa6\xfd\x29\xbf\x9b\x14\xc7\x09\x4e\x7b\xe0\xc4\x4d\x08\x89\xa8\xd4\xf8\xf4\x94\x17\x51\x7b\x6e\xe4\xe0\x5b\xb9\x24\x1e\x1e\x63\xea\x3e\x70\xc8\x7c\x1d\x3e\x96\x9c\x38\x7a\x1b\xc7\xa3\x88\x10\x42\xcd\x7c\x4a\x68\xb0\xdd\x6e\x37\x9b\x15\x27\xd4\xd2\x89\x8b\x5c\x75\x0f\x34\xe2\x9f\x11\x21\xce\x18\x39\xa2\x6a\x36\x62\x2a\x75\x13\x68\xc9\x30\x04\xa1\x63\x4f\xb1\xe4\xb4\xc7\x89\xbd\xcd\xea\x1a\xec\x67\xd7\x60\x94\xa2\xda\x1a\x64\x8a\x06\xc3\xdf\xd7\x5c\x7e\x0b\x87\xb7\xd6\x0a\x8c\x32\xfd\x99\x78\x54\x27\xe4\x11\x00\x0d\xcd\x7c\x37\x14\xaa\x16\x85\xca\x41\xb3\x29\x8b\x72\x91\x8c\xc8\xeb\x6b\xf1\x5e\x30\xc3\xa8\x72\x8c\xaa\xbb\x3d\x46\x75\xd9\x32\x8c\xca\x30\x2a\xc3\xa8\x8e\x9d\x51\x45\x31\xad\x41\x0d\x56\xf5\xef\x76\xb3\xd9\x34\xd4\x6a\x0d\x6a\x75\xab\xa0\x06\x4f\x40\xaf\x7a\x86\x5e\xed\x8c\x5e\x69\x08\x91\xa5\x49\x56\x95\x5f\xe5\xd9\x5a\x21\x5c\x6b\xd3\xab\xd6\x56\xb9\x55\x80\xb9\xa3\x06\xc8\xb0\xaa\x2c\xab\xb2\x5b\x6b\xd1\xaa\x88\x3e\x75\x3b\x86\x3e\x19\xfa\x64\xe8\xd3\xb1\xd2\x27\x11\xfa\x2f\x7f\x4c\xfd\x81\x8f\x7d\xc6\xef\x07\xa1\xc0\xee\x60\x78\x2f\x71\x45\xf6\x64\x01\xc4\x31\xfa\xfd\x1b\x9c\x60\x14\x7c\x83\xf3\x33\xc5\xa6\x7e\xcb\x14\xea\xa3\xd9\x46\x65\x6e\x40\xce\xb6\xc0\xbe\x76\xca\xb0\x7a\x4d\xeb\xb2\x59\x40\xb3\xfe\x8e\x51\x00\xd4\x80\xc0\xcd\x58\x54\xdf\xb0\x28\xc3\xa2\x0c\x8b\x7a\x32\x16\xd5\x5f\x9f\x45\xf5\xb6\xcc\xa2\x5a\x45\x36\xc3\xa2\x0c\x8b\x5a\x8f\x45\x59\xd5\xaa\x3c\xbf\x7c\xe4\x9f\x5a\xed\x69\x66\x1a\x53\x38\x01\x0c\xa5\xdb\x3e\xa5\xa3\x8c\xee\x82\xd5\xad\x14\x7b\xa2\xc4\xee\x4f\x46\xcf\x9f\x27\xb9\xb3\x36\xac\x70\x56\x52\xdd\x8e\x79\xe4\x9e\xce\xd5\xb5\x1b\x05\xf0\x2d\xce\xd5\xf5\xb6\x75\xae\xee\x3f\xbf\x7c\x04\x1f\xa3\xa5\xab\x9f\x72\x95\x8e\xd5\x21\x8f\x20\x71\xbd\x60\xc4\xbf\xd2\x2d\x1e\xc6\xc7\x29\xf3\xf0\xa9\x73\xc3\x1f\x30\x1d\xcb\x08\xae\x14\x0c\xd1\xb1\x48\x5c\x94\xad\x0a\xc5\xad\xc9\x64\x89\xe7\x65\xc7\x2e\x1a\xe6\xfc\x18\x7b\x78\x24\x3f\xb0\xf1\x1b\x24\x72\x01\xaa\xc4\xfa\x11\xcd\x34\xd0\x2f\x8c\x84\xea\x8d\x5c\xdd\x95\x2c\x2e\x38\x32\x17\x96\x1c\x5b\x97\x45\xaf\x18\xe7\xd5\x67\x6e\x4f\x33\x73\xbb\x15\x27\x6e\xa7\xb0\x4a\xe2\xe6\x16\x40\xe2\x8d\xf3\xad\x40\xd3\xb1\x6e\x78\xc0\xc3\xca\xcd\x8f\x6a\xcc\xf7\x34\xc3\xad\x0c\x84\x16\x94\x97\x6c\xc4\x9a\x3c\x92\x49\x54\xb4\xd9\x47\xce\xe4\x51\xdd\xa0\xa2\x3c\x8b\x44\x39\xc3\x4f\xe2\xca\x9c\x9b\x28\xa2\xf1\x39\xa2\x9a\xa3\x72\x0b\xe5\x85\xc6\x58\xd7\x66\x18\x28\x9a\xcb\x91\x4b\x42\xd5\xa2\xb4\xae\x08\x16\xfc\x38\x9f\x8d\x63\xea\x62\x8e\xd5\x62\x81\x23\x8f\x65\xb6\x9d\xf8\xee\xd7\x3f\xa6\x98\x73\xe2\x62\x4d\xbb\xa3\x4d\xbe\x68\x89\x0b\x89\x9c\x3b\x6d\xad\x6a\x9b\x0d\xb0\xfb\x21\x26\x6e\x79\xfb\xde\xc3\x59\x4f\xc9\x50\x72\x6c\x60\x1b\x37\xff\x5a\x39\x02\x63\xd5\x42\xd2\x61\xbe\x4f\xa4\x3c\x22\x38\x97\x2d\x2e\xc1\xed\xcd\xce\x71\x3b\xa6\xd8\xa7\x8f\x66\x25\x58\x5d\xaf\x81\xd5\xca\x55\x09\x49\xce\xf8\x10\xd5\x9d\x9b\x58\x4e\x66\x37\xbc\x68\x72\xfc\x85\xc7\xc9\x73\x5c\x9a\x8c\xb7\x13\x32\x7a\x9c\xff\x28\xd6\x9d\xea\x06\x94\xcb\x27\x52\xd2\x23\x0a\x7d\xf1\x17\x16\xcc\x0b\x93\x27\x51\x72\xbe\x49\x2d\xb1\x09\xe2\xd8\xd5\xf8\x7d\x65\x63\x5c\x6a\x76\xce\x68\x3f\x19\x2c\x28\x97\x13\xfa\xa1\x87\x24\x99\x62\xd8\x28\x98\x69\x4b\x7a\x16\x3d\x3f\x93\x6e\xfb\xec\x1c\xcd\x88\x76\xdf\x99\x25\x86\x4c\x9f\x86\xa1\x73\x17\xbb\xd3\x2c\x4c\xaa\xc3\xc9\x8e\x93\xbb\xaf\xff\x10\xb6\xd1\xe4\x2a\xde\x56\x97\x5b\xe7\xd7\xef\x85\x9d\xbb\x2f\xec\xc1\xfd\x20\x9e\xe7\x79\xdf\x0f\xfd\x68\x3d\x65\x9b\x28\x26\x2c\xfd\x4c\x53\x7a\x8e\xdc\xa3\x59\x95\x5b\x88\x0f\x8b\x4b\x57\x89\xf2\x32\x2a\xde\xa8\x85\x42\x19\x8b\xc8\xdc\x92\xb2\x68\xb3\xf9\x44\xf3\x7c\x58\x1a\xdb\x94\x69\x6e\x55\xed\x44\x0c\xca\x13\x76\x42\x6b\x2a\xec\x47\xd9\x68\xe9\xe6\x2f\xf2\xc8\xb8\x68\x31\x46\xb6\x0f\x78\xba\xec\x58\x43\x27\x16\x57\xa0\x5b\x2d\xdb\xe8\x9a\x93\xd4\x35\xdd\x1a\xba\xa6\x63\x74\x8d\xd1\x35\x47\xab\x6b\xb6\x19\x7c\xd5\x6b\x12\x23\x7c\x56\x85\xcf\x9e\x82\xdd\xc7\xad\x7d\xf6\x04\xda\xf3\x97\x3f\x8b\x1b\x0f\x46\x02\x19\x09\x64\x24\x90\x91\x40\x46\x02\x9d\xbc\x04\xb2\x5b\x35\x34\x50\xaa\xa0\x64\x1f\xcd\x37\x23\x52\xe4\xaf\xc5\xe7\x64\x04\x73\x03\x65\x64\x92\x91\x49\x87\x25\x93\xcc\xfd\x9e\xcd\xee\xf7\x18\x91\xf3\xa8\xc8\x39\x56\x49\x53\x11\x9c\xc5\xd3\x98\x09\x38\x53\x21\xd6\x02\xa7\x36\x36\x85\xef\xd2\xab\x87\xdb\x54\x88\x9a\x2b\xf3\xed\xce\x31\xe5\x4f\x85\x69\x0d\xdc\xb8\x10\x25\xd8\xbc\xdb\x39\x36\xc1\x01\x63\x13\x94\x62\x73\xb3\x73\x6c\xc4\x4f\x14\x1c\x2c\x38\xaa\x71\x25\xe8\xfc\xf1\x24\xe8\x1c\xf2\xec\x49\xda\x57\x82\xd1\xdf\x77\x8e\xd1\xba\xf8\x80\xbf\x01\x0d\xca\x07\x0b\x71\x30\xb9\x17\xe0\x65\x20\xc4\xdf\x14\xd8\x67\x25\x50\xbf\x5f\x03\xea\x95\xab\x83\x8a\x05\x7e\x8e\x64\x8f\x09\x04\x9a\x40\xe0\xc6\x81\x40\x08\xb7\x1b\x41\xcb\x4c\x94\x34\xb2\x29\xd3\xdc\xaa\xda\x05\x13\x06\xac\x18\x06\xdc\xd7\x0b\x66\xed\x56\xa3\x60\x5c\x17\x27\xe1\xfb\x5b\x3d\x09\x4f\x84\x03\xb5\xce\xc5\x9c\x83\x37\xe7\xe0\xd7\x38\x07\x6f\x5f\x14\xd6\x19\xcf\xdb\x66\xd7\x9c\x18\x31\x27\x46\x9e\xfe\xc4\xc8\xbd\x90\xd8\x1f\x38\x41\x38\x08\x05\x1a\xe3\x27\x8a\xc0\xe4\x5a\xae\x67\xe3\xf6\xa3\x6c\x3c\x6e\x7f\xcd\x28\xcc\xeb\xdd\x69\xa1\xa3\x05\x34\xe9\x40\x09\x6a\x6f\x60\x2d\x64\xd0\x74\x3c\x60\x53\xcc\xa3\x97\xaf\xbd\xac\x8b\xd3\x57\x7b\xf2\xfd\xec\xd0\xc0\x3a\xb7\x27\x25\x78\x5d\x67\xf0\x5a\xb9\x3a\x18\xa9\x77\xfd\xe9\x9f\x46\xe4\x1d\xb9\xc8\xd3\x69\x97\x2d\x69\xbc\x95\xf7\x83\xd8\x25\x6b\x2f\xd9\x69\x43\x4a\x4a\x34\x54\x2d\x19\x08\x6d\x68\x54\xe0\xe1\xa9\x40\xa3\x6f\x4e\x51\xdf\x74\x6b\xe8\x9b\xcb\xd4\x7b\x71\x92\x8d\xd4\xc8\x1b\x23\x6f\x9e\x44\xde\x78\x0c\xb9\x03\x34\xc5\x1c\x8d\xf1\xc0\xf6\x77\xcd\xc9\x6b\xdc\x55\x88\x1b\x7a\x6e\x1f\x90\x94\xd9\x65\xf7\x37\x15\x13\xc9\xb8\x2a\x95\xe5\xb0\x90\xca\x03\x1c\x51\x27\x08\x61\x71\xbf\x8f\x41\x14\x7c\x60\xc8\x35\xaa\xc0\xa8\x82\x0d\x55\xc1\x23\x6c\x1a\xc2\xed\x72\xe9\xcc\x24\x4a\xa3\x9e\x32\xcd\xad\xf2\xfe\x55\xee\x82\x11\x04\x46\x10\x9c\xb2\x20\xb0\x5b\x35\x14\xc1\x45\x2a\x53\xb2\x7d\x1a\x45\x60\x14\xc1\x6e\x15\x81\x3a\xbc\xac\x02\xad\xc8\x15\x03\x8f\x4c\xab\xc6\x9d\x01\xe3\x20\x9b\x75\x71\x71\x80\xe4\x53\x35\xef\x70\x94\xc4\x2a\x70\x2e\xc2\x3e\xa3\x35\x51\x8f\x33\x1f\x30\xee\x71\x03\x6b\x22\xff\x66\xb7\xc8\x07\x18\xdd\xd5\xc4\x5d\x65\x3d\x60\xd4\x55\xf3\x4a\x70\xdd\xe1\x01\xf6\x27\xc6\x44\x3f\x81\xea\x20\xf6\xe8\x5d\xbe\x75\x4e\xa8\xaf\x5c\x1d\x8c\x78\xfd\x1c\x8f\x8c\xd1\xaf\x46\xbf\x56\xd0\xaf\xcd\x92\x95\xf9\xe4\xe2\x2f\xdf\x98\x15\xdc\x53\xa6\xb9\x55\xde\xc3\x3d\x76\x42\x6b\x2a\xec\x47\xd9\x50\xee\x55\xc1\xae\x98\xd4\x92\xf2\x98\x73\x17\x39\x01\xf8\x62\x38\xb2\x87\xb9\x2f\xc2\x41\x8a\x95\x48\x82\x2f\x46\x0e\x6e\xb9\xb9\x95\xc5\x43\x4a\x13\x2d\x0a\x5f\xf4\xf0\xb0\xd5\xcd\x3e\x95\x06\x25\xe6\x3e\xa1\x48\x26\xb5\x74\x6c\xbb\xd7\xbe\xcc\x25\x22\x3e\x76\xcf\x7f\x22\x22\x09\x55\xf2\x08\xbe\x70\xec\x8e\x63\xf7\xb2\xe9\x56\x53\x60\x34\xec\x5f\x5c\x16\xbb\x9e\xfd\xa8\xf3\x42\x9d\xbd\xb9\x28\xed\xd7\x10\xa5\x76\xab\x7d\x7c\x0f\x2d\x47\x81\x82\xdb\xc2\xa3\x2d\x46\xd8\x9e\x9c\xb0\x55\x9f\xfb\xc1\xe2\x80\x19\xfb\xaf\x5f\x51\x13\xe7\xf3\x12\x06\xfa\x1a\x56\xdc\x23\xf6\xce\x32\xc1\xad\xea\x4c\x55\xae\xb9\x31\x91\x24\xd4\x25\x53\xe2\x86\xc8\x83\x85\xeb\xb2\x8c\x48\x1e\x0b\x5d\xac\xc6\xe2\x8e\x86\xe3\x58\xcf\xa4\x1f\x2b\x57\x87\xcc\xd5\x5c\x3c\x0c\xd5\x0e\x0c\x5f\xd8\x37\xbd\xfe\x75\xf6\x00\xd9\x32\xf6\x0f\x5f\xbc\xb9\xb1\xdf\xe4\x99\x1c\xa1\xa3\xe8\xe3\x32\x2f\x3a\xcd\x7e\xb7\xdd\xca\x9a\x25\x47\x31\xb3\x79\xd1\x7d\xf7\xb6\xf9\x2e\x97\xfd\x27\xe2\x34\xca\xfe\xee\xf5\x9b\x03\xe4\x5d\xe6\xae\xc8\x56\xef\x8a\xd8\xfd\x8a\xcf\x81\xb4\x9a\x85\x95\x96\x7f\x7f\xde\xde\x33\x2f\x55\x47\xa7\xdf\xf9\x81\xbc\x2f\xc8\xac\xec\xff\x83\x39\x2b\x30\x1b\x5a\x5b\x91\xd6\xe6\x6a\x7c\x2a\x56\x5b\xbe\x3b\x45\xb2\x58\x35\x3f\x76\x0e\xab\x85\xa5\x7c\xff\x7a\xdf\x79\x5b\x96\x1a\x79\xcb\xaa\x85\x16\x6e\x40\x87\x42\xc5\x09\x75\x38\x46\x02\xbf\xf4\xd8\x78\x88\x9c\xbb\x01\x9e\x62\x2a\xc5\x20\xba\x8f\x58\x91\x8d\x7f\xb5\xfd\xef\x67\x1b\x53\xf2\xad\x05\x8c\x7f\xfd\xf2\x54\x44\x65\x3e\xaf\x79\x6f\xa3\xf8\xae\x92\xdd\x3a\x86\x70\xf2\x07\x36\x06\xef\xa2\x51\x04\x2f\x6d\xff\xac\x22\xd5\x7f\x8a\xb0\x72\x25\x35\x50\xf1\x93\xfb\x26\xec\x7c\xc2\x61\x67\x98\xe5\xb0\x69\xe4\x53\xa6\xb9\x55\xde\xc7\x3d\x76\x43\x6b\x2a\xec\x47\xd9\x60\xee\x55\xcc\x18\x91\xf0\xec\x44\x42\xe5\x28\x75\x89\x48\x88\xc4\x40\xb7\xa2\x18\x78\xb2\x08\xf4\xca\xf2\x7a\x46\x54\xdc\x44\x98\xd3\x11\xe6\xc5\xa1\x07\x16\x60\x3a\x18\xb9\xe2\xb0\x1e\x6d\x7e\x9c\xc3\xaa\x76\x6f\x9d\xbe\x6e\x7c\x84\x44\xbd\x19\xf2\x08\xc1\xd4\xbe\x33\x72\x5f\xc7\x9c\x16\x58\x8e\x88\x87\xe3\xf9\x59\x11\x4e\xc0\x38\xc8\x67\x8e\xff\xba\xeb\x11\xd9\xda\x0c\x2e\x7c\xa8\xa4\x16\x7a\x3e\x9a\xd5\x06\x2f\x9a\xcb\x07\x8a\x5d\xf9\x4b\x4e\xdf\x66\xa0\x5b\xb9\x3a\x18\x0d\x7a\x43\x3c\x0c\xde\x62\xe1\x70\x12\x48\x45\x13\x0f\x47\x84\x9a\xb3\x4d\x27\x79\xb6\xa9\xb9\xbe\x3c\xcb\x67\x59\x01\x3e\x65\x9a\x5b\xe5\x5d\xdc\x5a\x2f\xd6\xef\x84\xd6\x54\xd8\x8f\xb2\xb1\x3c\x8d\xb7\xb6\xb5\x7a\x8d\x82\x71\x5d\x1c\xbb\xb9\x5c\xef\xad\x6d\xea\x19\x07\x41\x84\xc4\xd4\xc1\xf1\x13\xa0\x02\xea\x9d\xe6\xc3\xf7\xcc\xc1\x27\xc6\x3c\x01\x5e\xaa\x4f\xdb\x9c\x41\xad\x6b\x32\x2f\x75\x3b\xf1\x97\xba\xc5\x2f\x75\xab\x7c\x33\xaf\x5f\x58\x67\x34\xad\x2f\xf6\x7c\xcf\xee\x64\x6f\xba\xf9\x84\xde\x06\x88\x66\x87\xf2\x50\x23\x00\x0f\x6e\x6d\xe5\x8b\x01\x01\x63\xde\x60\x92\xfb\x0c\x97\x70\x58\x80\xdd\x2f\x48\x77\xe4\x41\x9b\x3b\x9b\x4a\x0d\x22\xf6\xb0\x23\xf5\xbb\x40\x72\x08\x75\x16\x79\xd9\x4f\xb7\xe0\x9d\x8b\x29\xb8\x55\x7c\x3d\xd5\x8e\x87\x61\xcd\xa7\x4b\x25\x9b\x17\x0e\xf7\xd1\xc4\x3d\x36\xf8\x54\x88\x05\x88\xab\x7e\xd2\x8d\xcc\xce\xd5\xbc\x5e\x87\x1f\xca\x27\x48\xec\x7e\x4d\xb1\xbf\xe9\xe7\x47\x9e\xc7\x98\xc4\x9f\x35\xc1\xee\xd6\x83\x30\xdb\x18\x97\xb5\x3f\xe9\xf8\x1c\x46\xa4\x7e\x44\xec\x7a\x8d\xc1\x58\xb9\x3a\x98\xc0\x84\x76\xe0\x4c\x74\xe2\xc8\xa3\x13\xe6\xeb\x91\xe6\xeb\x91\xe6\xeb\x91\xe6\xeb\x91\xeb\x7d\x3d\x52\xa7\x9d\xfb\x35\xb4\xb3\x7d\x91\xca\x95\xec\xb6\x46\x3d\x1b\xf5\x5c\xa8\x9e\xb3\xd3\x3c\xb1\xbc\x97\x98\xa3\x84\x5e\xd8\x9d\x6e\xab\xdb\x6c\x77\x2e\xda\x9d\x56\x3a\x3a\x13\xa7\xfd\xa4\x02\x8f\xef\xf3\xb1\x9b\xa7\x56\xdd\xff\xf0\x5c\xf0\x07\xa6\xe5\x92\x7b\x91\xa8\xd0\xd9\x1b\xbd\x6d\xf4\xb6\xd1\xdb\x46\x6f\x1b\xbd\x6d\xf4\xb6\xd1\xdb\x46\x6f\x1b\xbd\x6d\xf4\xb6\xd1\xdb\xcf\x5f\x6f\xdb\xdd\x5a\x82\xfb\xd2\x08\x6e\x23\xb8\x4f\x54\x70\xdf\x86\x7c\x4a\xa6\x8c\x57\xb9\xd5\x9d\x49\x5b\xe8\xfb\x8d\xfc\x36\xf2\xdb\xc8\x6f\x23\xbf\x8d\xfc\x36\xf2\xdb\xc8\x6f\x23\xbf\x8d\xfc\x3e\x35\xf9\xbd\xaf\xf3\xf6\x17\x9d\x46\xc1\xb8\x2e\xb4\x5e\x73\xbd\xf3\xf6\xc5\xce\x30\x7f\xbe\xfe\x4f\x46\xcf\xcd\x19\x7b\x73\xc6\x7e\x3b\x67\xec\x2f\xba\x85\x75\x46\x20\xf6\xfa\x26\x6a\x61\xa2\x16\xeb\x44\x2d\x56\x99\x7b\x44\xdf\x28\xa3\x79\x06\xb7\x4e\x00\x62\x51\xc0\x26\x31\x88\x8f\x58\x22\xf1\x48\xec\xe1\x21\x4d\xe1\x86\x67\x62\x0e\x8b\x98\x43\x32\x28\x26\xec\x70\x58\x61\x87\xa3\x1b\x96\x53\x88\x3c\x1c\xdd\xa0\x98\xe0\xc3\x32\xf8\x90\x8c\x9d\x89\x3f\x98\xf8\x83\x89\x3f\x98\xf8\x43\x2a\xfe\x60\x64\xf4\x29\xca\xe8\x7e\x0d\x19\x6d\xb7\x9b\x46\x47\x1b\x1d\xbd\x8e\x8e\xce\x4e\xf3\xc4\x52\xef\xee\x7f\xaf\xbf\x57\xf5\x7d\xcd\xfc\x80\x63\x21\xb0\x0b\xae\x3d\x24\xc4\xa3\xc7\x00\x0a\x32\x14\x6e\x04\x46\x97\x1b\x5d\x6e\x74\xb9\xd1\xe5\x46\x97\x1b\x5d\x6e\x74\xb9\xd1\xe5\x46\x97\x1b\x5d\x6e\x74\xf9\x69\xe9\x72\xbb\x5b\x4b\x98\xa7\x7a\x94\xec\xb8\x46\x98\x1b\x61\x7e\x22\xc2\xdc\xc5\xe0\x1a\x39\x93\x72\x31\xbe\x4c\x54\xe8\xf1\x8d\x00\x5f\x0a\xf0\xff\x67\xef\xdc\x7a\xdb\xb6\xbd\x00\xfe\xee\x4f\x41\x10\xff\x87\x14\x70\xd0\xc8\x8e\x73\x79\xf8\x3f\x24\x0d\xb0\xad\x40\x8a\x0e\xdd\xf2\xb2\x15\x06\x23\x33\x9e\x5a\x5d\x3c\x49\x4e\x93\x05\xfe\xee\x03\x29\xc9\xa2\x28\x52\xa6\x64\xd9\xb2\xe6\x03\xf4\xa1\xba\x98\xe2\x39\xe4\x21\x7f\xe7\xf0\x84\x04\x07\x1c\x1c\x70\x70\xc0\xc1\x01\x07\x07\x1c\x1c\x70\x70\xc0\xc1\x01\x3f\x42\x07\xbc\xab\xc4\xfc\xf3\xf1\x40\xd3\xae\xa9\xb7\x37\xb6\xda\x4a\xcc\xff\x89\x84\x8f\x64\x4e\xd1\x87\xc0\x65\xac\xcd\x86\x35\xe5\x40\xd3\xd7\x7c\x7c\x6d\xe8\x61\x6b\x3f\x5d\xd9\x70\xe7\xc2\xbd\xa2\xf8\xbc\xe1\xae\xaf\xcc\xbc\x74\x03\x17\x5c\xf5\x34\x31\x44\xe5\x13\xad\x13\x2e\x98\x61\x13\x27\x9b\x7f\x4c\x2b\x74\x7b\x5e\xf6\x81\x7a\xd3\xbd\xf1\x10\x43\x12\xd3\x13\xe6\x4c\xcc\xed\xe9\x82\x2c\x23\x46\xa2\x76\xe0\xcf\xa2\xe4\x5c\x0c\x33\xfe\xdd\xf2\xb8\x61\x23\xc6\xad\x41\xb1\x6f\x6f\x84\x8f\x58\xab\x15\x3a\x79\x7b\xb3\x99\x54\xab\xd5\xbb\x0a\x78\xbd\xc1\x86\x33\x4d\x87\x80\x9a\x0f\xc4\x91\x21\x93\xee\xe7\x6c\xe0\x0a\xe0\xec\x0b\x56\xd6\x85\xbd\x60\x11\xed\x93\x92\x3a\x39\x59\x17\xe3\x76\x85\x50\x3e\xd2\x4a\x21\x5c\x75\x8c\x7a\x47\x85\x2e\x57\x0d\xd0\xc5\x3a\xb3\x80\x5d\x80\x5d\x0e\x8b\x5d\xa2\xa5\x57\x87\x5c\xde\xf7\x80\x82\xa2\x98\xf8\xb1\x39\x25\x59\x1b\x29\x89\x3c\xcf\xd1\xd6\xa4\x34\x34\x6b\x29\xa5\x6a\xcd\x8f\x0a\xed\x8d\x4e\x3d\xf2\x52\x5f\xa7\xb7\x92\x4e\x85\xab\x83\xa1\xcf\xcf\x4c\x0c\x74\xb7\x4c\xb6\xc2\x06\x02\xed\x98\x40\x23\x3d\xb8\x01\x7f\x02\x7f\xf6\x95\x3f\xad\x8b\x06\x00\x7a\x7d\x0d\xfc\x09\xfc\xd9\x35\x7f\xa6\x4b\x87\xc4\x75\x03\x9b\xac\xd7\xf4\xa7\xbc\xbd\xf7\x82\x8f\x19\xad\xa8\xc7\xce\x06\x2c\xb3\x16\xa5\x02\x5d\x1a\xe2\xa0\x42\x71\x8b\x30\xf0\x82\xff\x84\xde\x32\x49\x2a\xd4\xd6\x07\xe2\xbb\xc9\x9a\xff\xfd\x67\x95\x40\x00\x7d\x7b\x87\xbe\xbd\xaf\x31\x77\x02\x7e\xed\x8b\xa1\x7c\xa4\x95\x43\xb8\xea\x18\xfd\xba\x5a\x65\x9e\x58\x03\x4d\xbb\x66\xab\xcc\xa3\xb6\x56\x99\xf9\x5f\x4b\xba\x01\x99\x39\xfe\x1c\x2b\x0d\x1c\xf6\x7b\x3b\xf2\xfd\xde\x92\xfd\xde\xac\x91\x69\xe7\x1d\x69\x3f\xca\x3b\xef\xb8\x50\x7a\x3a\x79\x83\xb7\x00\xde\xc2\xce\xbd\x05\xe6\x28\xd8\x6c\xbc\xa3\xd1\x94\x0d\x79\x74\x66\xc8\xb7\x28\x08\x51\xf9\xc7\xd9\xa5\x61\x21\x78\xa8\x9f\x91\x8d\xf8\x78\x4d\xc0\xa3\x8d\x04\x9c\x54\xb0\x61\x22\x69\xc5\x1f\x3d\x8f\xfa\x90\x48\xca\xa7\x34\x1a\x21\x85\x12\x20\x7d\xb4\x7f\xe9\xa3\x2a\x5a\x4d\x6f\x0a\xf7\xbe\x6e\x47\xf6\x7b\x47\x62\xa9\xa7\x14\x55\x5b\x78\xb4\x1a\x1e\xac\x10\xca\x47\x5a\x39\xaa\x5a\xeb\xe8\x43\xba\x40\xab\xbb\xa7\x55\x6b\xd4\x04\x57\xaf\x00\x57\x01\x57\xbb\xc0\xd5\x19\x75\x63\x72\xd2\x18\x5a\xff\x98\x78\x5f\xdf\x31\x72\xd5\x95\x53\x93\x5f\x79\x79\x78\xa8\x9f\x7e\xb6\xc9\x03\xa8\x15\x01\xde\xcc\xbf\x5c\xe4\x63\xc6\x5f\xc4\x35\x80\x4e\x26\xde\x3b\x00\xe0\x9e\x03\x70\x39\xd9\xb5\x25\xfc\x9d\x51\xdb\xf1\x88\xab\xd6\x4f\x1d\xb2\xc4\xb8\x4b\xae\x1c\x56\x4b\x69\x2c\x05\xf0\x71\xc6\xc7\x9d\xc5\xbd\xaf\x07\x9a\x76\xcd\xe2\xde\xe3\xb6\xe2\xde\xb7\xcb\xa7\x27\x1a\x26\x47\x9e\x60\xe5\x00\x04\x71\xef\x23\x8f\x7b\x27\x71\xef\x0b\xc3\xbe\x7b\x71\xa6\xfd\x26\xef\xbb\xe3\x31\xf8\x11\xe0\x47\x74\xe1\x47\x30\xf2\x7f\xe4\xc3\xdd\x96\x3b\x91\xcc\x9c\x90\xda\xf1\x9e\x43\xd9\x3b\xd9\x3c\x64\x74\x2e\x93\xfc\xb0\xb6\x2e\xb9\xaf\x3d\xb5\xc9\x82\xd8\x4e\xfc\xda\x17\x75\x66\xf5\x6d\xa8\xd2\xdb\x1a\x2a\x15\xae\x0e\xc6\x39\xba\xe3\x3a\x47\xc9\xec\x1f\x81\x6b\xd4\x73\xd7\x48\x45\xf4\xe9\x4d\xe1\x5e\x23\xe7\xa8\xc3\xac\x1f\xa9\xa7\x14\x55\x5b\x78\xb4\x1a\x9a\x0a\x01\x6b\x03\xb0\x36\x00\x6b\x03\x7c\x6d\x40\x45\xf4\x17\x0d\x88\xfe\x0a\x88\x1e\x88\xbe\x6b\xa2\xaf\xf1\xd7\x91\x32\x76\x66\x59\x2d\x62\x49\xe9\x45\x1f\x40\x96\x55\xb7\x21\xc5\xd6\x71\x0c\x84\x2b\xa0\x58\xa0\xd8\x9e\x51\xac\x10\xe2\x3f\xab\x30\xce\xbd\xd3\x61\xb9\x32\x82\xde\x0b\x8f\x56\xc3\x6a\x09\x3b\x14\x42\xf9\x48\x2b\x47\x55\x53\x02\xe2\x02\xe2\xee\x1a\x71\xad\x51\x13\xc6\x9d\x00\xe3\x02\xe3\x76\xcc\xb8\xdb\x45\xad\x3d\xc2\xc2\xe9\x10\xb5\x6e\x29\x6a\xdd\x89\x3a\x8f\x3c\x6a\x7d\xcf\x75\x0e\xbc\x0f\xbc\xbf\x89\xf7\x21\x6a\x0d\x51\x6b\x88\x5a\x1f\x4b\xd4\xda\xba\x6a\x82\xf4\x85\x6c\xac\x74\xaa\x05\xa4\x07\xa4\xdf\x27\xd2\xd7\x0f\x5b\xaf\xb9\xb3\xb5\xb0\x75\x37\x24\x7b\xc4\x61\x6b\xc0\x58\xc0\x58\x33\x8c\x85\xb0\x35\x84\xad\x4b\x61\xeb\xae\xb2\xd2\x2f\x2e\x07\x9a\x76\xcd\xb2\xd2\x27\x46\x59\xe9\xeb\x71\xf0\xe3\xdd\xed\x07\xac\x1c\x5c\x0e\x2f\xe7\x5c\xcb\xe3\xb5\xe0\xd5\x1a\x99\xea\xfa\x4a\xb8\xa7\xd4\xf5\xa5\x19\xbd\xb6\x73\x9a\x26\x00\x2e\x00\x6e\x0d\xc0\x65\xe9\x5c\x5f\xb8\x63\x3c\xb5\x03\xdf\x4f\x0f\x8b\x98\xb2\x1d\x9e\x9f\xe9\x9b\x16\x47\x45\x68\xdd\x06\x46\xdb\xdf\xa0\x9a\x57\xbc\x82\x48\x3f\x88\x33\xbd\xd4\x2f\xeb\xeb\x89\x6d\x47\xbd\x73\x25\xd5\x56\x82\xf2\x44\xbe\x5e\x6d\x24\x78\x47\x62\xf2\x48\x22\x8a\x04\x65\x1b\xd2\x37\x6c\x25\xd8\xea\x56\x82\x87\xca\xb5\xb0\xcf\xe0\x7e\xf6\x19\x1c\x48\x9f\x63\xab\x7e\x6c\x58\x60\xda\x1a\x9f\xe5\xe3\x15\x8e\xec\xbf\xa8\x47\x1e\x68\x18\x25\x6e\x6f\xbe\x2f\x33\x8e\xe2\x57\x9e\x0a\x8c\x67\x24\xfc\x9e\xff\x22\x26\xf3\x62\x07\xc4\xd1\xab\x3f\xa3\x91\x23\x8e\x82\xd8\xf1\x9f\x42\x82\x4b\xd5\x88\xa9\xb7\x60\x79\x5b\xfe\xbc\x20\x36\x76\x9d\x28\x96\x7a\x75\x49\x29\xee\x43\x7a\x8c\x73\x59\xfb\x02\x49\xbd\xe9\x0e\x88\xf6\x5e\x17\x61\xf0\x8d\xda\x71\xc5\xf9\xd0\xf9\x3b\x55\x3d\xd1\x2c\x2e\xcd\xde\xa3\x4f\x8e\xef\xa4\x01\x85\xa4\x3b\x4e\x13\x23\x3f\x59\x2e\xde\x22\x1a\x3e\x3b\x49\x14\x2a\x99\x6c\x86\x68\x3d\x0f\xc9\x9b\xdc\x66\xdb\x1a\xc8\x63\xa0\xe3\xdb\xee\x72\x46\x6f\x5c\x1d\xda\x65\x36\x80\x3f\x65\x45\xcb\x25\x7b\x4b\x37\x76\x34\xbf\x4e\x47\x31\xec\xeb\x7e\x1c\x2c\x62\xe5\x84\x81\x10\xfe\x7b\x49\xc3\xd7\xad\xe5\xce\xfb\xad\x64\xa6\x38\xa4\x73\xfa\xa2\x08\x64\xe1\xe8\xbb\xb3\xf8\x3d\x74\xbf\xbc\xfa\xb6\x46\xac\x74\x42\x91\x4b\x8c\xc9\x9c\x77\xb1\xe8\xd7\xac\xea\x72\xd1\x59\xdf\x97\x65\x65\xf7\xf5\x3f\x4a\x67\x9d\x44\x21\xd2\xc3\x65\x44\x7f\x23\xf3\x8d\xec\xdd\x96\x31\x08\xc7\xa9\x97\x27\x20\xc1\x58\x32\x93\x3e\xf5\x68\x4c\x2a\x0c\xa6\xf8\x5e\xa5\xd1\x6c\xdd\x83\x6f\x16\x0b\xd7\xb1\x49\xe9\x80\x4a\xc3\x3e\xfc\x2d\x78\xac\xe8\xbd\x85\x07\xb2\xbe\x4d\x54\x67\xac\x3c\x43\xf5\x95\x14\xb8\xa9\x52\x0a\xd9\xd5\xb5\x62\xe3\x0e\x0d\xcd\xea\x95\xbe\x2b\xbd\x9a\x4f\x34\x85\xd1\x5d\x36\xfc\x75\x29\x4c\x0f\x43\x54\x5d\x01\x03\xb3\xcd\x0c\xc9\x5e\x46\x71\xe0\xe1\x81\x46\x55\x45\x35\x31\x5b\xc9\x19\x7b\xee\x96\x7b\xc1\xd6\x33\xcb\x8d\xe4\xbb\x16\x75\xf9\xbf\xe9\x94\xb8\x05\x28\xdd\xc9\x84\x22\x1c\x6a\x5d\x3b\x7f\x89\x84\x94\xfc\xff\x4f\x9c\x1e\x60\x3e\x74\x66\x8d\xa6\x9f\xb2\x55\xe4\xb6\xfb\xf1\xe1\x1e\xdd\xf3\xec\xaa\x64\x27\x04\xf4\xb3\x7c\xe0\xf6\x06\x2b\xe6\xb3\x54\x75\x3b\xae\x0d\x5d\x3a\xde\xbb\x74\xb6\x77\x93\x79\x6b\xb7\xea\x85\x59\x4e\x33\xcb\x81\xe5\x1a\x59\x6e\x7a\x82\xfd\xbe\x8c\xf7\x53\xe0\x9f\xee\xd1\x80\x55\xe7\xf3\x77\x64\xc3\xd5\x8a\xde\xa5\x19\x8f\x0e\xdb\x8c\xd7\xff\xff\x3a\x90\x0c\x85\xc7\x8e\x0a\x46\x88\x9f\x92\x3d\x79\xb1\x1f\xfc\x38\x1d\x8b\x81\x18\x1c\x07\xe9\x7d\xac\x2c\x66\xe1\xd8\xdf\x69\x58\x2c\xcc\x5f\x7b\xdd\xc3\x81\xdc\x12\xd3\x2c\x0c\x27\x33\x1e\x9e\x14\x2d\x14\x5b\x82\x53\xcc\xfe\x15\xbc\x64\xf6\x0f\x5b\x5e\xf1\x7a\x22\x5d\x5b\xf2\x8d\xf1\x99\xfc\x86\x14\x73\x1a\x49\xd7\xd6\x2c\x1f\x6b\x84\x46\xe3\x0a\x9c\x0a\xbd\xbd\x56\x2d\xe4\x8f\x5e\xc8\x1f\x95\x6b\x31\x3a\x97\x6f\x14\x13\x8c\xf1\xa5\x74\x3d\x3e\x13\xeb\xad\x6c\xb7\x7f\x02\xbe\xc7\x08\x7e\x0c\x83\x1f\x91\x80\x7e\x79\xe8\xf0\x4b\x0a\x87\xe8\x14\xfd\xc2\x42\x07\xe8\x14\x7d\x7c\xb8\xcf\x5f\x5c\xf2\x55\x19\xec\x5f\x9f\xbf\xbc\x5c\xba\x5e\x8e\xd5\xf8\x79\x1d\xbb\x98\x0c\x10\x42\x68\x35\xf8\x77\x00\x1c\xd0\xdc\x9a\xb6\x88\x01\x00"),
 		},
 		"/addons/ops/addon-ops-meta-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-meta-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 2098,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdf\x8b\x1b\x47\x0c\x7e\xbf\xbf\x42\x38\x29\xb1\xc1\xde\xf5\x3d\xf8\x21\x03\x67\xb8\x87\x94\x14\x0a\x3d\x8e\x50\x02\xa5\x18\x79\x57\xde\x9d\xdc\xee\x68\x22\xcd\xf8\x6a\x8c\xf3\xb7\x97\x19\xff\xa6\x21\x6d\xc3\xbd\xb4\xfb\x34\xab\x91\xf4\x7d\xfa\x24\x0d\x7a\xfb\x2b\x89\x5a\x76\x06\x7a\x76\x36\xb0\x58\xd7\x14\x15\x0b\xb1\x16\x15\xf7\xe5\xfa\xf6\xe6\xc9\xba\xda\xc0\x83\x70\x4f\xa1\xa5\xa8\x8f\xb1\xa3\x9b\x9e\x02\xd6\x18\xd0\xdc\x00\x74\xb8\xa4\x4e\xd3\x09\x00\xbd\x37\xa0\x1b\x57\x93\x5a\xcd\x96\xe3\x4f\x61\xb9\xfc\xf6\x6d\xd8\x78\x32\x60\xdd\x4a\x50\x83\xc4\x2a\x44\xa1\xec\xe4\x4f\xd8\x26\x01\x74\xb6\xc2\x60\xd9\x4d\xce\x94\xb3\x9b\x70\x47\x06\xb0\x23\x09\x13\x89\x1d\xed\x11\xce\x4e\x93\x27\xda\x18\xe8\x6d\x5d\x77\xf4\x8c\x87\xdc\x5f\xcf\x67\x60\x10\x24\xd2\xe0\x06\xc0\x61\x4f\x67\xd2\x93\x4c\x6f\x92\xca\x9f\x64\xa4\x94\x77\x0f\xa6\x9e\xaa\x24\x42\x23\x1c\xfd\x41\x8e\xc9\x3f\x0f\x87\xfc\xe5\xb3\x39\xfc\x00\xbc\x82\xfb\xe4\x05\x2b\x16\x40\xb7\x39\xe7\x49\x19\xe0\xfe\xe1\x27\x20\x57\x7b\xb6\x2e\xc0\x73\x6b\xab\x16\x5a\x54\x40\x68\x6d\xd3\x82\x60\x20\xe0\x15\xbc\xff\xf0\xe1\x01\x66\x1f\x3f\x82\x90\x7a\x76\x7a\xc2\x4a\xf4\x32\x09\x03\x3f\x46\xa5\x5f\x5c\x67\x1d\x3d\x92\x86\x7b\x6f\xdf\xdb\xa6\x7d\x77\x48\xfd\x4e\x84\xe5\x11\x03\x9d\xe2\x00\xd0\x39\x0e\x59\xb5\x0b\xb6\x59\x6e\x52\xc5\x86\x0c\xcc\x27\x57\x76\xc8\x18\xb0\x07\x01\x25\x59\xdb\x8a\x60\xbb\x7d\xbd\x9f\x9e\xe2\x60\xd9\xed\xc0\x3a\xf0\x5c\x5f\x5c\x79\xae\x77\xbb\x71\x56\x52\x3d\x5e\x45\x9d\x6c\xc9\x21\xd7\xee\x80\x12\xdd\x7d\xf5\xc3\x63\xc9\x50\x71\x4d\x30\xbf\x83\xd9\x74\x3a\x4a\xa2\x6c\xb7\xaf\xd7\xd8\xc5\x84\x97\xfd\x15\x3c\x09\x28\x55\xec\x6a\x60\x77\x96\xf5\xcd\x19\x2c\xcd\x60\xa2\x72\x81\x1f\xc5\xee\x76\x6f\x8a\xab\x4a\x95\xfd\x22\x4a\x67\xa0\x0d\xc1\xab\x29\xcb\xc6\x86\x36\x2e\xf3\x3a\x1d\xfb\x67\xf9\x74\x2c\x97\x1d\x2f\xcb\x1e\x35\x90\x94\x35\x57\x65\x8f\x0e\x1b\xeb\x9a\x05\xb9\xb5\x15\x76\x3d\xb9\xa0\x65\x60\x6f\x2b\x2d\x8f\x43\xb3\x50\xf6\x05\xd6\x5c\xbd\x5a\x45\x25\xce\xaa\x0a\x69\x40\x6f\x53\xf3\x8f\xf4\x73\x6d\x72\xdd\x3a\xfa\xc3\x8b\x81\xf9\x15\xe7\xa1\xc6\x7e\x98\xfc\x86\x89\xf4\x22\x35\x83\x64\x21\xf4\x39\x92\x06\x5d\xec\x75\xd1\x45\xc5\xd1\x85\xad\x06\x0c\x51\xef\xbe\x0c\x66\x45\x31\x18\xc3\x27\x5e\xde\x7d\x19\x5c\x4d\xe6\x60\xf7\xdb\xac\xff\x7d\x34\x82\xe5\x06\x86\x9f\x78\x39\xf6\x5c\x8f\x4f\xbd\x1a\xef\x85\x1c\x47\xb1\xe3\x43\xdb\x47\x23\x28\xbf\x9f\xd0\x0b\x31\x98\xc3\xb4\xb8\xbd\x60\xb1\x62\x31\x30\xeb\x2f\x2c\x97\x2f\xdd\xa9\xdd\xb4\x26\xb1\x61\x63\xe0\x19\xc5\xa5\xb7\xe8\xfb\xb6\x97\x84\xe0\xed\xf4\x87\x34\x9c\xc7\x32\xa1\xc5\x35\x01\x42\x87\x81\x5c\xb5\x49\x57\x73\xb8\xfd\xb7\xeb\xfb\xf3\x3e\xfa\x3f\xb0\xbc\xf9\xd9\x3a\x16\x9b\x44\xfb\x9f\x6c\x61\xf7\x97\x0e\x7c\x6d\x07\x5b\xab\x81\x1b\xc1\x7e\xf1\x39\xa2\x0b\xb6\xa3\xe1\xb4\x78\x3b\x86\xbf\xdf\x82\x65\xac\x9e\xe8\xdb\x6b\x30\x87\xdb\x62\xfa\x02\xb3\xfd\x67\x00\x00\x00\xff\xff\x26\x50\x05\xf0\x32\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdd\x8b\xdb\x46\x10\x7f\xf7\x5f\x31\x38\x29\xb1\xc1\x92\xec\x07\x3f\x64\xe1\x0c\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\xb3\x96\xc6\xd2\xe6\xa4\x9d\xcd\xcc\xae\xaf\xc6\x28\x7f\x7b\x59\x7d\xf8\x03\x4a\xd2\x86\x7b\x69\xec\x17\x69\x76\x76\x7e\x1f\x33\x23\xed\xcc\xef\xc8\x62\xc8\x2a\x68\xc8\x1a\x4f\x6c\x6c\x99\xe6\xc4\x48\x92\xe6\xd4\x64\x87\xd5\xe4\xc9\xd8\x42\xc1\x03\x53\x83\xbe\xc2\x20\x8f\xa1\xc6\x49\x83\x5e\x17\xda\x6b\x35\x01\xa8\xf5\x0e\x6b\x89\x4f\x00\xda\x39\x05\x72\xb4\x05\x8a\x91\x2e\x32\xbe\xa4\x86\xb2\xaf\x9f\xfa\xa3\x43\x05\xc6\xee\x59\x8b\xe7\x90\xfb\xc0\xd8\x95\x70\x67\x6c\x15\x01\x6a\x93\x6b\x6f\xc8\x26\x17\xca\x5d\x1a\x53\x8d\x0a\x74\x8d\xec\x13\x0e\x35\xf6\x08\x97\xa4\xe4\x09\x8f\x0a\x1a\x53\x14\x35\x3e\xeb\xa1\xf6\x3f\xd7\x53\x30\xf5\x1c\x70\x3a\x01\xb0\xba\xc1\x0b\xe9\xa4\xa3\x97\x44\xf9\x49\x87\x64\x6c\x39\x80\x89\xc3\x3c\x9a\x50\x32\x05\x37\xd8\x91\xfc\xfb\xeb\x91\x2b\x40\xf7\xac\x86\x17\x80\x57\x70\x1f\xb3\x60\x4f\x0c\xda\x1e\x2f\x75\x62\x05\xb8\x7f\xf8\x05\xd0\x16\x8e\x8c\xf5\xf0\x5c\x99\xbc\x82\x4a\x0b\x68\xa8\x4c\x59\x01\x6b\x8f\x40\x7b\x78\xff\xe1\xc3\x03\xac\x3f\x7e\x04\x46\x71\x64\xe5\x8c\x05\x90\xf4\x6e\x29\xf8\x39\x08\xfe\x66\x6b\x63\xf1\x11\xc5\xdf\x3b\xf3\xde\x94\xd5\xbb\xa1\xf4\x3b\x66\xe2\x47\xed\xf1\x7c\x0f\x40\x5b\x4b\xbe\x73\x6d\x50\x3a\xfe\x1b\x14\xd1\x25\x2a\xd8\x24\x37\x71\xe8\x30\xa0\x07\x01\x41\x3e\x98\x1c\xe1\x74\x7a\xdd\x4f\x4f\x3a\x44\xda\x16\x8c\x05\x47\xc5\xd5\x91\xa3\xa2\x6d\x17\x9d\x93\xe2\xf4\xcd\xad\x73\x2c\x26\x74\xda\x2d\x60\xa4\xdb\xab\x9f\x8d\x92\x21\xa7\x02\x61\x73\x07\xeb\xe5\x72\x1e\x4d\x39\x9d\x5e\x1f\x74\x1d\xb0\x6d\xfb\x7c\x01\x87\x0c\x82\x39\xd9\x02\xc8\x5e\x6c\x7d\x73\x01\x8b\x33\x18\xa9\x5c\xe1\x07\x36\x6d\xfb\x26\xbd\x51\x2a\xe4\xb6\x81\x6b\x05\x95\xf7\x4e\x54\x96\x95\xc6\x57\x61\xd7\xad\xd3\xd8\x3f\x43\xe7\xc7\x6c\x57\xd3\x2e\x6b\xb4\x78\xe4\xac\xa0\x3c\x6b\xb4\xd5\xa5\xb1\xe5\x16\xed\xc1\x30\xd9\x06\xad\x97\xcc\x93\x33\xb9\x64\xe3\xd0\x6c\x85\x5c\xaa\x0b\xca\x5f\xed\x83\x20\x75\xae\x32\x8a\xd7\xce\xc4\xe6\x8f\xf4\x3b\x6d\x7c\xdb\x3a\xfc\xcb\xb1\x82\xcd\x0d\xe7\x99\x84\x66\x16\xf3\x66\x91\xf4\x36\x36\x03\x79\xcb\xf8\x39\xa0\x78\xd9\xf6\xbe\xc8\x36\xa7\x60\xfd\x49\xbc\xf6\x41\xee\xbe\x4c\xd7\x69\x3a\x5d\xc0\x27\xda\xdd\x7d\x99\x8e\x72\xba\xd5\x98\xb6\x7f\xac\x9b\x3f\xe7\x73\xd8\x1d\x61\xf6\x89\x76\x0b\x47\xc5\xe2\xdc\xab\x45\x6f\xe4\x22\xb0\x59\x0c\x6d\x9f\xcf\x21\xfb\x7e\x42\x2f\xc4\x60\x03\xcb\x74\x75\xc5\x62\x4f\xac\x60\xdd\x5c\x45\xae\xbf\x74\xe3\x4f\xf0\x80\x6c\xfc\x51\xc1\xb3\x66\x6b\x6c\x39\xf9\xbe\xed\x45\x46\x78\xbb\xfc\x29\x0e\xe7\x28\x13\x2a\x7d\x40\xd0\x50\x6b\x8f\x36\x3f\xc6\xa3\x0d\xac\xfe\xeb\xfa\xfe\xda\xdf\xfe\x1f\x2c\x6f\x9c\xdc\xb3\xd8\xf8\xc9\xfb\x41\xb6\x70\x90\xf4\x8d\x1d\xac\x8c\x78\x2a\x59\x37\xdb\xcf\x41\x5b\x6f\x6a\x9c\x2d\xd3\xb7\x0b\xf8\xf6\x16\xec\x42\xfe\x84\x5f\x5f\x83\x0d\xac\xd2\xe5\x0b\xcc\xf6\xdf\x03\x00\x26\x50\x05\xf0\x32\x08\x00\x00"),
 		},
 		"/addons/ops/addon-ops-server-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-server-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 2112,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdf\x8b\xdc\x36\x10\x7e\xbf\xbf\x62\xd8\xa4\x64\x17\xd6\xf6\xde\xc3\x3e\x44\x70\x0b\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\x23\xdb\xb3\xb6\x72\xb6\x46\x99\x91\xf6\xba\x2c\x9b\xbf\xbd\x48\xde\x9f\x6d\x68\x48\xc8\x4b\xe3\x27\x59\x1a\xcd\xf7\xcd\x37\xf3\x49\x3b\xf3\x3b\xb2\x18\xb2\x0a\x06\xb2\xc6\x13\x1b\xdb\xe6\x35\x31\x92\xe4\x35\x0d\xc5\xe6\xf6\xe6\xc9\xd8\x46\xc1\x03\xd3\x80\xbe\xc3\x20\x8f\xa1\xc7\x9b\x01\xbd\x6e\xb4\xd7\xea\x06\xa0\xd7\x15\xf6\x12\x57\x00\xda\x39\x05\xb2\xb5\x0d\x8a\x91\xb4\x73\xfc\xc9\x0d\x15\xff\x7d\xea\xb7\x0e\x15\x18\xbb\x66\x2d\x9e\x43\xed\x03\x63\x0a\x72\x27\x6c\x15\x01\x7a\x53\x6b\x6f\xc8\x66\x67\xca\x29\x8c\xa9\x47\x05\xba\x47\xf6\x19\x87\x1e\x47\x84\x73\x50\xf6\x84\x5b\x05\x83\x69\x9a\x1e\x9f\xf5\x21\xf7\xe7\xf3\x29\x98\x78\x0e\x38\xb9\x01\xb0\x7a\xc0\x33\xe9\x2c\xd1\xcb\x04\x79\x83\x9c\x25\xac\x98\x79\x84\x13\x87\x75\x94\xa1\x65\x0a\xee\x20\x48\xf6\x35\x09\x20\x7d\x69\xad\x0e\x3f\x00\x2f\xe0\x3e\x46\xc1\x9a\x18\xb4\xdd\x9e\x33\x8d\x39\xe0\xfe\xe1\x17\x40\xdb\x38\x32\xd6\xc3\x73\x67\xea\x0e\x3a\x2d\xa0\xa1\x33\x6d\x07\xac\x3d\x02\xad\xe1\xed\xbb\x77\x0f\xb0\x7c\xff\x1e\x18\xc5\x91\x95\x13\x5a\xa4\x98\x68\x28\xf8\x39\x08\xfe\x66\x7b\x63\xf1\x11\xc5\xdf\x3b\xf3\xd6\xb4\xdd\x9b\x43\xea\x37\xcc\xc4\x8f\xda\xe3\xe9\x1e\x80\xb6\x96\x7c\xd2\xee\x82\x6f\x12\x1d\x45\x74\x8b\x0a\x56\xd9\xd5\x3e\x24\x0c\x18\x41\x20\x16\x60\x6a\x84\xdd\xee\xe5\x38\x43\xf9\x61\x67\xbf\x07\x63\xc1\x51\x73\x71\xe4\xa8\xd9\xef\xe7\x49\x4d\x71\xfa\xea\xd6\x69\x2f\x06\xa4\xda\x2d\x60\xa4\x3b\x56\x3f\x3d\x96\x0c\x35\x35\x08\xab\x3b\x58\x2e\x16\xb3\x28\xca\x6e\xf7\x72\xa3\xfb\x10\xf1\x52\xbc\x80\x43\x06\xc1\x9a\x6c\x03\x64\xcf\xb2\xbe\x3a\x83\xc5\x49\x8c\x54\x2e\xf0\x03\x9b\xfd\xfe\x55\x7e\x55\xa9\x90\x2b\x03\xf7\x0a\x3a\xef\x9d\xa8\xa2\x68\x8d\xef\x42\x95\x4c\x75\xec\xa0\xa1\xd3\xb2\xa8\x7a\xaa\x8a\x41\x8b\x47\x2e\x1a\xaa\x8b\x41\x5b\xdd\x1a\xdb\x96\x68\x37\x86\xc9\x0e\x68\xbd\x14\x9e\x9c\xa9\xa5\x38\x8e\x4d\x29\xe4\x72\xdd\x50\xfd\x62\x1d\x04\x29\xa9\xca\x28\x5e\x3b\x13\x9b\x7f\xa4\x9f\x6a\xe3\xeb\xd6\xe1\x5f\x8e\x15\xac\xae\x38\x4f\x25\x0c\xd3\x18\x37\x8d\xa4\xcb\x71\xbe\x4a\xc6\x8f\x01\xc5\x4b\x39\xea\x22\x65\x4d\xc1\xfa\x9d\x78\xed\x83\xdc\x7d\x9a\x2c\xf3\x7c\x32\x87\x0f\x54\xdd\x7d\x9a\xfc\x63\x36\x27\xfb\x3f\x96\xc3\x9f\xb3\x19\x54\x5b\x98\x7e\xa0\x6a\xee\xa8\x99\x9f\xba\x35\x1f\xa5\x9c\x07\x36\xf3\x43\xe3\x67\x33\x28\xbe\x9d\xd2\x77\xe3\xb0\x82\x45\x7e\x7b\xc1\x63\x4d\xac\x60\x39\x5c\xec\x5c\xbe\x79\xa7\x96\xe3\x06\xd9\xf8\xad\x82\x67\xcd\x36\xbe\x4a\xdf\xea\x61\x64\x84\xd7\x8b\x9f\xe2\x88\x1e\x4b\x85\x4e\x6f\x10\x34\xf4\xda\xa3\xad\xb7\xf1\x68\x05\xb7\x5f\x6b\xe2\x5f\xc7\xdb\xff\x03\x0b\xa7\xc7\xeb\x58\x6c\x94\xed\x07\xf1\x62\xff\xaf\x0e\x7c\xce\x89\x9d\x11\x4f\x2d\xeb\xa1\xfc\x18\xb4\xf5\xa6\xc7\xe9\x22\x7f\x3d\x87\x2f\x3b\xa1\x0a\xf5\x13\x7e\xc9\x0a\x2b\xb8\xcd\x17\xdf\x61\xbe\xff\x0e\x00\x00\xff\xff\xad\x21\xb6\x53\x40\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdd\x8b\xdb\x46\x10\x7f\xf7\x5f\x31\x38\x29\xb1\xc1\x92\xec\x07\x3f\x64\xe1\x0c\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\xb3\x96\xc6\xd2\xe6\xa4\x9d\xcd\xcc\xae\xaf\xc6\x28\x7f\x7b\x59\x7d\xf8\xa3\x94\x1e\x09\x79\x69\xec\x17\x69\x76\x76\x7e\x1f\x33\x23\xed\xcc\xef\xc8\x62\xc8\x2a\x68\xc8\x1a\x4f\x6c\x6c\x99\xe6\xc4\x48\x92\xe6\xd4\x64\x87\xd5\xe4\xc9\xd8\x42\xc1\x03\x53\x83\xbe\xc2\x20\x8f\xa1\xc6\x49\x83\x5e\x17\xda\x6b\x35\x01\xa8\xf5\x0e\x6b\x89\x4f\x00\xda\x39\x05\x72\xb4\x05\x8a\x91\x2e\x32\xbe\xa4\x86\xb2\xff\x3e\xf5\x47\x87\x0a\x8c\xdd\xb3\x16\xcf\x21\xf7\x81\xb1\x2b\xe1\xce\xd8\x2a\x02\xd4\x26\xd7\xde\x90\x4d\x2e\x94\xbb\x34\xa6\x1a\x15\xe8\x1a\xd9\x27\x1c\x6a\xec\x11\x2e\x49\xc9\x13\x1e\x15\x34\xa6\x28\x6a\x7c\xd6\x43\xed\x7f\xaf\xa7\x60\xea\x39\xe0\x74\x02\x60\x75\x83\x17\xd2\x49\x47\x2f\x11\xe4\x03\x72\xd2\x61\x19\x5b\x0e\x70\xe2\x30\x8f\x36\x94\x4c\xc1\x0d\x86\x24\x5f\x53\x20\xf2\x05\xe8\x9e\xd5\xf0\x02\xf0\x0a\xee\x63\x16\xec\x89\x41\xdb\xe3\xa5\x52\x5f\x03\xee\x1f\x7e\x01\xb4\x85\x23\x63\x3d\x3c\x57\x26\xaf\xa0\xd2\x02\x1a\x2a\x53\x56\xc0\xda\x23\xd0\x1e\xde\x7f\xf8\xf0\x00\xeb\x8f\x1f\x81\x51\x1c\x59\x39\xa3\x01\x24\xbd\x67\x0a\x7e\x0e\x82\xbf\xd9\xda\x58\x7c\x44\xf1\xf7\xce\xbc\x37\x65\xf5\x6e\x28\xfd\x8e\x99\xf8\x51\x7b\x3c\xdf\x03\xd0\xd6\x92\xef\xbc\x1b\xd4\x8e\xff\x06\x45\x74\x89\x0a\x36\xc9\x4d\x1c\x3a\x0c\xe8\x41\x20\x0a\x30\x39\xc2\xe9\xf4\xba\x9f\xa1\x74\x88\xb4\x2d\x18\x0b\x8e\x8a\xab\x23\x47\x45\xdb\x2e\x3a\x37\xc5\xe9\x9b\x5b\xe7\x58\x4c\xe8\xb4\x5b\xc0\x48\xb7\x57\x3f\x1b\x25\x43\x4e\x05\xc2\xe6\x0e\xd6\xcb\xe5\x3c\x9a\x72\x3a\xbd\x3e\xe8\x3a\x60\xdb\xf6\xf9\x02\x0e\x19\x04\x73\xb2\x05\x90\xbd\xd8\xfa\xe6\x02\x16\x27\x31\x52\xb9\xc2\x0f\x6c\xda\xf6\x4d\x7a\xa3\x54\xc8\x6d\x03\xd7\x0a\x2a\xef\x9d\xa8\x2c\x2b\x8d\xaf\xc2\xae\x5b\xaa\xb1\x83\x86\xce\x8f\xd9\xae\xa6\x5d\xd6\x68\xf1\xc8\x59\x41\x79\xd6\x68\xab\x4b\x63\xcb\x2d\xda\x83\x61\xb2\x0d\x5a\x2f\x99\x27\x67\x72\xc9\xc6\xb1\xd9\x0a\xb9\x54\x17\x94\xbf\xda\x07\x41\xea\x5c\x65\x14\xaf\x9d\x89\xcd\x1f\xe9\x77\xda\xf8\xb6\x75\xf8\x97\x63\x05\x9b\x1b\xce\x33\x09\xcd\x2c\xe6\xcd\x22\xe9\x6d\x6c\x06\xf2\x96\xf1\x73\x40\xf1\xb2\xed\x7d\x91\x6d\x4e\xc1\xfa\x93\x78\xed\x83\xdc\x7d\x99\xae\xd3\x74\xba\x80\x4f\xb4\xbb\xfb\x32\xfd\xc7\x6c\x4e\xdb\x3f\xd6\xcd\x9f\xf3\x39\xec\x8e\x30\xfb\x44\xbb\x85\xa3\x62\x71\xee\xd6\xa2\xb7\x72\x11\xd8\x2c\x86\xc6\xcf\xe7\x90\x7d\x3b\xa5\xef\xc6\x61\x03\xcb\x74\x75\xc5\x63\x4f\xac\x60\xdd\x5c\x45\xae\xbf\x79\xe3\x4f\xf0\x80\x6c\xfc\x51\xc1\xb3\x66\x6b\x6c\x39\xf9\xd6\x1d\x46\x46\x78\xbb\xfc\x29\x8e\xe8\x28\x15\x2a\x7d\x40\xd0\x50\x6b\x8f\x36\x3f\xc6\xa3\x0d\xac\xbe\x76\x89\x7f\xed\x6f\xff\x0f\x56\x38\xce\xef\x59\x6c\xfc\xf4\xfd\x20\xbb\x38\x48\x7a\x61\x13\x2b\x23\x9e\x4a\xd6\xcd\xf6\x73\xd0\xd6\x9b\x1a\x67\xcb\xf4\xed\x02\x5e\xde\x84\x5d\xc8\x9f\xf0\xa5\x55\xd8\xc0\x2a\x5d\x7e\x87\xf9\xfe\x7b\x00\xad\x21\xb6\x53\x40\x08\x00\x00"),
 		},
 		"/addons/ops/addon-ops-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-servicemonitor.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4e\x03\x31\x0c\x44\xef\xfb\x15\xfe\x81\x6d\xc5\x35\x77\x0e\x48\x20\x21\x21\x71\x77\x93\xa1\x44\xdd\xd8\x91\xe3\x16\xfa\xf7\x28\xbb\x65\x8b\x44\x05\xc7\x8c\xc7\xce\x1b\x9b\x6b\x7e\x85\xb5\xac\x12\xa8\xa8\x64\x57\xcb\xb2\xdf\x44\x35\x68\xdb\x44\x2d\xdb\xd3\xdd\x70\xc8\x92\x02\xbd\xc0\x4e\x39\xe2\x69\x71\x0d\x05\xce\x89\x9d\xc3\x40\x24\x5c\x10\xa8\x9d\x25\xa1\xe5\x36\x66\x79\x33\x1e\x88\x26\xde\x61\x6a\xdd\x40\xc4\xb5\x5e\x1d\xb3\xf2\xfd\xd8\x64\xdd\xfe\x5d\x8d\x5a\xaa\x0a\xc4\x03\xb5\x05\x62\xbc\xb0\xfe\xb2\xfa\xb9\x22\xd0\x0c\xd0\xdc\x8e\xd1\x8f\x86\xd9\x74\x0d\x37\x1e\x70\x0e\x54\x72\x4a\x13\x3e\xd8\x30\xb4\x8a\xd8\x21\x21\xa9\x6a\x16\x9f\x89\x47\x72\xb6\x3d\xfc\x59\xcd\x03\x15\xb8\xe5\xd8\xc9\x1a\x26\x44\x57\x5b\x52\x15\xf6\xf8\xfe\xf8\x23\xe6\x7f\xb1\xe6\x86\xfb\xcf\x6a\x68\x7d\xe9\x6b\xd7\x48\x33\xd5\xcd\xd4\x17\x0b\x91\x56\x18\xf7\xbf\xe9\x41\x56\xf1\xc4\xd3\x11\xeb\x9c\x65\xd6\x7a\x89\xb4\xbb\xad\xf7\xe3\xdd\xae\xf4\x05\xc3\x86\xaf\x00\x00\x00\xff\xff\x64\xc0\xa3\x41\x18\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4a\x03\x31\x10\x86\xef\xfb\x14\xf3\x02\x69\xf1\x9a\xbb\x07\x41\x41\x10\xbc\x4f\x93\xdf\x1a\xba\xc9\x84\xc9\x74\xb5\x6f\x2f\x49\xeb\x56\xb0\x28\xec\x65\x67\xbe\xc9\x7c\x7f\xc2\x35\xbd\x42\x5b\x92\xe2\x29\x4b\x49\x26\x9a\xca\x7e\x13\x44\x21\x6d\x13\x24\x6f\x97\xbb\xe9\x90\x4a\xf4\xf4\x02\x5d\x52\xc0\xd3\x99\x9a\x32\x8c\x23\x1b\xfb\x89\xa8\x70\x86\xa7\x76\x2a\x11\x2d\x35\x97\xca\x9b\xf2\x44\x34\xf3\x0e\x73\xeb\x00\x11\xd7\x7a\x25\x46\xe5\xfb\x67\x93\x64\xfb\x77\x37\x48\xae\x52\x50\xcc\x53\x3b\x4b\xb8\x8b\xeb\x2f\xd4\x4e\x15\x9e\x86\x40\x33\x3d\x06\x3b\x2a\x06\x74\x0d\xe7\x0e\x38\x79\xca\x29\xc6\x19\x1f\xac\x98\x5a\x45\xe8\x92\x28\xb1\x4a\x2a\x36\x8c\x1d\x19\xeb\x1e\xf6\x2c\x6a\x9e\x32\x4c\x53\xe8\x66\x0d\x33\x82\x89\x76\x86\x28\xb3\x85\xf7\xc7\x1f\x31\xff\x8b\x35\x06\xee\x3f\xab\xa2\xf5\x4b\x5f\xa7\x1c\x0d\xab\x9b\xa9\x2f\x08\x91\x54\x28\xf7\xdd\xf4\x50\xd6\xe2\xc2\xf3\x11\xeb\x39\xfd\x73\xeb\x4a\x17\x77\xb7\xeb\xfd\xf1\x6e\x77\x1a\x74\x81\x4e\x5f\x03\x00\x64\xc0\xa3\x41\x18\x02\x00\x00"),
 		},
 		"/addons/todo": &vfsgen۰DirInfo{
 			name:    "todo",
@@ -190,9 +201,38 @@ var assets = func() http.FileSystem {
 		"/addons/todo/04-todo-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-todo-example.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 3634,
+			uncompressedSize: 4338,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4d\x6f\xdb\x38\x13\xbe\xe7\x57\x0c\x8c\x00\xb9\x44\x72\xfa\xbe\xd8\xdd\x82\xc0\x1e\x5c\x27\x6d\xb3\x68\x5a\xc3\x72\x7b\x59\x2c\x02\x46\x1a\xcb\x44\x29\x92\x4b\x8e\x92\x1a\x82\xff\xfb\x82\xfa\xa2\x2c\xc7\x49\xdb\xdd\x16\xba\x88\x33\xc3\xf9\x7c\x38\x1c\x56\x95\x58\x43\x7c\xad\x1c\x71\x29\xdd\x4a\x67\x7a\xb7\x3b\x89\x80\x1b\xf1\x09\xad\x13\x5a\x31\xb8\x7f\x71\x02\xf0\x59\xa8\x8c\x41\x82\xf6\x5e\xa4\x78\x02\x50\x20\xf1\x8c\x13\x67\x27\x00\x00\x92\xdf\xa1\x74\xcd\x3f\x00\x37\x86\x81\xdb\xaa\x0c\x9d\x70\x2d\xad\x5b\xc6\x42\x4f\x6b\x3e\xe9\x4c\x3f\xc2\x4b\x75\x61\xb4\x42\x45\x03\x09\xc5\x0b\xec\x97\xce\x60\xda\x18\x32\xda\x52\x6b\x33\xaa\x17\x0c\x5e\x5e\xbc\xbc\x68\x95\x1a\xab\x49\xa7\x5a\x32\x58\xcd\x17\x2d\x8d\xb8\xcd\x91\x16\xfb\xa2\x0e\x25\xa6\xa4\xed\x8f\xf0\x7e\x94\x49\xab\x4b\xc2\x58\x1b\x54\x6e\x23\xd6\xe4\x77\x0c\x92\xbb\xf4\xdc\x9f\x98\xda\xaa\x8a\xc0\x72\x95\x23\x9c\xfa\x0c\x9f\xc3\xe9\x3d\x97\x25\x02\xfb\x1d\x4e\xe3\xa4\xdb\x58\x7b\x15\xbf\xab\xbd\xd8\xed\x5a\xb5\x55\x45\xfa\x0f\xa7\x55\xb3\x73\xb7\x63\x03\x4a\xad\x64\xb7\xab\xd5\xa3\xca\xda\x3f\xb1\x3e\x54\x3a\x53\x4a\x13\x27\xa1\x55\xa7\x99\x07\x0a\xfb\x26\xff\x0e\x55\x7d\x8f\x93\xcd\xdf\x13\x90\xdb\x68\xd7\x66\x2f\xaa\xaa\xc6\xf4\x5b\xed\xc8\x4b\xb7\x1b\x0d\xa7\x0d\x83\x69\x0f\x50\xf6\x14\xf4\x28\x14\x56\x28\x87\x69\x69\xf1\x2a\xcb\x71\x85\xb6\x10\xaa\x4e\xc3\x42\x4b\x91\x6e\x19\xcc\xa4\xd4\x0f\x9d\xaa\xc0\x66\x80\x59\xee\x8f\x23\x00\xe9\x4e\xd5\xf8\xa8\x1e\xc4\xe3\x09\x0f\x28\xf2\x0d\x31\x78\x71\x71\x31\x46\xa9\x28\x78\x7e\x1c\xa5\xd7\x9e\x9b\x90\x45\x5e\xfc\xa7\x58\x3d\x92\x71\xa9\xf5\xe7\xd2\xb4\x69\x68\x95\x48\x9d\x72\xc9\x60\xcd\xa5\xf3\xf1\x39\xe2\x54\xb6\x56\x89\xe7\xbd\xfd\x08\x04\x61\xd1\x2f\x7d\x0d\x72\x06\x92\x13\x3a\x1a\xc7\x7c\x57\x0a\x99\x1d\x8d\xf9\x95\xe7\xce\xb5\x5a\x8b\xfc\x67\xc4\x6c\xb4\xa3\xb9\x2e\x0a\x41\x0c\xaa\x06\x56\x16\x9d\x2e\x6d\x8a\x2e\x50\xca\x1e\x1c\x09\x5a\xc1\x65\x4d\x6d\xa4\x3a\x6f\x72\x41\xdd\x2f\x40\x69\x05\x83\xb3\x0d\x91\x71\x6c\x3a\xcd\x05\x6d\xca\xbb\x38\xd5\xc5\xb4\xf3\x4f\xe8\xa9\xf7\x2c\xc2\x2f\xbc\x30\x12\xe3\x5c\xd0\x59\xbb\x9b\xb6\x06\x19\xbc\x11\x54\xaf\x75\x49\xa6\xec\x35\x07\xe0\x3d\x02\x91\x15\xcf\x7b\x66\x53\xe1\x33\x6f\x83\x35\x55\x68\xd4\x3b\xb2\x9c\x30\xef\xcb\xdb\xc4\x90\x8c\xa8\x00\x6b\xab\x8b\xb0\x7a\xc6\x58\x6f\xce\x6c\x0c\xfb\x2d\xbe\x38\x1b\x71\x9c\xe1\x29\x32\xe8\x2b\xde\xb2\x9b\x40\x93\xda\x83\x9a\x44\x56\xe4\x39\xda\xbe\xc0\x51\x2b\xd2\xa0\x61\xbe\xf1\xfd\xa9\x67\xd5\x27\xa7\xa1\x05\x47\x1b\xf9\xeb\xc0\x1a\x63\x8f\x1b\xe3\x8e\x42\xef\x12\x8d\xd4\xdb\x02\x15\xfd\x3c\xfc\x59\x34\x52\xa4\xdc\x31\x78\xf1\xc3\x6f\xc8\xc7\x00\x10\xc0\xde\x12\x00\xa4\x28\x44\x77\xd9\x37\x5f\x81\x85\xb6\x5b\x06\x93\xff\xfd\xf2\xeb\x8d\x98\xf4\x1c\x8b\x7f\x97\xe8\x8e\xc9\x5e\x04\xd1\xa6\x2e\x4b\x4c\x2d\x72\x6a\x7b\x28\x16\xc6\x23\xb3\xdb\xbb\x9f\xe9\x83\x0b\xaa\x23\x42\x80\x51\x13\xa2\x22\x2e\x14\xda\xd8\x27\x3a\xae\x41\x11\xa3\x22\xbb\x35\x5a\xf8\xdb\xf7\xec\xcf\x49\x2f\x13\x05\xc6\xe4\x7c\x32\xbd\x13\x6a\xea\x36\x93\xf3\x49\x94\x4e\xce\x27\xa7\xc9\xea\xfa\x36\x99\x2f\xaf\x17\xab\xe4\x76\x31\x5b\xbd\x9d\x96\x8e\xe7\x38\xf9\x2b\xa0\xb9\xf6\x5e\x68\xb5\x12\x05\x3a\xe2\x85\x61\xa0\x4a\x29\x7b\xfe\x3e\x3c\x8e\x55\xef\xb9\x0a\x7e\x4d\x15\x87\x08\xf2\x5f\x1f\xe2\x9e\x75\x7f\xc9\xde\x0f\x09\xfe\x8b\x5a\x24\xae\x3e\x5c\x7e\xb8\xbd\x7c\x75\x9b\x5c\x2d\x3f\x5d\x2d\x47\x42\x00\xf5\xa5\x1d\x7c\x8f\xb2\xbb\x67\xf4\xbc\x9f\xdd\x5c\x1d\xd5\x52\x37\xb9\x67\x55\x7c\x4c\xae\x96\xff\x52\xc5\x62\x96\x24\xc7\x54\x54\x55\x98\x64\xe6\x5d\x52\x5d\x7c\xc9\x89\xdf\x71\x87\x71\xd2\x9a\x58\x70\xe7\x1e\xb4\xed\xc6\x93\xe3\xc6\x92\xf9\xdb\xab\x9b\xd9\x37\x79\x5c\x03\x74\x51\x4a\xd9\xdd\x27\x33\xf9\xc0\xb7\x43\x68\x8c\x3a\x45\xf8\xea\xad\x0c\xce\x60\xd8\x5e\xf7\x0e\xb0\x1f\xab\x1e\x04\x6d\x20\xc4\x39\xcb\x32\xad\x5c\xec\xdf\x1a\xf1\x42\x67\x2e\x5e\x76\xe2\x07\xd1\x1d\x1e\xfb\xfd\x03\x5d\x55\xda\x42\x7c\x53\xb7\x82\x77\x5e\xb6\x6b\x07\xed\x40\xe7\xdf\x36\xf3\xc5\xc7\x9a\x75\xa0\x1c\x20\x35\xa5\xd7\x31\x14\xd9\x1f\x03\x01\x9e\x6a\x2b\xc7\x7d\x59\x36\x4d\xe8\x71\x6f\x5a\xe6\xd3\xfe\x04\xa1\xe0\xd1\xe3\xbe\x0d\x9e\x41\xe1\x8b\xc2\xf1\x1b\xcd\x9c\xc3\xaf\xa9\xaa\x1f\x09\xf6\x58\x83\x01\xf3\x06\x9d\xef\x38\x8b\x66\xaa\xcd\xf0\x7e\x3a\x60\x46\x52\xe7\xcf\x6d\x6c\x21\xf5\x5a\xc8\xee\x9a\x04\xc8\x94\xeb\xa0\x36\x97\xa5\x23\xb4\xaf\x85\x75\xf4\x35\x58\x69\xb3\xe0\xeb\xfa\x5e\x67\x98\xb4\x37\xd3\x20\x25\x6a\x40\x1e\x4c\xfc\x63\xf1\xfd\xac\x7a\x7d\x2b\x2d\xd1\x8e\x9e\x10\x7e\xac\xee\xa9\x43\x6d\xfb\xc2\x4f\x95\xc8\xfa\xb6\x6c\xe9\xc8\xd9\x72\xe9\x06\xb3\x52\xa2\x7d\x5f\x97\x22\xc3\x35\x2f\x25\x45\x3d\x39\x08\xfa\xd7\x81\xa0\xed\x5c\x2b\xc2\x2f\x61\x30\x1c\xa5\xfd\x8d\xe5\x29\x2e\xd0\x0a\x9d\x25\x98\x6a\x95\x39\x06\xff\x6f\x1f\x1b\xe8\x28\x0c\xcd\xdf\x3f\xd9\x2c\xb8\xe5\xc3\xa9\x1a\x80\x97\xa4\x0b\x4e\x22\x65\x40\xb6\x0c\x75\x1e\xdc\x01\x3e\xbc\xbd\x3d\xb5\xbd\xfd\x76\x32\x9e\xef\x9e\x9d\xf0\x86\x6d\xa9\x9d\x28\x9f\x98\xba\xaa\x0a\x55\xb6\xdb\x9d\xfc\x33\x00\xfd\x37\x56\x76\xf2\x10\x00\x00"),
+		},
+		"/alternate": &vfsgen۰DirInfo{
+			name:    "alternate",
+			modTime: time.Time{},
+		},
+		"/alternate/ingress.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "ingress.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 788,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5f\x6f\xdb\x36\x10\x7f\xcf\xa7\x20\x8c\x01\x7e\x89\x64\x67\xc3\xb6\x82\x6f\xae\xe3\x36\x79\x48\x2b\x44\x5e\x5f\x86\x21\xa0\xa5\xb3\x4c\x94\xe2\x71\xe4\xc9\xa9\x61\xe4\xbb\x0f\x12\xf5\x3f\x71\xdc\x0d\x6b\x51\x3f\x99\x77\xc7\xfb\xf7\xfb\x1d\x75\xc7\xa3\xdc\xb2\x30\x3e\xe8\x14\x9c\x74\xe1\x22\x4d\x51\xbb\x70\x8d\x29\x86\x2b\x2d\x36\x0a\x52\xf6\xf4\x74\x11\x30\x61\xe4\x27\xb0\x4e\xa2\xe6\x6c\x7f\x75\xc1\xd8\x67\xa9\x53\xce\x62\xb0\x7b\x99\xc0\x05\x63\x39\x90\x48\x05\x09\x7e\xc1\x18\x63\x4a\x6c\x40\x39\xff\x9f\x31\x61\x0c\x67\xae\x8e\x51\xcb\x9a\x63\x28\x71\x56\xe9\x09\x53\x7c\x41\x97\x60\x6e\x50\x83\xa6\x9e\x85\x16\x39\xb4\x47\x67\x20\xf1\x81\x0c\x5a\xaa\x63\x06\xd5\x81\xb3\x37\xf3\x37\xf3\xda\xa9\xb1\x48\x98\xa0\xe2\x6c\xbd\x8c\x6a\x19\x09\x9b\x01\x45\x43\x53\x07\x0a\x12\x42\xfb\x2d\xb2\x1f\x75\xd2\x62\x41\x10\xa2\x01\xed\x76\x72\x4b\xe5\x8d\x5e\x73\xef\x4b\xed\x8f\xd1\xda\x1d\xba\xda\x2a\x38\x1e\xc3\x2a\xb1\x1b\x74\x54\x5a\x3f\x3d\xf9\xe6\x0b\xda\x71\x36\x6b\x81\xe0\xaf\xb5\x98\xba\x02\xa4\x76\x90\x14\x16\x56\x69\x06\x6b\xb0\xb9\xd4\x82\x24\xea\x08\x95\x4c\x0e\x9c\x2d\x94\xc2\xc7\xc6\x55\xa7\xe6\x0c\xd2\x0c\xbc\x2f\x6c\x5c\x8d\x29\xf9\xac\x9e\xf2\xf7\x08\x32\xdb\x11\x67\x57\xf3\xf9\x18\x0d\x99\x8b\xec\x34\x1a\xb7\xa5\x36\x26\x0b\x22\xff\x5f\x31\x39\xd1\x71\x85\xf8\xb9\x30\x75\x1b\x6a\x27\x0a\x13\xa1\x38\xdb\x0a\xe5\xca\xfa\x1c\x09\x2a\xea\xa8\x24\xb2\x36\x7e\xc0\x24\x41\xde\x1e\x2b\x25\x67\x4a\x10\x38\x1a\xd7\xbc\x29\xa4\x4a\x4f\xd6\xfc\xb6\xd4\x2e\x51\x6f\x65\xf6\x3d\x6a\x36\xe8\x68\x89\x79\x2e\x89\xb3\xa3\xa7\x95\x05\x87\x85\x4d\xc0\x75\x92\xa2\x25\x47\x0c\x56\x0a\xe5\x87\xb6\xb2\x6a\xb2\xc9\x24\x75\xd5\x17\x56\x72\x36\xdd\x11\x19\xc7\x67\xb3\x4c\xd2\xae\xd8\x84\x09\xe6\xb3\x26\x3f\x89\xb3\x8a\xd9\xf0\x45\xe4\x46\x41\x98\x49\x9a\x36\x94\x3b\x18\xe0\xec\xbd\xa4\xea\x8c\x05\x99\xa2\x63\x36\x76\x31\x9e\x51\x64\x2d\xb2\x56\xe9\xab\x9d\x96\x31\xb8\x47\xc1\xbb\x77\x64\x05\x41\xd6\xc2\xeb\x6b\x88\x47\x52\xc6\xb6\x16\xf3\xee\x74\x26\x58\x1b\xce\xec\x0c\xff\x3d\x9c\x4f\x47\x1a\x67\x44\x02\x9c\xb5\x88\x0f\x0a\x8d\xab\x0c\x3c\xa1\xac\xcc\x32\xb0\x3d\x52\x79\x13\xcf\x86\xe5\x4e\xe8\x0c\x3a\xbe\x95\xb9\x78\x59\x8f\x75\x95\xfd\x6d\xa7\x1a\x73\x4f\x18\xe3\x4e\x52\xef\x1a\x8c\xc2\x43\x0e\x9a\xbe\x1f\xff\x2c\x18\x25\x13\xe1\x38\xbb\xfa\xe6\x5f\x82\x97\x08\xd0\x91\xbd\x6d\xa2\x92\xb9\x24\xd7\x47\x3f\x87\x1c\xed\x81\xb3\xc9\xcf\xbf\xfe\x76\x27\x27\xad\xc6\xc2\xdf\x05\xb8\x53\xb6\xf3\xce\xd4\xe3\x72\x0f\x89\x05\x41\x35\xd8\x90\x9b\x92\x99\xcd\xdd\x61\xa7\xab\xd2\xb5\x46\xaa\x9e\xde\x41\x80\x01\x7a\x09\x6a\x12\x52\x83\x0d\xcb\x0a\x43\xff\x9c\x82\x26\x7b\x30\x28\xcb\xc2\xa7\x7f\x4e\x5a\x9b\xa0\x53\x4c\x2e\x27\xb3\x8d\xd4\x33\xb7\x9b\x5c\x4e\x82\x64\x72\x39\xf9\x29\x5e\xdf\x3e\xc4\xcb\xfb\xdb\x68\x1d\x3f\x44\x8b\xf5\xcd\xac\x70\x22\x83\xc9\x5f\x1d\x9b\xab\xec\x25\xea\xb5\xcc\xc1\x91\xc8\x0d\x67\xba\x50\xaa\x6b\xdc\x80\x1e\xa7\xd0\x3b\x87\xe0\xd7\xa0\xd8\x67\x50\x95\x59\x53\xe2\x20\x7a\xc0\x40\xef\xfb\x02\x2f\xf4\x4c\x5c\x7f\xbc\xfe\xf8\x70\xfd\xf6\x21\x5e\xdd\x7f\x5a\xdd\x8f\x8c\x18\xdb\x0b\x55\x40\x97\x7b\x90\x6e\xce\xf8\xf9\xb0\xb8\x5b\x9d\xf4\x52\x3d\x72\x67\x5d\xfc\x11\xbf\x92\xc8\xd7\xb9\x88\x16\x71\x7c\xca\xc5\xf1\xd8\x2d\x9d\xcb\xa6\xa9\x2e\xbc\x16\x24\x36\xc2\x41\x18\xd7\x21\x22\xe1\xdc\x23\xda\xb4\xde\x32\x5e\x69\xdd\xf2\x66\x75\xb7\xf8\x57\x19\x57\x04\x8d\x0a\xa5\xba\x65\xe3\x51\x1c\xdc\xc0\xe6\xd9\xfe\xd0\xbb\xca\xd9\x94\x4d\x07\xe2\x17\x06\xd8\xff\x9e\x8f\xb1\xff\x35\x03\x5a\xcd\xf2\x48\xfb\xd2\x40\x9f\xbb\xd5\x5b\x81\xfb\xad\x6a\x29\x19\x8d\xb7\xe2\x71\xa5\xe5\x67\x72\xa0\xea\x2d\x5d\x77\xe0\xca\x29\x8c\xfc\xa6\x97\xc2\x7e\xd6\x53\x06\x0a\xb3\x73\x17\xeb\x36\xbf\x93\x0a\x5a\xd3\x54\xbb\x46\xbe\x54\x85\x23\xb0\xef\xa4\x75\xd4\x7b\xd6\x1c\x09\x4b\x27\x20\x72\xc9\x0e\xd2\x42\x81\xfd\x50\x65\x9f\xc2\x56\x14\x8a\x82\x56\xdc\x19\x96\x4b\xa6\xa4\xc3\x12\x35\xc1\x97\x6e\xbf\x18\x65\xfa\xde\x8a\x04\x22\xb0\x12\xd3\x18\x12\xd4\xa9\xe3\xec\x97\x7a\x67\x85\x72\x05\x6e\x76\xaf\xff\xfe\x81\x8c\x84\x15\xf9\xf0\x61\x2a\x08\x73\x41\x32\xe1\x8c\x6c\x01\x3d\x4d\x8b\x5b\x59\xde\x08\xd7\x60\xcc\xca\xf1\x9a\x70\x76\x51\xe8\xb3\xbb\x5e\x4c\x5e\xf9\x78\x1f\x8f\xa0\xcb\x31\xfc\x27\x00\x00\xff\xff\xb5\x64\x42\xe9\x32\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x4f\x8f\xd3\x40\x0c\xc5\xef\xf9\x14\x56\x4f\x70\x48\xaa\x15\x7b\x40\x73\xe3\x80\xb4\x1c\x40\x2b\xba\xe2\xee\x66\xde\xb6\xa3\xa6\x9e\xc1\xe3\x54\xad\xa2\x7c\x77\x34\x69\x42\x59\x2d\xe2\x8f\x72\x71\x9c\xf8\xf7\xfc\x9e\x87\xa1\xa6\xf0\x4c\x2c\x9e\xde\xe0\x3b\x35\x9b\x8b\x78\xe4\x90\x9b\x8f\xe7\x14\x73\xaf\xa0\x55\x90\x9d\x22\xe7\xd5\x5b\x6a\x36\xd0\x13\xfc\x87\xce\xa0\xc2\x86\x87\x98\x4d\xf8\x88\x3c\x8e\x55\x4d\x9c\xc2\x37\x68\x0e\x51\x1c\xe1\x6c\x90\x52\xe6\xf5\xe9\x6e\x0b\xe3\xbb\x8a\xe8\x10\xc4\x3b\xfa\x74\xc5\x55\x44\x47\x18\x7b\x36\x76\x15\x11\x51\xc7\x5b\x74\xf9\x5a\x13\x71\x4a\x8e\xf2\xbc\xcc\xdc\x5b\x5e\x9b\x10\xd7\x7f\xfb\x6e\x97\x04\x47\x41\x9e\x95\xb3\x69\xdf\x5a\xaf\x98\x30\x2c\x12\x8d\xad\xac\xb6\x68\xc9\x2e\xc8\xb9\x99\x6d\x36\x87\x7e\x0b\x15\x18\x26\xce\x96\xdb\x03\xc4\xd7\x49\xa3\xc5\x36\x76\x8e\x1e\x9e\x9e\x1e\x37\xd5\x9c\xdb\x8b\xc8\x5e\xe7\xf2\x39\x7a\xd0\x4a\xe1\x83\xa2\xb5\xd5\x38\xfe\x83\x62\x82\x1e\x59\x20\x56\x2f\x73\x8e\xf6\x66\x29\xbb\xf5\x7a\x18\x9a\xaf\xb1\xbf\x09\x8c\xe3\xb4\x08\xc4\xcf\xe8\xd2\xbc\xe5\x52\xf3\xb2\x51\x45\x94\x13\xda\xab\x63\x5b\x62\xae\x69\x1f\xb3\x65\x37\x41\x94\x65\x87\x3f\x9e\xb8\x8c\x94\xa1\x61\x68\x5e\x09\x6b\xdf\xe1\xbf\x40\x57\x6d\x37\xc3\x0a\x98\x26\x9b\xcb\x51\x88\x12\xdb\xfe\xe7\x8d\x8a\xee\x7c\x8b\x5b\xab\x3c\x19\x7a\x0a\x2d\xbe\xbc\x34\x1e\xb9\xb7\x7d\xd2\x78\xbe\xfc\xee\xe7\xc7\xa8\xe6\xe8\xfd\xfd\xfd\xbb\x5f\x5c\x0c\x43\x4d\x10\x3f\x8e\xd5\x8f\x01\x00\xf4\x89\x0c\x17\x14\x03\x00\x00"),
+		},
+		"/alternate/route.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "route.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 792,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\xcf\x6a\xdc\x40\x0c\xc6\xef\x7e\x0a\x1d\x7c\xcc\xba\x94\xe6\x50\x06\x7a\xc8\x21\x50\x4a\x0f\x21\x29\xed\x59\xf5\x68\x77\x45\xbd\x9a\x41\x23\x2f\x31\x66\xde\xbd\xcc\xd8\xae\x43\x53\x28\xed\x4d\xfe\x64\xfd\xe6\xd3\x9f\x79\x3e\x00\x1f\xa1\xbb\x7f\x8e\x21\x91\xff\xc6\x76\x7e\x0c\xa3\x51\xce\x4d\x49\x29\xca\x89\xa0\xe5\x1b\x68\xcf\x21\x99\xe0\x85\xc0\x7d\x80\xee\x89\xf4\x4a\xfe\x6e\x30\x52\x41\xa3\x8f\x6b\x2e\xe5\xdc\x1c\x00\x23\x7f\x25\x4d\x1c\xc4\x81\x16\x58\x17\x22\x49\x3a\xf3\xd1\x3a\x0e\x6f\xae\x6f\x1b\x80\x1f\x2c\xde\x41\x7d\xaa\x01\xb8\x90\xa1\x47\x43\xd7\x00\x00\x0c\xf8\x9d\x86\xb4\xc4\x00\x18\xa3\x83\x34\x89\xa7\xc4\x69\xd5\xb6\xcf\x82\xfb\x5b\xde\xa6\x48\x0e\x58\x8e\x8a\xc9\x74\xec\x6d\x54\x7a\xd9\x5b\xe9\xe9\x06\xda\x2b\x0e\x63\xed\xad\xed\x9e\xb6\xea\x6a\xaf\xfb\x5c\xed\xe4\xbc\xb2\xe7\xd9\xc2\xa7\x14\x64\xa9\xcc\xd9\xbd\x50\x2a\x64\x1d\x1d\x89\x5f\x23\x3e\xbe\x86\xde\x89\x04\x43\xe3\x20\x1b\x19\x77\xc5\xfd\x93\xbf\xd7\xa8\xff\x31\xb9\x44\xa5\xb6\x54\xec\x13\x3d\xe0\xb6\xe4\xc3\x3c\xb7\x5c\xcd\xa6\x48\xfd\xb2\x9e\x72\x14\x85\xfd\xeb\x3a\x56\x46\x0c\x6a\xdb\x02\x0d\xf5\x44\xf6\x50\x14\x78\x7f\x7b\xfb\xae\xca\xb6\x2f\x98\x25\x51\x3f\x2a\xdd\xfb\x13\x7d\x21\xbd\xb0\xd4\x29\x3c\x84\x81\xfb\xc9\xc1\x23\x79\x56\xea\x6d\xa3\xed\x7f\x38\x50\x22\xe9\x75\x8a\x4b\xd2\xc2\x86\x5c\xae\xab\x1c\x29\xf7\xb4\x6a\xbf\xb5\x15\x70\xb4\x73\xd4\xf0\x3c\xfd\x71\x10\x3f\x07\x00\x79\x63\x98\x88\x18\x03\x00\x00"),
+		},
+		"/consolelink": &vfsgen۰DirInfo{
+			name:    "consolelink",
+			modTime: time.Time{},
+		},
+		"/consolelink/console-link.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "console-link.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 621,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x50\xbd\x4e\xf3\x40\x10\xec\xfd\x14\xd3\xa5\xb2\xa3\xaf\xbd\xee\x13\x0d\x48\x41\xa0\x04\xe8\x8f\xf3\x9a\x1c\xb1\xf7\x4e\xde\x35\x4a\x74\xf2\xbb\x23\xc7\xb6\x62\xa1\x10\xca\xdd\x9d\x9f\x9d\xc9\x61\xa3\x7f\xa3\x56\x7c\x60\x03\x17\x58\x42\x4d\x45\x88\xc4\xb2\xf7\x95\x16\x3e\xac\xbf\xfe\x65\xc0\xc1\x73\x69\x70\x37\xde\x37\x9e\x0f\x19\xd0\x90\xda\xd2\xaa\x35\x19\x00\xb0\x6d\xc8\x40\x4e\x5c\x92\x78\xc9\x53\x2a\x9e\x22\xf1\x6e\x50\x79\x6e\xc3\x27\x39\xed\xfb\x33\xb0\xb6\xef\x54\xcb\x48\x02\x6c\x8c\x17\xd6\xb4\x9b\xc7\xc1\xfd\xaf\xbb\x9e\x22\x19\x78\xae\x5a\x2b\xda\x76\x4e\xbb\x96\xae\xc0\x5c\x68\x62\x60\x62\xbd\x88\xe5\x53\xda\xbc\x1e\xe3\xfc\xa4\x0c\x81\x24\x5a\x47\x06\xbf\x85\x91\x48\x6e\x0c\xb2\x6f\xa9\x32\xd8\xab\x46\x31\xeb\x75\x4a\xc5\x36\x74\x4a\xf7\x41\x74\x90\x99\x93\x07\x67\xf5\x5c\xf4\xff\x18\x6b\x3f\x0e\x8f\xc4\xdd\xf9\xaa\x74\x54\x83\x55\x4a\xc5\x6e\xfe\x62\xd1\x77\xf1\x42\x47\xed\xfb\x55\x36\x95\xb6\xa4\xcf\x5d\x0a\xb9\x61\x75\x43\x64\x37\x22\x66\x1d\x20\xa5\x1c\xbe\xc2\x75\xf4\x43\x63\x3f\xe8\x75\xbb\x99\xde\x07\xfc\xb4\xb8\xe1\x70\xe1\x2c\x2d\x88\xcb\xbe\xcf\xbe\x07\x00\x8e\xd5\x07\xe5\x6d\x02\x00\x00"),
 		},
 		"/database": &vfsgen۰DirInfo{
 			name:    "database",
@@ -201,9 +241,46 @@ var assets = func() http.FileSystem {
 		"/database/syndesis-db.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-db.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 14554,
+			uncompressedSize: 23466,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6d\x7b\x22\x37\x92\xdf\xe7\x57\xd4\xcd\x24\xdb\x33\xb7\x0d\x06\xfc\x0a\xc9\xdc\x1d\x06\xc6\x76\x82\x81\xd0\x78\x66\x73\x5f\x78\x44\x77\x01\x5a\x0b\xa9\x23\xa9\xed\x21\xc4\xff\xfd\x1e\xf5\x0b\xdd\x40\x63\xf0\x24\xc7\x25\x7b\x33\x9e\xdd\x18\xa9\xa4\x7a\x55\x55\xa9\x4a\x4c\x01\x88\x4f\x3f\xa2\x54\x54\xf0\x1a\x3c\x94\x5f\x01\xdc\x53\xee\xd5\xa0\x21\xf8\x98\x4e\x6e\x89\xff\x0a\x60\x86\x9a\x78\x44\x93\xda\x2b\x00\x00\x4e\x66\x58\x03\x35\xe7\x1e\x2a\xaa\x0a\xde\xa8\x30\x43\x2d\xa9\xab\x0a\x6e\xb8\x26\x04\x62\x64\x84\x4c\x45\x0b\x00\x88\xef\xa7\x2b\xe2\xb1\xe4\x63\x91\x8a\xa3\x5d\xf3\x7a\xee\x63\x0d\x28\x1f\x4b\xa2\xb4\x0c\x5c\x1d\x48\xcc\x01\x73\xc5\xcc\x17\x1c\xb9\xce\x25\xef\x15\x40\xca\xc4\x2f\x01\x4a\x8a\xaa\x38\x27\x33\x56\x83\xdf\xe2\xcd\x00\xfc\xc9\xd0\x00\x8d\x88\xc2\x84\xf8\x04\x7c\x5e\x83\xd7\xe0\xb4\xda\xad\xc6\x20\x0b\x56\xf4\x88\x36\x22\xb1\xb3\x83\x43\x45\x7f\xc5\xb7\x39\x50\xef\x80\x28\x30\x93\xf0\xa1\xdf\xbd\xcd\x2e\x79\x9d\x41\x17\x53\x9c\xa5\x00\xa0\x00\xf1\x1e\xab\xc3\xe6\x27\x50\x64\x82\x35\x78\xdd\xae\x5f\xb6\xda\xd9\x8d\xa2\x1f\x0f\x95\x2b\xa9\xaf\x43\x1d\xbf\xee\x90\x19\x82\x18\x83\x9e\x22\xe4\x21\x37\x98\x0c\x85\xdb\xd1\x5c\xd5\xef\xae\x5a\xbb\xd0\x34\xa9\xba\x07\xe5\x13\x17\x21\x50\xe8\xc1\x68\xbe\x86\xf1\xd5\x17\xd8\xde\x9f\xc8\xac\xf2\xce\x82\x22\x33\x9f\xa1\x37\x4a\x4f\x42\x4a\x3a\xf1\xbc\x78\xbe\xe0\x8d\x8a\x6a\x9a\x5a\xdd\x9b\x7f\x3b\x1a\x51\x7e\x34\x22\x6a\x1a\x8f\x04\x5c\x53\x06\x66\x00\x0a\x2e\xbc\xf6\xd5\x2f\x0c\x0a\x53\x28\x57\xce\x8b\xa5\x62\xa9\x58\x86\xc2\x1d\x7c\xd3\xeb\x3a\x83\xab\x7e\xcb\xf9\xa9\x3d\xbc\x73\x5a\x7d\x28\xfc\x02\x05\x6f\x65\xb8\x59\x1f\xd4\x2f\xeb\x4e\xcb\x6c\x62\xc5\x96\x5b\xb6\x5e\x7f\x07\x9e\x88\x11\x01\xa0\x3b\x15\xf0\xfa\x13\xa1\x9a\xf2\x09\x8c\x85\x84\x9e\x50\x7a\x22\x51\x81\x42\xf9\x80\xb2\x58\x2c\xa6\xaa\x56\x0c\xd1\x87\x72\xfc\xd9\x13\x3c\x91\x57\xb4\xcd\xbf\x9b\x3f\xe0\x4a\x24\xe1\x6e\x89\x38\x92\xf5\x21\x1f\xdf\x7f\xdf\xea\x7e\x88\x07\x00\x1a\xfd\x56\x7d\xd0\x82\x25\xa5\xc9\x92\xef\xd6\x21\x42\x16\x93\x59\xf8\x74\x33\xb8\x86\x5e\xdd\x71\x3e\x75\xfb\x4d\xb0\xb2\x4c\x3b\xf5\xdb\x5e\xbb\xd5\xbc\x1c\x26\xd3\x56\xba\xd7\x55\xbf\xde\x19\x40\xbd\xdd\x86\x5e\xff\xe6\xe3\x4d\xbb\x75\xd5\x72\xa0\xdb\xd9\x44\x0f\x5a\x6c\x90\x92\x92\x1d\xf2\x51\xf0\x52\xe8\xc2\x5d\xfa\xfb\xf7\xdf\x5b\xad\xee\x07\x6b\x9d\x7e\xa7\x71\xdd\xba\xad\x43\xfd\x6e\x70\xdd\xed\xdf\xfc\x77\x7d\x70\xd3\xed\x6c\xa0\x58\x42\x0f\xea\x97\xed\x16\xdc\x7c\x80\x4e\x77\x00\xad\x7f\xdc\x38\x03\x07\x5c\xc1\x35\x71\x35\xbc\x1d\x53\xa9\xf4\xd0\x78\x02\xf8\x58\xef\x37\xae\xeb\x7d\x1b\x18\xd9\x18\x32\xde\x90\xf0\x79\x06\x06\x89\x37\x54\x22\x90\x6e\x16\xca\x28\x0b\x8d\x9f\x42\x23\x86\xd6\xbb\x94\x96\x9b\x8e\xd3\xea\x0f\xe0\xa6\x33\xe8\x2e\x91\x7f\xac\xb7\xef\x5a\x0e\xbc\xb5\x7e\x10\x68\xd9\xd6\x0f\xc4\xbd\x57\x82\x5b\xb6\xd5\x47\x0f\xae\x89\xb6\x6c\xcb\x1b\x59\xb6\x1b\x48\x89\x5c\x0f\x35\x9d\xa1\xd2\x64\xe6\xbf\xdb\x8b\x45\x2d\x3c\x01\x6f\xa9\x07\x4e\xab\x7f\x53\x0f\xb5\x74\x5b\xef\xff\x0c\x3f\xb6\x7e\xb6\x41\x13\x75\x9f\xa1\x5b\x18\x4d\x69\xf4\x0c\x7d\xad\xab\x56\x7f\x3f\x0c\x8f\x94\x23\xa3\x4a\x6f\xc5\x62\x00\x52\x2c\xbe\xa4\x2e\x26\x18\x6c\x98\x23\x91\xe9\xa7\xc9\xa3\x4a\x3f\xb8\x34\x5d\xc5\x47\xff\x4c\x27\x7c\x29\xbc\xc0\xd5\xae\xf0\xd6\xf7\x1d\x09\x71\x8f\x5c\xcb\x39\xf5\x92\x99\x2d\xd2\xcf\x52\x6d\x87\x9f\xe2\x2d\x6c\x43\x51\x48\x89\xa1\x20\xc4\xfc\x6e\xa9\xa3\x93\x8a\x6d\xd5\x47\x12\x03\xf8\x48\x39\xce\x89\xf4\x6c\x68\x13\x65\x0e\x38\xf1\x88\xb2\xe1\x5a\x3c\x22\x63\x70\x2b\x02\xae\x09\xe5\x96\x5d\x39\x3f\xb5\x2b\xa5\xf2\xb1\x5d\xbd\x28\x55\x6c\xeb\xd2\xb2\x8f\xdf\x99\xf3\xd1\xe8\x76\x3e\xb4\x6f\x1a\x03\x83\xff\x1d\x34\xbb\x46\xa2\xd7\x37\x9d\xab\x3f\x92\xda\x6a\xd9\xb6\xea\x92\x04\xff\x14\xd0\x52\x9a\x68\xb4\xa1\x45\x15\x32\x5c\x52\x0f\x0d\x32\x42\xc9\x51\x83\x43\x82\x07\x3a\xe1\x82\xdb\xd0\x21\x3e\x81\x8f\x84\x31\x9c\x5b\xf6\x49\xb5\x6a\xe8\x3f\xb5\xab\xe7\x95\x0b\xdb\x6a\xfc\xfd\xa0\x0c\x54\x6d\xab\x1e\x8c\x50\x6a\xf8\x44\x39\x2a\x1b\xfa\x54\xbb\x53\x9a\x65\x60\x4a\xa4\x27\x38\x27\x73\x1b\x3e\x4d\xa9\xe1\xd1\x11\x5c\xcc\x08\x34\x04\x51\xda\xb2\x2b\x95\xd3\x84\x81\xf2\xb9\x6d\xd5\x0f\xca\xc0\xc5\x85\x6d\x5d\x0a\xee\xc5\xf2\x57\x36\xf4\x58\x20\xe9\x28\x50\xd0\x47\x6f\x4d\xd4\x70\x52\x2e\x2d\x65\x5d\x3d\x34\xa9\xc7\xc7\xb6\xd5\x20\xf3\x40\xa5\xc2\x55\x36\x5c\x52\xc1\xa9\x0b\x1f\xa4\x98\x80\x33\x97\x64\x6a\xc3\x27\xc2\x18\x89\xff\x3f\x21\xbd\x72\x11\x52\x5e\xb2\xab\x17\x87\x17\xf2\x59\xd5\xb6\x1a\x53\xe2\xfb\xc8\x18\x6a\x1b\x7a\xd2\x18\x89\xb1\xee\x6b\xca\xd8\x6e\x13\xaf\x1c\x87\x26\x7e\x62\x57\xcf\x4f\x2e\x0e\x4d\x7c\xa5\x64\x5b\x0d\xc1\x26\x94\x43\x03\x19\x23\x52\xd9\x30\x98\xbb\x53\x25\x78\x44\xfe\xfe\x47\xf5\xf8\xd4\x58\x7a\xa9\x62\x57\x2f\x12\x3e\x4e\x0e\xc6\xc7\x79\xc5\xb6\x9a\xa9\x4d\x64\x6d\xe8\x96\xcc\xc9\x1a\xa9\x27\x17\xd5\xd8\x2b\x9e\x9f\xd8\x56\xfd\x90\x84\x9e\xda\x60\x35\x09\x27\xe9\x91\x6c\x0b\x1d\xa8\x17\xc8\xb9\x12\xb9\x44\x63\xec\x17\xc6\xd8\x0f\x69\x2e\xe6\x74\x35\xc5\x8c\xf2\x40\xc5\x0c\xd8\xd0\x98\x4a\xaa\x34\x25\xdc\x84\x1d\xa4\x9f\xd7\xc8\x2d\x97\x2e\x92\x08\x74\x1a\x09\xfb\xec\x70\xe4\x96\x6d\xab\x19\x70\x9e\x35\x87\x81\x24\x94\xa1\x7c\x5e\xe0\x1b\x71\xf4\x38\x8d\xa3\x67\x07\x96\xf9\xf1\xa9\x6d\x7d\x08\x74\x1a\x44\x4f\x4f\x4b\x25\x70\x98\x07\x85\x5c\xda\x1d\x4d\x26\x0a\xda\x48\x7c\x68\x52\x65\xae\x9d\xda\xb2\x8f\x97\x61\xe8\xa2\x7c\x7c\x68\x27\x03\x55\xdb\xba\x26\x92\x11\xbe\xe4\x61\xc5\x44\x8e\xcf\x0c\x71\xa5\xb2\x5d\xbd\x38\x8f\x89\x3b\x9c\x8d\x18\x5f\xf5\x83\x50\xe8\x4f\xa1\x37\x45\xe6\xa7\x47\x51\xd9\x70\xc3\x15\x9d\x70\xba\xee\x3f\x2a\x67\x27\x76\xb9\x5a\x2d\xdb\xd5\xf3\xea\xc9\x81\xcd\xa1\x72\x6e\x5b\x3f\x12\xdf\x55\x84\x7b\x73\xf8\x40\x66\x94\xcd\xc3\xf4\x44\xce\x6d\x70\x8c\x85\x40\x9b\xf0\xd4\x03\xc2\x95\x24\xdc\x2b\x7c\xa4\x3c\xd7\x5a\x56\xf8\x2a\x57\x92\x6c\xeb\xe2\xa4\x7c\x68\x2b\x29\x97\x6c\xeb\x47\xc1\x27\x6a\x42\xc2\xc4\x76\x30\x45\xf8\x21\xf0\x26\x98\x97\x64\xad\xaa\xe3\xe4\xcc\xd8\x8f\x31\xee\xb3\xd3\x03\xab\xc3\x20\x6c\x13\x79\x3f\x43\xe2\x65\x2d\xc7\x50\x6f\xc6\xf7\x10\x7a\x39\x71\x90\xe7\xa7\x87\xa6\xfe\xb4\x6a\x5b\x6d\x71\x2f\xe6\x64\x69\x42\xa1\xcf\x83\x8f\x88\x1e\xca\xdd\xc4\x1f\x97\x8f\x63\x8b\x39\x3f\x74\x2c\x32\x08\x7b\x24\x60\x70\x2d\x46\x23\x93\x2b\xa2\x7b\xaf\xb4\x18\x8f\x51\xc2\x40\xc0\x8f\x84\x89\xd4\xf1\xe7\x72\xd2\x25\xf7\x0f\x94\x31\x34\xb9\xcb\x32\x21\x38\xbe\x38\x70\x46\x70\x71\x66\x5b\x3d\xd4\x28\xe1\x96\xba\x53\x82\x6c\xa9\x8a\x9e\xa0\x5c\x43\x5f\x04\x13\x7c\xf6\xa2\x11\x70\x6d\x0e\xef\x45\xe8\x45\x2f\x0c\x0f\x95\x43\xeb\xe2\xd8\xb6\x7a\x52\xcc\x04\xd7\x42\xce\xd7\x6c\xe4\xb4\x7a\xba\x9a\x6d\x1d\x8e\xae\x8b\xb2\x6d\xfd\x14\x50\xe6\xa2\x47\xa0\x21\x11\xef\xed\x5c\x4b\x68\x08\x16\xcc\x46\x34\xa5\xb9\x7c\x66\x0c\xa2\x54\x35\xc2\x34\x01\xff\xef\x96\x7d\x7a\x30\xaa\x8f\xcf\x6c\xab\x4f\x8d\xe7\xcb\x38\x94\x5b\xc1\x35\xc2\x25\x32\x26\x6c\x70\x08\xd7\x86\xa1\xe0\xd7\x65\x8e\xa2\x2c\xbb\x7c\x5a\x4a\xdc\x77\xa9\x7a\x60\x49\x9f\x9c\xd9\x96\xe3\x12\x89\xae\x14\x8f\xf9\x42\xee\x07\x7a\x8a\x72\x2c\xa4\x67\xd9\x27\x27\xa5\xe4\xd2\x53\x8d\xe5\x7b\xb8\x13\x77\x72\x6e\x68\x9d\x4a\x12\xba\xb8\xe4\xda\x93\xf5\x1f\x61\x51\x85\xa2\x27\x49\x36\x33\x17\x0c\xd5\xa3\x90\x7a\x3a\xdf\xed\x18\xe1\x6c\xe9\x51\xaa\x27\x07\xf6\x28\xa5\x13\xc3\x9f\x44\x32\x33\x35\xdb\x16\x99\x30\xb4\xf7\xa0\xb8\x72\x76\x96\x5c\xa3\xab\xa5\xd3\x03\xa7\xea\xe7\x65\xdb\x72\x98\x20\xdc\x5c\xa0\x85\x2f\x29\x6a\x22\xe7\x51\x99\x22\x6b\x38\x95\xe3\xd2\xd2\x99\x1c\x3c\x45\xa9\x1e\xdb\x96\xe3\x0b\xad\xd5\xa3\x10\x1e\xda\x49\xfa\x15\x65\xb5\x70\x25\xc5\x63\x7e\x96\xe5\x68\xb8\x46\x86\x9c\x58\x76\xf9\x64\x69\x18\x95\xb3\xd0\x30\xaa\x07\xa3\xff\xec\xcc\xb6\x3e\xa2\x0c\xcb\x54\x6d\x84\x26\x2a\x2a\x37\xe2\x48\x25\xb4\xdc\xd2\xb9\xc9\x47\x8e\x0f\x9c\x8f\x94\x4b\x61\x3d\x82\x6b\xca\x83\x60\x96\x63\x0a\x69\xc8\x8e\xc3\xdd\xb9\x29\xac\x9d\xbd\xcc\x10\xe2\x6a\x72\xb7\x0f\xfd\x56\xaf\x5d\x6f\xb4\xe0\xc3\x5d\xa7\x11\xd6\xef\x89\xe7\x0d\x19\x12\xef\xed\x12\x18\x20\xaa\xce\x13\xee\x0d\xd3\x9a\xfc\x03\x91\xa6\xc6\x63\x67\xc0\x92\xea\x7c\xce\x94\x3f\x15\x3c\x77\x0d\xce\x08\x65\x79\x13\xd9\xca\xfe\xd6\x69\x4d\x4c\xe5\x20\x67\x5a\x46\xdd\x9a\x78\xe6\xdd\xab\xcc\x54\xbf\x35\xb8\xeb\x77\x1c\x78\x10\xd4\xcb\x0c\xb7\xeb\x9d\xab\xbb\xfa\x55\x0b\x2c\x9f\xf9\x13\xf5\x0b\xb3\xd2\x45\x75\x07\xbe\xb9\xec\x36\x7f\xfe\x66\x39\xd2\x6c\x35\xda\xf5\x7e\x6b\xf9\x19\xa2\x52\x7e\x8c\x2f\x15\xf4\x65\xeb\xea\xa6\xb3\x0e\x55\x7b\x6f\x7a\x0f\x2e\xd1\x6f\xb3\x5c\xfc\xf6\x1b\x58\x60\xd9\x60\xb5\x91\x78\x35\xe8\x31\x24\x0a\x97\x4d\x0a\xcb\xce\xd3\x82\x0d\x16\x8c\xa5\x98\x81\x05\xbf\xfd\x96\xc8\xdf\x0c\x3e\x50\x12\xc9\xbc\x16\x4d\x85\xbf\x27\x13\xa1\xcc\xe3\x89\xf0\x77\x1b\xac\xe2\x12\x35\x50\x95\xd9\x33\xa3\x86\x10\xaa\x1f\x0a\x36\x5e\x1c\x49\xd9\x8c\x5b\x99\x2a\x3f\x00\xe5\xca\x94\x8c\x29\xd7\x22\xec\x7f\xbc\x35\xc2\xb1\x97\xed\x8d\xd4\xda\xc3\xf1\x52\x66\x6d\xab\xd3\x4c\x3f\x44\x32\xff\xee\xd5\x3e\x66\x1b\xf7\x7c\xd6\x2d\xb7\x7b\x37\x88\xe5\x66\xc4\x05\x1a\x3f\xeb\xac\x99\x98\x69\x46\x9e\x9b\x4d\x6c\x3a\x77\x65\xc6\x44\xcd\xfc\xbb\x1c\x2b\x73\x5a\x83\xee\x07\x90\xe8\x0a\x99\xb5\xb6\xba\x93\xf9\xf0\x4d\x6a\x57\xe6\x27\xee\x6a\xa6\x64\x67\x5a\x61\xcb\x16\xd8\x4a\xeb\x6b\x65\x79\xd8\x84\x8f\xcd\xe6\xbb\xad\x58\x52\x73\x37\xa6\x0e\x1f\xbb\xed\xfa\xe0\xa6\xdd\x4a\x16\x98\xc6\x60\x4e\x1b\x74\xd9\x11\x8c\xc4\xed\x45\x5d\x50\x5f\x28\xed\x68\x22\xf5\x8e\x16\xf0\xd1\x03\x91\x47\x8c\x8e\x8e\xc2\xf3\x75\x94\x6c\x76\xb4\xde\x46\x86\xbf\xfd\x07\xc0\x91\x2f\x85\x7b\x54\x3e\x1a\x7b\x47\x51\x6f\xd6\x0f\xe4\x04\xf7\x6e\x37\xa7\x46\x70\xc0\xc6\xf3\x4b\x5b\xcf\xeb\xcd\xe7\x95\xf6\xf3\xaa\xe4\x3d\x29\x7c\x3f\xaf\x01\x9d\xdb\x82\x06\x68\xf6\xbb\xbd\xb4\x07\x7c\xf3\x21\x69\x16\x26\xcb\xb3\x96\x11\xc2\x86\x6c\x6f\x87\x4b\x77\x7f\x67\xd4\xb3\xa2\x9d\x7f\xc1\x57\x0f\xf1\x7b\x87\x95\xd7\x0e\xcb\x49\x3f\x56\xe9\x2f\xac\x68\x1e\x45\xa4\x66\xc8\xc4\x64\x48\x02\x2d\x1e\x88\x1b\x04\xb3\xe1\x8c\xf2\xa1\x17\x18\x27\x29\x38\xbc\x87\x52\x06\x8a\x51\x8e\x43\x5f\xe2\x98\x7e\x86\xf7\x60\x7d\xab\xe1\x5b\x02\xdf\x52\xf8\x16\xe1\x5b\x17\x92\x4e\x3b\x13\x93\x09\xe5\x93\xa1\x2b\x18\x43\x57\x0b\x09\xef\x41\x8c\xc7\xf1\x6c\x16\x13\xf9\x3c\x7c\x14\xf2\x1e\xa5\x82\xf7\x70\xb6\x09\xc0\x89\x6f\xfa\xd6\xf0\x1e\xca\xa7\x6a\x73\x3a\xfe\x8f\x9e\x4a\x54\x53\xc1\x3c\x78\x0f\x95\xd3\xad\x60\xca\x25\x0c\x87\x63\x12\x53\x54\x2a\x96\x37\x41\x09\x27\x6c\xfe\x2b\xae\x6c\x59\x2e\x6d\x87\xdb\xd8\xb3\xb4\x1d\xbf\x2b\x94\x1e\x7a\xc8\xc8\xdc\xf0\x53\x9a\x6d\x67\x28\x84\x64\x74\x46\xb5\xe1\xa8\x54\x2a\xbd\x5a\x2c\x0a\x40\xc7\x50\x74\x62\x65\x16\x6f\xb8\x46\xc9\x09\x1b\xb4\x9d\x62\x8b\x93\x11\x43\xef\xe9\x29\xde\x50\x29\x66\x24\xce\xd3\x8f\x43\x17\xa5\x1e\x8e\x29\x43\xa3\xb6\x23\xd4\xee\x51\x62\x16\x47\x9a\x85\xff\x2b\xba\x52\x27\x0a\x54\x8a\x0d\xef\x71\xbe\x63\xc1\x3d\xce\xad\x90\x30\xe4\x06\x77\x42\xe3\x8c\xf8\xd7\x44\xfd\x88\x73\x28\xde\x52\x29\x85\x44\xef\x66\x46\x26\xe8\x68\x73\xb1\x19\x98\x1a\x77\xca\x46\x23\x31\x6d\x55\x6c\xc6\xcf\x91\x8a\x21\xf4\xd3\xd3\xda\xf1\xa4\x66\xb4\x28\x7c\xe4\x6a\x4a\xc7\xda\x1c\x9f\xcc\x89\xcd\x60\xd8\x38\xb3\xd1\x19\x59\x2c\x68\x0a\xd3\x1d\xef\x49\xc3\x9f\xef\xcc\x2b\x1f\xdd\x88\x16\xd3\x2e\x88\x7e\x7b\x03\x37\x33\x5f\x48\xf3\x04\x23\x4c\x81\xcc\xeb\x2e\x89\x13\xd3\x44\x98\xc3\x2c\x54\x82\x1d\x3d\xf9\x42\x9f\x89\xf9\xcc\xf0\x0a\x5a\xd2\xc9\x04\x25\x08\x0e\x7a\x4a\x15\x68\x32\x31\x19\x90\x36\xb9\x54\xfc\x26\x4d\x4d\x89\x44\x0f\x12\xe7\x5e\x88\xdd\xcd\xeb\xc5\x42\x93\xc9\xbe\x32\x4c\x3c\xbe\xa1\x2c\x11\x62\xa2\xb6\xa6\x70\xef\x51\x86\xc2\x5e\xce\x44\x38\xac\xc5\x82\x72\x0f\x3f\xff\x4e\x23\x4a\x2c\x9a\x86\xf2\xe9\x09\x46\xdd\x79\x4a\x84\x72\xa7\xe8\x05\x0c\xbd\x1a\x68\x19\x24\x6a\x90\x38\x46\x89\xdc\xc5\x75\xf0\x48\x75\x6d\xe1\x12\x96\xb1\xfb\xad\x51\xc4\x41\xf9\x40\x5d\xdc\x62\x8f\xab\x5a\xfd\x73\x59\x59\x7c\x92\x09\xf7\x9e\xf7\x38\xf0\x96\x0b\x0d\xc5\xdb\x40\x07\x84\x65\xe6\xdf\xc5\x27\x87\x70\x2e\x74\x18\x47\x96\x8c\x99\x97\x6c\xd4\xc5\xe2\x08\x35\x59\x3d\xcd\xe1\x0c\x9f\x14\x8c\xab\x2a\x28\x74\x25\xea\xc2\x66\x78\xd3\x4c\x65\x64\x9f\x3d\x0e\x46\xc1\xaa\xb6\x62\xa8\x69\xc4\x8b\xb1\x1b\x98\x1a\x9c\x9e\x1c\x57\x92\x01\x29\xb4\x70\x05\xab\xc1\xa0\xd1\x8b\xc7\x34\x91\x13\xd4\xbd\x55\x50\xf3\xfc\xc5\xd5\x42\xfe\x51\x0a\xda\x22\xf9\x10\x0c\x95\xb1\xa5\xfa\x78\x4c\x39\xd5\xf3\x1a\x74\x92\x03\x18\x69\xb5\xc1\x02\xa5\x51\xde\x18\x7a\x4d\xfd\x22\x88\xb9\x66\x82\x78\x97\x84\x11\xee\xa2\xac\xc1\xe2\x19\xcb\xec\x99\x31\xa5\x91\xeb\x8f\xa6\x7e\x8a\x0d\x46\xe8\xec\x2f\x68\xa7\x89\xfa\x63\x7b\x7d\xde\x23\xf4\x31\xba\xf3\xa8\x62\xc4\xb4\xa3\x85\x24\x13\xc3\xbb\x52\xb1\xbd\xaa\xcc\x50\x27\x71\x43\xbf\x67\xd7\x6c\x7c\xfc\x22\x22\x0d\x15\x31\x71\x0f\xcb\x81\x2f\x20\x2b\xda\x27\x4b\x8e\x11\x31\x71\x5d\x54\xea\x56\x78\x18\x2b\xb4\x00\x8b\x85\x90\x2f\xa4\xb1\xbe\xdc\x05\x5e\xf7\x91\x78\x9f\x4c\x9d\xaa\xcb\x5d\x7c\x1d\xa3\x91\xc9\x82\xc4\x6a\x24\xfe\x12\xa0\x4a\x4e\x6b\x46\xf2\x35\x78\x29\x63\x0d\xe2\x13\x97\xea\xb9\x49\x40\x56\xed\x9d\xf8\xbe\xda\x9a\x2f\x34\x97\x81\xb0\x91\xbc\x0f\xfe\xab\x1a\xbf\x81\x97\xe8\x33\xea\x12\x55\x83\xf2\xc1\xbd\x95\x96\x44\xe3\x64\x19\x26\x23\xa6\xfa\xc6\x7f\x13\x9d\xb0\xb3\x61\x01\x00\x61\x7a\x9b\xf9\x6c\xbc\xcf\x4c\x84\x4f\xfb\x2b\xa7\x67\xb7\x34\xbd\x28\x6e\x5a\x4b\x16\xb6\x94\x80\x6a\x9c\xf9\x8c\xe8\xe5\x63\xf9\x55\x7d\x6e\x6a\x6f\x9b\x5c\xf6\x91\xcd\x0b\xe4\x93\x55\x53\x26\x00\xd6\x5d\xd7\x94\x6d\x3b\x1b\x66\x66\x4e\xe8\x23\xd5\x53\xf8\xe6\xf9\x83\xe0\x44\xb9\x0b\xe5\x93\x8c\x6f\xe9\x08\x0f\x9d\x58\xf7\xf1\xe1\x33\x7f\x79\x66\xd8\x1c\x31\x2d\x7e\x30\xef\xc4\xd6\xc1\x37\x7d\xd5\x40\x30\x8c\x2e\x82\x89\x8b\x34\x3f\x3a\x1d\xcd\xee\xb6\x0a\xbc\xb9\x59\x12\xd0\x32\x3b\x91\x65\x8c\x4b\xb7\xc9\x80\xad\xee\xb1\xb2\x5b\x46\x3a\xd7\x42\xe9\x3a\xa3\x44\x61\x96\xc8\x69\x3a\x9a\xd9\x7d\xeb\xb2\xe7\x10\x34\x3b\x8e\x13\x8c\xc7\xf4\x73\x66\x7b\x8f\xab\xc8\x73\x64\xcd\x49\xa1\x29\xa4\x66\xad\xdc\x24\xcd\x8b\xc5\x37\xc5\xae\x8f\xdc\x31\x7e\xa8\x27\xc5\x3f\xd1\xd5\x4f\x4f\x45\xf5\xe0\x16\x17\x8b\x1d\x68\xcc\xfa\xbd\x01\xb7\x02\xa5\xcc\x25\xc0\x61\xa1\xcd\x74\xab\x33\xb4\x1a\x98\x87\x55\xd2\x23\x2f\xb8\x56\x65\xca\x40\x00\x3c\x10\x16\xec\xe1\xb6\xef\x14\xca\xa7\xa7\xe7\xf7\x4e\xbe\x25\xf0\x25\xfb\xf7\x88\x52\x8f\x42\x7a\xbb\x70\x24\xa5\xa5\x2f\xc1\x91\x89\xc5\x5b\xf7\xdf\xf8\xca\xc3\x97\x20\x72\xe2\x62\x57\x86\xa9\xd8\x28\x57\x62\xb3\xa3\x09\xf7\x46\xf3\x65\x32\xbe\xdc\xa0\x1f\x45\x03\x73\x12\xd3\xa5\x5b\xd7\xed\x62\xe9\xb6\xee\x0c\x5a\xfd\xa1\xd3\xea\x7f\xbc\x69\xb4\x86\x9d\xfa\xed\x4e\xe9\x25\x18\x7a\x92\xce\x88\x9c\x9b\x03\x9a\x6b\x85\xcf\xe1\xdb\x66\x69\x71\xa8\xd3\x42\xee\xb5\xcd\xef\xd1\x43\x46\x8e\x6b\xaa\x58\xf1\x14\xfb\x49\xd6\x15\xb3\x19\xe1\xde\xea\xf9\x92\x01\x2f\xa4\x97\x94\x82\x62\xe4\x01\x23\x04\x4c\x61\xa4\xb5\x48\x94\xe1\x1b\x0d\xbd\xb6\xe5\x1b\x70\xb4\xf0\x61\x2c\x18\x13\x8f\xa6\xc8\x6a\xae\xf8\x7e\x24\xf3\x95\x6f\x78\xc1\x23\x51\xe1\x80\x8a\x76\x03\x31\xde\x45\x59\xf8\x3d\xa8\x65\x59\xda\xfc\x2d\x40\xc1\x5d\xf9\x28\x67\x50\x18\xaf\x57\xc7\x4d\xc4\x3d\x0a\x14\xca\xf0\x17\xd3\x40\x78\x40\x39\x0f\x0b\x8e\xcf\x83\xc6\xa4\x15\xcd\xbb\x3d\xc2\xe0\x6f\x7f\x03\xfc\x8c\x2e\x2c\x16\x74\xbc\xc5\xb2\xd7\x84\x37\x23\xe6\x56\xb4\x58\x20\x53\xb8\x3e\xb9\x58\xa4\x1a\x5b\x8a\x36\x77\xd3\x5d\x72\xc9\x45\x9a\x6b\xda\x61\xb9\xc9\x74\x9c\xac\xf5\xc1\x5e\xc0\x58\x5c\x5b\x80\x9b\x71\x47\xe8\x9e\x44\x85\x5c\xef\x63\x50\x2b\x1c\xdc\x70\xa5\x09\x63\x2a\x72\x18\xcd\xcb\x15\xfc\x8c\x8e\xd1\x9d\xbb\x6c\xed\xdb\x83\xcb\xae\xc7\xea\x30\x84\xe2\x5e\x1f\xcb\x15\xc2\x76\x13\xc9\x35\x94\x25\xf8\xaa\xf6\x93\x4a\xfd\x51\xb6\x0d\xb3\xca\x5e\xde\xe1\x34\x45\x15\x94\xc5\x0f\x48\x4c\xb6\xac\x8a\x4d\x9c\x09\xa3\xc8\x62\xcf\xf4\x59\xfe\x9a\x02\xd8\xe8\x10\xe5\xda\x13\xa3\x0f\xc8\x51\xa9\x9e\x14\xa3\x35\x96\x4c\x0e\x45\x09\x6b\x9a\xda\xb2\x83\xae\xe0\x9e\xaa\xc1\x59\x52\xb6\x8e\x93\x36\xd7\x77\x4c\xb5\x6d\x83\xed\x8d\x9a\x48\x7a\xfd\x49\x0d\x3d\x33\x95\xa9\xb3\x24\x9c\x2d\xb3\x89\xb5\xa2\x09\xc0\xf6\x2a\x8b\xf9\x91\x48\x3c\xba\x85\xa7\x3c\x6d\x6c\xd1\xc5\x36\x4d\x14\xa0\x40\x5f\xed\x54\x4d\x01\xfe\xd8\xde\xda\x6e\xcd\x24\x2d\x02\xf3\xf3\x06\x9a\x97\xf0\x93\x70\xc0\x35\x15\x03\xd3\xc4\x7e\x7d\x15\x10\x49\xb8\x46\xf4\x5e\xc3\xdb\xe4\xf2\x03\xef\xdf\xc7\x57\xa6\x77\x10\x70\x86\x4a\x01\x01\x26\x1e\x51\xc6\x77\xa1\xe4\x9a\x04\x42\x02\x01\xd7\x0f\xcc\x5e\x0a\xf5\x0a\xae\x8e\xd0\x58\x83\x2e\x87\xae\xd3\x35\x01\x41\xa2\x81\xe2\x02\x52\x9c\x11\x21\x36\x50\xad\x80\xb0\x47\x32\x57\x30\x0a\xa4\xd2\xc6\x01\x65\xf6\xca\xb9\xd1\xe5\xdf\xea\xb2\xb7\xb5\xdd\x01\x37\xde\xb4\x78\x1b\xf2\xf4\xf4\xb4\xe9\x12\x9f\x5f\xd7\xe8\xdd\xb5\x8d\x94\x56\x4e\x8e\xf9\xeb\xfa\xc1\x8b\xca\x26\xe9\x46\xeb\x45\x93\xe4\x4f\xa2\x99\xed\xcc\xbe\xa0\x92\x12\xf1\xdb\x8f\x55\x78\x00\x29\xfd\x11\x02\xda\x26\x9b\xa8\x52\x15\xbe\x6f\x5c\x91\x4e\x01\x66\x66\xac\x47\xf4\xb4\xb6\xee\x0d\x4d\x32\x90\x01\xcd\x29\xc0\x14\xd6\x40\x9e\xdb\x2d\xf1\xad\xcf\xed\xb8\xf9\x0d\xf1\xfc\x9d\x85\xaf\x4d\x7d\xa4\x20\x85\xd0\x47\x4a\xba\x47\x99\xf0\xef\x8e\x27\x47\xcf\xe1\x48\xfa\xb1\x1b\xfa\xc9\x29\xa6\x3f\x3d\x6d\x25\x61\xbd\xfd\xb6\x03\xe5\x7a\x8d\x7c\xcb\xf5\xee\x0d\x74\x1f\x50\x1a\x37\x00\x4c\x08\x7f\x44\xdc\xfb\xa4\xf1\xe3\x0b\xcf\xbc\xd4\x18\x6b\x08\x38\x72\x57\xce\x7d\xd3\x5b\x0a\xcb\x12\x34\xa6\x1c\x06\x6d\x27\x27\x03\x37\x17\xac\xa1\xd3\xbd\xeb\x3f\x73\x55\x48\x05\x58\x3b\x3a\xda\x65\x71\xd1\xcd\xb1\xb6\x0b\x2c\x4d\xd0\xff\x8b\x99\x06\x8d\xb9\xfe\xd7\x4c\x20\x5a\x0a\xee\x3f\x95\x62\x33\xe1\xe1\x7b\x8f\xaa\x35\x67\xb6\xbc\x3e\x5c\x0d\x5b\xff\xe8\x75\xfb\xe6\xfe\xd1\xfa\xc7\xa0\xd5\x69\x0e\x7f\xba\x6b\xf5\x7f\x1e\xf6\xea\x83\xeb\x3c\x4e\xc2\x4e\x6a\xc2\xce\x11\x7e\x36\xb1\x11\xe5\x51\xf6\x9f\xb4\xc8\xc9\x08\x17\x8b\x1d\x27\xb5\x15\x6f\x14\xb5\xd0\xe0\xe9\x69\xff\x14\xf2\x39\xbb\x48\xff\xf5\x8d\x3d\x72\x8a\x31\xa1\x2c\x90\x38\x48\x1a\xe1\xab\x61\x6b\x67\x3e\x51\x2d\x5f\x9c\xef\x8e\x84\x67\xa5\x3d\xb3\x81\x83\x50\x73\x5c\x7a\x51\x9a\xb3\xb1\x69\x24\xf1\x4d\x29\x67\x62\xe5\xb2\xba\xb7\xa7\x01\x2c\xfd\xed\xd3\xd3\x8b\xe2\xac\x09\x3d\x51\x74\x08\xc3\x61\x52\x53\xcd\x84\x8a\xe7\x23\xe5\x62\x91\x0d\xa5\xbf\x27\x00\xae\x46\xb6\xa8\x5e\xbb\x4a\x46\x3c\xf7\x1c\x21\x29\x48\x4a\xca\x97\x47\x9e\xdc\x43\x9b\xa3\xc9\x9c\xb3\xb3\x1e\x2c\x22\x84\x19\x5c\x85\xfd\xd7\x9a\x7c\x36\x7e\x67\x54\xfb\x32\xec\x85\xdd\x51\xd2\xcf\x6b\xf5\xad\xa2\x73\xcd\xd0\x66\x21\x3a\x99\x2e\x6c\x23\xd3\xc3\x31\x09\x98\x36\xed\xa3\x1a\x9c\x96\xcb\xcf\xf1\xb0\x3d\xd8\xee\x09\xb8\x95\x8a\xb5\xf5\xf1\xca\x57\x3b\x01\x12\x03\xdc\x33\x24\xbf\x49\x5e\xc4\x39\x3f\xb5\x41\xe2\x38\x50\x68\x32\x70\x5f\xd2\x07\xf3\xcd\xbd\x7b\x9c\x87\xfe\xcb\x04\x16\xf3\xef\xeb\x08\x93\x5c\xa7\x3e\xa0\x00\x51\x43\xfb\x19\x01\x9e\x94\x93\xf7\x4f\x49\x2d\xd9\x2c\xd8\x50\x4b\x61\x35\xfc\xef\x13\xfc\xe3\x87\x1e\xb1\x91\x16\x92\x2e\x72\xa8\x88\xc6\x94\xf0\xf8\xed\x45\x21\x8a\x4f\xd1\x48\x8f\x48\x32\xcb\x98\xb5\x79\xa3\x34\x23\x9a\xba\x2b\x2f\x25\x32\x75\x64\x43\x68\x06\xbe\x90\x77\x77\x5c\x7d\x01\x92\xf3\x74\x67\x40\x36\x2d\x63\xb1\xd8\xeb\xa1\xc7\xda\xba\xf0\x5f\x3b\x0a\x17\x27\x80\x19\x34\x9d\x04\x60\xb9\x2c\x12\xc9\x4d\xca\x7f\x62\x1e\x93\xdd\xc9\x38\xf1\xe2\x02\x92\x82\xd2\x66\x17\xf2\x4b\xde\x83\x14\xe2\x1a\xe7\x9f\xad\xe5\x98\xa1\x2b\xed\x69\x65\xe2\x64\xe2\x8e\xfe\x72\xcf\x2e\x56\x04\xbe\xff\xf3\x8b\xff\xdd\x86\xf3\x5f\xca\x0a\xe2\x31\xb5\xcf\x1d\x3f\x3d\x30\x4f\x4f\xff\x67\x4a\xce\xef\x5a\x0b\xc6\x28\x9f\xfc\x49\xdb\xc9\x2b\x0c\x7c\x6d\x2b\x7f\x6d\x2b\xff\xeb\xb4\x95\xf7\xec\x31\x66\x0d\x7a\x9f\xfd\xfe\xb4\x3d\xc4\x67\xb1\x6e\xa3\xfa\xff\x65\x8f\x7d\x9f\x26\x5c\xd4\x36\x5d\xab\x90\xbc\xac\xf3\xb6\x57\x49\x64\x77\x09\x63\x67\x21\x22\x93\x03\x01\xc0\xb6\x7c\x29\x81\x5f\x3b\xf1\x5f\xdb\x2c\x5f\xdc\x66\xf9\xda\xae\xf8\xda\xae\x78\x69\xbb\xe2\x4d\x12\x27\x14\xb8\xc2\x9f\xaf\xbc\xae\x30\x99\x20\x3c\x4e\x91\x83\xd2\x44\xea\x24\x69\xcc\xab\x36\xbd\xb8\xcf\x11\x63\x5d\xad\xe4\xec\x55\x68\xca\x5d\x09\x80\x33\x5f\xcf\x9b\x34\x7a\x14\xfe\xb5\x22\xf0\x3b\x2a\x02\xc8\xbd\xa7\xa7\x57\xff\x33\x00\x46\x0c\xb2\xe9\xaa\x5b\x00\x00"),
+		},
+		"/exposure": &vfsgen۰DirInfo{
+			name:    "exposure",
+			modTime: time.Time{},
+		},
+		"/exposure/ingress": &vfsgen۰DirInfo{
+			name:    "ingress",
+			modTime: time.Time{},
+		},
+		"/exposure/ingress/ingress.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "ingress.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 478,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xcf\x6a\x83\x40\x10\x87\xef\x3e\xc5\xbc\x80\x86\xd0\x1c\xca\x3e\x41\x7a\x29\xa1\x0d\xbd\x8f\x3a\x8d\x8b\x66\x66\xd9\x99\x0d\x4a\xc8\xbb\x17\x35\xa6\x08\x2d\xc1\xcb\x38\x7f\xbe\xdf\xb7\x39\x60\xf0\x5f\x14\xd5\x0b\x3b\xa0\xde\x88\xc7\x52\x37\x97\x6d\x49\x86\xdb\x0c\xa0\xf5\x5c\x3b\x78\xe3\x53\x24\xd5\x0c\xe0\x4c\x86\x35\x1a\xba\x0c\x00\xa0\xc3\x92\x3a\x9d\x6b\x00\x0c\xc1\x81\x0e\x5c\x93\x7a\xbd\xf7\x96\xdf\xc2\xcb\xe6\xd9\xdc\x86\x40\x0e\x3c\x7f\x47\x54\x8b\xa9\xb2\x14\x69\xc2\x20\xb3\x18\xda\xa8\xb6\x64\xf1\xc9\x73\x5f\xf8\xd9\xab\x68\x53\x49\x91\xc9\x68\xe2\x94\x58\xb5\xc4\x75\x1e\xa2\x98\x54\xd2\x39\xd8\x1f\x8f\x87\xcf\xe9\x92\xf1\x4c\x2b\x09\x0d\x54\xcd\x50\x5b\x5e\x92\x43\x23\x6a\x8f\xa8\x1c\xae\xd7\xe2\x43\x92\xd1\x5e\xd4\x46\xc0\xed\x36\x8d\x62\xea\x68\x75\xe2\xfe\xdb\x04\x68\xcc\xc2\x02\x04\x08\x68\xcd\x83\x3f\x06\xde\x95\x7f\x5b\xe3\xa7\x14\x2f\xbe\xa2\xf7\x95\x72\x2e\x98\xac\x09\x51\xfa\xe1\xaf\xe5\x83\x44\x73\xf0\xba\xdb\xbd\x64\x3f\x03\x00\x59\x97\x8a\xba\xde\x01\x00\x00"),
+		},
+		"/exposure/loadbalancer": &vfsgen۰DirInfo{
+			name:    "loadbalancer",
+			modTime: time.Time{},
+		},
+		"/exposure/loadbalancer/service.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "service.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 459,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x50\xbd\x4e\xf4\x30\x10\xec\xf3\x14\xf3\x02\xf9\x3e\x21\xae\x40\x2e\xa1\xa5\x38\x09\x44\xbf\x38\x0b\x67\xe1\xec\xae\xd6\x9b\xd3\xe5\xed\x11\xf9\x11\x14\x48\xd7\xd0\x79\x7e\x3c\x1e\x4f\x0f\xb2\xf2\xc2\xde\x8a\x4a\xc2\xf9\xa6\x03\x3e\x8a\x0c\x09\x4f\xec\xe7\x92\xb9\x03\x46\x0e\x1a\x28\x28\x75\x00\x50\xe9\x95\x6b\x5b\xcf\x00\x99\x25\xb4\x59\x06\x6e\xa5\x6d\xdc\x0e\xff\x15\xfd\x7f\x4d\x8f\xd9\x38\xa1\xc8\x9b\x53\x0b\x9f\x72\x4c\xce\xbf\xd8\xb2\x8e\xa6\xc2\x12\xdf\x61\xbd\xd2\x14\x27\x73\xbd\xcc\xcb\x05\xa1\x91\x7f\xa8\x7c\x09\x76\xa1\xda\x01\xcd\x38\xaf\x7d\xd7\xd7\x1e\x95\x86\x7b\xaa\x24\x99\x7d\xa1\x4d\x3d\xb6\x1f\xf5\x5b\xce\x29\xc2\xf6\xbe\x5f\x72\xc2\xe1\x70\xbb\x63\xd7\xd0\xac\x35\xe1\xf9\xe1\xb8\x71\x41\xfe\xce\x71\x5c\x9c\x77\xbb\xb5\x71\xe5\x1c\xea\x7f\x35\xd6\x95\x15\x3e\x07\x00\xa3\x79\x6d\x83\xcb\x01\x00\x00"),
+		},
+		"/exposure/nodeport": &vfsgen۰DirInfo{
+			name:    "nodeport",
+			modTime: time.Time{},
+		},
+		"/exposure/nodeport/service.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "service.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 456,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5b\xdd\x77\xe2\xb8\x92\x7f\xef\xbf\xa2\x36\x77\x66\xdd\xbd\x6b\xc2\x47\x02\x01\x66\xb2\xbb\x04\x9c\x84\x19\x02\x0c\x26\xe9\x3b\xfb\xc2\x11\x76\x01\xba\x11\x92\x5b\x92\x93\x66\x7a\xfa\x7f\xdf\x23\x63\x63\x03\x26\x49\xf7\x99\xc3\xb9\x77\xf3\xd0\x0d\x52\x49\xf5\xf5\x53\x55\xe9\x83\x02\x90\x80\x3e\xa0\x54\x54\xf0\x26\x3c\x95\xdf\x01\x3c\x52\xee\x37\xa1\x2d\xf8\x8c\xce\xef\x48\xf0\x0e\x60\x89\x9a\xf8\x44\x93\xe6\x3b\x00\x00\x4e\x96\xd8\x04\xb5\xe2\x3e\x2a\xaa\x0a\xfe\xb4\xb0\x44\x2d\xa9\xa7\x0a\x5e\x34\x26\x22\x62\x64\x8a\x4c\xad\x07\x00\x90\x20\x48\x47\xc4\x6d\xc9\xd7\x53\x2a\x8a\xaf\xf5\xeb\x55\x80\x4d\xa0\x7c\x26\x89\xd2\x32\xf4\x74\x28\x31\x87\xcc\x13\xcb\x40\x70\xe4\x3a\x57\xbc\x77\x00\xa9\x12\x9f\x42\x94\x14\xd5\xe9\x8a\x2c\x59\x13\xfe\x8c\x27\x03\x08\xe6\x13\x43\x34\x25\x0a\x9b\x9b\xc6\x35\xf9\xaa\x09\x27\xe0\x3a\x3d\xa7\x3d\xce\x92\x9d\xfa\x44\x1b\x93\xd8\xd9\xc6\x89\xa2\x7f\xe0\xfb\x1c\xaa\x0f\x40\x14\x98\x4e\xb8\x1e\x0d\xee\xb2\x43\x4e\x32\xec\x62\x89\xb3\x12\x00\x14\x20\x9e\x63\xbb\xd9\xfc\x85\x8a\xcc\xb1\x09\x27\xbd\xd6\x95\xd3\x3b\xd9\xeb\xf6\x51\x79\x92\x06\x3a\xf2\xf1\x49\x9f\x2c\x11\xc4\x0c\xf4\x02\x21\x8f\xb9\xe1\x64\x24\x3c\xcc\xe6\xa6\x75\x7f\xe3\xbc\xc6\xa6\x43\xd5\x23\xa8\x80\x78\x08\xa1\x42\x1f\xa6\xab\x1d\x8e\xef\xbe\x03\x7b\xff\x44\xb0\xca\x5b\x0b\x8a\x2c\x03\x86\xfe\x34\x5d\x09\xa9\xe8\xc4\xf7\xe3\xfe\x82\x3f\x3d\x55\x8b\x14\x75\x7f\xfb\xb7\xe2\x94\xf2\xe2\x94\xa8\x45\xdc\x12\x72\x4d\x19\x98\x06\x28\x78\x70\x12\xa8\x4f\x0c\x0a\x0b\x28\x57\x2e\x4e\x4b\xa7\xa5\xd3\x32\x14\xee\xe1\x87\xe1\xc0\x1d\xdf\x8c\x1c\xf7\xb7\xde\xe4\xde\x75\x46\x50\xf8\x04\x05\x7f\xab\xb9\xd3\x1a\xb7\xae\x5a\xae\x63\x26\xb1\x62\xe4\x96\xad\x93\x9f\xc0\x17\x1b\xe7\xa1\xb7\x10\x70\xf2\x91\x50\x4d\xf9\x1c\x66\x42\xc2\x50\x28\x3d\x97\xa8\x40\xa1\x7c\x42\x79\x7a\x7a\x9a\xba\x5a\x31\xc4\x00\xca\xf1\x77\x5f\xf0\xc4\x5e\xeb\x69\xfe\xc3\xfc\x81\x27\x91\x44\xb3\x25\xe6\x48\xc6\x47\x7a\xfc\xfc\xb3\x33\xb8\xde\x4c\xd8\x1e\x39\xad\xb1\x03\x1b\x49\x93\x21\x3f\xed\x52\x44\x2a\x26\xbd\xf0\xb1\x3b\xbe\x85\x61\xcb\x75\x3f\x0e\x46\x1d\xb0\xb2\x4a\xbb\xad\xbb\x61\xcf\xe9\x5c\x4d\x92\x6e\x2b\x9d\xeb\x66\xd4\xea\x8f\xa1\xd5\xeb\xc1\x70\xd4\x7d\xe8\xf6\x9c\x1b\xc7\x85\x41\x7f\x9f\x3d\x68\xb1\x27\x4a\x2a\xf6\xda\x1f\x7e\x4a\x5d\xb8\x4f\x3f\xff\xfc\xb3\xe5\x0c\xae\xad\x5d\xf9\xdd\xf6\xad\x73\xd7\x82\xd6\xfd\xf8\x76\x30\xea\xfe\x6f\x6b\xdc\x1d\xf4\x0f\x6b\x3b\x6e\x5d\xf5\x1c\xe8\x5e\x43\x7f\x30\x06\xe7\xef\x5d\x77\xec\x82\x27\xb8\x26\x9e\x86\xf7\x33\x2a\x95\x9e\x18\xe8\xc1\x43\x6b\xd4\xbe\x6d\x8d\x6c\x60\x64\xaf\xc9\xc0\x96\xf0\x55\x86\x06\x89\x3f\x51\x22\x94\x5e\x96\xca\x38\x0b\x4d\x14\x42\x63\x06\xe7\x43\x2a\x4b\xb7\xef\x3a\xa3\x31\x74\xfb\xe3\xc1\x86\xf9\x43\xab\x77\xef\xb8\xf0\xde\xfa\x45\xa0\x65\x5b\xbf\x10\xef\x51\x09\x6e\xd9\xd6\x08\x7d\xb8\x25\xda\xb2\x2d\x7f\x6a\xd9\x5e\x28\x25\x72\x3d\xd1\x74\x89\x4a\x93\x65\xf0\xe1\x4d\x2a\x6a\xe1\x0b\x78\x4f\x7d\x70\x9d\x51\xb7\x15\x79\xe9\xae\x35\xfa\x1d\x7e\x75\x7e\xb7\x41\x13\xf5\xb8\xad\x1d\x43\x8d\xbe\x91\xcf\xb9\x71\x46\x6f\xe3\xf0\x4c\x39\x32\xaa\xf4\x41\x2e\x86\x20\xe5\x12\x48\xea\x61\xc2\xc1\x86\x15\x12\x99\x7e\x9b\x3f\xab\xf4\x8b\x47\xd3\x51\x7c\xfa\x8f\xb4\x23\x90\xc2\x0f\x3d\xed\x09\x7f\x77\xde\xa9\x10\x8f\xc8\xb5\x5c\x51\x3f\xe9\x39\x60\xfd\xac\xd4\x6b\x11\xe3\x29\x6c\x23\x51\x24\x89\x91\x20\xe2\xfc\x61\xe3\xa3\xf3\x8a\x6d\xb5\xa6\x12\x43\x78\xa0\x1c\x57\x44\xfa\x36\xf4\x88\x32\x0b\x9c\xf8\x44\xd9\x70\x2b\x9e\x91\x31\xb8\x13\x21\xd7\x84\x72\xcb\xae\x5c\x54\xed\x4a\xa9\x7c\x66\x37\xea\xa5\x8a\x6d\x5d\x59\xf6\xd9\x07\xb3\x3e\xda\x83\xfe\x75\xaf\xdb\x1e\x1b\xfe\x1f\xa0\x33\x30\x16\xbd\xed\xf6\x6f\xfe\x4a\x69\x1b\x65\xdb\x6a\x49\x12\xfe\x43\x80\xa3\x34\xd1\x68\x83\x43\x15\x32\xdc\x48\x0f\x6d\x32\x45\xc9\x51\x83\x4b\xc2\x27\x3a\xe7\x82\xdb\xd0\x27\x01\x81\x07\xc2\x18\xae\x2c\xfb\xbc\xd1\x30\xf2\x57\xed\xc6\x45\xa5\x6e\x5b\xed\xff\x3c\xaa\x02\x0d\xdb\x6a\x85\x53\x94\x1a\x3e\x52\x8e\xca\x86\x11\xd5\xde\x82\x66\x15\x58\x10\xe9\x0b\xce\xc9\xca\x86\x8f\x0b\x6a\x74\x74\x05\x17\x4b\x02\x6d\x41\x94\xb6\xec\x4a\xa5\x9a\x28\x50\xbe\xb0\xad\xd6\x51\x15\xa8\xd7\x6d\xeb\x4a\x70\x3f\xb6\xbf\xb2\x61\xc8\x42\x49\xa7\xa1\x82\x11\xfa\x3b\xa6\x86\xf3\x72\x69\x63\xeb\xc6\xb1\x45\x3d\x3b\xb3\xad\x36\x59\x85\x2a\x35\xae\xb2\xe1\x8a\x0a\x4e\x3d\xb8\x96\x62\x0e\xee\x4a\x92\x85\x0d\x1f\x09\x63\x24\xfe\x37\x11\xbd\x52\x8f\x24\x2f\xd9\x8d\xfa\xf1\x8d\x5c\x6b\xd8\x56\x7b\x41\x82\x00\x19\x43\x6d\xc3\x50\x1a\x90\x18\x70\xdc\x52\xc6\x5e\x87\x78\xe5\x2c\x82\xf8\xb9\xdd\xb8\x38\xaf\x1f\x5b\xf8\x4a\xc9\xb6\xda\x82\xcd\x29\x87\x36\x32\x46\xa4\xb2\x61\xbc\xf2\x16\x4a\xf0\xb5\xf8\x6f\x5f\xaa\x67\x55\x83\xf4\x52\xc5\x6e\xd4\x13\x3d\xce\x8f\xa6\xc7\x45\xc5\xb6\x3a\x29\x26\xb2\x18\xba\x23\x2b\xb2\x1b\x55\xea\x8d\x38\x2a\x5e\x9c\xdb\x56\xeb\x98\x82\x56\x6d\xb0\x3a\x84\x93\x74\x49\xf6\x84\x0e\xd5\x37\xd8\xb9\xb2\x0e\x89\x06\xec\xf5\x8b\x48\xfa\xe3\xc1\xc5\xac\xae\x8e\x58\x52\x1e\xaa\x4d\x4c\x6f\x2f\x24\x55\x9a\x12\x6e\xd2\x0e\xd2\xcf\x3b\xe2\x96\x4b\xf5\x24\x03\x55\xd7\xc6\xae\x1d\x4f\xdc\xb2\x6d\x75\x42\xce\xb3\x70\x18\x4b\x42\x19\xca\x97\x0d\xbe\x97\x47\xcf\xd2\x3c\x5a\x3b\xb2\xcd\xcf\xaa\xb6\x75\x1d\xea\x34\x89\x56\xab\xa5\x12\xb8\xcc\x87\x42\xae\xec\xae\x26\x73\x05\x3d\x24\x01\x74\xa8\x32\xdb\x4e\x6d\xd9\x67\x9b\x34\x54\x2f\x9f\x1d\x3b\xc8\x40\xc3\xb6\x6e\x89\x64\x84\x6f\x74\xd8\x8e\x1c\xb5\x28\x72\x94\xed\x46\xfd\x22\x16\xee\x78\x18\x31\xb1\xea\x17\xa1\x30\x58\xc0\x70\x81\x2c\x50\x59\xb0\x74\xb9\xa2\x73\x4e\x77\xe3\x47\xa5\x76\x6e\x97\x1b\x8d\xb2\xdd\xb8\x68\x9c\x1f\x19\x0e\x95\x0b\xdb\xfa\x95\x04\x9e\x22\xdc\x5f\xc1\x35\x59\x52\xb6\x8a\xca\x13\xb9\x8a\x7c\xaf\x11\x7a\x84\x67\x4a\x94\x1b\x49\xb8\x5f\x78\x30\x11\xfe\xb5\xd0\x52\xae\x24\xd5\x56\xfd\xbc\x7c\x6c\x94\x94\x4b\xb6\xf5\xab\xe0\x73\x35\x27\x51\x61\x3b\x5e\x20\xfc\x12\xfa\x73\xcc\x2b\xb2\xb6\xdd\x71\x5e\x33\xf8\x31\x62\xd7\xaa\x47\x76\x87\x61\xd8\x23\xf2\x71\x89\xc4\xdf\x0a\x33\x0b\xe3\x07\xf9\xf8\x06\xa3\x97\x93\x00\x79\x51\x3d\xb6\xf4\xd5\x86\x6d\xf5\xc4\xa3\x58\x91\x0d\x84\xa2\x98\x07\x0f\x88\x3e\xca\x37\x24\xfd\xf2\x59\x52\x33\x1e\x3b\x17\x19\x86\x43\x12\x32\xb8\x15\xd3\xa9\xa9\x15\xd1\x7b\x54\x5a\xcc\x66\x28\x61\x2c\xe0\x57\xc2\x04\x7f\x39\xca\x0f\xc8\xe3\x13\x65\x0c\x4d\xed\xb2\x29\x08\xce\xea\x47\xae\x08\x4c\x42\x19\xa2\x46\x09\x77\xd4\x5b\x10\x64\x1b\x57\x0c\x05\xe5\x1a\x46\x22\xcc\x5f\x03\x9b\x8d\x46\xc8\xb5\xc1\x51\x3d\x8a\xa2\x75\xa3\x43\xe5\xd8\xbe\x38\xb3\xad\xa1\x14\x4b\xc1\xb5\x30\x92\x6f\x61\xa4\xda\xa8\x6e\x57\x5b\x47\xdc\x00\x95\x6d\xeb\xb7\x90\x32\x0f\x7d\x02\x6d\x89\xf8\x68\xe7\x22\xa1\x2d\x58\xb8\x9c\xd2\xcc\xa2\xac\x19\x40\x94\x1a\xc6\x98\xb5\x75\x24\xac\x1e\x2f\xe3\xd7\x6c\x6b\x44\x4d\xe4\xcb\x96\xb1\x82\x6b\x84\x2b\x64\x4c\xd8\xe0\x12\xae\x8d\x42\xe1\x1f\x9b\x1a\x45\x59\x76\xb9\x9a\x6c\xe0\xea\xa5\xc6\x91\x2d\x7d\x5e\xb3\x2d\xd7\x23\x12\x3d\x29\x9e\xf3\x8d\x3c\x0a\xf5\x02\xe5\x4c\x48\xdf\xb2\xcf\xcf\x4b\xc9\xa6\xa7\x51\x3b\xf6\x66\xe1\xfc\xc2\xc8\xba\x90\x24\x0a\x71\xc9\xb6\x27\x1b\x3f\xa2\x43\x15\x8a\xbe\x24\xd9\xca\x5c\x30\x54\xcf\x42\xea\xc5\xea\xf5\xc0\x08\xb5\x4d\x44\x69\x1c\x7b\x8f\x51\x3a\x37\xfa\x49\x24\x4b\xca\xe7\xe0\x90\x39\xc3\x7c\x8f\x6c\x4b\x5c\xa9\xd5\x92\x6d\x74\xa3\x54\x3d\x72\xa9\x7e\x51\xb6\x2d\x97\x09\xc2\xcd\x06\x5a\x04\x92\xa2\x26\x72\xb5\x3e\xa6\xc8\x02\xa7\x72\x56\xda\x04\x93\xa3\x97\x28\x8d\x33\xdb\x72\x03\xa1\xb5\x7a\x16\xc2\x47\x3b\x29\xbf\xd6\x55\x2d\xdc\x48\xf1\x9c\x5f\x65\xb9\x1a\x6e\x91\x21\x27\x96\x5d\x3e\xdf\x00\xa3\xb2\xde\x4a\x34\x8e\x57\xac\xd4\x6c\xeb\x01\x65\x94\x3d\x7a\x08\x1d\x54\x54\xee\xe5\x91\xca\x3a\xf4\x5d\x98\x7a\xe4\xec\xd8\xfb\xcb\x52\x74\x1e\xc1\x35\xe5\x61\xb8\xcc\x81\x42\x9a\xb2\xe3\x74\x67\xc4\x2c\xd7\xbe\x0d\x08\xf1\x69\xf2\x60\x04\x23\x67\xd8\x6b\xb5\x1d\xb8\xbe\xef\xb7\xa3\xf3\x7b\xe2\xfb\x13\x86\xc4\x7f\x9f\xb9\x0b\x5b\x9f\xce\x13\xee\x4f\xd2\x33\xf9\x27\x22\xbd\x05\x91\x76\x86\x2c\x39\x9d\xcf\xe9\x0a\x16\x82\xe7\x8e\xc1\x25\xa1\x2c\xaf\x23\x7b\xb2\x7f\xb0\x5b\x13\x1d\xaa\xbc\x6e\xb9\xbe\xad\x89\x7b\x3e\xbc\xcb\x74\x8d\x9c\xf1\xfd\xa8\xef\xc2\x93\xa0\x7e\xa6\xb9\xd7\xea\xdf\xdc\xb7\x6e\x1c\xb0\x02\x16\xcc\xd5\x27\x66\xa5\x83\x5a\x2e\xfc\x70\x35\xe8\xfc\xfe\xc3\xa6\xa5\xe3\xb4\x7b\xad\x91\x93\x19\x1f\x1d\xe5\xc7\xfc\x52\x43\x5f\x39\x37\xdd\xfe\x2e\x55\xf3\x12\x3c\xc1\x3d\xa2\xdf\x67\xb5\xf8\xf3\x4f\xb0\xc0\xb2\xc1\xea\x21\xf1\x9b\x30\x64\x48\x14\x6e\x2e\x29\x2c\x3b\xcf\x0b\x36\x58\x30\x93\x62\x09\x96\x19\x1e\xdb\xdf\x34\x3e\x51\xb2\xb6\x79\x73\xdd\x15\x7d\x4e\x3a\x22\x9b\xc7\x1d\xd1\x67\x1b\xac\xd3\x0d\x6b\xa0\x2a\x33\x67\xc6\x0d\x11\xd5\x28\x32\x6c\x3c\x78\x6d\x65\xd3\x6e\x65\x4e\xf9\x01\x28\x57\x28\x35\x50\xae\x45\x74\xff\xf1\xde\xa8\x6d\x6f\xae\x37\x52\xb4\x47\xed\xa5\xcc\x58\xa7\xdf\x49\xbf\xac\x6d\xfe\xd3\xbb\xb7\xc0\x36\xbe\xf3\xd9\x45\xee\xe0\x7e\x0c\x99\xbb\x25\x8d\x9f\xb5\xbd\xd3\x9d\x42\x3a\xaf\x37\xc1\x74\xee\xc8\x0c\x44\x4d\xff\x87\x1c\x94\xb9\xce\x78\x70\x0d\x12\x3d\x21\xb3\x68\x6b\xb9\x99\x2f\x3f\xfc\xb0\x75\xef\x1c\xdf\x6a\xa6\x62\x67\xae\xc2\xec\xd4\xc9\x19\xee\x5b\xc3\xa3\x4b\xf8\x18\x36\x3f\x1d\xe4\x92\xc2\xdd\x40\x1d\x1e\x06\xbd\xd6\xb8\xdb\x73\xb2\x17\x83\x39\xd7\xa0\x9b\x1b\xc1\xb5\xb9\xfd\xf5\x2d\x68\x20\x94\x76\x35\x91\xfa\x95\x2b\xe0\xe2\x13\x91\x45\x46\xa7\xc5\x68\x7d\x15\x93\xc9\x8a\xbb\xd7\xc8\xf0\xef\xff\x05\x50\x0c\xa4\xf0\x8a\xe5\xe2\xcc\x2f\x96\xff\x3f\xde\xab\xc7\x37\xea\x5b\xf7\xe9\x9b\xce\x20\xbe\xaf\xfe\xc4\x4e\x0d\x51\x6a\x54\x26\xe6\x13\x12\x6a\xf1\x44\xbc\x30\x5c\x4e\x96\x94\x4f\xfc\xd0\x2c\x43\xc1\xe1\x12\x4a\x19\x2a\x46\x39\x4e\x02\x89\x33\xfa\x19\x2e\xc1\xfa\x51\xc3\x8f\x04\x7e\xa4\xf0\x23\xc2\x8f\x1e\x58\x29\xe5\x9c\xf2\xf9\xc4\x13\x8c\xa1\xa7\x85\x84\x4b\x10\xb3\x59\x62\xb4\x0c\x27\xf2\x79\xf2\x2c\xe4\x23\x4a\x05\x97\x50\xdb\x27\xe0\x24\xd0\x74\x89\x70\x09\xe5\xaa\xda\xef\x8e\xff\xd3\x0b\x89\x6a\x21\x98\x0f\x97\x50\xa9\x1e\x24\x53\x1e\x61\x38\x99\x91\x58\xa2\xd2\x69\x79\x9f\x94\x70\xc2\x56\x7f\xe0\xd6\x94\xe5\xd2\x61\xba\xbd\x39\x4b\x87\xf9\x7b\x42\xe9\x89\x8f\x8c\xac\xa2\x49\x97\x87\x15\x8a\x28\x19\x5d\x52\x6d\x34\x2a\x95\x4a\x2f\x60\xd5\x45\xf9\x44\xa3\xd5\xfa\xca\xeb\xa3\x7f\x42\xfc\xaa\x00\xbd\x66\xbc\xda\xa5\x8e\xc5\x2a\xc4\xa2\xa7\x70\x4d\x5e\x13\x08\xa9\x9b\x50\x3d\x3f\xab\x24\x0d\x52\x68\xe1\x09\xd6\x84\x71\x7b\x18\xb7\x69\x22\xe7\xa8\x87\xdb\xa4\x0a\xd7\x38\xfc\xab\xf4\x7e\x61\x41\x2a\x54\xc6\x45\xad\xd9\x8c\x72\xaa\x57\x4d\xe8\x27\x6f\x3f\xd6\xc6\x6a\xb3\x50\x69\x94\x5d\x23\xef\x3a\x47\xc7\x81\x45\x10\xff\x8a\x30\xc2\x3d\x94\x4d\xf8\xf2\xf5\xb0\xc3\x87\xa6\x4d\x69\xe4\xfa\xc1\x6c\xaf\xb1\xcd\x08\x5d\xfe\x8b\xbb\x9f\x78\x1e\x2a\x75\x27\x7c\xdc\x80\x60\x84\xc4\xff\x68\x6a\xea\x01\x8f\x73\x91\xc4\x75\x62\xda\xc8\x2f\xf1\x53\x88\x4a\x67\x9e\x7f\x29\x2d\x64\xf4\xf4\xea\xcb\x97\x53\x37\x11\xa1\x9d\xf0\x57\xa7\x9d\xe4\x95\xd9\x28\x99\xeb\x34\x36\x22\x09\x88\x47\xf5\xea\xeb\xd7\xdd\xa5\x46\x82\x40\x9d\x8a\x00\xb9\x5a\xd0\x99\x36\xfa\x64\x7c\xd1\xc1\x80\x89\xd5\x12\xb9\x6e\x27\x0f\x99\xfe\x95\xdd\x20\x31\x60\xd4\x23\xaa\x19\xbf\x5c\x3a\xe6\xba\xd1\x92\x68\x9c\xaf\x12\x56\x6b\xa5\x46\xb8\xae\x09\x36\x0e\xdf\x41\x00\x40\x14\x25\xb7\x1e\x00\x2e\x71\x29\xa2\x37\x88\x95\x6a\xed\x8e\xa6\x4f\xb2\xf6\xd1\x92\xa5\x2d\x25\xa4\x1a\x97\x01\x23\x7a\xf3\xaa\x6f\xdb\x9f\xfb\xde\x3b\x64\x97\xb7\xd8\xe6\x1b\xec\x93\x75\xd3\xda\x35\x51\xd8\x6f\x79\x9e\xd9\x5f\xf6\x77\x60\x86\x33\x12\x32\xbd\x21\x8e\x0a\x37\xca\x51\x66\xa4\x2e\x00\xf2\xa7\xac\x12\x49\xdc\xdd\x79\x2e\xb7\x55\x06\x3e\x11\x16\xbe\x61\x75\xdd\x2b\x94\x5f\xbf\xbe\x3c\x77\xf2\xea\xec\x7b\xe6\x1f\x12\xa5\x9e\x85\xf4\x5f\xe3\x91\x3c\x57\xfb\x1e\x1e\xc6\xa4\xaf\xcd\xbf\xf7\x84\xee\x7b\x18\xb9\x71\xc5\x9a\xab\x14\x5d\x46\xe1\xcc\x02\x6b\xb7\x71\x18\x32\x36\x14\x8c\x7a\xab\x26\x74\x67\x7d\xa1\x87\x12\x15\x72\x9d\xdd\xce\xd2\x19\x7a\x2b\x8f\xed\xbc\x50\xdd\x54\xd6\xbb\x0f\x57\xf1\x73\x16\x61\x29\x78\x96\x4b\xc2\xfd\xfd\x8e\x02\x44\xb5\xf8\xa6\x12\xcf\xf6\x14\xbc\x3c\xf2\x03\xa5\x7a\xb6\xd4\xdf\x12\xff\x09\x39\x2a\x35\x94\x62\xba\xa3\x82\xc9\xad\x94\xb0\x8e\x29\xa6\x5c\xf4\x04\xf7\x55\x13\x6a\xa5\x2d\x1a\xed\x05\xae\xf0\x1e\x71\x4f\xcd\xbd\x3a\x02\x36\x81\x7a\xaf\xe6\x80\xed\xda\x24\xd1\x64\xb3\xa0\x86\x79\x73\xe5\x57\x26\x10\xc5\x20\xe2\xd3\x03\x3a\xe5\x59\xff\x80\xed\x0f\x59\xbe\x00\x05\xba\xdf\xb4\xeb\x8a\x02\xfc\xb5\xef\x64\x5f\xf7\x4c\x35\x43\xf2\x37\xe8\x5c\xc1\x6f\xc2\x05\x8f\x11\xa5\x80\x2a\x38\xb9\x09\x89\x24\x5c\x23\xfa\x27\xf0\x3e\x09\xd3\x70\x79\x19\x07\xf7\x0f\x5b\xa3\xfb\x42\x63\x13\x06\x1c\x06\xee\x00\xf4\x02\x25\x9a\x39\xb8\x80\x74\x96\xf5\xd4\x36\x50\xad\x80\xb0\x67\xb2\x52\x30\x0d\xa5\xd2\x64\xca\x70\xcb\x19\x7b\xd9\x04\x72\x33\x0a\x64\x32\xc5\xdb\x0b\x8b\xbb\x68\xc4\xd6\x6a\xce\x4f\x42\x7f\xd9\xf4\x4f\x51\x29\x13\xdd\x5c\xec\xe0\x75\x69\xda\x86\x44\x2f\x9a\xbb\x8b\xd0\xa4\xb6\x2d\x59\xf6\x77\x94\x3b\x24\x2f\xcd\x96\x2c\xe9\x97\x66\xdc\x7f\xfb\x9d\x3f\xb3\x08\xb4\x49\x9a\x05\x29\x84\x2e\x2a\xe9\x15\xd3\xc5\x59\xf0\x66\xf3\xe2\x2b\x52\xc7\xfb\xe0\x64\xe6\x03\xc9\xce\x20\x7a\xe2\x0e\xee\x47\x6d\x67\xd2\x6f\xdd\xe5\x66\x8a\x94\x6f\xb3\x58\x7c\x5b\xea\x6b\xbe\x3d\x83\xfd\x0f\x13\x1e\x61\x0b\xa1\x74\xd3\x84\x91\x62\xa2\xc3\x7f\x2b\xc5\x96\xc2\xc7\x4b\x9f\xaa\x1d\xe0\x6e\x32\xd1\xcd\xc4\xf9\xfb\x70\x30\x1a\x3b\xa3\x89\xf3\xf7\xb1\xd3\xef\x4c\x7e\xbb\x77\x46\xbf\x4f\x86\xad\xf1\x6d\x9e\x26\x45\xd4\xa9\x19\x8b\xf8\xd9\x44\x36\x94\xc5\xec\x6f\x3c\x72\x72\xcf\x97\x2f\xf0\xb2\x32\x4e\x3c\xd1\x69\xd7\x8c\x80\xaf\x5f\xbf\x27\x59\x1d\xfc\xb5\xcc\xdb\x32\xc2\x8c\x50\x16\x4a\x1c\x27\xfb\xf6\xed\xa0\xf3\x6a\x36\x68\x94\xeb\x17\xdf\x98\x61\x5e\x8a\xe5\x47\x91\xe6\xac\xf4\x4d\x49\x6a\x6f\xd2\xb5\xc5\xf7\xad\xfc\x5d\x71\x31\x2a\xb6\xbf\x29\xd4\x99\x92\xfb\x9b\x83\x57\x2e\x80\x5f\x89\x04\x39\xbf\xba\x4a\x19\x6e\x55\xc4\x6f\x1e\x1b\x95\xd4\xeb\x23\xc2\xe6\xf7\x71\xcf\x63\xb6\x13\x68\x83\xbc\x8d\xfe\x36\x3b\xcf\x34\xed\x16\xff\xd3\x0c\x93\x03\x62\xc6\x1b\x04\xb3\xe1\x6e\x42\xb5\x5c\xfe\xbe\x78\xfd\x46\xc2\x83\x52\xbc\x12\xb4\x5f\x24\xd0\x92\xce\xe7\x9b\x0d\x4d\x21\x39\x5d\x89\x18\xb5\x17\x84\xcf\x31\xee\x88\xe2\xcf\xba\x65\x48\x24\x59\x66\x1c\x4e\x42\x2d\x96\x44\x53\xaf\x09\x5a\x86\xb8\xbf\x5d\x32\x96\xdd\x02\x48\x4e\x7d\x38\x93\x62\xcb\x29\xeb\x83\x81\x28\x12\xba\x5a\x22\x59\x8e\xc9\xbe\xcd\x5e\x4b\x0f\xd1\xf0\xad\xdc\x6e\xc6\x45\x3f\x12\x7b\xe3\xe0\x35\xef\x7e\x32\x6a\x33\xd7\xda\x4e\xdd\xd4\x28\xef\xfe\x2f\x00\x00\xff\xff\x75\x1f\x0b\x0e\xda\x38\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xc1\x4e\xc4\x30\x0c\x44\xef\xfd\x8a\xf9\x81\x82\x10\x7b\x40\xb9\x72\x47\x95\x40\xdc\x4d\x6a\xd8\x88\x34\xb6\x1c\x77\xb5\xfd\x7b\xc4\x36\x15\x20\x21\xf5\xb2\xb7\xe4\x8d\x33\x19\x4f\x0f\xd2\xf4\xca\x56\x93\x94\x80\xd3\x5d\x07\x7c\xa6\x32\x06\x3c\xb3\x9d\x52\xe4\x0e\x98\xd8\x69\x24\xa7\xd0\x01\x40\xa6\x37\xce\x75\x3d\x03\xa4\x1a\x50\x97\x32\x72\x4d\xb5\xb1\xed\x7a\x93\xe4\x76\x4f\xf7\x45\x39\x20\x95\x77\xa3\xea\x36\x47\x9f\x8d\xff\x19\x8b\x32\xa9\x14\x2e\xfe\x63\xd6\x0b\xcd\x7e\x54\x93\xf3\x72\x79\x50\x68\xe2\x5f\x2a\x9f\x9d\xad\x50\xee\x80\xaa\x1c\xd7\xbc\xeb\x6f\x4f\x32\xf2\x20\xe6\x17\xa4\x62\xde\xb6\xe9\x9b\xc7\xd1\x5d\xb7\xac\xdf\x72\xc0\xc3\xe1\x70\xbf\x01\x13\x97\x28\x39\xe0\xe5\x71\x68\xcc\xc9\x3e\xd8\x87\xbf\xa3\x95\x33\x47\x17\xbb\x56\x53\x3b\x15\x7c\x0d\x00\x42\xdd\xbe\x64\xc8\x01\x00\x00"),
 		},
 		"/infrastructure": &vfsgen۰DirInfo{
 			name:    "infrastructure",
@@ -214,77 +291,91 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 632,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x50\x3b\x6f\xf2\x50\x0c\xdd\xf3\x2b\x2c\x16\xa6\xe4\xd3\xc7\x78\xd7\xb2\x30\xb5\x52\xaa\xee\x6e\xe2\x80\x4b\xee\x43\xd7\x0e\x12\x8a\xf2\xdf\xab\x3c\x2e\x69\x11\x2a\xa3\x7d\x8e\x7d\x1e\x39\x60\xe0\x0f\x8a\xc2\xde\x19\x60\x8b\x47\x2a\x7c\x20\x27\x27\x6e\xb4\x60\xff\xef\xf2\x3f\x03\x38\xb3\xab\x0d\x1c\x46\xb4\xd4\x48\x68\x33\x00\x4b\x8a\x35\x2a\x9a\x0c\x00\xc0\xa1\x25\x03\x72\x75\x35\x09\x4b\x2e\x3b\x9e\xd6\x2d\x7e\x52\x2b\x33\x05\x00\x43\x58\x39\xcb\x2e\x8d\xa3\xd6\x33\x5c\xaf\x81\x0c\xb0\x6b\x22\x8a\xc6\xae\xd2\x2e\xd2\x03\x5a\xe5\x6d\xf0\x8e\x9c\x1a\x90\x1d\xe7\x5f\x78\xc1\x0c\x40\x02\x55\xb3\x11\xc5\xe3\xcd\x52\xdf\x73\x03\xc5\x9e\x2e\x65\x17\x82\x8f\x3a\x0c\x13\x90\x2f\x81\x36\x2d\x2a\x89\x6e\x16\x76\x13\xbd\x4d\x97\x0f\x5a\x79\xc7\xe3\x0d\x9c\xef\xb7\x3f\x1b\x31\xf3\xb3\xed\x4d\x9a\x5a\xa1\x7b\xc1\xbe\x1f\xfd\xbd\x36\x50\x94\x29\xd2\x4b\xca\x23\x45\xb9\x3b\x14\x93\x20\x0c\xc3\x5f\xa6\xf6\xbe\x3a\x53\x9c\x98\xf7\x8e\xfa\xfe\xf9\xeb\xed\xaf\x76\xca\xea\x44\x75\xd7\x52\xbd\x78\x05\x60\x3b\x76\xf5\xe6\x5b\xae\xae\xab\xb4\x24\x9e\x01\x8d\x1d\xad\x31\xdd\x7a\x99\xa6\xef\x00\x00\x00\xff\xff\x84\xc6\x81\xb7\x78\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xbf\x6e\xf3\x30\x0c\xc4\x77\x3f\x05\x91\x25\x93\xfd\xe1\xcb\xa8\xb5\x59\x32\xb5\x80\x8b\xee\xac\x4d\x3b\x6c\x6c\x49\x10\xe9\x00\x81\xe0\x77\x2f\xfc\x47\x31\x10\x04\xcd\x68\xdd\x91\xf7\xe3\x39\x07\xf4\xfc\x45\x41\xd8\x59\x03\xdc\x63\x4b\x85\xf3\x64\xe5\xcc\x8d\x16\xec\xfe\x5d\xff\x67\x00\x17\xb6\xb5\x81\xd3\xa4\x96\x1a\x08\xfb\x0c\xa0\x27\xc5\x1a\x15\x4d\x06\x00\x60\xb1\x27\x03\x72\xb3\x35\x09\x4b\x2e\x07\x9e\x9f\x3b\xfc\xa6\x4e\x16\x0b\x00\x7a\xbf\x79\xd6\xb7\xf4\x39\x65\xbd\xd2\xf5\xe6\xc9\x00\xdb\x26\xa0\x68\x18\x2a\x1d\x02\x3d\xb1\x55\xae\xf7\xce\x92\x55\x03\x72\xe0\xfc\x07\xaf\x98\x01\x88\xa7\x6a\x01\x51\x6c\xef\x48\x31\x72\x03\xc5\x91\xae\xe5\xe0\xbd\x0b\x3a\x8e\xb3\x90\xaf\x07\xed\x3a\x54\x12\xdd\xad\xee\x26\xb8\x3e\x4d\x3e\x69\xe5\x13\xdb\xbb\xb8\xcc\xef\x13\xd7\xd4\x88\x59\x96\xed\x57\x4f\x8c\xd4\x09\x3d\x06\xc6\x08\x8a\xed\x7b\x03\x45\x99\x4e\x7a\x4b\xf7\x48\x51\x1e\x4e\xc5\x1c\x08\xe3\xf8\x17\xd4\xd1\x55\x17\x0a\xb3\xf3\x91\x28\xc6\xd7\xab\x37\xc4\xa9\x9d\xb2\x3a\x53\x3d\x74\x54\xaf\xac\x00\xdc\x4f\x5d\x7d\xb8\x8e\xab\xdb\x16\x2d\xc9\x67\x40\xc3\x90\xfe\x4c\x8c\x64\xb7\xc9\x18\xc9\xd6\xe3\x98\xfd\x0e\x00\x84\xc6\x81\xb7\x78\x02\x00\x00"),
 		},
 		"/infrastructure/02-syndesis-secrets.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-secrets.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1759,
+			uncompressedSize: 3853,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\xc1\x8e\xda\x30\x10\x86\xef\x3c\xc5\xbc\x40\x52\xf5\x1a\x69\x0f\x69\x70\xbb\x11\x34\x49\xb1\xdb\x8a\x53\x64\x92\x01\xac\x4d\x6c\xcb\x36\x5b\x21\xba\xef\x5e\x2d\x75\x4a\xa1\x81\x40\x39\xec\x11\x3c\xfe\x66\xfe\xc9\xe7\x00\xb8\x16\xdf\xd0\x58\xa1\x64\x04\xcf\xef\x47\x00\x4f\x42\xd6\x11\x50\xac\x0c\xba\x11\x40\x8b\x8e\xd7\xdc\xf1\x68\x04\x00\x20\x79\x8b\x11\xd8\xad\xac\xd1\x0a\x1b\x58\x34\xcf\x68\x02\xdb\x15\x03\x34\x7c\x81\x8d\xfd\x5d\x0c\xc0\xb5\x3e\x54\xfb\xff\xba\x9f\xa1\x50\xef\x86\xce\xdd\x56\x63\x04\x42\x2e\x0d\xb7\xce\x6c\x2a\xb7\x31\x38\x02\xb0\xce\x08\xb9\x1a\xff\x99\xaa\x6a\x04\x4a\x47\x1d\x77\x18\x6f\xdc\x1a\xa5\x13\x15\x77\x42\xc9\x09\x6e\x23\xd8\xed\x42\xda\x31\x13\xd5\x6a\x25\x51\x3a\x1b\xd2\xfd\xec\x61\x72\xb8\x4b\x64\x65\xb6\xda\xdf\x7b\x79\x39\x45\x1f\x1d\xdf\x81\xbd\x73\xe7\xab\x46\x2d\x78\x13\x54\x4a\x2e\xc5\xea\xed\x76\x9e\x17\x24\xa3\x8f\xe9\x47\x56\xe6\xf1\x57\xf6\x58\x26\xd3\x94\x64\xac\xa4\x24\x99\x11\x16\xc1\xcf\xc0\xa3\x77\xbb\x30\xd7\x28\xe9\x5a\x2c\x5d\xce\x37\x6e\xed\x37\xb3\xcf\xea\x97\x5c\xe4\x94\x7d\x9a\x11\xfa\x65\x5a\x16\x31\xa5\xdf\xf3\xd9\xf8\x98\xd0\xb7\xe8\xd7\x51\x16\xdc\x62\x58\x70\x6b\x7f\x28\x53\xff\x0b\xa3\xf1\xe7\x62\x4a\xc6\x1f\xfe\x87\x4a\x79\xab\x1b\xac\x17\x27\x74\x1f\x36\xcf\x27\x29\xe9\x0d\xdb\x07\xdd\xe7\x0e\x13\xa5\x9e\x04\x1e\x05\xa7\xf3\x6c\x4c\x68\x4a\x4b\x92\x25\xb3\x79\xc1\xca\x09\x99\x0f\xe3\xbc\x62\xdd\x91\xf7\xeb\xe0\x6c\xf7\x29\x58\xcc\x48\xf9\x3a\x2f\xc9\x58\x9a\xc4\x2c\xcd\xb3\x9b\x1a\x24\x17\x9e\x55\x5f\x2b\x1f\xe2\x8e\x36\x7d\x2f\x50\x73\xc3\x5b\xfb\x17\xed\xa2\x79\x0f\xd7\xf8\xd6\x6b\xdc\xc3\x8d\x9e\x5d\x36\x6d\x90\x76\xc6\xaf\x5e\xc3\xce\xc1\xce\x7a\xd5\x6f\xd6\x39\xcc\x90\x4f\x83\x46\x0d\x80\xaf\xf0\xe8\xa2\x49\xd7\xe3\x4f\xfd\xf9\x15\x00\x00\xff\xff\x55\xb3\x5d\x61\xdf\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x95\x41\x6f\xab\x38\x14\x85\xf7\xfd\x15\x77\xfd\x34\xa1\x9a\x6d\x24\x16\x94\x78\xe6\xa1\x30\xc0\x60\xbf\x37\xea\x0a\x39\xe1\xb6\xb1\x42\x0c\xb2\x9d\x56\x28\xf3\xfe\xfb\x88\x14\x92\x86\x40\xa0\x49\xa4\x59\x26\xb6\xbf\x73\x7d\xb8\xf7\x78\x02\xbc\x10\x3f\x51\x69\x91\xcb\x29\xbc\xfd\xfe\x00\xb0\x16\x32\x9d\x02\xc5\xa5\x42\xf3\x00\xb0\x41\xc3\x53\x6e\xf8\xf4\x01\x00\x40\xf2\x0d\x4e\x41\x97\x32\x45\x2d\xf4\x44\xa3\x7a\x43\x35\xd1\xcd\x66\x80\x8c\x2f\x30\xd3\x1f\x9b\x01\x78\x51\x1c\x77\xd7\xff\x35\x3f\x2d\x91\x3f\x0e\xad\x9b\xb2\xc0\x29\x08\xf9\xa2\xb8\x36\x6a\xbb\x34\x5b\x85\x0f\x00\xda\x28\x21\x5f\x67\x87\xaa\x96\x99\x40\x69\xa8\xe1\x06\x9d\xad\x59\xa1\x34\x62\xc9\x8d\xc8\xe5\x1c\xcb\x29\xec\x76\x16\x6d\x98\x6e\xbe\x29\x72\x89\xd2\x68\x8b\xee\x6b\xb7\xdc\xe3\x59\x22\x97\xaa\x2c\xea\x73\xbf\x7e\xb5\xd1\x27\xcb\x37\x60\x77\xbb\x09\x88\x17\x18\x79\x3a\x52\xf8\x26\xf2\xad\x66\x22\xad\xcf\x3e\x7e\x03\x5f\xac\x11\xcc\x0a\x61\xb9\x55\x0a\xa5\x81\x35\x96\xfa\xb7\xfd\x3f\x1f\x9f\x04\x56\x5c\x03\xcf\xde\x79\xa9\x61\x81\x28\x81\x7f\xf2\x45\xbe\xc2\xbb\x30\xab\xfd\x76\x3c\x14\x57\x31\xe0\xdb\x63\x7d\xef\xa2\x96\x75\xef\x63\x6d\x73\x8b\xb6\x17\x3d\x52\xd7\x5a\xdd\x27\x53\x59\x8e\xb2\x32\xf0\xc6\x86\x7f\xcd\xf2\x05\xcf\x26\xcb\x5c\xbe\x88\xd7\xff\xaf\xe1\xc3\x88\x04\xf4\xbb\xf7\x07\x4b\x42\xe7\x07\xfb\x9e\xb8\xbe\x47\x02\x96\x50\xe2\xc6\x84\x4d\xe1\xdf\x49\x8d\xde\xed\xac\xb0\x40\x49\x57\xe2\xc5\x84\x55\x0b\xd4\x5e\xed\xef\x5a\xdb\x1f\x85\x94\xfd\x19\x13\xfa\xb7\x9f\x44\x0e\xa5\xff\x84\xf1\xec\x94\xd0\x65\x7d\x55\xca\x82\x6b\xb4\x22\xae\xf5\x7b\xae\xd2\x73\x18\x75\xfe\x8a\x7c\x32\x7b\xba\x86\x4a\xf9\xa6\xc8\x30\x5d\xf4\xd3\x63\x12\xf9\x9e\xeb\x30\x2f\x0c\xae\x11\x88\xb1\xc8\xea\x46\x6e\x69\xd4\x86\x86\xe1\xdc\x23\x9d\x86\x76\x71\xf7\xde\x5a\x6e\x9e\xaf\x05\x9e\x98\xdb\x41\x4b\xa2\x98\xfc\xf4\xc2\x1f\xf4\x1a\x6c\xd3\xe0\x35\x9e\x3e\x07\x33\x42\x3d\x9a\x90\xc0\x8d\x9f\x23\x96\xcc\xc9\xf3\x30\xb6\x9e\x9b\x66\xa9\x1e\x96\xe3\x40\x36\xdd\xc4\x1c\x46\x92\xea\x02\x24\x60\x8d\xd7\x5f\x11\xb8\x94\x1d\x5d\x52\xf5\x25\x6e\x90\xb9\x67\xd4\x9e\x95\xd7\x7c\xb7\x3b\x59\xd2\xa8\x8d\xb2\xe6\xa0\x7d\xbb\x47\x8d\x6e\x97\x57\x1f\x19\x59\xf1\x9e\xe2\x70\x4e\xe2\xa1\xd1\x72\xd2\x34\x97\xda\x7a\x52\xf9\x1a\x55\x3b\x0b\xbc\x80\x91\x38\x70\xfc\x84\xf9\xb4\x2a\x96\xb2\x30\x26\x43\x44\x4f\x1a\x54\x92\x67\xcc\xa7\xd6\x1c\x4b\x6d\x72\x85\x2d\x6e\xc1\x15\xdf\xe8\x4f\xe7\x2f\xa6\xa1\x3d\x26\x03\x4f\xa2\xa5\xa9\xd0\xee\xb1\xf4\x10\x22\xad\xba\x2e\xa7\x9f\x7d\x65\xe6\x0d\xa6\x9e\x7d\x7d\xd6\x75\xe6\x93\x3d\x3a\x8c\x2e\x51\x0e\x29\x37\x1e\xd7\x34\xe6\x45\x6c\x1c\xb2\xfd\xd4\x8d\xc7\xc6\xb9\xd9\x87\xce\x30\x96\xcc\x12\x87\x7d\x11\x8c\xa9\x73\xf4\xa1\x2b\x8e\xed\xcb\x83\xd9\x2c\xd5\xd3\x78\x1c\xff\xc1\x18\xb6\x47\x4f\x7c\x5f\xc2\xb4\x24\x4e\xa3\x65\x3c\xbe\x1d\x24\x1d\x68\xe6\xcd\xc6\xf3\x8e\xf1\xdb\x17\x82\x5f\xc2\x9d\xa7\xfa\xf8\x5c\xb7\xef\x96\xe6\x7d\x9a\xd7\x9a\xde\xe8\x8d\x30\x7f\x4e\x9e\x07\xc7\xe6\x5c\x60\x8e\xe5\xd9\xe4\x74\x63\x2f\x8e\x4d\x3f\xf8\x64\x72\x5a\xcf\x8d\x3d\xfa\x91\x19\x7a\x66\xec\x2f\x3c\x2e\xff\x0d\x00\x94\xa6\x76\x5e\x0d\x0f\x00\x00"),
 		},
 		"/infrastructure/02-syndesis-service-accounts.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-service-accounts.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1354,
+			uncompressedSize: 2260,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x90\x31\x4b\x03\x41\x10\x85\xfb\xfd\x15\x43\xfa\x3b\xb1\xdd\xce\xd2\x4e\x08\xd8\x8f\x7b\x73\xe7\xe0\xdd\xec\x32\x3b\x7b\x10\x96\xfd\xef\x42\xbc\x60\x88\x82\x4d\x90\x08\x96\x33\xef\x7d\xf0\xf8\x3a\xc0\xc4\xcf\xa4\x99\xa3\x78\x58\xef\x1d\xc0\x1b\xcb\xe0\x61\x4f\xba\x72\xa0\x87\x10\x62\x11\x73\x00\x0b\x19\x0e\x68\xe8\x1d\x00\x80\xe0\x42\x1e\xf2\x41\x06\xca\x9c\xbb\x81\x46\x2c\xb3\x1d\xa3\x19\x5f\x68\xce\x1f\x35\x00\x4c\xe9\xb3\xb7\xfd\x4e\x67\xcf\xf1\xee\xa7\xdc\x0e\x89\x3c\xb0\x8c\x8a\xd9\xb4\x04\x2b\x4a\xdf\xd4\x42\x5c\x52\x14\x12\x3b\x1b\x75\x01\xd5\xca\x23\xf4\x8f\x0b\x4e\xf4\x54\xe6\x79\x4f\x41\xc9\x32\xb4\xe6\x00\xf8\xe2\xeb\x5d\xad\x24\x43\x6b\xb5\x2a\xca\x44\x5f\xb9\x23\xd6\x6d\x1e\x76\xb5\xf6\xad\xed\x4e\x90\xbb\x92\xd5\x4c\xba\x92\xde\x96\xd4\x6d\xd3\xdf\x93\xc9\x62\x34\x29\x1a\x47\xf9\x37\x7a\x15\xa3\x49\xe3\x42\xf6\x4a\x25\xdf\x96\xd0\xb3\x5d\xbf\x28\xf5\x3d\x00\x00\xff\xff\x17\xf2\xc1\xb1\x4a\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x94\x31\xef\xd3\x30\x10\xc5\xf7\x7c\x8a\xa7\x3f\x03\x0b\x09\x62\xcd\x56\x21\x86\x4e\x54\x2a\x62\xbf\x26\xd7\xd4\xaa\x73\xb6\xec\x73\xa5\xca\xca\x77\x47\x4d\x9a\x96\x02\x12\x4b\x05\x81\xff\x98\xe7\x7b\xf6\xf3\xef\x59\x79\x83\xcf\xc2\x88\x1c\x4e\xa6\x61\x50\xd3\xb8\x24\x0a\xcf\x01\x8d\xeb\xbd\x13\x16\x7d\x07\x3d\x30\x9c\x70\x84\x38\x85\x92\x3d\x1a\xe9\xa0\x6e\xd4\x57\x9b\xf5\x68\xe7\x80\xd6\xc9\x5b\x45\xc7\x0a\x82\xba\x23\x4b\x51\x82\xbc\xf9\xca\x21\x1a\x27\x35\x4e\x1f\x0a\xe0\x68\xa4\xad\xb1\x9d\x0e\x5c\x4d\xe7\x15\x40\xcf\x4a\x2d\x29\xd5\x05\x00\x08\xf5\x5c\x23\x9e\xa5\xe5\x68\x62\xd9\xee\x46\xd5\xd2\x8e\x6d\x9c\x26\x00\xf2\xfe\x3e\x72\xd5\xe6\xcf\xca\xb8\xf7\xbf\x5b\xd7\xb3\xe7\x1a\x46\xf6\x81\xa2\x86\xd4\x68\x0a\xfc\x8b\xb1\x1b\x86\xfb\x66\x53\x1e\x4a\xea\xfa\x4b\xfa\xc7\xbb\x7c\xb9\x5c\xbc\xc6\x9e\x6c\xe4\x22\x67\xb3\x47\xb5\xee\xa9\xe3\x4d\xb2\x76\xcb\x4d\x60\x8d\x18\x86\x02\x30\x3f\xa8\x75\x91\x33\x4b\x3b\x0c\x39\x07\x92\x8e\x7f\xf6\x8d\xb6\xf2\x0a\xe7\x25\xe7\x6a\x18\x5e\x66\xd3\xb3\x50\x4f\x55\x2e\x0b\xf7\x35\xd3\xbf\x07\xd3\x88\x72\x17\x48\x8d\x93\xd7\x4e\x34\xe7\x12\x63\x7d\x12\x95\xac\x8d\x2b\xef\xad\x69\x46\x34\xcf\xe3\x9d\xcc\xb2\x30\x27\xf3\xdf\xfe\x27\x2e\x8b\xcb\x82\x3d\x26\xfa\xc3\x2f\xfa\xf1\x6d\x6f\xe7\x84\x1f\xe7\x78\xb1\xda\x04\xd7\xb3\x1e\x38\xc5\xea\x93\xd0\xce\xf2\x13\x3b\xf0\xb7\xbd\x97\xd5\xc4\x77\xb9\xfe\x4a\x1f\xdf\x06\x00\x60\xab\x51\xfc\xd4\x08\x00\x00"),
+		},
+		"/infrastructure/03-syndesis-logging-config.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "03-syndesis-logging-config.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 525,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x31\x6b\xec\x30\x10\x84\x7b\xfd\x8a\x81\x77\x70\xcd\xb3\x1f\xaf\x15\xa4\xba\xf6\x52\x05\xd2\xef\x59\x6b\x67\x89\x6e\x65\x24\xdd\x81\x71\xf4\xdf\x83\x6d\x1d\x0e\xa4\x48\x70\xe3\x9d\xd1\x8e\xbe\x51\x03\x1a\xe5\x95\x63\x92\xa0\x16\xf7\xff\x06\x78\x17\x75\x16\xa7\xa0\xbd\x0c\xcf\x34\x1a\xe0\xca\x99\x1c\x65\xb2\x06\x00\x3c\x5d\xd8\xa7\xed\x1f\xa0\x71\xb4\x48\x93\x3a\x4e\x92\xaa\xf6\x18\x5b\x09\xff\x7e\xf2\xf3\x34\xb2\x85\x68\x1f\x29\xe5\x78\xeb\xf2\x2d\xf2\x1a\xa3\x74\xe5\x7d\xb3\xf1\x61\x18\x44\x87\xa6\x5b\xb9\x0c\xb0\x03\xfd\xc1\x39\x90\x63\x87\xcb\xb4\x9f\x4f\x1c\xef\x1c\x41\xea\x76\x6d\xe9\x81\xa0\xc8\x61\x44\xe8\x91\xdf\x58\xe2\x52\xc0\x4b\x47\x59\x82\xb6\xd3\xd5\x9b\x5a\xea\xab\x66\xf1\xd1\x98\x79\x6e\x20\x3d\xda\x97\x9a\xd6\x9e\x37\xa2\xf6\x44\x99\x87\x10\x85\x53\x29\xb5\x60\x85\x7d\xbc\x11\xe0\xf9\xce\x7e\x19\x97\x94\x48\x3a\x30\x0e\xdd\xb6\x37\xfd\xc5\x61\xb5\x61\x9f\x7e\x97\xbe\x7c\xc7\x79\xde\x13\x50\xca\xd1\x6e\xd2\x96\x54\xca\xb1\xde\xc5\xea\x4a\x59\xd9\xd9\x27\xfe\x06\x88\xb9\x9a\xea\x4a\x31\x9f\x03\x00\x24\x68\x21\x5c\x0d\x02\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-server-config.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-server-config.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 3396,
+			uncompressedSize: 5014,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdd\x6e\x1b\x37\x13\xbd\xd7\x53\x10\x41\x80\x7c\x1f\x9a\x5d\x59\x4a\x0a\x04\x04\x7a\x91\x4a\x69\xaa\xc6\xae\x0d\x2b\x2e\x7c\x3b\x22\x47\x2b\x5a\x5c\x92\x26\xb9\x8a\x37\xaa\xde\xbd\xe0\xfe\x52\xb6\x64\x39\x68\xef\x76\x39\x67\xce\x0c\x67\xc8\x33\x4c\x08\x18\xf1\x17\x5a\x27\xb4\xa2\x64\x33\x1a\x10\xb2\x16\x8a\x53\x32\xd1\x6a\x29\xb2\x0b\x30\x03\x42\x72\xf4\xc0\xc1\x03\x1d\x10\x42\x08\x28\xa5\x3d\x78\xa1\x95\xab\x17\x08\x11\x3a\x75\xa5\xe2\xe8\x84\x1b\x16\x26\xb3\xc0\x31\xc9\x35\x47\x4a\xd6\x88\xa6\x02\x49\x58\xa0\xec\x1c\xc0\x18\x4a\x5a\x97\x66\xad\xfd\x4d\x85\x1e\x9e\xb2\xfb\xd2\x20\x25\x42\x2d\x2d\x38\x6f\x0b\xe6\x0b\x8b\x07\x60\x4c\xe7\x46\x2b\x54\xbe\x27\x4b\x1c\xda\x0d\xda\x0a\xac\x20\xc7\x27\x96\x84\x55\x3b\x1f\x10\x12\x6d\xd9\x18\x29\x58\xb5\xe7\xb4\xcc\x25\x25\x7f\x27\x4d\x34\x8e\x46\xea\x32\x0f\x21\x9a\x15\x42\xa4\x06\x9e\x70\xcc\x75\x52\x31\x90\x37\xdb\x6d\x3a\x6f\xb3\x9a\xb4\x29\xb9\x74\x5e\xc5\x4b\x7f\x43\x08\xe9\xbb\x74\x8a\xb9\x9e\x82\x87\xdd\xee\x4d\xc3\xc5\xb4\x75\x74\xb0\xdd\x26\x44\x2c\xc9\xff\x94\xf6\x24\xfd\x28\xa5\xfe\x76\xae\x19\xc8\xdf\xb5\xf3\xff\xdf\xed\xba\xb0\x10\x2c\xc8\x2f\xad\xc8\x84\x72\x94\xac\xbc\x37\x8e\x0e\x87\xdb\x6d\x7a\xad\x0b\x8f\x01\x1f\x76\xbc\xdb\x55\x8c\x28\x1d\x9e\xf0\xa6\xc3\xa1\x0c\x91\x56\xda\x79\xfa\x7e\x7c\x76\xf6\xb6\x23\x3d\xb6\x7e\x2c\x98\xe2\x5d\x2c\x06\x6c\x85\x7d\xb5\x98\x2c\x9c\x47\xdb\x2f\xb4\x7d\x69\x4b\x36\xa9\x01\x9d\x3d\x87\x87\x18\x8c\xca\x5b\x81\x8e\x92\xd1\xd9\xd9\xa0\x5d\x62\xb6\x34\x51\x47\xd6\x58\x9e\x6c\x43\x6b\xfa\x54\x3b\x7f\xc1\xb2\xef\x83\x33\x56\xa8\xac\xe7\xfb\x2e\xcc\x5a\xa8\xfd\x2c\x60\x21\x91\x53\xb2\x04\xe9\xb0\x33\x84\x03\xe0\x74\x61\x19\xc6\xe0\xc2\x4a\x4a\xde\xdc\xf1\x05\xa3\x47\x72\x0a\xc7\x60\x01\x0e\xd3\x9b\xeb\xf3\x3e\x8d\xca\xd7\xa1\xad\xeb\x73\x6c\x3f\xbd\xaf\x43\xbb\xef\x6c\xc0\xb9\x6f\xda\xf2\x17\x38\x5f\x35\xd0\x7d\x02\x6e\x45\x75\x45\x24\x38\x97\xd4\x69\x68\x9b\xa5\x46\x3b\x9f\x59\x74\xf7\x32\x9d\x56\x88\xb6\x6e\xc8\x0a\x2b\x7c\xd9\x6f\x7e\x01\x4e\xb0\x93\x85\xcb\x41\x41\x86\xfb\xb7\xca\x68\xeb\x29\xf9\x30\xfa\x30\xea\x96\x9e\xd2\x47\x7c\xde\x16\xd8\x9d\x07\x6e\xb4\x50\xde\xf5\xc0\x15\x82\xf4\xab\xd8\xd1\xa1\x72\xc2\x8b\x0d\x3e\xee\xe1\x9d\xd3\x8a\x2f\x4e\xc5\xc8\xb5\x12\x5e\xef\x1f\x93\x5a\x49\x39\x2e\xa1\x90\xbe\x59\x5d\x36\xb7\xbd\x47\x1d\xf2\x3c\x1c\x83\x10\x53\x2c\xa4\x60\x09\x18\x71\x1a\xbb\x56\x50\x6d\x27\x02\x36\x4a\xd2\x77\xfe\x23\xe7\x5a\xb9\xf4\x4b\x0d\x4d\x3f\xd5\x44\x24\xd2\x85\x63\xec\x35\xd9\x23\x11\x39\x7a\x0f\x1a\x74\xa5\x02\xc7\x92\xf8\x03\x30\x43\xdb\xe6\x10\xb1\xf2\x85\xd4\x59\x76\xac\x3e\x8f\x9a\x55\x91\x24\xc0\xbc\xd8\x08\x5f\x26\xde\x02\x7b\x41\x65\x6b\xb7\x1e\x75\x5f\xa0\x2d\x53\x30\x22\xad\xee\x6a\x23\x86\x4a\x43\xe1\x57\x49\x37\x30\x9a\x60\x15\x98\xbe\x7f\xff\x6e\x08\x46\x44\x87\xd3\x6e\x04\xc3\xf4\xe0\x90\x19\x3c\x53\x8e\x67\x26\xc4\x05\x6c\x50\x5d\xa3\xd1\xae\x3a\x31\xe8\xba\x2a\xe5\xc1\xd2\xe7\x6f\x23\x4c\xbd\x1a\xc2\x58\x50\x19\x92\xd7\x82\xbf\x25\xaf\x0b\x2b\x09\xfd\xe5\xdf\x86\xad\x99\x03\x25\xd9\xed\x68\xf5\x19\x88\x1b\x7b\xb3\x49\x72\x60\x08\x68\x83\xca\xad\xc4\x32\xba\xe0\x60\xc4\xaf\xe0\xf0\xa6\x12\xc7\x17\x8e\xcc\x4b\x83\x6a\x1e\x68\x2e\x20\xcc\x88\xdd\x6e\xa8\xc1\x88\xe1\x66\xd4\x8b\x56\xa8\xbf\x33\xc0\x1a\xc5\xec\x3c\xae\xac\xbe\x43\xe6\x63\x7d\x13\x39\x64\x38\xf7\x16\x21\xff\xb3\xf7\xda\x6e\xd3\xd9\x01\x43\x54\x83\x45\x21\x24\x47\x1b\xa1\xbe\x42\x16\xf7\x7c\x2c\xe8\x76\x4b\x3c\x64\x97\xc7\x1a\x3d\x9e\xd5\x41\xe2\xab\xd7\xbf\x2d\x2e\x30\xd7\xb6\xbc\xc6\xfb\x02\x9d\xbf\x10\x94\x8c\xbb\x59\xf7\x14\x76\x2e\x72\x51\x81\x7e\x1e\x8d\xa3\xa1\xb9\x41\x75\x69\xea\x57\x1b\x79\x95\xdc\xde\xd2\x9f\x6e\x1c\x7e\x1e\x7d\x9e\x90\xf6\x67\xee\x83\x08\x4d\x91\x17\xdd\x6b\x87\x24\xb7\xf9\xc3\xbb\xd1\x59\xfe\xaa\x2f\x92\xf2\x98\xd9\xca\x7a\x2e\x36\xa8\xd0\xb9\x2b\xab\x17\x38\x53\xc2\x0b\x90\x53\x94\x50\xce\x91\x69\xc5\xc3\x4c\x1e\xb7\x79\x72\xd0\x8f\x85\xb1\x16\xd6\xee\xa9\xa3\xbc\xd5\x52\x62\xf4\xe2\x79\x22\x11\x13\xc8\x51\x7e\x39\x20\x11\x51\x52\x94\xb0\x80\x4a\xd6\xfd\x0b\x23\xfc\xaf\x63\x09\x60\x85\xf3\x3a\x17\xdf\xab\x60\xfd\x72\xd2\xbd\x74\x5f\xa0\x99\x47\xe5\x2a\xf0\x34\xb2\x73\x4a\x2d\x13\xd2\x28\xdb\x41\xa1\x8c\x71\xdd\x5b\xf8\xa9\x39\x87\x87\x59\xbf\x7d\x77\x85\x36\x0c\xfe\x97\xdf\xa1\x59\xdc\xd0\x5c\xec\xdd\x88\x1c\x1e\xa6\xdd\xf1\xfa\x6f\xa9\xa3\x96\xcd\x3d\x78\x9c\xac\x90\xad\x83\x83\xdd\xc0\x0f\x28\xc0\xec\x39\x9a\x10\xef\x07\xfb\x97\xa1\x42\x0b\x5e\x47\xc3\xa0\x1d\x26\x5f\x9b\x59\x52\x0f\x8e\xbe\x11\xff\x04\x00\x00\xff\xff\xd5\x3f\x9c\x2c\x44\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcb\x6f\xdb\x3c\x12\xbf\xfb\xaf\x18\x74\x0b\x64\x17\x5b\xc9\x49\xda\x43\x21\x60\x0f\xa9\xd3\xed\xa6\x8d\x37\x41\xdc\x2c\x7a\x1d\x8b\x63\x99\x35\x45\xaa\x24\xe5\x46\xd5\xea\x7f\xff\x40\x3d\x69\x5b\xce\x03\xfd\x0e\x1f\xec\x83\x34\xef\xf9\x71\x1e\x54\x59\x06\xc0\x57\x10\x5e\x49\x63\x51\x08\x73\x91\x65\x82\xc7\x68\xb9\x92\x55\x35\x09\x00\x33\xfe\x3f\xd2\x86\x2b\x19\xc1\xf6\x6c\x02\xb0\xe1\x92\x45\x30\x53\x72\xc5\x93\x39\x66\x13\x80\x94\x2c\x32\xb4\x18\x4d\x00\x00\x50\x4a\x65\x6b\x7d\xd3\x10\x00\xb8\x0a\x4d\x21\x19\x19\x6e\xa6\x79\x96\x68\x64\x14\xa4\x8a\x51\x04\x1b\x22\x67\x01\x40\xe0\x92\x44\xaf\x80\x59\x16\x41\xa7\xd2\xd2\xba\xd7\x90\xab\xe9\x53\x7c\x5b\x64\x14\x01\x97\x2b\x8d\xc6\xea\x3c\xb6\xb9\xa6\x11\xb1\x58\xa5\x99\x92\x24\xed\x60\x2c\x30\xa4\xb7\xa4\x6b\x61\x89\x29\x1d\x70\x82\xb8\xce\x7c\x02\xe0\xa5\x3c\x60\x16\x16\xa9\x88\xe0\xff\x41\xeb\x8d\x51\x26\x54\x91\x3a\x17\x2d\x05\x40\x28\x64\x01\xa3\x54\x05\xb5\x05\x38\x29\xcb\xf0\x5a\x21\x33\x0b\x4c\x33\x41\x57\xd2\x52\xa2\x1b\x00\xab\xea\xa4\x55\x8b\x95\x36\xd1\xa4\x3d\xac\xbf\x4b\x65\x21\xbc\x10\x42\xfd\xbc\x56\x31\x8a\xff\x28\x63\xff\x51\x55\xbd\x07\x74\x1c\x62\x37\x9a\x27\x5c\x9a\x08\xd6\xd6\x66\x26\x9a\x4e\xcb\x32\xbc\x53\xb9\x25\x27\xef\x92\xab\xaa\xb2\xd4\x28\x13\x82\x70\xd1\x66\x19\x5e\x08\x4b\x5a\xe2\x20\x64\xaa\xea\x8d\x6f\xc1\x29\x91\x64\xe3\xba\xbe\x5f\xa7\xe7\xcb\xd7\xd1\x93\x30\xf4\x44\xa4\xd1\x74\x2a\x5c\x56\x6b\x65\x6c\xf4\xee\xfc\xf4\x74\x70\x7f\x8c\xfe\x57\x48\xac\x7e\x6a\x0f\x0b\xe3\x35\x0d\x07\x1e\x8b\xdc\x58\xd2\x03\xa1\x2b\xad\xce\xfe\xac\x11\xe8\xf9\x29\x3e\xf8\xc2\x24\xad\xe6\x64\x22\x38\x3b\x3d\x6d\xc9\x24\x63\x5d\x64\x5e\x51\x6d\xa8\x68\x2a\xa9\x8f\x79\xd6\x15\xb7\x09\x17\x75\xe5\xf6\xe9\x7c\x6c\x94\xbf\x50\x31\xd4\x97\xc9\x34\x97\xc9\x60\xef\x17\xcf\x36\x5c\x0e\xef\x2e\x0a\x5c\x0a\x62\x11\xac\x50\x98\xae\x9b\x9a\x2e\x30\x2a\xd7\xb1\x97\x30\x40\xae\x45\x13\xce\x25\x5a\x5c\xa2\xa1\xcf\x97\x1f\x66\xf7\x77\xd7\x83\x43\xf7\xcb\x8d\x2b\xb5\x94\x8e\x47\xde\xa9\x87\xf7\x86\xf4\xae\x72\x86\xc6\xfc\x54\x9a\x3d\x43\xf9\xb6\x15\xdd\x35\xc0\x34\xaf\xfb\x59\xa0\x31\x41\x13\x86\xd2\x49\x98\x29\x63\x13\x4d\xe6\x87\x08\x2f\x6b\x89\xae\xeb\x1e\xf7\x71\x47\xc8\x6e\xa4\x28\xfa\x44\x5b\x4f\x7f\x03\x8c\x2d\xdf\x72\x5b\x80\x26\x64\x06\xd4\x0a\xec\x9a\x80\xa1\x59\x2f\x15\x6a\xf6\x06\x4c\xe6\x38\xa0\xb6\xa4\x6b\x56\xfd\xa6\xa9\x9e\x29\xdd\x74\x73\xb4\x40\x49\x51\x04\x63\x80\xf7\x70\xbf\x2c\xc4\x93\xc9\x9f\x70\x12\xbf\x79\x0e\x2f\x3a\x05\xbf\xc9\x0c\xc5\xb9\xe6\xb6\x18\x50\x58\xa2\xe1\xf1\xf0\x7a\xa4\x64\x53\x94\x98\xd0\xee\x48\xce\x94\xb6\x11\xbc\x3f\x7b\x7f\xd6\x93\x0e\xcd\x7b\xf6\xac\xce\x3b\x73\x24\x59\xa6\xb8\xb4\xfd\xee\x02\x58\x13\x0a\xbb\xf6\x15\x0d\x49\xc3\x2d\xdf\xd2\x7e\xf7\x7c\x37\x4a\xb2\xe5\x71\x1f\x07\xa5\xe7\xb6\x83\x96\x28\xbe\x5e\x2f\xc2\x8f\x8d\x68\x8f\x88\xdb\xc3\x83\xa9\xba\x26\xba\xf1\xd6\x2f\x31\x27\x13\x96\x65\x78\x93\x91\x5c\xac\xf9\xca\xde\x6a\xf5\x9d\x62\x5b\x55\xa1\xd9\xc6\x53\xcc\xf8\x74\x7b\x76\x80\x75\xaa\x24\xb7\x6a\x77\x42\x34\xf7\x00\x46\x2b\xcc\x85\x6d\xa9\x2b\x42\xb7\x6a\x3d\x2c\xc6\x34\xc7\x81\x04\xc8\xf2\xa5\xe0\x71\x80\x19\x7f\x5a\x76\x23\xb1\xc6\xd3\x13\x3c\xc0\xea\x82\x31\x25\x4d\xf8\xa5\x11\xed\xe0\x82\xaa\x7a\xd2\x3a\xc0\xc8\xae\x3a\x52\x4f\xbd\x74\xbf\x0a\xc6\x82\xf8\x8c\x94\x90\x3e\x38\x32\x00\xb6\x14\x2a\x49\x8e\xe1\xb3\x57\x2d\xb5\x91\xa0\x1b\x28\x81\xd5\x18\x3f\x03\xd9\x46\x6d\x90\xfa\x91\x93\x2e\x42\xcc\x78\xd8\xd7\x48\x34\x9d\x4a\x85\xb9\x5d\x07\x7d\xa5\x34\x5a\x41\x2d\x1c\xbd\x7b\xf7\xd6\xd5\x46\x6f\xc2\xdd\x92\x78\x4c\xe1\xe8\x15\x69\xf2\x08\x1c\x87\x5b\xe9\xdf\x6d\xcd\x84\x73\xdc\x92\xbc\xa3\x4c\x99\xba\xd6\xdc\x7e\x6e\xfd\xa5\x8e\x33\xc4\xaf\x3d\x99\x86\xea\x1c\x36\x3b\xfb\x35\x67\x6f\xe0\x75\xae\x05\x44\xff\xfa\x5d\xb7\xee\x57\x96\xf0\x9a\x33\xa8\xaa\xa8\x7e\x74\x86\x5b\x7e\x9b\x24\x54\xd5\x61\xbe\x4a\xef\xf8\x96\x92\x62\xab\x74\x7b\x8f\x18\x67\x5d\x92\x2c\x7a\xcf\x71\x4f\x77\xf9\x8d\x80\xd8\xab\xd5\x16\x0f\x2f\x54\xfb\xb0\x3c\x4b\x37\x70\x2b\x04\x42\xe8\xc6\xf3\x90\xd6\xe1\x33\x5f\x3d\x23\x0d\x00\x46\x92\x3f\x33\x9a\x3d\xcd\xe7\x05\xe3\x53\xdd\x4f\x65\x24\x8d\x1b\x6b\x43\xb5\x60\xc6\x3f\xa0\xa1\xfb\xc7\x56\xe4\x7e\x4d\xf4\xd3\x71\x8e\xee\x62\x56\x55\x53\xd5\x4c\xc6\x61\x6f\xb9\xca\x37\x19\xc6\xed\xca\x3c\x9c\xa7\x83\x28\x4f\x31\xa1\x85\xd5\x84\xe9\x7f\x07\xad\xb2\x0c\xaf\x46\x18\x1e\x04\xcb\x9c\x0b\x46\xda\x93\xfa\x8a\x89\xdf\x6d\xe7\x3c\x2a\x4b\xb0\x98\xdc\xac\x60\x3c\xaf\xf3\xab\xc6\x89\x3f\xf4\x86\x6f\x92\x39\xa5\x4a\x17\x77\xf4\x23\x27\x63\xe7\xbc\x89\x69\xf8\xfc\xd8\x63\x3f\x62\xe2\x9a\xa7\xfc\xa8\x81\x96\xe9\xa9\xd7\xfd\x7c\x93\x39\x1f\x26\x82\x57\xc1\xb7\x6f\xd1\x3f\xef\x0d\x7d\x3a\xfb\x34\x83\xee\x65\x61\xdd\xd2\xb8\x24\x96\xf7\xdf\x56\x10\x7c\x4b\x1f\xde\x9e\x9d\xa6\xaf\x7a\x4b\x7c\x70\x76\xcd\xb7\x24\xc9\x98\x5b\xad\x96\x74\x25\xb9\xe5\x28\x2e\x49\x60\xb1\xa0\x58\x49\xe6\xae\xcf\xe7\xdd\xf5\x99\xa1\x1a\x0a\xa4\x59\x64\xcd\x26\x6e\x89\xb1\x92\x56\x2b\x21\xc8\xfb\xe8\x0a\x67\x98\x92\xf8\xe2\xa5\xe7\x4f\x0a\x2f\x90\x08\x62\x27\x19\x6c\x7a\x66\xfd\xbe\x19\x3c\x02\xc4\xb9\xb1\x2a\xe5\xbf\x6a\x07\x1d\x11\x20\xe8\xbf\xa5\x3d\xd9\x17\xaf\x14\x67\xa7\x5d\x0d\x4f\x6d\xb4\x00\xda\xed\xb3\x2f\xe8\xf5\x94\xfb\x07\x7d\xd5\x1d\xb4\x1c\x40\x8a\x0f\x3e\x2a\xb7\xa4\xdd\x6d\xbd\xe9\x8b\xf9\x28\xcf\xef\x8e\x14\x1f\x2e\xfb\x72\x7a\xa9\xb2\x07\xfb\xc2\xa2\xa5\xd9\x9a\xe2\x8d\x73\xa8\xb7\xd8\xf4\xfb\xce\x28\xf6\xac\x85\xb3\xfe\x8c\xc3\x43\xd5\x23\xed\xb4\x37\x26\xae\x1e\xf3\xee\xc2\x74\x58\xfd\xe4\x76\xfd\x64\x08\xc3\xea\x08\xe7\xf8\x30\x53\x32\xce\xb5\x26\x69\x3d\x64\x76\x01\x1f\x15\xe9\x51\x3b\x62\xe0\xc4\x3b\xbc\xd6\x9d\x83\xe7\x8e\xac\x2e\x3e\x60\xbc\x51\xab\x55\xe8\x77\xce\x1e\x6b\xee\x4e\x4a\xa0\x3f\xa5\xb5\xc7\xf7\x57\xd5\x51\x93\x9e\xae\x3b\xbd\x81\xd1\xc4\xfe\x88\xde\xc9\xce\xe0\x3f\xe2\x6a\x24\xc4\xb6\xc2\xc6\x5d\x0c\xf2\xbb\xe6\x8f\x3d\xbd\xa0\x0f\x13\x92\xa4\xd1\x2a\xef\xbb\xbf\xbb\xb8\x7d\x6d\xef\x6d\xc3\x1d\x7f\xdf\xd7\x1f\x03\x00\x43\x9f\x44\xee\x96\x13\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-ui.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-ui.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4816,
+			uncompressedSize: 7163,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x6d\x6f\xdb\x36\x10\xfe\xee\x5f\x71\x50\x31\x64\x03\x6a\x3b\x2f\x48\x30\xe8\x5b\x9b\x74\x5b\x8a\x39\x11\xea\xa6\xdb\xb7\xe1\x22\x9d\x65\x76\x14\xc9\x91\x27\x25\x99\xe7\xff\x3e\x50\x6f\xa6\xfc\xd2\xae\xeb\x50\x74\xf9\x14\x91\xcf\x1d\x9f\x7b\xee\x78\x3c\x8f\x01\x8d\x78\x47\xd6\x09\xad\x62\xa8\x4e\x46\x00\xbf\x0b\x95\xc5\x30\x27\x5b\x89\x94\x46\x00\x05\x31\x66\xc8\x18\x8f\x00\x00\x14\x16\x14\x83\x7b\x52\x19\x39\xe1\xc6\xa5\xa8\x57\x25\xde\x93\x74\x0d\x02\x00\x8d\xd9\x40\xda\xb5\xee\x73\x22\xf4\xf4\x63\xfb\xfc\x64\x28\x06\xa1\x16\x16\x1d\xdb\x32\xe5\xd2\xd2\x1e\x58\xaa\x0b\xa3\x15\x29\xde\xe6\xe3\x0c\xa5\x0d\x17\xa3\x2d\xb7\xb4\xc6\xf5\x47\x0c\xdf\x1f\xb7\xae\x8c\xd5\xac\x53\x2d\x63\x78\x7b\x99\xb4\x6b\x8c\x36\x27\x4e\x5a\x60\x0b\x75\x24\x29\x65\x6d\xff\xab\xf0\x0e\xf0\x1e\xa6\x02\x8d\x71\x13\x6d\x48\xb9\xa5\x58\xb0\x37\x0b\x92\x73\x45\x46\xea\xa7\x82\x14\x5f\x6a\xb5\x10\xf9\x4e\x96\xbe\xae\x7c\xec\xaf\x9a\x4d\x96\x2c\x19\x29\x52\x74\x31\x9c\x7c\x49\xc1\x6b\x18\x5b\x64\xca\x9f\xba\xa3\xac\x96\x52\xa8\x3c\x41\x8b\x45\xaf\x1f\x80\x50\x4c\xb6\x42\x39\xa7\x54\xab\xac\x27\xea\xff\x0a\x7c\x9c\x97\x36\xa7\x18\x4e\xcf\xbf\x09\x57\xef\x14\x56\x28\x24\xde\xcb\xad\x3d\x16\x05\xe9\x92\x7b\x5f\x17\xc7\xc7\xfd\x5e\x69\x32\x64\x4a\xc8\x0a\x9d\xed\x1c\x66\xc9\xe9\xd2\xa6\x14\x10\x93\xa2\x10\x1c\x7c\xfb\x42\x28\xb4\x7d\x8a\x21\x3a\x3d\xbf\x98\x89\xa8\xdf\xb1\xf4\x47\x49\xee\x10\xf6\x78\x03\x6d\xb2\xfd\xa6\x11\xa2\x5e\x63\x2a\x8c\x44\xa6\xce\x74\x58\x6b\xbb\xf5\x76\x28\x67\xff\x24\x6f\x9f\x50\x7b\x9f\x90\xe6\xb0\xda\x9a\x0a\xab\xbb\xdb\x8b\x34\xd5\xa5\xe2\x9b\x61\x75\x66\xb4\xc0\x52\x72\x0f\x4e\xb5\x62\x14\x8a\x6c\x10\xe0\x78\x4f\x49\xaf\x56\x62\x01\x93\x2b\xaa\xe6\xa5\xf1\xbd\x66\xbd\x0e\x88\x8a\x02\x7d\x8d\x1c\xc1\xd1\x68\xb5\x22\xe9\x68\xef\xee\x6a\x05\x93\x79\x17\xd2\x65\x17\x8f\x9b\xdc\x5d\x4f\xae\x3d\x04\xd6\xeb\xda\x5e\x65\x03\x73\x52\x55\x3c\x7a\x06\xbf\x10\x28\xa2\x0c\xd0\x53\x5e\x88\x1c\x2a\x94\x25\x01\x6b\x48\x97\xa8\xf2\xfa\x3f\xb6\x22\xcf\xc9\x02\x82\xa2\x07\xc8\xfa\x46\x02\x0f\x4b\x91\x2e\xc1\x3d\x08\x4e\x97\x42\xe5\xc0\x4b\x82\x4d\x2c\xb0\x90\x98\x4f\x46\xcf\xe0\x75\xe9\xb8\x71\xd7\x81\x6a\xee\xb5\x1c\x20\x1c\x28\xcd\xfe\x74\x27\x32\xb2\x21\x95\x86\xc1\x24\x20\xdd\x49\x78\xf5\xea\xdd\x6f\xf3\xbb\x24\xb9\x7d\xf3\x36\xd8\x85\x86\x7c\xad\xc9\x40\xd3\xa3\x6d\xd9\x92\x52\xca\x44\x4b\x91\x3e\xc5\x70\xbd\xb8\xd1\x9c\x58\x72\xa4\x38\xc0\x49\x51\x91\x22\xe7\x12\xab\xef\x29\x1e\x9c\xb2\x64\x36\x3f\x12\x0f\x17\x01\x0c\xf2\x32\x86\x68\x1a\x6d\xaf\x0f\x9f\x86\x9e\x88\x12\x2c\x50\x5e\x91\xc4\xa7\xfe\xda\x9e\x85\x18\x4b\x98\x89\x2f\xcf\xe1\x64\x34\xb4\x1b\xdc\xd1\xf1\xa6\xb4\x93\x7d\x3e\x2b\x2d\xcb\x82\x66\xfe\x8a\x6c\xd9\x15\x7e\x2d\xa9\xf9\x4d\xb5\x61\x7f\x93\xc7\x56\x6b\x9e\x3a\x9b\x4e\xd3\xee\x4d\xda\xfc\x35\x99\x6e\x36\xc6\x8d\xdb\x60\xff\x19\xcc\x89\x7d\x71\xde\x97\xd6\xb1\x6f\x98\xf0\x20\x78\x09\x08\x52\x3f\xb4\x4d\x0a\x16\x5a\xb3\xb1\x42\xd5\x40\xc7\x68\x19\xbe\x3d\x3f\x86\x99\xf8\x6e\xa0\xf2\x4e\x87\x84\xbd\x5d\x12\x82\xee\x77\x7a\x7e\x3e\x13\x83\xbd\x7d\xbd\x32\xb4\x38\x3f\x0e\x0c\x9a\x70\x06\xbd\xa1\x09\x74\x86\x26\xde\xa3\x42\xd0\x32\xc6\x3b\x52\x1d\x12\xaa\xbd\xb6\xfd\x2c\xd3\xb4\xc5\xe6\xf5\xbf\xac\xaf\xd6\xa1\xf6\x33\x6e\x6e\x49\x03\xda\x7e\xd7\xb0\x64\x5d\x20\x8b\x34\x06\xb6\x25\xed\xb6\x3c\xdf\x1a\x07\xa1\xed\x76\x56\x80\x85\xd5\x45\x18\x69\x33\xa4\xd4\x0d\x6b\xce\x96\xb0\x78\x8b\xbb\x31\x1e\x05\x9e\x62\xff\xba\x38\x3e\xda\x02\x39\x83\x69\xdb\x01\x02\x67\x37\xdd\xce\xa6\x17\x34\x6a\x5c\x6f\xe2\x1c\x6d\x4d\x53\xb5\x04\x07\xc7\xa9\xc0\xf9\xff\x7c\xde\x65\xcc\xfb\x12\x69\x98\x47\x8d\xb4\x5d\x0f\x19\xa6\xea\x83\x89\xfa\x40\x9a\xba\x17\x68\x74\xf0\xe7\xc3\x65\x77\x03\x3e\x2e\x68\x78\x09\xbe\x2e\x5d\x37\xa4\x1b\x8a\x93\xf7\xce\x87\xf9\x57\xeb\x63\xd5\x4b\x15\xa1\x11\x2f\xd1\x51\x14\x43\xe4\x9b\xb9\x8b\xa7\xd3\xd5\x6a\xf2\x46\x97\x4c\x3f\x69\xc7\x3e\xe6\xf5\x3a\x7a\x3e\x30\x78\xa5\x32\xa3\x85\x62\x6f\x34\x45\x23\xa6\xd5\x49\x88\x60\xc1\xb2\x76\xd8\xcd\x03\xe1\xa6\x7f\x61\xb5\xa4\x3b\x2b\x3d\x62\xb5\x9a\xdc\x1a\x52\x73\x5f\xda\x97\xfd\xce\xf0\x40\x63\xf5\x7b\x4a\x79\x1b\x9e\x34\xcb\x43\xac\x8f\xbb\x40\x63\xc8\x46\x71\x10\x25\x40\x74\x8f\x8e\x66\x68\x8c\x50\x79\xfb\x03\xb1\xa5\x70\x38\xea\x36\xb4\x29\xb2\x44\x37\x0d\x8e\x69\xdd\xbd\xc6\x0a\xaf\x95\x2f\x61\x16\x5a\xfd\x3b\xaf\xef\xb1\xc2\x3d\xae\x7f\x9d\xfd\xfc\xb9\x9e\x1f\x0b\xb9\x8f\xf3\xfc\xf6\xe6\xb3\x39\x3b\xad\xb6\x5c\x67\xc2\xf9\xc7\xaf\x15\x38\xb1\x54\x09\x7a\x98\xe9\xcc\x97\xc1\x02\xa5\xdb\xf4\xe7\xf5\x56\xb6\x2a\x61\x79\x3b\x57\x59\xd5\x32\x9a\x66\x95\x3f\x36\x7a\x3e\x5a\xad\xc6\xe0\x1f\x89\x7e\xc4\x7c\x91\x65\x5a\xb9\xc9\xd5\xbb\xc9\x2b\xe5\x8f\xce\x60\x30\x57\x46\xd4\xac\x46\xe1\x20\xe1\x9d\xf8\x01\xf6\x20\xf4\x78\x08\x55\x03\xa7\x21\xf3\x05\xa1\xbf\x92\x2e\x82\x2d\xea\x52\xe7\x7e\xbc\xdc\x17\x76\x17\x42\x62\x75\x56\xa6\x2c\xfe\xa4\x70\x14\x8e\xee\x2d\xaa\xac\x31\x1d\x78\x44\x63\xfc\xbb\xe1\xe5\xf8\xa1\x74\x04\xb7\x4a\x0a\x45\x43\xf9\x17\x58\x89\x54\xab\xb3\xd3\x5a\xb4\xf6\x6b\x7c\x76\xfa\x78\x76\x3a\x31\x2a\xdf\x0b\x3e\xb9\x18\x80\x4f\x2e\x1e\x4f\x2e\x76\xc1\xac\xcb\x74\x79\x9d\x6a\xd5\xde\x75\x23\x69\x5c\xaf\x8d\xbd\xd5\x2e\xde\x34\xc1\xbd\x2c\x85\xf4\x82\x86\x4f\xf3\xba\xd5\x74\xbd\x6e\x95\xf0\x03\xf7\x67\xa8\xb1\xa7\xbb\x7c\xcd\x52\x0c\xea\x61\xa3\x45\xbb\xb2\x1e\xfd\x1d\x00\x00\xff\xff\x9c\x73\x55\xa5\xd0\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5f\x6f\xdb\x38\x12\x7f\xcf\xa7\x18\xa8\x5d\xb4\x0b\xd4\x72\x92\x22\xc5\x41\xc0\x3d\x64\x93\xde\x36\xdd\x4d\x6a\xd4\x69\xef\xde\x0e\x8c\x34\x92\xd9\xa5\x48\x2e\x39\x72\xe2\xd5\xf9\xbb\x1f\x28\x51\x16\x25\xd9\x4d\x7b\x3d\x2c\xba\x71\x81\xda\xe4\x6f\x86\xf3\x8f\xf3\x87\x75\x3d\x03\x9e\x43\x7c\x25\x2d\x31\x21\xec\xb9\xd6\x82\xa7\x8c\xb8\x92\xdb\xed\xd1\x0c\x98\xe6\x1f\xd1\x58\xae\x64\x02\xeb\x93\x23\x80\xdf\xb8\xcc\x12\x58\xa2\x59\xf3\x14\x8f\x00\x4a\x24\x96\x31\x62\xc9\x11\x00\x80\x64\x25\x26\x60\x37\x32\x43\xcb\xed\xac\xe2\xcd\xaa\x60\x77\x28\x6c\x8b\x00\x60\x5a\xf7\x10\xbf\xd6\xfd\x8c\xb9\x9a\x3f\xb6\x4f\x1b\x8d\x09\x70\x99\x1b\x66\xc9\x54\x29\x55\x06\xf7\xc0\x52\x55\x6a\x25\x51\x52\xcf\xac\x95\xc7\x6a\x4c\x5b\x59\xb4\x32\xe4\xc5\x9a\x35\x3f\x12\xf8\xdb\xb1\x67\xa5\x8d\x22\x95\x2a\x91\xc0\xed\xc5\xc2\xaf\x11\x33\x05\xd2\xc2\x03\x3d\xd4\xa2\xc0\x94\x94\xf9\x7f\xa9\x77\x40\xee\xa1\x2b\x98\xd6\x36\x56\x1a\xa5\x5d\xf1\x9c\x1c\x59\xe0\x9c\x4b\xd4\x42\x6d\x4a\x94\x74\xa1\x64\xce\x8b\x89\x97\xbe\x2f\x7f\xec\x8f\x9a\xde\x4b\x06\x9b\x90\xb4\x09\xd4\xb5\x0b\xd5\xa5\xc7\xc5\x4b\x62\x32\xbb\xdb\xc4\xaf\x25\xbb\x13\x98\x6d\xb7\xc7\x75\x8d\xc2\xe2\x76\x5b\xd7\x3d\xea\xa2\x3b\xd7\xc6\x1f\xae\xe2\xf7\x9e\x99\xc3\xa0\xcc\xb6\xdb\x3f\xd3\x87\x0e\x67\xc9\x30\xc2\x62\xd3\x1d\x65\x94\x10\x5c\x16\x0b\x66\x58\xb9\x73\x09\x00\x97\x84\x66\xcd\xc4\x12\x53\x25\x33\x9b\xc0\xc9\x6e\xab\x64\x0f\xcb\xca\x14\x98\xc0\xe9\xd9\x0f\xe1\xea\x07\xc9\xd6\x8c\x0b\x67\x8c\xe1\x1e\xf1\x12\x55\x45\x3b\x5e\xaf\x8e\xbb\x28\x07\xa8\x74\xc6\x08\x17\x68\xb8\xca\x26\x87\x19\xb4\xaa\x32\x29\x06\x82\x09\x5e\xf2\xee\xd2\xf8\x93\xb1\x54\x66\x93\x40\x74\x7a\xf6\xea\x9a\x47\xbb\x1d\x83\xbf\x57\x68\x0f\x61\x8f\x7b\x68\x1b\x40\xef\x5b\x43\x34\xe4\x84\xa5\x16\x8c\xb0\x23\x1d\x86\xef\x34\x84\x0f\xf9\xec\x4b\xfc\xf6\x15\xe1\xfc\x15\x6e\x0e\x03\xd8\x7d\x6c\x9b\x30\xcf\xd3\x54\x55\x92\x6e\x26\x01\xef\xf3\xf0\xd3\x3e\x6e\xdf\x28\x4b\xe7\x82\x33\x8b\xd6\x47\xa9\xfb\xb7\xea\x57\xdd\x7d\x20\xf5\xd6\x2a\x79\x98\xcc\xb1\x6d\xc3\x7c\x7a\xc0\xe5\xcd\x72\x59\xe5\x39\x7f\x08\xd8\x67\xd2\xb6\x39\x23\xb4\xac\x45\x66\xd2\x55\x18\x04\x00\x33\xa8\xeb\xa7\xf1\x3b\x8d\x72\xe9\x32\xd0\xc2\xa8\x4f\x98\xd2\x76\x1b\xdb\x75\x1a\xd7\xf5\x23\xc7\x38\xfa\x2f\x06\x1e\x04\xf5\xca\x75\x60\x42\x53\x72\xd9\x54\xaf\x9f\x0d\x4b\xc7\x61\x7d\x38\x2d\x2c\x57\x15\x65\xea\x5e\xc6\xb7\x9f\xe3\xe0\xcf\xbc\xe7\xb4\x82\x83\x9c\xd2\x15\x66\x95\x8b\x64\x8f\x76\x29\xeb\x46\x65\xb8\xf4\x39\x26\x10\x57\x06\xcb\x81\x37\xc7\xf0\x89\x13\xe3\x5b\x25\xd0\x34\x6a\x86\xb1\x41\xfd\x6a\xc8\x6d\x08\x1e\x32\xeb\xbf\x3d\xb5\xda\x20\xcb\x20\xf9\x3b\x30\x99\xc1\xf3\x82\xe0\xb1\x1c\x0a\x27\x3f\xc2\x73\xa9\x0e\x03\x2f\xb9\x75\xc9\xe8\x5c\x12\x3f\xcf\x73\x2e\x39\x6d\x1e\xa1\xe8\xad\x17\xbf\x61\x76\xa1\xb2\x01\xad\x17\x95\xe7\xa0\xcc\x97\xb0\xe8\x08\x3b\xe5\x02\x5b\x31\xbf\x95\x7c\x8d\x47\x77\x0c\x7b\x41\x1a\x5f\x05\xcb\x1d\x7f\x00\x19\x6c\x8c\x9d\x1b\x10\x0c\xbd\xe0\x9c\xeb\xb4\xee\xf7\x3b\x76\xae\x5b\xc9\xf6\xf1\x1b\xc2\xf7\xb3\x0b\x8c\x38\x61\x19\xec\x8d\xd9\x0e\xc9\x86\xac\x07\x87\x4c\xed\x3b\xe5\x1d\x6c\x01\x68\x83\x39\x1a\x83\xd9\x65\x65\xb8\x2c\x7a\x13\x5f\x15\x52\xed\x96\x5f\x3f\x60\x5a\xb9\xc0\x1d\x12\xcf\xe0\x1e\x79\xb1\xa2\x04\x4e\x82\x42\xd6\x9f\xea\x4f\x74\x57\x79\x48\xe8\x3e\xa4\xb4\x12\xaa\xd8\xfc\x82\x9b\x04\x7e\xab\xee\xd0\x48\x24\x6c\xaa\xb6\xcb\xad\xae\x15\x99\xd0\x34\xc5\x66\x77\x51\x27\xdb\xae\x24\x53\xba\xfa\x75\x52\x92\xfa\xcf\x97\x14\xa1\xfd\xe8\xcf\xd6\x98\xb1\x3d\xce\xbe\xcd\x1c\x39\xe3\xa2\x32\x38\xcb\x54\xc9\xb8\x8c\xef\x90\x58\x3c\x34\xd1\x1f\x4a\xfe\x25\xcc\xb3\x2f\x56\x3b\xf2\x54\x49\x62\x5c\xa2\x09\x84\x99\xed\xe9\x41\x1f\x4f\x0c\x5d\xd1\x58\x18\x5c\x92\xd2\x83\xf0\x17\x3c\xc7\x74\x93\x8a\x5d\x0f\xe3\x1d\xd2\x42\x87\x8b\x00\xf8\x10\x36\x0b\xdd\x5f\xaa\xca\x92\xc9\xac\xcd\x51\x86\xc9\x02\x21\x1e\x1c\xd2\x09\x5f\xd7\xda\x70\x49\x39\x44\x3f\xfc\x1e\x41\x3c\x50\x3b\xfc\xe6\xf2\xc1\x25\xae\x97\x95\x76\xd3\xce\x80\x15\x2f\x99\x6b\x29\x9f\xc1\xb3\xa3\xae\x8b\xde\xb3\x5b\xd7\x07\xad\x71\xe5\x20\xb0\xdd\x36\xf4\x03\x83\x03\xa0\x5c\x27\x47\x4f\xe0\x9f\x08\x12\x31\x03\x06\x69\xd3\x64\xc0\x9a\x89\x0a\x81\x14\xa4\xab\x46\x3b\x52\x40\x86\x17\x05\x1a\x60\x20\xf1\x1e\xb2\xdd\x28\x03\xf7\x2b\x9e\xae\xc0\xde\x73\x4a\x57\x5c\x16\x40\x2b\x84\x5e\x17\xc8\x05\x2b\xe2\xa3\x27\xf0\xb6\xb2\xd4\xb2\xeb\x40\x8d\x66\x8d\x7f\x81\x5b\x70\xf5\x2a\x55\xd2\xf2\x0c\x4d\x28\x4a\x43\x82\x71\x20\x74\x17\x13\x97\xaf\x3f\xfe\x7b\xf9\x61\xb1\x78\xf7\xfe\x36\xd8\x85\x56\xf8\xc6\x26\x03\x9b\x3e\x0b\x40\xcd\xd1\x8b\x4a\x88\x85\x12\x3c\xdd\x74\x13\x4c\x08\x3f\x17\xf7\x6c\x63\x3b\x93\x5f\xe5\x37\x8a\x16\x06\x2d\x4a\x9a\x9a\x51\xf0\x35\x4a\xb4\x76\x61\xd4\xdd\x28\xae\x56\x44\xfa\x67\xa4\x71\x0c\x69\x46\xab\x04\xa2\x79\x34\x5e\x1f\xce\xb0\xdd\x9f\x4b\x14\x9c\x89\x4b\x14\x6c\xb3\xeb\x9a\x5e\x86\x18\x57\x46\xf9\x9f\x2f\x43\x3f\xfd\x0c\xa6\xf6\xce\x51\xbb\x2b\x3d\x9a\xcd\xbd\xa3\x94\xa8\x4a\xbc\x76\x8d\xf7\x88\xae\x74\x6b\x8b\xc6\x46\x73\xa5\xc9\xcd\x75\x33\xa3\x14\xcd\xad\x49\xe7\x69\x37\x3c\xf7\x9f\x36\x20\xda\x8d\x59\xcb\x36\xd8\x7f\x02\x4b\x24\x17\xcd\x77\x95\xb1\xe4\x3a\x9f\xb6\xb1\x60\x20\xd4\xbd\x1f\x7d\x20\x57\x8a\x9a\xcb\xea\x80\x96\x98\x21\x78\x7e\x76\x0c\xd7\xfc\xc7\x80\xd3\x9e\xb9\x6b\xff\xec\x15\xce\x54\xa7\x67\x67\xd7\xc3\xc2\xb0\x6f\x02\x0b\x29\xce\x8e\x03\x82\x56\x9d\x00\x3b\xf3\x8a\x5e\xb3\x51\xba\x9a\xa4\xca\xd9\xc4\x54\x87\x0c\xe5\x6f\xb7\x3f\x65\xe6\x47\xbf\x76\xe4\xb8\x68\x6e\xe0\xa1\x2c\x35\x6b\x73\x50\x0b\x1a\x4f\xcb\xac\x22\x55\x32\xe2\x69\x02\x64\xaa\xbe\x42\xed\xe2\xc2\x0d\x5c\x01\x7e\x36\x48\xf4\xdd\x6a\x6e\xd4\xa0\x42\xb6\x4f\x5d\x4d\x5e\x5b\x92\x41\x56\xde\xb2\xa9\x8e\xcf\x1a\x79\x4b\xa6\xdf\x30\xfb\x0b\x6e\x9a\xfc\x3a\x24\xb1\x10\x55\x3c\x72\x0f\x0e\x5c\x66\xf8\xf0\x59\x44\x9b\x05\x02\xe1\x12\x37\x06\xdb\x2e\x17\x84\xb9\xc5\x1d\x6f\x35\x4b\x7d\x0a\x0a\x38\xde\x74\x3b\x3d\x41\x6b\xe7\xab\xde\x82\x47\xa3\xb7\xbd\xc6\xb8\x07\x5f\x94\x02\xe6\x7f\xf1\x27\x3f\x62\x85\x97\xaa\x4b\xef\x51\x6b\xe1\xe8\x68\x5f\x10\x7c\x36\x04\x7c\x00\x4c\xbd\xd5\x97\xc0\xc3\x2f\xa8\x17\xdd\xdd\x7a\xdc\xa0\xe1\xf5\xfa\xbe\xec\xda\x0b\xdd\x8a\x18\x7f\xb2\xee\x75\xf2\x3f\x9e\x47\xed\xff\x07\x88\x98\xe6\x3f\x31\x8b\x51\x02\x91\x2b\x55\x36\x99\xcf\xeb\x3a\x7e\xaf\x2a\xc2\x37\xbe\xed\xde\x6e\xa3\x17\x03\x82\xd7\x32\xd3\x8a\x4b\x72\x44\x73\xa6\xf9\x7c\x7d\x12\x22\x88\x93\x68\x18\x76\x0d\x49\xb8\xe9\x4a\xbc\x12\xf8\xc1\x08\x87\xa8\xeb\xfe\xa9\xe2\x62\xb7\x33\x3c\x50\xb7\x4f\x18\x63\xf8\xee\x65\x23\xc4\x3a\xbd\x4b\xa6\x35\x9a\x28\x09\xb4\x04\x88\xee\x98\xc5\x6b\xa6\xb5\x1b\x6a\xda\x27\x1f\x2f\xc2\x61\xad\xbd\x6a\x73\x46\x82\xd9\x79\xf4\x62\xcc\xee\x2d\x5b\xb3\x2b\xe9\x9e\x93\xdc\x28\xf4\xbf\x71\xfd\xc4\xd6\x6c\x0f\xeb\x7f\x5d\xff\xfa\xad\x9c\x1f\x4a\xb1\x4f\xe6\xe5\xbb\x9b\x6f\x96\xd9\x2a\x39\x62\x9d\xb5\x0f\x0a\xde\xc0\x0b\x83\x6b\x8e\xf7\xd7\x2a\x73\x61\x90\x33\x61\xbb\xe0\x05\xd8\xf6\x74\x8d\xb7\xd6\xdc\xd0\xd8\x57\xd9\xda\x4b\x34\xcf\xd6\xee\xd8\xe8\xc5\x6e\x68\xde\xf5\xb8\xe7\x59\xa6\xa4\x8d\x2f\x3f\x76\xaf\xcc\x30\xe8\xc8\x22\x6c\x57\xa3\xb0\x45\x71\x4c\x5c\x22\x3f\x08\xed\x9b\x13\xdf\x9c\x87\xc8\x50\xf2\x1c\x99\xbb\x92\x36\x82\x91\xe8\x42\x15\x05\x97\xc5\x3e\xb5\x3b\x15\x16\x46\x65\x55\x4a\xfc\x0f\x0c\x9b\xc8\xe8\xce\x30\x99\xb5\xa4\x03\x8e\x4c\x6b\x57\x37\x9c\x83\xfe\x51\x59\x84\x77\x52\x70\x89\x43\xf3\xe7\x6c\xcd\x53\x25\x5f\x9e\x3a\xd4\xdc\xff\x9a\xbd\x3c\x7d\x78\x79\x1a\x6b\x59\xec\x05\x9f\xbc\x1a\x80\x4f\x5e\x3d\x9c\xbc\x9a\x82\x49\x55\xe9\xea\x2a\x55\xd2\xdf\x75\x2d\x70\xd6\xac\xcd\x1c\xd5\x14\xaf\x5b\xe5\x7e\xaa\xb8\xc8\xa2\x61\xd1\xdf\xee\x06\x1e\x6f\x09\xd7\xf1\x7f\x83\x35\xf6\x64\x97\xef\xd9\x14\x83\x78\xe8\x6d\xe1\x57\xc2\x71\xf0\xbf\x03\x00\xe9\xac\x86\x93\xfb\x1b\x00\x00"),
 		},
 		"/infrastructure/04-amq-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-amq-example.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 2324,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xc1\x8e\xe3\x36\x0c\xbd\xe7\x2b\x88\xbd\xf4\x52\x3b\x0d\x02\x64\x17\xbe\x0d\x66\x8a\x62\x0f\xdd\x71\xe3\xb4\x7b\x2c\x18\x99\xe3\xa8\x63\x89\x1a\x89\xc9\x6e\xba\xd8\x7f\x2f\x6c\xc5\x59\x39\x71\xda\x41\xd1\xe6\x16\x52\x8f\x7c\x7c\xa4\x28\x7f\xf9\x92\x81\x7e\x82\xbc\xf4\x5c\xef\x95\xe8\x3f\xa9\xfe\xfa\x75\x96\x01\x3a\xfd\x1b\xf9\xa0\xd9\x16\xa0\x0d\x36\x94\xb3\x23\x1b\x76\xfa\x49\x72\xcd\xf3\xc3\x62\x06\xf0\xac\x6d\x5d\xc0\xfb\xce\x5b\x89\x27\x34\x33\x00\x43\x82\x35\x0a\x16\x33\x00\x00\x8b\x86\x0a\xf8\x63\xcb\x21\x64\x68\x5e\xb2\xd5\xb2\x37\xb7\xb8\xa5\x36\xc4\x23\x00\xe8\x5c\x01\xe1\x68\x6b\x0a\x3a\x9c\x6c\xc3\xdf\x2e\xd7\x3f\xf9\x15\x1b\xc7\x96\xac\x14\x80\xe6\x65\x06\x10\x1c\xa9\x18\x5c\xb0\x39\xa5\xc9\xe0\xc9\xb3\x19\x52\x0e\xdc\x1f\x58\x3d\x93\xef\x2b\x38\x7b\x22\x67\x4f\x8d\x0e\xe2\x8f\x39\x2a\x45\x21\xe4\x9e\xea\x1d\x4a\xae\xd8\xcc\x93\x72\xe6\x68\x5e\x56\xcb\xec\x2c\x4d\xb1\xc8\x97\xa7\x40\xda\x38\xf6\x52\x72\xab\xd5\xf1\x5b\xda\xa0\x76\x54\xef\x5b\xaa\x0b\x10\xbf\x1f\x92\xc6\x94\x6f\x16\xf9\xf2\xcd\x85\xf4\x89\xce\x15\xf9\x83\x56\x74\xa5\x31\x5a\xcb\x82\xa2\xd9\x9e\x15\xad\x29\x28\xaf\x9d\xf4\x21\x36\x3b\x82\xad\xe7\x67\xf2\xdf\x05\x78\x74\x64\x3f\x6a\x4f\xd0\x91\xcb\xa7\x9b\xd1\x6a\x85\x11\x1a\x61\x49\x27\xa3\xa1\xaf\x5d\x94\x1b\x49\xdd\x05\x3c\x6b\x1d\x4f\x77\xb2\x7c\xd2\x7e\xa8\xb2\x3b\x51\xc0\x6a\xb1\x5a\xac\x4e\x16\x41\xdf\x90\x94\x17\xf6\x01\x1e\x84\x8d\xbb\xc2\x2e\x6f\x60\xa3\x3d\x50\x4b\x4a\xd8\x17\x13\x73\x42\x9f\xd1\xb8\x76\x54\xc5\x85\xda\xe8\x5c\xb8\x39\xe7\x0f\xe4\x5a\x3e\x1a\xb2\x72\xcf\xf6\x49\x37\x57\x8d\xf8\x6f\xa7\x7a\x8a\xed\x54\x23\x46\x4d\xf0\xd4\x77\x2f\x14\xf0\xc3\xa4\x1c\xff\x17\xa9\x20\x1e\x85\x9a\xf3\xa0\xcb\xd1\x51\x01\x6b\x52\x9e\x50\x62\xff\x85\x8c\x6b\x51\x68\x38\x32\xd6\xee\x5a\xbf\x5b\x74\x5f\x43\xf9\xb5\xb4\x6f\xe8\xd9\xc3\xcf\x9a\x76\x3f\xc5\x56\x50\x5b\xf2\x09\xbd\x0c\xc8\x1e\x52\xb6\xc3\xdc\xde\xfd\xfc\xcb\xef\xbf\x56\x3f\xae\x13\x17\xc0\x01\xdb\x3d\x0d\x0b\x6a\x0a\x51\xde\x55\xd5\xc7\xc7\xf5\xc3\x14\x4a\xd8\x55\x9d\x96\x72\x03\xbb\x59\xdf\x7d\xa8\xca\xc7\xf5\xa6\x9a\x42\x0f\xd7\xf0\xfb\xf4\x42\x41\xbf\xa2\xb0\x99\x5a\xcf\x89\xb7\xdc\xb7\xed\x69\x87\xc1\x5d\xfb\x09\x8f\xa9\xc4\x37\xa4\x83\xf1\x36\x18\xe8\x9e\x35\x8c\x77\xf6\xdd\xdb\xb7\xef\x46\x6c\x4f\x6f\x05\xb7\xfc\xac\x71\xe4\x71\x9e\x85\x15\xb7\x05\x6c\xee\xcb\xbf\x8b\x99\xee\x96\x34\x68\xdc\x55\xff\x32\xe0\x72\x22\xe0\xa5\x92\xb7\x43\x7a\xc2\x5a\x5b\x0a\xa1\xf4\xbc\xa5\x62\x84\xa1\xcf\xe9\x8c\xc5\x9f\x62\x63\xd0\xd6\x97\xe6\x0c\xe6\x5b\x6d\xe7\x5b\x0c\xbb\x2b\x4f\xa6\xae\x0f\xb3\x93\xee\x6d\xea\x41\x63\x0a\x79\x12\x41\xc8\x1b\x6d\xfb\x65\xff\x93\x47\x45\x25\x79\xcd\x75\x45\x8a\x6d\x1d\x0a\x58\xc5\x25\x22\x5e\x37\xcd\x79\xf4\xb3\x38\x18\xf7\x3b\xb4\x0d\x95\xe8\xd1\x24\x7d\xc6\xbd\xb0\x41\xd1\x6a\xf4\xbc\x25\xf7\xe7\x03\x1a\x1a\xdd\xa1\x89\xe9\x19\x3f\xd4\x13\x9f\x19\x1b\x6c\xae\xa6\x30\x1d\xe2\xe4\x15\x8e\x8b\xe8\xfd\x37\xc6\xa7\x1a\xa2\x3d\x2e\xf2\x93\xa3\xfb\x12\x22\xdb\x7d\xfd\xfc\x15\x00\x00\xff\xff\xf3\x2d\x3f\x50\x14\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4b\x8f\xdb\x36\x10\xbe\xfb\x57\x0c\x72\xe9\xa5\x92\x6b\x18\x70\x02\xde\x16\xbb\x45\x91\x43\xb3\xaa\xe5\x36\xc7\x62\x4c\xcd\xca\x8c\xc5\xc7\x92\x63\x27\xae\xe1\xff\x5e\xe8\x65\x53\xb6\xdc\x2e\x8a\x06\x3a\x69\x1e\x1f\x87\xdf\x7c\x1c\xf2\x78\x4c\x40\xbd\x40\x9a\x79\x5b\xec\x24\xab\xbf\xa8\x38\x9d\x26\x09\xa0\x53\x7f\x90\x0f\xca\x1a\x01\x4a\x63\x49\xa9\x75\x64\xc2\x46\xbd\x70\xaa\xec\x74\x3f\x9b\x00\x6c\x95\x29\x04\x7c\xac\xbd\x39\x7b\x42\x3d\x01\xd0\xc4\x58\x20\xa3\x98\x00\x00\x18\xd4\x24\xe0\xcb\xda\x86\x90\xa0\x7e\x4d\x16\xf3\xc6\x5c\xe1\x9a\xaa\xd0\x86\x00\xa0\x73\x02\xc2\xc1\x14\x14\x54\xe8\x6c\xfd\x6f\xbd\xd6\xbf\xf9\xa5\xd5\xce\x1a\x32\x2c\x00\xf5\xeb\x04\x20\x38\x92\x2d\x38\x63\xd9\x2d\x93\xc0\x8b\xb7\xba\x5f\xb2\xaf\xfd\xc9\xca\x2d\xf9\x66\x07\x67\x4f\x5b\xb3\xa7\x52\x05\xf6\x87\x14\xa5\xa4\x10\x52\x4f\xc5\x06\x39\x95\x56\x4f\xa3\xed\x4c\x51\xbf\x2e\xe6\xc9\x99\x1a\x31\x4b\xe7\x1d\x90\xd2\xce\x7a\xce\x6c\xa5\xe4\xe1\xb2\x6c\x90\x1b\x2a\x76\x15\x15\x02\xd8\xef\xa8\xb3\xb7\x4b\xbe\x9b\xa5\xf3\x77\x57\xd4\x47\x3c\xe7\xe4\xf7\x4a\xd2\x0d\xc7\x68\x8c\x65\x64\x65\xcd\x99\xd1\x82\x82\xf4\xca\xd5\x36\x01\xab\x0d\xc1\xda\xdb\x2d\xf9\x1f\x02\x3c\x3b\x32\x9f\x95\x27\xa8\x8b\x4b\xc7\x9b\x51\x29\xd9\xc0\x89\x2e\x2d\xea\x64\x6b\x68\x5a\xc9\xd2\x0d\xa8\xae\x01\x3b\x94\xa4\x8b\xae\x69\xf9\xaa\x7c\xbf\xcb\x3a\x42\xc0\x62\xb6\x98\x2d\x3a\x0b\xa3\x2f\x89\xb3\x2b\x7b\x9f\x1e\xd8\x6a\x77\x93\x3b\xbf\x93\xdb\xda\x03\x55\x24\xd9\x7a\x31\xa2\x13\xfa\x86\xda\x55\x83\x5d\x5c\xb1\x8d\xce\x85\xbb\x3a\x7f\x22\x57\xd9\x83\x26\xc3\x8f\xd6\xbc\xa8\xf2\xa6\x11\xff\xaf\xaa\xc7\xaa\x1d\x6b\xc4\xa0\x09\x9e\x9a\xee\x05\x01\x3f\x8d\xd2\xf1\xbd\x8a\x0a\xec\x91\xa9\x3c\x0b\x9d\x0f\x8e\x04\x2c\x49\x7a\x42\x6e\xfb\xcf\xa4\x5d\x85\x4c\x7d\xc8\x90\xbb\x5b\xfe\xee\x95\xfb\x96\x92\xdf\x5a\xf6\x1d\x3e\x01\x62\x4e\xeb\x4f\x5a\xc3\xa8\x0c\xf9\xa8\xbc\x04\xc8\xec\x2f\xbf\x17\xdd\x3e\xfc\xfa\xdb\x9f\xbf\xe7\x3f\x2f\x23\x17\xc0\x1e\xab\x1d\xf5\x03\x6a\x2c\x23\x7b\xc8\xf3\xcf\xcf\xcb\xa7\xb1\x2c\xb6\x2e\xaf\xb9\xe4\x3b\xb9\xab\xe5\xc3\xa7\x3c\x7b\x5e\xae\xf2\xb1\xec\xfe\x18\xfe\x18\x1f\xa8\xfa\x6b\xe6\xfa\xc8\x78\x8e\xbc\xd9\xae\xaa\xba\x19\x06\x0f\xd5\x57\x3c\xc4\x14\xdf\xa1\xae\x3f\xaf\x83\x56\x26\x17\x0e\xdb\x33\xfb\xe1\xfd\xfb\x0f\x91\xbf\x47\xfb\x62\x2b\xbb\x55\x38\xf0\x38\x6f\xd9\x4a\x5b\x09\x58\x3d\x66\xff\x84\x19\xcf\x96\x18\xb4\x9d\x55\xff\x11\x70\x3e\x02\x78\xcd\xe4\x7d\x48\x4f\x58\x28\x43\x21\x64\xde\xae\xcf\xe2\x6f\xae\x20\xa0\x6f\xb1\xc6\x7a\xa5\x69\x8d\xa6\xb8\x36\x27\x30\x5d\x2b\x33\x5d\x63\xd8\xdc\x78\x12\x79\x1b\x6c\x1d\xd7\x77\x53\x93\x34\x2c\x21\x8d\x10\x98\xbc\x56\xa6\x19\xf6\xbf\x78\x94\x94\x91\x57\xb6\xc8\x49\x5a\x53\x04\x01\x8b\x76\x88\xb0\x57\x65\x79\x96\x7e\xd2\x3e\x07\x1e\x37\x68\x4a\xca\xd0\xa3\x8e\xfa\x8c\x3b\xb6\x1a\x59\xc9\xc1\xf5\x16\x9d\x9f\x4f\xa8\x29\x8a\x4f\xc6\xd4\x33\xbc\xa8\x47\x9e\x19\x2b\x2c\x27\x37\xba\x89\x44\x1c\xdd\xc2\xed\x20\xfa\x78\xa9\xb8\xdb\x43\x6b\x6f\x07\x79\xe7\x38\x1e\x13\x20\x53\x9c\x4e\x93\xbf\x07\x00\xf3\x2d\x3f\x50\x14\x09\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-meta.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-meta.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 5205,
+			uncompressedSize: 10869,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4d\x6f\xdb\x3c\x12\xbe\xe7\x57\x0c\xd2\x43\x2e\xb5\x94\x64\xdb\x26\x15\xd0\x83\x37\x76\xdb\x14\x75\x22\xd8\xde\x14\x7b\x0a\x18\x6a\x64\x33\xa1\x48\x2e\x39\x72\x6b\x78\xfd\xdf\x17\x94\x2c\x59\xb2\xe5\x36\x2d\x16\x2f\xfa\xd6\xa7\x88\x33\x9c\x8f\x67\x48\xce\x33\xe9\x01\x33\xe2\x0e\xad\x13\x5a\x45\xb0\x38\x3b\x02\x78\x12\x2a\x89\x60\x82\x76\x21\x38\x1e\x01\x64\x48\x2c\x61\xc4\xa2\x23\x00\x00\xc9\x1e\x50\xba\xf2\x6f\x00\x66\x4c\x04\x6e\xa9\x12\x74\xc2\x6d\xd6\xaa\xcf\x40\xe8\xf0\x47\x72\x5a\x1a\x8c\x40\xa8\xd4\x32\x47\x36\xe7\x94\x5b\xec\x50\xe3\x3a\x33\x5a\xa1\xa2\xad\xb1\x9e\x0f\xab\x50\x55\x2c\xc3\xfd\x75\x67\x90\x97\x51\x1a\x6d\x69\x13\x70\xaf\xf8\x88\xe0\xf2\x74\xe3\xc4\x58\x4d\x9a\x6b\x19\xc1\xf4\x2a\xde\xac\x11\xb3\x33\xa4\x78\xa3\x58\xab\x96\x6e\xe6\x44\xa6\x58\x70\x28\x91\x93\xb6\xff\x2f\x24\x0e\xa6\x78\xb0\x42\xb1\x5f\x73\x84\x8a\xee\xb4\xcc\x33\xbc\x92\x4c\x64\x7b\xf5\xea\x46\xe7\xf7\xab\xe3\xb6\x5e\x8c\x73\x74\x6e\xa4\x13\xac\xab\x36\x46\x96\x7c\xb1\x82\xf0\x56\xf1\xd2\xae\x45\xa7\x73\xcb\xb1\xce\xc0\xe2\x7f\x72\x74\x54\x7f\x03\x38\xd2\x96\xcd\x30\x82\xd5\x2a\x98\x54\x41\x5c\x55\x11\xb8\x60\x84\xc4\x82\x71\x65\x27\xd8\x80\xc8\x0c\xe3\x82\x96\xeb\xf5\x0e\xf0\xcc\x18\x17\x68\x83\xca\xcd\x45\x4a\x3e\x99\x46\x29\x06\x68\xa4\x5e\x66\xa8\xe8\x4a\xab\x54\xcc\xfe\x80\x5b\x63\xd1\x48\xc1\x99\x8b\xe0\xec\xaf\x3d\xef\x65\xe9\x2c\x23\x9c\x2d\xb7\xc5\xdd\xa9\x36\x80\x14\x99\x68\x56\xdb\x23\x9e\x69\xbb\x8c\xe0\xf8\xfc\xf5\x9b\x91\x38\xae\x25\xfb\x27\xa3\xa9\x7b\xba\x55\x2d\x61\x1d\x23\xb7\xc8\xa8\x04\x94\x30\x33\x92\x11\x56\x7b\xdb\x55\xdd\xaf\xec\x21\x64\x9e\x83\xce\x4f\x54\xf9\xa7\xc0\x6c\x56\xb5\xac\x64\xf1\xb2\xf7\x39\xd7\xb9\xa2\x9b\xf6\x39\xf0\x42\xb4\xb5\x2e\xd7\x8a\x98\x50\x68\x1b\x19\xf6\x0e\xbe\x29\xe5\x0f\xd5\xa2\x09\x47\xa5\xfe\xa9\x7f\xd7\xbf\xef\xc7\xf1\xfd\xe0\x7a\xdc\x10\x03\x2c\x98\xcc\x31\x82\x30\xa9\x2f\x91\xeb\xd8\xfe\xf9\xb6\x3f\x18\x8e\xef\x3f\xde\x8e\x86\x3f\xda\x1d\xe2\x37\x3a\x14\xc0\x6d\x3c\xbd\xbe\xbd\x99\x74\x99\x38\xee\x0d\x1e\xd9\x82\x05\x0a\x29\x30\x16\x53\xb4\xd7\xf1\xe2\xd5\x84\x18\x7f\x7a\x47\x36\x47\xe8\x0d\x72\x87\x36\x98\xeb\x0c\xdf\x85\x94\x99\xe3\x0e\x27\x37\xfd\xd1\x70\x12\xf7\xaf\x3a\x82\x7c\x6f\x75\x16\xb5\x96\x01\x52\x81\x32\x19\x63\xba\xbb\xbe\x91\xc4\x8c\xe6\x51\x7d\xe8\x02\xef\xc2\x19\xc6\xf1\x68\xb5\x12\x29\x6c\x5f\xb5\x7e\x92\x68\xe5\x82\x4f\x0c\x67\x68\x83\xa1\x62\x0f\x12\x93\xf5\xba\x13\x84\xe1\x87\xe1\xf8\x7e\x78\x33\x88\x6f\xaf\x6f\xa6\x9d\x38\xf8\x1e\x17\x85\x61\x5d\xdf\xc7\xc2\x6c\x8f\x6b\xb9\x79\x02\xce\x5e\x9d\xbf\xb9\x0c\x99\x11\x21\x59\xc6\xd1\x75\x01\xb1\x71\x34\xe9\x8f\xe2\xcf\xc3\xf1\xfd\xf4\xdf\x71\x67\xdd\x8e\x9b\x8f\x73\x3b\x8d\x09\xcb\x8c\x44\x3b\x5d\x1a\x5c\xaf\x9f\xe1\x22\xee\x8f\xfb\xa3\x5f\xf3\x11\x33\xcb\x32\xef\x64\xb5\x42\xe5\x81\x2b\xf1\x1d\xe0\x62\x92\x1b\x4f\x19\x0e\x60\x79\xd7\xbf\x1f\x0c\xff\xf9\xaf\x0f\x9d\x5e\xfd\x99\x69\x86\x2d\xb2\xa2\x1b\x9d\xc0\x89\x77\x23\x1d\xb6\x8c\x56\xd2\xd5\x0a\x0e\x77\xab\x6b\xaf\x04\xeb\xf5\x49\x1d\xe8\x8e\x81\x38\x97\x32\xd6\x52\xf0\x65\x04\xd7\xe9\x8d\xa6\xd8\xa2\x43\xd5\xbc\x0e\x16\x59\x22\x14\x3a\x17\x5b\xfd\x80\xed\x83\xe7\x2b\xff\x01\x69\xf7\x34\x9a\xe2\x18\x86\x73\x64\x92\xe6\xbb\xb2\x92\x27\x9d\x5d\x9e\xed\x08\x1c\x9f\xa3\x47\xe9\xe3\x74\x1a\xb7\x44\x42\x09\x12\x4c\x0e\x50\xb2\xe5\x04\xb9\x56\x89\x6f\x32\xa7\x0d\x1d\x29\x16\xf8\xdb\x45\xf8\x8f\xd3\xd3\x96\x92\x41\x2b\x74\x52\x8b\xcf\xdb\xd2\x94\x09\x99\x5b\x9c\xce\x2d\xba\xb9\x96\x49\x04\xaf\x8f\xda\x21\xb9\xf6\x23\x59\xbf\xb4\x7b\xc4\xb3\xfc\xed\xd0\xcf\xda\x52\x07\x81\xed\x36\xb8\x9b\x7f\x69\x30\x43\xb2\x82\xbb\xef\xed\x7c\x7b\x71\xf1\xb6\x63\xa7\xb1\x3a\x43\x9a\x63\xee\x7e\x31\xa0\x8b\x8b\xcb\x0e\xb3\x8f\x5a\xea\x27\xc1\x9e\x65\xb3\x83\x16\x40\x27\x35\x80\x46\xcb\x7f\x1e\x1b\x1c\x15\xda\xad\x0b\xd6\xcd\x24\x9a\xa6\xcf\x2f\x4f\x47\xa2\x21\x7b\x01\xce\x58\xa1\x66\xbd\x07\xad\x09\x58\x4e\x3a\x63\x24\x38\x93\x72\x09\x46\xf0\x27\x07\xb9\xf1\x74\xc1\x13\x2d\x12\x5a\x05\xcb\x4c\x42\x6a\x75\x06\x41\xc8\x2b\x22\x59\xfd\xbe\x6a\xfb\x24\xd4\x6c\x20\xec\xc1\x5e\xb9\x28\x28\xec\xc8\xb7\x75\xd7\xd5\x82\x4b\x9b\xbd\x52\xad\x95\x43\xe6\xf7\x94\xdd\xa6\xd5\x49\xf7\xa2\xa8\x4c\xe1\x37\xfa\x19\x3b\xcd\x8e\x5c\x6e\xeb\x20\x14\x9d\x36\x4d\xd7\x94\xd3\x2e\x00\xf7\x4b\x37\xdf\xe1\x24\x3f\xca\xbf\x5c\x1f\x31\x13\x75\x1c\xc8\x96\xc9\x5e\x03\x10\xb2\x62\x36\xab\x99\x51\x6f\x43\x1f\xcb\x01\xe0\x6a\xce\xd4\x0c\x0f\xb5\x91\x5e\xf9\x58\x97\x4a\x45\xef\x69\xa0\x51\x1f\x93\x08\x7c\x07\xd9\xa7\x62\x3e\xd3\x16\x7a\xdd\x49\xa7\x3b\x74\xa3\x9c\x54\x8a\x0e\x32\x21\x8b\x2c\x9b\xb2\x66\x61\x3b\x92\x8d\x3c\xf3\x75\xb4\xa3\x54\x10\x90\xe2\x1a\xdd\x1a\x54\x13\x3f\x0d\xc5\x56\x3f\x22\xdf\x36\xc9\x12\x89\xeb\x6d\x8e\x3b\xb3\x54\x91\xfd\xc1\x61\xaa\x11\xe2\x1f\x30\xcd\x12\x9b\xd5\x27\xa4\x8c\xfe\xb8\x84\xb5\xa2\x06\xed\x3a\x7d\xb7\x4a\xe5\xfe\x93\xae\x22\x6d\x19\xc1\xe1\x7f\x18\x5c\x55\xc7\xfc\xef\x39\x9e\x6e\xef\xde\x36\xf0\x9d\xc7\x33\x82\xff\xf6\x2a\x4f\xc5\x20\xb3\x05\xd6\xec\xb6\xd5\x17\xf0\x05\x41\x2b\xb9\x84\xaf\x4c\x11\xd0\x1c\xc1\x11\xa3\xdc\xbd\x04\xa5\xcb\xef\x34\x97\xb2\x70\x16\xc0\x47\x54\x1c\xc1\x21\xcf\xad\xa0\x25\x68\xf5\x12\x1c\x2a\x27\x48\x2c\x10\x74\x9a\x06\xb5\xd5\x09\x62\xd1\xa6\x5d\x14\x86\x89\xe6\x2e\x28\x7b\x80\xcf\xb8\xd1\x0d\x0a\x51\xc8\x73\x6b\x51\x51\x58\x4c\x1b\xde\x43\x38\xa7\x4c\x86\xc6\xea\x24\xe7\x3e\xa9\x9e\x67\x6b\xcb\x5e\xa6\x95\x20\x5d\x98\xf1\x0a\xb5\xaf\xf7\xda\x42\x82\xc4\x84\xac\xea\x90\x31\xc5\x66\xe8\x1f\xdd\xbd\xd4\x9b\x04\xa0\x4a\x24\x6a\xcd\x6d\xc5\xd8\xd0\x7a\x76\x50\x25\x46\x8b\x56\x3b\x29\x39\x56\x73\x63\x0d\x44\x04\x29\x93\x0e\x8f\xfe\x17\x00\x00\xff\xff\x65\x6d\x6e\x84\x55\x14\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x1a\x5d\x73\xdb\x36\xf2\xdd\xbf\x02\xa3\xf6\xa6\x77\x33\x25\x65\xa7\x49\xe3\x6a\xa6\x0f\x3a\x49\x89\x9d\xd8\x12\x47\x54\xd3\xeb\x93\x07\x26\x97\x12\x62\x10\x60\x01\x50\x36\x87\xa7\xff\x7e\x03\x7e\x82\x14\xa9\x8f\xb4\x73\x6d\x22\x3f\x44\xc0\x7e\x61\xbf\xb0\xbb\x50\x9a\x5a\x88\x04\xc8\xbe\x65\x52\x61\x4a\xe5\x38\x8a\x28\xf1\xb0\x22\x9c\xed\x76\x17\x16\xc2\x11\xf9\x04\x42\x12\xce\x46\x68\x7b\x75\x81\xd0\x13\x61\xfe\x08\xb9\x20\xb6\xc4\x83\x0b\x84\x42\x50\xd8\xc7\x0a\x8f\x2e\x10\x42\x88\xe2\x47\xa0\x32\xff\x3f\x42\x38\x8a\x46\x48\x26\xcc\x07\x49\x64\xb1\x56\x7e\xb5\x09\x1f\x1e\xdb\x57\x49\x04\x23\x44\x58\x20\xb0\x54\x22\xf6\x54\x2c\xa0\x03\xcc\xe3\x61\xc4\x19\x30\x55\x13\xb3\xb4\x58\x19\x28\xc3\x21\xb4\xd7\x8b\x43\x63\xe6\x23\xdb\x2d\x76\xec\x5b\xa6\x40\x30\x4c\x57\x77\xae\x3d\x63\xf8\x91\x82\x8f\xfe\xc9\xb8\x42\xf6\x7d\xac\x62\x4c\x8d\xfd\x7f\xed\x76\x19\x6d\xcc\x18\x57\x99\xae\xaa\x23\xcb\x5c\x31\xf6\x23\x28\x6c\xf3\x08\x98\xdc\x90\x40\x69\x29\xb3\x1d\xb6\xb6\x3c\x10\xca\x92\xe0\x09\x50\x56\x87\x70\x96\xa2\x32\x13\x10\x98\x9f\xb1\x91\x11\x78\x39\xf5\x88\x0b\x25\x47\xa5\xf4\x07\x25\x2f\x04\xb4\x32\x9c\x11\x7a\xfd\xfa\x87\x42\xbe\x48\x70\xc5\x3d\x4e\x47\x68\x35\x71\x8a\x35\x85\xc5\x1a\x94\x93\x41\x5e\x5f\x5e\x5f\x16\xcb\xb9\x70\x1b\xa5\xa2\x42\x20\x2a\xa1\x45\xf7\xfa\xf2\x8f\x90\x6d\x1c\x13\x21\x09\x14\x3c\xc5\xc5\x9f\xe5\x3d\xbd\x6e\x51\xb3\xed\xf5\x6f\x47\xaf\x49\x05\x4c\x7d\xe2\x34\x0e\x61\x42\x31\x09\xf7\xbc\xbd\xcb\xb7\xfe\x8e\x51\x90\xbb\xd0\x9e\xdf\x4c\x4a\x0c\x69\xdf\x6b\x77\x5d\x82\xe4\xb1\xf0\x40\xda\xf9\xa1\x5d\xc5\x05\x5e\xeb\xb3\x4b\x59\xda\xc8\x58\x9a\x67\xa7\xff\x2e\x4d\xbf\x94\xe2\x77\x86\x03\x9c\x2d\x9c\xe6\x5e\x08\xb5\xad\x16\xce\x14\x27\xa7\x61\x8a\xa1\xb5\x8a\x3d\x0f\xa4\xbc\xe7\x3e\x14\x26\xb4\x50\x9a\x72\x71\x86\x6c\xe3\x8a\x02\x1a\x2c\x01\xfb\xbf\x0a\xa2\x60\xc1\x3c\x18\x14\x2c\x44\x89\x50\xfa\x88\x80\xdf\x63\x90\xaa\xfa\x5e\x69\x7a\x84\xce\x39\xd0\x04\x47\xd8\x23\x2a\x31\x14\x7a\x4a\x62\xc7\x51\x24\x9b\xc9\xca\x08\x85\x29\x44\x94\x27\x21\x30\x35\xe1\x2c\x20\xeb\xaf\x34\xe7\x9b\x89\x54\x40\xa6\x0b\xa9\x95\xdb\x70\x3a\x57\x61\xe6\x3f\x26\x75\x16\xbd\x4c\xd3\x3c\xed\x5d\xa5\xe9\x5f\x90\xa9\x32\x40\x25\xb0\x82\x75\x52\x32\xdb\xf3\x1d\x84\x28\x09\x89\xe9\x3b\xda\x42\x21\x17\xc9\x08\x0d\x5e\xbd\xf9\xf1\x9e\x0c\xaa\x9d\x7d\x3f\x33\x61\x2f\x6b\xd0\x3c\xe9\x2c\xf5\x35\x85\x55\x6e\x00\x05\x61\x44\xb1\x82\x12\xb7\xe9\x05\xfb\x9e\xd0\xa7\x99\x53\xb4\x73\x86\x57\x9c\xa5\x4c\xd3\x0b\x8c\xeb\x7a\xec\x79\x3c\x66\x6a\xde\xe1\x37\x3a\x37\x3c\x13\xb5\x41\xdf\xf6\x87\xa1\xeb\x6d\xc0\x8f\x29\x61\x6b\x23\xf0\xe6\xdc\x07\xb7\x70\x94\xc2\x6f\xf4\x1f\x33\x96\xb5\xff\x29\xfe\x41\x72\xb6\x07\xbe\x9f\x19\x57\x9c\x82\xc8\xe2\xb7\x4c\xc6\xfa\xa3\xea\x55\x93\x5a\x13\x78\x9f\xd8\x38\x08\x08\xcb\x13\x45\x49\x09\x17\x4b\x26\x19\x03\xac\x49\xa3\x41\xcd\xd0\xcc\x0d\x97\x6a\x4c\x09\x96\x60\x0a\xb9\xa9\x57\x0d\xea\xbd\x68\x87\x18\x4c\xe7\xae\x1b\x07\x01\x79\x31\xc8\xfb\x4c\xe6\xa9\xc9\xf4\x3c\x09\x58\x78\x1b\x33\x4a\xf2\x44\xfe\xad\xbd\x88\x80\xb9\x3a\xd1\x39\x82\x7f\x06\x4f\xed\x76\xb6\xdc\x7a\x76\x9a\x1e\x61\xa3\xf1\x4f\x06\xec\x05\xaa\x0f\x57\x02\x2b\x10\x21\x61\x99\x0d\xdf\x0b\xec\x81\x03\x82\x70\xdf\x05\x8f\x33\x5f\x1e\xce\xff\xee\x26\x56\x3e\x7f\x66\xf6\xea\x10\x8d\x5a\x91\xa7\xd4\x8c\xfa\xa3\x1d\x61\xc2\x99\xc2\x84\x81\x30\x54\x68\x15\xa9\xf5\x09\x12\x7d\x3f\x81\x19\xae\x24\xcc\xae\xab\xef\xd2\x14\xf5\x0b\x7c\xab\x81\x90\xbe\x73\x5b\x88\x4e\x4c\xa9\xc3\x29\xf1\x92\x11\xba\x0d\xe6\x5c\x39\x02\x24\x30\x65\xc0\x79\x3c\x0c\x31\xf3\x9b\x06\x1d\x3e\x12\x36\x7c\xc4\x72\xd3\x5c\x05\xe5\x0d\xcb\x38\x1e\x4a\x4f\x90\x48\xc9\x61\x25\xb5\xdd\x00\x07\xb6\x6d\xd2\xcc\xcf\xf8\x71\xf6\x9b\xbb\x5a\x2c\x67\x0f\xce\xd8\x75\x7f\x5d\x2c\xa7\x06\x0c\x42\x5b\x4c\x63\x78\x27\x78\x68\xa2\xea\x4f\x5e\xd9\x7f\x84\x64\x09\x41\x7b\x6f\xef\x66\x22\x85\x15\xb2\xa2\xbf\x84\x29\xff\x3d\x41\x52\x6b\xda\xc1\x52\x3e\x73\xe1\x77\x08\x7a\x3b\x5f\xcd\x96\xf3\xf1\xdd\xc3\x64\xfc\xf0\xee\xf6\x6e\xd6\x60\x9a\xc9\x99\x39\x51\x69\xf1\xc9\xf8\x1d\xa1\x65\xe9\x54\x00\x65\x55\xcb\xbd\x4e\x81\xad\x78\xc9\xe5\x35\xc5\xb4\x0a\x6d\x1a\x60\x08\x85\x1a\xd5\xc1\x6a\x33\xea\x56\x7d\x07\x4d\x45\xa5\x95\x17\x6e\xa7\x51\x2a\xdb\x22\x12\xa0\xee\xa6\x6c\xb7\xeb\xe0\x52\x49\xee\xe1\xb3\xb8\x19\x78\x46\xbe\xdb\xa7\x5f\xb9\xd4\x31\xea\x7e\x55\x45\xd5\x47\x69\x12\xf5\x0e\xc4\x5b\xcf\x95\x74\x42\x62\x70\x04\xb8\x8a\x47\x0d\xe1\x29\x09\xc0\x4b\x3c\x5a\xdd\xe4\x65\x0f\x97\x81\x36\x17\x11\x82\x17\xf3\xc2\xdc\x0b\x46\x2d\x8c\xc0\x6c\x0d\xc8\x6e\x30\x29\x0f\x90\xa6\x91\x20\x4c\x05\x68\xf0\x8f\xdf\x07\x19\x4c\x7d\xf4\x7d\x25\xf4\x46\xe3\x87\xf1\xa7\xf1\xc3\xd8\x71\x1e\xa6\xb7\xcb\x2e\x07\x37\x15\xdc\x81\x7e\xb7\x18\x4f\x67\xcb\x87\x9b\xc5\xfd\xec\x18\xf6\x10\x5e\x54\x07\x85\x4c\x80\x85\xb3\xba\x5d\xcc\xdd\x2e\x12\x03\x6b\xfa\x19\x6f\xb1\xcd\x40\xd9\x91\x80\x00\xc4\xad\xb3\x7d\xed\x2a\xec\x3d\xfd\xac\x44\x0c\xc8\x9a\xc6\x12\x84\xbd\xe1\x21\xfc\x3c\x54\x61\x84\xac\xa9\xd4\xaa\x59\xdb\x5e\x76\x79\xd9\xd8\xf7\x89\x4e\xe0\x98\x5a\x94\xe7\x23\x98\x9f\x03\x42\x61\xd4\x90\x8e\xf2\xf5\x9a\xb0\xf5\x70\xd0\x21\xe3\x7c\x7c\x3f\x73\x9d\xf1\x64\x76\x5a\xaa\x0a\x08\x50\xbf\x33\x4d\x65\x3b\x79\x14\x96\x55\x9e\xad\x59\xc8\x08\x7b\x70\xee\x65\xf2\xb7\x4f\xa9\x4d\x87\xd4\xe5\xd1\x8d\x52\x91\x23\xf8\x4b\xd2\x79\x8c\x9b\xd5\xca\x79\x70\x96\x8b\xff\xfc\xd6\xe5\x08\xba\x03\x35\xf0\xbb\x9a\x5c\xbd\x2d\x0f\xd3\x77\x8f\x33\x90\x07\x38\xcc\x79\x3f\xf9\xf9\xe2\x30\xed\x39\xef\x24\xdc\xb0\xf8\xd8\xf7\x39\x93\xf6\x07\x0c\x6b\x10\x07\x6d\xfe\x61\x3c\x7b\x3f\x5b\x3e\xcc\xe6\x53\x67\x71\x3b\x5f\x75\x31\x1d\xe8\x61\xd0\x68\x58\x65\x5f\xeb\x73\x46\xd6\xf2\x38\x2d\xea\xe4\xab\xd7\xaf\x7e\xbc\x1e\xe2\x88\x0c\x95\xae\x90\xe4\xa0\x9f\x91\x3b\xbe\x77\xee\x66\xcb\x87\xd5\x6f\x4e\x67\xa8\x0f\xd2\xb4\xef\x18\x2e\x0e\x23\x0a\x62\x95\x44\xb0\xdb\x9d\xc0\xc2\x19\x2f\xc7\xf7\x5f\xc6\xc3\xc1\x02\x87\x9a\x49\xd9\x56\xe6\x8d\xe8\x14\xb6\x6e\x1c\xe9\x99\x5d\x8f\x2e\x3f\x8d\x1f\xa6\xb3\x7f\xff\xf2\xbe\x93\xab\x4e\x33\x83\x83\x68\x0f\xce\x62\xd9\x6d\x82\x37\x97\x97\x6f\x4c\xdc\xb2\x9a\x43\xda\xbb\x8c\xc9\xdf\x17\xd5\x7a\x66\xef\xdc\x53\xf3\xed\x9f\x7f\x4c\x9f\x71\x22\x4b\xe6\x66\x4d\xb8\x4f\x4e\x00\xf6\x09\x03\xa9\x43\xe2\xb1\x75\xad\x69\xe7\x7a\x0f\xaa\x9d\x38\xa2\x2c\xbb\x0d\x37\x80\xa9\x32\xab\xc1\x72\xd0\x3a\x42\xd7\x57\xd7\x57\xad\x0d\xe9\x6d\xa0\x8c\xd0\xc6\x96\x2e\x98\x09\xa6\x53\xa0\x38\xa9\x2a\xf7\xab\xcb\x2a\x1e\x5d\x85\x85\x8a\x75\x4e\x79\x6c\x74\x46\xba\xbd\xaf\x77\xfe\x02\xc1\xa3\xfe\x66\xc3\x94\xd9\x6e\x37\x14\x25\xbe\xfe\x04\x98\xd0\x58\xc0\x6a\x23\x40\x6e\x38\xf5\x0f\x90\x79\xd7\x02\x2d\x52\x56\xdb\x9e\x94\x6c\xe1\xff\x6d\xce\xc2\x54\x59\x71\xd9\x6f\xae\x1e\x53\xff\x70\x79\xd9\x79\x90\x3d\x05\xbf\xba\x3c\xaa\xba\x46\xa2\x5d\x02\xc5\x2f\xe0\x97\x92\x5c\xbd\x29\x03\xe2\x4d\x19\x05\x95\x8b\xf5\xa0\x34\xf8\x29\x12\x02\x8f\x55\xdb\x45\xdb\x62\x17\x2f\x0d\xe5\x57\x9d\x4a\xaa\xf2\x74\x6f\xa0\x6f\x5e\xc3\xda\x67\x1b\xcb\x5d\x0f\x03\xdd\x04\xdb\xe6\xc9\x09\x86\xa0\x04\xf1\xe4\x21\xcc\x9f\xde\xbe\xfd\xa9\x03\x33\x12\x3c\x04\xb5\x81\x58\x7e\xa1\x40\x6f\xdf\x5e\x37\x30\x73\x81\x3e\x73\xca\x9f\x08\x3e\x40\xb3\x34\x48\x6f\x32\x6f\x31\xd2\xa9\xb7\x41\x2e\x67\xe4\xc3\x63\xbc\x3e\xc2\xa6\x6d\xb7\x8e\xd9\x60\xf7\x7c\xd0\x9c\xfb\xa5\x69\x7f\x0e\xaf\x07\xcc\xf7\x19\x74\x97\xbf\xf5\xe3\x4c\x9c\x5f\xee\xf4\x68\xb2\x21\xa3\xfe\xf3\xa2\xf8\xe4\x51\x7d\x4d\xc4\xac\x47\x4a\x4a\x7d\x43\xcd\xe6\x01\x4f\x9c\xde\xe7\x67\x5c\xe6\x33\x52\x34\x78\x75\xad\x07\xa2\x67\x1f\xf9\x8f\x9e\xb6\xef\xa0\xdf\xa0\xbc\x5f\xb0\x1e\x39\x57\x08\xc7\x8a\x87\x58\x11\x0f\x53\x9a\xa0\x88\x78\x4f\x12\xc5\x11\xc2\xf5\x98\xdf\x4e\x42\x8a\x02\xc1\x43\x64\x0f\xbd\x72\x74\x5f\x7e\x9e\xb9\x78\x22\x6c\x3d\x25\xa2\xb7\x77\x3a\x36\x15\xc8\x69\x9e\xd3\xf8\xee\x49\x51\x92\x82\x17\x75\x0e\x9d\xee\x0e\xad\xe8\x8c\xce\x21\x54\xa0\x7c\x79\x47\xf3\x27\xf6\xff\x39\x01\x43\xd3\x07\x95\x13\x75\x3d\x50\x9a\x56\x42\xc8\xd3\x4b\x5d\x23\xed\x36\x83\x3e\x43\xe6\xeb\xf7\x38\x1a\x5d\x1c\x68\xb8\x74\x83\x68\xb5\x2c\x7b\xd4\x24\xa7\x91\x2e\xd1\x0b\xea\x67\x5a\xa9\x14\xe2\xc8\xf4\xea\x34\x51\x0e\x12\x39\x38\xd3\xca\x07\x82\x4d\xe2\xf9\x5a\x87\x6d\xac\x73\xc6\x5c\x27\x0d\xb9\xce\x3c\x5e\xe7\xbc\xeb\x04\x6f\x87\x30\x52\x49\x96\x4d\xd2\x76\x49\xa7\x04\x59\xaf\xab\xb1\x96\x55\x3c\x2d\xe5\x13\xfb\xc9\x46\x8f\x8e\xfa\xba\x1f\x2b\xef\x13\x72\xa0\xac\x65\x32\xc2\xa3\x4a\x80\x23\xa4\x1b\x9f\x6a\xbd\xba\x5d\xb5\x7a\x0d\x78\xab\xa9\xe9\x6a\x3d\x68\x4d\x1c\xf2\x1f\xb8\x64\x83\x6a\x57\x09\xc0\xe1\x0a\x9b\x29\x8b\x95\xcf\xcc\x24\x40\x21\x8e\x6e\xb0\xfc\x08\x49\x76\xd9\x37\x51\x24\x1a\x68\x36\x83\xdd\x2e\x4d\x09\xf3\xe1\xe5\x08\x4c\x5e\xd5\x35\x44\x1c\xe9\xd7\x36\x59\xb6\x3b\xe6\xc8\xbc\x9a\xc5\xe8\xcb\xad\xe3\x41\xa3\x00\xcd\x35\x7d\x5b\xeb\xb0\xf5\xee\x9b\x69\xb7\xf7\xe1\xd7\x90\xf5\x2b\xff\xe5\x83\x46\x50\x78\x5d\xc8\x55\x7a\xf3\x20\x57\xef\xe0\xa2\xcb\x0f\x0e\x7a\x41\xe1\x03\x5d\xc6\xaa\x9b\xdd\x96\xae\x0d\xc5\x4e\xca\x90\xfc\x3a\x9f\xd2\xeb\x64\x5f\x0b\xde\x2a\x3b\x46\xe8\xbf\x56\xc9\x09\xc4\x16\xaa\x97\xf2\xaa\x19\xd3\xbf\x07\x3a\x33\x9f\x4b\x49\x6b\x32\x7a\x50\x9c\x5d\xcb\xad\xe8\xcf\x9e\x4d\xac\xec\x71\x6a\xff\xd6\xad\x5e\x80\xec\xe8\xea\x55\x17\x8a\x95\x47\x8c\xf3\x71\xe2\xf6\x00\x44\xc5\xd0\x70\x84\xbe\x4d\xf7\xc6\x99\xb5\xac\xb9\x18\x58\x3f\x69\xd6\xda\xab\x0e\x7c\xf8\xed\xe2\x9b\x5a\xdd\xfa\x79\x1a\x84\xae\xf5\x36\xc0\x74\xad\xa7\x40\xe6\xa3\x7f\xa2\x24\xf2\x28\x01\xa6\x90\xfe\x41\x19\x09\xb2\x4d\x83\x4a\xbe\x69\x69\xd4\x11\x62\x00\xe6\xcb\x91\x12\xb1\x54\xbd\x4a\xca\x31\x65\x4b\x47\x06\x4e\x9f\x96\x4c\x90\x23\x7a\xaa\x6f\x88\xe6\x5d\xa1\x4f\xff\x2b\x20\xce\x68\x82\x9e\x31\x53\x48\x6d\x40\x8f\x48\x54\x2c\xbf\xcf\x2e\x44\xfd\x3d\x88\x29\xcd\x7c\xcf\x46\x37\xc0\x3c\xd0\x03\xe2\x58\x10\x95\x20\xce\xbe\x47\x12\x98\x24\x8a\x6c\x01\xf1\x20\xb0\x2b\xaa\x2e\x40\x36\x3b\x90\xa3\xe1\xd0\xe7\x9e\xb4\x8b\xe1\xbb\xfe\x65\x5e\x5d\x56\x67\x5b\x43\x2f\x16\x02\x98\x1a\x66\x63\x7c\xcd\x61\xb8\x51\x21\x1d\x46\x82\xfb\xb1\xa7\x4b\x6b\x4b\x0f\x9c\x12\x2b\xe4\x8c\x28\xae\x91\x6d\x0d\x50\xf1\x7a\xc7\x05\xf2\x41\x61\x52\x8d\xa0\x43\xcc\xf0\x1a\x74\xd1\x39\xba\x38\x30\x96\x28\x0f\xd2\xef\xe7\x67\xc6\xcc\x37\x68\xb5\x01\xfd\xeb\xbc\x47\x90\x5a\x8f\x5a\x45\x28\xa2\x98\xb0\x66\xab\xde\x17\x5b\x01\xa6\x12\xf6\x6c\x04\xcc\x8f\x38\x69\xb4\x05\xf9\xe0\xc5\xa4\x51\xd9\x61\x9f\xca\xff\x06\x00\xfb\x17\xa0\x6d\x75\x2a\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-oauth-proxy.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-oauth-proxy.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4209,
+			uncompressedSize: 5526,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\xdd\x6f\xdb\x36\x10\x7f\xcf\x5f\x41\x68\x03\xda\x6e\x95\x95\x76\xed\x30\x08\xf0\x43\xe1\xa6\x6b\xd0\x35\x31\x6a\x6f\x2f\xfb\x28\xce\xd4\x59\x62\x4c\x91\x1c\x79\x52\xa2\x39\xfe\xdf\x07\x4a\xb6\x23\x59\xca\xd7\x30\xa0\xd8\xf4\x10\xc4\xba\xaf\x1f\x7f\x77\xbc\x3b\x85\x0c\x8c\xf8\x05\xad\x13\x5a\xc5\xac\x7c\x71\xc4\xd8\x4a\xa8\x24\x66\x33\xb4\xa5\xe0\x78\xc4\x58\x8e\x04\x09\x10\xc4\x47\x8c\x31\x26\x61\x81\xd2\x35\xff\x33\x06\xc6\xc4\xcc\x55\x2a\x41\x27\xdc\xf6\xdd\xee\xe7\x48\xe8\xe8\x3e\x39\x55\x06\x63\x26\xd4\xd2\x82\x23\x5b\x70\x2a\x2c\x0e\xa8\x71\x9d\x1b\xad\x50\xd1\x8d\xb3\x50\x43\x41\x99\xb1\xfa\xaa\xaa\x0d\x40\x29\x4d\x40\x42\xab\x3d\x38\xd7\x1c\x61\x04\xd2\x64\x30\xd2\x06\x95\xcb\xc4\x92\xbc\xc3\x5a\xa4\xd2\x90\xa3\xa5\xd0\x21\xb7\x48\xa1\x82\x1c\x07\xfd\x87\x24\x1b\xec\xb7\x6a\x1c\x31\xe6\x0c\xf2\x26\xb0\xd1\x96\xb6\x18\xc2\xfa\x47\xcc\x7e\x78\xf5\xea\xbb\x2d\x28\x63\x35\x69\xae\x65\xcc\xe6\x93\xe9\xf6\x1d\x81\x4d\x91\xa6\x5d\x55\x87\x12\x39\x69\xfb\x6f\x51\x7d\x0f\x87\xdd\x42\x00\x63\x5c\x97\xb1\x56\x69\xbc\x45\x23\x75\x95\xa3\xa2\x89\x56\x4b\x91\xfe\x67\x6a\xe4\x61\xf9\xb3\x68\xa4\xe0\xe0\x62\xf6\xe2\x4b\x24\xa2\x56\x27\x0b\x84\x69\xb5\x0b\x69\xd1\xe9\xc2\x72\xdc\x73\xca\x98\x14\xb9\xa0\xd6\x6f\x9f\x83\x5c\xdb\x2a\x66\xc1\xcb\xd7\xdf\x7f\x14\xc1\x5e\x62\xf1\xcf\x02\xdd\x6d\xba\xc7\x37\xaa\x0d\xd1\x9f\xfc\x6d\x00\x6a\x28\x26\xcc\x8d\x04\xc2\x9d\x6d\x37\xcf\xfd\x5c\xdf\xc6\xcf\x43\x38\x7a\x44\xde\xff\x01\xa5\xed\x0c\xfb\x87\x6b\x45\x20\x14\xda\x16\xf6\x70\x5b\x21\x3d\x53\xff\x88\x1c\x52\x8c\xd9\x93\xf5\x9a\x8d\x66\xbb\xd8\x93\x5d\x60\x37\x3a\xf7\x46\xa3\x53\xaf\xc5\x36\x9b\x27\x6d\x42\x6c\xda\x21\xc8\x07\x0a\x43\x63\x75\x29\x12\xb4\xe3\xfd\x35\xeb\xa9\x70\x29\x50\x51\x28\x92\xb1\xab\x1c\x61\x1e\x6f\x3b\x1a\x70\xae\x0b\x45\xf1\x7a\x3d\x3a\x37\xa8\x66\xde\x78\x6a\xf5\x05\x72\xda\x6c\xe2\x2e\x03\x5b\x27\xb7\xf9\x6e\x7a\xdf\xb8\xed\xa9\x3e\xc8\xa4\x16\xcf\x6a\xe9\x66\xd3\xb3\x2e\x8c\x23\x8b\x90\x8f\x33\x22\x13\x47\xd1\x3e\xa6\x47\x88\x36\x02\x23\xa2\x47\x1b\xe5\x60\x0c\xda\x47\xd8\x15\x8f\x09\x92\x94\x51\x52\xf6\xf5\x49\xba\x7a\x0a\x8c\x23\x24\x1e\x91\x74\x91\xb1\xa2\x04\x42\xff\xff\x88\xdb\x3e\x73\xde\x62\x85\xd5\xb0\xc1\x0a\xab\x3e\xd5\x5a\xaf\x04\xee\xa8\xfe\xfa\xe9\xf9\x9b\x9f\xe7\xef\x3f\x4f\xce\xcf\x3f\x9c\x9e\x7c\x9e\x9d\x4c\x3e\x9d\xcc\x9f\xf5\xcb\x03\x9c\x0b\x81\x73\x74\x2e\x24\xbd\x42\xd5\xd3\x70\x2b\x61\xf6\x55\x14\x2e\x0a\x22\x7d\x8b\x52\x5d\x07\x16\x53\xbc\x1a\x47\x52\xa7\xba\xe8\x1f\xaa\xa7\xf7\xeb\x1f\xd1\xef\xdf\xfe\x36\x7a\x6a\x54\x7a\x7d\x61\xd2\x6b\xd4\x74\xed\xca\xf4\x9a\x68\x79\x7d\xa9\x97\xcd\x9f\x97\x7d\xdc\x3d\x47\xbe\x14\xca\x17\x91\xbb\x84\x34\x45\x3b\xfa\xe6\xc1\x16\x42\x25\x78\x35\xca\x28\x97\x0f\x36\xe1\x16\x13\x54\x24\x40\xba\x88\x83\x94\x0b\xe0\xab\x07\x1b\x97\xcd\xf0\xbb\x5f\x9f\xd7\x53\x6f\x74\xe1\xee\x54\x36\x16\x97\x52\xa4\x59\x9f\xeb\xfd\x85\x0f\x39\x34\x55\x64\x56\xa2\xae\x24\x5f\x88\x1e\x79\xb8\x28\x54\x22\x71\xb0\xfc\xba\xd6\x25\xd8\xc8\x16\x2a\x6a\x8a\xcb\x45\xab\x62\x81\x56\x21\xa1\xdb\xaf\x39\xfb\x7e\x11\x71\xa8\x3d\xae\xd7\x4c\x2c\xd9\x53\xa5\xe9\xae\x4e\xf6\x56\x38\x58\x48\x9c\x81\x9d\x64\xc8\x57\xcf\xd8\x40\x1b\xb8\xc1\xe2\xc0\x8e\xd7\x81\x6f\x9f\xce\x00\xc7\x20\x0e\xd6\xeb\x3b\x9c\xcf\xc0\x9e\xed\x74\x37\x9b\xe0\x79\xb0\x9b\x70\x41\x1c\x18\x9d\xb8\xe0\x79\x50\xa2\x5d\x04\x71\x90\x22\x05\x1b\x0f\x19\x55\x72\x08\xe1\x2b\xb6\x05\x99\xb0\xa5\xb6\x4c\xe9\xcb\x78\x77\x73\x0a\xe7\xef\x04\x82\x45\xdb\x5c\x1f\x06\x8e\x51\x26\x5c\x3d\x0e\x85\x45\xc7\xf0\x8a\x2c\x30\x83\x36\x17\xce\x27\x9e\x5d\x66\x82\x67\x4c\x2b\x59\x1d\x44\xe1\xa0\xd8\x02\x59\x2a\x4a\x54\x6c\x51\x31\x60\x5c\x16\x8e\xd0\x86\x90\xe4\xa2\x5d\x04\xa8\xca\x76\xa7\xdf\x0d\x94\x81\x1b\xdf\x09\x51\x82\x2c\xf0\x9d\xd5\x79\x77\x4c\xf8\xdd\xc3\xa7\xf5\x03\x56\x9f\x70\x79\x28\xeb\xed\x33\xa9\xd4\x0b\x90\x21\xdf\x2d\x65\xdd\x67\x85\xd5\x7d\x40\xf6\x70\xa7\x27\x67\xb3\xf7\xa7\xef\xe6\x9f\xb7\xfa\x3f\x9d\x9e\x9c\xcd\xbf\x2c\xf0\x07\x42\x6a\x2d\xdf\xbb\x33\xed\x27\xfd\xf4\x70\x17\x6f\x83\x31\xc5\x42\x0a\xde\x11\x0c\xad\xea\xac\x5e\xa8\x20\x11\x0a\x9d\x9b\x5a\xbd\xc0\xee\xe9\xfc\xd4\xf9\x11\xe9\xf0\xc8\xbd\xcf\x80\xbd\x00\x28\x8b\x59\x54\x8f\xea\x28\x43\x90\x94\xfd\x75\x48\x25\xcf\xd0\x23\x7c\x3f\x9f\x4f\x67\x1d\x99\x50\xc2\xf7\xba\xb7\x28\xa1\x9a\x21\xd7\x2a\xf1\x4b\xeb\xeb\x8e\x0e\x89\x1c\x75\x41\x37\xe2\xe3\x96\x58\xfa\x8a\xfe\x3f\x1c\xa4\xd4\xb2\xc8\xf1\xa3\xef\x72\x07\xd9\xcf\xfd\xbb\x69\x03\xee\x60\x60\x0f\x54\xc1\x5d\x5f\x7f\xcd\x33\xb0\x8a\xb3\xc1\x75\x9c\xb5\xd6\xec\x97\xc7\xc7\x1f\x45\x47\x36\xb4\x94\x77\x2d\x5a\x06\xdb\x36\xfe\xa6\x69\xe3\x67\x03\x48\x0f\xb7\xbc\x86\x8f\x81\xd5\xf6\xfe\x03\x36\x37\xb7\x8b\xab\x79\x37\x14\xb8\xeb\x81\xac\xf0\x43\x7e\xff\xf5\xdb\xac\xf0\xcd\x57\xe2\x24\x03\x95\xe2\xd1\xdf\x01\x00\x00\xff\xff\xba\x2e\x56\x2d\x71\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x6d\x6f\xdb\xb6\x13\x7f\x9f\x4f\x41\xb8\x05\xda\xfe\xff\x95\xd4\x76\xed\x30\x08\xc8\x8b\xc0\x49\x97\xac\x6d\x62\xc4\x6e\xdf\xec\x21\xa0\xa9\xb3\xc4\x9a\x22\x39\xf2\xa4\xc4\x53\xfc\xdd\x07\xea\xc1\x96\x6c\xd9\x71\x86\x01\xdd\x06\x19\x86\x4d\xfe\xee\xf8\xe3\xf1\x1e\x78\xf2\x08\xd5\xfc\x0b\x18\xcb\x95\x0c\x49\xfe\xfa\x88\x90\x39\x97\x51\x48\xc6\x60\x72\xce\xe0\x88\x90\x14\x90\x46\x14\x69\x78\x44\x08\x21\x82\x4e\x41\xd8\xea\x37\x21\x54\xeb\x90\xd8\x85\x8c\xc0\x72\x5b\x8f\x35\x7f\x7d\xae\x82\x87\xe6\x71\xa1\x21\x24\x5c\xce\x0c\xb5\x68\x32\x86\x99\x81\x1e\x18\x53\xa9\x56\x12\x24\xae\x95\x79\x8a\x66\x98\x68\xa3\xee\x16\xa5\x00\x95\x52\x21\x45\xae\xe4\x8a\x9c\xad\xb6\xe0\x53\xa1\x13\xea\x2b\x0d\xd2\x26\x7c\x86\x4e\x61\x39\x25\x63\x8f\x81\x41\xcf\x02\x33\x80\x9e\xa4\x29\xf4\xea\xf7\x50\x54\xdc\x77\x22\x8e\x08\xb1\x1a\x58\xb5\xb0\x56\x06\x6b\x0e\x5e\xf9\x27\x24\x3f\xbc\x7d\xfb\x5d\x4d\x4a\x1b\x85\x8a\x29\x11\x92\xc9\x70\x54\x8f\x21\x35\x31\xe0\xa8\x0b\xb5\x20\x80\xa1\x32\x7f\x97\xa9\x1f\xb0\x61\xd7\x11\xa8\xd6\xb6\x6b\xb1\x96\x6b\x9c\x82\x16\x6a\x91\x82\xc4\xa1\x92\x33\x1e\xff\x6b\x7c\xe4\xb0\xf3\x33\xa0\x05\x67\xd4\x86\xe4\xf5\xb7\x38\x88\x12\x8e\x86\x22\xc4\x8b\x66\x49\x03\x56\x65\x86\xc1\xca\xa6\x84\x08\x9e\xf2\xc6\xcd\xaa\x27\x85\x54\x99\x45\x48\x06\x6f\xde\x7d\xff\x89\x0f\x56\x33\x06\x7e\xcf\xc0\xee\xc2\xbe\x5a\x43\xab\x60\xbc\x76\xd1\x40\xb1\x32\x31\x42\xaa\x05\x45\x68\x64\xbb\xe7\xbc\x7d\xd6\xbb\xec\x73\x88\x8d\x1e\x71\xee\x7f\xc1\xa4\xed\x13\x76\x0f\x53\x12\x29\x97\x60\x5a\xdc\xbd\x3a\xc2\xb7\x44\xdd\x87\xa7\x34\x86\x90\x3c\x2b\x0a\xe2\x8f\x9b\xb5\x87\xcd\xc2\xd6\xbf\x72\x42\xfe\x85\x43\x91\xe5\xf2\x59\x4b\x92\x9a\xb8\x63\x20\x42\x3c\xe2\x79\xda\xa8\x9c\x47\x60\x8e\x57\x61\xb6\x05\x61\x82\x83\x44\x8f\x47\xc7\x45\xe1\x5f\x9d\x64\x98\x0c\xcb\x91\x8b\xd3\xe5\x72\x17\xb8\x4a\x66\xa5\x80\x06\x39\x76\xe1\x5b\x32\xab\x24\xc7\xe5\x6c\x8f\x74\xa6\x2d\x1a\xa0\xe9\x71\x82\xa8\xc3\x20\x58\x99\xd1\x65\x4a\x30\x01\xd5\x3c\x78\xb4\x50\x4a\xb5\x06\xf3\x08\xb9\xec\x31\x8b\x44\x79\x10\xe5\xdb\x78\x14\xb6\x4c\xeb\xc7\x01\x20\x0b\x50\xd8\x40\x1b\x9e\x53\x04\xf7\xdb\x67\x06\x7b\x25\xe6\xb0\xe8\x17\x98\xc3\x62\xdb\xd4\x4a\xcd\x39\x34\xa6\x7e\xfa\xfc\xea\xe4\xf3\xe4\xfc\x66\x78\x75\xf5\xe1\xe2\xec\x66\x7c\x36\xbc\x3e\x9b\xbc\x38\x2a\x0a\x8f\xf0\xd9\x3e\x5f\x19\x96\x6a\xce\xee\x34\x37\xd0\x77\xa0\xe5\xb4\x07\xe5\xbc\x3b\xd0\x83\x35\xb9\xa5\x41\x46\xcb\xe5\xc1\x24\xae\x61\x66\xc0\x26\xbb\x59\x98\x0a\x70\x08\x8d\xb5\xae\x35\x8f\x4d\xad\x9a\x5a\xeb\x51\xc6\xc0\x5a\x0f\xd5\x1c\xe4\x16\xc2\xce\xb9\x5e\xc5\x88\x37\xcd\x10\xd5\x0e\x90\xdb\x86\x67\x20\x86\xbb\xe3\x40\xa8\x58\x65\xf8\x30\xee\xe7\xdf\x82\x5f\xff\xff\x8b\xff\x5c\xcb\xf8\xfe\xab\x8e\xef\x41\xe1\xbd\xcd\xe3\x7b\xc4\xd9\xfd\xad\x9a\x55\x5f\x6f\x5e\x3c\xac\xc8\xc5\x45\xfe\x3a\xb0\xb7\x34\x8e\xc1\xf8\xff\x3b\x58\x82\xcb\x08\xee\xfc\x04\x53\x71\xb0\x08\x33\x10\x81\x44\x4e\x85\x0d\x18\x15\x62\x4a\xd9\xfc\x60\xe1\xbc\x2a\xed\x0f\xe3\x59\x59\xd3\xfd\xaf\x76\x2f\x58\x1b\x98\x09\x1e\x27\xdb\xb6\x5e\xa5\x33\x8f\xd1\x2a\xa4\xf4\x9c\xbb\xd8\x0b\x5c\x54\x3a\xe6\xde\x34\x93\x91\x80\xde\x58\xec\x4a\xe7\xd4\x04\x26\x93\x41\x15\x69\x36\x98\x67\x53\x30\x12\x10\xec\xea\x12\xc7\x80\x32\xa6\x32\x89\x01\xa3\xa5\xc6\xa2\x70\x1e\xff\x5c\x2a\xdc\xe7\xf6\xa7\xdc\xd2\xa9\x80\x31\x35\xc3\x04\xd8\xfc\x05\x59\x2e\xf7\x70\xb1\xd4\x1c\x17\x03\x57\x1c\xac\xa6\x0c\x06\xe1\x60\x6f\x1c\x8c\xa9\xb9\x6c\xb0\xcb\xe5\xe0\xe5\xa0\xa9\xdf\x83\x70\xa0\x55\x64\x07\x2f\x07\x39\x98\xe9\x20\x1c\xc4\x80\x03\x17\x27\x04\x64\xb4\x49\xe1\x09\xa9\x49\x46\x64\xa6\x0c\x91\xea\x36\x6c\x22\x27\xb3\x60\xbc\x29\x50\x03\xa6\x0a\x1f\x42\x2d\xc1\x84\xdb\xb2\xd8\x73\x03\x96\xc0\x1d\x1a\x4a\x34\x98\x94\x5b\x77\xf0\xe4\x36\xe1\x2c\x21\x4a\x8a\x6e\x3e\x7b\x42\x18\x95\x64\x0a\x24\xe6\x39\x48\x32\x5d\x10\x4a\x98\xc8\x2c\x82\xf1\x68\x94\xf2\xb6\x13\x80\xcc\xdb\x75\xac\x29\x97\x3d\xe9\xaf\x85\x22\x24\xa7\x22\x83\xf7\x46\xa5\xdd\x22\xe8\x6e\x56\xee\x58\x3f\xc0\xe2\x1a\x66\x9b\x73\x5b\xb7\xb5\x58\xa8\x29\x15\x1e\x6b\xae\x9c\xdd\x67\x0e\x8b\x7e\x22\x87\x66\xc0\xaa\x32\x8e\x0c\xe4\x5c\x65\x76\xb9\x3c\x6c\x9f\x37\xa3\xeb\xb3\x2f\x17\x57\x9f\xc7\xff\x98\x0d\xaf\x19\xf5\x65\xdf\xd5\x56\x46\x67\x97\xe3\xf3\x8b\xf7\x93\x9b\x5a\xc5\xc7\x8b\xb3\xcb\x49\xad\xe2\x1b\xed\xe5\x40\x4a\xad\xf6\xaa\xd9\xd3\xea\x2e\xb7\xd1\x42\x35\x4f\x45\x46\x67\x53\xc1\x59\x67\xa2\xaf\x19\x73\x8f\x01\x1a\x71\x09\xd6\x8e\x8c\x9a\xae\x2e\xbf\xd5\xc7\x5d\x43\x7e\x04\xec\x0e\x92\xed\x46\xaf\x79\x34\xc5\x24\x24\x41\x79\xa7\x0c\x12\xa0\x02\x93\x3f\x36\x20\x96\x25\xe0\x18\x9e\x4f\x26\xa3\xae\x27\x71\xc9\x5d\xbe\x3f\x05\x41\x17\x63\x60\x4a\x46\xae\x2d\x79\xd7\xc1\x20\x4f\x41\x65\xb8\x9e\x7e\xd5\x9a\x16\x2e\xaa\xff\x0b\x1b\xc9\x95\xc8\x52\xf8\xe4\x32\xfd\xc6\xe9\xa7\x6e\x6c\x54\x59\x79\xe3\x06\xd7\xe3\x05\x3d\xfd\xc1\xaa\xbf\xdf\x6a\xb6\x5c\x08\xdd\x72\x4c\xf6\xa5\x8f\xeb\x06\xde\x09\xb4\xbe\x06\xad\xdd\x78\x15\x85\x32\xc4\xff\x54\xb6\x6c\x1f\x5d\x2b\x47\x06\x6f\x5e\xb9\x5e\xac\x75\x69\x1b\x8e\x3e\x97\x53\x1b\x8a\x09\x61\x3a\x73\x1a\xda\x80\xfe\xab\x56\x5f\xeb\xb7\x8b\xc5\x75\x85\xad\x5b\xc2\x2e\x8d\x7a\x6e\x1f\x91\x35\x64\x4d\x65\x9b\x54\x5d\xb2\x4f\xaa\x92\x7d\xd9\x73\x22\x75\x1f\xb3\x36\xfd\xd3\x3d\xb6\x1f\xb3\x04\xa2\x4c\x70\x19\xd7\xab\xb9\x4c\x7f\xa9\x22\x18\xd7\x3d\x7b\x6b\x69\xd9\x1a\x76\xa4\x51\xfd\x64\x95\xdc\x82\x77\xd9\x3b\x7d\x13\x25\xc0\x54\x6f\x96\x5a\xea\x70\x3d\xda\xd6\xd6\x05\x6f\x2b\x3b\x99\xcd\x5c\x4c\x2f\x5a\x9a\x68\x3d\xd4\x56\xd3\x82\x75\x75\x74\xb4\xb5\x4c\x73\xae\x2c\x9e\x08\x4e\x6d\xc7\x0f\x93\xf5\x68\x4b\xfb\x4e\xb1\x7d\x0b\x9c\x5e\x8e\xc7\xd9\x6c\xc6\xef\x5a\xea\x23\x69\xab\x37\x40\x6d\xff\xb2\x40\x0d\x4b\xda\xaf\x2a\x5c\x8a\x2e\x8a\xa7\xeb\x86\x74\x64\xd4\x57\x60\xb8\x5c\xfa\x36\x67\x7e\x51\x3c\xb0\x8c\x93\x3f\x18\xb8\x13\xb4\xde\x5c\x03\xae\x52\x4a\x8b\xa8\x77\x70\x8e\xa8\x8a\x5f\x78\xb4\x5d\x10\x2f\x1f\xd4\x80\x86\xbb\x5e\xa1\x5e\xd7\xab\x5f\xbb\x54\x86\x1c\x26\x54\xc6\x70\xf4\xe7\x00\xa9\x09\xfe\xd6\x96\x15\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-server.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-server.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 7840,
+			uncompressedSize: 14732,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x5f\x73\xdb\xb8\x11\x7f\xf7\xa7\xc0\x28\xd3\xf1\x5d\x27\xa4\xe2\x4b\x72\xf1\x71\x26\x0f\x8c\xc4\xd8\x3a\xeb\x0f\x8f\x64\xd2\xc9\x74\x3a\x1a\x18\x5c\x91\x88\x40\x80\x05\x40\xe5\xd4\xd4\xdf\xbd\x43\x42\xa4\x49\x99\xb4\x9d\xe9\xa5\xe3\xb6\x7c\x71\x04\xec\x62\x77\x7f\xfb\x07\xbb\x88\x85\x70\x4e\x3f\x82\x54\x54\x70\x07\xed\xce\x4e\x10\xda\x52\x1e\x3b\x28\x04\xb9\xa3\x04\x4e\x10\xca\x40\xe3\x18\x6b\xec\x9c\x20\x84\x10\xc3\xd7\xc0\x94\xf9\x37\x42\x38\xcf\x1d\xa4\xf6\x3c\x06\x45\xd5\x61\xad\xfe\x69\x53\x31\x7e\x68\x5f\xef\x73\x70\x10\xe5\x1b\x89\x95\x96\x05\xd1\x85\x84\x1e\x32\x22\xb2\x5c\x70\xe0\xfa\xf6\x30\x4b\x81\xdc\x81\xac\x88\x39\xce\xa0\x6f\x47\xe5\x40\x8c\xa6\xb9\x90\xfa\xa0\xb4\x55\xfd\x70\xd0\xf9\x8b\x83\xa0\x5c\x0a\x2d\x88\x60\x0e\x8a\x26\xfe\x61\x4d\x63\x99\x80\xf6\x0f\x84\x0d\xa9\x11\x94\x6a\x9d\x57\x0b\x0a\x18\x10\x2d\xe4\x1f\x85\xc6\x3d\x66\x76\xfd\x84\xf3\x5c\xd9\x22\x07\xae\x52\xba\xd1\x25\x6b\xcb\x73\x53\xc8\x99\xd8\x67\xc0\xf5\x44\xf0\x0d\x4d\xfe\x47\x5c\x28\x21\x67\x94\x60\xe5\xa0\xb3\xff\x34\xf8\x15\xa9\x96\x58\x43\xb2\xaf\xc5\x49\x50\xa2\x90\x04\x1a\x1c\x11\x62\x34\xa3\xba\xf5\xbb\xc4\x3d\x13\x72\xef\xa0\xd1\x4f\xaf\x7f\x5e\xd0\x51\xb3\x23\xe1\xef\x05\xa8\x21\xda\x17\xb7\xa4\x06\xdc\x00\x88\x04\xac\x0d\xac\x1a\xb2\x9c\x61\x0d\x35\x6f\xd7\xb7\x77\xfd\x3b\x84\xcd\x63\xf0\xf9\x06\x5f\x7f\x23\x9c\x6d\xcf\x1a\x6f\x56\xe5\xc6\x25\x44\x14\x5c\x2f\x07\xa2\xc1\x7c\x44\x70\x8d\x29\x07\xd9\xb2\xd1\x1a\x8c\xa0\xfa\x03\xbe\x6b\x43\x52\x33\xfc\xea\x7e\x74\xd7\xae\xef\xaf\xa7\xb3\xa0\xb5\x8d\xd0\x0e\xb3\x02\x1c\x34\x8e\x9b\x74\x52\x43\xec\x2b\x3f\x9a\xad\x96\x61\x1f\xfb\xc8\x9a\x7e\xc6\x3b\x6c\x73\xd0\x76\x2e\x61\x03\x72\xe6\xef\x5e\x85\x1a\x93\xed\x5b\x2d\x0b\x40\xd6\xb4\x50\x20\xed\x54\x64\xf0\x76\xac\xb3\x7c\xd4\x23\x64\xe9\x2e\xbc\xd0\x77\x27\xde\x5d\x09\xef\xa5\xc8\x9c\xce\x32\x42\x1b\x0a\x2c\x0e\x60\x73\xbc\x7e\xd8\xf1\xb1\x4e\x9d\x26\x6c\xec\x52\x84\xca\x31\x81\x1e\xc1\xde\x72\xea\xaf\x66\xcb\x28\x5c\x47\x5e\x18\xad\xc3\x0f\xbe\xbf\x0a\xa2\xb5\xb7\x74\xdf\xcd\xbd\x69\x9f\xbd\xa7\x5f\xbf\x22\x3b\xac\xe3\x60\x52\x07\x81\xb2\xc3\xca\x1f\xf6\x7b\xc0\x65\xd0\x28\x3b\x02\xa5\xc3\x22\x2f\x6b\x31\xba\xb9\x39\xed\x11\x3e\x59\x2d\xa3\x60\x35\x9f\x7b\x41\xb8\x9e\x2d\x23\xef\x22\x70\x4b\x98\xff\x10\xe9\xa6\x46\xce\xb8\x86\x44\x62\x4d\x05\x57\x03\x4a\xf8\xab\x30\xba\x08\xbc\xf0\xb7\xf9\x3a\x74\x17\xfe\xdc\x9b\xbe\x5b\xfb\x6e\x18\xfe\x65\x15\x0c\x69\xd0\xab\xc0\x14\x6b\x7c\x8d\x15\xd8\x21\xce\x72\x06\xf1\xb5\x8f\x95\xfa\x22\x64\x3c\x60\xfb\x7c\xe6\x2d\xa3\x75\x18\xb9\x91\xb7\x76\x3f\x44\x97\xde\x32\x9a\x4d\x8c\xfd\xee\xfc\x62\x15\xcc\xa2\xcb\x45\x6f\xbc\x5d\x66\x98\x84\x97\xee\x59\x5f\x1c\xdd\x77\xea\x95\xf7\xe9\x71\xd1\xa5\xca\x62\xa4\xaf\x60\xdf\x1b\x61\xbd\x59\x68\x19\x9e\x3b\xc4\x5b\xd8\x3b\x88\x30\x0a\x5c\x87\x1a\x6b\x70\x0b\x9d\x02\xd7\x94\x54\x2e\xb9\x82\xfd\x43\x36\x78\xcb\x49\xf0\xc9\x7f\x04\x2a\xae\x17\x8e\x27\xef\x26\x63\xff\x6a\x12\xbe\xf6\x71\x1c\x53\x9e\x3c\x88\x50\xeb\xf4\xa7\x80\x8e\xc7\x89\xdc\xe7\x8f\x44\x26\x9a\xf5\x86\xe7\xa8\x37\x2e\xda\xd9\x65\xd8\x27\x97\xde\xe4\xaa\xca\xba\xe0\xa3\x3b\xff\xb7\x52\xad\x95\x64\x95\x19\x93\x14\xc8\xb6\x5c\x94\x3b\xcc\x06\xb2\x6e\xe5\x7b\xcb\xf0\x72\xf6\x3e\x5a\x2f\xdc\xa5\x7b\xe1\x2d\x4a\xc3\x3e\x04\xf3\xf5\xfb\x55\xf0\x32\x9c\xb8\xf3\x9e\x4a\xf8\x0d\x2a\x2d\x30\xc7\x09\x94\x25\xfd\x83\x64\xef\x85\x7c\xa9\x08\x66\x50\xe9\xf2\xf5\xab\x85\xe8\xa6\x75\x8c\x1b\xc7\x82\x2b\xfb\x57\x0c\x09\x48\xdb\xe3\xf8\x9a\x41\x7c\x73\xd3\x7b\x15\x78\x17\x5e\xb0\xae\x6b\x66\x2f\xfc\x65\xeb\xe8\x8c\xc7\x4d\x04\x7c\xae\x8e\xb5\x88\x60\x87\x66\xe6\xec\xd5\x4f\x3f\x9f\x8f\x71\x4e\xc7\x5a\x62\x02\xaa\xcf\x5d\x07\x41\xa6\x1e\x05\xeb\xe8\x93\xdf\x0b\xc7\xa8\x5d\x8a\xba\x66\x98\x22\x24\xa3\x7d\x0e\x37\x37\x8f\x10\xe1\xbb\x81\xdb\x9f\x58\x0f\xca\xf0\xb1\xc4\x59\x29\xa4\x44\x16\x78\x09\xdd\x10\xc6\x13\x9c\x01\xbb\xea\xc5\xf8\x19\x5a\x60\xb9\x05\x89\x74\x8a\x35\x22\xb8\x50\xa0\x10\x46\x12\x6e\x6f\x67\x24\x36\x48\xa7\xd0\x64\x17\x32\xd9\xf5\x1c\x29\x61\xb8\xca\x4d\x0e\x5f\xca\xf6\x61\x43\x93\xc2\x84\x24\xa2\xaa\xec\x8e\x18\x85\xb8\x2f\xa5\xdc\x85\x37\x5f\x5f\xdd\x77\xe5\x8c\xca\x5b\xbc\x6b\x5d\x69\xdb\x14\x76\x87\xdb\x6d\x20\x56\x3e\xba\xeb\xa9\xf7\xee\xc3\xc5\xbd\x67\x3e\xe2\x44\x9a\xe1\xa4\x8c\x7d\x54\xc6\x2e\x30\x05\xbd\xbb\x0f\x64\xc6\xac\x24\x3b\xc4\xbf\x91\x79\x74\x84\x5f\x30\xe6\x0b\x46\xc9\xde\x41\xb3\xcd\x52\x68\x5f\x82\x02\xde\x2e\x5a\x8c\xee\x80\x83\x52\xbe\x14\xd7\xd0\x2d\x7d\x65\xd4\x5f\x80\x3e\xae\x87\xf9\xf1\x78\xd5\x6c\x54\x1d\x4a\x95\x05\xbb\xb3\xf1\xce\x4c\x3d\x47\x34\xe5\x99\x97\x80\xe3\x4e\x17\xd8\x05\xd9\x25\x04\xf2\xbb\x75\xb5\xae\x16\x1a\x7e\xd7\xe3\x9c\x61\xca\x4f\x3b\x34\x94\x53\x4d\x31\x9b\x02\xc3\xfb\x10\x88\xe0\xb1\x72\xd0\xcb\x17\x5d\x25\x73\x90\x54\xc4\xcd\xf6\x4f\xdd\xdd\x0d\xa6\xac\x90\x10\xa5\x12\x54\x2a\x58\xec\xa0\xd7\xad\x7d\x09\x38\xa6\xdf\x08\x55\x85\xc8\x68\x9c\x02\x66\x3a\x1d\xf5\x03\x79\x76\x7e\xf6\xb0\x21\x67\x6d\x4d\x5b\x63\x71\x0d\x5d\xd3\x5c\xdf\x19\x7e\xcd\x77\x34\x02\x0f\xb1\x1d\xeb\x62\xd8\x32\xd0\x92\x12\x75\x1f\xe7\x2f\x6f\xde\xfc\xd2\xc3\x99\x4b\x91\x81\x4e\xa1\xb8\x97\xf9\xfc\xcd\x9b\xf3\x1e\xe6\xcf\x82\x89\x2d\xc5\xad\x9d\x2f\x42\x6e\x29\x4f\xa6\x54\x0e\x76\xf8\x3b\xc1\x8a\x0c\x16\xe5\x38\xa2\xfa\x06\x07\x53\x46\x2c\x43\xd6\x11\x9a\x95\x3c\xa6\xcb\x6e\x9f\x3d\x26\xf5\x38\x5e\x7f\xcf\x50\x08\x1a\xfd\x26\x42\x44\x18\x56\x0a\x69\x81\x46\x17\x05\x96\x98\x6b\x80\x78\x84\x7e\x30\x13\x25\x7a\xfb\xb6\x99\x18\x7f\xec\xb0\x47\x29\x55\x28\x16\xa0\xf8\xa9\xae\x6c\x42\x82\xa3\x55\xb8\x42\x58\x95\xe5\x4e\x42\x55\xde\xd0\x86\xfe\x0e\x31\xaa\x0a\x5e\x87\x7d\x23\x45\x66\xa6\xd6\x52\x74\x3d\xd1\xa2\x1f\xce\x5f\xfc\x09\x91\x42\x4a\xe0\x9a\xed\x7f\xb4\xd1\x69\x2d\xfd\xb4\x3c\x8f\x26\x5c\x48\x88\x8d\x80\x4e\x58\xdf\x99\x88\x51\xef\x54\x8c\x5a\xd3\xee\x43\x95\x29\xa8\x0f\xb5\x17\x15\xc7\x51\xc3\x50\x7e\x24\x2f\x1c\xf4\xe6\xf5\x8b\xac\xb3\xde\x37\x62\xb7\x05\x57\x13\x79\xdf\x49\xaf\xda\x27\x19\xef\xf6\xcc\x99\x43\xde\x37\xeb\x0b\x9c\x3b\x3d\x71\x78\xdc\xf8\xb5\x02\x42\x4b\x9a\x24\x4d\x2d\xb3\x0e\x83\xbf\x79\xc0\x99\xa4\x98\x27\x30\x74\x0d\x58\xa6\x42\x1b\xa2\xea\xb6\x6d\xa9\x8b\x0b\x2d\x32\xac\x29\x71\x50\x79\xa7\xdc\x1d\xa1\xcb\x49\xbb\x63\xde\xd0\x00\xbd\x39\x6a\x76\xcd\x5b\x53\x75\x71\x84\x5a\x02\xce\x22\xdc\x0e\x6d\x63\xf0\xe9\xd1\x69\x0e\xc3\x1a\x94\x3e\x3d\x22\xac\xc6\x4f\x33\x3b\xad\x72\xe0\x61\x4a\x37\xda\x97\xe2\x33\x10\x7d\xeb\x6e\x83\xc8\xec\xd6\xd6\x93\xa3\x47\xb1\x0a\x86\xc1\x57\xb1\x96\xa6\x77\x1e\xc4\x86\x9f\x0e\x9e\xde\x53\xd9\xed\xb3\x89\xc6\x49\x13\x2e\xc6\x82\x91\x81\xb7\xbe\x1e\xba\x2e\xbb\xd7\x61\x0f\xb8\xab\xee\x0a\x4e\x9e\x55\x55\x06\x4b\x51\xf0\x18\x91\xb2\x5f\xb3\xb6\x4d\x55\x1f\x7c\x4b\x9e\xd4\x49\x71\x07\x79\xcc\xb9\xd0\x66\x00\xaf\x35\xa5\xc2\xae\xd5\x18\x17\x79\x22\x71\x0c\x56\x26\x62\x70\xd0\x16\x20\x7f\xa2\x5e\xb9\x13\x43\xb7\xf7\x95\x85\x13\xe0\xfa\x36\xd7\x6f\x8d\x6f\xd1\x98\x5d\x7b\x9f\x31\x07\xfd\xd3\x3a\x19\x78\x44\xf0\x1b\x06\x3b\x28\x18\xa8\xd2\x23\x8f\x6b\xa6\xd1\xcd\xcd\xc9\x33\x14\x46\x6e\x10\x39\x55\x53\x6b\x5d\x9d\x58\x07\xef\x04\x82\x95\xf6\xb6\x7d\x27\xaf\x31\xb1\x71\xa1\x53\x21\xe9\x3f\x2a\xf7\xd8\xdb\x73\x65\x32\xea\x1a\x34\x3e\x1b\x48\xa1\x43\x44\x3c\x51\x27\xc9\x12\xb3\x52\xa1\x2a\x50\x2f\xa4\x28\xf2\x26\x81\x2a\xcd\x6d\x9c\x63\x92\x82\x2d\xa4\xc9\x8c\xa3\x1b\xcd\x42\xa3\x3f\x9b\xdc\xda\x81\xbc\x56\x0e\xfa\x2b\x4a\x40\x3f\x47\x8c\x2a\xfd\x1c\x99\x27\xda\xe7\xa8\xc8\xe3\xea\x6f\x0c\x0c\x6e\xff\x1e\x26\x3c\x2a\xf8\x73\xf4\x05\x6b\x92\xfe\xad\x83\xff\x3b\xca\x63\xca\x93\xff\x0b\x37\xa8\xe2\xba\xac\xec\x07\x4f\x74\xfe\xb7\xe9\xf0\xfc\x7b\x6f\x51\x96\x82\x41\xf3\xb8\xd2\x89\xe0\x3e\xf3\x6b\x47\xdf\x03\xe6\xf7\x48\x84\x46\xed\x2d\xc7\x9a\xee\xc0\x92\xd5\xa8\xf2\x44\x3d\x32\x9c\x18\x55\x47\x50\xfa\x86\x27\xf6\xc1\x14\x3b\x86\x5d\x5f\x76\x34\xa4\x04\xd4\x60\x92\x1c\x42\x7f\x40\x12\xec\x80\xeb\x47\x8a\x22\x29\xe6\x1c\xd8\x83\xa2\xbe\x5f\x96\xfd\x77\xf9\xf8\xbb\x67\xdd\x7d\x70\x3c\x26\x0b\x9f\x21\x6f\x39\x6d\x2e\xa7\xba\xe3\xf8\x57\x00\x00\x00\xff\xff\xb4\x4b\xe9\x88\xa0\x1e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3b\x6b\x6f\xdb\xb8\x96\xdf\xf3\x2b\x08\xb7\x17\x69\x17\x95\x9c\x74\x26\xb7\xb9\x06\xf2\x41\xb5\x95\xc4\x8d\x63\xeb\x5a\x6a\xef\x16\x8b\x85\xc1\x48\xc7\x36\x1b\x89\xd4\x90\x94\x13\x8f\xd7\xff\x7d\x41\xbd\x65\xc9\xb2\xd3\xe9\x2c\x3a\x7b\xeb\x02\x49\xa4\xf3\xe2\x79\xf1\xf0\xf0\x78\xb3\xd1\x10\x99\x23\x7d\x48\x85\xc4\xbe\x2f\x8c\x30\xf4\x89\x8b\x25\x61\x74\xbb\x3d\xd1\x10\x0e\xc9\x17\xe0\x82\x30\xda\x43\xab\xf3\x13\x84\x1e\x09\xf5\x7a\xc8\x06\xbe\x22\x2e\x9c\x20\x14\x80\xc4\x1e\x96\xb8\x77\x82\x10\x42\x3e\x7e\x00\x5f\x24\xbf\x23\x84\xc3\xb0\x87\xc4\x9a\x7a\x20\x88\x48\x9f\x65\x7f\xea\x84\x75\x0f\xbd\x97\xeb\x10\x7a\x88\xd0\x39\xc7\x42\xf2\xc8\x95\x11\x87\x06\x30\x97\x05\x21\xa3\x40\x65\x41\x4c\x13\xc0\x57\xc0\x63\x60\x8a\x03\x68\x7a\x23\x42\x70\x13\x49\x43\xc6\x65\x2a\xb4\x16\xff\xd1\x43\x97\x67\x29\xa3\x90\x33\xc9\x5c\xe6\xf7\x90\xd3\xb7\xd2\x67\x12\xf3\x05\x48\x2b\x05\xcc\x41\x13\x46\x4b\x29\xc3\xf8\x81\x00\x1f\x5c\xc9\xf8\x8f\xd2\x46\xcb\x32\xab\x76\xc2\x61\x28\x74\x16\x02\x15\x4b\x32\x97\x0a\xb5\x64\xb9\x01\x84\x3e\x5b\x07\x40\x65\x9f\xd1\x39\x59\xfc\x3f\x31\x21\x87\xd8\x6f\x45\x0f\x6d\x36\xca\x9f\xed\x14\x56\xb7\x25\xa6\xde\xc3\x5a\x37\x29\x7e\xf0\xc1\xdb\x6e\xcf\x36\x1b\xf0\x05\x6c\xb7\x9b\x4d\x01\xd5\xcf\xf8\x0b\x5d\xb9\x36\x70\x7d\x9a\x12\x54\x70\x40\xbd\xed\xf6\xff\xda\xa6\x0a\x56\x48\x8e\x25\x2c\xd6\x19\x3b\x0e\x82\x45\xdc\x85\xdc\x3c\x08\xf9\x24\x20\x99\xf3\x26\x9f\x00\x02\xc6\xd7\x3d\xd4\x79\x7f\xf1\xf7\x7b\xd2\xc9\xdf\x70\xf8\x2d\x02\xb1\x0f\xf6\xac\x00\x4d\xc2\x6e\x0a\x2e\x07\x2c\x13\x6b\x49\x08\x42\x1f\x4b\xc8\x70\xab\x2e\x53\x77\x9b\x7d\xba\x39\x46\x3f\x2f\x70\xa1\x17\xaa\xb3\xec\x30\xea\xa3\x5e\x11\x17\x0c\xd7\x65\x11\x95\xe3\x46\x27\x4b\x13\xe4\xeb\xc2\x57\x6e\x99\x90\x86\x4f\xb0\x00\x91\x7a\x85\xfa\xbf\x2c\x9e\x2a\x1f\x94\xec\x93\x60\x74\x3f\x9a\x22\x9b\xb8\x55\x9d\xc1\x60\x6c\xdb\xd1\x7c\x4e\x9e\x4b\xe4\x3d\x2a\x92\x78\x2d\x6b\x58\x00\xe6\xee\xb2\xec\x0d\x08\x69\x68\xb3\x79\xad\x4f\x42\xa0\xb6\x8a\x7e\x8b\xb3\x6f\xe0\xca\xed\x56\x17\x2b\x57\xdf\x6c\x0e\xb0\x51\xf8\x47\x03\xee\x05\x2a\x16\x97\x01\x4b\xe0\x01\xa1\xf1\xb6\x72\xc3\xb1\x0b\x16\x70\xc2\x3c\x1b\x5c\x46\xbd\x38\x66\xdb\x42\xd1\x5e\x46\xd2\x63\x4f\x54\x77\xda\xa8\xa4\x7c\x9f\x88\x5c\xa2\x56\x6a\xee\x12\xbc\xc8\x27\x74\x91\x62\xa8\x74\x31\x66\x1e\xd8\x69\x6c\x97\xc4\xa6\xa5\xc7\x25\xab\xee\x82\xd7\x8c\xa9\x3b\xcc\x07\x1e\x2f\xb7\xec\x23\xb2\x78\x5a\xa6\x56\x05\xae\x12\x2b\x7e\x7b\x2d\x42\x0e\xd8\x43\xbd\x2b\x84\xa9\x87\xde\x2c\x24\x3a\x26\x7f\xa1\xf3\xb7\xe8\x0d\x65\xed\xc0\x03\x22\x54\x76\x34\xa8\x24\xc6\x7c\x4e\x28\x91\xeb\x23\xb0\x0a\x4d\xea\xb7\x58\x58\xcc\xab\xe0\xa7\x62\x93\x39\x62\xfc\x58\x32\x19\x72\xb6\xd8\x92\xee\x70\xfa\xaa\xf7\x52\x2b\xe7\x44\x0b\x81\x62\xfb\x95\x1e\x67\x3c\x10\xa2\xa5\x17\xbb\x06\x2f\x21\x54\x2d\xa3\x0c\xae\x56\x5f\xbc\xcf\xc8\xa9\xd2\xc2\x6b\xa2\x57\x05\x6f\x26\x57\x52\x66\x8d\x64\xe9\xdd\x2e\xd9\x2a\x5a\x95\x74\x85\x49\x5d\xc7\x75\xda\xa5\x57\x08\x85\x1c\xe6\xc0\x39\x78\x83\x88\x13\xba\x28\x54\x3c\x5c\x50\x96\x3f\x36\x9f\xc1\x8d\x94\x33\x57\x91\x35\xf4\x04\x64\xb1\x94\x3d\x74\x7e\x96\x15\x4c\x0d\x4a\x52\x21\x5e\x45\x54\x1f\xc9\x42\xe6\xb3\xc5\xfa\x0e\xd6\x3d\xf4\x18\x3d\x00\xa7\x20\x21\xde\x41\x55\xde\x55\x85\x57\x0d\x27\xde\x90\xf2\xe0\xad\xbd\x46\x28\xc0\xd2\x5d\x8e\x6a\xdb\x56\xf1\x39\x66\xa3\x6a\x86\x3e\xb8\x0f\xed\xea\xe4\xe2\x8f\xa9\x64\x8e\x89\x1f\x71\xd0\x3c\x16\x60\x42\xf5\x07\x90\x58\xaf\xaa\xe9\x77\x46\xff\x32\x2a\x6a\xf1\xd9\x22\xe4\x87\x54\x02\xa7\xd8\x77\x46\x76\x51\xdb\xe5\x5c\x95\xe2\xfa\x8c\x4a\x4c\x28\xf0\x92\xec\x5a\x5a\x4a\x3e\xc2\x5a\x48\xc6\xa1\x2c\x27\x09\xf0\x02\x7a\xe8\x74\xb3\x69\xcd\x2c\x43\x05\x86\xb6\xdb\xd3\x5d\x54\x2b\xf2\x7d\x8b\xf9\xc4\x5d\xf7\xd0\x70\x3e\x66\xd2\xe2\x20\x80\xca\x12\x9c\xcb\x82\x00\x53\xaf\xac\x4b\x0d\x75\x1f\x08\xed\x3e\x60\xb1\xac\x3e\x05\xe9\x76\x33\xcd\x74\x85\xcb\x49\x28\x45\x37\x97\x5b\xaf\x80\x03\x5d\x55\x69\x26\xab\xbc\x33\xbf\xda\xce\x64\x6a\xce\x2c\xc3\xb6\xff\x35\x99\x0e\x4a\x30\x08\xad\xb0\x1f\xc1\x35\x67\x35\xe7\x12\xaa\xf2\x93\x77\xb0\x9e\xc2\x7c\xf7\x5d\xad\x16\x27\xa9\x1d\x34\xe9\xd7\x8d\xfe\x08\xeb\x42\xd7\x16\x16\xe2\x89\x71\xaf\x41\xd0\xe1\xd8\x31\xa7\x63\x63\x34\xeb\x1b\xb3\xeb\xe1\xc8\xac\x10\x8a\xe5\x54\x59\x2e\xb7\x79\xdf\xb8\x26\x3e\x54\x12\xd7\x8a\xf9\x51\x00\xf7\xaa\x8e\x13\xbd\x06\x0e\x65\x31\xb5\x54\x9b\x25\x30\x84\x02\x85\x6a\x61\xb9\xec\x35\xab\x3e\xf7\xc0\xfb\x48\x46\xd8\x2f\xb9\xdf\x76\xdb\xc0\x4f\xfa\x42\x4b\x64\x3a\x8e\x8b\xd2\x9e\xe2\x90\x1c\x4d\xda\x16\xe0\xe2\x17\x11\x2e\xe1\x95\xc2\xa9\x4e\x3f\xf7\xac\x43\xd4\xbd\xfc\x00\x59\x92\xba\x42\xd4\x6d\x09\xbc\xa6\x58\x3f\xbc\x99\x67\x05\xa0\xc5\xc1\x96\x2c\xac\x2c\xc0\x27\x73\x70\xd7\xae\x9f\x9f\x4d\xd2\x04\x9a\x80\x56\x1f\x22\x04\xcf\xe5\x03\x40\x2d\x2e\x95\x38\x1c\xd3\x05\x20\xbd\xc2\x24\x5b\xc4\x66\x13\x72\x42\xe5\x1c\x75\xfe\xf6\x5b\x27\x86\x29\x96\x5f\x57\xc4\xde\xc0\xfc\x64\x7c\x31\x66\x86\x65\xcd\x06\xc3\x69\x93\xaf\x97\x95\xfc\xd2\xd4\xf7\xd3\x87\x7f\x93\x9e\x5e\xa1\x7f\x29\x27\xc8\xa8\x21\x67\x64\x23\xb9\x84\xe2\x81\x0b\x5c\x92\xb9\xea\x4c\x01\xc2\x91\x5c\x32\x4e\xe4\x1a\x11\x81\x24\x8f\x84\x04\x0f\x31\xaa\xb6\x44\xc4\xe6\x31\x1e\xa3\x20\xb2\xdf\xc5\x5a\x48\x08\xde\x55\xb8\xa9\x0a\x7a\xc7\x15\x55\x79\xa3\xd2\xb5\x40\x44\x8a\x0a\x3f\xc9\x0a\x58\x75\xf0\x4d\xaa\xcf\x20\xce\x03\x4a\xd2\x06\xd5\xc7\x06\x9e\x58\xce\x70\x32\xb6\xeb\x5a\xef\xa1\x8e\x36\xf8\x86\x57\x58\xa7\x20\xf5\xa4\xac\x1a\x5a\xab\x5f\x6d\x89\xdd\xc7\x2b\xc9\x23\x40\xda\x20\x12\xc0\xf5\x25\x0b\xe0\xaa\x2b\x83\x10\x69\x03\xa1\x5c\x6f\xa1\xbb\xf1\x31\x50\xc7\x9e\x47\x54\x95\x85\x7d\xcd\x67\x49\xc3\xee\x6a\x4e\x7c\xe8\x55\x02\xd4\x67\x8b\x05\xa1\x8b\xee\x91\x4e\x84\x12\xb1\x9e\x63\xb9\x84\xf0\xf5\x58\xbb\xb6\x32\xdf\x55\x85\xae\xf4\x45\x37\x7e\x17\xef\xa2\x7a\x78\xfe\xbe\x05\xd5\x59\x87\x70\x65\xdd\xf5\xed\x56\xa8\xcc\x3f\xae\x5e\xbf\xa9\x39\xee\xdb\xb6\xec\x5b\xa3\xf9\x08\xeb\x3d\x22\x67\xbe\xd8\x28\x70\x86\xd6\x26\x6e\x06\xd3\x2a\xec\x66\xb3\x93\x10\x3a\x0d\x1e\x32\x36\xee\x4d\xdb\x32\xfa\xe6\x71\x41\x39\x27\xe0\x7b\x8d\x01\x19\xbf\x49\xb6\x94\xac\x2b\xa3\x2b\x16\x22\xc4\x2e\x34\x30\x36\xc7\x03\x6b\x32\x1c\x3b\xf6\xcc\x31\x6d\x67\x66\x7f\xb6\xac\xc9\xd4\x99\x99\x63\xe3\xe3\xc8\x6c\x48\x11\x87\x2b\xa2\x6b\xc0\xaa\x27\x23\x74\x07\x84\xb4\xa3\x50\x75\x50\x77\x0a\xa4\x8c\x79\x7f\x32\x76\xa6\x93\xd1\xc8\x9c\xda\x33\xb5\xe9\xdf\x4c\x0d\x15\x23\x3f\x84\x7b\xd2\xd9\x54\x7e\xbd\x48\x4f\xd3\x7b\x84\xb0\x26\xb6\x73\x33\x35\xed\x7f\x8e\x66\xb6\x71\x6f\x8d\xcc\xc1\xc7\xdc\x78\x7b\x24\x68\x14\x60\x80\x25\x7e\xc0\x02\x74\x1b\x07\xa1\x0f\xde\x43\xe6\x15\x7b\xd6\x3e\x1a\x9a\x63\x67\x66\x3b\x86\x63\xce\x8c\xcf\xce\xad\x39\x76\x86\xfd\x64\xfd\xc6\xe8\x66\x32\x1d\x3a\xb7\xf7\x4d\xfc\x3b\xb7\x01\x76\xed\x5b\xe3\xbc\xc9\x8f\xda\xa8\xde\x99\x5f\x8f\xf3\xae\x17\xa5\xfc\xa4\x4a\xd7\x12\x9c\x1a\x70\x5c\xf2\xb9\x3e\x01\x2a\x6d\x89\x25\x18\x91\x5c\x02\x95\xe9\x9d\xc2\x1d\xac\x0f\xad\xc1\x1c\xf7\xa7\x5f\xad\x23\xb4\x62\x98\x76\xb7\xff\xb1\xdf\x55\x99\xe5\xc2\x52\xf9\x90\x2e\x3a\x2f\xa0\xfe\x33\x68\xc7\xa4\x2e\x5f\x87\x47\x6a\xc6\x19\xee\x0b\x90\x03\x1d\x96\x7e\xc1\xd0\x21\x1e\xea\x9c\x77\x94\x87\xd6\xb6\x84\x56\x44\x8b\xc3\x8a\xb0\x48\x38\xa4\xb9\xd8\xa8\x48\x6a\x4d\xcd\x2f\xc3\xc9\x67\xbb\x45\xe4\xef\x61\x7b\x7a\x34\xdf\x9f\x25\x10\xc2\x54\xfc\xfe\x1f\x08\x88\x7c\x51\x3f\x83\xef\x36\x2c\xa8\xea\xc3\x4d\xd5\x5d\xb6\xac\x72\xc6\x4f\xd6\xd6\xbf\x35\xfb\x77\xf1\x4e\x30\xfd\x62\x8c\xf6\xb8\xca\x71\xe9\xbf\x94\xf8\x63\xb1\xfa\x4b\x70\x1f\xd5\x43\xbe\xc2\xfe\x9e\x9d\x60\x62\x99\x63\xfb\x76\x78\xed\xcc\xee\x8d\xb1\x71\x63\xde\x2b\xad\x7f\x9e\x8e\x66\xd7\x93\xe9\x2f\x76\xdf\x18\x99\x7f\x48\xa4\x7b\x4c\xf1\x02\xd4\x5d\xdb\x67\xee\x5f\x33\xfe\x8b\x70\xb1\x0f\xa8\x1c\x7c\xb7\x52\x86\x16\x67\xcf\xeb\x46\x85\xdd\x3a\x8e\x35\xb3\xa6\x93\xff\x6c\xb0\x76\x2c\x47\x19\xff\xb4\xa4\xfb\x32\x79\xd1\x4e\xdf\x3e\xcc\x40\xb4\x70\x18\xb3\xfd\xe4\xc7\x93\x76\xda\x63\xd6\x42\x38\x57\xb0\xe1\x79\x8c\x0a\xfd\x13\x86\x05\xf0\xd6\x03\xcf\x27\xc3\xbc\x31\xa7\xb3\xac\xc2\x69\x62\xdb\x51\xd7\xb3\xbd\x6e\x7e\x3e\xd6\xbe\xc5\x64\x35\x97\xf9\x69\x1b\xec\xfc\xd7\xf7\x7f\xbf\xec\xe2\x90\x74\xa5\xba\xa9\x10\x9d\xfd\x8c\x92\xea\x61\x3a\x73\xbe\x5a\x8d\x8e\xd2\xd9\x6c\xf6\x2d\x23\x29\x19\xb8\xaa\x35\xb7\xdb\x23\x58\x58\xc6\xd4\xb8\xff\x3e\x1e\x16\xe6\x38\x50\x4c\xea\x3a\xee\xe3\x00\xfc\xbb\x72\xc9\x54\xd1\xeb\x2b\x74\x8f\xf9\x23\x70\x24\x97\x58\x22\x17\x47\x02\x04\xc2\x88\x43\x51\x57\x17\x87\xac\xc4\x5a\x28\xd9\xff\xde\x21\xc1\x12\x2c\xf5\x92\xc2\x13\x4a\x8e\x2d\x51\x52\x99\xa9\x83\x1b\x56\x93\x06\xd0\xd4\x0d\xea\x1b\xf7\xe6\x68\x76\xd7\x56\x14\x76\xd4\x21\xa9\xba\x22\xb5\x9e\x01\xac\xd2\xfa\x73\x8f\x7f\x7c\x31\x66\x03\xf3\xe3\xe7\x9b\x16\x9a\x6d\x68\x33\x55\x2a\x37\xe2\x5e\x9c\x9d\x5d\x28\x79\x8e\x90\x26\xeb\x33\x22\x15\x4e\xb5\x7e\x4f\xf6\xf6\xe8\x2e\x64\xc6\x73\x87\x44\xb9\x1b\x59\x17\xc7\xf0\x9f\xf0\x5a\x64\xec\xcb\xdd\xca\xcd\x66\x27\x0c\x25\xe6\x32\x52\x39\xea\xa1\x72\xdf\xa9\x2e\xa7\x8b\x37\xd5\xad\x45\xc5\xd8\x0d\xc8\xdd\xfd\x26\x8c\x0f\x29\x9d\xee\x12\xb0\x2f\x97\x65\x4d\x67\x43\x18\x3d\x74\x79\x7e\x79\x5e\x79\x11\xee\xbf\x29\xb4\x4b\x02\xe8\xbb\x77\x81\x19\xbe\xfa\xa4\xed\x71\x67\xc9\x41\x2c\x99\xef\xb5\x90\xb9\xde\x01\xdd\x6e\x1b\x77\x34\x9f\xac\x80\x82\x10\x2f\x58\xfc\xee\xb4\x48\xf6\x2f\xd1\x4a\x9c\x70\x56\xe7\xdd\x55\x32\xc4\xb1\x03\xa3\x68\xde\x02\xf6\x2a\x2d\xb5\xaa\x93\x1a\xae\x0b\x61\x7d\xd3\x4e\xfd\xf3\x54\xc2\xb3\xec\x86\x3e\x26\x34\xdf\x7b\x92\x4b\xc1\xbd\xe6\x4d\x9a\xe8\x04\xfb\x03\xf0\xf1\x3a\x37\xc0\x2f\x67\x67\x8d\x1a\xa9\x59\xea\xfd\xd9\x41\x1b\x54\xd2\xfc\x14\x7c\xfc\x0c\x5e\x26\xc9\xf9\x45\xe6\x9d\x17\x35\x97\xdc\x83\x52\xe1\x27\x49\x00\x2c\x92\xb9\x38\xe7\xcd\x62\xab\x5b\x46\xf2\x42\x4b\x7e\x8f\x1b\x37\xea\xf2\xbc\xac\xa2\xd2\x10\x52\x66\xd9\xbc\x91\x5a\x1b\x35\x6a\x1c\x38\xda\x87\xb6\x2b\x4b\x82\x16\x80\xe4\xc4\x15\x6d\x98\xff\xf8\xf0\xe1\x1f\x0d\x98\x21\x67\x01\xc8\x25\x44\xad\xc8\x97\x1f\x3e\x5c\x36\x20\x7f\x63\x3e\x7b\x24\x38\x37\xe6\x9e\x24\x59\x23\xa7\x12\x6c\x03\x39\x0f\x1e\xa2\x45\xa3\x65\x9f\x18\x7f\x24\x74\x31\x20\x7c\xa7\x9f\x7a\xfc\xa5\x41\xb2\x67\xbd\xa4\x21\xee\x66\x33\x55\xbb\xa4\xd2\x46\xdc\x4b\x68\xa5\x28\x47\xb6\xee\x1a\x78\x66\xdd\x2e\xf1\x12\xae\x59\x4b\x3f\x4b\x10\xed\xd7\x1d\xaf\x50\xbf\xb1\x31\x9b\x56\x05\x5e\xda\x21\x51\xc6\xa4\xe0\xca\x6a\x66\xcb\xe4\xfc\x61\x57\x1b\xfb\xee\x4e\xbe\xfb\x32\x26\x4f\x3b\xbb\xce\xf5\x0a\xd9\x20\xd1\x3f\x99\x8d\x5c\x1f\x0b\x81\x24\x43\x9d\x9b\x08\x73\x4c\x25\x80\xd7\x41\x6f\x92\xb1\x2c\x74\x75\x95\x8f\x5d\xbd\xad\xa0\x3b\x4b\x22\x90\xc7\x40\xd0\x53\x19\xbb\xaa\x6a\x60\x4f\xec\x09\xc2\x42\x69\x8e\x43\x5c\x22\xa1\x39\x79\x06\x0f\xc5\x45\x53\x05\x7d\xce\x59\x90\x8c\x7e\x29\xd6\xd9\x58\x18\x7a\x73\x79\xf6\x37\xe4\x46\x9c\x03\x95\xfe\xfa\xad\x8e\x4e\x33\xee\xa7\x8a\x1e\x49\xee\xe9\x13\x06\x25\x7a\x0d\x63\x65\xcd\xa3\x65\xe5\x91\xb1\x03\x67\xf8\x69\x46\x53\xbf\x8f\xe7\xd1\xaa\x07\x30\xf5\x71\xc3\xe8\x98\xe6\x45\x41\xa8\x6f\x7d\x1e\xc5\x4b\xee\x7c\xb8\x38\x0b\x3a\xbb\x14\xb3\xa5\xb6\x08\x7c\x34\xab\x44\xe6\x69\x42\x31\x9b\xa4\xfb\x31\x4b\x40\x9d\x5f\x77\xa5\x4f\x7c\xb3\x24\xf8\xa1\x04\x94\x3c\xbf\xc7\x3b\xd7\x5b\xcd\xc7\xf9\x9d\x9c\x74\x30\x23\x1d\x47\x3c\x43\x4f\xa9\xbf\x30\x49\xd5\x42\xbf\xf9\x5a\xf6\x38\x51\x1a\x89\xb4\x5c\x18\xd4\xa4\x68\xcc\x0f\x49\x17\xa4\xca\x38\x79\xd6\x38\x28\xa8\xe5\x49\xa3\x9a\x85\x8e\x4a\x72\x2f\x5c\xa7\x8b\x1b\x92\xd2\x11\x59\x1f\x82\x50\xae\xe3\x0d\x71\xb3\x9b\xd6\x24\x27\x8b\x45\x5e\x5e\x6a\xe9\x0c\x68\x32\x72\xd8\x5f\xaa\x1b\xd1\x7d\x87\x1a\x2d\x99\x7e\x48\x80\xe2\xb3\x66\xc9\x91\x71\x24\x59\x80\x25\x71\x7b\xea\xa2\xae\x90\x25\xdf\xdc\x95\x2e\x4b\xf0\xda\xae\x5a\xf3\x37\xf3\x9d\xe6\x56\x32\x87\x1e\x0f\x63\xd8\x92\x03\x0e\x1c\x5c\xde\x77\x13\x5d\x9c\xc6\x32\x07\x38\xbc\xc5\xe2\x0e\xd6\xf1\x19\xa8\x8a\x22\x50\x27\x61\xd4\x51\x83\xbe\x84\x7a\xf0\x7c\x10\x2a\x29\x4b\x77\x04\xed\xa9\xf1\x58\x91\x1d\x9f\xca\x89\x22\xbf\x8c\x89\xe5\x69\x18\xcd\xcc\x80\x13\x9d\x0f\x0b\x6d\x9e\xec\x0c\xe0\xc7\x8a\xde\x3b\xd9\x5d\x12\xb9\x36\xd4\xdd\x7c\xf9\x9e\xcf\x00\xe5\x16\x68\x9a\xe1\x39\x34\xe3\x73\xe4\xac\xee\x91\xc3\x3f\xe5\x19\x5d\x89\x17\xa9\x64\x99\x73\x77\x12\x2d\x77\x4e\x9a\x9c\xa2\xd5\x25\x52\x87\x68\xb6\x5a\x71\x8a\x2e\xa2\xe2\xe4\x55\xbc\x2f\x63\xce\x22\xea\x21\x17\x07\xe0\x6b\x8f\x79\xd5\x5c\x35\x4c\xc9\x0a\x49\xc8\xdc\xe3\xb0\x66\x03\x4c\x29\x93\xe9\xf4\x67\x2a\x16\x61\x7a\x26\x50\x37\x0a\x17\x1c\x7b\xa0\x05\xcc\x8b\x6b\x37\x08\x7f\x52\xfb\xd4\xbc\xa9\x38\x0f\x68\x78\x01\x54\x16\x3b\x4e\xb1\xf8\x12\x4c\x7a\x81\xbd\x0e\xfc\x1e\xfa\x1f\xed\x64\x4f\x19\x61\xe5\x08\xfa\x34\xf2\xd5\x89\xf0\xa4\x5e\x09\xa7\xfd\xae\xa4\x8d\x95\x6d\x33\x68\xbb\x3d\x79\x85\x6c\xc7\x98\x3a\xbd\xb8\x95\xa4\xdd\x9d\x68\xa9\x75\xa6\xcc\x57\x49\xa8\x6c\x3b\xfe\x80\x5d\x3d\xad\x5c\x7f\x8f\xcd\xa3\x3f\x5e\xc6\x5a\x58\x9d\xab\x41\xba\xf3\x3d\xc1\x94\x7a\xc4\x4f\x6a\x24\xae\x74\xa6\xc4\x8d\x1d\xf5\x86\xb3\x28\x4c\xe5\xd3\x12\x5f\xd6\x71\x88\xdd\x25\xe8\x8c\x2f\xd2\x2f\x50\xa4\x35\x4a\x06\xd5\xf9\x8f\x24\xca\x56\xc0\x1f\x44\x0f\xfd\x17\x5a\x80\x7c\x87\x7c\x22\xe4\x3b\x94\x7c\x33\xe0\x1d\x8a\x42\x2f\xfe\xe9\x81\x0f\xc5\xcf\xb4\x97\x4a\x18\x7d\x87\x9e\xd4\x04\xe1\x7f\x57\xf4\xff\x91\x50\x75\x55\xf7\x6f\x61\x06\x11\x3d\xa8\xf1\xfb\xd4\x12\x95\xef\x4e\xa5\xdf\x3a\x28\x2d\xa5\x8e\xce\x99\x0f\xf9\xc5\x4d\xc5\x83\x9b\x96\x9f\x19\xba\x45\x99\x7f\x46\x20\xe4\x62\x3f\xaa\xd1\xfc\x15\x68\xaa\xa7\x02\xfc\x2f\x17\x18\xea\x91\x02\x53\x43\x36\xe9\x52\x74\x0f\x56\x4d\xd1\x91\x83\xba\x20\xf6\x06\x49\xea\xfa\x7b\x38\xc1\x4a\xdd\x8a\x1f\xc7\xca\x5d\x62\x4a\xc1\x3f\xc8\xea\xcf\x8b\xb2\x5c\x8f\x7f\x09\x1b\xff\xe9\x51\xd7\xa6\x8e\xcc\xd6\x2d\xca\x3e\x79\x85\xcc\xf1\x20\xdf\x9c\x36\x1b\xa0\xde\x76\x7b\xf2\xbf\x03\x00\x50\x57\x66\x06\x8c\x39\x00\x00"),
 		},
 		"/infrastructure/05-syndesis-security.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "05-syndesis-security.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 7303,
+			uncompressedSize: 4647,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x3d\x73\xf3\x36\x0c\xde\xfd\x2b\x78\x19\x7b\x8e\x75\xdd\x7a\xde\xda\xa5\xfb\xdb\xbb\x2e\xbd\x0e\x10\x05\xcb\xa8\x29\x82\x25\x21\xa7\xc9\xaf\xef\x49\xb2\x6c\xea\xc3\x1f\x91\x1d\x25\xed\x3b\x59\x02\x20\x12\x78\x00\xc2\x78\xf8\xac\xc0\xd1\xef\xe8\x03\xb1\x5d\x2b\x9f\x82\x5e\x41\x29\x5b\xf6\xf4\x06\x42\x6c\x57\xbb\x9f\xc2\x8a\x38\xd9\xff\xb8\x50\x6a\x47\x36\x5b\xab\x6f\x6c\x70\xa1\x54\x81\x02\x19\x08\xac\x17\x4a\x29\x65\xa1\xc0\xb5\x0a\xaf\x36\xc3\x40\xe1\x19\x33\x12\xf6\xb5\xc6\x40\x8a\x26\x34\x56\x4a\x81\x73\x27\xb3\x83\xac\x7d\xad\xf6\xb9\xa6\x97\x57\x87\x6b\x45\x76\xe3\x21\x88\x2f\xb5\x94\x1e\x47\xcc\x34\x17\x8e\x2d\x5a\x89\x7c\x0a\xe8\xf7\x58\xf9\xe4\x4b\x83\xb5\x43\x75\xf0\xbf\x7a\x2e\xdd\xc1\xbf\x67\xa5\xa1\x40\xb3\x02\x07\x7a\x8b\x2b\xf6\x79\x2d\xf6\x18\xb8\xf4\x1a\x8f\x56\x4f\x3f\x3c\xd5\x4f\x7b\xf4\x69\x58\xab\x3f\x54\x8e\xb2\x54\x86\x82\x2c\x95\xf6\x08\x82\x4b\x55\xba\xac\xfe\xcd\xd0\xe0\xe9\x57\xb3\x31\xa8\x2b\x64\x97\xea\x05\x44\x6f\xd5\x9f\xe3\x8e\x3c\x3d\x8d\x6f\xed\x38\x0b\x87\xc7\x2a\x20\xd2\xd8\xbe\xa2\xcd\x1c\x93\x95\xf6\xdd\x55\x59\x0d\x82\x56\xf6\x6c\xca\x02\xb5\x01\x2a\x5a\xa5\x66\xbb\xa1\xbc\x00\x77\x5a\x4c\x7b\x94\xde\xd2\xa0\x35\x97\xed\x8a\x9f\x10\xac\x47\x67\x48\xd7\x85\xa8\xd9\x8a\xaf\xd6\xf3\xe1\xa2\x32\x09\x1a\x0c\x3e\xca\xe1\xa5\x72\x97\xfc\x06\x77\xc0\x6f\xe0\x79\x06\x58\xb0\x0d\x27\x44\x33\x74\x86\x5f\x0b\xb4\x63\x92\xc8\xe9\x63\x5c\xd1\xb7\x91\xa4\x63\x19\x04\x04\x37\xa5\x89\x4c\x63\xd1\xac\x50\xe0\x3f\x82\xb6\xea\x22\x8f\x07\x84\x6c\xee\x31\x84\x63\xa1\x5b\x94\x17\xf6\x3b\xc7\x86\x34\xe1\x08\x48\x57\x60\xfb\x02\x85\x73\xae\xe0\x53\xb2\x19\xd9\xfc\x78\xa4\xf7\x11\x3c\x86\x0a\x12\x0f\x36\x3f\x01\x01\x05\x06\x07\x1a\x43\x52\xe5\xbd\x0c\x51\x8f\x48\x0c\xe7\xf1\x6b\xc7\xe0\x1c\x02\x5d\x9b\xc6\xb7\xbf\x4b\x16\x18\x17\xc6\x1f\x8c\x61\x76\xcb\x99\x7f\x56\x69\x49\x26\x5b\xb1\x43\x1b\xb6\xb4\x91\x15\xf1\x19\x6c\x2a\xbb\xa6\x6f\x85\x11\x51\xf2\x82\xe9\x96\x79\xd7\xd1\x3d\xac\x73\xdd\x96\xcf\x69\xc1\x24\x64\x83\x80\x15\x02\xc1\x2b\xea\x94\x2c\xf8\xd7\x4e\x84\x89\x36\x6c\x7b\x75\xdb\x04\xf7\x58\x67\x43\x92\xa1\x00\x99\x1e\xa4\x0d\x7e\x8f\xde\xaa\x2d\xde\xe9\x55\x55\xb5\xe6\x1b\xf6\x3b\xf5\x9c\x6e\x65\x0d\xe4\x9d\x0e\x32\xd4\x6e\xc8\x82\xa1\xb7\xf6\xdf\x69\xc6\x8a\x9b\x18\x68\x75\xe6\x53\xd0\xbb\xf3\x01\x0f\xab\x72\x68\xd3\xae\x32\xa9\xfc\xa6\xa6\x28\x6a\x6d\x23\x69\x7a\x44\x4b\xa2\x02\x72\xbc\xc1\xb5\xda\x2e\x88\x47\x38\x0e\x56\x91\xa8\x79\x1c\xca\x0b\x70\x2e\x6a\xf2\xf1\x22\x49\x77\x0c\x8b\x54\x02\xf9\xec\xa5\x35\x01\x06\x2a\x1c\xfb\xfe\xc0\x78\x63\x3d\x4c\x41\xfd\xae\x7c\x7b\x2e\xe5\x96\x0d\x6b\xbb\xd9\xd1\x17\x2c\x9c\x81\x9b\x1c\x74\x9e\x75\x35\x21\x65\xed\x37\xa1\xb7\x46\xb7\xb9\xb5\xd2\xe6\x84\xeb\x81\xf5\xd7\xfe\xd7\x34\xfc\x11\x27\x61\x31\x91\x09\xff\xd2\x4c\x6c\xd7\x08\x71\x43\x3e\xbf\x36\x2f\x0e\x65\xfa\x17\x6a\x39\x50\xe3\x26\xc4\xdf\x1a\x22\xf8\x73\x43\x04\xcf\x47\x56\xd1\x6a\x36\xf8\x0d\x37\x4d\x44\x9d\xab\x82\x4b\xd7\x03\x6d\x51\x5c\x80\x7c\x31\x39\x39\xd7\xb2\xb2\x27\x7c\xc1\x19\xd3\x71\xe9\xe6\xe1\x1c\x25\x18\x30\xf5\x77\xf1\xfc\xe8\xba\xe0\x3e\x32\x3d\x7e\x27\xd0\xbb\x84\x68\x4e\xe4\x41\x93\xa3\x1c\x99\x4b\x68\x1f\xeb\x53\xf7\x7d\x71\xa2\x89\x70\xfc\xc7\xee\x16\x26\x46\xf9\xff\xbb\x36\xb8\xaf\xfa\xe7\xa3\xc3\xb3\xfa\xd9\x67\x74\xf7\x6e\xfe\x81\xec\xee\x73\x1d\x7c\x1f\xb7\xb9\xd7\xcb\x49\x03\xfe\xbb\x49\xcd\x50\xd4\x23\x33\x9f\x10\xc6\xe3\xc0\x9c\x4c\x22\xee\x8d\xfa\x6b\xf0\x83\x59\xdb\x48\x6f\xf4\xbf\xbe\xf7\x07\x4f\xf5\xce\x73\x81\xb2\xc5\x72\xfe\x51\xb2\x6f\x36\x36\xd9\x9f\xdc\x9b\x3c\xdd\x77\x96\x78\xc7\x84\x1f\xc1\x71\xcb\x84\xff\x6f\x00\x00\x00\xff\xff\x9d\x16\x8a\xbb\x87\x1c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcd\x8e\xeb\x36\x0f\xdd\xe7\x29\x88\xcc\xe2\x03\x3e\x24\x0e\xba\x2b\xb2\xeb\x1f\xba\x2d\xee\x2d\xda\x45\xd1\x05\x2d\x31\xb6\x1a\x59\x12\x44\x3a\xd3\x74\x30\xef\x5e\x48\x76\xe2\x8c\xf3\xdb\x74\x32\xbd\xab\xd8\x14\x23\x9d\x73\xc8\x63\x88\x73\xc0\x60\x7e\xa1\xc8\xc6\xbb\x25\xc4\x12\x55\x81\xad\xd4\x3e\x9a\xbf\x50\x8c\x77\xc5\xfa\x6b\x2e\x8c\x5f\x6c\xbe\x9a\x00\xac\x8d\xd3\x4b\xf8\xe4\x2d\x4d\x00\x1a\x12\xd4\x28\xb8\x9c\x00\x00\x38\x6c\x68\x09\xbc\x75\x9a\xd8\xf0\x9c\xb4\x11\x1f\xf3\x8a\xc5\x92\x2c\x77\x59\x00\x18\xc2\x90\xd6\xc7\x76\xaf\xe9\x9c\x6b\xeb\xb2\x0d\xb4\x04\xe3\x56\x11\x59\x62\xab\xa4\x8d\x74\x22\x4d\xf9\x26\x78\x47\x4e\x86\xcd\xe6\x4c\x71\x43\x09\xd3\x13\xfc\x5a\xa3\x80\xd4\x04\x5d\x0c\x1c\x91\x66\x10\x0f\x65\x6b\xac\x06\x74\x1a\x34\x05\xeb\xb7\x39\xc9\x38\xa1\x2a\x66\x39\x78\x96\x23\x5e\x6a\x8a\xb0\x3f\x24\x01\x7d\x02\xed\xdd\xff\x04\x2a\x12\x40\xb7\x85\xe8\x2d\x15\xf0\x73\x4d\x59\x1a\x30\x0c\x6b\x0a\x02\xec\x81\xfe\x34\x2c\xc6\x55\x50\x1a\xa7\x8d\xab\xd2\x0a\x05\x78\xf6\x71\x6d\x5c\x55\xe4\xbd\xd2\xff\x92\xb8\xb0\x31\x51\x5a\xb4\x7d\x35\x00\xb5\xf6\x0e\x62\xeb\x18\x90\xc7\xd4\x40\xbc\x5f\x66\x7c\xb1\xb5\xc4\x07\x04\x7b\x7c\xc4\x09\x61\xcb\x04\x65\xdb\xf1\x7f\xbb\x3d\x83\x91\x4e\x02\x3e\xd0\x80\xa1\xc1\x2d\x60\xa4\x8e\xc1\xca\x47\x30\x92\x60\xe6\x53\x52\x61\x73\x13\xfd\x18\x7d\x1b\xfa\x3a\xcf\x41\x61\x43\xb6\xc0\x80\xaa\xa6\xc2\xc7\x2a\x87\x23\xb1\x6f\xa3\xa2\x7d\xd6\xf4\xff\xd3\xfc\xb4\xa1\x58\xf2\x12\x7e\x4b\xea\xcd\xc0\x1a\x96\x19\xa8\x48\x28\x34\x83\x36\xe8\xfc\xab\xc9\xd2\xf0\xab\xbc\xb5\xa4\x12\xe8\x19\x3c\xa3\xa8\x1a\x7e\x3f\x0d\x64\x3a\x3d\x7d\x74\xf0\x9a\xfb\xc7\xa4\x9e\x51\xb4\x7b\x55\xde\xad\x4c\xd5\x60\x18\xd6\x55\x24\xe9\xde\x9e\xe0\xfb\xe3\xb2\xf4\x79\x21\xd9\x88\x85\x9c\x6c\xbc\x6d\x1b\x52\x16\x4d\x33\x6c\x92\x0f\x41\xa5\x7c\xeb\x84\xff\x2b\xda\x91\x82\x35\x2a\x37\x93\xf2\x4e\x62\xda\x2f\xf2\xc5\xc5\x05\x2b\xb4\xf4\x5e\x80\x67\x10\x2e\xe1\xc6\x10\xf8\x34\xf2\xce\x90\x4d\x6f\xb7\x51\xe4\x00\xe3\x9e\x06\xd3\xfb\xc9\x7c\x05\xf5\x39\xb5\xc9\xe9\xe0\xcd\x80\x98\x36\x07\xf0\x53\x07\x2e\xac\xaf\x0e\x5f\x59\x50\x5a\x7e\xcb\xe3\xa8\x1c\x43\xce\x29\x66\xb7\xb4\xc5\xbc\x73\x79\xe1\x03\x39\xae\xcd\x4a\x0a\xe3\x4f\x33\xc8\x79\x9d\x23\x76\xb0\x72\xe8\x83\x95\xbd\x0f\xf0\xc2\x38\x16\x74\x62\x50\xe8\xca\x72\x69\x1c\xc6\xed\x61\x12\x2f\x94\xf5\x6e\xd4\xf7\x1d\xb9\x0c\xf6\xdc\x97\xe0\xdd\x48\xf0\x42\x93\xa0\xb1\x23\xa9\x3b\x5d\xdf\x57\xaf\xa1\x0f\x4f\x55\xf4\xb6\x8e\x4a\xce\xbd\xe1\xbc\xc1\xb4\x7d\x15\xce\xc5\xdf\x58\xfa\x78\x75\x65\x5c\x52\x9d\xe2\x48\x9e\xc7\x77\xe2\xbd\x44\x4f\x74\xe3\x71\x4e\x32\x79\x89\x6a\x7d\xb6\xed\x1e\x82\x6c\xf8\x0a\x1d\xaf\x1d\x7c\x92\xee\x6f\x0e\xd3\x60\x45\x37\x40\xcb\x79\x2c\x91\xb0\xe1\xe3\x90\x60\x75\x1e\xc8\xbf\xa8\xf6\x3d\x56\xbe\x83\x91\x69\x82\x8f\x32\x62\x70\xb9\xb4\xd1\xb7\x72\xcb\x29\x39\xef\x8b\xd1\x46\xa8\x09\x16\x6f\x02\x1e\xa2\x57\xc4\x4c\x7a\xf7\x1f\x1e\xed\xd1\xb7\xe1\x28\xda\x59\x49\xd1\x38\xfe\x00\x09\x26\x77\x8e\x48\xdf\x76\xb7\xfb\x6b\x93\x52\xba\x17\x52\xfc\xb2\x07\x26\x6e\xcb\x3f\x48\x49\x2e\xda\xbc\xa7\xf8\xb9\xbb\x34\x7f\xd3\xdd\x67\xcf\x33\x4b\x73\x82\xb7\xf4\x89\x56\xe9\xdf\xa3\x19\xf2\xd2\xdc\xb8\x6b\xaf\x0b\x92\x4f\x26\x93\x07\xcd\xaf\x1b\x43\xcf\xf4\x81\xf3\x6b\x3f\x92\x86\xe8\x1b\x92\x9a\x5a\x1e\x46\x52\x6d\x58\xf9\x3c\xdd\xd5\x94\x2f\x89\x29\xc8\x2a\x62\xa0\x2b\x33\xd8\x74\x7a\xda\x73\xe3\x5b\xe9\xb9\x51\xa8\xb3\x51\xbf\x52\x91\xf4\x4f\xc9\x4f\xfd\x63\xb6\xca\xe4\xe5\x65\x0e\x66\x05\xc5\xe7\x1d\xcf\xef\xf6\x83\x71\xf1\xd3\x9e\x51\xf1\x83\xc3\xd2\x92\x7e\x7d\x7d\xb4\xa5\x06\x15\x3f\xbc\x8e\xb7\xd8\x6a\x80\x77\xb7\xb5\xde\x6c\xf1\x0f\xec\x75\x20\xc7\x2d\xf6\x7a\x79\x99\x03\x39\xfd\xfa\x3a\xf9\x7b\x00\x92\x17\x15\x1c\x27\x12\x00\x00"),
 		},
 		"/infrastructure/06-syndesis-prometheus.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "06-syndesis-prometheus.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6035,
+			uncompressedSize: 12801,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\xeb\x6f\x13\xb9\x16\xff\x9e\xbf\xe2\xa8\x17\xa9\xad\x20\x69\x01\x81\x2e\xbe\xaa\xd0\xa5\xdc\x8b\x90\xb6\x34\x4b\x11\xfb\x81\x65\x47\x27\x9e\xd3\xc4\xc5\x63\x7b\xed\x33\xd9\x46\x25\xff\xfb\xca\xf3\x48\x66\x92\x49\x5f\x6a\xb4\x08\xbe\xd0\xd8\xe7\xfc\xce\xfb\x31\xee\x03\x3a\xf5\x99\x7c\x50\xd6\x08\x98\x3e\xed\x01\x7c\x53\x26\x15\x70\x6c\xcd\xb9\x1a\x9f\xa0\xeb\x01\x64\xc4\x98\x22\xa3\xe8\x01\x00\x68\x1c\x91\x0e\xe5\xdf\x00\xe8\x9c\x80\x30\x33\x29\x05\x15\xaa\xb3\xfa\xe7\x40\xd9\x83\x9b\xee\x79\xe6\x48\x80\x32\xe7\x1e\x03\xfb\x5c\x72\xee\xa9\x83\x4c\xda\xcc\x59\x43\x86\x97\x60\x7d\xe7\x6d\x46\x3c\xa1\xbc\xc4\x35\x98\x51\xe7\x6d\x5f\x16\xb6\xf4\x00\x96\x46\x2c\x6f\x07\xb3\x4c\x0b\xf8\xde\xaf\x84\x8e\xb5\x1d\xa1\xae\xad\x03\x08\xd2\xa3\xa3\x44\x19\x26\x3f\x45\x2d\x8a\xc3\x17\x61\x71\x4f\x53\xd4\x39\xb2\xb2\xa6\x41\xf3\x22\xf4\x7a\x2d\xf6\x52\x83\xb0\x84\xed\xc3\x85\x1d\x25\xa5\xca\x2b\x76\x54\x8c\x8c\xac\xe4\x3a\x63\xc9\xcc\xe8\xc7\xc4\x2b\xc7\xf1\x42\x5b\x89\x7a\x62\x03\x8b\x57\x87\xaf\x0e\x7b\x8d\xfb\x8c\xd8\x2b\x99\x78\x2a\xe2\xd7\x05\xdc\x87\x60\x73\x2f\x29\xa9\x22\x0c\x5f\x92\x42\xc3\x24\xf9\xda\x92\xe3\x69\x4c\x97\x02\xc6\x36\xd9\x1b\x3c\xde\x6f\x5d\xa1\xe4\x22\x91\x52\x6f\xdd\xfd\x91\x27\xcc\x6e\x5b\xd8\x86\x78\x5b\xd0\xce\x5b\x49\x21\x6c\x11\xbe\x4a\x93\x6d\x49\xe0\x90\x8e\x6e\xc0\xee\x4c\xe0\x98\xf8\x63\x5f\x14\x41\xdf\xd9\x34\x34\xd3\xee\x5b\x3e\x22\x6f\x88\x29\x24\x21\xed\xce\x3a\x6f\x75\xac\x02\x9b\xb6\xc4\x46\xe8\xe0\x50\xd2\x5a\x96\x17\x37\xab\x87\x11\xe8\xea\x6a\x70\xea\xc8\x9c\x4d\xd4\x39\x0f\xbd\xbd\x20\xc9\xf3\x79\x53\x99\x3b\x26\x7f\xec\x7b\x49\xc3\x00\x67\xd3\x04\x8d\xb1\x5c\xd6\x7b\x23\x20\xca\x26\x65\xa5\x7f\xed\x74\xdd\x37\x22\xd7\xe9\x70\x9f\xd3\x3d\x74\x28\x8d\xa8\x3b\x5d\x14\x1e\xbb\xe8\xdd\x44\x37\x62\xf6\x90\x5e\x70\xc8\x93\x6e\x45\x3c\x39\x8d\x92\x5a\x77\x65\x1b\x2b\x85\x09\x28\x44\x79\x25\x43\x81\x92\x24\x5d\x6a\xaf\x64\x67\x97\xbe\x98\xa6\x3e\x96\x61\xf2\x04\xee\xaa\xbc\xf5\x7c\x7b\xe5\x6b\x8d\xbe\xfc\x21\xbe\x3e\xde\xdf\x7b\x2d\xc4\xef\xe9\xe3\xfd\xd7\xff\xd9\x8b\xff\xad\x50\x16\xdc\x59\x31\xbe\x1e\x3d\x15\x8f\x9e\x5d\xeb\x85\x85\x01\x2d\x43\x6b\x55\x0a\xb2\x0c\x3b\x83\x7a\x5d\xba\xac\x79\xee\xfe\x80\x0d\x07\xee\x2d\x86\xf4\x8d\x71\x59\x45\x5a\x14\xf8\x7d\xf3\xa5\x0b\xeb\x1e\xb9\x1c\x79\x1f\x40\x85\x1a\x6a\x9b\x23\xf7\x22\xbb\xdc\x56\xef\xbf\x98\x66\x3f\xee\x5c\x8c\xed\x2d\x56\x73\x97\x90\x40\x0e\x3d\xb2\xf5\x02\x76\xc5\x6e\x97\x7c\x69\x0d\xd3\x25\x8b\x3d\xeb\xc7\x09\x3a\x94\x13\x4a\x24\x66\xa4\x93\xff\x5d\xca\x09\x9a\x31\x85\x4f\x96\x51\x7f\xdf\x7c\xff\x7f\x54\x9a\xd2\xef\xb1\xcd\xd7\x5d\xb7\xa4\x38\x63\xf4\xfc\x49\x65\x14\x18\x33\xd7\x41\xf0\x0b\x06\xae\x61\x8e\x6d\xe6\x34\x31\xa5\xb7\x65\x88\x62\x73\x4f\x0b\xf2\x6e\xf7\x15\x2d\xbe\xb7\x71\x93\x3f\x23\x3f\x55\x45\x16\xb7\xf7\xf8\x8d\xfb\xf2\x0f\xbc\xe5\x07\x47\xb2\x5a\xe0\xad\xaf\xf7\xdf\x3e\x6c\xd8\xa3\x23\x8d\x80\x7f\x1f\xd6\x3f\xbd\x65\x2b\xad\x16\xf0\xe9\x78\x58\x9d\x95\xe5\x3c\x2c\x08\x8b\x8d\xb9\x4c\x29\x4d\x32\x66\xd4\x03\x59\x7f\xb3\x59\x8c\x9c\x57\xd6\x68\x8b\xe9\x1b\xd4\x68\x24\x79\x01\x57\xf3\xcd\x81\x1d\xc6\xb3\xc0\x64\xf8\xb3\xd5\x79\x46\xc7\x1a\x55\xf6\x93\x85\x19\x65\x6c\x1d\x27\x36\xa5\x45\xb0\x3f\x12\xa6\xbf\x79\xc5\x74\x6a\xaa\xde\xec\xa9\x6c\x18\x0b\x3b\x3c\xfd\x99\x53\x68\x7e\x1f\x05\xb6\x1e\xc7\x24\xe2\x8e\x78\x56\xab\x72\x5c\xeb\x11\x06\xc3\xe5\xf7\xe0\xc7\x1a\x6d\x50\xb9\x35\xb6\x04\xc5\xb3\xf9\x6a\x28\xd0\xb9\x30\xb0\x8e\x4c\x88\x0b\x67\x34\xac\x11\x9c\xb7\xe4\xb4\x9d\xc5\x91\x7f\x5c\x7f\x7d\xfe\x4c\x71\x89\xa3\x51\x49\x0c\x02\x9e\xfe\x33\x25\x13\x43\xea\x91\x69\x3c\xab\x45\x96\x46\x7e\x24\xe9\x09\x99\x16\x99\xb0\x92\x1a\x00\x5a\x65\xaa\xfd\xe9\x9c\x51\x66\xfd\x4c\xc0\xce\xb3\x17\x2f\x4f\xd4\x4e\x6f\x39\x3f\x56\xd3\xa8\x49\x7b\x58\x93\x32\x65\x4e\x23\x53\x4d\xd6\x8e\xf3\x7a\x34\x37\xf9\xe7\x36\x3e\xba\x43\x64\xef\xe1\xd2\x66\x84\xcb\xa8\x16\xa3\xe3\xbf\x52\xda\xdc\xf0\x87\x6b\x33\x36\xfe\x8b\x93\x16\x95\x21\xdf\x7a\xe9\xb8\xe6\x95\x43\x65\x45\x51\xee\x5e\x5d\xc1\x4d\x65\xf9\x3e\x92\xc2\x7c\xbe\xbb\xca\x3e\xcc\xb5\x1e\x5a\xad\xe4\x4c\xc0\xfb\xf3\x0f\x96\x87\x9e\x02\x19\x6e\x7a\xdb\xaf\x3f\xa1\xec\xf6\xab\x77\xa1\xc1\xb9\xd2\x74\x74\x40\x2c\x0f\x96\x3a\x1e\xb4\x1f\x88\x76\xd7\x99\xab\x8e\x32\x88\x1f\xcd\x03\x4f\xb1\x0d\x2b\x6b\x8e\x9e\x1f\xa6\x4d\x62\xad\xa6\x64\x28\x84\xa1\xb7\x23\x6a\xab\x30\x61\x76\xef\x88\x57\x3f\x64\x5d\x7b\x16\x2d\x4c\x35\x8a\x15\xea\xb7\xa4\x71\x76\x46\xd2\x9a\x34\x08\x78\xd9\xa4\x69\x4c\xc4\x5a\xcd\x45\x3c\x86\x5d\xa0\x9e\x30\x55\xdb\x53\xee\x79\x93\xe6\x5f\xf0\xf6\x0d\xfc\x6a\xcf\x40\x6a\x0c\x01\x54\x80\x9d\x77\x39\x7a\x34\x4c\x94\xee\xc0\x5e\x5d\x6a\x70\x74\x54\x15\xe8\x7e\x8b\xfb\x83\x65\x12\x70\x6a\xe0\xf4\xec\x14\x78\x42\x9e\x22\x86\xb1\xb0\x44\x29\xa1\x9f\x80\xe2\x00\xa8\xff\xc2\x59\x80\x51\xee\x03\xe3\x48\x53\xcb\xea\xb5\x8e\x00\x9d\x5d\x01\x1a\xd5\x7e\x8b\xfc\x5c\x8e\x8d\x93\x82\x69\x3e\x5f\xd9\x45\xd7\x7b\xc9\x43\x4a\x98\x16\xb3\xea\x24\xd6\xe9\x4a\x12\x6c\x7e\x17\x8d\x4d\xaa\xa5\x4e\x16\xd9\x87\xc8\x13\x01\x07\x9d\xf5\x7a\x1d\xda\xe2\x95\xb5\x1b\xaf\x5d\x5f\xbd\xb6\xde\x1d\xfd\xe2\x16\x0a\xbb\xae\x05\xa8\xed\x60\x19\x8f\x6e\x6e\x5c\x77\xb2\x4b\xd6\x4f\xe3\x6d\x51\xb7\x42\x60\xaf\xc6\xe3\x45\x7f\xec\x57\x43\xab\x5c\x11\x8e\x8b\x9d\xbf\xf7\x77\x00\x00\x00\xff\xff\x69\x47\x13\xb3\x93\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\xfb\x73\xdb\x36\xf2\xff\xdd\x7f\xc5\x8e\xe2\x19\x3b\x53\x53\x4a\x9a\xb6\xf3\x2d\xbf\xe3\xe9\xb8\x4e\xfa\xb8\xc6\xb6\x4e\x72\x7b\x3f\xf4\x72\x1c\x08\x5c\x49\x88\x49\x80\x07\x80\x4a\x34\xac\xfe\xf7\x1b\x90\x04\x09\x3e\x64\x3d\x9a\xdc\xf9\x2e\xd2\x4c\x64\x62\xf1\xc1\xbe\xb0\xbb\x58\x30\xcb\x3c\x60\x73\x18\x4e\xd7\x3c\x44\xc5\xd4\xf0\x5a\xc4\x89\xe0\xc8\xb5\x1a\x8e\xa5\x88\x51\x2f\x31\x55\xc3\x37\x9c\xcc\x22\x0c\x37\x9b\x13\x0f\x48\xc2\x7e\x43\xa9\x98\xe0\x3e\xac\x5e\x9e\x00\x3c\x30\x1e\xfa\x70\x2d\xf8\x9c\x2d\x6e\x48\x72\x02\x10\xa3\x26\x21\xd1\xc4\x3f\x01\x00\x88\xc8\x0c\x23\x55\xfc\x06\x20\x49\xe2\x83\x2a\x97\x2b\x9f\xd9\x3f\x87\x4c\x8c\x76\x8d\xeb\x75\x82\x3e\x30\x3e\x97\x44\x69\x99\x52\x9d\x4a\xec\x21\xa3\x56\x8e\x1a\xcc\x4b\x2a\x81\xf2\x09\x9c\xc4\xd8\x3b\xea\xd1\x5c\x96\x13\x80\x5a\x88\x7a\x74\xb8\x8e\x23\x1f\xfe\xf0\xca\x45\x17\x91\x98\x91\xc8\x4a\x07\xa0\xa8\x24\x09\x06\x8c\x6b\x94\x2b\x12\xf9\xe6\x19\x7c\x6d\x25\x01\xc0\x15\x89\x52\xa2\x99\xe0\x0e\xcd\xd7\xea\xe4\xa4\x31\xbd\xe0\xa0\x52\x1a\x80\x07\xef\xc5\x2c\x28\x58\xae\x79\xa9\x86\x01\x94\x26\x9a\xd1\xee\x44\xf3\xf1\x40\x13\xb9\x40\xdd\x7a\x6c\x06\x22\x41\x49\xb4\x14\x4a\xfb\xdf\xbe\xf8\xf6\x85\xe5\xc2\x7c\x62\xd4\x92\xd1\x40\x62\x6e\xbf\x3e\x60\x0f\x94\x48\x25\xc5\xa0\xb4\x30\xfc\x1e\xe4\x1c\x06\xc1\x3b\x87\x0a\x40\xe2\x02\x3f\xfa\xb0\x10\xc1\xf9\xf0\x8b\xe7\x8d\x21\x42\x8d\x26\x7c\x08\xa5\x48\x8e\x47\x5e\x6a\x9d\x7c\x2e\x6c\x8e\xfa\x73\x41\x27\x52\x50\x54\xea\x33\xc2\x97\x6e\xf2\xb9\x56\xd0\x2a\x9c\xed\xc0\xee\x75\x60\xe3\xf8\x0b\x99\x6f\x02\x2f\x11\x61\xe5\xfc\xe6\xfb\x90\xce\x50\x72\xd4\xa8\x02\x15\xf6\x7b\x9d\x14\x11\xfa\x90\x88\xd0\x79\x0a\x60\xfc\x43\x25\x84\x62\x83\xba\x1a\x69\x3f\x34\x40\x59\x36\xbc\x4b\x90\x4f\x97\x6c\xae\xc7\x52\xbc\x47\xaa\x37\x1b\x97\x99\x03\x9d\xdf\xc4\xbd\xc0\x11\x20\x11\x61\x40\x38\x17\x66\x6b\x0a\x1e\x38\x06\x61\x22\x28\x02\xc5\xbb\x5e\xd5\x3d\x20\x26\xbd\x0a\x97\x29\x1e\xc1\x43\xce\x62\x60\x23\x5d\xc0\x44\xa0\xd7\x87\x2e\xed\xd8\xec\x08\x0e\xb6\x6a\x21\x21\x7a\xd9\xcf\x88\xc4\x24\x22\xd4\x15\x17\xca\x30\x56\x88\xeb\x43\x2e\xac\x64\x54\xe5\x28\x41\xd0\xc7\x76\xcb\x3b\xfb\xf8\x25\x61\x28\xcd\x36\x0c\x2e\xe0\x50\xe6\x85\xd4\xfb\x33\x6f\x39\xfa\xfd\x1f\xfe\xbb\x2f\x9e\x9f\x7f\xe7\xfb\x7f\x0f\xbf\x78\xfe\xdd\xff\x9f\x9b\xff\x5a\x94\xf9\xec\x38\x4f\x5f\xa7\x2f\xfd\xd3\x2f\x1f\xd5\x42\x25\x80\x43\xe5\x55\xac\xe4\x64\x31\xe9\x35\x6a\xbf\xbc\xf9\x8c\xf6\xbe\xfe\x33\x80\x8e\x02\xcf\xad\x17\xee\xb6\x4b\x1b\xa9\xda\xe0\xc7\xfa\x4b\x1f\xd6\x81\x3c\x18\x69\x0c\x1f\x9f\x80\x05\x0b\xe5\x50\x3f\x03\xc1\xd1\xc4\x49\x48\x50\xba\x3b\xee\x02\x94\x00\xbd\x94\x22\x5d\x2c\x93\x54\x03\x25\x1c\x66\x08\x74\x49\xa4\xc6\xb0\x4d\x7d\x84\x4c\xdd\x08\xe1\xe0\xed\x2f\x6c\xff\xa6\x6b\x2b\xe1\xbd\x98\x3d\x75\x16\x1d\xe8\xcf\x59\x12\xbd\x8f\x3f\xee\xc8\x9f\xc7\x43\xaf\xe2\xa7\x5b\xb7\x98\xf4\x73\x01\xfd\x8b\x28\x4c\x88\x24\x5a\x48\x1f\xce\xfc\xb3\xbe\xf5\xa9\xe0\x1a\x3f\x6a\xff\x5c\xc8\x45\x40\x12\x42\x97\x18\x50\x12\x63\x14\xbc\xf9\x48\x97\x84\x2f\x50\xdd\x0b\x4d\xa2\x3f\xb6\x8f\xff\x40\x58\x84\xe1\x1f\x4c\xd4\x0e\x55\x20\x4c\x35\x91\xfa\x9e\xc5\xa8\x34\x89\x93\x1e\x82\xb7\x44\x69\x0b\x63\x0e\x4b\x11\x6a\x0c\xf7\x9d\x60\x96\x4d\x25\x56\xe4\xfd\xea\xcb\x53\xf0\x9e\x27\xb3\x09\xc6\x42\xe3\xdf\x24\xd3\x58\x97\x2e\x32\x7f\x18\x7c\x30\x4f\xfd\x1c\x49\x9a\xd5\xe1\x94\x5d\xc0\x69\x31\x08\xfe\xe5\x81\xd8\x96\x4b\x0f\x52\x19\xf9\x70\x96\x65\x25\xd4\xf0\xd7\xc9\xdb\xcd\xe6\xcc\x72\x6c\x9f\x8e\x89\x52\x1f\x84\x0c\xa7\x48\x25\x6a\x07\x00\x60\x46\x14\xa3\x01\x49\xf5\xd2\xdd\x3b\x00\xa9\x42\x69\x5c\xa2\x89\x5e\x3e\x34\x4b\x58\x42\xf3\x49\x4a\xfc\x60\xce\x4c\x39\x38\x42\x4d\x47\x75\x7a\xf6\x8a\xd9\x5e\xae\x83\x51\x96\x9d\xb2\xcd\x66\x64\xa7\xe4\xac\x22\x37\xe7\xd9\x16\xd3\xdf\x23\x91\x28\xef\xc5\x03\xf2\x3e\xbe\xf3\xd1\x40\x9b\xe1\x03\x96\xcd\xe9\xb7\xaf\x99\x1b\x6f\x52\x54\x9a\xc5\x29\x5a\x35\x56\xcd\xb1\xba\x41\xc7\x31\xeb\x9e\x40\x75\x0a\xcf\x32\x21\x61\x78\x95\x6f\x57\x18\x94\x61\x72\x50\xb3\x36\x9c\xe6\xf1\xe0\xad\x59\xb1\x89\x01\xed\xbd\x9c\x65\x5a\xfc\x45\x09\xde\x99\xd3\x91\x77\x38\xb5\x3b\x7b\xb3\xd9\xba\xe3\xb3\xcc\x25\x3b\xeb\x6a\x6d\x38\x31\x41\x60\xb3\xe9\x0b\x0c\x35\x2f\x96\xa8\x3b\xfd\x3e\x2f\x9e\x72\x2e\x37\x9b\x47\x32\x40\x96\xb5\x48\xfb\x38\xa9\xca\xb4\xcd\x66\x7b\x01\xe7\x72\xe5\x4e\x68\x02\xee\xf3\x6b\x7b\xf7\x65\x8a\x72\xc5\x28\x76\x7a\x2f\x5b\x7b\x1c\x4f\xb8\x33\xa3\x12\xa4\x65\xd3\x45\x48\xdb\xb3\xf0\x60\x4b\xef\x23\x11\x52\xfb\xf0\x7f\x2f\xec\x9f\x52\x68\x41\x45\xe4\xc3\xfd\xf5\xb8\x7c\x56\xa4\xf6\x71\x4e\x98\x77\x39\xf6\x8c\xad\x3f\x60\x88\x45\x79\xe1\x34\xc0\x5c\x66\xe6\x15\x81\x5d\xdd\xae\xf1\x72\x7f\x76\x5e\x3a\x06\x36\xc3\x0a\x23\xa4\x26\xfd\x7d\x22\xb3\xec\xd6\xb7\x26\x3a\x2d\xd5\x1c\x09\x12\x7e\x4f\x22\xc2\x29\x4a\x1f\xb2\xcd\x9f\x52\x55\xd3\x5b\xa5\x48\x35\x0e\x45\x82\x5c\x99\xf3\xb6\x71\x05\xc7\x81\x27\x66\x74\x7f\xf7\xf5\x5a\xaa\x7f\x8a\x9e\xec\x04\x68\xe3\x2e\x17\x70\x6a\x5a\x7f\x79\xe6\x3d\xad\xf5\x99\x0b\x3e\x6c\x45\xda\x2a\x64\xe4\x33\x37\x1b\x27\x88\x14\x20\x9d\x00\xc1\xe6\x5d\xd0\xab\xea\xd8\x65\x91\xeb\x83\x98\xf2\x0f\xe2\xaf\x0b\x75\x0c\x93\xd6\xc9\x8b\x1d\x6e\x5d\xab\xf6\x9d\x9f\x84\xd2\x05\x56\xae\x87\xbc\x2d\x09\x59\xd6\x4f\xe1\x02\x96\x3b\xaf\x67\x83\xb5\xfc\x44\xd7\x4e\xc2\xb8\x42\x9a\x4a\x7c\x13\x2e\xf0\x1e\x65\xcc\x78\xbe\xc2\x58\x44\x8c\xae\x7d\x98\x60\xc8\x24\x52\x6d\x31\x6b\x0a\x1f\x30\x5c\x14\x91\x4d\x0b\x8b\xd6\x0e\xc3\x8f\x07\xdf\x47\x45\x7f\x06\xd3\xbc\x35\x04\xc5\xf9\x22\x2d\x08\x40\xcc\x41\x2f\xd1\xc6\x1c\x0c\x41\xa1\x64\xa8\x2e\x60\x2e\x64\x3e\x42\x91\x6b\x49\x22\x37\x44\x1e\xd1\xad\xff\xaf\xde\x72\x6e\xc7\xbe\xe8\xaf\x95\xbd\xfc\x56\xd3\xde\x6d\x46\x56\x38\xfd\xdd\x40\x9b\xd2\x97\x82\x0b\x59\x55\x3d\x8d\x46\x9c\xdb\x85\xf2\x61\x64\x2d\x54\x8d\x2b\xba\x44\xb3\x92\x69\x53\x5b\xd9\x4d\xfd\x2a\x49\x5c\xe9\xcf\x7c\x63\xa2\xe9\xf2\xf7\x77\xce\x3e\x3a\x20\xec\xde\x98\xc9\x0e\xbf\xbb\x6b\xd5\x91\xca\x2b\x5c\x35\xaa\x34\x50\x5b\xb8\xac\x59\x77\x5f\x2e\x6c\xb9\x5a\xf0\xb6\x6d\x5b\xff\xab\xaf\x5e\x39\x5b\xb7\xf9\x8b\xcd\x81\x0b\xbd\x33\xd9\xbc\x66\xca\xa4\x98\xb1\xf1\x6b\xa5\x91\x53\x7c\xec\x62\xaa\x22\xd3\xbf\x89\x28\x8d\xf1\x3a\x22\x2c\xde\xdf\xed\x9f\xb0\xaf\x37\xc3\xe8\x0e\xa5\x4d\xb0\x28\xdc\xd5\xb0\x50\xc3\x54\x0b\x49\x16\x46\x1b\xca\x86\x74\xe5\x3c\xba\xb5\xc7\xb0\x3f\x87\xdb\x57\xc0\x1f\x08\x78\x5b\x27\x84\x55\xf5\xe0\x28\xd6\x6e\xcb\x53\x64\xcd\x92\x91\x9a\x50\x73\x09\x73\x23\x42\x7b\x47\xe0\x95\xc7\xa3\x03\xd1\xaf\x2a\x1c\x18\x4c\x90\x84\xf9\xb1\xee\x8e\x53\x1c\x94\x0b\x49\x3b\xc1\xfa\x91\xc4\x7f\xa6\xa8\xdc\xad\x53\x5a\xc0\x87\xc3\x85\xbb\x36\x8d\x0e\xa6\xd7\xa5\xa2\x0b\xf9\x9a\x9b\x82\x24\x89\xda\x5a\x80\xbd\xc6\x24\x12\x6b\x73\x62\xb9\xb6\xb7\x9f\xff\x2b\x3b\xc4\x9e\xc8\x18\x25\xca\x87\x97\xff\x99\x2a\xdb\x18\xd7\x64\x85\xc5\xda\x2e\x59\x08\x39\x31\x41\xb8\xce\x16\x1d\x27\x01\x88\x58\xcc\x9a\xf1\x35\xc6\x58\xc8\xb5\x0f\x83\x2f\xbf\xfe\xe6\x86\x0d\xaa\x91\xae\x43\xb9\xb4\x2f\x2c\xa9\xc6\x38\x89\x88\x69\x0d\x59\x12\xd7\xce\x5d\x6b\x6e\xd3\xcf\x3e\x3a\x3a\xc0\xb2\x47\xa8\xd4\xb5\xb0\xf9\xa8\xa2\xfe\xba\xa2\x54\xa4\x5c\xdf\x3e\x5a\x7f\x7d\x60\x7a\x09\xa7\xbb\xb6\xd9\x94\x2e\x31\x4c\x23\xc6\x17\x4e\x04\xbb\x15\x21\x4e\x4b\x07\x2a\x37\xb7\xf9\x72\xe7\xb1\x9b\xc4\x5b\xe4\xdd\x88\x78\x2f\x22\x94\xad\xea\xda\x14\x97\xd5\x53\x17\xad\x49\xdc\x05\xbb\x9a\xcf\x19\x2f\x02\x81\x45\x22\xe5\x23\x17\xc6\x21\x6b\x62\x34\xd0\x1c\xfd\xfc\x24\x94\xbe\x8a\x18\x51\xe8\x32\xb9\xac\x9f\x3a\xe8\x5b\xa7\x3d\xb6\xc0\xeb\xdb\xe9\x34\x9d\xcf\x99\xdb\xdc\x09\xb9\x2a\xc2\x91\xeb\x8b\x0a\x89\xa4\x4b\x77\x8b\x14\x41\xfb\xb4\xa7\x7e\x1b\xaa\x15\x1d\x66\xd9\x8e\x65\xcc\xfc\xbd\x09\xb7\x12\xd5\xc2\x59\x62\xd3\xa9\x26\x8c\xa3\x74\x78\xdd\xda\xc9\x30\x5f\x16\xe7\xe1\xff\x2c\xcb\x76\xe6\x9f\x9f\x0d\x29\x34\xbb\xa2\xf9\xf4\x71\x1a\x45\xf6\x14\xf3\xf3\xfc\x56\xe8\xb1\x44\x85\xdc\x9e\x64\xcc\x87\xc8\x66\x15\x67\xe4\x3f\xf3\x6c\xad\x6c\x3a\x9b\x97\xed\x62\xb1\xfe\x69\x6a\xe9\x66\x2b\x36\x9f\x5c\xe6\xae\xa1\x79\x29\x60\x28\xd1\x14\x5c\x4c\xf0\xcb\x57\x2f\x42\x97\x38\x62\x2b\xe4\xa8\xd4\x58\x8a\x59\x15\x80\x4a\x57\xd2\x3a\xf9\x11\xab\x53\x1c\x40\xab\xa7\x62\x5b\x3c\xa5\xa8\x9c\x69\x46\xa2\xd7\x18\x91\xf5\x14\xa9\xe0\xa1\xf2\xe1\x1b\x97\xc6\xe9\x1e\x59\x36\x2b\x7b\x8c\xfb\x40\x25\x92\x90\x7d\x3e\xe6\x5e\xb9\x34\xcf\xa0\x36\x25\xfc\x55\x4c\x81\x9a\x82\x09\x98\x82\xc1\x8f\x29\x91\x84\x6b\xc4\x70\x00\xe7\x36\xa4\xc3\xe5\x65\x99\x08\x9e\x43\xca\x23\x54\x0a\x08\x44\xe2\x03\xca\x32\xc2\xdb\x6a\x02\x84\x04\x02\x34\x49\x0d\x96\x42\xd7\xea\xcf\xe0\x56\x68\xf4\xe1\x8e\xc3\xdd\xf4\xce\x9c\x19\x25\x1a\x2a\x2e\xa0\x5e\xb3\x60\xe4\x02\x98\x56\x40\xa2\x0f\x64\xad\x60\x96\x4a\xa5\x4d\xc5\xed\x60\xf5\xe4\xa9\xfe\x5c\xe5\xe6\xa0\x43\xaa\x9a\x9b\x5c\xaa\x3a\x50\xec\x3f\xf3\x7a\xfc\xeb\x5b\xa3\xa9\xc6\xb6\x35\x5f\x9a\xa4\x07\xd6\x8d\x35\x54\xbb\x6a\xb4\xff\xac\x7d\xb6\x8b\x7c\x50\x29\x59\x48\x3d\x29\x4d\xf9\x6f\xd2\xd6\xa7\x51\xd4\x36\x1d\x15\x45\xfb\x8d\x49\xc8\x0d\x2d\xd9\x38\xd8\x93\x9f\x3d\x53\x8d\x38\xa4\x00\xb1\x99\x3e\x2e\xce\xd7\x35\xdd\x9e\x68\xd5\xeb\x7c\xfd\x78\xcd\x40\xf7\xa9\xae\xcb\x4a\x43\x08\xb9\xe5\x42\x6c\xcf\x2b\xa7\xc7\xe4\x72\xef\x9a\xbc\xe2\x8a\x6b\x4f\x21\xfb\xae\xa9\x1a\x53\x4d\x28\xbc\xe3\xd1\xba\xec\x74\xd4\x76\x6d\xfe\x62\xf3\x43\x5a\x14\xcd\x26\xba\xf9\x3c\x03\xb3\x08\x44\xa8\x95\x7d\xbd\xa1\xbc\x64\x83\xbc\x01\x61\x42\x90\x09\x72\x21\x68\x01\x0b\xd4\x26\x66\x99\x17\xbf\x94\xed\x86\x55\xef\x71\x5c\x38\x98\x74\x89\xf4\x01\xc3\xa2\xc2\xcb\x71\x80\xf0\xb0\x3c\xe8\x81\xc4\x15\xc3\x0f\xea\xa4\xad\xe1\xba\xf9\x61\x74\xfc\x71\xdd\x4e\xab\x8f\x64\xe5\x3b\x73\x95\xd9\x9b\x90\xfb\x12\xad\x67\xf0\x57\x2c\x44\x79\x59\x1d\xc6\x3a\x24\xd5\x88\x57\xd6\xb4\x1e\x29\x8a\xda\xcb\x1e\x4f\xe8\xcc\x36\x9d\x26\xaf\x7c\x2d\xe8\xd2\x77\xee\x23\x9a\x24\xaa\xa2\xe9\x0c\xa7\x89\xd2\x12\x49\x7c\x69\xe8\xfc\xd1\xa8\xf9\x9e\x68\xb7\xc7\x65\xe7\x51\x21\x1e\x18\x7a\x45\x6b\xe9\xf2\xf4\xfc\xee\xea\xd7\xfb\x9f\x82\xeb\xbb\xbb\x5f\x7e\x7e\x13\x4c\xdf\x5c\x4f\xde\xdc\x3f\x7f\x44\xd8\x10\x23\x5c\x10\x8d\x5e\x2a\x23\x75\x99\x0d\x46\x03\x3f\x1b\x54\x46\x1e\xf8\x83\xde\x26\xdd\xe0\x62\x60\xf3\xd1\xc0\x1f\x18\xff\x18\x5c\x0c\x56\x28\x67\x03\x7f\xb0\x40\x6d\x0f\xe0\xf6\x9f\x59\x52\x3d\xb0\xa4\xb2\x83\x37\x4b\xb5\x16\xbc\x43\x54\xf3\x45\x49\x59\x10\x3d\xb0\x91\x8e\xd4\x88\xa2\xd4\x6a\x44\x89\x37\x4b\x79\x18\xe1\x90\x4a\xbd\x63\xf6\x8a\xc8\x91\x4c\x79\xd5\x74\xab\xdf\x6e\x31\x67\x9d\xd2\xc8\xa5\x8d\x47\x94\xb4\x10\x91\xaf\xfa\x62\x67\x8f\x76\x1d\x2a\x80\xbc\xa1\xff\x83\x14\x71\xd3\x07\x4d\x09\x6d\xec\xf3\x0b\xae\x27\x38\x6f\x8f\x75\x8e\xfa\xc5\x2b\xce\x65\x75\xd8\x21\x7e\xc0\xf5\x2e\x46\xf6\xaa\xc4\x9a\x2e\xba\xe5\x82\x6d\xfb\xad\xda\xd1\x45\xc9\x37\x5f\xdd\xb0\x83\x52\xfa\xab\x2f\x6f\x58\x4f\xa6\x2b\xf2\x9c\xf2\x3b\x81\xa5\x67\xc3\x16\x09\x6e\xcf\x08\xda\xdb\xee\xb4\x8b\x00\x60\x9c\xe8\xf5\x6b\x56\x5f\xd7\x61\xa4\x9a\x14\x49\x5f\x07\xb4\x29\x1d\x35\x8f\x1e\x3f\x2f\x37\xa5\x3d\x28\xdf\x52\x7b\xdb\xd0\x5c\x74\x27\xc2\x53\x4a\xc5\xc7\x27\xe2\x62\xaf\x35\x45\x2f\x9e\x15\x0a\xcf\xb2\xe3\x38\xab\x8d\xd2\x34\x8f\x96\x6c\xb1\xa8\x8e\x9c\x5e\xd9\x67\x2a\x8e\xd1\xd7\xf9\xeb\x4f\x27\x59\xe6\x01\xf2\x70\xb3\x39\xf9\xd7\x00\x07\xda\xc4\x81\x01\x32\x00\x00"),
+		},
+		"/infrastructure/07-syndesis-db-maintenance.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "07-syndesis-db-maintenance.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2120,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdf\x8f\x9b\x46\x10\x7e\xf7\x5f\x31\x4a\x1f\x9c\x48\xc5\xd7\x6b\xd5\x3e\x20\xa5\x12\x3d\x93\xd4\xa9\x7f\x50\xb0\xd3\x1f\x2f\xd6\xb0\x0c\x77\xdb\x83\x59\xb2\xbb\xb8\x42\x16\xff\x7b\x84\x6d\x44\x0c\xbe\x9c\x73\x5e\xbf\x30\xdf\xcc\xf0\x7d\x1f\xb3\xb3\xdf\x3b\x20\x53\x40\x4e\x60\x12\x55\x9c\x90\x91\x66\x72\xa7\xf2\x42\x31\xb1\x35\x93\x29\x5a\x8c\xd1\xd0\x64\x81\x92\x2d\x31\xb2\xa0\x89\xcf\x18\x67\x94\xc0\x6b\x56\xf6\x8b\xb2\xc8\x22\x27\x71\xd5\xc2\x6f\xea\x7a\xe4\x00\x16\xf2\x23\x69\x23\x15\xbb\x10\xa3\x15\x0f\x37\xbb\xdb\x98\x2c\xde\x8e\x00\x1e\x25\x27\x2e\xdc\x69\xc5\x1f\x54\x3c\x02\xc8\xc9\x62\x82\x16\xdd\x11\x00\x00\x63\x4e\x2e\x98\x53\x77\x27\x89\x9d\xbc\xe3\x70\xc8\xc8\x30\xa6\xcc\x1c\xb3\x01\xb0\x28\xba\xf4\x53\xac\x7d\x9c\x48\x75\xf3\x1c\x6e\xab\x82\x5c\x90\x9c\x6a\x34\x56\x97\xc2\x96\x9a\x2e\xa4\x89\xd6\x9c\xaf\x71\x33\x05\x89\x23\x2f\x23\x1e\x28\x29\x33\x72\x61\xbc\xdf\x5f\x6f\xf1\x66\x7d\x17\x9d\x2a\xeb\x7a\x7c\xe8\x24\x14\x8b\x52\x6b\x62\x51\x05\x2a\x93\xa2\x72\xe1\x9d\xd2\xb1\x4c\x0e\xa8\x29\x85\x20\x63\xd2\x32\xfb\xa0\x62\xf3\xbb\x34\x56\xe9\x6a\x2e\x73\x69\x5d\x68\xbc\x06\x48\x51\x66\x94\x0c\xd1\x9f\x0e\xe8\x7f\x2a\x5e\x53\x5e\x64\x68\xa9\x35\xf4\xfc\x73\x0c\x0d\x7f\xca\xf4\x6b\x8c\x7f\x91\xab\x00\x5f\x3a\xdb\x9c\x18\xc5\xa3\x4a\xd3\x33\x9d\xcd\xdf\xf6\xa4\x5c\x96\x73\x59\xd2\xd7\x64\x5d\x2b\xed\xc5\xf2\x86\x12\x9b\x63\x48\xef\xa4\x20\x4f\x08\x55\xb2\x5d\xf6\x2f\xc6\x59\xae\x26\x63\x51\xdb\x76\x44\x96\xb4\x23\x7d\x96\x20\x14\x5b\x94\x4c\xba\xa7\xda\xb9\xe2\xc6\x75\x47\xe6\x78\xff\xad\x43\x3d\x6b\x6a\xda\x71\xee\x7e\x87\x56\x41\x99\x65\x2d\xe7\x59\xba\x54\x36\xd0\x64\x88\x6d\x2f\x97\x78\x77\xce\xba\xe3\x1d\xbc\xdf\x44\x7e\xd8\x03\x01\x76\x98\x95\xd7\x10\xdd\x18\xd2\x43\x6e\x5d\xf3\xc0\x8b\xa2\xbf\x56\xe1\xf4\xf2\x0b\xde\x69\x95\xf7\x89\x35\xc7\x90\xd0\x64\xff\xa0\x2a\xa4\xf4\x12\x3e\x58\x73\xf7\x99\x8a\x31\x73\x84\xe2\x54\xde\x5f\x2c\x78\xa4\xca\x85\x60\x15\xad\xdf\x87\x7e\xf4\xe7\x7c\xfb\x04\xb1\x8e\xf9\x2a\x58\xcf\x56\xcb\xe8\x49\x67\x1c\x01\xc6\xa2\xa5\x9c\xd8\x6e\xad\xcc\x49\x95\xf6\xed\xb7\x7c\xd7\xa8\xad\x5e\x1f\x8b\x87\x36\x0a\x95\xe7\xc8\x49\xdf\x01\x07\x6e\x62\xc9\x37\xe6\x61\x10\x77\x44\x2f\xf4\x1d\x34\x83\x5c\x75\x4c\x41\x97\x6c\x40\x31\x48\x6b\x40\xfd\xcf\xdf\xc3\x47\xef\x6e\xb3\x59\x80\x40\x1e\x1f\x50\x90\x6c\x64\x42\x80\x60\x35\xb2\x41\x61\xa5\xe2\xc1\x9b\x7e\xed\x45\x00\x0a\xf3\x29\x3b\xcc\x4b\x2b\x76\x13\xce\xeb\x7a\x0c\xce\x0e\x56\xcb\xad\x1f\x86\xab\x70\x1b\xad\x57\xc1\xdb\xdb\x51\xaf\x12\x1c\x01\xe3\x13\x8d\xd7\xde\xd2\x9b\xff\xf3\xaf\xff\xa6\xef\xc5\x31\x2b\xf4\x67\xcb\xa9\xff\x37\x4c\xbd\xb5\xf7\x9b\x17\xf9\xf0\xea\x39\xc7\x9b\x6b\x5f\xd7\xaf\xfa\xed\x34\x19\x55\x6a\x41\x83\x1d\x06\x90\x35\x6b\xf1\x42\xbc\xd9\x85\xb9\xd2\x95\x0b\x3f\xfe\xfc\xcb\x42\x0e\x70\x4d\x9f\x4a\x32\xcf\x54\xfe\xb0\x90\xa3\xfd\xde\x01\xe2\xa4\xae\x47\x9f\x07\x00\xbc\xc8\x1e\xa5\x48\x08\x00\x00"),
 		},
 		"/install": &vfsgen۰DirInfo{
 			name:    "install",
@@ -295,49 +386,85 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 162,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8b\x41\x0a\xc2\x30\x10\x45\xf7\x39\xc5\xa7\x7b\x2b\xdd\xce\xce\x85\x27\x10\xdc\x8f\xce\xa0\xc1\x76\x12\x32\x51\x90\x92\xbb\x4b\x6a\x77\x8f\xff\xde\xe7\x1c\xaf\x5a\x3c\x26\x23\xf8\xd7\x44\x3d\xfa\x18\xd3\xf1\x33\xf1\x9c\x9f\x3c\x85\x57\x34\x21\x5c\x76\x15\x16\xad\x2c\x5c\x99\x02\x60\xbc\x28\x81\x73\x0e\x9e\xf5\xde\x17\x16\x49\xe6\x9d\xd6\xf5\x80\xc2\xf6\x50\x8c\x67\xe3\xdb\xac\x72\xda\x1c\x5a\x0b\x40\xf7\x63\x6b\xb4\x21\xa0\xff\x82\x30\xd4\xf2\xd6\x61\xbf\xab\x49\xaf\x7f\x01\x00\x00\xff\xff\xcd\xd2\x14\x3f\xa2\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8b\xb1\xca\xc2\x40\x10\x84\xfb\x7d\x8a\x21\xfd\x9f\x9f\xb4\xd7\x59\xf8\x04\x82\xfd\xea\x2e\x7a\x98\x6c\x8e\xdb\x53\x90\xb0\xef\x2e\xa7\x61\x9a\x61\xbe\x6f\xb8\xe4\xb3\x56\xcf\xab\x25\xf8\xdb\x44\x3d\xfb\x98\xd7\xff\xd7\xc4\x73\xb9\xf3\x44\x8f\x6c\x92\x70\xda\x11\x2d\xda\x58\xb8\x71\x22\xc0\x78\xd1\x04\x2e\x85\xbc\xe8\xb5\x2f\x2c\xb2\x9a\xf7\xb6\x6d\x7f\xa8\x6c\x37\xc5\x78\x34\xbe\xcc\x2a\x87\x2f\x43\x04\x01\x9d\x8f\x11\x5d\xec\xd1\x9f\x91\x30\xb4\xfa\xd4\x61\xbf\xab\x09\x22\xe8\x33\x00\xcd\xd2\x14\x3f\xa2\x00\x00\x00"),
 		},
 		"/install/cluster.yml": &vfsgen۰CompressedFileInfo{
 			name:             "cluster.yml",
 			modTime:          time.Time{},
-			uncompressedSize: 4843,
+			uncompressedSize: 5483,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xe2\xc3\xb6\x05\xac\x45\x6e\x85\x6e\x5b\xa7\x2d\xb6\x2e\x92\x20\x9b\xee\x29\x87\x1d\x49\x13\x89\x10\x45\x0a\xe4\xd0\xd9\xb4\xe8\x7f\x2f\x44\xca\xb6\x64\xa9\x8a\x1b\x38\xcd\x22\x37\x6b\xbe\xf9\xf8\xde\x48\x5e\x02\x36\xe2\x33\x19\x2b\xb4\x4a\xda\xdf\xf4\x95\x49\xb5\x4f\x36\xae\x7e\xb4\xb1\xd0\xef\x37\xe7\x29\x31\x9e\x47\x00\x95\x50\x79\x02\x2b\x67\x59\xd7\x37\x64\xb5\x33\x19\x5d\xd0\xbd\x50\x82\x85\x56\x11\x40\x4d\x8c\x39\x32\x26\x11\x00\x80\xc2\x9a\x12\xb0\x8f\x2a\x27\x2b\x2c\xd9\x78\xfb\x33\x16\xda\x07\x48\x4c\x49\xda\x10\x0c\x80\x4d\xb3\x8f\x8e\x00\x6c\x43\x59\xf0\x15\x46\xbb\x9e\x6f\x9b\xde\xd6\xdf\x65\x87\xd9\x3e\xed\xd3\x7d\x03\x61\x79\x3d\x70\xfc\x2e\x2c\x77\xce\x46\x3a\x83\xb2\x3f\x60\xb4\x80\xdb\xab\x8b\xab\x04\xe0\x0f\x4b\x70\x16\x1c\x64\xcf\xe0\xa1\x24\x05\xae\x29\x0c\xe6\x42\x15\xc0\x25\xc1\xea\xe6\x02\x36\x01\xb7\xae\x9e\x15\xaa\x70\x12\xcd\xe0\x10\x00\x36\xd3\x0d\x25\x70\xd9\x0e\xdb\x60\x46\xb9\xb7\x6e\xb6\x90\x6f\xce\x51\x36\xa5\x87\x17\x00\xf3\xdc\x43\x89\xf2\xda\x08\xc5\x64\x56\x5a\xba\x5a\xed\x0e\xb9\x84\xdf\x3e\x5d\x5d\x5e\x23\x97\x09\xc4\x96\x91\x9d\x8d\x9b\x12\x2d\x75\x7e\x80\x9c\x6c\x66\x44\xc3\xbe\xf6\x6d\x49\xbb\x59\x60\x18\x17\xee\xe6\x7a\x60\xe3\xc7\x76\x50\xcb\x46\xa8\x62\xa6\xe1\xf0\xd4\x73\x2d\x0f\x23\x43\xd3\xcf\x07\xd6\x41\xdb\xed\x0d\xfc\xac\x30\x95\xf4\x14\xf0\x0b\x0f\xb0\x4b\x4d\x47\x46\x9b\x04\x13\x40\x98\x35\x81\xbf\xfe\x8e\xa2\x68\x11\x2d\xe0\x17\xa3\x6b\xf8\x52\x61\x4d\x12\x84\xb2\x8c\x52\xc2\x52\xc3\x23\xd6\xf2\x4b\xb4\x88\x5e\x52\x07\x13\x34\xcf\xda\x39\x96\x55\x4f\x26\xed\x75\x17\x06\xdb\x02\x8d\x44\xbe\xd7\xa6\xb6\xb1\x0f\x8b\xb1\xc1\xac\xa4\x58\x9b\x62\xa0\x8a\x17\x20\xcb\xc7\xfd\x10\xd7\xdd\x10\xcf\xe1\x4d\xa7\xd7\x89\xe9\x27\x45\x3b\xd1\x75\xa4\xdf\x89\x98\x09\x29\x4f\x81\xb8\x55\x67\xa9\x0d\x5f\xf6\x9b\xb7\x08\x89\x66\x24\xde\x89\x1a\x33\x3a\x1e\x92\x6f\xc4\xbd\x69\xa9\x7f\x4b\x64\xab\x04\xbf\x2a\xcf\xd6\x82\x4f\xb0\x9a\xb6\x18\xc4\xe1\xf8\xe1\x40\x77\xdd\x89\xee\xda\x23\xdd\xbd\xaf\x04\xdf\xc5\x6d\xa1\xa3\xe7\x1a\x04\x87\xb1\x6e\xfb\xa6\x23\x17\xa6\xa8\xb1\x38\xbe\xe9\x30\x3a\x74\xfd\x38\xb0\x9d\x50\x6f\x6b\xc1\x73\x52\x5b\x0b\x9e\x57\x59\xcb\x9e\x79\x81\x55\x73\x02\xab\xba\xf6\x6f\x54\x5b\xaf\x2a\xac\xd3\xbd\xf0\xab\x1d\x49\x8e\x90\x8c\x06\x37\xea\xba\xee\x15\x38\x21\x79\xe7\x98\x3b\x4f\xdb\x27\x38\xcb\x73\x9c\x7d\x73\x84\xf5\x96\x0c\x19\xa5\x2e\x4e\xca\xd8\x86\xb2\xa7\x3f\x16\x57\xa1\xf1\x73\xbe\x15\x9f\xc1\x9e\x55\x1b\xd7\xb5\x1c\xd1\xa7\xef\x9c\xe0\xcf\x00\xa7\x59\x02\x65\xd9\x88\x40\xfd\xe4\x37\xc7\xa0\xd4\x09\x99\xff\xff\xcb\xce\xb7\x3d\xdd\x9a\xb3\x8c\x86\x29\xff\x30\xb3\xec\x58\xd4\x04\xc8\xf0\x50\x8a\xac\xf4\xff\x46\xc2\x0c\x0f\x68\x41\xa2\x65\xf8\xce\xd0\xf2\xfb\xae\xd0\xc1\x4c\x1f\x46\x6f\xf0\x1c\x99\x66\xe6\xc9\xdd\x60\xcd\xfd\x2b\x00\xbe\x31\x7d\xa5\xcc\xf9\xb5\x3f\x4a\x0b\xed\x2f\x0e\xcd\x47\xa2\x72\x8f\x42\x3a\x43\xb1\xa1\x4c\x6f\xc8\x3c\xc6\xc8\x4c\x75\x33\x03\x92\x72\x75\x4a\x06\xf4\x7d\x6f\xa8\x2e\xc9\x1e\x62\x72\x68\x0e\x43\xf9\x6d\x4b\xe6\x39\x02\xff\xa9\x85\x64\xa4\x6c\x6f\x9d\x90\x74\x20\xee\x48\xac\xe9\xae\xc8\x8b\xa9\xd4\xa4\x98\xc5\xe8\xb8\xd4\x46\xfc\xe9\x2f\x66\x2f\xd5\xbd\x4a\xa5\xb3\x4c\xe6\x46\x4b\x1a\x09\x33\x33\xe4\xd3\x6e\x45\x4d\x96\xb1\x6e\x12\x50\x4e\xca\x63\x44\x0b\x33\xdd\xb1\x28\x0c\x15\xc8\xb4\x64\xbd\xc4\xbc\x16\x2a\x81\x33\x36\x8e\xce\xfe\x5b\x2a\xe5\x82\x07\x99\xbd\x77\xcd\xb2\x4a\x5a\x77\x04\x60\x9c\xdc\x22\xe8\xe1\xf9\xb5\xbd\xec\xe1\x2a\x9d\xba\x77\x80\x11\xfc\x6d\xec\xbb\x1f\xde\x75\x4f\x1b\x32\xe9\xc8\xf5\x4f\x00\x00\x00\xff\xff\xae\x13\xa7\xc4\xeb\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xe2\xc3\xb6\x05\xac\x45\x6e\x85\x6e\xa9\xd3\x16\xdb\x14\x49\x90\x4d\xf7\x94\xc3\x8e\xa5\xb1\x44\x98\x22\x05\x72\xe8\x6c\x5a\xf4\xbf\x17\x94\x68\x4b\xb2\x64\xad\x1b\x38\xd9\x45\x90\x1c\xec\xf9\x7a\xc3\x99\xf7\x28\xdb\x73\xc0\x4a\x7c\x22\x63\x85\x56\x89\x7f\x4d\x5f\x98\x94\x7f\x67\xe3\xf5\xcf\x36\x16\xfa\xfd\xe6\x7c\x49\x8c\xe7\x11\xc0\x5a\xa8\x2c\x81\x85\xb3\xac\xcb\x3b\xb2\xda\x99\x94\x2e\x69\x25\x94\x60\xa1\x55\x04\x50\x12\x63\x86\x8c\x49\x04\x00\xa0\xb0\xa4\x04\xec\x93\xca\xc8\x0a\x4b\x36\xde\xbe\x8c\x85\xae\x03\x24\x2e\x49\xda\x26\x18\x00\xab\xaa\x8d\x8e\x00\x6c\x45\x69\xe3\xcb\x8d\x76\x1d\xdf\x36\xdd\xd7\xdf\x65\x37\xbd\x7d\x6c\xd3\xfd\x9f\x14\x96\xaf\x7a\x8e\x3f\x85\xe5\xe0\xac\xa4\x33\x28\xdb\xb2\x64\xa3\x19\xdc\xdf\x5c\xde\x24\x00\x7f\x59\x82\xb3\xc6\x41\xf6\x0c\x1e\x0b\x52\xe0\xaa\xdc\x60\x26\x54\x0e\x5c\x10\x2c\xee\x2e\x61\xd3\xcc\x2d\xd4\xb3\x42\xe5\x4e\xa2\x69\x2b\xd6\x0e\x9b\xea\x8a\x12\xb8\xf6\xcd\x56\x98\x52\x56\x5b\x43\x6a\x02\x9b\x73\x94\x55\x51\x8f\x17\x60\x06\x61\xd8\x20\x2c\x58\x32\x1b\xca\x40\xab\x94\x6a\x44\x5d\x91\x41\xd6\x06\x0c\xe5\xc2\x32\x19\x5b\x9b\x1f\x69\x59\x68\xbd\x86\x54\xab\x0d\x19\xde\xf6\x67\xc2\x7e\x6c\x17\x6e\x37\xad\x79\x58\x4e\x0f\xdd\xff\x37\xa0\x09\xb0\x71\xd4\x1a\x59\x1b\xcc\xa9\x67\x6d\x2b\x6c\xd9\xd1\x2f\xb0\x42\x69\x47\x2a\xb4\x66\xcc\xb2\x9a\x36\x28\x6f\x8d\x50\x4c\x66\xa1\xa5\x2b\xbb\x2d\xfe\xf1\xf1\xe6\xfa\x16\xb9\x48\x20\xb6\x8c\xec\x6c\x5c\x15\xd8\x29\x9a\x91\x4d\x8d\xa8\x7c\x91\x04\xee\x0b\xda\xcd\x1d\xfa\x71\x4d\xa3\xb7\x3d\x1b\x3f\xf9\xa5\x58\x36\x42\xe5\x13\x80\x61\x6e\x47\x40\xee\x47\x36\xa0\x9f\xf6\xac\x3d\xd8\x2d\xdb\x7e\x55\xb8\x94\xf4\x35\x92\xcd\x7c\xbe\x75\xcb\xdd\x62\x93\x68\xb6\x1d\xae\xef\x35\x81\x7f\xfe\x8d\x5e\x4f\xce\x5b\x36\xa6\x5a\xad\x44\xfe\x9a\xda\xbe\x09\xc8\x8b\x1a\xf9\xa0\xd2\xfb\x61\x13\xba\xdf\x3b\xc9\x41\x35\xf7\xe3\xba\xda\x5e\x48\xe7\xf5\x78\x40\xd8\x51\x34\x8b\x66\xf0\x9b\xd1\x25\x7c\x5e\x63\x49\x12\x84\xb2\x8c\x52\xc2\x5c\xc3\x13\x96\xf2\x73\x34\x7b\xd1\xc5\x8d\xac\x22\xf5\x7d\xcc\xd7\x9d\xbd\x7a\x09\xe6\x06\x7d\x81\x4a\x22\xaf\xb4\x29\x6d\x5c\x87\xc5\x58\x61\x5a\x50\xac\x4d\xde\xdb\xdc\x0b\x08\xf8\x43\xdb\xc4\x6d\x68\xe2\x39\x5a\x0e\x9c\x1a\xe9\x7e\x94\x58\x23\xa8\x03\x56\x8d\xc4\x8c\x50\x6a\x6c\x88\x21\xc4\x16\xda\xf0\x75\x17\xdc\x4f\x48\x54\x03\xba\x8d\xd4\x98\x78\x8e\xf4\x2f\x84\x50\xab\xbd\x0f\xc6\x19\xf9\x3d\x91\x6d\x2d\xf8\x9b\xf2\xec\x4a\xf0\x09\x1e\x17\xdb\x19\xc4\xcd\xf1\x9b\x03\x3d\x84\x13\x3d\xf8\x23\x3d\xbc\x5f\x0b\x7e\x88\xfd\x03\xe0\xe8\xbe\x7a\xc1\x9e\xb8\x09\xdc\x3f\x55\xc7\x77\x15\x86\x21\x4a\xcc\x8f\x07\xed\x47\x37\xa8\x1f\x7a\xb6\x13\xea\xed\x4a\xf0\x94\xd4\xae\x04\x4f\xab\xcc\xb3\x67\x5a\x60\xeb\x29\x81\xad\x05\xbf\x65\x6d\x7d\x53\x61\x9d\x40\x55\x01\x73\xbb\xa5\x63\xd8\xcb\x1a\xdc\x00\xb5\x65\xd9\x49\xc9\x3b\xc5\xdc\x69\xda\x7e\x85\xb3\x3c\xc5\xd9\x37\x47\xd8\x7a\xf2\x29\x32\x4a\x9d\x9f\x94\xb1\x15\xa5\x71\x38\xef\x61\xfe\x2c\x1a\x60\xd8\x0f\x3c\xe2\xf3\xfb\x33\xd8\xb3\xf0\x71\x01\x72\x40\x9f\xae\x73\x84\x3f\xbd\x39\x4d\x12\x28\x4d\x07\x04\xea\x26\xbf\x39\x06\x2d\x9d\x90\xd9\xeb\x5f\x76\x35\xec\xe9\xae\x39\xcb\x68\x98\xb2\x0b\x3e\x8c\xc8\xa2\x24\x40\x86\xc7\x42\xa4\x45\xfd\xed\xbf\xe9\xe1\x11\x2d\x48\xb4\x0c\x3f\x18\x9a\xff\x18\x0a\xed\xca\x78\x1e\x26\x70\x31\x78\x82\x67\xc8\x34\xd1\x4f\xe6\x7a\xd7\xdc\xc1\x01\xd4\xc0\xf4\x85\x52\xe7\xa3\x61\x90\xd6\xc0\x5f\xee\x9b\x8f\x9c\xca\x0a\x85\x74\x86\x62\x43\xa9\xde\x90\x79\x8a\x91\x99\xca\x6a\x62\x48\xca\x95\x4b\x32\xa0\x57\x9d\xa6\x42\x92\xdd\x6b\xea\x62\xdf\xdc\x34\x55\xdf\xb6\x64\x9e\x23\xf0\x5f\xfc\x48\x06\xca\xae\xad\x23\x92\xae\xb7\x67\x07\x62\x5d\xee\x8a\xbc\x98\x4a\xcd\x12\xd3\x18\x1d\x17\xda\x88\xbf\xeb\xc5\xb4\x52\x6d\x55\xda\x7c\xaf\xbd\xd3\x92\x06\xc2\x4c\x0d\xd5\x69\xf7\xa2\x24\xcb\x58\x56\x09\x28\x27\xe5\x31\xa2\x85\x09\x74\xcc\x73\x43\x39\x32\xcd\x59\xcf\x31\x2b\x85\x4a\xe0\xcc\xff\xec\x74\xf6\xff\x52\x29\x13\xdc\xcb\xec\x3c\x6b\xe6\xeb\xc4\xbb\x23\x00\xe3\xe4\x76\x82\xf5\x25\xf6\xbb\x5f\xf6\xae\x71\x6f\x1c\xdd\x3b\xb4\xbf\xae\x75\x63\xdf\xfd\xf4\x2e\xbc\xdb\x90\x59\x0e\x5c\xff\x0d\x00\xc2\xb1\x70\xbf\x6b\x15\x00\x00"),
 		},
 		"/install/grant_cluster_role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_cluster_role.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 275,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\xb1\xae\xc2\x30\x0c\x45\x77\x7f\x85\x7f\xa0\x79\x7a\x1b\xca\x08\x03\x7b\x25\xd8\xdd\xd6\x80\x69\x1b\x57\x4e\xc2\x40\x95\x7f\x47\x91\x58\x10\x08\x89\xed\x0e\x57\xe7\x1c\x5a\xe4\xc8\x16\x45\x83\x47\xeb\xa8\x77\x94\xd3\x45\x4d\xee\x94\x44\x83\x1b\x37\xd1\x89\xfe\xdd\xfe\x61\x94\x30\x78\xdc\x4d\x39\x26\xb6\x56\x27\xde\x4a\x18\x24\x9c\x61\xe6\x44\x03\x25\xf2\x80\x18\x68\x66\x8f\xeb\x8a\xae\x3e\xb0\x94\xa6\xee\x43\x64\xc3\x52\xc0\x74\xe2\x96\x4f\xf5\x48\x8b\xec\x4d\xf3\xf2\x45\x0a\x88\x6f\xce\x4f\x0a\x88\xb9\xbb\x72\x9f\xa2\x87\xe6\x27\x6e\xcd\x7a\x01\x3e\x3b\x1f\x01\x00\x00\xff\xff\x41\x15\xdc\xc5\x13\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\xb1\xae\xc2\x30\x0c\x45\xf7\x7c\x85\x7f\xa0\x79\x7a\x1b\xca\x08\x03\x7b\xa5\xb2\xbb\xad\x01\xd3\x36\xae\xec\x84\x81\x2a\xff\x8e\x32\x22\x10\x12\xdb\x1d\x8e\xce\xb9\xb8\xf2\x89\xd4\x58\x62\x00\xed\x71\xf0\x98\xd3\x55\x94\x1f\x98\x58\xa2\x9f\x76\xe6\x59\xfe\xee\xff\x6e\xe2\x38\x06\x38\xcc\xd9\x12\x69\x2b\x33\xed\x39\x8e\x1c\x2f\x6e\xa1\x84\x23\x26\x0c\x0e\x20\xe2\x42\x01\xb6\x0d\x7c\x25\xa0\x94\xa6\xee\xce\x48\xa1\x14\xa7\x32\x53\x4b\xe7\x0a\xe2\xca\x47\x95\xbc\x7e\x89\x3a\x80\xb7\xe6\xa7\x84\xb3\xdc\xdf\x68\x48\x16\x5c\xf3\x93\xb7\xde\x7a\x11\x76\x46\x0a\xa5\x3c\x07\x00\x41\x15\xdc\xc5\x13\x01\x00\x00"),
 		},
 		"/install/grant_role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_role.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 291,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\xc1\xaa\xc2\x30\x10\x45\xf7\xf9\x8a\xf9\x81\xe6\xf1\x76\x92\xa5\x1b\x77\x2e\x0a\xba\x9f\xb6\xa3\x8e\x6d\x33\x21\x93\xba\xb0\xe4\xdf\x25\x10\x11\x41\x04\x77\x33\xdc\xcb\xb9\x07\x03\x1f\x29\x2a\x8b\x77\x10\x3b\xec\x2d\x2e\xe9\x22\x91\xef\x98\x58\xbc\x1d\x37\x6a\x59\xfe\x6e\xff\x66\x64\x3f\x38\x68\x65\xa2\x2d\xfb\x81\xfd\xd9\xcc\x94\x70\xc0\x84\xce\x00\x78\x9c\xc9\xc1\xba\x82\x2d\x0d\xc8\xb9\x29\xf7\x41\x29\x42\xce\x35\xd7\x80\x7d\x2d\xed\x9f\x6f\x49\xa3\x4c\xd4\xd2\xa9\x60\x30\xf0\x2e\xca\x12\xbe\xb8\x18\x80\x97\xca\xa7\x65\xa3\x4b\x77\xa5\x3e\xa9\x33\xcd\x4f\xc0\x62\xfb\x06\xac\xfa\x8f\x00\x00\x00\xff\xff\x67\x61\x85\x07\x23\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\xb1\x0a\xc2\x30\x10\x86\xf7\x3c\xc5\xbd\x40\x23\x6e\x92\xd1\xc5\xcd\xa1\x50\xf7\x6b\x7b\xea\xd9\x36\x09\x77\xa9\x83\x25\xef\x2e\x81\x8a\x08\x22\xb8\xdd\xf1\xff\x7c\xff\x87\x91\x4f\x24\xca\xc1\x3b\x90\x16\x3b\x8b\x73\xba\x06\xe1\x07\x26\x0e\xde\x0e\x3b\xb5\x1c\x36\xf7\xad\x19\xd8\xf7\x0e\xea\x30\xd2\x9e\x7d\xcf\xfe\x62\x26\x4a\xd8\x63\x42\x67\x00\x3c\x4e\xe4\x60\x59\xc0\x96\x06\xe4\x5c\x95\xbb\x51\x12\xc8\x79\xcd\x35\x62\xb7\x96\x8e\xaf\xb7\xa4\x12\x46\xaa\xe9\x5c\x30\x18\xf9\x20\x61\x8e\x3f\x5c\x0c\xc0\x5b\xe5\xdb\xb2\xd1\xb9\xbd\x51\x97\xd4\x99\xea\x2f\x60\xb1\xfd\x00\x36\x4a\x02\x39\x3f\x07\x00\x67\x61\x85\x07\x23\x01\x00\x00"),
+		},
+		"/install/namespace.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "namespace.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 210,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xcd\xb1\xae\xc2\x30\x0c\x85\xe1\x3d\x4f\x71\x86\x4a\x5d\x6e\xaf\xc4\x6a\x89\x37\x40\x8c\xec\x86\x18\x14\xd1\x98\xaa\x29\x5d\x2c\xbf\x3b\x4a\xa8\x60\x62\x4c\xf4\x7f\xc7\x03\x78\x4a\x27\x99\x4b\x7a\x28\x61\xdd\x05\xe0\x9e\x34\x12\x8e\x9c\xa5\x4c\x7c\x91\x00\x64\x59\x38\xf2\xc2\x14\x00\x40\x39\x0b\xc1\xec\xff\x93\xb8\x07\xb3\x01\xe9\x8a\xef\xdf\x81\xcf\x32\x16\xf7\x46\xc6\xf6\xa8\xbc\x76\x33\xeb\x4d\xd0\xd5\x9d\x3f\x74\x2b\x8f\x4f\x01\xed\x7f\xd9\x6a\x5a\xeb\x4e\xe8\xcd\xde\xc0\xbd\xdf\xd6\x44\xe3\x76\x5f\x34\xba\x87\xd7\x00\x92\xf2\x1d\x06\xd2\x00\x00\x00"),
 		},
 		"/install/operator.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "operator.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 2618,
+			uncompressedSize: 3890,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\x4d\x93\xda\x38\x10\xbd\xf3\x2b\x54\x5c\xe6\x64\x2f\x5c\xb6\xb6\x7c\x63\x81\xdd\xcc\x21\x83\x0b\xc8\xe4\x38\xd5\xc8\x6d\xa3\x8c\x2c\xa9\xa4\x36\x55\xc4\xc5\x7f\x4f\x81\x6c\x63\xf3\xe1\x99\x1c\x52\x99\xf8\x36\xea\x56\x7f\xbc\xf7\xf4\x86\x80\x81\x11\xcf\x68\x9d\xd0\x2a\x62\xbb\xf1\x80\xb1\x57\xa1\x92\x88\xad\xd0\xee\x04\xc7\x09\xe7\xba\x50\x34\x60\x2c\x47\x82\x04\x08\xa2\x01\x63\x8c\x29\xc8\x31\x62\x6e\xaf\x12\x74\xc2\x05\xda\xa0\x05\xd2\xf6\x14\x93\xb0\x41\xe9\x7c\x1e\x63\x60\xcc\x39\xb1\x3a\xab\xff\x0c\x85\xfe\xeb\xad\x38\xed\x0d\x46\xac\xd3\xa0\x9b\xc0\x75\x6e\xb4\x42\x45\xb7\xe6\x09\xaa\x75\x96\x5a\xe2\xbf\x42\x25\x42\x65\x03\xd6\xd9\xd9\x6e\x80\x87\x50\xd0\x56\x5b\xf1\x1d\x48\x68\x15\xbe\xfe\x73\x2a\xbc\x1b\x6f\x90\x60\xfc\xde\xdd\x23\xa1\x1c\x81\x94\x1f\x0e\x03\xc6\x5c\xb1\xf9\x86\x9c\x4e\xf3\x04\xf7\x08\xee\x23\xd5\x6a\x89\x4b\x4c\xfd\x3e\x67\x44\xdf\x94\x02\x18\xf1\xbf\xd5\x85\xe9\x81\x79\xd0\x95\xa0\xc8\x21\xc3\x50\x1b\x54\x6e\x2b\x52\xf2\x3c\x34\xaa\x7c\x3c\x46\x57\x64\x11\xf2\x2b\x5a\x3e\x16\xe4\x7d\xb8\x38\x83\xdc\xcf\x49\x90\x55\x13\x07\x55\xfe\xb0\x2c\xc3\x35\x64\x87\xc3\xb0\xea\x99\x5a\x9d\xd7\x4b\xd5\x45\xcb\x32\x3c\x21\x71\x38\x44\x75\x7a\x95\x51\x96\x22\x65\xe1\x0c\x77\xab\xc2\x18\x6d\xa9\x09\xdc\x40\x70\x0d\x59\x73\x0b\xa5\xc3\xab\xdc\x99\xe6\xaf\x68\x4f\x37\xaa\x88\xc8\x8f\x45\x63\x2d\x05\xdf\x9f\x87\x72\x7c\x8b\x49\x21\x31\x89\x18\xd9\x02\xcf\x45\x55\x72\x38\x5c\xf0\x0b\xc6\xb8\xbb\xf4\xce\xd0\x48\xbd\xcf\x51\xd1\x54\xab\x54\x64\x7f\xac\xed\xb4\x39\x76\x64\x81\x30\x6b\xf0\xf2\x95\x97\xc8\x2d\x02\x79\xb0\x2c\x1a\x29\x38\xb8\x88\x8d\xfd\x15\x94\xc8\x8f\x96\xf2\x5b\x84\x4b\x98\x1b\x09\x84\x75\xf7\x2e\x05\xd7\x50\xbf\x67\xc2\x77\x4d\xf9\xd3\x93\xb6\x61\xf6\xb8\xb5\x3d\xed\xa9\x47\x2c\xc7\x8f\x6b\x45\x20\x14\xda\xd6\x26\x41\xaf\xc4\xea\x47\x00\x19\x46\xec\x81\x3d\x5c\x1e\xc6\x85\x94\xd5\xe3\x60\x8f\xe9\x93\xa6\xd8\xa2\xc3\xca\x5d\xfd\x77\x7c\x3f\x1d\xe4\x82\xf3\x1c\xb1\xb6\x14\xb1\xbf\x47\xa3\xd1\xa8\x95\x50\xab\x3e\x47\xb2\x82\xb7\x01\x45\xb5\xeb\x56\xf2\x89\x5f\x27\xeb\xe9\xa7\x97\xa7\xc9\xe7\xf9\x2a\x9e\x4c\xe7\x9d\x52\x3b\x90\x05\xfe\xd7\xb1\x14\xff\xa5\x02\x65\xd2\x58\xfc\x55\x24\x06\xda\x46\x8d\x12\xc2\x63\x23\x67\x80\xe3\x8d\xf6\xf1\x62\x76\x6a\xfe\xab\xfa\xde\x68\xb9\x88\xe7\xcb\xc9\x7a\xb1\xbc\xd3\x37\x62\xc3\x2b\x3e\x87\x37\xca\xcc\xe6\xcf\x2f\xab\x2f\x71\xbc\x58\xae\x6f\x16\x29\xcb\x8e\xb3\xfa\x12\x64\x45\x96\x35\x1a\x0a\xbc\x10\xa6\x5b\x50\x19\xc6\x60\x21\x6f\x91\x0d\x05\xe9\x1c\x48\xf0\x8e\x4f\xb6\x84\x78\x54\x6c\x47\x8c\xf7\x65\x98\x5e\x60\xd9\xe3\xee\xac\xe7\x37\xcb\xc5\xbf\x0f\xff\x2c\x1f\xcf\x2b\x54\x4b\xf9\x73\x6f\xca\x55\xe0\x47\x00\x00\x00\xff\xff\x6b\x52\xe9\xc8\x3a\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4b\x6f\xe3\x36\x10\xbe\xfb\x57\x10\xb9\xec\x49\x8a\xdd\x6e\x8b\x40\x37\xd7\x4e\xb7\x39\x6c\x2c\xd8\xee\xf6\xb8\x18\x53\x63\x89\x0d\x45\x12\xe4\xc8\x85\x57\xf0\x7f\x2f\xa8\x87\x2d\xf9\xdd\x16\x6d\x93\xf8\x22\x72\x86\xf3\xcd\x37\xcf\x80\x81\x11\x5f\xd0\x3a\xa1\x55\xc4\x36\xa3\x01\x63\x6f\x42\x25\x11\x5b\xa0\xdd\x08\x8e\x63\xce\x75\xa1\x68\xc0\x58\x8e\x04\x09\x10\x44\x03\xc6\x18\x53\x90\x63\xc4\xdc\x56\x25\xe8\x84\x0b\xb4\x41\x0b\xa4\x6d\x75\x27\x61\x85\xd2\xd5\x72\x8c\x81\x31\x07\xc1\xe6\xac\xfd\x0c\x85\x7e\xbc\x75\x4f\x5b\x83\x11\xeb\x19\xe8\x0b\x70\x9d\x1b\xad\x50\xd1\x39\x3c\x41\xe3\xce\x5c\x4b\xfc\x49\xa8\x44\xa8\x74\xc0\x7a\x3e\xdb\x15\xf0\x10\x0a\xca\xb4\x15\xdf\x80\x84\x56\xe1\xdb\x53\xf5\xf0\x66\xb4\x42\x82\xd1\xbd\xbe\x47\x42\x39\x02\x29\xdf\x1d\x07\x8c\xb9\x62\xf5\x3b\x72\xaa\xf0\x04\x97\x02\x7c\x2d\xa8\x56\x4b\x9c\xe3\xda\xeb\xb7\x09\xe2\x19\xbd\xa1\x55\x11\xfd\xc9\xea\xc2\x5c\xa1\x79\x70\x2b\x05\xef\xe5\x3f\xf8\x03\x57\x99\xd6\x6f\xef\x90\x7f\xc6\x40\x29\x4d\x55\x76\xed\x41\xb9\xda\xbd\xd0\x27\x59\xa8\x0d\x2a\x97\x89\x35\xf9\xd7\xaa\x1b\x95\x06\x1c\x2d\x05\x0e\xb9\x45\x0a\x6e\x3b\xed\x0c\xf2\xfa\x6d\xa3\x2d\x35\x66\x82\x86\xad\x2e\x37\xb5\x40\xc4\x3e\x7e\xfc\xbe\xf9\x26\xb0\x29\x52\x5c\x9d\x3e\xb5\xc7\x0e\x25\x72\x9f\xd7\x8d\xd0\x7f\x47\x58\x3f\x23\x44\x0e\x29\xf6\x09\xea\x24\xc9\x8b\xbf\x5d\x90\x45\xc8\x4f\x12\xe5\xbd\x25\xc1\x85\x18\xf6\x62\x47\x90\x1e\x85\xee\xa1\x2c\xc3\x25\xa4\xbb\xdd\x43\x63\x73\x6d\x75\xde\x3a\xd5\x3e\x5a\x96\x61\xc5\xc4\x6e\x17\xb5\xe2\x8d\x44\x59\x8a\x35\x0b\xa7\xb8\x59\x14\xc6\xc7\x7d\x7f\x71\x86\xc1\x25\xa4\x7b\x2d\x94\x0e\x4f\x64\xa7\x9a\xbf\xa1\xad\x34\x9a\x1b\x91\xfb\x47\x63\x2d\x05\xdf\x1e\x40\x39\x9e\x61\x52\x48\x4c\x22\x46\xb6\xc0\xe6\xbc\x2c\x51\x25\xbb\xdd\x51\x7c\xc1\x18\x77\x31\xbc\x53\x34\x52\x6f\x73\x54\x34\xd1\x6a\x2d\xd2\x7b\x9b\xc1\x3b\x8c\xff\x21\xc6\x8e\x2c\x10\xa6\x7b\xbe\xea\xcc\x9a\xfb\x42\x07\xaa\xc9\xb2\x68\xa4\xe0\xe0\x7c\x64\xb5\x65\xe1\xbc\xf9\x66\xa3\xdd\xee\x7f\xae\x4e\x2f\x4c\x98\x1b\x09\x84\xad\xf5\x7e\x4c\x4e\xb9\xbf\x07\xe1\x5d\x28\xff\x32\xd2\x2e\xef\x9d\xae\xdb\x8c\xbd\xd7\x2b\xd9\xe3\x7f\x5c\x2b\x02\xa1\xd0\x76\x3c\x69\xeb\xf2\xb2\x16\xab\x7b\x56\xc4\x3e\xb0\x0f\xc7\x87\x71\x21\x65\x53\x2d\xec\x65\xfd\xaa\x29\xb6\xe8\xb0\x19\xc0\x87\xee\xdc\x63\x2e\x38\xe0\xa8\x7b\xf4\x8f\xc3\xe1\x70\xd8\x11\x68\xcb\x20\x47\xb2\x82\xbb\x6b\xaa\x4f\xc3\xa7\xd1\x19\xcd\x0c\x41\x52\x76\x55\xf1\x30\x2e\xba\x8a\xfd\xc1\xe2\xff\xa5\xd8\xa0\x42\xe7\x62\xab\x57\xfb\x04\xa9\x7f\x19\x91\xf9\x84\xd4\x3f\x64\xcc\x00\x65\x11\x7b\xac\x31\x7c\x3b\xbe\x3c\x0f\x5b\x28\x41\x02\xe4\x14\x25\x6c\x17\xc8\xb5\x4a\x5c\xc4\x46\x3f\xf4\x64\x0c\x5a\xa1\x93\xfd\xed\x77\x5d\xca\x2c\x42\x22\xfe\x16\x4c\xaf\xb9\xfd\x27\x28\xaf\x82\x1c\x75\x41\xa2\xda\x74\x41\xb4\x99\xf7\xdb\x78\x39\xf9\xe5\xeb\xeb\xf8\xf3\xf3\x22\x1e\x4f\x9e\x3b\x12\x8c\x6d\x40\x16\xf8\x73\x6f\x42\x34\x53\x43\xa0\x4c\xf6\x3b\x5c\xf7\xbf\xba\x89\xab\x18\xb4\x75\x1c\x7a\x43\xce\x00\xc7\x33\xe6\xe3\xd9\xb4\x32\xfe\x6f\xd9\x3d\x63\x72\x16\x3f\xcf\xc7\xcb\xd9\xfc\x82\xdd\x88\x3d\x9c\x54\xe3\xc3\x99\x67\xa6\xcf\x5f\xbe\x2e\x7e\x8d\xe3\xd9\x7c\x79\xf6\x91\xb2\xec\x0d\xca\xee\x13\x1b\x2d\x8b\x1c\x3f\xfb\x5d\xf9\xa8\x36\x7b\x75\x50\xed\x6c\xdd\x02\x64\x2c\xf7\x3a\x35\xbd\x8f\x48\xfc\xf1\x04\xe9\xe3\x69\x0d\xd5\xf9\x39\x53\x72\xdb\x1b\xa0\x2d\x8c\x0e\x82\x5b\xf6\x9d\x9f\x2a\x47\xa9\x5c\x9f\xbd\xde\xdc\x28\x0f\x7f\xda\xf8\xd5\x15\x64\x07\x0d\x59\x91\xa6\xfb\xc6\x18\xd4\xdd\x6d\x92\x81\x4a\x31\x06\x0b\x79\x07\x23\x14\xa4\x73\x20\xc1\x8f\x9c\xd9\x77\x18\x0f\xa5\x23\x1f\x5c\xe9\xad\xfd\xe5\xe7\xea\x0e\x73\x68\x52\x27\xcf\x1d\x2f\x49\xf5\xac\x79\x39\xb8\xd0\x38\x55\x9f\xd7\xab\xc7\x24\x03\x95\xe2\xe0\xcf\x01\x00\x04\x9a\xb9\x92\x32\x0f\x00\x00"),
 		},
 		"/install/role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "role.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 7490,
+			uncompressedSize: 8844,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x92\xdb\x36\x0c\xbe\xe7\x29\x38\x7b\xcc\xac\xed\xe9\xad\xb3\x2f\xd0\x43\x6f\x3d\xf4\xd2\xe9\x01\xa2\x60\x99\x31\x49\x30\x00\xe5\xcd\x26\x93\x77\xef\xe8\xcf\x2b\xd9\x94\x2c\xb9\xb6\x27\x93\xc9\xc9\x32\x00\x01\x1f\x7e\x09\x49\x2b\xb5\x37\x3e\x7f\x51\xdf\xbe\xad\xff\x34\x3e\xff\xfe\xfd\x83\x52\x10\xcc\xdf\xc8\x62\xc8\xbf\x28\xce\x40\xaf\xa1\x8c\x3b\x62\xf3\x15\xa2\x21\xbf\xde\xff\x2e\x6b\x43\x9b\xc3\x6f\x1f\x94\x72\x18\x21\x87\x08\x2f\x1f\x94\x52\xca\x83\xc3\x5a\xd5\x5f\x64\xb1\x56\xa5\x94\x85\x0c\xad\x34\xfc\x4a\x75\x78\x51\xf2\xe6\x73\x14\x23\x2d\xad\xfb\x5b\x29\xbd\xc4\x8f\x6f\x01\x5f\x14\x05\x64\x88\xc4\x09\x01\x4d\x2e\x90\x47\x1f\xdf\xd5\xac\x7a\xe2\x5c\x5a\xac\xc1\xac\x2a\x2f\xff\x60\x2a\x43\x8b\x6d\xa5\x9e\x9e\xea\x0b\x46\xa1\x92\x35\x1e\xe9\x82\x7c\x30\x1a\x41\x6b\x2a\x7d\x6c\x50\x1d\x90\xb3\xa3\x80\x71\x01\x59\xc8\x43\xc4\x65\x9a\xab\x78\x49\x00\x8d\x09\xa5\x05\xc6\xf6\x2a\x40\xd4\xbb\xf6\xba\x0c\xf9\x25\x2b\x2b\x15\x98\x3e\xa1\x8e\x6b\x0a\xe8\x65\x67\xb6\x71\x6d\x28\x0d\xa0\x95\x1c\x35\x7f\x93\x28\xa9\x7f\xfa\x11\x52\xff\x2e\xd3\x1b\x28\x97\xde\xe5\x06\xbf\xa0\xee\xff\x0f\xc4\x71\x4b\xfc\x0a\x9c\x0f\x91\x74\x77\xa1\xcf\x03\x99\x0e\xd2\x4a\x55\x48\x8c\x44\xf4\xf1\x40\xb6\x74\xa8\x2d\x18\xd7\x31\x35\xf9\xad\x29\x1c\x84\x8e\x20\xa8\x19\xa3\x0c\x55\xa7\x9d\x2c\x30\x3e\x2b\x6b\x24\x3e\x2b\xcd\x08\x11\x9f\xdb\x74\x3d\xab\x1c\x2d\xbe\xff\x6a\xb2\x16\x75\xd5\x4b\xcf\xea\xb5\x4a\xee\xd2\x98\x30\x06\x6b\x74\xdd\x8d\x9a\x7c\xe4\x4a\x1f\xcb\x24\x73\x23\x1a\x2c\xde\x0a\xf0\xb3\x0a\x53\xb8\xb3\x63\xc5\x9e\x41\xd7\x4c\xfe\x13\x65\x1d\xd8\xe3\xe5\xfd\x41\x89\x87\x20\x3b\x8a\x6b\x89\xc4\x50\x60\x3b\xc7\xd2\x30\x9b\xd2\xe8\x6e\xb9\x0c\xb1\x83\x36\x99\x4e\x08\x41\xd2\xe6\x72\x40\x47\x5e\xde\x0b\x2d\xc7\x60\xe9\xcd\xa1\x4f\x51\x7a\xb9\x3c\xa6\xbb\x77\x6f\x8f\x32\x90\x94\x08\x11\xb7\xa5\xed\x89\xf6\x49\x3d\xd9\xfb\x27\x03\xbf\x44\xf4\xd5\x09\x73\xfb\x80\x18\x5f\x30\x8a\x1c\xfb\xdf\x63\x7c\x25\xde\x07\xb2\x46\x1b\x4c\x04\xe9\x9c\x32\xd0\xf7\x03\xf4\x53\xeb\x82\xf1\xc5\x64\xd1\xa6\x3c\xbd\x3f\xb8\xb1\x21\x95\x19\x9f\x1b\x5f\x74\xe1\xc5\x43\x2f\x77\xd6\x38\x13\x19\x7c\x81\x72\x76\x14\x6e\xaa\xa2\x2c\x3b\x7a\x3d\xf3\x2d\x15\xfd\xbf\x03\x81\xb1\xf4\x0c\x65\x9a\x48\x7d\x2e\x29\x42\x9a\xd8\xbf\x21\x15\xb3\x39\x73\x7a\xa5\xb2\xd2\xd8\x7c\xc6\xb9\x5b\xcb\x35\x67\x8d\x24\x48\x9b\x57\xcc\x76\x44\xfb\x01\xef\xc1\xf9\xbc\xce\x99\x8d\xf1\x12\xc1\x47\xd3\x6c\x29\x53\xec\xcc\x78\xe0\xb7\xbe\x90\x6c\xb4\x25\x7f\xd2\x54\x8d\x73\xb7\x05\x2b\x9b\x1c\x23\x18\x7b\x12\xd2\x26\x7e\xb7\x36\xd5\x15\x6f\x2a\x73\xf3\xaa\xaa\x3a\x37\x66\xd8\x7b\x1f\x88\x6d\xb4\xc7\xe8\x83\xf1\x76\xce\xdd\x1a\x0f\xd6\x7c\x45\x3e\x09\xcf\xfd\x2b\xee\x4a\x47\xab\xfd\x27\x03\xbd\x97\x11\x7e\xaa\x2a\xcf\x65\x3a\x2d\x57\x95\xdf\xb5\x29\x3a\x56\x47\x8a\x77\x93\x91\x64\x5c\xb5\xeb\x5c\x86\x56\xcb\x49\x64\x04\x27\xe7\xa4\x86\x7b\x4e\x77\x10\x42\x6f\xc8\xf7\x38\xb2\x19\xae\xce\x3d\x56\x84\x62\xdc\xab\x3b\x95\xd6\x15\x61\x30\xae\x7a\xb6\x90\xab\xea\xe1\x9a\xa8\xff\xaf\x7c\x33\x95\x71\x8e\xc1\x5a\xee\xe1\xd1\x8f\xe8\x82\x85\x59\x00\x03\x93\xae\xd6\xb7\xbc\xbb\x47\x4e\x74\xb4\xdd\x71\x42\x6d\x3a\x5c\xe3\x29\xfd\xe1\xae\x2e\x3a\x1d\x2c\x3d\xac\x13\x7a\x2f\x49\xd2\x80\x9e\x3e\x76\x2e\x3c\x7d\xec\x9d\x01\x4f\xb7\xc2\x77\x21\x72\x53\x0f\xfe\x3f\xff\x13\xfd\x60\xcd\xed\xdb\x5f\xaa\x28\xbd\x0e\xcf\x7d\x94\x19\x17\x99\x1e\x4d\xb7\x8d\xce\xc2\x26\x92\xc4\xa2\x39\xbe\xed\xcd\xd8\xb4\x13\x5b\x43\x6a\x59\x4d\xa5\xeb\x5e\x01\xb9\x76\xbf\x98\xb1\x02\xde\x7f\xf4\xfc\xda\xef\x7e\xed\x77\x3f\xe0\x7e\x37\x48\xc0\xe5\xcd\x6f\x61\x66\xce\x2c\xf7\x5e\x80\x4c\xc5\x65\xd2\x5d\x8d\x1c\x57\x0e\x3c\x14\xc8\xa3\x2e\x56\x42\x66\x5b\x0d\x71\x4c\x99\x1b\x26\xe0\x66\x96\x92\xd5\x7c\xc1\x06\x11\xe7\xc6\xf7\xbf\x2a\xa5\xed\x58\x04\xc1\x13\xd5\x0b\x7c\x19\xfd\x86\x95\xb6\xc6\x64\x5b\x63\xcd\xf5\xe0\x45\xd6\x54\xea\x66\x96\xf4\x85\xc2\x79\xdf\x5d\x7f\xde\x6d\x79\x98\x8c\xcb\x6e\x3e\x32\x0d\x57\x3c\x49\x75\x7f\x36\xba\x94\x48\x6e\xb5\x23\x89\x0f\x8a\xa4\x06\x87\x76\x0d\x01\xf4\x0e\xd7\xc4\xc5\xf4\x6e\x7f\x03\x3c\x23\x38\x1c\x79\x13\x89\xab\x57\xd4\x9a\x18\x49\xd6\x9a\x5c\x1a\x0c\x58\xe4\xd8\x8e\x96\x2e\x7e\x81\xc9\x61\xdc\x61\x29\x78\xb2\x99\xb7\x8a\x8f\x82\x94\x9f\x52\x8e\xb7\xd6\x1f\x77\xef\xec\xa7\x26\x2f\x64\xe7\xd4\x47\x2b\x69\x8d\xdf\x2f\x07\x35\x59\xa1\x54\xb5\xcf\x0c\x04\xb5\x9c\xb6\x66\xd6\xb9\xb3\x08\x41\x33\x55\x66\x40\x08\x4c\x5f\xde\x3f\xba\x0c\x3f\xcd\xa4\xd0\x4c\x5a\xcd\x98\xf6\xc8\x6b\x70\x9f\x47\xed\x81\x8e\xe6\x80\xee\x33\x70\x44\x67\x04\x97\xfb\xbd\xac\x1c\x8c\x8f\x58\x54\x11\xb4\x6f\xe3\xdd\x57\x30\x6c\xc1\x43\x0e\xb2\xcb\x08\x38\xbf\x37\xa8\xba\x73\xaa\x6f\x45\xd5\xd1\x7a\xc0\x75\x8e\x87\x34\xb0\xb6\xc5\xc6\xf1\x4c\x59\xa9\xf7\x99\x59\x66\xf4\x0e\xbc\x47\x7b\xd1\xcc\x7f\x03\x00\xd0\x6e\x73\xb3\x8c\x22\x00\x00"),
+		},
+		"/install/webhook.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "webhook.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1857,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x93\x4f\x6f\xd4\x40\x0c\xc5\xef\xfb\x29\xac\x72\xe0\x42\x52\xed\x0d\xe5\x86\x4a\x85\x38\x80\xaa\xaa\x94\xb3\x77\xe2\x6c\x4c\x27\xf6\x68\xc6\xb3\x55\x55\xf5\xbb\xa3\xfc\xdd\x80\x56\xcb\x01\x09\x21\x84\xf6\xb2\x9e\x3c\x7b\x5e\x5e\xfc\x2b\x00\x03\xdf\x53\x4c\xac\x52\x01\xd6\x1d\xa7\xfe\x6f\xa4\x3d\x27\x8b\x68\xac\x52\x3e\xbc\x4d\x25\xeb\xe5\x61\xbb\x23\xc3\xed\x06\xe0\x81\xa5\xae\xe0\x1e\x3d\xd7\x68\x2c\xfb\xaf\xb4\x6b\x55\x1f\xae\x54\x1a\xde\xe7\xb1\x6b\x03\xd0\x91\x61\x8d\x86\xd5\x06\x00\x40\xb0\xa3\x0a\xd2\x93\xd4\x94\x38\x15\x1a\x28\xa2\x69\x2c\x9e\x9f\xcb\xcf\xd8\x51\x0a\xe8\xe8\xe5\x65\x90\x7a\xdc\x91\x4f\x63\x1b\x00\x86\x70\xec\x9b\xce\xe6\xb2\xf7\xf5\xab\xe7\xf6\x14\xa8\x82\xf9\xbe\x13\x02\xa7\x5d\x50\x21\xb1\x13\xf6\x4e\xc8\x65\x76\x5b\xc1\x09\xef\x28\xa2\x36\x24\xb0\xbc\x40\xa2\x78\x60\x47\x65\x1f\x5f\xa9\x81\x24\xb5\xdc\x58\x6f\x8d\xe5\x1b\x39\x2b\x1c\xee\xb2\xd4\x9e\x2a\xb8\xb0\x98\xe9\x62\x03\xf0\x38\x46\x3a\xcc\x28\xa6\xec\x0e\x63\xe0\x54\xae\xdc\x0c\x57\x38\xcf\x24\x36\xc6\xff\xd3\xa5\x73\x09\x70\xde\xf6\xb9\x2f\x34\x79\x59\x74\x01\xad\xad\xe0\x72\xb6\x53\xcc\x1d\x83\x20\x66\x4f\xd3\x9b\x0f\xbb\xf5\x21\x6a\x0e\x4b\x14\xc5\x3a\xc9\xe9\xec\xb8\x80\x2b\xd9\x61\x8b\x3e\xb4\xb8\x5d\x1d\xcc\xdb\xd7\xff\xc6\xaf\xf9\x63\xcb\xd5\xed\xf5\xbb\xbb\xeb\xa5\xfc\x72\xf3\xfe\x58\x46\x4a\x9a\xa3\xa3\x13\x46\x68\xdc\x99\x57\x70\x3b\x6b\x40\x1b\xb0\x96\x40\xad\xa5\x78\xcc\x2d\x01\x46\x02\x4f\x8d\x81\x69\x2f\xe0\x08\xfa\x28\xcb\x66\xbd\x01\x94\x1a\x22\x39\x15\xc7\x9e\x67\x0a\xfa\xd1\xc9\xd8\x7b\x88\x14\x34\x5a\x02\x96\x21\xba\xa3\x29\x78\x6c\x49\xfa\x89\xcb\x2c\x70\x28\xaf\x0d\x76\x04\x91\xd0\xb5\x54\x0f\x1e\x1b\x64\x9f\x23\xdd\xa8\x67\xf7\x54\xc1\xc7\xbd\x68\xa4\xcd\x6f\x20\xfc\x29\xdb\x7f\x80\xff\x1c\xc0\x35\x35\x98\xbd\xfd\x25\xfc\x76\xd9\xfe\x21\x7a\xef\xd6\xf4\x60\x08\x9e\x29\x0d\x48\x25\xec\x68\x4e\x7e\x22\x6d\x61\x54\xf6\x2b\x08\xad\x45\x03\xa7\xd9\xd7\x20\x3a\xb0\x37\x75\x9d\xa5\xef\xfb\x00\xba\xb4\x23\x07\x41\x07\x00\x00"),
+		},
+		"/internaltls": &vfsgen۰DirInfo{
+			name:    "internaltls",
+			modTime: time.Time{},
+		},
+		"/internaltls/syndesis-internal-tls.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-internal-tls.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 4903,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x6d\x6f\xdb\xb6\x13\x7f\xef\x4f\x71\x7f\xc7\x40\xdb\x7f\x23\x09\xe9\x4b\x17\x1b\xe0\x3a\xee\xe6\x25\x4d\x02\x3b\xeb\x30\x6c\x43\x40\x51\x27\x8b\xb5\x4c\x0a\x24\xe5\xd4\xf0\xfc\xdd\x87\xa3\x44\x59\x7e\x48\xd3\x76\x08\x10\x60\x2f\x0c\x88\xf7\x48\xfe\xf8\x3b\xdd\xc9\x27\x30\x41\x99\xa0\xc6\x04\x94\xcc\x57\x70\x2f\x6c\x06\x42\x5a\xd4\x92\xe5\x70\x7b\x39\x0d\xe1\x36\x43\x30\xa8\x97\x82\x23\x0c\x07\x20\x8c\x29\xd1\x80\xf5\x52\x39\x03\x8e\xda\x8a\x54\x70\x66\xd1\x80\x4a\xc1\xac\x64\x82\x46\x98\x60\x81\x96\x01\x93\x09\xa8\xb4\x73\xe2\x5c\x12\x66\x59\xcc\x0c\x42\xaa\xd5\xc2\x49\x98\x94\xca\x32\x2b\x94\x74\xbe\x36\x43\xa1\x7d\x3e\x73\xea\x62\x07\x0b\x26\xd9\x0c\x75\x2b\xf7\x62\x1b\x60\x27\x7b\x8c\xb9\xba\x3f\xa5\x64\x6a\x86\x36\x43\x5d\x9d\x88\x01\xcf\x05\x4a\xdb\x36\x86\x54\xe9\xed\x56\x29\x23\xea\xb0\x13\x00\x2b\xc4\x47\xd4\x46\x28\xd9\x87\xe5\x59\x07\x60\x2e\x64\xd2\x87\x29\x72\x8d\xb6\x03\x40\x87\xa2\x63\xf4\x3b\x00\x00\x92\x2d\xb0\xbf\x0d\xe3\xa1\x0b\x6c\x6e\x9c\x3e\x67\x31\xe6\xa6\xb2\x05\x60\x45\xb1\x35\xae\x65\x7e\x19\x0a\x15\x3d\xa6\xb7\xab\x02\xfb\x20\x64\xaa\x99\xb1\xba\xe4\xb6\xd4\x78\xc4\x8c\xab\x45\xa1\x24\x4a\xfb\xf0\xce\x8c\xd5\x42\xce\xce\x9b\x73\xcc\x71\x65\xac\xd2\x78\xc3\x8c\xb9\x57\x3a\xe9\xc3\x7a\x1d\x4e\x7d\xcc\x71\xed\x4c\x84\xb8\xd8\xb3\xdc\x6c\x1e\x06\x6d\xa8\x64\x2a\x66\x1f\x58\xf1\x2d\xb8\x05\x86\x6b\x51\xd8\xe7\x8d\x5f\x72\x80\x9c\x09\x4d\xd6\x87\xbf\xeb\x70\x27\xff\x8b\x62\x21\xa3\x98\x99\xcc\x4b\xe0\x5d\x29\xf2\xc4\xd1\x17\x6e\x2e\x86\xd3\xb3\x37\xe0\x80\xac\x24\x9f\xd8\x92\x41\x93\xd8\x00\xd3\x08\xdc\xc1\x57\x52\x75\xfa\xc2\x54\x10\x25\x58\xe4\x6a\xb5\x20\xab\xc8\xe6\xa6\xdf\xec\x20\x2c\xce\xde\x34\xc9\x9c\xc3\x5e\x7d\xd4\x05\xb6\x4d\x03\xf7\x19\x4a\x10\x16\x32\x66\x40\x49\x3c\x05\xab\x4b\x63\x9b\x68\xc7\xa3\xb0\xd2\x66\x4a\x0b\x2b\xd0\x63\x7c\xe2\x23\x9b\x95\xb1\xb8\x70\x35\x4f\x4b\x0f\x5a\x15\xbb\xaa\x41\xb3\x1b\xb8\x6d\xe2\xde\x41\x61\x1d\xd3\xa0\x85\xc0\xdf\x8e\x2a\xed\x0f\xfb\x07\xaf\x55\x22\x85\x3f\x20\x48\x21\x42\xcb\x23\x7f\x5d\xa4\xa7\x5f\x38\xc7\x15\xfc\xf5\x96\x10\x96\xb5\x3d\x80\x2a\x50\x1a\x93\x43\x31\xe7\xe6\xec\x0d\x04\xf8\xb9\x50\xda\x42\x40\xb5\xdc\x5c\x38\x04\x42\x3e\x10\x93\x93\xb1\x90\x14\xfa\xe1\xa4\x7f\x36\xe9\x00\x02\x55\x5a\xe8\xa9\xd2\x46\xed\xab\x82\xa0\x60\xc6\x90\x0a\xe5\xb2\x7f\x31\xfa\x7d\x7a\x7b\x3d\x19\xdd\xdd\x0c\xa6\xd3\xdf\xae\x27\xe7\xb5\x7f\x2a\x3a\xfe\x49\x69\xe0\x8c\x2e\xc2\x80\xdf\x5b\x31\x17\x11\x31\x27\xf2\x8a\xde\x2f\x83\x8f\x83\xbb\x9f\xaf\x3f\x8c\xa2\x4f\x1a\xa3\x5c\xc4\x91\x41\x5e\x6a\x61\x57\xc7\x6c\x8e\xe9\xdf\x42\xa2\xea\x94\x5b\x74\x7b\xde\x79\x1f\x4c\x57\x00\x56\xa9\x1c\x02\xb1\x20\x1c\xfd\x11\x21\x90\xaa\xd0\x6a\x51\x58\x08\x8c\xe6\x8d\xb8\x89\x44\x52\xc7\x34\x82\x01\x78\xc6\xe4\x0c\x85\xdd\x01\x0e\x20\x48\xd0\x6c\x43\x3a\x0c\xf7\x28\xea\x2c\x9c\x9a\xaa\xdb\x57\xd6\x56\xea\xa2\x77\x7b\x07\xf8\x76\x5b\x79\x62\x8d\x6c\xde\xac\x53\x51\x3f\x26\x4a\xa2\x47\xff\xc4\x35\xc2\xb8\x94\x49\x8e\x0d\xdb\xb7\x6d\x31\x53\x54\xda\x06\x97\xa8\x59\xde\xae\x16\x03\xf7\x99\xc8\x91\x40\x23\x3b\x61\x40\x53\xbf\xc3\xe4\xb4\x01\x8e\x58\x0f\x15\x78\xd5\xbb\x20\x15\xda\xd8\xaa\x20\x52\x60\x90\x8a\xdc\xd7\x01\xbb\x9f\x43\xb0\x74\xe5\x40\x58\xc0\x8b\xe8\xdd\xe8\xa7\xf1\x15\x0c\x47\x93\xdb\xf1\xfb\xf1\x70\x70\x3b\x8a\x60\x0d\xf2\xf5\x6b\xd8\xc0\x1a\x0a\x2d\xa4\x85\x1f\xe1\x25\xd9\x76\x23\x5f\x6b\x01\x67\x41\x17\x24\x74\x43\xae\x6d\xf7\x15\x6c\x5e\x40\xb7\x37\xbe\xba\x1d\x4d\xae\x06\x97\x77\xc3\xc1\xdd\xfb\xf1\xe5\xc8\xe3\x43\x6d\x92\x33\x62\x1c\x65\xdc\x89\xf1\x7f\xf2\xdf\xa1\x0b\x19\xbb\xbb\x20\xfb\xbd\x8b\x6a\x95\xff\x8e\xcf\x01\x83\x08\xbd\x36\x7b\x58\x2e\x98\x81\xde\x4b\x1a\x1c\x5c\x8d\xf6\x38\x03\x4a\xfd\x0a\x02\xc2\xc6\xad\xf7\x68\xb3\x4b\x99\x5e\xcd\xc8\x43\x9a\x7c\x25\x45\x1c\x13\xfc\x42\x2f\x28\x63\xad\x73\x9a\xf5\x3a\xa0\x4a\x09\x3f\x94\xb6\x64\x79\xab\x5b\x1e\x34\xc7\xf6\x3c\x43\x1d\xa9\xdd\x2c\xb7\xa4\x79\xac\x5d\x56\xd3\xca\xf3\x1b\x32\xaa\x7d\x75\x00\x4c\x81\xbc\x6a\x8f\xc6\xcd\x4d\x57\x5f\xdc\x3f\x57\x8b\x85\x92\x47\x6d\xc2\xf5\x3a\xbc\x2e\x50\x4e\x33\x91\xda\x1b\xad\x3e\x21\xb7\x9b\x4d\x68\x96\xdc\x79\x26\xd2\x90\x5b\x7d\xf6\x60\xdf\xfb\xb8\xf4\x78\xcc\x6f\xb1\x6d\xf2\x97\x86\xcd\xb6\xd9\x13\x31\x13\x96\xe5\x60\xc4\x4c\xb2\x06\xb6\x80\xf8\x0d\x28\xb9\x28\x32\xd4\xd4\xb7\x6b\x71\x95\xc1\xb5\xd3\x5a\x52\x4f\xa9\x8d\xc4\x0d\xbc\x7a\x82\xa9\xbf\x5b\xa2\xff\xc3\xa3\xd9\xd8\x9b\x87\x04\x4a\x7d\x24\x4f\xaf\xf5\x5a\x69\x78\xcc\xef\x42\xc8\x04\xba\xd5\xba\xfb\x44\xe4\x25\xe5\xf3\xa3\x2e\xed\xea\x71\xe2\xee\xec\xfd\x28\x6d\xc9\xe2\x7b\x49\x5b\xef\xe1\x40\x76\x3c\xde\xd7\x5b\xfe\x67\xe8\x5a\xbf\x87\xa5\xb2\x2d\xd7\xa1\xbf\x6d\x13\x9e\xd7\x9f\x9f\xe1\xe8\x73\xc5\xfe\xf3\xf8\xd7\xc9\xe5\x13\xf1\x3c\x89\x9f\x1f\xcb\x93\xf8\x71\x8e\x27\xf1\x97\x19\x9e\xc4\xdf\xcb\xef\x24\x3e\x94\x1c\x8f\xf5\xb5\x76\x4f\xc3\xec\x67\xc1\x63\x94\x89\x7f\xca\x0d\x1e\x70\xf4\x5f\x7d\x65\xd7\xd3\x4b\xeb\xbf\x17\x7f\xd2\x13\x78\x2f\xf2\xdc\x7f\x70\xb6\x86\xdc\x80\x33\x1a\xb9\x1e\x9c\x80\x6b\xff\x5a\x10\xc6\xf4\xda\x72\x1f\x5a\xd4\xb8\x89\x8e\x42\xd2\x95\x05\x9c\x55\x11\xfa\xd0\xb5\xba\xc4\xee\xf3\xaa\x8f\x06\x21\xaa\xdc\xf5\x3a\x00\x94\xc9\x66\xd3\xf9\x67\x00\x7f\x7c\x3d\xb8\x27\x13\x00\x00"),
+		},
+		"/oauthclient": &vfsgen۰DirInfo{
+			name:    "oauthclient",
+			modTime: time.Time{},
+		},
+		"/oauthclient/syndesis-oauth-client.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-oauth-client.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 542,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x92\xe2\x36\x10\xbe\xcf\x53\xa8\x38\xa6\xc0\xae\xdc\x52\xbc\x40\x0e\xb9\xe5\x90\x4b\x2a\x87\x46\x6e\x4c\x07\x49\xad\x48\x6d\x66\xd9\xad\x7d\xf7\x94\x0d\x06\x19\xdb\x8c\x21\x0c\xd9\xda\x9d\x13\x76\xab\xdd\x3f\x9f\x5a\xad\x4f\x62\xa1\xb6\xe4\x8a\xa5\xfa\xf2\x25\xfb\x8d\x5c\xf1\xf5\xeb\x8b\x52\xe0\xe9\x0f\x0c\x91\xd8\x2d\x55\x58\x81\xce\xa0\x92\x0d\x07\xfa\x0c\x42\xec\xb2\xed\x2f\x31\x23\xce\x77\x3f\xbf\x28\x65\x51\xa0\x00\x81\xe5\x8b\x52\x4a\x39\xb0\xd8\x98\xfa\x9d\x0d\x36\xa6\x94\x32\xb0\x42\x13\x0f\xe3\xb5\x69\xbf\x54\x71\xef\x0a\x8c\x14\x8f\xb2\xf6\xb5\x36\xfa\xd6\xb8\xec\x3d\x2e\x15\x7b\x0c\x20\x1c\x06\x14\x34\x5b\xcf\x0e\x9d\x9c\xcd\x2c\x12\xf5\x50\x19\x6c\x82\x59\xd4\x59\xfe\x1a\xb8\xf2\xc7\xd8\x16\x6a\x36\x6b\x1e\x02\x46\xae\x82\xc6\x93\x3c\x62\xd8\x91\x46\xd0\x9a\x2b\x27\x87\xa8\x76\x18\x56\x27\x05\xb2\x1e\x43\x64\x07\x82\xb7\x59\xae\xf1\x8a\x1e\x34\x0e\x18\x2d\x51\xae\x1a\x5b\x28\x1f\xf8\x6f\xd4\x92\xb1\x47\x17\x37\xb4\x96\x8c\x78\xd8\xcf\x51\xf3\x0e\x2f\x37\x80\xa1\xfe\x4c\x81\x50\x7f\xdd\x66\xd7\x73\x11\x93\xc7\x1c\x3f\xa1\xee\xba\x6c\x87\xd1\x15\x9e\xa9\xf5\xbd\x50\xb5\x4b\x8a\x82\x4e\x76\x6c\x2a\x8b\xda\x00\xd9\x76\x50\xb3\x5b\x53\x69\xc1\xc7\x93\x31\x1d\x50\xe2\x94\x6c\x4a\x94\xb9\x32\x14\x65\xae\x74\x40\x10\x9c\xab\xca\x17\xcd\x6f\x81\x06\xcf\xbf\x9a\x8d\x41\x5d\xaf\x8d\xb9\x7a\x05\xd1\x9b\x5b\x93\x0f\xe8\x0d\xe9\x66\x75\x69\x76\x12\x6a\x7b\x21\x5e\x1d\xcc\xa3\x06\x83\x8f\x0a\x78\xae\xfc\xb5\xb8\xc1\x1f\xf1\xeb\x45\x5e\x00\x5a\x76\xf1\x8c\x68\x81\xde\xf0\xde\xa2\x1b\x92\x24\x41\x9f\xf2\x4a\xbe\x4d\x24\x1d\xcd\x28\x20\xb8\xae\x4c\xa2\x9a\x8a\x9e\x0a\x05\x7e\x12\x74\x75\x6b\x7c\x3c\x20\xe4\xca\x80\x31\x9e\x0a\xdd\xa1\xbc\x72\xd8\x7a\x36\xa4\x09\x07\x40\x7a\x03\xb6\x6f\xa0\x70\xc6\x0a\x7e\x45\xae\x20\x57\x9e\x96\xf4\x2e\x81\xc7\x90\x25\x09\xe0\xca\x33\x10\xa7\x36\x99\xd7\xf3\x5e\x75\x1a\x85\xe1\x32\x7d\xed\x28\x8c\x21\xd0\xd5\x39\xc4\xf6\x4f\xc5\x02\xc3\xc2\xf4\x83\x21\xcc\xa6\xac\xf9\x85\x5a\x55\x64\x8a\x09\xcd\xba\xd1\x3b\xf4\xad\x38\x20\xca\x5f\x71\xb5\x61\xde\x76\xc6\x1e\xd6\xb9\xa6\xcd\xe7\x7d\xc9\xe4\xe4\xa2\x80\x13\x3a\xec\x93\xd7\x86\x57\xe4\x20\xec\x3b\x19\xe6\xda\xb0\xbb\xa8\xdb\x43\x72\x8f\x0d\x36\xe6\x05\x0a\x90\xb9\x80\xf4\x80\xdf\xa3\x5d\xb5\xc5\x7b\x7f\x55\xd5\xad\x79\x82\xbf\x73\xcf\xe9\x56\x56\x4f\xde\xe9\x20\xfd\xd1\x35\x39\x30\xf4\xb9\xdd\x9d\x9e\x58\x71\x77\x26\x5a\xaf\xf9\x15\xe8\xed\x78\xc2\xfd\xaa\xec\xeb\xb4\x56\xee\x2a\xbf\x7b\xa7\x28\x69\x6d\x03\xd3\xf4\x88\x96\x44\x16\x4a\x9c\x10\x5a\xa3\x17\x25\x20\x9c\x88\x55\x22\x3a\x3c\xf6\xe5\x16\xbc\x4f\x9a\x7c\x6a\x24\xef\xd2\xb0\x64\x48\xa0\x7c\x7a\x69\xdd\x01\x03\x59\xcf\xe1\x92\x30\x4e\xac\x87\x7b\x50\xff\x4f\xf3\x1d\xb8\x92\x29\x0e\x1b\xbd\xa7\xa3\x2f\x68\xbd\x81\x49\x01\xfa\xc0\xba\x66\x48\x45\xfb\x4d\xbc\xb0\xd1\x6d\x6e\xad\xf4\xb0\xc2\x75\x4f\xfb\xdb\xde\x35\x0d\x3f\x6d\x25\x24\x07\xe8\xe1\x80\x66\x3f\xcd\x4e\x4f\xc9\x1e\x30\xfb\xbf\x0e\x4c\xc9\x69\xf1\xfb\x3f\x1d\x76\x68\x6e\xea\xff\xb9\xc7\xcc\x29\x74\xfa\xfd\xd1\xb9\x95\x62\xbd\x41\xaa\xbb\x6c\x6f\x02\xd3\x9e\x48\x56\xaf\x11\xbb\x47\x03\xf2\x8e\x14\xf0\x83\xdf\x7d\xf0\xbb\x1f\x92\xdf\x5d\x5c\x12\xbc\xc5\xfc\x6e\x9c\x99\x9e\xe7\xe4\x02\xa4\x6f\x73\x94\xd6\x8d\x5d\xcd\x8f\xb1\x3b\x73\xbe\x44\x62\x83\x9d\x3b\x98\x67\xf4\xa9\xef\x9f\xe8\x75\x27\x63\x0a\xe1\x7e\xde\x34\xdc\x71\x08\x68\x5f\x72\x5d\x45\x61\xbb\xd8\x70\x94\x27\x21\xa9\xc1\xa2\xc9\xc0\x83\xde\x60\xc6\xa1\xbc\x4e\x4b\x1f\x10\xcf\x48\x1c\x96\x1d\x09\x07\x72\x65\xa6\x39\x20\xc7\x4c\xb3\x1d\x0e\x06\x0c\x06\xb1\xe0\xa0\x3c\x93\x2a\x1f\xd8\xa2\x6c\xb0\x3a\xdf\xea\x1e\x49\xe5\xd1\x70\x5f\xb1\xf9\x87\xea\x9d\xb3\x22\x27\x58\xd6\x96\xcc\x7e\x1c\xdc\x32\xc0\x1a\x1c\x14\x10\x37\x2b\x86\xf0\xb8\x3b\xc6\xb1\x43\x48\x0d\x8c\x2b\xb3\xad\x03\xa1\x1d\x66\x05\xee\x86\x03\xeb\x30\xfe\xd1\x16\x3c\x76\x7f\x5f\x77\xda\x49\x6e\xf4\x06\x9c\xc3\xcb\x7b\xc0\xbe\x9b\x7f\x03\x00\x00\xff\xff\xc5\x72\xd3\x8c\x42\x1d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x41\x6f\x22\x31\x0c\x85\xef\xf9\x15\xbe\x71\x9a\x81\x5d\xed\x29\x37\xb4\x7b\x58\xa4\x56\x54\xa0\xf6\xee\xce\x18\x26\x6d\xb0\xa3\xc4\xd3\x16\x45\xf9\xef\xd5\x4c\x87\x82\x10\x52\x6f\x89\xdf\x7b\xb6\x3f\x57\x80\xc1\x3d\x51\x4c\x4e\xd8\x82\x60\xaf\x5d\x2d\x81\x38\x75\x6e\xa7\xb5\x93\xf9\xdb\x2f\x03\xf0\xea\xb8\xb5\xb0\x5e\xf6\xda\xfd\xf5\x8e\x58\x0d\xc0\x81\x14\x5b\x54\xb4\x06\x00\x80\xf1\x40\x16\x72\xae\x2f\x4c\xab\x7f\xa5\x8c\xa2\xc7\x67\xf2\xe9\xcb\x08\x80\x21\x58\x48\x47\x6e\x29\xb9\x34\xd5\x4e\xdf\x61\xe2\x4f\xba\x1e\x03\x59\x70\xbc\x8b\x98\x34\xf6\x8d\xf6\x91\x6e\xd8\x1a\x39\x04\x61\x62\x3d\x37\xab\x46\xbe\x10\xe5\xe3\x78\x23\x30\x20\xa4\x80\xcd\xc4\x11\x88\xb7\xc3\x11\x1e\xa2\xbc\x50\xa3\x23\x4a\xa2\x26\x92\x5a\x98\x5d\x1a\xd6\xf8\x4d\xbc\x1d\xf5\x52\x66\x06\x60\x1f\x91\xf5\x9e\xb4\x93\xd6\x02\xf6\x2a\x06\x20\x52\xeb\x22\x35\xfa\xb8\x59\x8d\xe7\xa8\xa0\x53\x0d\xc9\xce\xe7\x39\xd7\x1b\xe9\x95\xfe\x4b\xd2\x61\x91\x52\x4c\xce\x15\x44\xe4\x3d\x41\xbd\x3d\xad\xb9\xf4\x4a\x91\xf1\xec\x4b\xa5\x5c\xf7\x99\xa2\xc4\xed\xf4\x72\x3b\xa8\x97\xde\xcb\xfb\x9d\x34\xe8\x87\xe4\x55\xc8\x0f\xf5\x4e\x92\xda\x3f\xbf\x17\x0b\x93\x73\x05\xc4\x6d\x29\xe6\x73\x00\x04\xd8\xae\x02\x1e\x02\x00\x00"),
 		},
 		"/prometheus-config.yml": &vfsgen۰CompressedFileInfo{
 			name:             "prometheus-config.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 18150,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x9c\x51\x73\xda\xb8\x16\xc7\xdf\xfd\x29\xce\x84\xce\xb4\xbd\x97\x3a\x6d\xee\xed\x3e\x78\xdb\xce\x50\x92\xb6\xcc\xa6\x64\x07\x92\xed\x74\x3a\xdd\x19\x61\x1f\x8c\x5a\x59\xf2\x4a\x32\x81\x6f\xbf\x63\x1b\x83\x8d\x4d\x80\x84\x60\x41\xfb\xd4\xfa\xfc\x65\xf4\xfb\xeb\xc8\x3a\x07\x28\x0d\xab\x01\x6d\x11\x4e\x25\xf5\x47\x1a\x9e\xb5\x9f\xc3\xd9\xcb\x57\xbf\x41\x0f\x3d\xf8\x44\x74\x13\x3a\xdc\xb5\xad\x58\x74\x49\x5d\xe4\x0a\x3d\x88\xb8\x87\x12\xf4\x08\xa1\x15\x12\x77\x84\x59\xa4\x09\x7f\xa1\x54\x54\x70\x38\xb3\x5f\xc2\xb3\x58\x70\x32\x0b\x9d\x3c\xff\xdd\x6a\xc0\x54\x44\x10\x90\x29\x70\xa1\x21\x52\x08\x7a\x44\x15\x0c\x29\x43\xc0\x89\x8b\xa1\x06\xca\xc1\x15\x41\xc8\x28\xe1\x2e\xc2\x2d\xd5\xa3\xe4\x65\x66\x37\xb1\xad\x06\x7c\x9d\xdd\x42\x0c\x34\xa1\x1c\x08\xb8\x22\x9c\x82\x18\xe6\x75\x40\x74\x32\xe1\xf8\xcf\x48\xeb\xd0\x39\x3d\xbd\xbd\xbd\xb5\x49\x32\x59\x5b\x48\xff\x94\xa5\x42\x75\x7a\xd9\x69\x5f\x74\xfb\x17\x2f\xce\xec\x97\xc9\x90\x1b\xce\x50\x29\x90\xf8\x4f\x44\x25\x7a\x30\x98\x02\x09\x43\x46\x5d\x32\x60\x08\x8c\xdc\x82\x90\x40\x7c\x89\xe8\x81\x16\xf1\x7c\x6f\x25\xd5\x94\xfb\x4d\x50\x62\xa8\x6f\x89\x44\xab\x01\x1e\x55\x5a\xd2\x41\xa4\x0b\x66\x65\xb3\xa3\xaa\x20\x10\x1c\x08\x87\x93\x56\x1f\x3a\xfd\x13\x78\xdf\xea\x77\xfa\x4d\xab\x01\x5f\x3a\xd7\x9f\xae\x6e\xae\xe1\x4b\xab\xd7\x6b\x75\xaf\x3b\x17\x7d\xb8\xea\x41\xfb\xaa\x7b\xde\xb9\xee\x5c\x75\xfb\x70\xf5\x01\x5a\xdd\xaf\xf0\x47\xa7\x7b\xde\x04\xa4\x7a\x84\x12\x70\x12\xca\x78\xfe\x42\x02\x8d\x6d\x44\x2f\xf6\xac\x8f\x58\x98\xc0\x50\xa4\x13\x52\x21\xba\x74\x48\x5d\x60\x84\xfb\x11\xf1\x11\x7c\x31\x46\xc9\x29\xf7\x21\x44\x19\x50\x15\x2f\xa6\x02\xc2\x3d\xab\x01\x8c\x06\x54\x13\x9d\x5c\x29\x41\xc5\x19\x62\x29\x4d\xa4\x3e\x47\x46\xa6\x7d\x74\x95\x03\xaf\x2d\xa5\x98\x03\x43\xc2\x14\x5a\x03\x46\xdc\x9f\x8c\x2a\x7d\x35\xf8\x81\xae\xee\x92\x00\x95\x03\xdf\x4e\x7e\x90\x31\xb1\xe3\x09\x38\xff\x39\xf9\x6e\xc9\x88\xa1\x72\xe2\x39\x4f\xb9\x87\x8a\x2a\x68\x93\x00\x19\xb4\x05\xd7\x38\xd1\x16\xc0\x0b\x08\x89\xd6\x28\xb9\x03\x4f\xa9\xb0\xd5\x4c\x67\xbb\xb1\xee\x8d\x9b\xea\xde\x3e\xfb\xf6\x77\xf3\xfb\x7f\x9f\x37\x41\x4f\x43\x7c\x3b\xbb\xda\x04\x4e\x02\xcc\x62\xef\xde\xbc\xbb\x24\x4a\x5f\x4c\xdc\x11\xe1\x3e\xb6\xe3\xbc\x43\x8d\xde\x35\x0d\x50\x69\x12\x84\x4f\xad\x38\x81\xe2\x21\x0e\x94\x5e\xc9\xbe\x7b\x6c\x32\x74\x84\x2c\x74\x20\x16\x42\xa6\x84\xb9\x14\x8a\xda\x78\x9e\x0e\xb4\xaf\x6e\xba\xd7\x17\xbd\xe4\x0a\x23\x03\x64\xca\x49\xfe\x0e\x30\x43\x70\xe0\xc9\xab\xd9\x95\x74\x84\xfb\xe8\xc6\x7c\x20\x94\x45\x12\xef\x61\xcb\xf2\xc8\x95\xa6\xcc\x84\x46\x5b\xd2\x8f\x93\x7b\x43\x13\x8a\xda\x1c\x76\x12\xd8\x31\x66\x23\xdb\x1c\xc0\x70\x8c\x6c\x09\x5b\x48\x3f\x7b\xf0\xdd\x87\x3a\x5b\x22\x35\xcf\xdb\x3c\xf9\xf2\xcd\xed\xb2\x3c\x07\x3f\x0f\x42\x31\xba\xdb\x75\xde\x15\x70\x9c\x93\x9b\xd2\xa6\xda\x4a\xd4\x5c\xc8\x4c\xce\x0e\x1f\xb2\xf8\xe4\xdf\x8c\x34\x53\x57\xb2\x16\x82\x66\xd2\x5e\x0b\x4d\xd8\x66\xa8\x89\xb4\x92\x73\x11\x31\x0a\x72\xf6\x14\x55\x9f\x08\xf7\xd6\xa5\xee\x92\x36\x87\x99\x45\x20\x1f\x2a\x52\x55\x71\x95\xc9\xca\xee\xec\x74\x41\xe3\xfb\x10\xd6\x43\x0f\x19\x1d\xa3\xa4\xa8\xd6\x2c\x6b\x79\x40\x61\x71\xd3\x30\x94\xe2\xc6\xa1\x7f\x26\x93\x3f\xa5\x70\x51\x29\xca\xfd\xf8\x28\xb9\x93\xbb\xa4\xce\x41\x7f\x26\x13\x1a\x44\x01\x2c\x04\x30\x57\x3c\x04\xfb\x63\xeb\xe6\xe3\xc5\x8e\xa1\x91\xf0\x6d\xa8\x4b\xf2\x3c\x36\x12\x7e\x10\xcc\x74\x2b\xe4\x65\x75\x9e\x98\xf2\x43\x59\xe8\x8d\x37\xf4\x8a\x8d\xbc\xd3\xfd\xfb\x08\x7c\xc9\xd9\xb1\xc5\xb2\x56\xe8\x73\xb4\x49\xd4\xb0\x65\x55\x51\x80\x52\x95\xc0\xb3\xe2\x60\x7e\x8c\xde\x89\x5d\x52\xe7\xa0\xb3\x18\x14\x83\x0f\x27\xb6\x1a\xd0\x13\x91\xc6\x87\x14\xd2\x32\xbe\x41\x99\xde\xcc\x3a\x3a\x99\xac\x03\x4f\xce\x0a\xc5\x49\x8a\xf0\x38\xf8\x26\x54\xd5\xfb\xa7\x36\xa7\xc6\xde\x3f\x7b\xed\x15\xf7\xde\x90\x6b\xae\xbf\x97\x39\x4b\xa4\x3b\xa9\x4a\x57\x2e\xb7\x49\xf5\x78\xad\x56\x98\x53\x9f\x6f\x6c\xc3\x03\x0e\xfc\x55\x26\x98\x52\xaf\xd7\xe9\x81\x31\xf5\x7b\x8d\x26\xd4\x52\xcf\xd7\xc8\x6b\x52\x7d\x5f\xa3\x0d\x46\x54\xfb\x5b\xf2\x5b\x8d\xcc\x69\x21\x1f\xd2\x02\x84\xd9\x4d\x0e\xa5\x0d\x98\x4f\xb8\x54\x25\x2d\x50\x1e\xcf\x0a\x13\x5a\x82\x7a\x1d\x30\xa7\x3d\xa8\xd7\x87\xda\x5b\x85\x5a\xf0\x6b\x6e\x1b\xaa\x98\x2b\xa9\x77\x52\x33\xdf\x99\x06\x26\xb5\x10\xc6\xd8\x62\x4e\x3b\xb1\xb5\x25\x0f\x28\x2b\xee\x32\xc4\x94\xd6\xc2\x14\x3f\x8c\x69\x33\x0c\x31\xa4\x96\x96\xc3\x10\x76\x93\xda\x0f\x43\x2c\x31\xa2\x15\xb9\xf7\x71\x92\x7e\xbd\x27\xfd\x4c\xe5\x28\x3f\x92\xc9\xa6\x57\x61\x8c\x9b\x03\x2f\xb5\x6a\x7d\x94\x63\xea\xde\xf7\x2d\x5c\x35\x1b\x5d\x75\xdc\x9e\x47\x32\xf9\x9a\xe3\xba\x83\x36\xd3\x55\x1c\xb1\x85\xd0\x26\x2e\xcc\xe6\x53\x61\x82\x5a\x70\xee\x60\x9f\xac\xe6\x46\xc2\x37\x03\xcf\x09\x97\x4f\xd2\x03\xc4\xa6\x1b\x52\xd3\x4a\xe8\xd9\x81\x79\x78\xdc\xc9\x93\x7d\x23\xf2\x82\xb2\x74\x36\x1c\x20\xf9\x48\x22\xf1\xd4\x7b\x26\xdc\x9f\x6b\xba\xad\xa2\x34\xcf\x9e\x06\x20\x1f\x39\x24\xf8\x0e\xd7\x28\x65\x14\xae\x7b\xff\xa9\x2c\xaf\x30\x61\x39\xba\x77\x23\x26\xc3\x37\x83\x48\xd9\xd4\x5b\x72\x61\xfe\xaf\xd9\x3d\x17\x17\x42\x21\x73\x96\x89\x10\xd3\x24\xce\x19\xd5\x8d\x82\x4b\xe1\x53\x97\xb0\x5e\xc4\x35\x0d\xf0\x03\x89\x98\x5e\x79\x40\x4e\x86\xf6\x8a\x11\x39\xc3\xba\x51\x30\x40\x09\x62\x08\x2c\x15\x82\x4c\x95\x30\x5c\x48\x8b\xc8\x65\x37\x53\xd2\x8a\x2f\x35\xe4\x9c\x5c\xb8\xfb\xbf\x45\x01\x22\x64\xbc\x04\xff\x9f\x5f\x98\x53\x3b\xf0\xe4\xf5\xa3\xb9\x6b\xb0\x95\x65\x27\x97\x8d\xac\xf0\x31\x67\xe3\x3e\xd3\xb1\x35\xf6\x7b\xa8\x42\xc1\x15\xde\x55\xd2\x4f\x86\xf6\x92\x32\xe7\x59\x6b\x8c\x92\xf8\x08\x59\x78\x51\xcc\x3f\xb2\x51\xfb\x4d\xb7\x5a\xad\xaa\xde\xa0\x15\x5b\xb4\x7a\x93\xd6\x96\x5f\xdd\x28\xe8\xf0\xb1\x70\xd3\xff\xde\x73\xf7\xd6\xcc\x09\x2b\x77\x24\x5d\x8a\x1f\xe5\x13\xed\x90\xdd\xda\x67\x62\x7d\x26\x93\x0d\x77\xe3\x92\xb2\xa2\xa9\xda\x7e\x37\x1e\x5e\x6a\x1d\xbc\x5f\x7b\x4d\x2e\xca\x37\x35\xab\xa8\xac\x68\xe1\x8e\x3e\xb9\x8e\xd4\xc2\x7d\x9a\x95\xb4\xbc\xc9\xe7\x6b\x6b\xde\x5a\x9d\x0c\xed\x92\xb6\xd4\x3a\x67\xc1\xa3\xcd\xb8\xe3\x70\x6c\xcf\x65\xd8\x16\x3d\xd2\xfa\xe6\xe8\x57\xe9\x2f\x8f\xc0\xb4\x3d\xa7\xd9\x0d\x6f\x8f\xd0\xfd\x89\x5e\x2b\xfd\x75\x83\x58\xb1\x89\x7d\xab\xc7\x55\x7a\x19\x71\x37\x95\x67\xbf\xa2\x10\xeb\x8f\x3e\x1d\x7f\x21\x73\xf7\x9c\xb6\xf7\xf3\x75\x2b\x57\xeb\xf7\x74\xff\x09\xfb\x0b\xd8\xfa\x6f\x00\x00\x00\xff\xff\x99\x2c\x87\x35\xe6\x46\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x9c\x6d\x6f\x1a\x3b\x16\xc7\xdf\xf3\x29\x8e\x42\xa4\xb6\xbb\x94\xb4\xd9\xed\xbe\x98\x4d\x23\x51\x92\xb6\x68\x13\xb2\x02\x72\xab\xaa\xea\x95\xcc\x70\x00\xb7\x1e\xcf\x5c\xdb\x43\xe0\xdb\x5f\x79\x1e\xc0\x8c\x27\x03\x49\x78\x18\x92\x77\xad\xcf\xdf\x13\xff\x7f\x3e\xc6\xe7\x00\x49\xb5\x52\x85\xa6\x1f\xcc\x04\x1d\x8d\x15\xbc\x6e\xbe\x81\xd3\x77\xef\xff\x03\x1d\x1c\xc0\x57\xa2\x6a\xd0\xe2\x6e\xbd\xa2\x45\x57\xd4\x45\x2e\x71\x00\x21\x1f\xa0\x00\x35\x46\x68\x04\xc4\x1d\x63\x1a\xa9\xc1\x1f\x28\x24\xf5\x39\x9c\xd6\xdf\xc1\x6b\x2d\x38\x4a\x42\x47\x6f\xfe\x5b\xa9\xc2\xcc\x0f\xc1\x23\x33\xe0\xbe\x82\x50\x22\xa8\x31\x95\x30\xa4\x0c\x01\xa7\x2e\x06\x0a\x28\x07\xd7\xf7\x02\x46\x09\x77\x11\xee\xa8\x1a\x83\x5a\x3c\xbf\x5e\xa9\xc2\xf7\xe4\x11\x7e\x5f\x11\xca\x81\x80\xeb\x07\x33\xf0\x87\xa6\x0e\x88\x8a\x16\x0c\x00\x30\x56\x2a\x70\x4e\x4e\xee\xee\xee\xea\x24\x5a\x6c\xdd\x17\xa3\x13\x16\x0b\xe5\xc9\x55\xab\x79\xd9\xee\x5e\xbe\x3d\xad\xbf\x8b\xa6\xdc\x72\x86\x52\x82\xc0\xbf\x42\x2a\x70\x00\xfd\x19\x90\x20\x60\xd4\x25\x7d\x86\xc0\xc8\x1d\xf8\x02\xc8\x48\x20\x0e\x40\xf9\x7a\xbd\x77\x82\x2a\xca\x47\x35\x90\xfe\x50\xdd\x11\x81\x95\x2a\x0c\xa8\x54\x82\xf6\x43\xb5\x04\x2b\x5d\x1d\x95\x4b\x02\x9f\x03\xe1\x70\xd4\xe8\x42\xab\x7b\x04\x9f\x1a\xdd\x56\xb7\x56\xa9\xc2\xb7\x56\xef\xeb\xcd\x6d\x0f\xbe\x35\x3a\x9d\x46\xbb\xd7\xba\xec\xc2\x4d\x07\x9a\x37\xed\x8b\x56\xaf\x75\xd3\xee\xc2\xcd\x67\x68\xb4\xbf\xc3\xff\x5a\xed\x8b\x1a\x20\x55\x63\x14\x80\xd3\x40\xe8\xf5\xfb\x02\xa8\xc6\x88\x03\xcd\xac\x8b\xb8\xb4\x80\xa1\x1f\xef\x9e\x0c\xd0\xa5\x43\xea\x02\x23\x7c\x14\x92\x11\xc2\xc8\x9f\xa0\xe0\x94\x8f\x20\x40\xe1\x51\xa9\x37\x53\x02\xe1\x83\x4a\x15\x18\xf5\xa8\x22\x2a\x1a\xb1\x4c\xe9\x0c\xa9\x48\x45\x84\xba\x40\x46\x66\x5d\x74\xa5\x03\x1f\x2a\x52\x32\x07\x86\x84\x49\xac\xf4\x19\x71\x7f\x33\x2a\xd5\x4d\xff\x17\xba\xaa\x4d\x3c\x94\x0e\xfc\x38\xfa\x45\x26\xa4\xae\x17\xe0\xfc\xe3\xe8\x67\x45\x84\x0c\xa5\xa3\xd7\x3c\xe3\x03\x94\x54\x42\x93\x78\xc8\xa0\xe9\x73\x85\x53\x55\x01\x78\x0b\x01\x51\x0a\x05\x77\xe0\x15\xf5\xeb\x32\xd1\xd5\x5d\xad\x3b\x73\x63\xdd\xc7\xd7\x3f\xfe\xac\xfd\xfc\xe7\x9b\x1a\xa8\x59\x80\x1f\x93\xd1\x1a\x70\xe2\x61\x1a\x3b\x3f\x3b\xbf\x22\x52\x5d\x4e\xdd\x31\xe1\x23\x6c\xea\xbc\x43\x85\x83\x1e\xf5\x50\x2a\xe2\x05\xaf\x2a\x3a\x81\xf4\x14\x07\xac\x9f\x54\x2f\x9e\x1b\x4d\x1d\x23\x0b\x1c\xd0\x42\x48\x95\x30\x97\xc2\xb2\x56\xaf\xd3\x81\xe6\xcd\x6d\xbb\x77\xd9\x89\x66\x33\xd2\x47\x26\x9d\xe8\xdf\x00\x89\x05\x07\x8e\xdf\x27\x23\xf1\x0c\x77\xeb\x60\x3e\x13\xca\x42\x81\x8f\xc0\x92\x9d\x79\x2f\x94\x44\x58\x6a\x24\x5d\x9d\xdc\x6b\x42\x58\xd6\x1a\xb6\xa3\xc0\x86\x6d\x56\xd3\xc3\x01\x0c\x27\xc8\x32\xb6\x7d\x31\x4a\x5f\xf8\x1e\xe3\x3a\xdd\x22\x39\xcf\x5b\xd3\x79\xf6\xe1\x75\x5b\x6e\x98\x9f\x07\x17\x87\x60\x0b\xfb\xbc\x29\xc3\x3a\x27\xd7\x75\x1b\x6b\x73\xad\x1a\xa1\x72\xfa\x6c\xf1\x21\xd3\x37\xff\x7a\x4e\x53\x75\xae\xd7\xa5\x60\x39\xdd\xf6\x7c\x45\xd8\x7a\x56\x23\x69\xae\xcf\x45\xa4\x54\x26\x93\x57\x51\xf9\x95\xf0\xc1\xaa\xd4\xcd\x68\x0d\x9b\x69\x04\xcc\xd0\xb2\xab\x3c\x5f\xb6\x33\x9b\xce\x46\x37\x54\x1f\x77\xc2\x3a\x38\x40\x46\x27\x28\x28\xca\x15\xdb\x6a\x4f\x58\xda\xdc\x38\x0c\x56\xbc\x74\xd6\xaf\xc9\xf4\xff\xc2\x77\x51\x4a\xca\x47\xfa\x2a\x29\xf4\x6d\xa9\x0d\xd3\xd7\x64\x4a\xbd\xd0\x83\x85\x00\xe6\x8a\xa7\xd8\xfe\xd2\xb8\xfd\x72\xb9\x61\xd3\x48\xf8\x43\x5c\x5b\x72\xd3\x36\x12\x7e\x10\x9e\xe9\x83\x2c\xd3\x02\xc7\x94\x1f\xca\x46\x9b\xe7\xaf\xd0\xaf\x75\x50\xe3\x6a\xdb\x1a\x2e\x99\xbf\xe8\xee\x78\xc0\xb6\xe6\xe8\x0d\xb7\x51\xb4\x64\xdb\x2a\x43\x0f\x85\xb4\x8c\xa7\xc5\xc1\xfc\x1a\x2d\xb4\x6d\xa9\x0d\xd3\x69\x6c\x71\x23\x6f\xc8\x71\xa5\x0a\x1d\x3f\x54\xf8\x94\x42\x5a\xe8\x07\xd8\xee\xed\xc2\xb8\xd0\xbe\x2d\x37\xfc\x6f\xae\x8e\x8e\x16\xeb\xc0\xf1\x69\xf2\xff\xf8\x09\xd1\xa8\xdc\x8e\xfd\x32\x54\xd5\xbb\x77\x9d\x66\xec\x7a\xbe\x53\x75\xae\xf3\xa5\xe0\x21\x78\xdf\x7b\xc5\xbd\x33\xcb\x99\x9a\xba\xd0\x73\x46\x6b\x98\x7e\x74\xfd\x9d\xf5\x69\x39\xb5\xe1\x6d\x72\xbb\xcb\x54\x8f\xef\x15\x45\x79\xea\xf3\xb5\x31\x3c\xe1\xc2\xbf\x0f\x42\x59\xea\xf5\x7d\x32\x28\x4d\xfd\xbe\x47\x08\xe6\xf9\x2d\xf4\xbf\xc9\x7a\x7e\x8f\x7e\xcb\x54\xdf\xef\x11\x43\x5a\xa6\xcc\xaf\xf0\x42\x08\x96\xda\x40\x90\xc6\x16\xd5\xc0\xb6\xfc\x57\xaa\x29\x69\x5f\x3c\xa5\x05\x08\xd2\x87\xd8\x58\xec\xba\xbe\x90\x8b\x2d\x37\xc0\x6c\xae\x0d\x98\x2f\xd8\x60\x15\x3f\x65\x61\x65\x7b\x28\xca\xd0\x12\xec\x97\x40\x9a\xe2\xeb\x31\x48\xd5\xb9\x14\x96\x82\x87\xc6\x61\xef\xad\xc2\x5e\xec\x67\x5a\x81\x42\xff\x19\xad\x01\xe0\xd1\x6d\x43\x9e\xe7\x5c\xd7\x36\xd0\x4d\xa7\x41\x99\x5a\x88\xd2\x60\x29\x4f\x3b\xf1\x60\x24\x4f\x28\x2b\x8a\x80\x94\xa5\xb5\x28\x0b\x8f\xd2\xb4\x19\x25\x01\x62\x9e\xf7\x42\x16\x9b\x6c\x39\x4a\xe2\xbd\x4c\xed\x47\x49\x90\xa4\x25\xd1\xbc\x44\x28\x04\x62\xa9\x0d\x1c\x69\x6c\x51\x6d\x6c\xff\x3a\x89\xbe\x9c\x99\x7c\xa6\xf2\x2c\x3f\x92\x49\x97\x97\x03\x66\xbe\x72\x2b\x47\xf4\x17\x04\x51\x4c\xa8\xfb\xd8\xb7\x70\x65\x32\xdb\x62\x72\x4d\xa6\x17\xa1\x88\xbe\xe6\x58\x48\xc3\xd0\xe5\x5c\xb1\x4b\xa1\x75\x28\x24\xeb\xc9\x81\x90\xae\xd4\x62\xb0\x61\xdf\x48\xf8\x7a\xc6\x0d\xa1\xe9\x5c\xdf\xa4\x07\x68\x9b\xae\xe9\x9a\xe6\x9a\x4e\x2e\xcc\xc3\xf3\x1d\xbd\xb2\xaf\xe5\x7c\x49\x69\x78\x8f\xc6\x0f\x70\xc7\x7b\x63\x81\x64\x20\x3f\x31\xdf\xfd\xbd\xa2\xdb\x5a\x96\x9a\xde\xe3\x00\x98\x91\x43\x32\xdf\xe2\x0a\x85\x08\x83\x55\xef\x3f\xd9\xf2\x1c\x08\xd9\xe8\xce\x41\x4c\x87\x67\xfd\x50\xd6\xe9\x20\x43\x61\xfe\xbf\xe4\x99\x8b\x70\xe0\x0b\x03\x99\x1f\x60\x7c\x0e\xd2\xa1\xf3\xb3\xf3\x76\xe8\x5d\xf9\x23\xea\x12\xd6\x09\xb9\xa2\x1e\x7e\x26\x21\x53\xf7\x5e\x90\xd3\x61\xfd\x9e\x19\x06\xb0\x76\xe8\xf5\x51\xe8\xdf\x55\x60\xb1\x10\x44\xfc\x6c\x18\x2e\xa4\xcb\x96\x6d\x9a\xb1\xd3\x25\x98\xf1\x14\x83\x64\xe2\xd7\x81\xe3\x7f\xcd\xc7\xb4\x65\x07\x8e\xff\x3d\x1f\x98\xbb\x76\xe0\xf8\xc3\xd6\xe8\x96\x18\xa5\x4d\x32\x0b\x32\x87\xa3\x81\x71\x97\xe9\xd8\x98\x8c\x3a\x28\x03\x9f\x4b\x2c\x2a\xe9\xa7\xc3\x7a\x46\x69\x30\x6b\x4c\x50\xe8\xdf\xf1\x48\xc3\x8b\x62\x7e\xcb\xa0\x76\x9b\x6e\x7b\x45\x95\x4b\x2b\x07\x58\x3e\xb3\xfd\xe5\x57\x3b\xf4\x5a\x7c\xe2\xbb\xd1\xf8\x8a\xa3\x69\x08\x73\x4f\x24\xcd\xc4\x57\x21\xcb\x01\x66\xe1\xca\x83\x95\xcd\xb0\xdd\xa5\xd8\x81\xd3\xda\x65\x62\x5d\x93\xa9\x79\xc6\x0a\x58\x65\x94\x39\x4d\xd5\xc3\x4f\xe3\xe1\xa5\xd6\xc1\xf3\xda\x69\x72\x51\xbe\x2e\x2c\xca\xef\x83\x95\xb4\x70\x7b\x81\xb5\xcb\xe4\x7a\xa6\x08\x77\x09\x2b\x6a\x79\xa3\x8f\xde\x56\xbc\xb5\x3a\x1d\xd6\x2d\xad\x01\x2c\x8a\x41\x1a\x7c\xb6\x19\xf7\x3c\x88\xed\x32\xc1\xda\xa1\xf7\x80\x1e\x69\x75\x73\xf4\x52\xfa\xcb\x67\x00\x6d\xc7\x69\x76\xcb\x9b\x63\xd4\xef\x6a\x35\xe2\xbf\x6e\xa0\x5f\x0c\xd6\xc1\x77\xff\xbc\x5c\x96\x21\x77\xe3\x1f\x93\xfe\x15\x05\xad\x7f\xf6\xe9\xf8\x82\xe0\xee\x38\x6d\x1f\xc7\xf5\x41\x54\x5f\x62\xc2\xbe\x00\xac\x7f\x0f\x00\x99\x2c\x87\x35\xe6\x46\x00\x00"),
 		},
 		"/route": &vfsgen۰DirInfo{
 			name:    "route",
@@ -346,9 +473,31 @@ var assets = func() http.FileSystem {
 		"/route/route.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "route.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 475,
+			uncompressedSize: 690,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x51\xc1\x4e\xc3\x30\x0c\xbd\xf7\x2b\xac\xdd\x5b\x84\xd8\x01\xe5\x8e\xc4\x71\x1a\x88\xbb\x49\xbd\xd5\xa2\xb3\x23\xc7\x1d\x54\xd3\xfe\x1d\x35\x5d\x99\x7a\xe2\x96\xbc\xf7\xf2\xfc\x5e\x5c\x03\x26\xfe\x20\xcb\xac\x12\xc0\x74\x70\x6a\x34\x91\xe4\x8e\x0f\xde\xb0\x3e\x9c\x1f\x2b\x80\x2f\x96\x36\xc0\x7e\x62\x2b\x80\x13\x39\xb6\xe8\x18\x2a\x00\x80\x1e\x3f\xa9\xcf\xf3\x19\x00\x53\x0a\x90\x47\x69\x29\x73\xbe\x61\xcb\x75\xb2\xfb\x8f\xf7\x31\x51\x00\x96\x83\x61\x76\x1b\xa2\x0f\x46\x45\x86\x22\xea\xe8\xac\xf2\x37\x2b\xaa\x64\xed\xa9\xc1\x3e\x75\xb8\x4e\xad\x67\xb2\x33\xd3\x77\x8d\x29\xd5\xa5\x55\x80\x8d\xdb\x40\x9b\xf2\x56\xf0\x44\xab\x18\x39\x51\x9c\x6d\x3b\xcd\x1e\xe0\x72\x69\x4a\xdb\x57\xcd\x3e\x89\xaf\xd7\x42\x26\x35\x5f\xa6\x3b\xda\x91\x7c\x37\x21\xf0\xbc\xdd\x3e\x15\xd8\xef\x3f\xc1\x92\x29\x0e\x46\x2f\xed\x91\xde\xc9\x4e\x2c\x25\xfd\x4e\x7b\x8e\x63\x80\x3d\xb5\x6c\x14\x7d\x71\xbb\x2b\x02\x18\x91\x44\x1b\xd3\x4c\xba\x2e\x96\xf3\x1a\xde\xa6\x6a\x91\x6e\xd8\xba\x4a\xad\x38\x78\x97\x4c\x7f\xc6\xea\x37\x00\x00\xff\xff\xbc\x15\x3a\x3e\xdb\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x4d\x6b\xdb\x50\x10\xbc\xeb\x57\x2c\xc6\xc7\x4a\xa5\xd4\x87\xf2\xa0\x87\x1e\x0a\xa5\xe4\x60\xec\x90\xfb\xe6\x69\x6d\x3d\x22\xef\x3e\xf6\xad\x94\x08\xa1\xff\x1e\xf4\x15\xc7\xa7\x10\x72\x93\x66\x77\x66\x67\x46\xca\x01\x63\x78\x20\x4d\x41\xd8\x81\x4a\x63\x54\x48\x24\x4e\x55\x38\x59\x11\xe4\x7b\xfb\x23\x03\x78\x0a\x5c\x3a\x38\x8c\xd3\x0c\xe0\x42\x86\x25\x1a\xba\x0c\x00\xa0\xc6\x47\xaa\xd3\xfc\x0c\x80\x31\x3a\x48\x1d\x97\x94\x42\x5a\xb0\xf5\x75\x94\xfb\x68\x6e\x5d\x24\x07\x81\x4f\x8a\xc9\xb4\xf1\xd6\x28\x65\x7d\x9f\x83\x22\x9f\x09\xb6\x8c\x17\xfa\x06\xdb\x16\xeb\x86\xc0\xfd\x86\x6d\x71\x5c\xd9\x93\xbd\xe2\x6e\xb2\x33\x0c\x8b\x76\xdf\x9b\xfc\x4f\xc2\x33\x73\x18\xdc\x3b\x64\x12\x19\x86\x49\x9e\xb8\x5c\x38\xc8\x2c\x86\x16\x84\xdf\x42\x79\xe1\x24\x35\x15\x58\xc7\x0a\x6f\xeb\x91\x96\xb4\x0d\xf4\x9c\x63\x8c\xf9\x54\x9f\x83\x8d\x69\x43\x9b\x4f\xb9\xfe\x73\x3d\xfa\x05\xeb\xe3\x99\x9b\x76\x53\x24\x3f\x87\xa8\x24\xd9\x98\x7d\xbe\xf7\x4f\x92\x8d\xcb\x0b\x2f\x8a\xda\x9a\xd5\x50\xcf\x64\xfb\x11\x81\x5f\xbb\xdd\xcf\x09\xb6\xeb\x07\x0e\x9c\xc8\x37\x4a\x7f\xcb\x33\xdd\x93\x5e\x02\x4f\xb6\xf7\x52\x07\xdf\x39\x38\x50\x19\x94\xbc\xad\x6a\xd7\x0d\x07\x4a\xc4\x5e\xbb\x38\x0f\x4d\x56\xc9\xf9\xef\x3a\x8e\x45\x7a\x5a\xb0\xdb\x28\xb9\x60\x63\x55\x54\x79\xe9\xb2\xd7\x01\x00\x3b\x53\x05\x0d\xb2\x02\x00\x00"),
+		},
+		"/smoketest": &vfsgen۰DirInfo{
+			name:    "smoketest",
+			modTime: time.Time{},
+		},
+		"/smoketest/syndesis-smoke-test.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-smoke-test.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1835,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x5d\x6f\xda\x4a\x10\x7d\xe7\x57\x8c\xb8\x91\xb8\x57\xba\xcb\x26\x6d\x14\x55\x96\xfa\x10\xa5\xad\x9a\xb4\x21\x48\xd0\x2a\xaf\xcb\x7a\x88\xb7\xac\x77\xdd\x9d\xb1\x53\x4a\xf9\xef\x95\xd7\x10\x30\x81\x24\x95\x2a\x23\x21\xcf\x97\xcf\x99\x39\x33\x02\x54\x61\xbe\x62\x20\xe3\x5d\x02\x13\xc5\x3a\x93\xd5\x49\x07\x60\x66\x5c\x9a\xc0\x95\x9f\x74\x00\x72\x64\x95\x2a\x56\x49\x07\x00\xc0\xa9\x1c\x13\xa0\xb9\x4b\x91\x0c\x09\xca\xfd\x0c\x05\x23\x71\xf4\x5a\x35\x41\x4b\x4d\x24\x80\x2a\x8a\x4d\xe8\xca\xb6\x7e\xed\x1b\x2f\x9f\xf3\xf3\xbc\xc0\x04\x8c\x9b\x06\x45\x1c\x4a\xcd\x65\xc0\x3d\x61\xda\xe7\x85\x77\xe8\xf8\x10\x2e\x2a\x50\x37\x98\x26\x4a\xcf\xfc\x74\xfa\xd9\xe4\x86\x13\x78\x15\x6d\x4a\xb3\xa9\xf0\x1d\xaa\xd4\x1a\x87\x23\xd4\xde\xa5\x94\xc0\xd9\xf1\x71\x74\x33\xe6\x85\x55\x8c\x6b\x52\xed\x76\x3c\x26\x7d\x88\xf8\x4b\xc8\xff\x31\x33\x80\x6d\x76\xf5\xf3\x0f\x8c\x54\x8e\x40\x18\x2a\xa3\x11\x94\xd6\xbe\x74\x0c\x8a\x80\x33\x04\xaf\x4a\xce\xa0\x08\xfe\xc7\xfc\xff\x68\x20\x1d\x4c\xc1\x50\x9b\xd1\xb1\xd1\x8a\xb1\x89\xbc\x57\xf3\xf8\x1f\x63\x21\xf5\xb8\xc1\xb8\xaa\x7d\xde\x94\x1e\xb4\x05\x11\xbf\x20\xb4\x35\xe8\xd6\x00\x01\x02\x12\xab\xc0\x43\x6f\x8d\x9e\x27\x30\xc0\x0a\xc3\x83\x53\x7b\xc7\xca\x38\x0c\x5b\x2d\x14\xcf\xe8\xac\xf9\x99\x5c\xdd\x61\x02\xbd\xc5\xa2\x3f\x5a\x05\xf6\x47\xf5\xe0\xc7\x48\xdc\xbf\xac\xbd\xcb\x65\x6f\x37\x61\x58\x5a\xbb\x46\x72\x39\x1d\x78\x1e\x06\xa4\x6d\xb4\x00\xda\xe7\xb9\x72\xe9\x06\x0f\x80\x00\x39\x31\x4e\x52\xd6\xb2\x09\xdd\x59\x2c\x04\x98\x29\xec\x43\x30\x8a\xcd\x5d\x2e\x5b\x29\x8b\x05\xfb\x2b\xf2\xee\xe9\x8c\xba\x2a\x5a\xc2\x9d\xe4\x5f\x5b\x6f\xf5\x20\x18\xc4\x7a\x23\x9a\x67\x7c\xf3\xe9\xfd\xe0\xed\xd1\xbf\x5a\x31\xc8\x4a\x05\x19\x4a\x27\x09\x75\x40\x26\x39\x2b\x27\x18\x1c\x32\xc6\xb5\x59\x8d\x71\xa5\x10\xc9\x7e\x86\xee\xbf\x56\x31\xd4\x99\x87\xee\x45\x86\x7a\x66\xdc\xdd\xae\x80\xba\xad\x58\x5d\x06\x0b\x62\x4a\xa3\x19\x08\x0f\x32\xc5\x4a\xba\xd2\x5a\xc8\x98\x0b\x4a\xa4\x6c\x0b\x24\xaa\x2a\x79\x73\x7a\xfa\x5a\x46\xc1\xc8\x0c\x95\xe5\xec\xe7\xdf\x29\xa9\x0a\x23\xab\x13\x59\x35\x87\xad\x03\xb0\x9e\xd1\xa5\x23\x56\xd6\xd2\x79\x51\xd8\x5a\xeb\xc6\xbb\xe5\xf2\x29\xca\x0f\x9f\xa8\x9b\x85\xe1\x00\xe5\x36\x3c\xf1\x11\xba\xb7\xe2\x83\x0f\xf7\x2a\xa4\x98\x8a\x2f\x84\x61\xaf\x92\xbb\x8f\x42\xcf\xb5\x46\x22\x31\xae\x47\x91\xc0\x51\x1c\x66\x37\xf2\xdd\xa6\xdb\x60\x59\xb3\xd4\xde\x39\xd4\xec\x03\xbd\x88\x48\x7d\xbe\x5e\x46\xe3\x16\x86\x37\xa3\x71\xc4\x78\xe1\x1d\xa3\x63\x31\x8e\x07\x59\x6d\xba\x27\xbf\x91\x77\x5d\x10\x29\xf4\x16\xcb\xde\x23\xa4\xf5\xc7\xb6\xa6\x61\xa6\x06\x83\x64\x93\xc7\xed\xaf\x87\x82\x2e\xdd\x3b\x80\xb8\x43\x50\xaf\x3b\x14\x8a\x08\xd3\x6e\x67\x5f\x78\x40\xf2\x65\xd0\xd8\x3a\xbe\x00\xb6\x3e\xef\x3b\xb6\xfa\x70\xe7\x3e\xcc\x13\x38\x3b\xbd\x36\x2d\x57\xc0\xef\x25\xd2\xe1\x84\x93\xb3\x6b\xd3\xf9\x3d\x00\xcb\x5c\x41\x26\x2b\x07\x00\x00"),
+		},
+		"/testsupport": &vfsgen۰DirInfo{
+			name:    "testsupport",
+			modTime: time.Time{},
+		},
+		"/testsupport/syndesis-test-support.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-test-support.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2135,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x3d\x6f\x1b\x3b\x10\xec\xef\x57\x0c\xa4\xd6\x92\xfd\x3a\xe3\x3a\xbf\x57\x3c\x18\x08\x02\xc1\x36\xd2\x04\x2e\x28\x72\x25\x31\xe2\x71\x89\xe5\x9e\x95\xcb\xaf\x0f\x78\x1f\xf2\x07\xe4\x08\x09\x52\xb9\x3b\x92\xcb\xd9\xd9\x9d\xe5\xdc\x1c\x77\x14\x1d\x09\x39\x70\x0c\x1d\x0e\x3b\x8a\xd0\x1d\x41\x29\x2b\x72\x9b\x12\x8b\x82\xa2\x4b\xec\xa3\x66\xf0\x06\xb9\x8b\x8e\xb2\xcf\x8b\x4c\xf2\x44\x02\x23\x04\x8a\x66\x1d\xc8\x2d\xab\x39\x1e\xca\x45\x69\x63\x24\xc9\x68\x33\xf5\x68\xc7\x3b\x05\x76\x31\xc1\x16\x00\x6f\x09\xc6\x5a\x6e\xa3\x42\x19\x42\xc6\xee\xca\x95\x6a\x8e\x11\xdf\x79\x21\xab\xa1\xbb\xc0\xba\x4b\x26\x67\x1f\xb7\x25\x00\x6c\x5a\xdd\x21\x09\x7f\xef\x96\xd5\x02\x26\xf9\x2f\x24\xd9\x73\xac\xf1\xf4\x4f\x05\xec\x7d\x74\x35\xee\x87\x1c\x37\x43\x8a\x0a\x68\x48\x8d\x33\x6a\xea\x0a\x00\xa2\x69\xa8\x3e\x4d\xaf\x3f\x0f\x66\x4d\x21\x0f\xb1\x80\x49\xe9\x39\x78\xdc\x9b\x96\x4b\xcf\x97\xe7\xce\xb5\x4b\x54\xc3\xc7\x8d\x98\xac\xd2\x5a\x6d\x85\x4e\x84\x59\x6e\x12\x47\x8a\xfa\x1e\xb3\xd7\xd5\xca\xda\xd8\x65\x69\x06\x8b\xff\x61\xd4\x73\x5c\xee\xaf\x7b\xa0\x17\x7d\xb8\xe3\x40\x1f\xa4\x7a\x40\xda\x40\xbd\x28\x7d\x23\xfe\x17\x6e\xd3\xc8\x72\x81\xd9\xac\xff\x10\xca\xdc\x8a\xa5\xe3\x7e\x62\x97\xc7\xcf\x71\xee\xa6\xe5\x71\xba\xfb\xf5\x13\xc9\x3a\xd7\xf8\x8a\x2d\xe9\x05\x82\xcf\x7a\x81\x83\x51\xbb\xc3\xe3\xef\x27\xbc\x2c\x83\xb4\x61\x39\x18\x71\xaf\xd1\xad\x90\x51\xc2\xe3\x9f\x6a\xf9\xaf\x8f\xce\xc7\xed\x87\x91\x34\xb7\xeb\x6f\x64\x75\x54\xf5\x9d\xb7\x7b\xae\x3c\xe1\x40\x77\xb4\x29\x18\x6f\xe6\xfe\x7c\x63\x26\x5d\x7f\xa1\x41\x35\xc7\x8a\x5d\x1e\x7a\x18\xc8\x1d\xd1\x4a\x8d\x2f\x01\xd1\x98\x0e\xd6\x84\xd0\x1b\xd5\xe8\x63\x37\xab\xdb\x67\x2f\xab\xe6\x38\xec\x7c\x18\xdc\x51\xa8\x31\x3e\x16\x5f\x9b\x00\xfb\xe1\xc1\x9e\x28\x95\x00\x2f\xc5\x22\x29\xe7\xe2\x90\x5e\xdf\xba\x5d\x24\x3d\xb0\xec\x7d\xdc\x9e\x98\x95\xcf\xc3\xe1\x8a\x83\xb7\xdd\xc7\x99\x96\x44\x76\x50\x39\xb1\xbb\xa7\x40\x56\x59\x26\x9a\x4d\x79\xad\x9f\x5e\x31\x3f\xcf\xf3\x3c\x85\x41\xc6\x31\x69\xe9\xe6\x43\x97\x9e\x5f\xfb\x6d\xdc\x0a\xe5\x01\xcd\x0f\xdf\xd3\xd1\x46\xb8\x99\x88\x2c\x4e\x11\x7e\x97\xf4\x79\xe2\x7f\x13\xfd\x65\x8f\x6b\xcc\x54\x5a\x9a\x8d\xc1\x65\xeb\x78\xb3\x64\x29\x11\xd7\x57\xd7\x57\xe3\x16\xca\x7f\x58\xd9\x72\xa8\xf1\xf0\xdf\xaa\xfa\x39\x00\xb9\xec\xf3\x11\x57\x08\x00\x00"),
 		},
 		"/upgrade": &vfsgen۰DirInfo{
 			name:    "upgrade",
@@ -357,21 +506,29 @@ var assets = func() http.FileSystem {
 		"/upgrade/07-syndesis-upgrade.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "07-syndesis-upgrade.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1304,
+			uncompressedSize: 2242,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x41\x8f\xa2\x4e\x10\xc5\xef\x7e\x8a\x8a\x97\x39\x35\xe6\xff\x3f\x72\x33\x0e\x6b\xdc\x64\x90\x80\xe3\x66\x4f\xa6\x6c\x4a\xb7\x33\xd0\xdd\xdb\x5d\x90\x18\xe3\x77\xdf\x20\x82\xe8\x8e\x19\xd7\x5b\xbf\x2e\x5f\xbd\xfa\x51\x20\x00\xad\x5a\x93\xf3\xca\xe8\x10\xea\xff\x46\x00\x1f\x4a\xe7\x21\x24\x8d\xe6\x99\x34\xaf\x4d\x51\x95\x34\x2b\x50\x95\x23\x80\x92\x18\x73\x64\x0c\x47\x00\x00\x1a\x4b\x0a\xc1\x1f\x74\x4e\x5e\x79\x51\xd9\xbd\xc3\x9c\xce\x57\x05\x6e\xa9\xf0\x6d\x19\x00\x5a\x7b\xad\xbb\x68\xdd\x31\x50\x66\xf2\xd5\x3d\x1f\x2c\x85\xa0\xf4\xce\xa1\x67\x57\x49\xae\x5c\xd3\xc6\x5b\x92\x6d\x0b\x94\x92\xbc\x7f\x33\x39\x5d\x7a\x0a\x48\x09\xf3\x1f\x4e\x31\x2d\xb5\x6c\x33\x39\xf2\xa6\x72\x92\xfa\x58\x8e\x7e\x57\xe4\xb9\x3f\x03\x78\x36\x0e\xf7\x14\xc2\xcb\xf1\x18\x64\x5d\x82\xcc\x92\x0c\x66\xa6\xb4\x46\x93\x66\x1f\xbc\xb7\x83\x06\x69\x67\x18\x5c\x28\xa1\x45\xa9\xf8\x70\x3a\xbd\x8c\x1e\xa3\x35\xf9\x93\x20\xc5\xf1\x08\x83\x10\x8c\x5c\xf9\x60\x85\x6e\x4f\x7c\x31\x86\xd3\xe9\x06\x83\x27\x57\x2b\x49\x53\x29\x4d\xa5\x39\xbe\x75\x35\x96\x1c\xb2\x71\xe7\x4a\x69\x34\xa3\xd2\xe4\x7a\x5e\x6d\x86\xe1\x33\x04\x50\x65\x07\x03\xbe\xa6\xb1\x68\x8a\xa1\x99\xbd\xfd\x33\xe9\xfa\x0a\xb6\xf3\xcf\x7e\xc6\xaf\x51\xb6\xc8\x36\xeb\x28\xcd\x16\xcb\xb8\x2f\x00\xa8\xb1\xa8\x28\x84\x27\x87\xbe\xb5\x8d\xe2\xf5\x26\xfb\x7f\xb1\x59\x4d\xd3\x79\xb4\xda\xac\xa6\xf3\xbf\x8d\xc7\xcf\x38\x8f\x1f\x27\x7e\x4f\xe6\xe9\xf4\x35\xda\x24\xe9\xf2\x7b\x34\x5b\xdd\x37\xf8\xe6\x4c\x19\x0e\xc4\xe6\xb7\x53\x54\xe4\x29\xed\xee\xf5\xcb\x4d\x82\xfc\x2b\xec\x57\x21\x68\xfa\x79\x8b\xf2\x86\x7e\x52\x15\x45\x62\x0a\x25\x0f\x21\x2c\x76\xb1\xe1\xc4\x91\x27\xcd\xdd\x9b\xe5\xf6\x7e\x48\x79\x2c\xc4\x16\xe5\x47\x65\x87\x83\x8c\x27\xc6\xf2\xe4\x13\x5d\x08\xc6\xfd\x8d\xf2\xaf\x8c\xc6\x42\xd4\xe4\xb6\xc6\x53\xa7\xd6\xe7\x57\xe1\xad\x59\xc0\x3e\x99\x80\xb2\x39\xb7\xf3\x0e\xc2\xf4\x46\x2d\xea\x56\x14\xb9\x6a\x77\xb4\x75\xba\x5b\xd0\xbb\x1a\x00\xfb\xd9\xa7\xea\x8a\x44\x36\xc7\xf8\xf1\x87\xca\x91\x67\x74\xdc\x21\x8e\xa9\x26\x37\xfa\x13\x00\x00\xff\xff\xa7\x13\x1a\xa0\x18\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x4b\x6f\xe2\x30\x10\xbe\xf3\x2b\x46\xb9\xf4\xe4\x54\xad\xd4\x3d\xe4\x86\x28\x5b\x51\x2d\x69\x94\x50\x56\x7b\x42\xae\x33\x50\xab\x89\x9d\xb5\x1d\x56\x28\xe2\xbf\xaf\x9c\x38\x0f\x0a\x68\x29\x2b\x4e\xf3\xf0\xcc\xf7\xcd\x8b\x10\xa0\x05\x5f\xa2\xd2\x5c\x8a\x00\xb6\x77\x23\x80\x0f\x2e\xd2\x00\x22\xab\xd3\x06\x85\x59\xca\xac\xcc\x71\x92\x51\x9e\x8f\x00\x72\x34\x34\xa5\x86\x06\x23\x00\x00\x41\x73\x0c\x40\xef\x44\x8a\x9a\x6b\x52\x16\x1b\x45\x53\xac\x4d\x19\x7d\xc3\x4c\x37\x6e\x00\xb4\x28\x7a\x3f\xa7\x6b\x45\x9f\xcb\xdb\x7f\xd9\xcd\xae\xc0\x00\xb8\x58\x2b\xaa\x8d\x2a\x99\x29\x95\x4d\xa3\x0b\x64\xc1\xa8\xaa\x08\xf0\x35\xf8\x49\xfb\x20\x29\x90\xf9\x13\x99\x17\x52\xa0\x30\xda\x7f\x6d\x70\xf9\x31\x6a\x59\x2a\x86\xda\x6f\x48\x25\x46\x2a\xba\xb1\xdc\xb4\xde\xef\x6b\x58\x7a\xa0\x0a\x6b\x76\x37\x55\xf5\xbf\x91\x6f\x6a\x88\x28\xd2\xfd\xfe\x7a\xb0\x16\x8d\x03\xb9\xed\x14\x57\xc2\x6b\x62\x0d\x61\x59\xee\x94\x31\xd4\x7a\x2e\x53\x74\x7d\x23\x50\x55\x52\x5d\x81\x75\xdc\x45\x02\x2f\x46\x9a\xfe\x54\xdc\xe0\x8b\x60\xe8\xb9\x54\xaa\x7d\xd0\x0e\x88\xc2\xdf\x25\x6a\xd3\xc9\x5d\x27\xae\x64\x38\xa1\x05\x65\xdc\xec\x2c\xcb\xf3\x43\x2e\xd3\x0b\x47\x9a\x54\xd5\xb0\x0c\x86\x9a\x52\xfb\x0b\xaa\x36\x68\x5c\x60\xa8\x99\x35\x03\x69\xf1\x6b\x54\x5b\xce\x70\xcc\x98\x2c\x85\x09\x0f\xa3\xca\x02\x15\x35\x52\xd5\x19\x99\x14\x86\x72\x81\xca\x91\x27\x0e\xc3\x70\x9b\x00\x78\xde\x16\xe3\x82\x7e\xcc\xac\x33\x58\xee\xf6\x29\x00\x8a\x6d\x5f\xd8\x36\x7e\xf2\x2b\x7c\x9c\x26\xb3\x64\xb5\x9c\xc6\xc9\xec\x25\xec\x1c\x00\xb6\x34\x2b\x31\x80\x0b\x49\x1f\x86\x9d\x86\xcb\x55\x72\x3f\x5b\x2d\xc6\xf1\xd3\x74\xb1\x5a\x8c\x9f\x8e\x03\x7b\x97\x44\xf6\xce\x23\x7e\x8d\x9e\xe2\xf1\xe3\x74\x15\xc5\x2f\xcf\xd3\xc9\xe2\x73\x82\xef\x4a\xe6\x3d\xdf\xe6\xb7\xe6\x98\xa5\x31\xae\x3f\xeb\x9d\x25\xa2\xe6\x3d\xe8\x46\xc1\x17\x34\x47\x5d\x50\x76\x50\xfd\xa8\xcc\xb2\x48\x66\x9c\xed\x02\x98\xad\x43\x69\x22\x85\x1a\x85\x71\x3e\x54\x6d\x06\xe3\x4b\xc0\x23\xe4\x8d\xb2\x8f\xb2\x18\x12\xf1\x6e\x65\x61\x6e\x4f\xe8\x09\x31\x74\x73\xa0\xf9\x6a\x8d\x3c\x42\xb6\xa8\xde\xa4\x46\xaf\x5e\xec\x3f\xdc\xbc\x5f\x30\x2b\x91\x4c\x75\xbf\x3e\x5d\x43\x8f\x36\x14\x20\xe3\x39\x1f\x6e\xa8\x5d\x9d\x5c\xaa\x5d\xe0\xce\xc4\xbc\x96\x7e\x58\x2f\xf0\x1e\xee\xee\xe7\xdc\x1b\x1c\xbc\x49\xf4\x5a\x9b\x06\x23\x03\xc0\x8a\xd2\xbe\x1e\x1a\x0f\x6f\xd2\xe9\xdb\x70\x3a\x73\xdc\xdc\x10\xf0\xee\x1f\xbe\x1d\xe5\x76\xc6\x73\xd9\x7b\x73\x9f\xff\x33\x92\xe6\xec\xce\xed\x3e\x77\x58\x08\xe4\x56\x6e\xc6\x67\xd0\x5b\x67\x6e\xef\x49\xd3\x70\x92\x72\xf5\xd5\xd6\x38\x1c\x96\x45\x28\x53\x4c\x30\x43\x66\xa4\x72\xa0\xc4\x40\x65\xa9\x18\xf9\xac\xa5\x38\x72\x3d\xe4\x64\x63\x2d\x64\x66\x4f\x10\x97\xa2\x6d\xb9\xe9\x35\xc3\x48\x87\x8e\xe7\x8a\xd3\x94\xc6\x55\x85\x1c\x93\xb6\x3e\x00\xc5\xa9\x8f\x8a\xbe\xab\xcc\x8a\xe1\xc9\xfb\xdb\xfe\x69\x18\xaa\x4c\xbb\x82\x21\x6e\x51\x8d\xfe\x0e\x00\x5f\xa2\xf7\xaa\xc2\x08\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons"].(os.FileInfo),
+		fs["/alternate"].(os.FileInfo),
+		fs["/consolelink"].(os.FileInfo),
 		fs["/database"].(os.FileInfo),
+		fs["/exposure"].(os.FileInfo),
 		fs["/infrastructure"].(os.FileInfo),
 		fs["/install"].(os.FileInfo),
+		fs["/internaltls"].(os.FileInfo),
+		fs["/oauthclient"].(os.FileInfo),
 		fs["/prometheus-config.yml"].(os.FileInfo),
 		fs["/route"].(os.FileInfo),
+		fs["/smoketest"].(os.FileInfo),
+		fs["/testsupport"].(os.FileInfo),
 		fs["/upgrade"].(os.FileInfo),
 	}
 	fs["/addons"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/broker"].(os.FileInfo),
 		fs["/addons/camelk"].(os.FileInfo),
 		fs["/addons/dv"].(os.FileInfo),
 		fs["/addons/jaeger"].(os.FileInfo),
@@ -379,6 +536,9 @@ var assets = func() http.FileSystem {
 		fs["/addons/ops"].(os.FileInfo),
 		fs["/addons/todo"].(os.FileInfo),
 	}
+	fs["/addons/broker"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/broker/syndesis-broker.yml.tmpl"].(os.FileInfo),
+	}
 	fs["/addons/camelk"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/camelk/camel-catalog-2.21.0.fuse-760011.yaml.tmpl"].(os.FileInfo),
 		fs["/addons/camelk/maven-settings.yml.tmpl"].(os.FileInfo),
@@ -388,7 +548,7 @@ var assets = func() http.FileSystem {
 		fs["/addons/dv/addon-dv-server.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/jaeger"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/addons/jaeger/syndesis-jaeger.yml"].(os.FileInfo),
+		fs["/addons/jaeger/syndesis-jaeger.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/knative"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/knative/empty.yml"].(os.FileInfo),
@@ -402,8 +562,8 @@ var assets = func() http.FileSystem {
 		fs["/addons/ops/addon-ops-integrations-camel-dashboard.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-integrations-home-dashboard.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-integrations-jvm-dashboard.yml"].(os.FileInfo),
-		fs["/addons/ops/addon-ops-integrations-service.yml"].(os.FileInfo),
-		fs["/addons/ops/addon-ops-integrations-servicemonitor.yml"].(os.FileInfo),
+		fs["/addons/ops/addon-ops-integrations-podmonitor.yml"].(os.FileInfo),
+		fs["/addons/ops/addon-ops-integrations-slo-rules.yml.tmpl"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-jvm-dashboard.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-meta-alerting-rules.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-server-alerting-rules.yml"].(os.FileInfo),
@@ -412,13 +572,35 @@ var assets = func() http.FileSystem {
 	fs["/addons/todo"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/todo/04-todo-example.yml.tmpl"].(os.FileInfo),
 	}
+	fs["/alternate"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/alternate/ingress.yml.tmpl"].(os.FileInfo),
+		fs["/alternate/route.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/consolelink"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/consolelink/console-link.yml.tmpl"].(os.FileInfo),
+	}
 	fs["/database"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/database/syndesis-db.yml.tmpl"].(os.FileInfo),
 	}
+	fs["/exposure"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/exposure/ingress"].(os.FileInfo),
+		fs["/exposure/loadbalancer"].(os.FileInfo),
+		fs["/exposure/nodeport"].(os.FileInfo),
+	}
+	fs["/exposure/ingress"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/exposure/ingress/ingress.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/exposure/loadbalancer"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/exposure/loadbalancer/service.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/exposure/nodeport"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/exposure/nodeport/service.yml.tmpl"].(os.FileInfo),
+	}
 	fs["/infrastructure"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/infrastructure/02-syndesis-image-streams.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/02-syndesis-secrets.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/02-syndesis-service-accounts.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/03-syndesis-logging-config.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/03-syndesis-server-config.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/03-syndesis-ui.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-amq-example.yml.tmpl"].(os.FileInfo),
@@ -427,18 +609,33 @@ var assets = func() http.FileSystem {
 		fs["/infrastructure/04-syndesis-server.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/05-syndesis-security.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/06-syndesis-prometheus.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/07-syndesis-db-maintenance.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/install"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/install/app.yml.tmpl"].(os.FileInfo),
 		fs["/install/cluster.yml"].(os.FileInfo),
 		fs["/install/grant_cluster_role.yml.tmpl"].(os.FileInfo),
 		fs["/install/grant_role.yml.tmpl"].(os.FileInfo),
+		fs["/install/namespace.yml.tmpl"].(os.FileInfo),
 		fs["/install/operator.yml.tmpl"].(os.FileInfo),
 		fs["/install/role.yml.tmpl"].(os.FileInfo),
+		fs["/install/webhook.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/internaltls"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/internaltls/syndesis-internal-tls.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/oauthclient"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/oauthclient/syndesis-oauth-client.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/route"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/route/route.yml.tmpl"].(os.FileInfo),
 	}
+	fs["/smoketest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/smoketest/syndesis-smoke-test.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/testsupport"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/testsupport/syndesis-test-support.yml.tmpl"].(os.FileInfo),
+	}
 	fs["/upgrade"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/upgrade/07-syndesis-upgrade.yml.tmpl"].(os.FileInfo),
 	}
@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Upper bound of rendered manifests kept in memory, the cache is emptied when reached
+const maxRenderedEntries = 512
+
+// Templates are parsed once and their output is kept per file and per hash of
+// the context they were rendered with, so that reconciling an unchanged
+// configuration does not render everything again.
+type renderCache struct {
+	lock      sync.Mutex
+	templates map[string]*template.Template
+	rendered  map[string][]unstructured.Unstructured
+}
+
+var cache = &renderCache{
+	templates: map[string]*template.Template{},
+	rendered:  map[string][]unstructured.Unstructured{},
+}
+
+func (c *renderCache) template(filePath string, parse func() (*template.Template, error)) (*template.Template, error) {
+	if !cacheTemplates {
+		return parse()
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if tmpl, ok := c.templates[filePath]; ok {
+		return tmpl, nil
+	}
+
+	tmpl, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	c.templates[filePath] = tmpl
+	return tmpl, nil
+}
+
+// Returns a copy of the manifests rendered from the file with an equal context
+func (c *renderCache) get(key string) ([]unstructured.Unstructured, bool) {
+	if key == "" {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	resources, ok := c.rendered[key]
+	if !ok {
+		return nil, false
+	}
+	return deepCopyAll(resources), true
+}
+
+func (c *renderCache) put(key string, resources []unstructured.Unstructured) {
+	if key == "" {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.rendered) >= maxRenderedEntries {
+		c.rendered = map[string][]unstructured.Unstructured{}
+	}
+	c.rendered[key] = deepCopyAll(resources)
+}
+
+func (c *renderCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.rendered = map[string][]unstructured.Unstructured{}
+}
+
+// Forgets the manifests rendered so far, the templates being rendered again on their next use
+func ClearRenderCache() {
+	cache.clear()
+}
+
+// Key of the manifests rendered from a file with the given context. The context
+// is hashed from its JSON form, so templates must only depend on its exported
+// fields. An empty key is returned when the context can't be hashed, disabling
+// the cache for it.
+func renderKey(filePath string, context interface{}) string {
+	if !cacheTemplates {
+		return ""
+	}
+
+	data, err := json.Marshal(context)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(data)
+	return filePath + "@" + hex.EncodeToString(hash[:])
+}
+
+// Callers modify the rendered resources, e.g. to set owner references, so they never share them with the cache
+func deepCopyAll(resources []unstructured.Unstructured) []unstructured.Unstructured {
+	copies := make([]unstructured.Unstructured, len(resources))
+	for i := range resources {
+		resources[i].DeepCopyInto(&copies[i])
+	}
+	return copies
+}
@@ -4,6 +4,7 @@ package generator
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -17,6 +18,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+// Annotation recording the template a resource was rendered from
+const TemplateAnnotation = "syndesis.io/template"
+
 func AssetAsBytes(path string) ([]byte, error) {
 	file, err := GetAssetsFS().Open(path)
 	if err != nil {
@@ -45,7 +49,13 @@ var templateFunctions = template.FuncMap{
 			return false, nil
 		}
 	},
-	"tagOf": util.TagOf,
+	"tagOf":         util.TagOf,
+	"imageStreamOf": util.ImageStreamOf,
+	// Renders a value as a JSON document, which is valid YAML on a single line
+	"toJson": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
 }
 
 func RenderFSDir(assets http.FileSystem, directory string, context interface{}) ([]unstructured.Unstructured, error) {
@@ -78,6 +88,7 @@ func RenderFSDir(assets http.FileSystem, directory string, context interface{})
 func Render(filePath string, context interface{}) ([]unstructured.Unstructured, error) {
 	var obj interface{} = nil
 	response := []unstructured.Unstructured{}
+	cacheKey := ""
 
 	// We can load plain yml files..
 	if strings.HasSuffix(filePath, ".yml") || strings.HasSuffix(filePath, ".yaml") {
@@ -94,12 +105,18 @@ func Render(filePath string, context interface{}) ([]unstructured.Unstructured,
 
 	// We can process go lang templates.
 	if strings.HasSuffix(filePath, ".yml.tmpl") || strings.HasSuffix(filePath, ".yaml.tmpl") {
-		fileData, err := AssetAsBytes(filePath)
-		if err != nil {
-			return nil, err
+		cacheKey = renderKey(filePath, context)
+		if resources, ok := cache.get(cacheKey); ok {
+			return resources, nil
 		}
 
-		tmpl, err := template.New(filePath).Funcs(templateFunctions).Parse(string(fileData))
+		tmpl, err := cache.template(filePath, func() (*template.Template, error) {
+			fileData, err := AssetAsBytes(filePath)
+			if err != nil {
+				return nil, err
+			}
+			return template.New(filePath).Funcs(templateFunctions).Parse(string(fileData))
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -139,6 +156,17 @@ func Render(filePath string, context interface{}) ([]unstructured.Unstructured,
 		return nil, fmt.Errorf("Unexptected yaml unmarshal type: %v", obj)
 	}
 
+	location := strings.TrimPrefix(filePath, "./")
+	for _, u := range response {
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[TemplateAnnotation] = location
+		u.SetAnnotations(annotations)
+	}
+
+	cache.put(cacheKey, response)
 	return response, nil
 }
 
@@ -2,6 +2,7 @@ package generator_test
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -10,7 +11,13 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/openshift"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
 )
 
 func TestGenerator(t *testing.T) {
@@ -22,8 +29,8 @@ func TestGenerator(t *testing.T) {
 					SamplerType:  "const",
 					SamplerParam: "0",
 				},
-				Ops:  v1alpha1.AddonSpec{Enabled: true},
-				Todo: v1alpha1.AddonSpec{Enabled: true},
+				Ops:  v1alpha1.OpsConfiguration{Enabled: true},
+				Todo: v1alpha1.TodoConfiguration{Enabled: true},
 				DV: v1alpha1.DvConfiguration{
 					Enabled:   false,
 					Resources: v1alpha1.Resources{Memory: "1024Mi"},
@@ -116,6 +123,196 @@ func TestGenerator(t *testing.T) {
 	assert.True(t, checks >= 1)
 }
 
+func TestGeneratorAntiAffinity(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				UI:     v1alpha1.UIConfiguration{Replicas: 2, DisableAntiAffinity: true},
+				Server: v1alpha1.ServerConfiguration{Replicas: 3},
+			},
+		},
+	}
+
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, resource := range resources {
+		if resource.GetKind() != "DeploymentConfig" {
+			continue
+		}
+		_, hasAffinity, _ := unstructured.NestedMap(resource.UnstructuredContent(), "spec", "template", "spec", "affinity", "podAntiAffinity")
+		replicas, _, _ := unstructured.NestedFieldNoCopy(resource.UnstructuredContent(), "spec", "replicas")
+		switch resource.GetName() {
+		case "syndesis-server":
+			assert.Equal(t, float64(3), replicas)
+			assert.True(t, hasAffinity)
+			checks++
+		case "syndesis-ui":
+			assert.Equal(t, float64(2), replicas)
+			assert.False(t, hasAffinity)
+			checks++
+		default:
+			assert.False(t, hasAffinity)
+		}
+	}
+	assert.Equal(t, 2, checks)
+}
+
+func TestGeneratorValidate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientscheme.AddToScheme(scheme))
+	openshift.AddToScheme(scheme)
+
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{
+				Jaeger: v1alpha1.JaegerConfiguration{Enabled: true},
+				Ops:    v1alpha1.OpsConfiguration{Enabled: true},
+				Todo:   v1alpha1.TodoConfiguration{Enabled: true},
+				DV:     v1alpha1.DvConfiguration{Enabled: true},
+				CamelK: v1alpha1.CamelKConfiguration{Enabled: true},
+				Broker: v1alpha1.AddonSpec{Enabled: true},
+			},
+			TestSupport: true,
+		},
+	}
+
+	for _, support := range []bool{false, true} {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+		configuration.DevSupport = support
+		configuration.StartupProbes = support
+		configuration.RouteHostname = "syndesis.example.com"
+
+		for _, dir := range []string{"./route/", "./alternate/", "./infrastructure/", "./database/", "./internaltls/", "./testsupport/", "./consolelink/", "./addons/jaeger/", "./addons/ops/", "./addons/dv/", "./addons/camelk/", "./addons/todo/", "./addons/knative/", "./addons/broker/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err, dir)
+			assert.NoError(t, generator.Validate(scheme, resources), dir)
+		}
+	}
+
+	misplaced := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "syndesis-server"},
+		"spec":       map[string]interface{}{"port": float64(80)},
+	}}
+	assert.Error(t, generator.Validate(scheme, []unstructured.Unstructured{misplaced}))
+
+	// The startup probes of Kubernetes 1.16 are checked though the vendored containers don't have them
+	probe := map[string]interface{}{"periodSeconds": float64(10), "failureThreshold": float64(60)}
+	deployment := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "syndesis-server"},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "syndesis-server", "startupProbe": probe}},
+		}}},
+	}}
+	assert.NoError(t, generator.Validate(scheme, []unstructured.Unstructured{deployment}))
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, probe, containers[0].(map[string]interface{})["startupProbe"], "the resource is left alone")
+	probe["periodSecond"] = float64(10)
+	assert.EqualError(t, generator.Validate(scheme, []unstructured.Unstructured{deployment}),
+		`invalid Deployment syndesis-server: invalid startupProbe of container syndesis-server: json: unknown field "periodSecond"`)
+}
+
+func TestGeneratorRenderCache(t *testing.T) {
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, &v1alpha1.Syndesis{})
+	require.NoError(t, err)
+
+	resources, err := generator.Render("./infrastructure/04-syndesis-server.yml.tmpl", configuration)
+	require.NoError(t, err)
+	require.NotEmpty(t, resources)
+	resources[0].SetNamespace("modified")
+
+	cached, err := generator.Render("./infrastructure/04-syndesis-server.yml.tmpl", configuration)
+	require.NoError(t, err)
+	assert.Empty(t, cached[0].GetNamespace())
+
+	configuration.Syndesis.Components.Server.Resources.Memory = "1Gi"
+	rendered, err := generator.Render("./infrastructure/04-syndesis-server.yml.tmpl", configuration)
+	require.NoError(t, err)
+	assert.NotEqual(t, cached, rendered)
+}
+
+func TestGeneratorDevSupport(t *testing.T) {
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, &v1alpha1.Syndesis{})
+	require.NoError(t, err)
+	configuration.DevSupport = true
+	configuration.DevImageStreamTags = map[string]string{"server": "syndesis-server:local"}
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, resource := range resources {
+		if resource.GetKind() != "DeploymentConfig" {
+			continue
+		}
+		triggers, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "triggers")
+		containers, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "containers")
+		switch resource.GetName() {
+		case "syndesis-server", "syndesis-meta", "syndesis-ui":
+			container := containers[0].(map[string]interface{})
+			assert.Equal(t, "Always", container["imagePullPolicy"])
+
+			tag := ""
+			for _, trigger := range triggers {
+				if name, ok, _ := unstructured.NestedString(trigger.(map[string]interface{}), "imageChangeParams", "from", "name"); ok {
+					tag = name
+				}
+			}
+			expected := resource.GetName() + ":latest"
+			if resource.GetName() == "syndesis-server" {
+				expected = "syndesis-server:local"
+			}
+			assert.Equal(t, expected, tag)
+
+			ports := container["ports"].([]interface{})
+			hasDebug := false
+			for _, port := range ports {
+				if port.(map[string]interface{})["name"] == "debug" {
+					hasDebug = true
+				}
+			}
+			assert.Equal(t, resource.GetName() != "syndesis-ui", hasDebug)
+			checks++
+		}
+	}
+	assert.Equal(t, 3, checks)
+}
+
+func TestGeneratorLogging(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Logging: v1alpha1.LoggingConfiguration{
+				Categories: map[string]string{"org.apache.camel": "WARN", "io.syndesis.server": "DEBUG"},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	found := false
+	for _, resource := range resources {
+		if resource.GetKind() == "ConfigMap" && resource.GetName() == "syndesis-logging-config" {
+			config, _, _ := unstructured.NestedString(resource.UnstructuredContent(), "data", "application.yml")
+			assert.Contains(t, config, "'io.syndesis.server': 'DEBUG'")
+			assert.Contains(t, config, "'org.apache.camel': 'WARN'")
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
 //
 // Checks syndesis-meta resources have had syndesis
 // object values correctly applied
@@ -293,3 +490,1125 @@ func assertPropStr(t *testing.T, resource map[string]interface{}, expected strin
 		assert.Equal(t, expected, value, "rendering should be applied correctly")
 	}
 }
+
+func TestGeneratorJaegerSampling(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{
+				Jaeger: v1alpha1.JaegerConfiguration{
+					Enabled: true,
+					Sampling: v1alpha1.JaegerSamplingConfiguration{
+						Integrations: map[string]v1alpha1.JaegerSamplingStrategy{
+							"orders": {Type: "probabilistic", Param: "0.1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./addons/jaeger/", configuration)
+	require.NoError(t, err)
+
+	found := false
+	for _, resource := range resources {
+		if resource.GetKind() == "Jaeger" {
+			options, _, _ := unstructured.NestedMap(resource.UnstructuredContent(), "spec", "sampling", "options")
+			assert.Equal(t, map[string]interface{}{"type": "probabilistic", "param": float64(1)}, options["default_strategy"])
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"service": "orders", "type": "probabilistic", "param": 0.1},
+			}, options["service_strategies"])
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestGeneratorBroker(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Broker: v1alpha1.AddonSpec{Enabled: true}},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	for brokerOperator, kind := range map[bool]string{false: "Deployment", true: "ActiveMQArtemis"} {
+		configuration.BrokerOperator = brokerOperator
+		resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./addons/broker/", configuration)
+		require.NoError(t, err)
+
+		kinds := map[string]bool{}
+		for _, resource := range resources {
+			kinds[resource.GetKind()] = true
+			if resource.GetKind() == "Secret" {
+				password, _, _ := unstructured.NestedString(resource.UnstructuredContent(), "stringData", "password")
+				assert.Equal(t, configuration.Syndesis.Addons.Broker.Password, password)
+			}
+		}
+		assert.True(t, kinds["Secret"])
+		assert.True(t, kinds[kind], kind)
+	}
+}
+
+func TestGeneratorIntegrationScraping(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Ops: v1alpha1.OpsConfiguration{Enabled: true}},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+	scraping := false
+	for _, resource := range resources {
+		if resource.GetName() == "syndesis-prometheus-config" {
+			config, _, _ := unstructured.NestedString(resource.UnstructuredContent(), "data", "prometheus.yml")
+			assert.Contains(t, config, "__meta_kubernetes_pod_label_syndesis_io_integration]")
+			assert.Contains(t, config, "target_label: job")
+			scraping = true
+		}
+	}
+	assert.True(t, scraping)
+
+	resources, err = generator.RenderFSDir(generator.GetAssetsFS(), "./addons/ops/", configuration)
+	require.NoError(t, err)
+	monitors := 0
+	for _, resource := range resources {
+		if resource.GetKind() == "PodMonitor" {
+			assert.Equal(t, "syndesis-integrations", resource.GetName())
+			monitors++
+		}
+	}
+	assert.Equal(t, 1, monitors)
+}
+
+func TestGeneratorSLORules(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Ops: v1alpha1.OpsConfiguration{
+				Enabled: true,
+				SLO:     v1alpha1.SLOConfiguration{SuccessRate: "99.9", LatencyThreshold: 250},
+			}},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./addons/ops/", configuration)
+	require.NoError(t, err)
+
+	exprs := map[string]string{}
+	for _, resource := range resources {
+		if resource.GetName() != "syndesis-integrations-slo-rules" {
+			continue
+		}
+		groups, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "groups")
+		for _, group := range groups {
+			rules, _, _ := unstructured.NestedSlice(group.(map[string]interface{}), "rules")
+			for _, rule := range rules {
+				rule := rule.(map[string]interface{})
+				if name, ok := rule["alert"]; ok {
+					exprs[name.(string)] += rule["expr"].(string)
+				}
+			}
+		}
+	}
+	assert.Contains(t, exprs["IntegrationErrorBudgetBurn"], "(14.4 * (1 - 99.9 / 100))")
+	assert.Contains(t, exprs["IntegrationHighLatency"], "> 250")
+}
+
+func TestGeneratorDatabaseReplicas(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Database: v1alpha1.DatabaseConfiguration{ReadReplicas: 2},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./database/", configuration)
+	require.NoError(t, err)
+	replicas := false
+	for _, resource := range resources {
+		if resource.GetKind() == "DeploymentConfig" && resource.GetName() == "syndesis-db-replica" {
+			count, _, _ := unstructured.NestedFieldNoCopy(resource.UnstructuredContent(), "spec", "replicas")
+			assert.EqualValues(t, 2, count)
+			replicas = true
+		}
+	}
+	assert.True(t, replicas)
+
+	resources, err = generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+	for _, resource := range resources {
+		if resource.GetName() == "syndesis-server-config" {
+			config, _, _ := unstructured.NestedString(resource.UnstructuredContent(), "data", "application.yml")
+			assert.Contains(t, config, "jdbc:postgresql://syndesis-db-replica:5432/syndesis?readOnly=true")
+		}
+	}
+}
+
+func TestGeneratorDatabaseMaintenance(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Database: v1alpha1.DatabaseConfiguration{
+					Maintenance: v1alpha1.DatabaseMaintenance{Enabled: true, StatementTimeout: "20min"},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+	jobs := 0
+	for _, resource := range resources {
+		if resource.GetKind() != "CronJob" {
+			continue
+		}
+		jobs++
+		schedule, _, _ := unstructured.NestedString(resource.UnstructuredContent(), "spec", "schedule")
+		assert.Equal(t, "0 3 * * 0", schedule)
+		containers, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "jobTemplate", "spec", "template", "spec", "containers")
+		require.Len(t, containers, 1)
+		env := containers[0].(map[string]interface{})["env"].([]interface{})
+		assert.Contains(t, env, map[string]interface{}{"name": "PGOPTIONS", "value": "-c statement_timeout=20min"})
+	}
+	assert.Equal(t, 1, jobs)
+}
+
+func TestGeneratorStartupProbes(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			StartupProbe: v1alpha1.StartupProbeConfiguration{FailureThreshold: 90},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	for _, startupProbes := range []bool{false, true} {
+		configuration.StartupProbes = startupProbes
+		resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+		require.NoError(t, err)
+
+		checks := 0
+		for _, resource := range resources {
+			if resource.GetKind() != "DeploymentConfig" || (resource.GetName() != "syndesis-server" && resource.GetName() != "syndesis-meta") {
+				continue
+			}
+			containers, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "containers")
+			container := containers[0].(map[string]interface{})
+			threshold, _, _ := unstructured.NestedFieldNoCopy(container, "startupProbe", "failureThreshold")
+			delay, _, _ := unstructured.NestedFieldNoCopy(container, "livenessProbe", "initialDelaySeconds")
+			if startupProbes {
+				assert.EqualValues(t, 90, threshold)
+				assert.Nil(t, delay)
+			} else {
+				assert.Nil(t, threshold)
+				assert.EqualValues(t, 300, delay)
+			}
+			checks++
+		}
+		assert.Equal(t, 2, checks)
+	}
+}
+
+func TestGeneratorShutdown(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Server: v1alpha1.ServerConfiguration{
+					Shutdown: v1alpha1.ShutdownConfiguration{
+						TerminationGracePeriodSeconds: 120,
+						PreStop:                       []string{"/bin/sh", "-c", "sleep 15"},
+					},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	periods := map[string]interface{}{}
+	for _, resource := range resources {
+		if resource.GetKind() != "DeploymentConfig" {
+			continue
+		}
+		period, found, _ := unstructured.NestedFieldNoCopy(resource.UnstructuredContent(), "spec", "template", "spec", "terminationGracePeriodSeconds")
+		if !found {
+			continue
+		}
+		periods[resource.GetName()] = period
+
+		containers, _, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "containers")
+		container := containers[0].(map[string]interface{})
+		command, found, _ := unstructured.NestedStringSlice(container, "lifecycle", "preStop", "exec", "command")
+		if resource.GetName() == "syndesis-server" {
+			assert.True(t, found)
+			assert.Equal(t, []string{"/bin/sh", "-c", "sleep 15"}, command)
+		} else {
+			assert.False(t, found, resource.GetName())
+		}
+	}
+
+	assert.Len(t, periods, 3)
+	assert.EqualValues(t, 120, periods["syndesis-server"])
+	assert.EqualValues(t, 30, periods["syndesis-meta"])
+	assert.EqualValues(t, 30, periods["syndesis-ui"])
+}
+
+func TestGeneratorInfrastructureOnly(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			InstallMode: v1alpha1.SyndesisInstallModeInfrastructureOnly,
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	names := map[string]bool{}
+	for _, resource := range resources {
+		names[resource.GetKind()+"/"+resource.GetName()] = true
+	}
+	for _, name := range []string{"DeploymentConfig/syndesis-server", "DeploymentConfig/syndesis-meta", "DeploymentConfig/syndesis-ui", "ConfigMap/syndesis-server-config"} {
+		assert.False(t, names[name], name)
+	}
+	for _, name := range []string{"DeploymentConfig/syndesis-oauthproxy", "DeploymentConfig/syndesis-prometheus", "Secret/syndesis-global-config", "PersistentVolumeClaim/syndesis-meta"} {
+		assert.True(t, names[name], name)
+	}
+}
+
+func TestGeneratorPrometheusDisabled(t *testing.T) {
+	enabled := false
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Prometheus: v1alpha1.PrometheusConfiguration{Enabled: &enabled},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	for _, resource := range resources {
+		assert.NotEqual(t, "syndesis-prometheus", resource.GetLabels()["syndesis.io/component"], resource.GetKind()+"/"+resource.GetName())
+	}
+}
+
+func TestGeneratorAlternateHostnames(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			AlternateHostnames:     []string{"syndesis.legacy.example.com", "syndesis.eu.example.com"},
+			AlternateHostnamesMode: v1alpha1.SyndesisAlternateHostnamesModeProxy,
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+	configuration.RouteHostname = "syndesis.example.com"
+
+	resources, err := generator.RenderDir("./alternate/", configuration)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	for i, hostname := range syndesis.Spec.AlternateHostnames {
+		assert.Equal(t, "Route", resources[i].GetKind())
+		assert.Equal(t, "syndesis-alternate-"+strconv.Itoa(i), resources[i].GetName())
+		host, _, _ := unstructured.NestedString(resources[i].Object, "spec", "host")
+		assert.Equal(t, hostname, host)
+	}
+
+	configuration.Syndesis.Exposure = "ingress"
+	configuration.Syndesis.AlternateHostnamesMode = "redirect"
+	resources, err = generator.RenderDir("./alternate/", configuration)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "Ingress", resources[0].GetKind())
+	assert.Equal(t, "https://syndesis.example.com", resources[0].GetAnnotations()["nginx.ingress.kubernetes.io/permanent-redirect"])
+	rules, _, _ := unstructured.NestedSlice(resources[0].Object, "spec", "rules")
+	assert.Len(t, rules, 2)
+
+	configuration.Syndesis.AlternateHostnamesMode = "none"
+	resources, err = generator.RenderDir("./alternate/", configuration)
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+}
+
+func TestGeneratorAddonPods(t *testing.T) {
+	pods := v1alpha1.AddonPodsConfiguration{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/addons": "true"},
+		Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "addons", Effect: corev1.TaintEffectNoSchedule}},
+		Resources:    v1alpha1.AddonResources{CPULimit: "500m", MemoryLimit: "768Mi"},
+	}
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{
+				Jaeger: v1alpha1.JaegerConfiguration{Enabled: true, Pods: pods},
+				DV:     v1alpha1.DvConfiguration{Enabled: true, Pods: pods},
+				Todo:   v1alpha1.TodoConfiguration{Enabled: true, Pods: pods},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, dir := range []string{"./addons/dv/", "./addons/todo/", "./addons/jaeger/"} {
+		resources, err := generator.RenderDir(dir, configuration)
+		require.NoError(t, err, dir)
+
+		for _, resource := range resources {
+			switch resource.GetKind() {
+			case "DeploymentConfig":
+				podSpec, _, _ := unstructured.NestedMap(resource.Object, "spec", "template", "spec")
+				assert.Equal(t, map[string]interface{}{"node-role.kubernetes.io/addons": "true"}, podSpec["nodeSelector"], resource.GetName())
+				assert.Len(t, podSpec["tolerations"], 1, resource.GetName())
+				containers, _, _ := unstructured.NestedSlice(podSpec, "containers")
+				limits, _, _ := unstructured.NestedMap(containers[0].(map[string]interface{}), "resources", "limits")
+				assert.Equal(t, "500m", limits["cpu"], resource.GetName())
+				assert.Equal(t, "768Mi", limits["memory"], resource.GetName())
+				checks++
+			case "Jaeger":
+				allInOne, _, _ := unstructured.NestedMap(resource.Object, "spec", "allInOne")
+				assert.Len(t, allInOne["tolerations"], 1)
+				terms, _, _ := unstructured.NestedSlice(allInOne, "affinity", "nodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms")
+				assert.Len(t, terms, 1)
+				limits, _, _ := unstructured.NestedMap(allInOne, "resources", "limits")
+				assert.Equal(t, map[string]interface{}{"cpu": "500m", "memory": "768Mi"}, limits)
+				_, requests, _ := unstructured.NestedMap(allInOne, "resources", "requests")
+				assert.False(t, requests)
+				checks++
+			}
+		}
+	}
+	assert.Equal(t, 3, checks)
+}
+
+func TestGeneratorPrometheusRemoteWrite(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Prometheus: v1alpha1.PrometheusConfiguration{
+					RemoteWrite: []v1alpha1.PrometheusRemoteWrite{
+						{URL: "https://thanos.example.com/api/v1/receive", BearerTokenSecret: "thanos-token"},
+						{
+							URL:            "https://mimir.example.com/api/v1/push",
+							Username:       "syndesis",
+							PasswordSecret: "mimir-credentials",
+							WriteRelabelConfigs: []v1alpha1.PrometheusRelabelConfig{
+								{SourceLabels: []string{"__name__"}, Regex: "org_apache_camel_(.+)", Action: "keep"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderDir("./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, resource := range resources {
+		switch {
+		case resource.GetKind() == "ConfigMap" && resource.GetName() == "syndesis-prometheus-config":
+			data, _, _ := unstructured.NestedString(resource.Object, "data", "prometheus.yml")
+			config := map[string]interface{}{}
+			require.NoError(t, yaml.Unmarshal([]byte(data), &config))
+
+			remoteWrite := config["remote_write"].([]interface{})
+			require.Len(t, remoteWrite, 2)
+			assert.Equal(t, map[string]interface{}{
+				"url":               "https://thanos.example.com/api/v1/receive",
+				"bearer_token_file": "/etc/prometheus-remote-write/0/token",
+			}, remoteWrite[0])
+			assert.Equal(t, map[string]interface{}{
+				"url": "https://mimir.example.com/api/v1/push",
+				"basic_auth": map[string]interface{}{
+					"username":      "syndesis",
+					"password_file": "/etc/prometheus-remote-write/1/password",
+				},
+				"write_relabel_configs": []interface{}{
+					map[string]interface{}{"action": "keep", "source_labels": []interface{}{"__name__"}, "regex": "org_apache_camel_(.+)"},
+				},
+			}, remoteWrite[1])
+			checks++
+		case resource.GetKind() == "DeploymentConfig" && resource.GetName() == "syndesis-prometheus":
+			volumes, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "volumes")
+			secrets := []string{}
+			for _, volume := range volumes {
+				if name, ok, _ := unstructured.NestedString(volume.(map[string]interface{}), "secret", "secretName"); ok {
+					secrets = append(secrets, name)
+				}
+			}
+			assert.Equal(t, []string{"thanos-token", "mimir-credentials"}, secrets)
+			checks++
+		}
+	}
+	assert.Equal(t, 2, checks)
+}
+
+func TestGeneratorPrometheusFederation(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"},
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Prometheus: v1alpha1.PrometheusConfiguration{
+					Federation: v1alpha1.PrometheusFederation{Enabled: true, Hostname: "federate.apps.example.com"},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderDir("./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, resource := range resources {
+		switch {
+		case resource.GetKind() == "Route" && resource.GetName() == "syndesis-prometheus-federation":
+			host, _, _ := unstructured.NestedString(resource.Object, "spec", "host")
+			assert.Equal(t, "federate.apps.example.com", host)
+			checks++
+		case resource.GetKind() == "ConfigMap" && resource.GetName() == "syndesis-prometheus-federation":
+			data, _, _ := unstructured.NestedString(resource.Object, "data", "scrape-config.yml")
+			scrapeConfigs := []map[string]interface{}{}
+			require.NoError(t, yaml.Unmarshal([]byte(data), &scrapeConfigs))
+			require.Len(t, scrapeConfigs, 1)
+			assert.Equal(t, "syndesis-syndesis", scrapeConfigs[0]["job_name"])
+			assert.Equal(t, map[string]interface{}{"match[]": []interface{}{`{integration=~".+"}`}}, scrapeConfigs[0]["params"])
+			checks++
+		case resource.GetKind() == "DeploymentConfig" && resource.GetName() == "syndesis-prometheus":
+			containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "containers")
+			require.Len(t, containers, 2)
+			args, _, _ := unstructured.NestedStringSlice(containers[1].(map[string]interface{}), "args")
+			assert.Contains(t, args, "--upstream=http://localhost:9090/federate")
+			assert.Contains(t, args, `--openshift-delegate-urls={"/":{"namespace":"syndesis","resource":"pods","verb":"get"}}`)
+			checks++
+		}
+	}
+	assert.Equal(t, 3, checks)
+}
+
+func TestGeneratorNameResolution(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"},
+		Spec: v1alpha1.SyndesisSpec{
+			HostAliases: []corev1.HostAlias{{IP: "10.0.0.12", Hostnames: []string{"sso.corp.example.com"}}},
+			DNSSuffix:   "cluster.corp",
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, dir := range []string{"./infrastructure/", "./database/"} {
+		resources, err := generator.RenderDir(dir, configuration)
+		require.NoError(t, err, dir)
+
+		for _, resource := range resources {
+			if resource.GetKind() != "DeploymentConfig" {
+				continue
+			}
+			podSpec, _, _ := unstructured.NestedMap(resource.Object, "spec", "template", "spec")
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"ip": "10.0.0.12", "hostnames": []interface{}{"sso.corp.example.com"}},
+			}, podSpec["hostAliases"], resource.GetName())
+			searches, _, _ := unstructured.NestedStringSlice(podSpec, "dnsConfig", "searches")
+			assert.Equal(t, []string{"syndesis.svc.cluster.corp", "svc.cluster.corp", "cluster.corp"}, searches, resource.GetName())
+			checks++
+		}
+	}
+	assert.True(t, checks >= 6)
+}
+
+func TestGeneratorServiceAccounts(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"}}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	accounts := map[string]bool{}
+	bound := map[string]bool{}
+	used := map[string]string{}
+	for _, dir := range []string{"./infrastructure/", "./database/"} {
+		resources, err := generator.RenderDir(dir, configuration)
+		require.NoError(t, err, dir)
+
+		for _, resource := range resources {
+			switch resource.GetKind() {
+			case "ServiceAccount":
+				accounts[resource.GetName()] = true
+			case "RoleBinding":
+				subjects, _, _ := unstructured.NestedSlice(resource.Object, "subjects")
+				for _, subject := range subjects {
+					bound[subject.(map[string]interface{})["name"].(string)] = true
+				}
+			case "DeploymentConfig":
+				used[resource.GetName()], _, _ = unstructured.NestedString(resource.Object, "spec", "template", "spec", "serviceAccountName")
+			}
+		}
+	}
+
+	assert.Equal(t, "syndesis-server", used["syndesis-server"])
+	assert.Equal(t, "syndesis-meta", used["syndesis-meta"])
+	assert.Equal(t, "syndesis-ui", used["syndesis-ui"])
+	assert.Equal(t, "syndesis-db", used["syndesis-db"])
+	for dc, account := range used {
+		// The oauth client account is created by the operator
+		if account != "syndesis-oauth-client" {
+			assert.True(t, accounts[account], dc+" runs as "+account)
+		}
+	}
+	for _, account := range []string{"syndesis-meta", "syndesis-ui", "syndesis-db"} {
+		assert.False(t, bound[account], account)
+	}
+	assert.True(t, bound["syndesis-server"])
+}
+
+func TestGeneratorIntegrationController(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Integration: v1alpha1.IntegrationConfiguration{
+				Controller: v1alpha1.IntegrationControllerConfiguration{
+					StateCheckInterval:       300,
+					MaxConcurrentDeployments: 5,
+					RetryBackoff:             v1alpha1.IntegrationRetryBackoff{InitialDelay: 30, MaxDelay: 600},
+				},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.Render("./infrastructure/03-syndesis-server-config.yml.tmpl", configuration)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	data, _, _ := unstructured.NestedString(resources[0].Object, "data", "application.yml")
+	config := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(data), &config))
+	controllers := config["controllers"].(map[string]interface{})
+	assert.Equal(t, "300", controllers["integrationStateCheckInterval"])
+	assert.Equal(t, "5", controllers["maxConcurrentDeployments"])
+	assert.Equal(t, map[string]interface{}{"initialDelay": "30", "maxDelay": "600"}, controllers["retryBackoff"])
+}
+
+func TestGeneratorConnectors(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Connectors: v1alpha1.ConnectorsConfiguration{Deny: []string{"ftp", "http4"}},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.Render("./infrastructure/03-syndesis-server-config.yml.tmpl", configuration)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	data, _, _ := unstructured.NestedString(resources[0].Object, "data", "application.yml")
+	config := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(data), &config))
+	assert.Equal(t, map[string]interface{}{"denied": []interface{}{"ftp", "http4"}}, config["connectors"])
+}
+
+func TestGeneratorDemoData(t *testing.T) {
+	postStart := func(demoData v1alpha1.DemoDataConfiguration) string {
+		syndesis := &v1alpha1.Syndesis{
+			Spec: v1alpha1.SyndesisSpec{
+				Components: v1alpha1.ComponentsSpec{
+					Server: v1alpha1.ServerConfiguration{Features: v1alpha1.ServerFeatures{DemoData: demoData}},
+				},
+			},
+		}
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		resources, err := generator.RenderDir("./database/", configuration)
+		require.NoError(t, err)
+		for _, resource := range resources {
+			if resource.GetKind() == "DeploymentConfig" && resource.GetName() == "syndesis-db" {
+				containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "containers")
+				command, _, _ := unstructured.NestedStringSlice(containers[0].(map[string]interface{}), "lifecycle", "postStart", "exec", "command")
+				if len(command) == 0 {
+					return ""
+				}
+				return command[len(command)-1]
+			}
+		}
+		t.Fatal("syndesis-db not rendered")
+		return ""
+	}
+
+	assert.Equal(t, "", postStart(v1alpha1.DemoDataConfiguration{}))
+	assert.Equal(t, "/var/lib/pgsql/sampledb/postStart.sh", postStart(v1alpha1.DemoDataConfiguration{SampleDB: true}))
+	assert.Equal(t, "/var/lib/pgsql/sampledb/postStart.sh", postStart(v1alpha1.DemoDataConfiguration{Todo: true}))
+	assert.Equal(t, "/var/lib/pgsql/sampledb/purge-sample-db.sh", postStart(v1alpha1.DemoDataConfiguration{SampleDB: true, Purge: true}))
+}
+
+func TestGeneratorMirroredImageStreams(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"}}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+	configuration.MirroredImageStreamTags = map[string]string{"postgresql:9.6": "mirror.example.com/rhscl/postgresql-96-rhel7:latest"}
+
+	resources, err := generator.RenderDir("./database/", configuration)
+	require.NoError(t, err)
+
+	checks := 0
+	for _, resource := range resources {
+		switch {
+		case resource.GetKind() == "ImageStream" && resource.GetName() == "postgresql":
+			tags, _, _ := unstructured.NestedSlice(resource.Object, "spec", "tags")
+			require.Len(t, tags, 1)
+			assertPropStr(t, tags[0].(map[string]interface{}), "9.6", "name")
+			assertPropStr(t, tags[0].(map[string]interface{}), "mirror.example.com/rhscl/postgresql-96-rhel7:latest", "from", "name")
+			checks++
+		case resource.GetKind() == "DeploymentConfig" && resource.GetName() == "syndesis-db":
+			triggers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "triggers")
+			require.Len(t, triggers, 2)
+			assertPropStr(t, triggers[1].(map[string]interface{}), "syndesis", "imageChangeParams", "from", "namespace")
+			checks++
+		}
+	}
+	assert.Equal(t, 2, checks)
+}
+
+func TestGeneratorSmokeTest(t *testing.T) {
+	script := func(smokeTest v1alpha1.SmokeTestConfiguration) string {
+		syndesis := &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{SmokeTest: smokeTest}}
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		resources, err := generator.RenderDir("./smoketest/", configuration)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		containers, _, _ := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "containers")
+		require.Len(t, containers, 1)
+		command, _, _ := unstructured.NestedStringSlice(containers[0].(map[string]interface{}), "command")
+		require.Len(t, command, 3)
+		return command[2]
+	}
+
+	assert.Contains(t, script(v1alpha1.SmokeTestConfiguration{Enabled: true}), "http://syndesis-server/api/v1/connectors")
+	assert.Equal(t, "curl -fsS http://syndesis-server/api/v1/version\nexit 0", script(v1alpha1.SmokeTestConfiguration{
+		Enabled: true,
+		Script:  "curl -fsS http://syndesis-server/api/v1/version\nexit 0",
+	}))
+}
+
+func TestGeneratorRouteShards(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			AlternateHostnames:     []string{"syndesis.legacy.example.com"},
+			AlternateHostnamesMode: v1alpha1.SyndesisAlternateHostnamesModeProxy,
+			Route: v1alpha1.RouteConfiguration{
+				Labels:      map[string]string{"router": "internal"},
+				Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "2m"},
+			},
+		},
+	}
+	configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+	configuration.RouteHostname = "syndesis.example.com"
+
+	for _, dir := range []string{"./route/", "./alternate/"} {
+		resources, err := generator.RenderDir(dir, configuration)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "internal", resources[0].GetLabels()["router"])
+		assert.Equal(t, "syndesis", resources[0].GetLabels()["syndesis.io/app"])
+		assert.Equal(t, "2m", resources[0].GetAnnotations()["haproxy.router.openshift.io/timeout"])
+	}
+}
+
+func TestGeneratorStandby(t *testing.T) {
+	render := func(syndesis *v1alpha1.Syndesis) (command []string, env map[string]string, replicas interface{}) {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		env = map[string]string{}
+		for _, dir := range []string{"./database/", "./infrastructure/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				if resource.GetKind() != "DeploymentConfig" {
+					continue
+				}
+				switch resource.GetName() {
+				case "syndesis-db":
+					containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "containers")
+					command, _, _ = unstructured.NestedStringSlice(containers[0].(map[string]interface{}), "command")
+					vars, _, _ := unstructured.NestedSlice(containers[0].(map[string]interface{}), "env")
+					for _, v := range vars {
+						env[v.(map[string]interface{})["name"].(string)], _ = v.(map[string]interface{})["value"].(string)
+					}
+				case "syndesis-server":
+					replicas, _, _ = unstructured.NestedFieldNoCopy(resource.Object, "spec", "replicas")
+				}
+			}
+		}
+		return
+	}
+
+	syndesis := &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{
+		Standby: v1alpha1.StandbyConfiguration{Enabled: true, PrimaryHost: "syndesis-db.syndesis.svc"},
+	}}
+	command, env, replicas := render(syndesis)
+	assert.Equal(t, []string{"run-postgresql-slave"}, command)
+	assert.Equal(t, "syndesis-db.syndesis.svc", env["POSTGRESQL_MASTER_SERVICE_NAME"])
+	assert.Equal(t, "replicator", env["POSTGRESQL_MASTER_USER"])
+	assert.EqualValues(t, 0, replicas)
+
+	// Promoted
+	syndesis.Spec.Standby.Enabled = false
+	syndesis.Status.Standby = true
+	command, env, replicas = render(syndesis)
+	require.Len(t, command, 3)
+	assert.Contains(t, command[2], "exec run-postgresql")
+	assert.NotContains(t, env, "POSTGRESQL_MASTER_SERVICE_NAME")
+	assert.EqualValues(t, 1, replicas)
+
+	// Primary of standbys
+	command, env, _ = render(&v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{
+		Standby: v1alpha1.StandbyConfiguration{AllowReplication: true},
+	}})
+	assert.Equal(t, []string{"run-postgresql-master"}, command)
+	assert.Equal(t, "replicator", env["POSTGRESQL_MASTER_USER"])
+}
+
+func TestGeneratorSidecarResources(t *testing.T) {
+	render := func(syndesis *v1alpha1.Syndesis) map[string]interface{} {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		sidecars := map[string]interface{}{}
+		for _, dir := range []string{"./database/", "./infrastructure/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				if resource.GetKind() != "DeploymentConfig" {
+					continue
+				}
+				containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "containers")
+				for _, c := range containers {
+					container := c.(map[string]interface{})
+					if name := container["name"]; name == "oauthproxy" || name == "syndesis-db-metrics" {
+						sidecars[name.(string)] = container["resources"]
+					}
+				}
+			}
+		}
+		return sidecars
+	}
+
+	sidecars := render(&v1alpha1.Syndesis{})
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "200Mi"},
+		"requests": map[string]interface{}{"memory": "20Mi"},
+	}, sidecars["oauthproxy"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "256Mi"},
+		"requests": map[string]interface{}{"memory": "20Mi"},
+	}, sidecars["syndesis-db-metrics"])
+
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Spec.Components.Oauth.Resources = v1alpha1.AddonResources{MemoryLimit: "64Mi", CPULimit: "100m"}
+	syndesis.Spec.Components.Database.Exporter.Resources = v1alpha1.AddonResources{MemoryRequest: "32Mi", CPURequest: "10m"}
+	sidecars = render(syndesis)
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "64Mi", "cpu": "100m"},
+		"requests": map[string]interface{}{"memory": "20Mi"},
+	}, sidecars["oauthproxy"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "256Mi"},
+		"requests": map[string]interface{}{"memory": "32Mi", "cpu": "10m"},
+	}, sidecars["syndesis-db-metrics"])
+}
+
+func TestGeneratorComponentResources(t *testing.T) {
+	render := func(syndesis *v1alpha1.Syndesis) map[string]interface{} {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		components := map[string]interface{}{}
+		for _, dir := range []string{"./database/", "./infrastructure/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				if resource.GetKind() != "DeploymentConfig" {
+					continue
+				}
+				containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", "containers")
+				for _, c := range containers {
+					container := c.(map[string]interface{})
+					if name := container["name"]; name == resource.GetName() || name == "postgresql" || name == "prometheus" {
+						components[resource.GetName()] = container["resources"]
+					}
+				}
+			}
+		}
+		return components
+	}
+
+	components := render(&v1alpha1.Syndesis{})
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "800Mi", "cpu": "750m"},
+		"requests": map[string]interface{}{"memory": "256Mi", "cpu": "450m"},
+	}, components["syndesis-server"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "512Mi"},
+		"requests": map[string]interface{}{"memory": "280Mi"},
+	}, components["syndesis-meta"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "255Mi"},
+		"requests": map[string]interface{}{"memory": "255Mi"},
+	}, components["syndesis-db"])
+
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Spec.Components.Server.Resources = v1alpha1.Resources{Memory: "2Gi", MemoryRequest: "1Gi", CPU: "1", CPULimit: "2"}
+	syndesis.Spec.Components.Meta.Resources = v1alpha1.ResourcesWithVolume{CPU: "100m"}
+	syndesis.Spec.Components.Database.Resources = v1alpha1.ResourcesWithVolume{Memory: "1Gi", MemoryRequest: "255Mi", CPULimit: "500m"}
+	syndesis.Spec.Components.Prometheus.Resources = v1alpha1.ResourcesWithVolume{CPU: "200m", CPULimit: "200m"}
+	components = render(syndesis)
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "2Gi", "cpu": "2"},
+		"requests": map[string]interface{}{"memory": "1Gi", "cpu": "1"},
+	}, components["syndesis-server"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "512Mi"},
+		"requests": map[string]interface{}{"memory": "280Mi", "cpu": "100m"},
+	}, components["syndesis-meta"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "1Gi", "cpu": "500m"},
+		"requests": map[string]interface{}{"memory": "255Mi"},
+	}, components["syndesis-db"])
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"memory": "512Mi", "cpu": "200m"},
+		"requests": map[string]interface{}{"memory": "512Mi", "cpu": "200m"},
+	}, components["syndesis-prometheus"])
+}
+
+func TestGeneratorVolumes(t *testing.T) {
+	render := func(syndesis *v1alpha1.Syndesis) map[string]map[string]interface{} {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+		claims := map[string]map[string]interface{}{}
+		for _, dir := range []string{"./database/", "./infrastructure/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				if resource.GetKind() == "PersistentVolumeClaim" {
+					spec, _, _ := unstructured.NestedMap(resource.Object, "spec")
+					delete(spec, "resources")
+					claims[resource.GetName()] = spec
+				}
+			}
+		}
+		return claims
+	}
+
+	claims := render(&v1alpha1.Syndesis{})
+	for _, name := range []string{"syndesis-db", "syndesis-meta", "syndesis-prometheus"} {
+		assert.Equal(t, map[string]interface{}{"accessModes": []interface{}{"ReadWriteOnce"}}, claims[name], name)
+	}
+
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Spec.Components.Database.Resources = v1alpha1.ResourcesWithVolume{VolumeStorageClass: "ceph-rbd", VolumeName: "syndesis-db-data"}
+	syndesis.Spec.Components.Prometheus.Resources = v1alpha1.ResourcesWithVolume{VolumeStorageClass: "gp2", VolumeAccessMode: "ReadWriteMany"}
+	claims = render(syndesis)
+	assert.Equal(t, map[string]interface{}{
+		"storageClassName": "ceph-rbd",
+		"volumeName":       "syndesis-db-data",
+		"accessModes":      []interface{}{"ReadWriteOnce"},
+	}, claims["syndesis-db"])
+	assert.Equal(t, map[string]interface{}{
+		"storageClassName": "gp2",
+		"accessModes":      []interface{}{"ReadWriteMany"},
+	}, claims["syndesis-prometheus"])
+	assert.Equal(t, map[string]interface{}{"accessModes": []interface{}{"ReadWriteOnce"}}, claims["syndesis-meta"])
+}
+
+func TestGeneratorScheduling(t *testing.T) {
+	render := func(syndesis *v1alpha1.Syndesis) map[string]map[string]interface{} {
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+		pods := map[string]map[string]interface{}{}
+		for _, dir := range []string{"./database/", "./infrastructure/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				if resource.GetKind() == "DeploymentConfig" {
+					spec, _, _ := unstructured.NestedMap(resource.Object, "spec", "template", "spec")
+					pods[resource.GetName()] = spec
+				}
+			}
+		}
+		return pods
+	}
+
+	pods := render(&v1alpha1.Syndesis{})
+	for name, spec := range pods {
+		assert.NotContains(t, spec, "nodeSelector", name)
+		assert.NotContains(t, spec, "tolerations", name)
+		assert.NotContains(t, spec, "affinity", name)
+	}
+
+	storage := map[string]string{"node-role.kubernetes.io/storage": "true"}
+	toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "storage", Effect: corev1.TaintEffectNoSchedule}
+	nodeAffinity := &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+		NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "node-role.kubernetes.io/infra", Operator: corev1.NodeSelectorOpDoesNotExist},
+		}}},
+	}}
+	antiAffinity := &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+		TopologyKey:   "kubernetes.io/hostname",
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"syndesis.io/component": "syndesis-ui"}},
+	}}}
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Spec.Components.Database.ReadReplicas = 1
+	syndesis.Spec.Components.Database.Scheduling = v1alpha1.SchedulingConfiguration{NodeSelector: storage, Tolerations: []corev1.Toleration{toleration}}
+	syndesis.Spec.Components.Server.Replicas = 2
+	syndesis.Spec.Components.Server.Scheduling.Affinity = &corev1.Affinity{NodeAffinity: nodeAffinity}
+	syndesis.Spec.Components.UI.Replicas = 2
+	syndesis.Spec.Components.UI.Scheduling.Affinity = &corev1.Affinity{PodAntiAffinity: antiAffinity}
+	syndesis.Spec.Components.Meta.Scheduling.Affinity = &corev1.Affinity{NodeAffinity: nodeAffinity}
+	pods = render(syndesis)
+
+	for _, name := range []string{"syndesis-db", "syndesis-db-replica"} {
+		require.Contains(t, pods, name)
+		assert.Equal(t, map[string]interface{}{"node-role.kubernetes.io/storage": "true"}, pods[name]["nodeSelector"], name)
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"key": "dedicated", "operator": "Equal", "value": "storage", "effect": "NoSchedule",
+		}}, pods[name]["tolerations"], name)
+	}
+
+	// The node affinity comes on top of the anti-affinity spreading the replicas, an own anti-affinity replaces it
+	serverAffinity := pods["syndesis-server"]["affinity"].(map[string]interface{})
+	assert.Contains(t, serverAffinity, "nodeAffinity")
+	assert.Contains(t, serverAffinity, "podAntiAffinity")
+	uiAntiAffinity, _, _ := unstructured.NestedSlice(pods["syndesis-ui"], "affinity", "podAntiAffinity", "requiredDuringSchedulingIgnoredDuringExecution")
+	assert.Len(t, uiAntiAffinity, 1)
+	spread, _, _ := unstructured.NestedSlice(pods["syndesis-ui"], "affinity", "podAntiAffinity", "preferredDuringSchedulingIgnoredDuringExecution")
+	assert.Empty(t, spread)
+	terms, _, _ := unstructured.NestedSlice(pods["syndesis-meta"], "affinity", "nodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms")
+	assert.Len(t, terms, 1)
+	assert.NotContains(t, pods["syndesis-server"], "nodeSelector")
+}
+
+func TestGeneratorInternalTLS(t *testing.T) {
+	render := func(internalTLS v1alpha1.InternalTLSConfiguration) map[string]unstructured.Unstructured {
+		syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"}}
+		syndesis.Spec.InternalTLS = internalTLS
+		configuration, err := configuration.GetProperties("../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+		require.NoError(t, err)
+
+		rendered := map[string]unstructured.Unstructured{}
+		for _, dir := range []string{"./database/", "./infrastructure/", "./internaltls/"} {
+			resources, err := generator.RenderDir(dir, configuration)
+			require.NoError(t, err)
+			for _, resource := range resources {
+				rendered[resource.GetKind()+"/"+resource.GetName()] = resource
+			}
+		}
+		return rendered
+	}
+	container := func(resource unstructured.Unstructured, field string, name string) map[string]interface{} {
+		containers, _, _ := unstructured.NestedSlice(resource.Object, "spec", "template", "spec", field)
+		for _, c := range containers {
+			if c.(map[string]interface{})["name"] == name {
+				return c.(map[string]interface{})
+			}
+		}
+		return nil
+	}
+	annotations := func(resource unstructured.Unstructured) map[string]string {
+		return resource.GetAnnotations()
+	}
+	env := func(container map[string]interface{}, name string) string {
+		vars, _, _ := unstructured.NestedSlice(container, "env")
+		for _, v := range vars {
+			if v.(map[string]interface{})["name"] == name {
+				value, _ := v.(map[string]interface{})["value"].(string)
+				return value
+			}
+		}
+		return ""
+	}
+
+	// Disabled
+	resources := render(v1alpha1.InternalTLSConfiguration{})
+	server := resources["DeploymentConfig/syndesis-server"]
+	assert.Nil(t, container(server, "initContainers", "keystores"))
+	assert.NotContains(t, env(container(server, "containers", "syndesis-server"), "JAVA_OPTIONS"), "javax.net.ssl")
+	serverConfig, _, _ := unstructured.NestedString(resources["ConfigMap/syndesis-server-config"].Object, "data", "application.yml")
+	assert.Contains(t, serverConfig, "url: 'jdbc:postgresql://syndesis-db:5432/syndesis?sslmode=disable'")
+	ports, _, _ := unstructured.NestedSlice(resources["Service/syndesis-meta"].Object, "spec", "ports")
+	assert.EqualValues(t, 80, ports[0].(map[string]interface{})["port"])
+
+	// Service CA
+	resources = render(v1alpha1.InternalTLSConfiguration{Enabled: true})
+	assert.Equal(t, "syndesis-meta-tls", annotations(resources["Service/syndesis-meta"])["service.beta.openshift.io/serving-cert-secret-name"])
+	assert.Equal(t, "syndesis-db-tls", annotations(resources["Service/syndesis-db"])["service.beta.openshift.io/serving-cert-secret-name"])
+	assert.Equal(t, "true", annotations(resources["ConfigMap/syndesis-internal-ca"])["service.beta.openshift.io/inject-cabundle"])
+	assert.NotContains(t, resources, "Certificate/syndesis-meta-tls")
+	ports, _, _ = unstructured.NestedSlice(resources["Service/syndesis-meta"].Object, "spec", "ports")
+	assert.EqualValues(t, 443, ports[0].(map[string]interface{})["port"])
+
+	server = resources["DeploymentConfig/syndesis-server"]
+	keystores := container(server, "initContainers", "keystores")
+	require.NotNil(t, keystores)
+	assert.Equal(t, "/etc/syndesis/internal-ca/service-ca.crt", env(keystores, "INTERNAL_CA_FILE"))
+	javaOptions := env(container(server, "containers", "syndesis-server"), "JAVA_OPTIONS")
+	assert.Contains(t, javaOptions, "-Djavax.net.ssl.trustStore=/deployments/tls/truststore.p12")
+	assert.NotContains(t, javaOptions, "-Djavax.net.ssl.keyStore=")
+	serverConfig, _, _ = unstructured.NestedString(resources["ConfigMap/syndesis-server-config"].Object, "data", "application.yml")
+	assert.Contains(t, serverConfig, "url: 'jdbc:postgresql://syndesis-db.syndesis.svc:5432/syndesis?sslmode=verify-full&sslrootcert=%2Fetc%2Fsyndesis%2Finternal-ca%2Fservice-ca.crt'")
+	assert.Contains(t, serverConfig, "url: https://syndesis-meta.syndesis.svc/api/v1")
+	metaConfig, _, _ := unstructured.NestedString(resources["ConfigMap/syndesis-meta-config"].Object, "data", "application.yml")
+	assert.Contains(t, metaConfig, "key-store: /deployments/tls/keystore.p12")
+	assert.NotContains(t, metaConfig, "client-auth: need")
+	dbConfig, _, _ := unstructured.NestedString(resources["ConfigMap/syndesis-db-conf"].Object, "data", "syndesis-postgresql.conf")
+	assert.Contains(t, dbConfig, "ssl = on")
+
+	// cert-manager
+	resources = render(v1alpha1.InternalTLSConfiguration{
+		Enabled:   true,
+		Issuer:    v1alpha1.SyndesisCertificateIssuerCertManager,
+		IssuerRef: v1alpha1.CertificateIssuerReference{Name: "internal", Kind: "ClusterIssuer"},
+	})
+	for _, name := range []string{"syndesis-server-tls", "syndesis-meta-tls", "syndesis-db-tls"} {
+		certificate, ok := resources["Certificate/"+name]
+		require.True(t, ok, name)
+		issuer, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+		assert.Equal(t, map[string]string{"name": "internal", "kind": "ClusterIssuer"}, issuer)
+	}
+	assert.NotContains(t, resources, "ConfigMap/syndesis-internal-ca")
+	assert.NotContains(t, annotations(resources["Service/syndesis-meta"]), "service.beta.openshift.io/serving-cert-secret-name")
+
+	server = resources["DeploymentConfig/syndesis-server"]
+	assert.Equal(t, "/etc/syndesis/tls/ca.crt", env(container(server, "initContainers", "keystores"), "INTERNAL_CA_FILE"))
+	assert.Contains(t, env(container(server, "containers", "syndesis-server"), "JAVA_OPTIONS"), "-Djavax.net.ssl.keyStore=/deployments/tls/keystore.p12")
+	metaConfig, _, _ = unstructured.NestedString(resources["ConfigMap/syndesis-meta-config"].Object, "data", "application.yml")
+	assert.Contains(t, metaConfig, "client-auth: need")
+}
@@ -7,6 +7,9 @@ import (
 	"net/http"
 )
 
+// Assets are read from disk and may change while running
+const cacheTemplates = false
+
 func GetAssetsFS() http.FileSystem {
 	return dev.GetAssetsFS()
 }
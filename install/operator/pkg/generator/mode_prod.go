@@ -6,6 +6,9 @@ import (
 	"net/http"
 )
 
+// Assets are embedded in the binary, so parsed templates can be cached
+const cacheTemplates = true
+
 func GetAssetsFS() http.FileSystem {
 	return assets
 }
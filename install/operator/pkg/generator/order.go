@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// Kinds in the order their resources are listed in manifests, so that applying a manifest as is creates the
+// resources before the ones referring to them. Resources of other kinds come last, in alphabetical order of
+// their kind, and resources of the same kind in alphabetical order of their namespace and name.
+var KindOrder = []string{
+	"Namespace",
+	"CustomResourceDefinition",
+	"ServiceAccount",
+	"Secret",
+	"SealedSecret",
+	"ConfigMap",
+	"PersistentVolumeClaim",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"OAuthClient",
+	"LimitRange",
+	"ResourceQuota",
+	"Service",
+	"ImageStream",
+	"BuildConfig",
+	"DeploymentConfig",
+	"Deployment",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"Route",
+	"Ingress",
+}
+
+var kindRanks = func() map[string]int {
+	ranks := map[string]int{}
+	for i, kind := range KindOrder {
+		ranks[kind] = i
+	}
+	return ranks
+}()
+
+func kindRank(kind string) int {
+	if rank, ok := kindRanks[kind]; ok {
+		return rank
+	}
+	return len(KindOrder)
+}
+
+// Sorts the resources in the order of KindOrder. Rendering the same configuration always gives the same
+// manifest, whatever the templates or components the resources come from.
+func SortResources(resources []unstructured.Unstructured) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := &resources[i], &resources[j]
+		if rankA, rankB := kindRank(a.GetKind()), kindRank(b.GetKind()); rankA != rankB {
+			return rankA < rankB
+		}
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+// Serializes the resources as a YAML List, sorted with SortResources and with the fields of every object in
+// alphabetical order, so that manifests of equal resources are equal byte for byte
+func Manifest(resources []unstructured.Unstructured) ([]byte, error) {
+	sorted := append([]unstructured.Unstructured(nil), resources...)
+	SortResources(sorted)
+	items := make([]interface{}, 0, len(sorted))
+	for _, res := range sorted {
+		items = append(items, res.Object)
+	}
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	})
+}
+
+// Renders the resources twice, without the render cache, and fails with the difference when the manifests
+// differ, e.g. because a template depends on the iteration order of a map or on the current time
+func CheckIdempotent(render func() ([]unstructured.Unstructured, error)) error {
+	manifests := make([]string, 2)
+	for i := range manifests {
+		ClearRenderCache()
+		resources, err := render()
+		if err != nil {
+			return err
+		}
+		manifest, err := Manifest(resources)
+		if err != nil {
+			return err
+		}
+		manifests[i] = string(manifest)
+	}
+	if manifests[0] == manifests[1] {
+		return nil
+	}
+
+	diff, err := util.UnifiedDiff(manifests[0], manifests[1])
+	if err != nil {
+		return err
+	}
+	return errors.Errorf("rendering the same configuration twice gave different resources:\n%s", diff)
+}
@@ -0,0 +1,75 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resource(kind string, name string) unstructured.Unstructured {
+	res := unstructured.Unstructured{Object: map[string]interface{}{}}
+	res.SetAPIVersion("v1")
+	res.SetKind(kind)
+	res.SetName(name)
+	return res
+}
+
+func TestSortResources(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		resource("Route", "syndesis"),
+		resource("Addon", "todo"),
+		resource("Service", "syndesis-server"),
+		resource("Secret", "syndesis-server-secret"),
+		resource("Service", "syndesis-meta"),
+		resource("ServiceAccount", "syndesis-server"),
+	}
+	generator.SortResources(resources)
+
+	order := []string{}
+	for _, res := range resources {
+		order = append(order, res.GetKind()+"/"+res.GetName())
+	}
+	assert.Equal(t, []string{
+		"ServiceAccount/syndesis-server",
+		"Secret/syndesis-server-secret",
+		"Service/syndesis-meta",
+		"Service/syndesis-server",
+		"Route/syndesis",
+		"Addon/todo",
+	}, order)
+}
+
+func TestManifest(t *testing.T) {
+	a := resource("Service", "syndesis-server")
+	a.Object["spec"] = map[string]interface{}{"type": "ClusterIP", "ports": []interface{}{map[string]interface{}{"port": 80, "name": "http"}}}
+	b := resource("ConfigMap", "syndesis-server-config")
+
+	first, err := generator.Manifest([]unstructured.Unstructured{a, b})
+	require.NoError(t, err)
+	second, err := generator.Manifest([]unstructured.Unstructured{b, a})
+	require.NoError(t, err)
+	assert.Equal(t, string(first), string(second))
+	assert.Contains(t, string(first), "    ports:\n    - name: http\n      port: 80\n    type: ClusterIP\n")
+}
+
+func TestCheckIdempotent(t *testing.T) {
+	renderings := 0
+	err := generator.CheckIdempotent(func() ([]unstructured.Unstructured, error) {
+		renderings++
+		return []unstructured.Unstructured{resource("Service", "syndesis-server")}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, renderings)
+
+	err = generator.CheckIdempotent(func() ([]unstructured.Unstructured, error) {
+		renderings++
+		res := resource("Service", "syndesis-server")
+		res.SetLabels(map[string]string{"rendering": string(rune('0' + renderings))})
+		return []unstructured.Unstructured{res}, nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-      rendering: \"3\"\n+      rendering: \"4\"")
+}
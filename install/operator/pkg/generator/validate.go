@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Container fields of Kubernetes releases newer than the vendored API, with the type they have there. They are
+// checked against that type on their own, the vendored container not knowing them.
+var newerContainerFields = map[string]func() interface{}{
+	"startupProbe": func() interface{} { return &corev1.Probe{} }, // Kubernetes 1.16
+}
+
+// Checks the rendered resources against the Go types registered in the scheme,
+// so that a field misplaced by the template, e.g. because of a wrong indentation,
+// or a value of the wrong type is reported instead of being silently dropped by
+// the API server. Kinds unknown to the scheme, like optional custom resources,
+// are not checked.
+func Validate(scheme *runtime.Scheme, resources []unstructured.Unstructured) error {
+	for _, res := range resources {
+		gvk := res.GroupVersionKind()
+		if !scheme.Recognizes(gvk) {
+			continue
+		}
+
+		typed, err := scheme.New(gvk)
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(res.Object)
+		if err != nil {
+			return err
+		}
+
+		// The newer fields are taken out of a copy of the resource
+		var object interface{}
+		if err := json.Unmarshal(data, &object); err != nil {
+			return err
+		}
+		if err := validateNewerFields(object); err != nil {
+			return errors.Wrapf(err, "invalid %s %s", res.GetKind(), res.GetName())
+		}
+		if data, err = json.Marshal(object); err != nil {
+			return err
+		}
+
+		if err := decodeStrict(data, typed); err != nil {
+			return errors.Wrapf(err, "invalid %s %s", res.GetKind(), res.GetName())
+		}
+	}
+	return nil
+}
+
+// Checks then removes the newer fields of the containers found in the object
+func validateNewerFields(object interface{}) error {
+	switch value := object.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if containers, ok := field.([]interface{}); ok && (key == "containers" || key == "initContainers") {
+				for _, container := range containers {
+					if err := validateNewerContainerFields(container); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := validateNewerFields(field); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if err := validateNewerFields(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateNewerContainerFields(container interface{}) error {
+	fields, ok := container.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for name, newType := range newerContainerFields {
+		field, present := fields[name]
+		if !present {
+			continue
+		}
+		data, err := json.Marshal(field)
+		if err != nil {
+			return err
+		}
+		if err := decodeStrict(data, newType()); err != nil {
+			return errors.Wrapf(err, "invalid %s of container %v", name, fields["name"])
+		}
+		delete(fields, name)
+	}
+	return nil
+}
+
+func decodeStrict(data []byte, into interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(into)
+}
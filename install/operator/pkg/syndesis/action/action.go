@@ -5,6 +5,9 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/audit"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/notification"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,13 +50,29 @@ func NewOperatorActions(mgr manager.Manager, api kubernetes.Interface) []Syndesi
 		newStartupAction(mgr, api),
 		newUpgradeAction(mgr, api),
 		newUpgradeBackoffAction(mgr, api),
+		newUpgradeQuarantineAction(mgr, api),
+		newTelemetryAction(mgr, api),
+		newConnectionsAction(mgr, api),
+		newImportAction(mgr, api),
+		newRepublishAction(mgr, api),
+		newImagePolicyAction(mgr, api),
+		newRemediationAction(mgr, api),
+		newCertificatesAction(mgr, api),
+	}
+}
+
+// Actions run instead of the operator actions once the syndesis resource is being deleted
+func NewFinalizerActions(mgr manager.Manager, api kubernetes.Interface) []SyndesisOperatorAction {
+	return []SyndesisOperatorAction{
+		newExportAction(mgr, api),
+		newOAuthClientCleanupAction(mgr, api),
 	}
 }
 
 func newBaseAction(mgr manager.Manager, api kubernetes.Interface, typeS string) baseAction {
 	return baseAction{
 		actionLog.WithValues("type", typeS),
-		mgr.GetClient(),
+		audit.NewClient(mgr.GetClient(), mgr.GetScheme(), typeS),
 		mgr.GetScheme(),
 		api,
 		mgr,
@@ -74,6 +93,34 @@ func syndesisPhaseIs(syndesis *v1alpha1.Syndesis, statuses ...v1alpha1.SyndesisP
 	return false
 }
 
+func hasFinalizer(syndesis *v1alpha1.Syndesis, name string) bool {
+	for _, finalizer := range syndesis.GetFinalizers() {
+		if finalizer == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Adds the finalizer when missing, returning whether the resource changed
+func ensureFinalizer(syndesis *v1alpha1.Syndesis, name string) bool {
+	if hasFinalizer(syndesis, name) {
+		return false
+	}
+	syndesis.SetFinalizers(append(syndesis.GetFinalizers(), name))
+	return true
+}
+
+func removeFinalizer(syndesis *v1alpha1.Syndesis, name string) {
+	finalizers := []string{}
+	for _, finalizer := range syndesis.GetFinalizers() {
+		if finalizer != name {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	syndesis.SetFinalizers(finalizers)
+}
+
 func createOrReplaceForce(ctx context.Context, client client.Client, res runtime.Object, force bool) error {
 	if err := client.Create(ctx, res); err != nil && k8serrors.IsAlreadyExists(err) {
 		if force || canResourceBeReplaced(res) {
@@ -100,3 +147,18 @@ func canResourceBeReplaced(res runtime.Object) bool {
 	}
 	return true
 }
+
+// Send a notification using the configuration of the given syndesis resource. Failures are only logged,
+// as notifications must never block the reconciliation.
+func (a *baseAction) notify(ctx context.Context, syndesis *v1alpha1.Syndesis, subject string, message string) {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		a.log.Error(err, "Unable to load configuration for notifications", "subject", subject)
+		return
+	}
+
+	event := notification.Event{Namespace: syndesis.Namespace, Subject: subject, Message: message}
+	if err := notification.Send(ctx, a.client, config.Syndesis.Notifications, event); err != nil {
+		a.log.Error(err, "Unable to send notification", "subject", subject)
+	}
+}
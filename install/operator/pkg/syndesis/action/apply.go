@@ -0,0 +1,92 @@
+package action
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Maximum number of resources created or updated at the same time
+const applyWorkers = 8
+
+// Resources are applied in stages, so that the ones others depend on exist first.
+// Kinds not listed here are applied with services and routes, in defaultApplyStage.
+var applyStages = map[string]int{
+	"CustomResourceDefinition": 0,
+	"ServiceAccount":           1,
+	"Secret":                   1,
+	"ConfigMap":                1,
+	"Role":                     1,
+	"RoleBinding":              1,
+	"ClusterRole":              1,
+	"ClusterRoleBinding":       1,
+	"PersistentVolumeClaim":    1,
+	"ImageStream":              1,
+	"DeploymentConfig":         3,
+	"Deployment":               3,
+	"StatefulSet":              3,
+	"DaemonSet":                3,
+}
+
+const defaultApplyStage = 2
+
+func applyStageOf(res unstructured.Unstructured) int {
+	if stage, ok := applyStages[res.GetKind()]; ok {
+		return stage
+	}
+	return defaultApplyStage
+}
+
+// Applies the resources stage after stage, the resources of a stage being applied concurrently.
+// The first error encountered is returned once its stage is over, later stages are skipped.
+func applyConcurrently(resources []unstructured.Unstructured, apply func(res unstructured.Unstructured) error) error {
+	stages := map[int][]unstructured.Unstructured{}
+	for _, res := range resources {
+		stage := applyStageOf(res)
+		stages[stage] = append(stages[stage], res)
+	}
+
+	order := make([]int, 0, len(stages))
+	for stage := range stages {
+		order = append(order, stage)
+	}
+	sort.Ints(order)
+
+	for _, stage := range order {
+		if err := applyAll(stages[stage], apply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyAll(resources []unstructured.Unstructured, apply func(res unstructured.Unstructured) error) error {
+	work := make(chan unstructured.Unstructured)
+	errs := make(chan error, len(resources))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < applyWorkers && i < len(resources); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for res := range work {
+				errs <- apply(res)
+			}
+		}()
+	}
+
+	for _, res := range resources {
+		work <- res
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
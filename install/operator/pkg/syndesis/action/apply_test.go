@@ -0,0 +1,51 @@
+package action
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceOfKind(kind string, name string) unstructured.Unstructured {
+	res := unstructured.Unstructured{Object: map[string]interface{}{}}
+	res.SetKind(kind)
+	res.SetName(name)
+	return res
+}
+
+func Test_applyConcurrently(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		resourceOfKind("DeploymentConfig", "syndesis-server"),
+		resourceOfKind("Service", "syndesis-server"),
+		resourceOfKind("Secret", "syndesis-server-secret"),
+		resourceOfKind("ConfigMap", "syndesis-server-config"),
+		resourceOfKind("DeploymentConfig", "syndesis-meta"),
+	}
+
+	lock := sync.Mutex{}
+	stages := []int{}
+	err := applyConcurrently(resources, func(res unstructured.Unstructured) error {
+		lock.Lock()
+		defer lock.Unlock()
+		stages = append(stages, applyStageOf(res))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 1, 2, 3, 3}, stages)
+
+	applied := 0
+	err = applyConcurrently(resources, func(res unstructured.Unstructured) error {
+		lock.Lock()
+		defer lock.Unlock()
+		applied++
+		if res.GetKind() == "Service" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 3, applied)
+}
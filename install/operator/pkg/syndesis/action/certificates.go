@@ -0,0 +1,221 @@
+package action
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// Secret of the serving certificate of the oauth proxy, generated by the service CA unless replaced
+	oauthProxyTLSSecret = "syndesis-oauthproxy-tls"
+
+	// Annotation cert-manager sets on the secrets of the certificates it manages
+	certManagerCertificateAnnotation = "cert-manager.io/certificate-name"
+)
+
+// Secrets of the certificates of the components with internal TLS, syndesis-server only has one with cert-manager
+var internalTLSSecrets = []string{"syndesis-server-tls", "syndesis-meta-tls", "syndesis-db-tls"}
+
+var certificateExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "syndesis_certificate_expiry_timestamp_seconds",
+	Help: "Expiry of the serving certificates of Syndesis resources, as a unix timestamp.",
+}, []string{"namespace", "certificate"})
+
+func init() {
+	metrics.Registry.MustRegister(certificateExpiry)
+}
+
+// A certificate syndesis is served with
+type servingCertificate struct {
+	name     string    // What the certificate serves, e.g. route syndesis
+	notAfter time.Time // Expiry of the certificate
+	manager  string    // Name of the cert-manager certificate issuing it, if any
+}
+
+// Watches the expiry of the serving certificates of installed syndesis resources. Certificates about to expire
+// are reported by the Certificates condition, a warning event and a notification, and renewed when cert-manager
+// manages them and the resource asks for it.
+type certificatesAction struct {
+	baseAction
+}
+
+func newCertificatesAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &certificatesAction{
+		newBaseAction(mgr, api, "certificates"),
+	}
+}
+
+func (a *certificatesAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled)
+}
+
+func (a *certificatesAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+	threshold, err := config.CertificateExpiryThreshold()
+	if err != nil || threshold == 0 {
+		return err
+	}
+
+	certificates, err := a.servingCertificates(ctx, syndesis, servingSecrets(config))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, certificate := range certificates {
+		certificateExpiry.WithLabelValues(syndesis.Namespace, certificate.name).Set(float64(certificate.notAfter.Unix()))
+		if config.Syndesis.Certificates.Renew && certificate.manager != "" && certificate.notAfter.Sub(now) < threshold {
+			if err := a.renewCertificate(ctx, syndesis.Namespace, certificate.manager); err != nil {
+				a.log.Error(err, "Cannot renew certificate", "name", syndesis.Name, "certificate", certificate.manager)
+			}
+		}
+	}
+
+	target := syndesis.DeepCopy()
+	problems := expiringCertificates(certificates, threshold, now)
+	if len(problems) == 0 {
+		if setCondition(&target.Status, v1alpha1.SyndesisConditionCertificates, corev1.ConditionTrue, "Valid", "") {
+			return a.client.Update(ctx, target)
+		}
+		return nil
+	}
+
+	message := strings.Join(problems, "; ")
+	if !setCondition(&target.Status, v1alpha1.SyndesisConditionCertificates, corev1.ConditionFalse, "ExpiringSoon", message) {
+		return nil
+	}
+	a.log.Info("Serving certificates of Syndesis resource expire soon", "name", syndesis.Name, "problems", problems)
+	if err := a.client.Update(ctx, target); err != nil {
+		return err
+	}
+	a.mgr.GetRecorder("syndesis-operator").Event(syndesis, corev1.EventTypeWarning, "CertificateExpiring", message)
+	a.notify(ctx, syndesis, "Certificates expire soon", "The serving certificates of "+syndesis.Name+" need to be renewed: "+message)
+	return nil
+}
+
+// Secrets of the serving certificates: the one of the oauth proxy, and the internal ones with internal TLS
+func servingSecrets(config *configuration.Config) []string {
+	secrets := []string{oauthProxyTLSSecret}
+	if config.Syndesis.InternalTLS.Enabled {
+		secrets = append(secrets, internalTLSSecrets...)
+	}
+	return secrets
+}
+
+// The certificates of the secrets, of those that exist, and of the route, when it has its own
+func (a *certificatesAction) servingCertificates(ctx context.Context, syndesis *v1alpha1.Syndesis, secrets []string) ([]servingCertificate, error) {
+	certificates := []servingCertificate{}
+
+	for _, name := range secrets {
+		secret := &corev1.Secret{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: name}, secret); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+		} else if notAfter, err := certificateNotAfter(secret.Data[corev1.TLSCertKey]); err != nil {
+			a.log.Error(err, "Invalid serving certificate", "name", syndesis.Name, "secret", secret.Name)
+		} else {
+			certificates = append(certificates, servingCertificate{
+				name:     "secret " + secret.Name,
+				notAfter: notAfter,
+				manager:  secret.Annotations[certManagerCertificateAnnotation],
+			})
+		}
+	}
+
+	route := &routev1.Route{}
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: SyndesisRouteName}, route); err != nil {
+		if !k8serrors.IsNotFound(err) && !util.IsNoKindMatchError(err) {
+			return nil, err
+		}
+	} else if route.Spec.TLS != nil && route.Spec.TLS.Certificate != "" {
+		if notAfter, err := certificateNotAfter([]byte(route.Spec.TLS.Certificate)); err != nil {
+			a.log.Error(err, "Invalid route certificate", "name", syndesis.Name, "route", route.Name)
+		} else {
+			certificates = append(certificates, servingCertificate{name: "route " + route.Name, notAfter: notAfter})
+		}
+	}
+
+	return certificates, nil
+}
+
+// Asks cert-manager to issue a certificate again, the way cmctl renew does, unless it already is
+func (a *certificatesAction) renewCertificate(ctx context.Context, namespace string, name string) error {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetAPIVersion("cert-manager.io/v1")
+	certificate.SetKind("Certificate")
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, certificate); err != nil {
+		return err
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(certificate.Object, "status", "conditions")
+	for _, c := range conditions {
+		if condition, ok := c.(map[string]interface{}); ok && condition["type"] == "Issuing" && condition["status"] == "True" {
+			return nil
+		}
+	}
+	conditions = append(conditions, map[string]interface{}{
+		"type":               "Issuing",
+		"status":             "True",
+		"reason":             "ManuallyTriggered",
+		"message":            "Certificate re-issuance requested by the syndesis operator before its expiry",
+		"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err := unstructured.SetNestedSlice(certificate.Object, conditions, "status", "conditions"); err != nil {
+		return err
+	}
+
+	a.log.Info("Renewing certificate", "namespace", namespace, "certificate", name)
+	return a.client.Status().Update(ctx, certificate)
+}
+
+// Expiry of the first certificate of a PEM bundle, the serving one
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, errors.New("no PEM encoded certificate found")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return certificate.NotAfter, nil
+}
+
+// A description of every certificate expiring within the threshold, sorted
+func expiringCertificates(certificates []servingCertificate, threshold time.Duration, now time.Time) []string {
+	problems := []string{}
+	for _, certificate := range certificates {
+		expiry := certificate.notAfter.UTC().Format(time.RFC3339)
+		switch {
+		case !certificate.notAfter.After(now):
+			problems = append(problems, fmt.Sprintf("%s expired on %s", certificate.name, expiry))
+		case certificate.notAfter.Sub(now) < threshold:
+			problems = append(problems, fmt.Sprintf("%s expires on %s", certificate.name, expiry))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
@@ -0,0 +1,50 @@
+package action
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_certificateNotAfter(t *testing.T) {
+	notAfter := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "syndesis-oauthproxy.syndesis.svc"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	expiry, err := certificateNotAfter(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	require.NoError(t, err)
+	assert.True(t, notAfter.Equal(expiry))
+
+	_, err = certificateNotAfter([]byte("not a certificate"))
+	assert.EqualError(t, err, "no PEM encoded certificate found")
+}
+
+func Test_expiringCertificates(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	certificates := []servingCertificate{
+		{name: "secret syndesis-oauthproxy-tls", notAfter: now.AddDate(1, 0, 0)},
+		{name: "route syndesis", notAfter: now.AddDate(0, 0, 10)},
+	}
+
+	assert.Empty(t, expiringCertificates(certificates, 24*time.Hour, now))
+	assert.Equal(t, []string{"route syndesis expires on 2021-05-11T12:00:00Z"}, expiringCertificates(certificates, 720*time.Hour, now))
+
+	certificates[1].notAfter = now.Add(-time.Hour)
+	assert.Equal(t, []string{"route syndesis expired on 2021-05-01T11:00:00Z"}, expiringCertificates(certificates, 24*time.Hour, now))
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	"context"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/compatibility"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Checks the cluster against the compatibility matrix of the operator, an incompatible cluster
+// being recorded in the status of the syndesis resource
+func (a *baseAction) checkCompatibility(ctx context.Context, syndesis *v1alpha1.Syndesis, config *configuration.Config) (bool, error) {
+	cluster, err := compatibility.Discover(a.api.Discovery())
+	if err != nil {
+		return false, err
+	}
+	problems := compatibility.Check(cluster, config)
+	if len(problems) == 0 {
+		return true, nil
+	}
+
+	a.log.Info("Cluster cannot run the Syndesis resource", "name", syndesis.Name, "problems", problems)
+	return false, a.failPreflight(ctx, syndesis, v1alpha1.SyndesisStatusReasonUnsupportedCluster, "The cluster is not supported: "+strings.Join(problems, "; "))
+}
+
+// Records why syndesis cannot be installed or upgraded, the status being left alone when already up to date
+func (a *baseAction) failPreflight(ctx context.Context, syndesis *v1alpha1.Syndesis, reason v1alpha1.SyndesisStatusReason, description string) error {
+	target := syndesis.DeepCopy()
+	target.Status.Reason = reason
+	target.Status.Description = description
+	changed := setCondition(&target.Status, v1alpha1.SyndesisConditionPreflight, corev1.ConditionFalse, string(reason), description)
+	if !changed && syndesis.Status.Reason == reason && syndesis.Status.Description == description {
+		return nil
+	}
+	return a.client.Update(ctx, target)
+}
@@ -0,0 +1,44 @@
+package action
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/client/helpers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Records the outcome of an installation step. The transition time only changes
+// with the condition status, and true is returned when the condition changed,
+// so that the status is only written when there is something new to tell.
+// The given status is modified in place: the actions record conditions on the
+// status of a copy of the syndesis resource, the one they write back.
+func setCondition(status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType, conditionStatus corev1.ConditionStatus, reason string, message string) bool {
+	condition := v1alpha1.SyndesisCondition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	for i, existing := range status.Conditions {
+		if existing.Type != conditionType {
+			continue
+		}
+		if existing.Status == conditionStatus {
+			if existing.Reason == reason && existing.Message == message {
+				return false
+			}
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return true
+	}
+
+	status.Conditions = append(status.Conditions, condition)
+	return true
+}
+
+func getCondition(status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType) *v1alpha1.SyndesisCondition {
+	return helpers.GetCondition(status, conditionType)
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_setCondition(t *testing.T) {
+	status := v1alpha1.SyndesisStatus{}
+
+	assert.True(t, setCondition(&status, v1alpha1.SyndesisConditionDatabase, corev1.ConditionFalse, "ApplyFailed", "timeout"))
+	assert.Len(t, status.Conditions, 1)
+
+	transition := metav1.Unix(1000, 0)
+	status.Conditions[0].LastTransitionTime = transition
+
+	// Nothing new to tell
+	assert.False(t, setCondition(&status, v1alpha1.SyndesisConditionDatabase, corev1.ConditionFalse, "ApplyFailed", "timeout"))
+
+	// Same status with another message keeps the transition time
+	assert.True(t, setCondition(&status, v1alpha1.SyndesisConditionDatabase, corev1.ConditionFalse, "ApplyFailed", "forbidden"))
+	condition := getCondition(&status, v1alpha1.SyndesisConditionDatabase)
+	assert.Equal(t, "forbidden", condition.Message)
+	assert.Equal(t, transition, condition.LastTransitionTime)
+
+	// A new status is a transition
+	assert.True(t, setCondition(&status, v1alpha1.SyndesisConditionDatabase, corev1.ConditionTrue, "Applied", ""))
+	condition = getCondition(&status, v1alpha1.SyndesisConditionDatabase)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.NotEqual(t, transition, condition.LastTransitionTime)
+
+	assert.True(t, setCondition(&status, v1alpha1.SyndesisConditionReady, corev1.ConditionFalse, "Starting", ""))
+	assert.Len(t, status.Conditions, 2)
+	assert.Nil(t, getCondition(&status, v1alpha1.SyndesisConditionAddons))
+}
@@ -0,0 +1,117 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Creates the connections declared in the custom resource, once syndesis is up. Each connection is
+// only created once, later changes made from the console are left alone.
+type connectionsAction struct {
+	baseAction
+}
+
+func newConnectionsAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &connectionsAction{
+		newBaseAction(mgr, api, "connections"),
+	}
+}
+
+func (a *connectionsAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled) &&
+		syndesis.Spec.InstallMode != v1alpha1.SyndesisInstallModeInfrastructureOnly &&
+		!syndesis.Spec.Standby.Enabled &&
+		len(pendingConnections(syndesis)) > 0
+}
+
+func (a *connectionsAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	url := serverURL(syndesis)
+	token := a.mgr.GetConfig().BearerToken
+
+	target := syndesis.DeepCopy()
+	var result error
+	for _, connection := range pendingConnections(syndesis) {
+		secret := corev1.Secret{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: connection.Secret}, &secret); err != nil {
+			result = err
+			break
+		}
+		properties := map[string]string{}
+		for key, value := range secret.Data {
+			properties[key] = string(value)
+		}
+
+		if err := createConnection(ctx, url, token, connection, properties); err != nil {
+			result = err
+			break
+		}
+		target.Status.ProvisionedConnections = append(target.Status.ProvisionedConnections, connection.Name)
+		a.log.Info("Connection provisioned", "name", syndesis.Name, "connection", connection.Name, "connector", connection.Connector)
+	}
+
+	if len(target.Status.ProvisionedConnections) != len(syndesis.Status.ProvisionedConnections) {
+		if err := a.client.Update(ctx, target); err != nil {
+			return err
+		}
+	}
+	return result
+}
+
+// Connection to the broker provisioned by the broker addon
+var brokerConnection = v1alpha1.ConnectionConfiguration{
+	Name:      "Broker",
+	Connector: "activemq",
+	Secret:    "syndesis-broker",
+}
+
+func pendingConnections(syndesis *v1alpha1.Syndesis) []v1alpha1.ConnectionConfiguration {
+	provisioned := map[string]bool{}
+	for _, name := range syndesis.Status.ProvisionedConnections {
+		provisioned[name] = true
+	}
+
+	connections := append([]v1alpha1.ConnectionConfiguration{}, syndesis.Spec.Connections...)
+	if syndesis.Spec.Addons.Broker.Enabled {
+		connections = append(connections, brokerConnection)
+	}
+
+	pending := []v1alpha1.ConnectionConfiguration{}
+	for _, connection := range connections {
+		if !provisioned[connection.Name] {
+			pending = append(pending, connection)
+		}
+	}
+	return pending
+}
+
+// Creates the connection with the syndesis-server API, which encrypts the secret properties before storing them
+func createConnection(ctx context.Context, serverURL string, token string, connection v1alpha1.ConnectionConfiguration, properties map[string]string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"name":                 connection.Name,
+		"connectorId":          connection.Connector,
+		"configuredProperties": properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := callServer(ctx, http.MethodPost, serverURL+"/api/v1/connections", token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("cannot create connection %s, syndesis-server answered with status %s", connection.Name, res.Status)
+	}
+	return nil
+}
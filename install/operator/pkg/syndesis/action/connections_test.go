@@ -0,0 +1,61 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func Test_pendingConnections(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Connections: []v1alpha1.ConnectionConfiguration{
+				{Name: "broker", Connector: "activemq", Secret: "broker-credentials"},
+				{Name: "crm", Connector: "salesforce", Secret: "crm-credentials"},
+			},
+		},
+		Status: v1alpha1.SyndesisStatus{ProvisionedConnections: []string{"broker"}},
+	}
+
+	pending := pendingConnections(syndesis)
+
+	assert.Equal(t, []v1alpha1.ConnectionConfiguration{{Name: "crm", Connector: "salesforce", Secret: "crm-credentials"}}, pending)
+}
+
+func Test_createConnection(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/connections", r.URL.Path)
+		assert.Equal(t, operatorUser, r.Header.Get("X-Forwarded-User"))
+		assert.Equal(t, "token", r.Header.Get("X-Forwarded-Access-Token"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	connection := v1alpha1.ConnectionConfiguration{Name: "broker", Connector: "activemq", Secret: "broker-credentials"}
+	err := createConnection(context.TODO(), server.URL, "token", connection, map[string]string{"brokerUrl": "tcp://broker:61616"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":                 "broker",
+		"connectorId":          "activemq",
+		"configuredProperties": map[string]interface{}{"brokerUrl": "tcp://broker:61616"},
+	}, received)
+}
+
+func Test_pendingConnections_broker(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Broker: v1alpha1.AddonSpec{Enabled: true}},
+		},
+	}
+	assert.Equal(t, []v1alpha1.ConnectionConfiguration{brokerConnection}, pendingConnections(syndesis))
+
+	syndesis.Status.ProvisionedConnections = []string{brokerConnection.Name}
+	assert.Empty(t, pendingConnections(syndesis))
+}
@@ -0,0 +1,42 @@
+package action
+
+import (
+	"context"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Create or remove the ConsoleLink pointing to the syndesis route. ConsoleLinks are cluster scoped,
+// so they cannot be owned by the syndesis resource and are not garbage collected with it.
+// Clusters without the console.openshift.io API (OpenShift 3) are silently skipped.
+func (a *installAction) installConsoleLink(ctx context.Context, config *configuration.Config) error {
+	resources, err := generator.RenderDir("./consolelink/", config)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources {
+		if config.Syndesis.ConsoleLink.Disabled || config.RouteHostname == "" {
+			if err := a.client.Delete(ctx, &res); err != nil && !k8serrors.IsNotFound(err) && !util.IsNoKindMatchError(err) {
+				return err
+			}
+			continue
+		}
+
+		_, modificationType, err := util.CreateOrUpdate(ctx, a.client, &res)
+		if err != nil {
+			if util.IsNoKindMatchError(err) {
+				continue
+			}
+			return err
+		}
+		if modificationType != controllerutil.OperationResultNone {
+			a.log.Info("resource "+string(modificationType), "kind", res.GetKind(), "name", res.GetName())
+		}
+	}
+	return nil
+}
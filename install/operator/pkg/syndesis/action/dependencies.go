@@ -0,0 +1,61 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Time given to a dependency to accept a connection
+const dependencyDialTimeout = 5 * time.Second
+
+// Opens and closes a connection to a dependency, replaced by the tests
+var dialDependency = func(address string) error {
+	conn, err := net.DialTimeout("tcp", address, dependencyDialTimeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Probes the external dependencies of a syndesis resource that opted in, from the operator pod, and records
+// the outcome with the Dependencies condition. Unreachable dependencies are only reported, so that a
+// misconfiguration shows up right away instead of when the first integration gets built or deployed.
+func (a *startupAction) checkDependencies(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	if !syndesis.Spec.ConnectivityChecks {
+		return nil
+	}
+
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+
+	dependencies := config.ExternalDependencies()
+	if problems := unreachableDependencies(dependencies); len(problems) > 0 {
+		a.log.Info("Syndesis dependencies are unreachable", "name", syndesis.Name, "problems", problems)
+		setCondition(&syndesis.Status, v1alpha1.SyndesisConditionDependencies, corev1.ConditionFalse, "Unreachable", strings.Join(problems, "; "))
+	} else {
+		setCondition(&syndesis.Status, v1alpha1.SyndesisConditionDependencies, corev1.ConditionTrue, "Reachable", fmt.Sprintf("%d dependencies reachable", len(dependencies)))
+	}
+	return nil
+}
+
+// A description of every dependency that doesn't accept connections, sorted
+func unreachableDependencies(dependencies map[string]string) []string {
+	problems := []string{}
+	for name, address := range dependencies {
+		if err := dialDependency(address); err != nil {
+			problems = append(problems, fmt.Sprintf("%s at %s is unreachable: %v", name, address, err))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
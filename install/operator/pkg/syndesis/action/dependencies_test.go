@@ -0,0 +1,27 @@
+package action
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_unreachableDependencies(t *testing.T) {
+	dial := dialDependency
+	defer func() { dialDependency = dial }()
+	dialDependency = func(address string) error {
+		if address == "db.example.com:5432" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	assert.Empty(t, unreachableDependencies(map[string]string{"maven repository central": "repo.maven.apache.org:443"}))
+	assert.Equal(t,
+		[]string{"external database at db.example.com:5432 is unreachable: connection refused"},
+		unreachableDependencies(map[string]string{
+			"maven repository central": "repo.maven.apache.org:443",
+			"external database":        "db.example.com:5432",
+		}))
+}
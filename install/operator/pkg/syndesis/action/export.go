@@ -0,0 +1,103 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// Keeps the syndesis resource until its integrations have been exported
+	ExportFinalizer = "syndesis.io/export-integrations"
+
+	// Largest export a ConfigMap can hold
+	maxExportSize = 1000 * 1000
+)
+
+// Exports the integrations of a syndesis resource being deleted, then lets the deletion proceed.
+type exportAction struct {
+	baseAction
+}
+
+func newExportAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &exportAction{
+		newBaseAction(mgr, api, "export"),
+	}
+}
+
+func (a *exportAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesis.GetDeletionTimestamp() != nil && hasFinalizer(syndesis, ExportFinalizer)
+}
+
+// A failed export is notified but doesn't block the deletion, a broken installation must remain removable
+func (a *exportAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	if name, err := a.saveExport(ctx, syndesis, "uninstall"); err != nil {
+		a.log.Error(err, "Cannot export the integrations before removal", "name", syndesis.Name)
+		a.notify(ctx, syndesis, "Integrations export failed", "The integrations of "+syndesis.Name+" could not be exported before its removal: "+err.Error())
+	} else {
+		a.log.Info("Integrations exported before removal", "name", syndesis.Name, "configmap", name)
+	}
+
+	target := syndesis.DeepCopy()
+	removeFinalizer(target, ExportFinalizer)
+	return a.client.Update(ctx, target)
+}
+
+// Stores the export of all integrations, with their connections, extensions and icons, in a ConfigMap.
+// The ConfigMap is neither owned by the syndesis resource nor labelled as part of the installation,
+// so that it outlives it.
+func (a *baseAction) saveExport(ctx context.Context, syndesis *v1alpha1.Syndesis, reason string) (string, error) {
+	data, err := exportIntegrations(ctx, serverURL(syndesis), a.mgr.GetConfig().BearerToken)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	export := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("syndesis-export-%s-%d", reason, now.Unix()),
+			Namespace: syndesis.Namespace,
+			Labels: map[string]string{
+				"syndesis.io/export": reason,
+			},
+			Annotations: map[string]string{
+				"syndesis.io/exported-at": now.UTC().Format(time.RFC3339),
+				"syndesis.io/version":     syndesis.Status.Version,
+			},
+		},
+		BinaryData: map[string][]byte{
+			"export.zip": data,
+		},
+	}
+	return export.Name, a.client.Create(ctx, export)
+}
+
+func exportIntegrations(ctx context.Context, serverURL string, token string) ([]byte, error) {
+	res, err := callServer(ctx, http.MethodGet, serverURL+"/api/v1/integration-support/export.zip?id=all", token, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return nil, fmt.Errorf("cannot export the integrations, syndesis-server answered with status %s", res.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxExportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxExportSize {
+		return nil, fmt.Errorf("the export of the integrations exceeds %d bytes and cannot be stored", maxExportSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,44 @@
+package action
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func Test_exportIntegrations(t *testing.T) {
+	size := 16
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/integration-support/export.zip", r.URL.Path)
+		assert.Equal(t, "all", r.URL.Query().Get("id"))
+		w.Write([]byte(strings.Repeat("x", size)))
+	}))
+	defer server.Close()
+
+	data, err := exportIntegrations(context.TODO(), server.URL, "token")
+	assert.NoError(t, err)
+	assert.Len(t, data, 16)
+
+	size = maxExportSize + 1
+	_, err = exportIntegrations(context.TODO(), server.URL, "token")
+	assert.Error(t, err)
+}
+
+func Test_ensureFinalizer(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.SetFinalizers([]string{"other"})
+
+	assert.True(t, ensureFinalizer(syndesis, ExportFinalizer))
+	assert.False(t, ensureFinalizer(syndesis, ExportFinalizer))
+	assert.Equal(t, []string{"other", ExportFinalizer}, syndesis.GetFinalizers())
+	assert.True(t, hasFinalizer(syndesis, ExportFinalizer))
+
+	removeFinalizer(syndesis, ExportFinalizer)
+	assert.Equal(t, []string{"other"}, syndesis.GetFinalizers())
+	assert.False(t, hasFinalizer(syndesis, ExportFinalizer))
+}
@@ -0,0 +1,65 @@
+package action
+
+import (
+	"context"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Keeps a copy of the generated passwords and keys of the global configuration secret.
+// Should the secret be deleted, the configuration is loaded from the copy and the secret
+// is recreated with the values the database and the stored credentials were set up with,
+// instead of newly generated ones.
+func backupGlobalConfig(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, resources []unstructured.Unstructured) (types.UID, error) {
+	for _, res := range resources {
+		if res.GetKind() != "Secret" || res.GetName() != configuration.SyndesisGlobalConfigSecret {
+			continue
+		}
+
+		params, _, err := unstructured.NestedString(res.Object, "stringData", configuration.SyndesisGlobalConfigParamsProperty)
+		if err != nil {
+			return "", err
+		}
+
+		backup := newGlobalConfigBackup(params)
+		operation.SetNamespaceAndOwnerReference(backup, syndesis)
+		o, _, err := util.CreateOrUpdate(ctx, cl, backup)
+		if err != nil {
+			return "", err
+		}
+		return o.GetUID(), nil
+	}
+	return "", nil
+}
+
+func newGlobalConfigBackup(params string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: configuration.SyndesisGlobalConfigBackupSecret,
+			Labels: map[string]string{
+				"app":                   "syndesis",
+				"syndesis.io/app":       "syndesis",
+				"syndesis.io/type":      "infrastructure",
+				"syndesis.io/component": "syndesis-global-config",
+			},
+			Annotations: map[string]string{
+				"syndesis.io/backup-of": configuration.SyndesisGlobalConfigSecret,
+			},
+		},
+		Data: map[string][]byte{
+			configuration.SyndesisGlobalConfigParamsProperty: []byte(params),
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_backupGlobalConfig(t *testing.T) {
+	global := resourceOfKind("Secret", configuration.SyndesisGlobalConfigSecret)
+	assert.NoError(t, unstructured.SetNestedField(global.Object, "POSTGRESQL_PASSWORD=db-password", "stringData", "params"))
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "uid"}}
+
+	cl := fake.NewFakeClient()
+	_, err := backupGlobalConfig(context.TODO(), cl, syndesis, []unstructured.Unstructured{resourceOfKind("ConfigMap", "syndesis-server-config"), global})
+	assert.NoError(t, err)
+
+	backup := corev1.Secret{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: configuration.SyndesisGlobalConfigBackupSecret, Namespace: "syndesis"}, &backup))
+	assert.Equal(t, "POSTGRESQL_PASSWORD=db-password", string(backup.Data["params"]))
+	assert.Equal(t, types.UID("uid"), backup.OwnerReferences[0].UID)
+}
@@ -0,0 +1,223 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/registry"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Checks the registries for new releases of the component images on the schedule of the image policy. The
+// tracking policies resolve the releases they follow to their digests, which the components are then rendered
+// with; the newer releases they don't follow are reported in the status, like all of them with the pinned policy.
+type imagePolicyAction struct {
+	baseAction
+}
+
+func newImagePolicyAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &imagePolicyAction{
+		newBaseAction(mgr, api, "image-policy"),
+	}
+}
+
+func (a *imagePolicyAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled)
+}
+
+func (a *imagePolicyAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	// The images as configured, not the ones resolved at the last check
+	configured := syndesis.DeepCopy()
+	configured.Status.Images = nil
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, configured)
+	if err != nil {
+		return err
+	}
+
+	interval, err := config.ImageCheckInterval()
+	if err != nil {
+		return err
+	}
+	policy := v1alpha1.ImageUpdatePolicy(config.Syndesis.ImagePolicy.Update)
+	images := config.TrackedImages()
+	var status *v1alpha1.ImagePolicyStatus
+	switch current := syndesis.Status.Images; {
+	case interval == 0:
+		// Nothing is checked, so nothing is left to report
+	case current != nil && current.LastCheck != nil && current.Policy == policy && sameImages(current.Checked, images) &&
+		time.Since(current.LastCheck.Time) < interval:
+		return nil
+	default:
+		client := registry.NewClient(a.registryCredentials(syndesis))
+		status = checkImages(ctx, client, policy, images, current, time.Now())
+	}
+
+	if reflect.DeepEqual(status, syndesis.Status.Images) {
+		return nil
+	}
+	a.reportChanges(ctx, syndesis, syndesis.Status.Images, status)
+	target := syndesis.DeepCopy()
+	target.Status.Images = status
+	return a.client.Update(ctx, target)
+}
+
+// The credentials of the registries in the pull secret, none when there is no pull secret or it cannot be read
+func (a *imagePolicyAction) registryCredentials(syndesis *v1alpha1.Syndesis) map[string]registry.Credentials {
+	secret, err := a.api.CoreV1().Secrets(syndesis.Namespace).Get(SyndesisPullSecret, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			a.log.Error(err, "Unable to read the pull secret, the registries are checked anonymously", "name", syndesis.Name)
+		}
+		return nil
+	}
+
+	for _, key := range []string{corev1.DockerConfigJsonKey, corev1.DockerConfigKey} {
+		if data, ok := secret.Data[key]; ok {
+			credentials, err := registry.ParseDockerConfig(data)
+			if err != nil {
+				a.log.Error(err, "Unable to read the pull secret, the registries are checked anonymously", "name", syndesis.Name)
+				return nil
+			}
+			return credentials
+		}
+	}
+	return nil
+}
+
+// Logs the images the components switch to, and notifies about newly available updates
+func (a *imagePolicyAction) reportChanges(ctx context.Context, syndesis *v1alpha1.Syndesis, previous *v1alpha1.ImagePolicyStatus, status *v1alpha1.ImagePolicyStatus) {
+	if status == nil {
+		return
+	}
+	old := &v1alpha1.ImagePolicyStatus{}
+	if previous != nil {
+		old = previous
+	}
+
+	for image, resolved := range status.Resolved {
+		if old.Resolved[image] != resolved {
+			a.log.Info("Following a new release of an image", "name", syndesis.Name, "image", image, "resolved", resolved)
+		}
+	}
+
+	announced := map[v1alpha1.ImageUpdate]bool{}
+	for _, update := range old.Available {
+		announced[update] = true
+	}
+	updates := []string{}
+	for _, update := range status.Available {
+		if !announced[update] {
+			updates = append(updates, fmt.Sprintf("%s can be updated to %s", update.Image, update.Tag))
+		}
+	}
+	if len(updates) > 0 {
+		a.log.Info("Image updates available", "name", syndesis.Name, "updates", updates)
+		a.notify(ctx, syndesis, "Image updates available", fmt.Sprintf("New releases of the images of %s are available "+
+			"that its %s image policy doesn't follow: %s", syndesis.Name, status.Policy, strings.Join(updates, "; ")))
+	}
+}
+
+// What checkImages reads from the registries
+type imageRegistry interface {
+	Tags(ctx context.Context, ref registry.Reference) ([]string, error)
+	Digest(ctx context.Context, ref registry.Reference, tag string) (string, error)
+}
+
+// Checks the registries for new releases of the images. The tracking policies resolve the releases they follow,
+// an image that cannot be checked keeps the release resolved at the previous check if any.
+func checkImages(ctx context.Context, client imageRegistry, policy v1alpha1.ImageUpdatePolicy, images []string, previous *v1alpha1.ImagePolicyStatus, now time.Time) *v1alpha1.ImagePolicyStatus {
+	checkedAt := metav1.NewTime(now)
+	status := &v1alpha1.ImagePolicyStatus{
+		LastCheck: &checkedAt,
+		Policy:    policy,
+		Checked:   append([]string{}, images...),
+	}
+	sort.Strings(status.Checked)
+	tracking := policy == v1alpha1.ImageUpdatePolicyTrackMinor || policy == v1alpha1.ImageUpdatePolicyTrackLatest
+
+	for _, image := range status.Checked {
+		resolved, update, err := checkImage(ctx, client, policy, image)
+		if err != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("%s: %v", image, err))
+			if previous != nil && previous.Resolved[image] != "" && tracking {
+				resolved = previous.Resolved[image]
+			}
+		}
+		if resolved != "" {
+			if status.Resolved == nil {
+				status.Resolved = map[string]string{}
+			}
+			status.Resolved[image] = resolved
+		}
+		if update != nil {
+			status.Available = append(status.Available, *update)
+		}
+	}
+	return status
+}
+
+// The release of an image the policy follows pinned to its digest, empty with the pinned policy or for images
+// already pinned to a digest, and the newest release it doesn't follow if any
+func checkImage(ctx context.Context, client imageRegistry, policy v1alpha1.ImageUpdatePolicy, image string) (string, *v1alpha1.ImageUpdate, error) {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return "", nil, err
+	}
+	tags, err := client.Tags(ctx, ref)
+	if err != nil {
+		return "", nil, err
+	}
+
+	releases := registry.NewerReleases(ref.Tag, tags)
+	followed := ref.Tag
+	switch {
+	case policy == v1alpha1.ImageUpdatePolicyTrackMinor && releases.Patch != "":
+		followed = releases.Patch
+	case policy == v1alpha1.ImageUpdatePolicyTrackLatest && releases.Minor != "":
+		followed = releases.Minor
+	}
+
+	var update *v1alpha1.ImageUpdate
+	for _, newest := range []string{releases.Major, releases.Minor, releases.Patch} {
+		if newest != "" {
+			if newest != followed {
+				update = &v1alpha1.ImageUpdate{Image: image, Tag: newest}
+			}
+			break
+		}
+	}
+
+	if policy == v1alpha1.ImageUpdatePolicyPinned || strings.Contains(image, "@") {
+		return "", update, nil
+	}
+	digest, err := client.Digest(ctx, ref, followed)
+	if err != nil {
+		return "", update, err
+	}
+	return ref.Pinned(followed, digest), update, nil
+}
+
+// Whether the images checked are the ones configured
+func sameImages(checked []string, images []string) bool {
+	sorted := append([]string{}, images...)
+	sort.Strings(sorted)
+	if len(checked) != len(sorted) {
+		return false
+	}
+	for i := range sorted {
+		if checked[i] != sorted[i] {
+			return false
+		}
+	}
+	return true
+}
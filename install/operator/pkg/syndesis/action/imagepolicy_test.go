@@ -0,0 +1,93 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/registry"
+)
+
+// Registry serving the tags of the repositories, every tag pointing to the digest sha256:<tag>
+type fakeRegistry map[string][]string
+
+func (r fakeRegistry) Tags(ctx context.Context, ref registry.Reference) ([]string, error) {
+	tags, ok := r[ref.Repository]
+	if !ok {
+		return nil, errors.New("registry unreachable")
+	}
+	return tags, nil
+}
+
+func (r fakeRegistry) Digest(ctx context.Context, ref registry.Reference, tag string) (string, error) {
+	return "sha256:" + tag, nil
+}
+
+func Test_checkImage(t *testing.T) {
+	releases := fakeRegistry{
+		"syndesis/syndesis-server": {"latest", "1.9.0", "1.9.1", "1.9.3", "1.10.0", "2.0.0"},
+		"syndesis/syndesis-meta":   {"latest", "1.9.0"},
+	}
+	tests := []struct {
+		name     string
+		policy   v1alpha1.ImageUpdatePolicy
+		image    string
+		resolved string
+		update   *v1alpha1.ImageUpdate
+	}{
+		{"pinned only reports", v1alpha1.ImageUpdatePolicyPinned, "docker.io/syndesis/syndesis-server:1.9.0",
+			"", &v1alpha1.ImageUpdate{Image: "docker.io/syndesis/syndesis-server:1.9.0", Tag: "2.0.0"}},
+		{"track-minor follows patches", v1alpha1.ImageUpdatePolicyTrackMinor, "docker.io/syndesis/syndesis-server:1.9.0",
+			"docker.io/syndesis/syndesis-server:1.9.3@sha256:1.9.3", &v1alpha1.ImageUpdate{Image: "docker.io/syndesis/syndesis-server:1.9.0", Tag: "2.0.0"}},
+		{"track-latest follows minor versions", v1alpha1.ImageUpdatePolicyTrackLatest, "docker.io/syndesis/syndesis-server:1.9.0",
+			"docker.io/syndesis/syndesis-server:1.10.0@sha256:1.10.0", &v1alpha1.ImageUpdate{Image: "docker.io/syndesis/syndesis-server:1.9.0", Tag: "2.0.0"}},
+		{"newest release", v1alpha1.ImageUpdatePolicyTrackLatest, "docker.io/syndesis/syndesis-server:2.0.0",
+			"docker.io/syndesis/syndesis-server:2.0.0@sha256:2.0.0", nil},
+		{"moving tags are resolved again", v1alpha1.ImageUpdatePolicyTrackMinor, "docker.io/syndesis/syndesis-meta:latest",
+			"docker.io/syndesis/syndesis-meta:latest@sha256:latest", nil},
+		{"digests are kept", v1alpha1.ImageUpdatePolicyTrackMinor, "docker.io/syndesis/syndesis-meta:1.9.0@sha256:0123",
+			"", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved, update, err := checkImage(context.TODO(), releases, test.policy, test.image)
+			assert.NoError(t, err)
+			assert.Equal(t, test.resolved, resolved)
+			assert.Equal(t, test.update, update)
+		})
+	}
+}
+
+func Test_checkImages(t *testing.T) {
+	releases := fakeRegistry{
+		"syndesis/syndesis-server": {"1.9.0", "1.9.1"},
+	}
+	images := []string{"docker.io/syndesis/syndesis-ui:1.9.0", "docker.io/syndesis/syndesis-server:1.9.0"}
+	previous := &v1alpha1.ImagePolicyStatus{Resolved: map[string]string{
+		"docker.io/syndesis/syndesis-ui:1.9.0": "docker.io/syndesis/syndesis-ui:1.9.0@sha256:0123",
+	}}
+	now := time.Now()
+
+	status := checkImages(context.TODO(), releases, v1alpha1.ImageUpdatePolicyTrackMinor, images, previous, now)
+	assert.Equal(t, now.Unix(), status.LastCheck.Unix())
+	assert.Equal(t, v1alpha1.ImageUpdatePolicyTrackMinor, status.Policy)
+	assert.Equal(t, []string{"docker.io/syndesis/syndesis-server:1.9.0", "docker.io/syndesis/syndesis-ui:1.9.0"}, status.Checked)
+	// Images that cannot be checked keep the release resolved before
+	assert.Equal(t, map[string]string{
+		"docker.io/syndesis/syndesis-server:1.9.0": "docker.io/syndesis/syndesis-server:1.9.1@sha256:1.9.1",
+		"docker.io/syndesis/syndesis-ui:1.9.0":     "docker.io/syndesis/syndesis-ui:1.9.0@sha256:0123",
+	}, status.Resolved)
+	assert.Equal(t, []string{"docker.io/syndesis/syndesis-ui:1.9.0: registry unreachable"}, status.Errors)
+	assert.Empty(t, status.Available)
+
+	status = checkImages(context.TODO(), releases, v1alpha1.ImageUpdatePolicyPinned, images, previous, now)
+	assert.Empty(t, status.Resolved)
+	assert.Equal(t, []v1alpha1.ImageUpdate{{Image: "docker.io/syndesis/syndesis-server:1.9.0", Tag: "1.9.1"}}, status.Available)
+
+	assert.True(t, sameImages(status.Checked, images))
+	assert.False(t, sameImages(status.Checked, images[:1]))
+	assert.True(t, sameImages(nil, []string{}))
+}
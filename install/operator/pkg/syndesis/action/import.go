@@ -0,0 +1,171 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Key of the export in the ConfigMaps and Secrets when the import doesn't name one
+const defaultExportKey = "export.zip"
+
+// Imports the integration exports declared in the custom resource, once syndesis is up. Each export is
+// only imported once, the integrations are then managed from the console like any other.
+type importAction struct {
+	baseAction
+}
+
+func newImportAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &importAction{
+		newBaseAction(mgr, api, "import"),
+	}
+}
+
+func (a *importAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled) &&
+		syndesis.Spec.InstallMode != v1alpha1.SyndesisInstallModeInfrastructureOnly &&
+		!syndesis.Spec.Standby.Enabled &&
+		len(pendingImports(syndesis)) > 0
+}
+
+func (a *importAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	url := serverURL(syndesis)
+	token := a.mgr.GetConfig().BearerToken
+
+	target := syndesis.DeepCopy()
+	var result error
+	for _, integrations := range pendingImports(syndesis) {
+		data, err := a.loadExport(ctx, syndesis.Namespace, integrations)
+		if err != nil {
+			result = err
+			break
+		}
+
+		ids, err := importIntegrations(ctx, url, token, data)
+		if err != nil {
+			result = fmt.Errorf("cannot import %s: %v", integrations.Name, err)
+			break
+		}
+		if integrations.Publish {
+			for _, id := range ids {
+				if err := publishIntegration(ctx, url, token, id); err != nil {
+					result = err
+					break
+				}
+			}
+			if result != nil {
+				break
+			}
+		}
+		target.Status.ImportedIntegrations = append(target.Status.ImportedIntegrations, integrations.Name)
+		a.log.Info("Integrations imported", "name", syndesis.Name, "import", integrations.Name, "integrations", len(ids), "published", integrations.Publish)
+	}
+
+	if len(target.Status.ImportedIntegrations) != len(syndesis.Status.ImportedIntegrations) {
+		if err := a.client.Update(ctx, target); err != nil {
+			return err
+		}
+	}
+	return result
+}
+
+func pendingImports(syndesis *v1alpha1.Syndesis) []v1alpha1.IntegrationImportConfiguration {
+	imported := map[string]bool{}
+	for _, name := range syndesis.Status.ImportedIntegrations {
+		imported[name] = true
+	}
+
+	pending := []v1alpha1.IntegrationImportConfiguration{}
+	for _, integrations := range syndesis.Spec.Integrations.Import {
+		if !imported[integrations.Name] {
+			pending = append(pending, integrations)
+		}
+	}
+	return pending
+}
+
+// Reads the export from the ConfigMap, binary or text data, or from the Secret of the import
+func (a *importAction) loadExport(ctx context.Context, namespace string, integrations v1alpha1.IntegrationImportConfiguration) ([]byte, error) {
+	key := integrations.Key
+	if key == "" {
+		key = defaultExportKey
+	}
+
+	var data []byte
+	switch {
+	case integrations.ConfigMap != "" && integrations.Secret != "":
+		return nil, fmt.Errorf("integration import %s names both a ConfigMap and a Secret", integrations.Name)
+	case integrations.ConfigMap != "":
+		cm := corev1.ConfigMap{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: integrations.ConfigMap}, &cm); err != nil {
+			return nil, err
+		}
+		data = cm.BinaryData[key]
+		if data == nil {
+			if value, ok := cm.Data[key]; ok {
+				data = []byte(value)
+			}
+		}
+	case integrations.Secret != "":
+		secret := corev1.Secret{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: integrations.Secret}, &secret); err != nil {
+			return nil, err
+		}
+		data = secret.Data[key]
+	default:
+		return nil, fmt.Errorf("integration import %s names neither a ConfigMap nor a Secret", integrations.Name)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("integration import %s has no export under the key %s", integrations.Name, key)
+	}
+	return data, nil
+}
+
+// Imports an export with the syndesis-server API, returning the ids of the imported integrations
+func importIntegrations(ctx context.Context, serverURL string, token string, data []byte) ([]string, error) {
+	res, err := callServerWithContent(ctx, http.MethodPost, serverURL+"/api/v1/integration-support/import", token, "application/octet-stream", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return nil, fmt.Errorf("syndesis-server answered with status %s", res.Status)
+	}
+
+	// The imported models by kind
+	imported := map[string][]struct {
+		ID string `json:"id"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&imported); err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, integration := range imported["integration"] {
+		ids = append(ids, integration.ID)
+	}
+	return ids, nil
+}
+
+func publishIntegration(ctx context.Context, serverURL string, token string, id string) error {
+	res, err := callServer(ctx, http.MethodPut, serverURL+"/api/v1/integrations/"+url.PathEscape(id)+"/deployments", token, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("cannot publish integration %s, syndesis-server answered with status %s", id, res.Status)
+	}
+	return nil
+}
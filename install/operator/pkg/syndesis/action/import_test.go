@@ -0,0 +1,85 @@
+package action
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_pendingImports(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Integrations: v1alpha1.IntegrationsConfiguration{Import: []v1alpha1.IntegrationImportConfiguration{
+				{Name: "orders", ConfigMap: "orders-export"},
+				{Name: "billing", Secret: "billing-export", Publish: true},
+			}},
+		},
+		Status: v1alpha1.SyndesisStatus{ImportedIntegrations: []string{"orders"}},
+	}
+
+	assert.Equal(t, []v1alpha1.IntegrationImportConfiguration{{Name: "billing", Secret: "billing-export", Publish: true}}, pendingImports(syndesis))
+}
+
+func Test_loadExport(t *testing.T) {
+	cl := fake.NewFakeClient(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders-export", Namespace: "syndesis"},
+			BinaryData: map[string][]byte{"export.zip": []byte("zip")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "billing-export", Namespace: "syndesis"},
+			Data:       map[string][]byte{"billing.zip": []byte("secret zip")},
+		},
+	)
+	a := &importAction{baseAction{log: actionLog, client: cl}}
+
+	data, err := a.loadExport(context.TODO(), "syndesis", v1alpha1.IntegrationImportConfiguration{Name: "orders", ConfigMap: "orders-export"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("zip"), data)
+
+	data, err = a.loadExport(context.TODO(), "syndesis", v1alpha1.IntegrationImportConfiguration{Name: "billing", Secret: "billing-export", Key: "billing.zip"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret zip"), data)
+
+	_, err = a.loadExport(context.TODO(), "syndesis", v1alpha1.IntegrationImportConfiguration{Name: "billing", Secret: "billing-export"})
+	assert.EqualError(t, err, "integration import billing has no export under the key export.zip")
+
+	_, err = a.loadExport(context.TODO(), "syndesis", v1alpha1.IntegrationImportConfiguration{Name: "orders"})
+	assert.EqualError(t, err, "integration import orders names neither a ConfigMap nor a Secret")
+}
+
+func Test_importIntegrations(t *testing.T) {
+	published := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/integration-support/import":
+			assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, "zip", string(body))
+			w.Write([]byte(`{"integration": [{"id": "i-1"}, {"id": "i-2"}], "connection": [{"id": "c-1"}]}`))
+		case "/api/v1/integrations/i-1/deployments":
+			assert.Equal(t, http.MethodPut, r.Method)
+			published = append(published, "i-1")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ids, err := importIntegrations(context.TODO(), server.URL, "token", []byte("zip"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"i-1", "i-2"}, ids)
+
+	assert.NoError(t, publishIntegration(context.TODO(), server.URL, "token", "i-1"))
+	assert.Equal(t, []string{"i-1"}, published)
+	assert.Error(t, publishIntegration(context.TODO(), server.URL, "token", "i-3"))
+}
@@ -3,10 +3,15 @@ package action
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
-	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,6 +37,15 @@ import (
 const (
 	SyndesisRouteName  = "syndesis"
 	SyndesisPullSecret = "syndesis-pull-secret"
+
+	// Setting this annotation to a new value rotates the oauth cookie secret
+	RotateCookieSecretAnnotation = "syndesis.io/rotate-cookie-secret"
+
+	// Setting this annotation to a new value rotates the keys protecting the client side state
+	RotateClientStateKeysAnnotation = "syndesis.io/rotate-client-state-keys"
+
+	// Setting this annotation to a new value, e.g. a timestamp, looks up, renders and applies everything again
+	ForceReconcileAnnotation = "syndesis.io/force-reconcile"
 )
 
 // Install syndesis into the namespace, taking resources from the bundled template.
@@ -62,105 +76,149 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 	}
 	resourcesThatShouldExist := map[types.UID]bool{}
 
+	// Forget what was looked up on the cluster and rendered so far when a resync is forced, e.g. after manual changes
+	forceReconcile := syndesis.Annotations[ForceReconcileAnnotation]
+	forced := forceReconcile != "" && forceReconcile != syndesis.Status.ForcedReconcile
+	if forced {
+		a.log.Info("Forced reconcile of Syndesis resource", "name", syndesis.Name, "annotation", forceReconcile)
+		configuration.InvalidateLookups()
+		generator.ClearRenderCache()
+	}
+
 	// Load configuration to to use as context for generate pkg
 	configuration, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
 	if err != nil {
 		return err
 	}
 
-	// Check if an image secret exists, to be used to connect to registries that require authentication
-	secret := &corev1.Secret{}
-	err = a.client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: SyndesisPullSecret}, secret)
+	// Rotate the oauth cookie secret when requested, or drop the previous one once its grace period is over
+	if err := configuration.RotateCookieSecret(syndesis.Annotations[RotateCookieSecretAnnotation], time.Now()); err != nil {
+		return err
+	}
+
+	// Same for the client state keys, whose previous keys are still accepted during the grace period
+	if err := configuration.RotateClientStateKeys(syndesis.Annotations[RotateClientStateKeysAnnotation], time.Now()); err != nil {
+		return err
+	}
+
+	// Check if an image secret exists, to be used to connect to registries that require authentication.
+	// Only its name is needed, so its content is not fetched
+	var secret *corev1.Secret
+	metadata, err := util.GetObjectMetadata(a.api, "secrets", syndesis.Namespace, SyndesisPullSecret)
 	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			secret = nil
-		} else {
+		if !k8serrors.IsNotFound(err) {
 			return err
 		}
+	} else {
+		secret = &corev1.Secret{ObjectMeta: metadata.ObjectMeta}
 	}
 
 	if secret != nil {
 		configuration.ImagePullSecrets = append(configuration.ImagePullSecrets, secret.Name)
 	}
 
-	serviceAccount, err := installServiceAccount(ctx, a.client, syndesis, secret)
+	serviceAccount, err := installServiceAccount(ctx, a.client, syndesis, configuration, secret)
 	if err != nil {
 		return err
 	}
 	resourcesThatShouldExist[serviceAccount.GetUID()] = true
 
-	token, err := serviceaccount.GetServiceAccountToken(ctx, a.client, serviceAccount.Name, syndesis.Namespace)
-	if err != nil {
+	if err := configuration.ExternalDatabase(ctx, a.client, syndesis); err != nil {
 		return err
 	}
-	configuration.OpenShiftOauthClientSecret = token
 
-	if err := configuration.ExternalDatabase(ctx, a.client, syndesis); err != nil {
+	if err := configuration.SetClusterNetwork(ctx, a.client); err != nil {
 		return err
 	}
 
-	// Render the route resource...
-	all, err := generator.RenderDir("./route/", configuration)
-	if err != nil {
+	if err := configuration.SetBrokerOperator(ctx, a.client, syndesis.Namespace); err != nil {
 		return err
 	}
 
-	routes, _ := util.SeperateStructuredAndUnstructured(a.scheme, all)
-	syndesisRoute, err := installSyndesisRoute(ctx, a.client, syndesis, routes)
-	if err != nil {
+	if err := configuration.SetCamelKPlatformReady(ctx, a.client, syndesis.Namespace); err != nil {
 		return err
 	}
+
+	if err := configuration.SetStartupProbes(a.api.Discovery()); err != nil {
+		return err
+	}
+
+	applicationUrl := ""
+	if configuration.ExposedWithRoute() {
+		// Render the route resource...
+		all, err := generator.RenderDir("./route/", configuration)
+		if err != nil {
+			return err
+		}
+
+		routes, _ := util.SeperateStructuredAndUnstructured(a.scheme, all)
+		syndesisRoute, err := installSyndesisRoute(ctx, a.client, syndesis, routes, configuration.ClusterIngressDomain)
+		if err != nil {
+			return err
+		}
+		resourcesThatShouldExist[syndesisRoute.GetUID()] = true
+		applicationUrl = extractApplicationUrl(syndesisRoute)
+	}
+
 	if err := configuration.SetRoute(ctx, a.client, syndesis); err != nil {
 		return err
 	}
+	if applicationUrl == "" {
+		applicationUrl = "https://" + configuration.RouteHostname
+	}
 
-	resourcesThatShouldExist[syndesisRoute.GetUID()] = true
+	oauthFinalizerChanged, err := a.installOAuthClient(ctx, syndesis, configuration, serviceAccount.Name)
+	if err != nil {
+		return err
+	}
 
-	// Render the remaining syndesis resources...
-	all, err = generator.RenderDir("./infrastructure/", configuration)
+	// Render the resources installed by each step...
+	phases, err := renderInstallPhases(configuration)
 	if err != nil {
 		return err
 	}
+	all := []unstructured.Unstructured{}
+	for _, phase := range phases {
+		all = append(all, phase.resources...)
+	}
 
-	// Render the database resource if needed...
-	if syndesis.Spec.Components.Database.ExternalDbURL == "" {
-		dbResources, err := generator.RenderDir("./database/", configuration)
-		if err != nil {
-			return err
-		}
-		all = append(all, dbResources...)
-	}
-
-	addons := []struct {
-		name    string
-		enabled bool
-	}{
-		{"jaeger", configuration.Syndesis.Addons.Jaeger.Enabled},
-		{"ops", configuration.Syndesis.Addons.Ops.Enabled},
-		{"dv", configuration.Syndesis.Addons.DV.Enabled},
-		{"camelk", configuration.Syndesis.Addons.CamelK.Enabled},
-		{"knative", configuration.Syndesis.Addons.Knative.Enabled},
-		{"todo", configuration.Syndesis.Addons.Todo.Enabled},
-	}
-	for _, addon := range addons {
-		if !addon.enabled {
-			continue
-		}
+	warnings, err := applyServerConfigOverride(ctx, a.client, syndesis.Namespace, configuration.Syndesis.Components.Server.ConfigOverride, all)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		a.log.Info(warning, "name", syndesis.Name)
+	}
 
-		addonDir := "./addons/" + addon.name + "/"
-		f, err := generator.GetAssetsFS().Open(addonDir)
-		if err != nil {
-			a.log.Info("unsupported addon configured", "addon", addon, "error", err)
-			continue
+	// The conditions are recorded on a copy of the status, written along with the rest of it
+	status := syndesis.Status.DeepCopy()
+
+	// Don't apply resources the templates got wrong, the API server would silently drop misplaced fields
+	if err := generator.Validate(a.scheme, all); err != nil {
+		return a.failPhase(ctx, syndesis, status, v1alpha1.SyndesisConditionPreflight, "InvalidResources", err)
+	}
+
+	// Fail early when the cluster or the namespace limits can't run syndesis, instead of leaving it half installed
+	if syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalling) {
+		if compatible, err := a.checkCompatibility(ctx, syndesis, configuration); err != nil || !compatible {
+			return err
 		}
-		f.Close()
 
-		resources, err := generator.RenderDir(addonDir, configuration)
+		problems, err := checkNamespaceQuotas(ctx, a.client, syndesis.Namespace, all)
 		if err != nil {
 			return err
 		}
+		if len(problems) > 0 {
+			a.log.Info("Namespace cannot accommodate the Syndesis resource", "name", syndesis.Name, "problems", problems)
+			return a.failPreflight(ctx, syndesis, v1alpha1.SyndesisStatusReasonInsufficientResources, "The namespace limits cannot accommodate Syndesis: "+strings.Join(problems, "; "))
+		}
+	}
+	conditionsChanged := setCondition(status, v1alpha1.SyndesisConditionPreflight, corev1.ConditionTrue, "Passed", "")
 
-		all = append(all, resources...)
+	if labelled, err := labelNamespace(ctx, a.client, syndesis.Namespace, syndesis.Spec.NamespaceManagement.Labels); err != nil {
+		return err
+	} else if labelled {
+		a.log.Info("Namespace labels updated", "namespace", syndesis.Namespace)
 	}
 
 	// Link the image secret to service accounts
@@ -171,11 +229,31 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 		}
 	}
 
+	// Integrations deployed to other namespaces pull the same images
+	pullSecretNamespaces, err := propagatePullSecret(a.api, syndesis, secret)
+	if err != nil {
+		return err
+	}
+	if err := syncIntegrationQuota(a.api, syndesis, configuration); err != nil {
+		return err
+	}
+	syncedSecrets, err := syncIntegrationSecrets(a.api, syndesis, configuration)
+	if err != nil {
+		return err
+	}
+
 	// Install the resources..
-	for _, res := range all {
+	lock := sync.Mutex{}
+	drifted := []string{}
+	applyResource := func(ctx context.Context, res unstructured.Unstructured) error {
 
 		operation.SetNamespaceAndOwnerReference(res, syndesis)
-		o, modificationType, err := util.CreateOrUpdate(ctx, a.client, &res)
+		if err := operation.SetProvenance(&res); err != nil {
+			return err
+		}
+		o, live, modificationType, err := util.CreateOrUpdateFromLive(ctx, a.client, &res)
+		lock.Lock()
+		defer lock.Unlock()
 		if err != nil {
 			if util.IsNoKindMatchError(err) {
 				gvk := res.GroupVersionKind()
@@ -192,9 +270,76 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 			if modificationType != controllerutil.OperationResultNone {
 				a.log.Info("resource "+string(modificationType), "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
 			}
+			if modificationType == controllerutil.OperationResultUpdated && operation.Drifted(live, &res) {
+				drifted = append(drifted, res.GetKind()+"/"+res.GetName())
+			}
 		}
+		return nil
+	}
 
+	// Each step gets a bounded time to apply its resources. A failed step is retried
+	// with the next reconcile, the later steps waiting for it to succeed. While installing,
+	// they also wait for the workloads of the step to roll out.
+	for _, phase := range phases {
+		phaseCtx, cancel := context.WithTimeout(ctx, installPhaseTimeout)
+		err := applyConcurrently(phase.resources, func(res unstructured.Unstructured) error {
+			return applyResource(phaseCtx, res)
+		})
+		if err == nil && phase.condition == v1alpha1.SyndesisConditionSecrets {
+			var backup types.UID
+			backup, err = backupGlobalConfig(phaseCtx, a.client, syndesis, phase.resources)
+			resourcesThatShouldExist[backup] = true
+		}
+		if err == nil && phase.condition == v1alpha1.SyndesisConditionExposure {
+			err = a.installConsoleLink(phaseCtx, configuration)
+		}
+		cancel()
+		if err != nil {
+			return a.failPhase(ctx, syndesis, status, phase.condition, "ApplyFailed", err)
+		}
+		if syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalling) {
+			pending, err := notRolledOut(ctx, a.client, syndesis.Namespace, phase.resources)
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				return a.waitPhase(ctx, syndesis, status, phase.condition, pending)
+			}
+		}
+		message := fmt.Sprintf("%d resources applied", len(phase.resources))
+		conditionsChanged = setCondition(status, phase.condition, corev1.ConditionTrue, "Applied", message) || conditionsChanged
+	}
+
+	driftChanged := false
+	if len(drifted) > 0 {
+		sort.Strings(drifted)
+		a.log.Info("Resources changed outside of the operator were restored", "name", syndesis.Name, "resources", drifted)
+		a.mgr.GetRecorder("syndesis-operator").Event(syndesis, corev1.EventTypeWarning, "ResourcesDrifted",
+			"Resources changed outside of the operator were restored: "+strings.Join(drifted, ", "))
+		syndesis.Status.Drift = &v1alpha1.SyndesisDrift{DetectedAt: metav1.Now(), Resources: drifted}
+		driftChanged = true
+	}
+
+	standbyChanged := syndesis.Status.Standby != configuration.Syndesis.Standby.Enabled
+	if standbyChanged && syndesis.Status.Standby {
+		a.log.Info("Standby Syndesis resource promoted", "name", syndesis.Name)
+		a.mgr.GetRecorder("syndesis-operator").Event(syndesis, corev1.EventTypeNormal, "StandbyPromoted",
+			"The installation no longer follows its primary, its components are being scaled up")
+		now := metav1.Now()
+		syndesis.Status.StandbyPromotedAt = &now
+		// The connections and integrations of the spec were created on the primary, they came along with its database
+		for _, connection := range pendingConnections(syndesis) {
+			syndesis.Status.ProvisionedConnections = append(syndesis.Status.ProvisionedConnections, connection.Name)
+		}
+		for _, integrations := range pendingImports(syndesis) {
+			syndesis.Status.ImportedIntegrations = append(syndesis.Status.ImportedIntegrations, integrations.Name)
+		}
+		// Wait for the scaled up components before declaring the installation ready again
+		if syndesis.Status.Phase == v1alpha1.SyndesisPhaseInstalled {
+			syndesis.Status.Phase = v1alpha1.SyndesisPhaseStarting
+		}
 	}
+	syndesis.Status.Standby = configuration.Syndesis.Standby.Enabled
 
 	// Find resources which need to be deleted.
 	labelSelector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
@@ -233,17 +378,49 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 		return err
 	}
 
-	addRouteAnnotation(syndesis, syndesisRoute)
+	addApplicationUrlAnnotation(syndesis, applicationUrl)
+	testSupport := configuration.Syndesis.Components.Server.Features.TestSupport
+	// Without syndesis-server there are no integrations to export before removal
+	finalizersChanged := configuration.InstallsApplication() && ensureFinalizer(syndesis, ExportFinalizer)
+	finalizersChanged = oauthFinalizerChanged || finalizersChanged
 	if syndesis.Status.Phase == v1alpha1.SyndesisPhaseInstalling {
 		// Installation completed, set the next state
 		syndesis.Status.Phase = v1alpha1.SyndesisPhaseStarting
 		syndesis.Status.Reason = v1alpha1.SyndesisStatusReasonMissing
 		syndesis.Status.Description = ""
+		syndesis.Status.TestSupport = testSupport
+		syndesis.Status.ExternalURL = applicationUrl
+		syndesis.Status.Warnings = warnings
+		syndesis.Status.ForcedReconcile = forceReconcile
+		syndesis.Status.PullSecretNamespaces = pullSecretNamespaces
+		syndesis.Status.SyncedSecrets = syncedSecrets
+		syndesis.Status.Conditions = status.Conditions
 		_, _, err := util.CreateOrUpdate(ctx, a.client, syndesis, "kind", "apiVersion")
 		if err != nil {
 			return err
 		}
 		a.log.Info("Syndesis resource installed", "name", syndesis.Name)
+	} else if syndesis.Status.TestSupport != testSupport || syndesis.Status.ExternalURL != applicationUrl ||
+		!reflect.DeepEqual(syndesis.Status.Warnings, warnings) || !reflect.DeepEqual(syndesis.Status.PullSecretNamespaces, pullSecretNamespaces) ||
+		!reflect.DeepEqual(syndesis.Status.SyncedSecrets, syncedSecrets) ||
+		conditionsChanged || finalizersChanged || driftChanged || standbyChanged || forced {
+		target := syndesis.DeepCopy()
+		target.Status.TestSupport = testSupport
+		target.Status.ExternalURL = applicationUrl
+		target.Status.Warnings = warnings
+		target.Status.ForcedReconcile = forceReconcile
+		target.Status.PullSecretNamespaces = pullSecretNamespaces
+		target.Status.SyncedSecrets = syncedSecrets
+		target.Status.Conditions = status.Conditions
+		if err := a.client.Update(ctx, target); err != nil {
+			return err
+		}
+	}
+	if forced {
+		a.log.Info("Forced reconcile of Syndesis resource completed", "name", syndesis.Name, "annotation", forceReconcile)
+	}
+	if testSupport {
+		a.log.Info("Test support endpoints are enabled, this installation must not be used in production", "name", syndesis.Name)
 	}
 
 	return nil
@@ -283,8 +460,8 @@ nextType:
 	return nil
 }
 
-func installServiceAccount(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, secret *corev1.Secret) (*corev1.ServiceAccount, error) {
-	sa := newSyndesisServiceAccount()
+func installServiceAccount(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, config *configuration.Config, secret *corev1.Secret) (*corev1.ServiceAccount, error) {
+	sa := newSyndesisServiceAccount(config)
 	if secret != nil {
 		linkImagePullSecret(sa, secret)
 	}
@@ -299,7 +476,7 @@ func installServiceAccount(ctx context.Context, cl client.Client, syndesis *v1al
 	return sa, nil
 }
 
-func newSyndesisServiceAccount() *corev1.ServiceAccount {
+func newSyndesisServiceAccount(config *configuration.Config) *corev1.ServiceAccount {
 	sa := corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -318,16 +495,27 @@ func newSyndesisServiceAccount() *corev1.ServiceAccount {
 		},
 	}
 
+	// Without a route, OAuth redirects go to the configured external hostname
+	if !config.ExposedWithRoute() {
+		delete(sa.Annotations, "serviceaccounts.openshift.io/oauth-redirecturi.route")
+		delete(sa.Annotations, "serviceaccounts.openshift.io/oauth-redirectreference.route")
+		sa.Annotations["serviceaccounts.openshift.io/oauth-redirecturi.external"] = "https://" + config.Syndesis.ExternalHostname
+	}
+
+	for i, hostname := range config.Syndesis.AlternateHostnames {
+		sa.Annotations["serviceaccounts.openshift.io/oauth-redirecturi.alternate-"+strconv.Itoa(i)] = "https://" + hostname
+	}
+
 	return &sa
 }
 
-func addRouteAnnotation(syndesis *v1alpha1.Syndesis, route *v1.Route) {
+func addApplicationUrlAnnotation(syndesis *v1alpha1.Syndesis, applicationUrl string) {
 	annotations := syndesis.ObjectMeta.Annotations
 	if annotations == nil {
 		annotations = make(map[string]string)
 		syndesis.ObjectMeta.Annotations = annotations
 	}
-	annotations["syndesis.io/applicationUrl"] = extractApplicationUrl(route)
+	annotations["syndesis.io/applicationUrl"] = applicationUrl
 }
 
 func extractApplicationUrl(route *v1.Route) string {
@@ -338,7 +526,7 @@ func extractApplicationUrl(route *v1.Route) string {
 	return scheme + "://" + route.Spec.Host
 }
 
-func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, objects []runtime.Object) (*v1.Route, error) {
+func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, objects []runtime.Object, ingressDomain string) (*v1.Route, error) {
 	route, err := findSyndesisRoute(objects)
 	if err != nil {
 		return nil, err
@@ -346,6 +534,21 @@ func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1alp
 
 	operation.SetNamespaceAndOwnerReference(route, syndesis)
 
+	// A host generated by OpenShift doesn't follow changes of the cluster ingress domain, so the route is
+	// recreated to get a new one
+	if route.Spec.Host == "" && ingressDomain != "" {
+		existing := &v1.Route{}
+		err := cl.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: route.Name}, existing)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil && existing.Annotations["openshift.io/host.generated"] == "true" && !strings.HasSuffix(existing.Spec.Host, "."+ingressDomain) {
+			if err := cl.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+	}
+
 	// We don't replace the route if already present, to let OpenShift generate its host
 	o, _, err := util.CreateOrUpdate(ctx, cl, route)
 	if err != nil {
@@ -0,0 +1,163 @@
+package action
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/component"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Time given to an installation step to apply its resources
+const installPhaseTimeout = 2 * time.Minute
+
+// Resources installed by the Secrets step, before the components using them
+var secretsPhaseKinds = map[string]bool{
+	"Secret":         true,
+	"ServiceAccount": true,
+	"ConfigMap":      true,
+	"Role":           true,
+	"RoleBinding":    true,
+	"Certificate":    true,
+}
+
+// Kinds of the workloads a step waits for, before the next step is run
+var rolledOutKinds = map[string]bool{
+	"DeploymentConfig": true,
+	"Deployment":       true,
+	"StatefulSet":      true,
+}
+
+// A step of the installation, its outcome is recorded in the condition of the same name
+type installPhase struct {
+	condition v1alpha1.SyndesisConditionType
+	resources []unstructured.Unstructured
+}
+
+// Renders the resources installed by each step, in the order the steps are run
+func renderInstallPhases(config *configuration.Config) ([]installPhase, error) {
+	var secrets, database, infrastructure, addons, exposure []unstructured.Unstructured
+
+	for _, c := range component.Registry {
+		if !c.IsEnabled(config) {
+			continue
+		}
+		resources, err := c.Render(config)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range resources {
+			switch {
+			case c.Addon:
+				addons = append(addons, res)
+			case secretsPhaseKinds[res.GetKind()]:
+				secrets = append(secrets, res)
+			case c.Name == "database":
+				database = append(database, res)
+			default:
+				infrastructure = append(infrastructure, res)
+			}
+		}
+	}
+
+	// Render the resources exposing syndesis when not using a route...
+	if exposureDir := "./exposure/" + config.Syndesis.Exposure + "/"; !config.ExposedWithRoute() {
+		if f, err := generator.GetAssetsFS().Open(exposureDir); err == nil {
+			f.Close()
+			resources, err := generator.RenderDir(exposureDir, config)
+			if err != nil {
+				return nil, err
+			}
+			exposure = append(exposure, resources...)
+		}
+	}
+
+	// ...and the routes or ingresses serving the alternate hostnames
+	resources, err := generator.RenderDir("./alternate/", config)
+	if err != nil {
+		return nil, err
+	}
+	exposure = append(exposure, resources...)
+
+	return []installPhase{
+		{v1alpha1.SyndesisConditionSecrets, secrets},
+		{v1alpha1.SyndesisConditionDatabase, database},
+		{v1alpha1.SyndesisConditionInfrastructure, infrastructure},
+		{v1alpha1.SyndesisConditionAddons, addons},
+		{v1alpha1.SyndesisConditionExposure, exposure},
+	}, nil
+}
+
+// Gives the workloads of a step whose replicas are not all ready yet, e.g. the database the server of the
+// next step connects to when starting
+func notRolledOut(ctx context.Context, c client.Client, namespace string, resources []unstructured.Unstructured) ([]string, error) {
+	pending := []string{}
+	for _, res := range resources {
+		if !rolledOutKinds[res.GetKind()] {
+			continue
+		}
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(res.GroupVersionKind())
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: res.GetName()}, live); err != nil {
+			// Workloads of kinds the cluster doesn't serve were not applied
+			if k8serrors.IsNotFound(err) || util.IsNoKindMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		replicas, found, err := unstructured.NestedInt64(live.Object, "spec", "replicas")
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			replicas = 1
+		}
+		ready, _, err := unstructured.NestedInt64(live.Object, "status", "readyReplicas")
+		if err != nil {
+			return nil, err
+		}
+		if ready < replicas {
+			pending = append(pending, res.GetKind()+"/"+res.GetName())
+		}
+	}
+	return pending, nil
+}
+
+// Records an installation step waiting for its workloads to roll out. Nothing failed, the step is checked
+// again with the next reconcile.
+func (a *installAction) waitPhase(ctx context.Context, syndesis *v1alpha1.Syndesis, status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType, pending []string) error {
+	message := "Waiting for " + strings.Join(pending, ", ") + " to roll out"
+	target := syndesis.DeepCopy()
+	target.Status.Conditions = status.DeepCopy().Conditions
+	if setCondition(&target.Status, conditionType, corev1.ConditionFalse, "RollingOut", message) {
+		a.log.Info("Installation step waiting for its workloads", "name", syndesis.Name, "step", conditionType, "workloads", pending)
+		return a.client.Update(ctx, target)
+	}
+	return nil
+}
+
+// Records the failure of an installation step and returns its error, so that the step is retried.
+// The given reason is replaced by the class of the error, when known. The status gives the conditions
+// recorded by the reconcile so far.
+func (a *installAction) failPhase(ctx context.Context, syndesis *v1alpha1.Syndesis, status *v1alpha1.SyndesisStatus, conditionType v1alpha1.SyndesisConditionType, reason string, err error) error {
+	if class := ClassifyError(err); class != ErrorClassUnknown {
+		reason = string(class)
+	}
+	target := syndesis.DeepCopy()
+	target.Status.Conditions = status.DeepCopy().Conditions
+	if setCondition(&target.Status, conditionType, corev1.ConditionFalse, reason, err.Error()) {
+		if updateErr := a.client.Update(ctx, target); updateErr != nil {
+			a.log.Error(updateErr, "Cannot record failed installation step", "name", syndesis.Name, "step", conditionType)
+		}
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_notRolledOut(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	deploymentConfig := func(name string, replicas int32, ready int32) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps.openshift.io/v1", Kind: "DeploymentConfig"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "syndesis"},
+			Spec:       appsv1.DeploymentConfigSpec{Replicas: replicas},
+			Status:     appsv1.DeploymentConfigStatus{ReadyReplicas: ready},
+		}
+	}
+	cl := fake.NewFakeClientWithScheme(scheme,
+		deploymentConfig("syndesis-db", 1, 0),
+		deploymentConfig("syndesis-server", 1, 1),
+		deploymentConfig("syndesis-meta", 0, 0),
+	)
+
+	rendered := func(kind string, name string) unstructured.Unstructured {
+		res := unstructured.Unstructured{}
+		res.SetAPIVersion("apps.openshift.io/v1")
+		if kind == "Service" {
+			res.SetAPIVersion("v1")
+		}
+		res.SetKind(kind)
+		res.SetName(name)
+		return res
+	}
+	resources := []unstructured.Unstructured{
+		rendered("DeploymentConfig", "syndesis-db"),
+		rendered("DeploymentConfig", "syndesis-server"),
+		rendered("DeploymentConfig", "syndesis-meta"),
+		rendered("DeploymentConfig", "syndesis-prometheus"),
+		rendered("Service", "syndesis-db"),
+	}
+
+	pending, err := notRolledOut(context.TODO(), cl, "syndesis", resources)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DeploymentConfig/syndesis-db"}, pending)
+}
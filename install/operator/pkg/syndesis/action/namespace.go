@@ -0,0 +1,37 @@
+package action
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Sets the labels of spec.namespaceManagement on the namespace syndesis is installed into.
+// Labels set by others, or dropped from the spec, are left alone.
+func labelNamespace(ctx context.Context, cl client.Client, namespace string, labels map[string]string) (bool, error) {
+	if len(labels) == 0 {
+		return false, nil
+	}
+
+	ns := corev1.Namespace{}
+	if err := cl.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		return false, err
+	}
+
+	changed := false
+	for name, value := range labels {
+		if current, found := ns.Labels[name]; !found || current != value {
+			if ns.Labels == nil {
+				ns.Labels = map[string]string{}
+			}
+			ns.Labels[name] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	return true, cl.Update(ctx, &ns)
+}
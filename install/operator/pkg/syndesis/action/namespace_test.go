@@ -0,0 +1,32 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_labelNamespace(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis", Labels: map[string]string{"team": "integration"}},
+	}
+	cl := fake.NewFakeClient(ns)
+	labels := map[string]string{"openshift.io/cluster-monitoring": "true"}
+
+	changed, err := labelNamespace(context.TODO(), cl, "syndesis", labels)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = labelNamespace(context.TODO(), cl, "syndesis", labels)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	updated := corev1.Namespace{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "syndesis"}, &updated))
+	assert.Equal(t, map[string]string{"team": "integration", "openshift.io/cluster-monitoring": "true"}, updated.Labels)
+}
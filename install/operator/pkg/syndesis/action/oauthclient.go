@@ -0,0 +1,108 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Keeps the syndesis resource until the OAuthClient managed for it has been removed
+const OAuthClientFinalizer = "syndesis.io/oauth-client"
+
+// Sets the secret the oauth proxy authenticates with. A managed OAuthClient is created, or repaired when it
+// drifted, with the generated secret and the hostnames syndesis is reachable at as redirect URIs. An OAuthClient
+// provided by the administrator only lends its secret, and the syndesis-oauth-client service account token is
+// used otherwise. OAuthClients are cluster scoped, so a finalizer removes the managed one with the syndesis
+// resource. True is returned when the finalizers of the syndesis resource changed.
+func (a *installAction) installOAuthClient(ctx context.Context, syndesis *v1alpha1.Syndesis, config *configuration.Config, serviceAccount string) (bool, error) {
+	oauthClient := config.Syndesis.Components.Oauth.Client
+	switch {
+	case oauthClient.Name != "":
+		provided := oauthv1.OAuthClient{}
+		if err := a.client.Get(ctx, types.NamespacedName{Name: oauthClient.Name}, &provided); err != nil {
+			return false, fmt.Errorf("cannot read oauth client %s: %v", oauthClient.Name, err)
+		}
+		config.OpenShiftOauthClientSecret = provided.Secret
+	case oauthClient.Managed:
+		resources, err := generator.RenderDir("./oauthclient/", config)
+		if err != nil {
+			return false, err
+		}
+		for _, res := range resources {
+			_, modificationType, err := util.CreateOrUpdate(ctx, a.client, &res)
+			if err != nil {
+				return false, err
+			}
+			if modificationType != controllerutil.OperationResultNone {
+				a.log.Info("resource "+string(modificationType), "kind", res.GetKind(), "name", res.GetName())
+			}
+		}
+		return ensureFinalizer(syndesis, OAuthClientFinalizer), nil
+	default:
+		token, err := serviceaccount.GetServiceAccountToken(ctx, a.client, serviceAccount, syndesis.Namespace)
+		if err != nil {
+			return false, err
+		}
+		config.OpenShiftOauthClientSecret = token
+	}
+
+	// The OAuthClient managed until now is not used anymore
+	if !hasFinalizer(syndesis, OAuthClientFinalizer) {
+		return false, nil
+	}
+	if err := deleteOAuthClient(ctx, a.client, syndesis.Namespace); err != nil {
+		return false, err
+	}
+	removeFinalizer(syndesis, OAuthClientFinalizer)
+	return true, nil
+}
+
+func deleteOAuthClient(ctx context.Context, cl client.Client, namespace string) error {
+	res := unstructured.Unstructured{}
+	res.SetAPIVersion("oauth.openshift.io/v1")
+	res.SetKind("OAuthClient")
+	res.SetName("syndesis-" + namespace)
+	if err := cl.Delete(ctx, &res); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Removes the OAuthClient managed for a syndesis resource being deleted, once its integrations have been exported.
+type oauthClientCleanupAction struct {
+	baseAction
+}
+
+func newOAuthClientCleanupAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &oauthClientCleanupAction{
+		newBaseAction(mgr, api, "oauthclient-cleanup"),
+	}
+}
+
+func (a *oauthClientCleanupAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesis.GetDeletionTimestamp() != nil && !hasFinalizer(syndesis, ExportFinalizer) && hasFinalizer(syndesis, OAuthClientFinalizer)
+}
+
+func (a *oauthClientCleanupAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	if err := deleteOAuthClient(ctx, a.client, syndesis.Namespace); err != nil {
+		return err
+	}
+	a.log.Info("OAuth client removed", "name", syndesis.Name, "oauthclient", "syndesis-"+syndesis.Namespace)
+
+	target := syndesis.DeepCopy()
+	removeFinalizer(target, OAuthClientFinalizer)
+	return a.client.Update(ctx, target)
+}
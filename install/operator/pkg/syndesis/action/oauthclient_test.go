@@ -0,0 +1,99 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInstallOAuthClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, oauthv1.AddToScheme(scheme))
+	require.NoError(t, apis.AddToScheme(scheme))
+
+	provided := &oauthv1.OAuthClient{ObjectMeta: metav1.ObjectMeta{Name: "corporate-sso"}, Secret: "provided-secret"}
+	cl := fake.NewFakeClientWithScheme(scheme, provided)
+	a := &installAction{baseAction{log: actionLog, client: cl, scheme: scheme}}
+
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+	config, err := configuration.GetProperties("../../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+	config.OpenShiftProject = "syndesis"
+	config.RouteHostname = "syndesis.apps.example.com"
+	config.Syndesis.AlternateHostnames = []string{"integrations.example.com"}
+	config.OpenShiftOauthClientSecret = "generated-secret"
+
+	// A managed client is created and the syndesis resource keeps it until removed
+	config.Syndesis.Components.Oauth.Client.Managed = true
+	changed, err := a.installOAuthClient(context.TODO(), syndesis, config, "syndesis-oauth-client")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, hasFinalizer(syndesis, OAuthClientFinalizer))
+
+	managed := &oauthv1.OAuthClient{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "syndesis-syndesis"}, managed))
+	assert.Equal(t, "generated-secret", managed.Secret)
+	assert.Equal(t, []string{"https://syndesis.apps.example.com", "https://integrations.example.com"}, managed.RedirectURIs)
+
+	// Drift is repaired
+	managed.Secret = "tampered"
+	managed.RedirectURIs = []string{"https://attacker.example.com"}
+	require.NoError(t, cl.Update(context.TODO(), managed))
+	changed, err = a.installOAuthClient(context.TODO(), syndesis, config, "syndesis-oauth-client")
+	require.NoError(t, err)
+	assert.False(t, changed)
+	managed = &oauthv1.OAuthClient{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "syndesis-syndesis"}, managed))
+	assert.Equal(t, "generated-secret", managed.Secret)
+	assert.Equal(t, []string{"https://syndesis.apps.example.com", "https://integrations.example.com"}, managed.RedirectURIs)
+
+	// Switching to a client provided by the administrator uses its secret, and drops the managed one
+	config.Syndesis.Components.Oauth.Client = configuration.OAuthClientConfiguration{Name: "corporate-sso"}
+	changed, err = a.installOAuthClient(context.TODO(), syndesis, config, "syndesis-oauth-client")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, hasFinalizer(syndesis, OAuthClientFinalizer))
+	assert.Equal(t, "provided-secret", config.OpenShiftOauthClientSecret)
+	assert.True(t, k8serrors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Name: "syndesis-syndesis"}, &oauthv1.OAuthClient{})))
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "corporate-sso"}, &oauthv1.OAuthClient{}))
+}
+
+func TestOAuthClientCleanupAction(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, oauthv1.AddToScheme(scheme))
+	require.NoError(t, apis.AddToScheme(scheme))
+
+	now := metav1.Now()
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{
+		Name:              "app",
+		Namespace:         "syndesis",
+		DeletionTimestamp: &now,
+		Finalizers:        []string{ExportFinalizer, OAuthClientFinalizer},
+	}}
+	cl := fake.NewFakeClientWithScheme(scheme, syndesis, &oauthv1.OAuthClient{ObjectMeta: metav1.ObjectMeta{Name: "syndesis-syndesis"}})
+	a := &oauthClientCleanupAction{baseAction{log: actionLog, client: cl, scheme: scheme}}
+
+	// Integrations are exported first
+	assert.False(t, a.CanExecute(syndesis))
+
+	syndesis.SetFinalizers([]string{OAuthClientFinalizer})
+	require.NoError(t, cl.Update(context.TODO(), syndesis))
+	require.True(t, a.CanExecute(syndesis))
+	require.NoError(t, a.Execute(context.TODO(), syndesis))
+
+	assert.True(t, k8serrors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Name: "syndesis-syndesis"}, &oauthv1.OAuthClient{})))
+	updated := &v1alpha1.Syndesis{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "app"}, updated))
+	assert.Empty(t, updated.GetFinalizers())
+}
@@ -0,0 +1,211 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resources reserved for the S2I build of an integration, which runs next to the syndesis pods
+var integrationBuildResources = corev1.ResourceList{
+	corev1.ResourceRequestsMemory: resource.MustParse("256Mi"),
+	corev1.ResourceLimitsMemory:   resource.MustParse("512Mi"),
+	corev1.ResourcePods:           resource.MustParse("1"),
+}
+
+type plannedContainer struct {
+	owner    string
+	name     string
+	replicas int64
+	requests corev1.ResourceList
+	limits   corev1.ResourceList
+}
+
+// Verifies that the LimitRanges and ResourceQuotas of the namespace can accommodate the given resources.
+// A description of every violation is returned, naming the offending LimitRange or ResourceQuota.
+func checkNamespaceQuotas(ctx context.Context, cl client.Client, namespace string, resources []unstructured.Unstructured) ([]string, error) {
+	limitRanges := corev1.LimitRangeList{}
+	if err := cl.List(ctx, &client.ListOptions{Namespace: namespace}, &limitRanges); err != nil {
+		return nil, err
+	}
+	quotas := corev1.ResourceQuotaList{}
+	if err := cl.List(ctx, &client.ListOptions{Namespace: namespace}, &quotas); err != nil {
+		return nil, err
+	}
+	if len(limitRanges.Items) == 0 && len(quotas.Items) == 0 {
+		return nil, nil
+	}
+
+	containers, claims := plannedResources(resources)
+
+	problems := []string{}
+	for _, lr := range limitRanges.Items {
+		for _, item := range lr.Spec.Limits {
+			switch item.Type {
+			case corev1.LimitTypeContainer:
+				for i := range containers {
+					applyLimitRangeDefaults(&containers[i], item)
+					problems = append(problems, checkContainerLimits(lr.Name, containers[i], item)...)
+				}
+			case corev1.LimitTypePersistentVolumeClaim:
+				for name, storage := range claims {
+					if max, ok := item.Max[corev1.ResourceStorage]; ok && storage.Cmp(max) > 0 {
+						problems = append(problems, fmt.Sprintf("LimitRange %s: persistent volume claim %s requests %s of storage, above the maximum of %s", lr.Name, name, storage.String(), max.String()))
+					}
+				}
+			}
+		}
+	}
+
+	required := totalResources(containers, claims)
+	for _, quota := range quotas.Items {
+		for name, hard := range quota.Spec.Hard {
+			needed, ok := required[name]
+			if !ok {
+				continue
+			}
+			available := hard.DeepCopy()
+			if used, ok := quota.Status.Used[name]; ok {
+				available.Sub(used)
+			}
+			if needed.Cmp(available) > 0 {
+				problems = append(problems, fmt.Sprintf("ResourceQuota %s: %s %s is required but only %s is available", quota.Name, name, needed.String(), available.String()))
+			}
+		}
+	}
+
+	sort.Strings(problems)
+	return problems, nil
+}
+
+func plannedResources(resources []unstructured.Unstructured) ([]plannedContainer, map[string]resource.Quantity) {
+	containers := []plannedContainer{}
+	claims := map[string]resource.Quantity{}
+
+	for _, res := range resources {
+		switch res.GetKind() {
+		case "DeploymentConfig", "Deployment", "StatefulSet":
+			replicas := int64(1)
+			if r, found, _ := unstructured.NestedFieldNoCopy(res.Object, "spec", "replicas"); found {
+				replicas = toInt64(r)
+			}
+			list, _, _ := unstructured.NestedSlice(res.Object, "spec", "template", "spec", "containers")
+			for _, c := range list {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(container, "name")
+				containers = append(containers, plannedContainer{
+					owner:    res.GetName(),
+					name:     name,
+					replicas: replicas,
+					requests: toResourceList(container, "resources", "requests"),
+					limits:   toResourceList(container, "resources", "limits"),
+				})
+			}
+		case "PersistentVolumeClaim":
+			storage := toResourceList(res.Object, "spec", "resources", "requests")
+			claims[res.GetName()] = storage[corev1.ResourceStorage]
+		}
+	}
+	return containers, claims
+}
+
+func applyLimitRangeDefaults(c *plannedContainer, item corev1.LimitRangeItem) {
+	for name, value := range item.Default {
+		if _, ok := c.limits[name]; !ok {
+			c.limits[name] = value
+		}
+	}
+	for name, value := range item.DefaultRequest {
+		if _, ok := c.requests[name]; !ok {
+			c.requests[name] = value
+		}
+	}
+	// A missing request defaults to the limit
+	for name, value := range c.limits {
+		if _, ok := c.requests[name]; !ok {
+			c.requests[name] = value
+		}
+	}
+}
+
+func checkContainerLimits(limitRange string, c plannedContainer, item corev1.LimitRangeItem) []string {
+	problems := []string{}
+	for name, max := range item.Max {
+		if limit, ok := c.limits[name]; ok && limit.Cmp(max) > 0 {
+			problems = append(problems, fmt.Sprintf("LimitRange %s: container %s of %s has a %s limit of %s, above the maximum of %s", limitRange, c.name, c.owner, name, limit.String(), max.String()))
+		}
+	}
+	for name, min := range item.Min {
+		if request, ok := c.requests[name]; ok && request.Cmp(min) < 0 {
+			problems = append(problems, fmt.Sprintf("LimitRange %s: container %s of %s has a %s request of %s, below the minimum of %s", limitRange, c.name, c.owner, name, request.String(), min.String()))
+		}
+	}
+	return problems
+}
+
+func totalResources(containers []plannedContainer, claims map[string]resource.Quantity) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	add := func(name corev1.ResourceName, q resource.Quantity, times int64) {
+		current := total[name]
+		for i := int64(0); i < times; i++ {
+			current.Add(q)
+		}
+		total[name] = current
+	}
+
+	pods := map[string]int64{}
+	for _, c := range containers {
+		pods[c.owner] = c.replicas
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if q, ok := c.requests[name]; ok {
+				add("requests."+name, q, c.replicas)
+				add(name, q, c.replicas)
+			}
+			if q, ok := c.limits[name]; ok {
+				add("limits."+name, q, c.replicas)
+			}
+		}
+	}
+	for _, replicas := range pods {
+		add(corev1.ResourcePods, resource.MustParse("1"), replicas)
+	}
+	for _, storage := range claims {
+		add(corev1.ResourceRequestsStorage, storage, 1)
+		add(corev1.ResourcePersistentVolumeClaims, resource.MustParse("1"), 1)
+	}
+	for name, q := range integrationBuildResources {
+		add(name, q, 1)
+	}
+	return total
+}
+
+func toResourceList(obj map[string]interface{}, fields ...string) corev1.ResourceList {
+	result := corev1.ResourceList{}
+	values, _, _ := unstructured.NestedMap(obj, fields...)
+	for name, value := range values {
+		if q, err := resource.ParseQuantity(fmt.Sprint(value)); err == nil {
+			result[corev1.ResourceName(name)] = q
+		}
+	}
+	return result
+}
+
+func toInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+	return 1
+}
@@ -0,0 +1,70 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+const plannedServer = `
+apiVersion: apps.openshift.io/v1
+kind: DeploymentConfig
+metadata:
+  name: syndesis-server
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: syndesis-server
+        resources:
+          limits:
+            memory: 800Mi
+          requests:
+            memory: 256Mi
+`
+
+func Test_checkNamespaceQuotas(t *testing.T) {
+	server, err := util.LoadRawResourceFromYaml(plannedServer)
+	require.NoError(t, err)
+	resources := []unstructured.Unstructured{*server}
+
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "syndesis"},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type: corev1.LimitTypeContainer,
+				Max:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			}},
+		},
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "syndesis"},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourceLimitsMemory: resource.MustParse("2Gi")},
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Used: corev1.ResourceList{corev1.ResourceLimitsMemory: resource.MustParse("1Gi")},
+		},
+	}
+
+	problems, err := checkNamespaceQuotas(context.TODO(), fake.NewFakeClient(), "syndesis", resources)
+	assert.NoError(t, err)
+	assert.Empty(t, problems)
+
+	problems, err = checkNamespaceQuotas(context.TODO(), fake.NewFakeClient(limitRange, quota), "syndesis", resources)
+	assert.NoError(t, err)
+	if assert.Len(t, problems, 2) {
+		assert.Contains(t, problems[0], "LimitRange limits: container syndesis-server of syndesis-server")
+		assert.Contains(t, problems[1], "ResourceQuota quota: limits.memory")
+	}
+}
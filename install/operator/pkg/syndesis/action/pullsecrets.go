@@ -0,0 +1,147 @@
+package action
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Annotation of the copies of the pull secret, naming the namespace and name of the secret they were copied from
+const PullSecretSourceAnnotation = "syndesis.io/copied-from"
+
+// Service accounts building and deploying the integrations, which pull the syndesis images
+var integrationServiceAccounts = []string{"builder", "deployer"}
+
+// Keeps a copy of the pull secret in the namespaces integrations are deployed to, linked to their builder and
+// deployer service accounts. The copies are updated on every reconcile so that rotated credentials reach them,
+// and removed from the namespaces that are no longer listed. The namespaces holding a copy are returned.
+// The clientset is used, as the cache of the manager only holds the namespace of the operator.
+func propagatePullSecret(api kubernetes.Interface, syndesis *v1alpha1.Syndesis, secret *corev1.Secret) ([]string, error) {
+	var propagated []string
+	kept := map[string]bool{}
+	if secret != nil {
+		source, err := api.CoreV1().Secrets(syndesis.Namespace).Get(secret.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range syndesis.Spec.IntegrationNamespaces {
+			if namespace == syndesis.Namespace {
+				continue
+			}
+			if err := syncPullSecretCopy(api, pullSecretCopy(source, namespace)); err != nil {
+				return nil, err
+			}
+			propagated = append(propagated, namespace)
+			kept[namespace] = true
+		}
+	}
+
+	for _, namespace := range syndesis.Status.PullSecretNamespaces {
+		if kept[namespace] {
+			continue
+		}
+		if err := removePullSecretCopy(api, syndesis, namespace); err != nil {
+			return nil, err
+		}
+	}
+	return propagated, nil
+}
+
+// Copy of the pull secret for another namespace
+func pullSecretCopy(source *corev1.Secret, namespace string) *corev1.Secret {
+	return secretCopy(source, namespace)
+}
+
+// Copy of a secret of the syndesis namespace for another namespace, annotated with its source
+func secretCopy(source *corev1.Secret, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      source.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":             "syndesis",
+				"syndesis.io/app": "syndesis",
+			},
+			Annotations: map[string]string{
+				PullSecretSourceAnnotation: source.Namespace + "/" + source.Name,
+			},
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+}
+
+func syncPullSecretCopy(api kubernetes.Interface, secret *corev1.Secret) error {
+	if err := syncSecretCopy(api, secret); err != nil {
+		return err
+	}
+
+	for _, name := range integrationServiceAccounts {
+		sa, err := api.CoreV1().ServiceAccounts(secret.Namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				// Created by OpenShift shortly after the namespace, linked with the next reconcile
+				continue
+			}
+			return err
+		}
+		linked := linkImagePullSecret(sa, secret)
+		linked = linkSecret(sa, secret.Name) || linked
+		if linked {
+			if _, err := api.CoreV1().ServiceAccounts(secret.Namespace).Update(sa); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Creates the copy of a secret or updates its data with the one of its source, so that rotated credentials reach it
+func syncSecretCopy(api kubernetes.Interface, secret *corev1.Secret) error {
+	secrets := api.CoreV1().Secrets(secret.Namespace)
+	existing, err := secrets.Get(secret.Name, metav1.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		_, err = secrets.Create(secret)
+		return err
+	case err != nil:
+		return err
+	case existing.Annotations[PullSecretSourceAnnotation] != secret.Annotations[PullSecretSourceAnnotation]:
+		return fmt.Errorf("secret %s of namespace %s is not a copy of %s, it is left untouched", secret.Name, secret.Namespace, secret.Annotations[PullSecretSourceAnnotation])
+	case existing.Type != secret.Type || !reflect.DeepEqual(existing.Data, secret.Data):
+		existing.Type = secret.Type
+		existing.Data = secret.Data
+		_, err = secrets.Update(existing)
+		return err
+	}
+	return nil
+}
+
+func removePullSecretCopy(api kubernetes.Interface, syndesis *v1alpha1.Syndesis, namespace string) error {
+	return removeSecretCopy(api, syndesis, namespace, SyndesisPullSecret)
+}
+
+// Deletes the copy of a secret of the syndesis namespace, unless the secret is not a copy of it. Only its
+// annotations are needed to tell, so its content is not fetched
+func removeSecretCopy(api kubernetes.Interface, syndesis *v1alpha1.Syndesis, namespace string, name string) error {
+	existing, err := util.GetObjectMetadata(api, "secrets", namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if existing.Annotations[PullSecretSourceAnnotation] != syndesis.Namespace+"/"+name {
+		return nil
+	}
+	if err := api.CoreV1().Secrets(namespace).Delete(existing.Name, &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
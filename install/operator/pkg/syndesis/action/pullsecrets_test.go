@@ -0,0 +1,26 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_pullSecretCopy(t *testing.T) {
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SyndesisPullSecret, Namespace: "syndesis", ResourceVersion: "42", UID: "0a2b"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}
+
+	copied := pullSecretCopy(source, "integrations")
+	assert.Equal(t, "integrations", copied.Namespace)
+	assert.Equal(t, SyndesisPullSecret, copied.Name)
+	assert.Empty(t, copied.ResourceVersion)
+	assert.Empty(t, copied.UID)
+	assert.Equal(t, "syndesis/syndesis-pull-secret", copied.Annotations[PullSecretSourceAnnotation])
+	assert.Equal(t, source.Type, copied.Type)
+	assert.Equal(t, source.Data, copied.Data)
+}
@@ -0,0 +1,164 @@
+package action
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LimitRange capping the memory of the integrations in the integration namespaces
+	IntegrationLimitRange = "syndesis-integration-limits"
+	// ResourceQuota capping the number of integrations deployed in the integration namespaces
+	IntegrationResourceQuota = "syndesis-integration-quota"
+)
+
+// Resources the integrations are deployed as, depending on their runtime
+var integrationResources = []corev1.ResourceName{
+	"count/deploymentconfigs.apps.openshift.io",
+	"count/integrations.camel.apache.org",
+}
+
+var integrationQuotaLabels = map[string]string{
+	"app":             "syndesis",
+	"syndesis.io/app": "syndesis",
+}
+
+// Keeps the LimitRange and ResourceQuota enforcing the integration quota in the integration namespaces, removing
+// them once the quota no longer sets their limits. The syndesis namespace is left alone, as they would also constrain
+// the syndesis components there, the server settings limiting its integrations instead.
+func syncIntegrationQuota(api kubernetes.Interface, syndesis *v1alpha1.Syndesis, config *configuration.Config) error {
+	quota := config.Syndesis.Integration.Quota
+	for _, namespace := range syndesis.Spec.IntegrationNamespaces {
+		if namespace == syndesis.Namespace {
+			continue
+		}
+		if err := syncLimitRange(api, integrationLimitRange(namespace, quota)); err != nil {
+			return err
+		}
+		if err := syncResourceQuota(api, integrationResourceQuota(namespace, quota)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LimitRange of the namespace for the quota, nil when it sets no memory limit
+func integrationLimitRange(namespace string, quota configuration.IntegrationQuota) *corev1.LimitRange {
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: IntegrationLimitRange, Namespace: namespace, Labels: integrationQuotaLabels},
+	}
+	memory, err := resource.ParseQuantity(quota.MaxMemory)
+	if quota.MaxMemory == "" || err != nil {
+		return limitRange
+	}
+	limitRange.Spec.Limits = []corev1.LimitRangeItem{{
+		Type: corev1.LimitTypeContainer,
+		Max:  corev1.ResourceList{corev1.ResourceMemory: memory},
+	}}
+	return limitRange
+}
+
+// ResourceQuota of the namespace for the quota, without limits when it doesn't limit the integrations of a namespace
+func integrationResourceQuota(namespace string, quota configuration.IntegrationQuota) *corev1.ResourceQuota {
+	resourceQuota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: IntegrationResourceQuota, Namespace: namespace, Labels: integrationQuotaLabels},
+	}
+	if quota.MaxIntegrationsPerNamespace <= 0 {
+		return resourceQuota
+	}
+	resourceQuota.Spec.Hard = corev1.ResourceList{}
+	for _, name := range integrationResources {
+		resourceQuota.Spec.Hard[name] = *resource.NewQuantity(int64(quota.MaxIntegrationsPerNamespace), resource.DecimalSI)
+	}
+	return resourceQuota
+}
+
+// Creates or updates the LimitRange, deleting it when it has no limits. One not labeled by the operator is not touched.
+func syncLimitRange(api kubernetes.Interface, limitRange *corev1.LimitRange) error {
+	limitRanges := api.CoreV1().LimitRanges(limitRange.Namespace)
+	existing, err := limitRanges.Get(limitRange.Name, metav1.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		if len(limitRange.Spec.Limits) == 0 {
+			return nil
+		}
+		_, err = limitRanges.Create(limitRange)
+		return err
+	case err != nil:
+		return err
+	case existing.Labels["syndesis.io/app"] != "syndesis":
+		return nil
+	case len(limitRange.Spec.Limits) == 0:
+		return ignoreNotFound(limitRanges.Delete(existing.Name, &metav1.DeleteOptions{}))
+	case !sameLimits(existing.Spec.Limits, limitRange.Spec.Limits):
+		existing.Spec = limitRange.Spec
+		_, err = limitRanges.Update(existing)
+		return err
+	}
+	return nil
+}
+
+// Creates or updates the ResourceQuota, deleting it when it has no limits. One not labeled by the operator is not touched.
+func syncResourceQuota(api kubernetes.Interface, resourceQuota *corev1.ResourceQuota) error {
+	quotas := api.CoreV1().ResourceQuotas(resourceQuota.Namespace)
+	existing, err := quotas.Get(resourceQuota.Name, metav1.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		if len(resourceQuota.Spec.Hard) == 0 {
+			return nil
+		}
+		_, err = quotas.Create(resourceQuota)
+		return err
+	case err != nil:
+		return err
+	case existing.Labels["syndesis.io/app"] != "syndesis":
+		return nil
+	case len(resourceQuota.Spec.Hard) == 0:
+		return ignoreNotFound(quotas.Delete(existing.Name, &metav1.DeleteOptions{}))
+	case !sameResources(existing.Spec.Hard, resourceQuota.Spec.Hard):
+		existing.Spec.Hard = resourceQuota.Spec.Hard
+		_, err = quotas.Update(existing)
+		return err
+	}
+	return nil
+}
+
+// Whether the limits are the same, comparing the quantities by value as the API server may format them differently
+func sameLimits(a []corev1.LimitRangeItem, b []corev1.LimitRangeItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type || !sameResources(a[i].Max, b[i].Max) || !sameResources(a[i].Min, b[i].Min) ||
+			!sameResources(a[i].Default, b[i].Default) || !sameResources(a[i].DefaultRequest, b[i].DefaultRequest) ||
+			!sameResources(a[i].MaxLimitRequestRatio, b[i].MaxLimitRequestRatio) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameResources(a corev1.ResourceList, b corev1.ResourceList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, quantity := range a {
+		other, ok := b[name]
+		if !ok || quantity.Cmp(other) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func ignoreNotFound(err error) error {
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,48 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func Test_integrationQuota(t *testing.T) {
+	quota := configuration.IntegrationQuota{MaxIntegrationsPerNamespace: 10, MaxMemory: "1Gi"}
+
+	limitRange := integrationLimitRange("integrations", quota)
+	assert.Equal(t, IntegrationLimitRange, limitRange.Name)
+	assert.Equal(t, "integrations", limitRange.Namespace)
+	require.Len(t, limitRange.Spec.Limits, 1)
+	assert.Equal(t, corev1.LimitTypeContainer, limitRange.Spec.Limits[0].Type)
+	max := limitRange.Spec.Limits[0].Max[corev1.ResourceMemory]
+	assert.Equal(t, int64(1024*1024*1024), max.Value())
+
+	resourceQuota := integrationResourceQuota("integrations", quota)
+	count := resourceQuota.Spec.Hard["count/deploymentconfigs.apps.openshift.io"]
+	assert.Equal(t, int64(10), count.Value())
+	assert.Len(t, resourceQuota.Spec.Hard, len(integrationResources))
+
+	// Removed once the quota no longer limits the namespace
+	assert.Empty(t, integrationLimitRange("integrations", configuration.IntegrationQuota{}).Spec.Limits)
+	assert.Empty(t, integrationResourceQuota("integrations", configuration.IntegrationQuota{}).Spec.Hard)
+}
+
+func Test_sameLimits(t *testing.T) {
+	limits := []corev1.LimitRangeItem{{
+		Type: corev1.LimitTypeContainer,
+		Max:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}}
+	live := []corev1.LimitRangeItem{{
+		Type: corev1.LimitTypeContainer,
+		Max:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1024Mi")},
+	}}
+	assert.True(t, sameLimits(limits, live))
+
+	live[0].Max[corev1.ResourceMemory] = resource.MustParse("2Gi")
+	assert.False(t, sameLimits(limits, live))
+	assert.False(t, sameLimits(limits, nil))
+}
@@ -0,0 +1,113 @@
+package action
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Class of a reconcile failure, used as the reason of the Reconciled condition and as the
+// class label of the reconcile errors metric, so that alerts can be routed by class
+type ErrorClass string
+
+const (
+	ErrorClassPermission        ErrorClass = "Permission"
+	ErrorClassQuota             ErrorClass = "Quota"
+	ErrorClassImagePull         ErrorClass = "ImagePull"
+	ErrorClassDependencyMissing ErrorClass = "DependencyMissing"
+	ErrorClassTransient         ErrorClass = "Transient"
+	ErrorClassUnknown           ErrorClass = "Unknown"
+)
+
+var reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "syndesis_reconcile_errors_total",
+	Help: "Number of failed reconciles of Syndesis resources, by class of failure.",
+}, []string{"class"})
+
+func init() {
+	metrics.Registry.MustRegister(reconcileErrors)
+}
+
+// An error whose class is known where it happens, e.g. an image that cannot be pulled
+type ClassifiedError struct {
+	Class ErrorClass
+	Err   error
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func newClassifiedError(class ErrorClass, err error) error {
+	return &ClassifiedError{Class: class, Err: err}
+}
+
+type causer interface {
+	Cause() error
+}
+
+// Classifies a reconcile failure: errors that were classified where they happened keep their class,
+// the other ones are classified from the API status or the network error they wrap
+func ClassifyError(err error) ErrorClass {
+	for err != nil {
+		if classified, ok := err.(*ClassifiedError); ok {
+			return classified.Class
+		}
+		cause, ok := err.(causer)
+		if !ok {
+			break
+		}
+		err = cause.Cause()
+	}
+
+	switch {
+	case err == nil:
+		return ErrorClassUnknown
+	// Quotas are enforced by an admission plugin, refusing the request as forbidden
+	case k8serrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota"):
+		return ErrorClassQuota
+	case k8serrors.IsForbidden(err), k8serrors.IsUnauthorized(err):
+		return ErrorClassPermission
+	case k8serrors.IsNotFound(err), meta.IsNoMatchError(err):
+		return ErrorClassDependencyMissing
+	case k8serrors.IsConflict(err), k8serrors.IsServerTimeout(err), k8serrors.IsTimeout(err), k8serrors.IsTooManyRequests(err),
+		k8serrors.IsInternalError(err), k8serrors.IsServiceUnavailable(err), k8serrors.IsUnexpectedServerError(err):
+		return ErrorClassTransient
+	}
+	if _, ok := err.(net.Error); ok {
+		return ErrorClassTransient
+	}
+	return ErrorClassUnknown
+}
+
+// Records the outcome of a reconcile in the Reconciled condition of the syndesis resource, failures
+// being counted by class. The resource is read again, as the actions may have updated it.
+func RecordReconcileOutcome(ctx context.Context, cl client.Client, key types.NamespacedName, reconcileErr error) error {
+	status, reason, message := corev1.ConditionTrue, "Succeeded", ""
+	if reconcileErr != nil {
+		class := ClassifyError(reconcileErr)
+		reconcileErrors.WithLabelValues(string(class)).Inc()
+		status, reason, message = corev1.ConditionFalse, string(class), reconcileErr.Error()
+	}
+
+	syndesis := &v1alpha1.Syndesis{}
+	if err := cl.Get(ctx, key, syndesis); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !setCondition(&syndesis.Status, v1alpha1.SyndesisConditionReconciled, status, reason, message) {
+		return nil
+	}
+	return cl.Update(ctx, syndesis)
+}
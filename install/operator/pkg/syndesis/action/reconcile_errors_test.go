@@ -0,0 +1,88 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClassifyError(t *testing.T) {
+	secrets := schema.GroupResource{Resource: "secrets"}
+	tests := []struct {
+		err   error
+		class ErrorClass
+	}{
+		{k8serrors.NewForbidden(secrets, "syndesis-db", errors.New("cannot create secrets")), ErrorClassPermission},
+		{k8serrors.NewUnauthorized("token expired"), ErrorClassPermission},
+		{k8serrors.NewForbidden(secrets, "syndesis-db", errors.New("exceeded quota: compute, requested: limits.memory=1Gi")), ErrorClassQuota},
+		{k8serrors.NewNotFound(secrets, "syndesis-db"), ErrorClassDependencyMissing},
+		{&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "jaegertracing.io", Kind: "Jaeger"}}, ErrorClassDependencyMissing},
+		{k8serrors.NewConflict(secrets, "syndesis-db", errors.New("modified")), ErrorClassTransient},
+		{k8serrors.NewServiceUnavailable("etcd leader changed"), ErrorClassTransient},
+		{pkgerrors.Wrap(k8serrors.NewTimeoutError("slow", 1), "applying syndesis-db"), ErrorClassTransient},
+		{pkgerrors.WithStack(newClassifiedError(ErrorClassImagePull, errors.New("no such image"))), ErrorClassImagePull},
+		{errors.New("template error"), ErrorClassUnknown},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.class, ClassifyError(tt.err), tt.err.Error())
+	}
+}
+
+func TestRecordReconcileOutcome(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apis.AddToScheme(scheme))
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+	cl := fake.NewFakeClientWithScheme(scheme, syndesis)
+	key := types.NamespacedName{Namespace: "syndesis", Name: "app"}
+	ctx := context.TODO()
+
+	before := reconcileErrorCount(t, ErrorClassPermission)
+	require.NoError(t, RecordReconcileOutcome(ctx, cl, key, k8serrors.NewUnauthorized("token expired")))
+	assert.Equal(t, before+1, reconcileErrorCount(t, ErrorClassPermission))
+
+	recorded := &v1alpha1.Syndesis{}
+	require.NoError(t, cl.Get(ctx, key, recorded))
+	condition := getCondition(&recorded.Status, v1alpha1.SyndesisConditionReconciled)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, "Permission", condition.Reason)
+
+	require.NoError(t, RecordReconcileOutcome(ctx, cl, key, nil))
+	recorded = &v1alpha1.Syndesis{}
+	require.NoError(t, cl.Get(ctx, key, recorded))
+	assert.Equal(t, corev1.ConditionTrue, getCondition(&recorded.Status, v1alpha1.SyndesisConditionReconciled).Status)
+
+	assert.NoError(t, RecordReconcileOutcome(ctx, cl, types.NamespacedName{Namespace: "syndesis", Name: "deleted"}, nil))
+}
+
+func reconcileErrorCount(t *testing.T, class ErrorClass) float64 {
+	metric := dto.Metric{}
+	require.NoError(t, reconcileErrors.WithLabelValues(string(class)).Write(&metric))
+	return metric.GetCounter().GetValue()
+}
+
+func Test_imagePullFailure(t *testing.T) {
+	pods := []corev1.Pod{*crashLoopingPod("syndesis-server-1-abcde", "syndesis-server", 1)}
+	assert.NoError(t, imagePullFailure(pods))
+
+	pods[0].Status.ContainerStatuses[0].Image = "docker.io/syndesis/syndesis-server:missing"
+	pods[0].Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "manifest unknown"}
+	err := imagePullFailure(pods)
+	assert.EqualError(t, err, "container syndesis-server of pod syndesis-server-1-abcde cannot pull image docker.io/syndesis/syndesis-server:missing: manifest unknown")
+	assert.Equal(t, ErrorClassImagePull, ClassifyError(err))
+}
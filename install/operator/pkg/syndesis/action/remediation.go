@@ -0,0 +1,223 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Pods of the syndesis components, integrations are left alone
+const remediationPodSelector = "syndesis.io/app=syndesis,syndesis.io/component,!syndesis.io/integration"
+
+// A remediation deletes resources of a crash looping component, the install action
+// re-creating them from the templates on the next reconcile
+type remediationStep struct {
+	name    string
+	newList func() runtime.Object
+}
+
+// Remediations in the order they are tried, each one more disruptive than the previous one
+var remediationSteps = []remediationStep{
+	{"Restart", func() runtime.Object { return &corev1.PodList{} }},
+	{"RerenderConfig", func() runtime.Object { return &corev1.ConfigMapList{} }},
+	{"RecreateDeployment", func() runtime.Object { return &appsv1.DeploymentConfigList{} }},
+}
+
+// Watches the components of installed syndesis resources that opted in, and remediates the crash looping ones.
+// Once all the remediations failed, the Degraded condition tells which components are broken and why.
+type remediationAction struct {
+	baseAction
+}
+
+func newRemediationAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &remediationAction{
+		newBaseAction(mgr, api, "remediation"),
+	}
+}
+
+func (a *remediationAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled) && syndesis.Spec.Remediation.Enabled
+}
+
+func (a *remediationAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+
+	settings := config.Syndesis.Remediation
+	interval, err := time.ParseDuration(settings.Interval)
+	if err != nil {
+		a.log.Error(err, "Invalid remediation interval", "name", syndesis.Name, "interval", settings.Interval)
+		return nil
+	}
+
+	pods := corev1.PodList{}
+	listOptions := client.ListOptions{Namespace: syndesis.Namespace}
+	if err := listOptions.SetLabelSelector(remediationPodSelector); err != nil {
+		return err
+	}
+	if err := a.client.List(ctx, &listOptions, &pods); err != nil {
+		return err
+	}
+	crashing := crashLoopingComponents(pods.Items, settings.RestartThreshold)
+
+	target := syndesis.DeepCopy()
+	changed := false
+	remediations := []v1alpha1.SyndesisRemediation{}
+	for _, remediation := range target.Status.Remediations {
+		if _, found := crashing[remediation.Component]; found {
+			remediations = append(remediations, remediation)
+		} else {
+			a.log.Info("Component recovered", "name", syndesis.Name, "component", remediation.Component, "lastAction", remediation.LastAction)
+			changed = true
+		}
+	}
+
+	components := make([]string, 0, len(crashing))
+	for component := range crashing {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	degraded := []string{}
+	now := time.Now()
+	for _, component := range components {
+		remediation := findRemediation(remediations, component)
+		if remediation == nil {
+			remediations = append(remediations, v1alpha1.SyndesisRemediation{Component: component})
+			remediation = &remediations[len(remediations)-1]
+			changed = true
+		}
+
+		if int(remediation.Attempts) >= len(remediationSteps) {
+			degraded = append(degraded, component+": "+crashing[component])
+			continue
+		}
+		if !remediation.LastAttempt.IsZero() && now.Sub(remediation.LastAttempt.Time) < interval {
+			continue
+		}
+
+		step := remediationSteps[remediation.Attempts]
+		if err := remediate(ctx, a.client, syndesis, component, step); err != nil {
+			return err
+		}
+		a.log.Info("Crash looping component remediated", "name", syndesis.Name, "component", component, "action", step.name, "diagnostic", crashing[component])
+		remediation.Attempts++
+		remediation.LastAction = step.name
+		remediation.LastAttempt = metav1.NewTime(now)
+		changed = true
+	}
+	if len(remediations) == 0 {
+		remediations = nil
+	}
+	target.Status.Remediations = remediations
+
+	if len(degraded) > 0 {
+		message := strings.Join(degraded, "; ")
+		if setCondition(&target.Status, v1alpha1.SyndesisConditionDegraded, corev1.ConditionTrue, "RemediationFailed", message) {
+			a.log.Info("Remediation failed, Syndesis resource degraded", "name", syndesis.Name, "components", message)
+			a.notify(ctx, syndesis, "Syndesis degraded", "Syndesis "+syndesis.Name+" components keep crashing after remediation: "+message)
+			changed = true
+		}
+	} else if getCondition(&target.Status, v1alpha1.SyndesisConditionDegraded) != nil {
+		changed = setCondition(&target.Status, v1alpha1.SyndesisConditionDegraded, corev1.ConditionFalse, "Healthy", "") || changed
+	}
+
+	if !changed {
+		return nil
+	}
+	return a.client.Update(ctx, target)
+}
+
+// Finds the components with a container in CrashLoopBackOff that restarted at least threshold times,
+// together with a diagnostic taken from the last termination of the container
+func crashLoopingComponents(pods []corev1.Pod, threshold int32) map[string]string {
+	crashing := map[string]string{}
+	for _, pod := range pods {
+		component := pod.Labels["syndesis.io/component"]
+		if component == "" {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting == nil || status.State.Waiting.Reason != "CrashLoopBackOff" || status.RestartCount < threshold {
+				continue
+			}
+			diagnostic := fmt.Sprintf("container %s of pod %s restarted %d times", status.Name, pod.Name, status.RestartCount)
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				diagnostic += fmt.Sprintf(", last exit code %d (%s)", terminated.ExitCode, terminated.Reason)
+				if message := strings.TrimSpace(terminated.Message); message != "" {
+					if len(message) > 200 {
+						message = message[:200] + "..."
+					}
+					diagnostic += ": " + message
+				}
+			}
+			crashing[component] = diagnostic
+		}
+	}
+	return crashing
+}
+
+// Deletes the resources of the kind handled by the remediation step that belong to the component
+func remediate(ctx context.Context, cl client.Client, syndesis *v1alpha1.Syndesis, component string, step remediationStep) error {
+	list := step.newList()
+	listOptions := client.ListOptions{Namespace: syndesis.Namespace}
+	if err := listOptions.SetLabelSelector("syndesis.io/app=syndesis,syndesis.io/component=" + component); err != nil {
+		return err
+	}
+	if err := cl.List(ctx, &listOptions, list); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		res, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		// Pods belong to their replication controller, anything else must have been rendered by the operator
+		if _, pod := item.(*corev1.Pod); !pod && !ownedBy(res, syndesis) {
+			continue
+		}
+		if err := cl.Delete(ctx, item); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func findRemediation(remediations []v1alpha1.SyndesisRemediation, component string) *v1alpha1.SyndesisRemediation {
+	for i := range remediations {
+		if remediations[i].Component == component {
+			return &remediations[i]
+		}
+	}
+	return nil
+}
+
+func ownedBy(res metav1.Object, syndesis *v1alpha1.Syndesis) bool {
+	for _, owner := range res.GetOwnerReferences() {
+		if owner.UID == syndesis.UID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func crashLoopingPod(name string, component string, restarts int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "syndesis",
+			Labels:    map[string]string{"syndesis.io/app": "syndesis", "syndesis.io/component": component},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:                 component,
+				RestartCount:         restarts,
+				State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "cannot connect to syndesis-db"}},
+			}},
+		},
+	}
+}
+
+func Test_crashLoopingComponents(t *testing.T) {
+	pods := []corev1.Pod{
+		*crashLoopingPod("syndesis-server-1-abcde", "syndesis-server", 6),
+		*crashLoopingPod("syndesis-meta-1-abcde", "syndesis-meta", 2),
+	}
+
+	crashing := crashLoopingComponents(pods, 5)
+	assert.Equal(t, map[string]string{
+		"syndesis-server": "container syndesis-server of pod syndesis-server-1-abcde restarted 6 times, last exit code 1 (Error): cannot connect to syndesis-db",
+	}, crashing)
+}
+
+func TestRemediationAction(t *testing.T) {
+	defer func(config string) { configuration.TemplateConfig = config }(configuration.TemplateConfig)
+	configuration.TemplateConfig = "../../../build/conf/config.yaml"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, apis.AddToScheme(scheme))
+
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "syndesis-uid"},
+		Spec: v1alpha1.SyndesisSpec{
+			Remediation: v1alpha1.RemediationConfiguration{Enabled: true, Interval: "0s"},
+		},
+		Status: v1alpha1.SyndesisStatus{Phase: v1alpha1.SyndesisPhaseInstalled},
+	}
+	owner := []metav1.OwnerReference{{APIVersion: "syndesis.io/v1alpha1", Kind: "Syndesis", Name: "app", UID: "syndesis-uid"}}
+	config := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:            "syndesis-server-config",
+		Namespace:       "syndesis",
+		Labels:          map[string]string{"syndesis.io/app": "syndesis", "syndesis.io/component": "syndesis-server"},
+		OwnerReferences: owner,
+	}}
+	unowned := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "syndesis-server-custom",
+		Namespace: "syndesis",
+		Labels:    map[string]string{"syndesis.io/app": "syndesis", "syndesis.io/component": "syndesis-server"},
+	}}
+	cl := fake.NewFakeClientWithScheme(scheme, syndesis, config, unowned, crashLoopingPod("syndesis-server-1-abcde", "syndesis-server", 6))
+	a := &remediationAction{baseAction{log: actionLog, client: cl, scheme: scheme}}
+	assert.True(t, a.CanExecute(syndesis))
+
+	reconcile := func() *v1alpha1.Syndesis {
+		current := &v1alpha1.Syndesis{}
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "app"}, current))
+		require.NoError(t, a.Execute(context.TODO(), current))
+		updated := &v1alpha1.Syndesis{}
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "app"}, updated))
+		return updated
+	}
+
+	// The crash looping pod gets restarted
+	current := reconcile()
+	require.Len(t, current.Status.Remediations, 1)
+	assert.Equal(t, "Restart", current.Status.Remediations[0].LastAction)
+	assert.True(t, k8serrors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-server-1-abcde"}, &corev1.Pod{})))
+
+	// The new pod keeps crashing, its generated configuration is dropped
+	require.NoError(t, cl.Create(context.TODO(), crashLoopingPod("syndesis-server-1-fghij", "syndesis-server", 7)))
+	current = reconcile()
+	assert.Equal(t, "RerenderConfig", current.Status.Remediations[0].LastAction)
+	assert.True(t, k8serrors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-server-config"}, &corev1.ConfigMap{})))
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-server-custom"}, &corev1.ConfigMap{}))
+
+	// Then the deployment, and once all the remediations failed the resource is degraded
+	reconcile()
+	current = reconcile()
+	assert.EqualValues(t, 3, current.Status.Remediations[0].Attempts)
+	degraded := getCondition(&current.Status, v1alpha1.SyndesisConditionDegraded)
+	require.NotNil(t, degraded)
+	assert.Equal(t, corev1.ConditionTrue, degraded.Status)
+	assert.Contains(t, degraded.Message, "syndesis-server: container syndesis-server of pod syndesis-server-1-fghij restarted 7 times")
+
+	// Recovery clears the remediations and the degraded condition
+	require.NoError(t, cl.Delete(context.TODO(), crashLoopingPod("syndesis-server-1-fghij", "syndesis-server", 7)))
+	current = reconcile()
+	assert.Empty(t, current.Status.Remediations)
+	assert.Equal(t, corev1.ConditionFalse, getCondition(&current.Status, v1alpha1.SyndesisConditionDegraded).Status)
+}
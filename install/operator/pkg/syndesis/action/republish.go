@@ -0,0 +1,337 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Annotation whose changes republish the published integrations, e.g. set to the date of an infrastructure change
+const RepublishIntegrationsAnnotation = "syndesis.io/republish-integrations"
+
+// Integrations listed per page of the syndesis-server API
+const republishPageSize = 100
+
+// Republishes the published integrations in batches once the annotation changes, or the S2I image or the maven
+// repositories they are built with when automatic, so that their builds don't all run at once. A batch starts
+// once the previous one is published and the delay is over; the republication is aborted when too many
+// integrations fail. Its progress is kept in the status, a restarted operator carries on from there.
+type republishAction struct {
+	baseAction
+}
+
+func newRepublishAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &republishAction{
+		newBaseAction(mgr, api, "republish"),
+	}
+}
+
+func (a *republishAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled) &&
+		syndesis.Spec.InstallMode != v1alpha1.SyndesisInstallModeInfrastructureOnly &&
+		!syndesis.Spec.Standby.Enabled
+}
+
+func (a *republishAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+
+	target := syndesis.DeepCopy()
+	republisher := &republisher{
+		serverURL: serverURL(syndesis),
+		token:     a.mgr.GetConfig().BearerToken,
+		config:    config,
+		now:       time.Now(),
+	}
+	status, err := republisher.reconcile(ctx, syndesis.Status.Republish, syndesis.Annotations[RepublishIntegrationsAnnotation])
+	if status != nil && !reflect.DeepEqual(status, syndesis.Status.Republish) {
+		previous := syndesis.Status.Republish
+		target.Status.Republish = status
+		if updateErr := a.client.Update(ctx, target); updateErr != nil {
+			return updateErr
+		}
+		a.reportProgress(ctx, syndesis, previous, status)
+	}
+	return err
+}
+
+// Logs the start and the end of the republication, and notifies when it gets aborted
+func (a *republishAction) reportProgress(ctx context.Context, syndesis *v1alpha1.Syndesis, previous *v1alpha1.IntegrationRepublishStatus, status *v1alpha1.IntegrationRepublishStatus) {
+	if previous != nil && previous.Phase == status.Phase && previous.StartedAt.Equal(status.StartedAt) {
+		return
+	}
+	switch status.Phase {
+	case v1alpha1.IntegrationRepublishPhaseRunning:
+		a.log.Info("Republishing integrations", "name", syndesis.Name, "reason", status.Reason, "integrations", len(status.Pending), "skipped", len(status.Skipped))
+	case v1alpha1.IntegrationRepublishPhaseCompleted:
+		a.log.Info("Integrations republished", "name", syndesis.Name, "republished", status.Republished, "failed", len(status.Failed))
+	case v1alpha1.IntegrationRepublishPhaseAborted:
+		message := fmt.Sprintf("The republication of the integrations of %s was aborted after %d failures, %d integrations are left: %s",
+			syndesis.Name, len(status.Failed), len(status.Pending), strings.Join(status.Failed, ", "))
+		a.log.Info("Integrations republish aborted", "name", syndesis.Name, "failed", status.Failed, "pending", len(status.Pending))
+		a.notify(ctx, syndesis, "Integrations republish aborted", message)
+	}
+}
+
+// Moves the republication of the integrations forward, from the status of the syndesis resource
+type republisher struct {
+	serverURL string
+	token     string
+	config    *configuration.Config
+	now       time.Time
+}
+
+// Returns the status of the republication once moved forward, the status is returned together with an error
+// when the progress made before the error needs to be recorded
+func (r *republisher) reconcile(ctx context.Context, current *v1alpha1.IntegrationRepublishStatus, annotation string) (*v1alpha1.IntegrationRepublishStatus, error) {
+	fingerprint := r.config.IntegrationBuildFingerprint()
+	// Fresh installations have nothing to republish
+	if current == nil {
+		return &v1alpha1.IntegrationRepublishStatus{Fingerprint: fingerprint, Annotation: annotation}, nil
+	}
+
+	status := current.DeepCopy()
+	switch {
+	case annotation != "" && annotation != status.Annotation:
+		status.Annotation = annotation
+		status.Fingerprint = fingerprint
+		if status.Phase == v1alpha1.IntegrationRepublishPhaseAborted {
+			r.resume(status, "annotation "+annotation)
+		} else if err := r.start(ctx, status, "annotation "+annotation); err != nil {
+			return current, err
+		}
+	case fingerprint != status.Fingerprint:
+		status.Fingerprint = fingerprint
+		if r.config.Syndesis.Integration.Republish.Automatic {
+			if err := r.start(ctx, status, "configuration"); err != nil {
+				return current, err
+			}
+		}
+	}
+
+	if status.Phase != v1alpha1.IntegrationRepublishPhaseRunning {
+		return status, nil
+	}
+	return status, r.step(ctx, status)
+}
+
+// Starts over with the published integrations, the ones with unpublished changes are skipped
+func (r *republisher) start(ctx context.Context, status *v1alpha1.IntegrationRepublishStatus, reason string) error {
+	integrations, err := listIntegrations(ctx, r.serverURL, r.token)
+	if err != nil {
+		return err
+	}
+
+	started := metav1.NewTime(r.now)
+	*status = v1alpha1.IntegrationRepublishStatus{
+		Phase:       v1alpha1.IntegrationRepublishPhaseRunning,
+		Reason:      reason,
+		Fingerprint: status.Fingerprint,
+		Annotation:  status.Annotation,
+		StartedAt:   &started,
+	}
+	for _, integration := range integrations {
+		if integration.TargetState != "Published" {
+			continue
+		}
+		if integration.Draft {
+			status.Skipped = append(status.Skipped, integration.ID)
+			continue
+		}
+		status.Pending = append(status.Pending, integration.ID)
+	}
+	status.Total = len(status.Pending)
+	return nil
+}
+
+// Carries on with the integrations an aborted republication did not publish, the failed ones first
+func (r *republisher) resume(status *v1alpha1.IntegrationRepublishStatus, reason string) {
+	pending := append([]string{}, status.Failed...)
+	for _, deployment := range status.Batch {
+		pending = append(pending, deploymentIntegration(deployment))
+	}
+	status.Pending = append(pending, status.Pending...)
+	status.Batch = nil
+	status.Failed = nil
+	status.CompletedAt = nil
+	status.Phase = v1alpha1.IntegrationRepublishPhaseRunning
+	status.Reason = reason
+}
+
+// Settles the current batch, then starts the next one once the delay is over
+func (r *republisher) step(ctx context.Context, status *v1alpha1.IntegrationRepublishStatus) error {
+	republish := r.config.Syndesis.Integration.Republish
+	delay, err := r.config.RepublishDelay()
+	if err != nil {
+		return err
+	}
+	timeout, err := r.config.RepublishTimeout()
+	if err != nil {
+		return err
+	}
+
+	if len(status.Batch) > 0 {
+		if err := r.settleBatch(ctx, status, timeout); err != nil {
+			return err
+		}
+		if len(status.Batch) > 0 {
+			return nil
+		}
+	}
+
+	completed := metav1.NewTime(r.now)
+	if len(status.Failed) >= republish.FailureThreshold {
+		status.Phase = v1alpha1.IntegrationRepublishPhaseAborted
+		status.CompletedAt = &completed
+		return nil
+	}
+	if len(status.Pending) == 0 {
+		status.Phase = v1alpha1.IntegrationRepublishPhaseCompleted
+		status.CompletedAt = &completed
+		return nil
+	}
+	if status.LastBatchAt != nil && r.now.Before(status.LastBatchAt.Add(delay)) {
+		return nil
+	}
+
+	batchStarted := metav1.NewTime(r.now)
+	status.LastBatchAt = &batchStarted
+	for len(status.Pending) > 0 && len(status.Batch) < republish.BatchSize {
+		id := status.Pending[0]
+		status.Pending = status.Pending[1:]
+		version, err := deployIntegration(ctx, r.serverURL, r.token, id)
+		if err != nil {
+			status.Failed = append(status.Failed, id)
+			if len(status.Failed) >= republish.FailureThreshold {
+				status.Phase = v1alpha1.IntegrationRepublishPhaseAborted
+				status.CompletedAt = &completed
+				return nil
+			}
+			continue
+		}
+		status.Batch = append(status.Batch, id+":"+strconv.Itoa(version))
+	}
+	return nil
+}
+
+// Removes the published deployments from the batch, and the ones that failed or are still not published after the timeout
+func (r *republisher) settleBatch(ctx context.Context, status *v1alpha1.IntegrationRepublishStatus, timeout time.Duration) error {
+	timedOut := status.LastBatchAt == nil || r.now.After(status.LastBatchAt.Add(timeout))
+	batch := []string{}
+	for _, deployment := range status.Batch {
+		state, err := deploymentState(ctx, r.serverURL, r.token, deployment)
+		if err != nil {
+			return err
+		}
+		switch {
+		case state.CurrentState == "Published":
+			status.Republished++
+		case state.TargetState != "Published":
+			// Unpublished, deleted or published again in the meantime
+		case state.CurrentState == "Error" || timedOut:
+			status.Failed = append(status.Failed, deploymentIntegration(deployment))
+		default:
+			batch = append(batch, deployment)
+		}
+	}
+	status.Batch = batch
+	return nil
+}
+
+// Id of the integration of a deployment of the batch
+func deploymentIntegration(deployment string) string {
+	if i := strings.LastIndex(deployment, ":"); i >= 0 {
+		return deployment[:i]
+	}
+	return deployment
+}
+
+// The state of an integration or of one of its deployments in the syndesis-server API
+type integrationState struct {
+	ID           string `json:"id"`
+	Draft        bool   `json:"draft"`
+	CurrentState string `json:"currentState"`
+	TargetState  string `json:"targetState"`
+}
+
+// Lists the integrations with the syndesis-server API
+func listIntegrations(ctx context.Context, serverURL string, token string) ([]integrationState, error) {
+	integrations := []integrationState{}
+	for page := 1; ; page++ {
+		res, err := callServer(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/integrations?page=%d&per_page=%d", serverURL, page, republishPageSize), token, nil)
+		if err != nil {
+			return nil, err
+		}
+		list := struct {
+			Items []integrationState `json:"items"`
+		}{}
+		if res.StatusCode >= 300 {
+			res.Body.Close()
+			return nil, fmt.Errorf("cannot list the integrations, syndesis-server answered with status %s", res.Status)
+		}
+		err = json.NewDecoder(res.Body).Decode(&list)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		integrations = append(integrations, list.Items...)
+		if len(list.Items) < republishPageSize {
+			return integrations, nil
+		}
+	}
+}
+
+// Publishes the integration again, returning the version of the new deployment
+func deployIntegration(ctx context.Context, serverURL string, token string, id string) (int, error) {
+	res, err := callServer(ctx, http.MethodPut, serverURL+"/api/v1/integrations/"+url.PathEscape(id)+"/deployments", token, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return 0, fmt.Errorf("cannot publish integration %s, syndesis-server answered with status %s", id, res.Status)
+	}
+	deployment := struct {
+		Version int `json:"version"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&deployment); err != nil {
+		return 0, err
+	}
+	return deployment.Version, nil
+}
+
+// Reads the state of a deployment of the batch, a deleted one being reported as no longer targeting publication
+func deploymentState(ctx context.Context, serverURL string, token string, deployment string) (integrationState, error) {
+	id := deploymentIntegration(deployment)
+	version := strings.TrimPrefix(deployment, id+":")
+	state := integrationState{}
+	res, err := callServer(ctx, http.MethodGet, serverURL+"/api/v1/integrations/"+url.PathEscape(id)+"/deployments/"+url.PathEscape(version), token, nil)
+	if err != nil {
+		return state, err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return state, nil
+	case res.StatusCode >= 300:
+		return state, fmt.Errorf("cannot read deployment %s, syndesis-server answered with status %s", deployment, res.Status)
+	}
+	return state, json.NewDecoder(res.Body).Decode(&state)
+}
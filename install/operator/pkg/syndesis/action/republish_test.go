@@ -0,0 +1,141 @@
+package action
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func Test_republisher(t *testing.T) {
+	// Current state of the deployments the republication created, by integration
+	states := map[string]string{}
+	deployed := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/integrations":
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+			w.Write([]byte(`{"items": [
+				{"id": "i-1", "currentState": "Published", "targetState": "Published"},
+				{"id": "i-2", "currentState": "Error", "targetState": "Published"},
+				{"id": "i-3", "currentState": "Published", "targetState": "Published"},
+				{"id": "i-4", "currentState": "Published", "targetState": "Published", "draft": true},
+				{"id": "i-5", "currentState": "Unpublished", "targetState": "Unpublished"}
+			], "totalCount": 5}`))
+		case r.Method == http.MethodPut:
+			id := strings.Split(r.URL.Path, "/")[4]
+			assert.Equal(t, "/api/v1/integrations/"+id+"/deployments", r.URL.Path)
+			if id == "i-3" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			deployed = append(deployed, id)
+			states[id] = "Pending"
+			w.Write([]byte(`{"id": "` + id + `:7", "version": 7}`))
+		default:
+			id := strings.Split(r.URL.Path, "/")[4]
+			assert.Equal(t, "/api/v1/integrations/"+id+"/deployments/7", r.URL.Path)
+			w.Write([]byte(`{"currentState": "` + states[id] + `", "targetState": "Published"}`))
+		}
+	}))
+	defer server.Close()
+
+	config, err := configuration.GetProperties("../../../build/conf/config.yaml", context.TODO(), nil, &v1alpha1.Syndesis{})
+	require.NoError(t, err)
+	config.Syndesis.Integration.Republish = configuration.IntegrationRepublishConfiguration{
+		BatchSize: 2, Delay: "1m", Timeout: "10m", FailureThreshold: 2,
+	}
+	start := time.Now()
+	r := &republisher{serverURL: server.URL, token: "token", config: config, now: start}
+
+	// Fresh installations only record what the integrations are built with
+	status, err := r.reconcile(context.TODO(), nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.IntegrationRepublishStatus{Fingerprint: config.IntegrationBuildFingerprint()}, status)
+	status, err = r.reconcile(context.TODO(), status, "")
+	require.NoError(t, err)
+	assert.Empty(t, status.Phase)
+
+	// The first batch
+	status, err = r.reconcile(context.TODO(), status, "2020-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.IntegrationRepublishPhaseRunning, status.Phase)
+	assert.Equal(t, "annotation 2020-06-01", status.Reason)
+	assert.Equal(t, 3, status.Total)
+	assert.Equal(t, []string{"i-1:7", "i-2:7"}, status.Batch)
+	assert.Equal(t, []string{"i-3"}, status.Pending)
+	assert.Equal(t, []string{"i-4"}, status.Skipped)
+	assert.Equal(t, []string{"i-1", "i-2"}, deployed)
+
+	// The next batch waits for the current one to be published
+	states["i-1"] = "Published"
+	r.now = start.Add(2 * time.Minute)
+	status, err = r.reconcile(context.TODO(), status, "2020-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Republished)
+	assert.Equal(t, []string{"i-2:7"}, status.Batch)
+	assert.Equal(t, []string{"i-3"}, status.Pending)
+
+	// Too many failures abort the republication
+	states["i-2"] = "Error"
+	r.now = start.Add(3 * time.Minute)
+	status, err = r.reconcile(context.TODO(), status, "2020-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.IntegrationRepublishPhaseAborted, status.Phase)
+	assert.Equal(t, []string{"i-2", "i-3"}, status.Failed)
+	assert.Empty(t, status.Pending)
+	assert.NotNil(t, status.CompletedAt)
+
+	// A new value of the annotation resumes with the failed integrations
+	r.now = start.Add(5 * time.Minute)
+	status, err = r.reconcile(context.TODO(), status, "2020-06-02")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.IntegrationRepublishPhaseRunning, status.Phase)
+	assert.Equal(t, []string{"i-2:7"}, status.Batch)
+	assert.Equal(t, []string{"i-3"}, status.Failed)
+	assert.Equal(t, []string{"i-1", "i-2", "i-2"}, deployed)
+
+	// Integrations still not published after the timeout fail
+	r.now = start.Add(16 * time.Minute)
+	status, err = r.reconcile(context.TODO(), status, "2020-06-02")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.IntegrationRepublishPhaseAborted, status.Phase)
+	assert.Equal(t, []string{"i-3", "i-2"}, status.Failed)
+}
+
+func Test_republisher_automatic(t *testing.T) {
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listed++
+		w.Write([]byte(`{"items": [], "totalCount": 0}`))
+	}))
+	defer server.Close()
+
+	config, err := configuration.GetProperties("../../../build/conf/config.yaml", context.TODO(), nil, &v1alpha1.Syndesis{})
+	require.NoError(t, err)
+	r := &republisher{serverURL: server.URL, token: "token", config: config, now: time.Now()}
+	status := &v1alpha1.IntegrationRepublishStatus{Fingerprint: config.IntegrationBuildFingerprint()}
+
+	// Changes of the maven repositories are only recorded unless automatic
+	config.Syndesis.Components.Server.Features.MavenRepositories = map[string]string{"corp": "https://maven.corp.example.com"}
+	status, err = r.reconcile(context.TODO(), status, "")
+	require.NoError(t, err)
+	assert.Empty(t, status.Phase)
+	assert.Equal(t, config.IntegrationBuildFingerprint(), status.Fingerprint)
+	assert.Equal(t, 0, listed)
+
+	config.Syndesis.Integration.Republish.Automatic = true
+	config.Syndesis.Components.S2I.Image = "docker.io/syndesis/syndesis-s2i:1.10"
+	status, err = r.reconcile(context.TODO(), status, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, listed)
+	assert.Equal(t, "configuration", status.Reason)
+	assert.Equal(t, v1alpha1.IntegrationRepublishPhaseCompleted, status.Phase)
+}
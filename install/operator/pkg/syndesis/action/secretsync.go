@@ -0,0 +1,83 @@
+package action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Keeps a copy of the secrets selected by integration.secretSync in the integration namespaces it lists, the way
+// the pull secret is copied. Copies that are no longer wanted are removed, the remaining ones are returned
+// as namespace/name.
+func syncIntegrationSecrets(api kubernetes.Interface, syndesis *v1alpha1.Syndesis, config *configuration.Config) ([]string, error) {
+	namespaces, err := secretSyncNamespaces(syndesis, config.Syndesis.Integration.SecretSync)
+	if err != nil {
+		return nil, err
+	}
+
+	var synced []string
+	kept := map[string]bool{}
+	if selector := config.Syndesis.Integration.SecretSync.Selector; selector != "" && len(namespaces) > 0 {
+		secrets, err := api.CoreV1().Secrets(syndesis.Namespace).List(metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for i := range secrets.Items {
+			source := &secrets.Items[i]
+			// Already kept in the integration namespaces, along with the service accounts it is linked to
+			if source.Name == SyndesisPullSecret {
+				continue
+			}
+			for _, namespace := range namespaces {
+				if err := syncSecretCopy(api, secretCopy(source, namespace)); err != nil {
+					return nil, err
+				}
+				synced = append(synced, namespace+"/"+source.Name)
+				kept[namespace+"/"+source.Name] = true
+			}
+		}
+	}
+
+	for _, copied := range syndesis.Status.SyncedSecrets {
+		if kept[copied] {
+			continue
+		}
+		parts := strings.SplitN(copied, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if err := removeSecretCopy(api, syndesis, parts[0], parts[1]); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(synced)
+	return synced, nil
+}
+
+// Namespaces the secrets are copied to, which have to be integration namespaces
+func secretSyncNamespaces(syndesis *v1alpha1.Syndesis, sync configuration.SecretSyncConfiguration) ([]string, error) {
+	integrationNamespaces := map[string]bool{}
+	var all []string
+	for _, namespace := range syndesis.Spec.IntegrationNamespaces {
+		if namespace == syndesis.Namespace {
+			continue
+		}
+		integrationNamespaces[namespace] = true
+		all = append(all, namespace)
+	}
+	if len(sync.Namespaces) == 0 {
+		return all, nil
+	}
+
+	for _, namespace := range sync.Namespaces {
+		if !integrationNamespaces[namespace] {
+			return nil, fmt.Errorf("secrets cannot be synced to namespace %s, it is not one of the integration namespaces", namespace)
+		}
+	}
+	return sync.Namespaces, nil
+}
@@ -0,0 +1,28 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_secretSyncNamespaces(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"},
+		Spec:       v1alpha1.SyndesisSpec{IntegrationNamespaces: []string{"syndesis", "team-a", "team-b"}},
+	}
+
+	namespaces, err := secretSyncNamespaces(syndesis, configuration.SecretSyncConfiguration{Selector: "syndesis.io/sync=true"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team-a", "team-b"}, namespaces)
+
+	namespaces, err = secretSyncNamespaces(syndesis, configuration.SecretSyncConfiguration{Namespaces: []string{"team-b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team-b"}, namespaces)
+
+	_, err = secretSyncNamespaces(syndesis, configuration.SecretSyncConfiguration{Namespaces: []string{"kube-system"}})
+	assert.EqualError(t, err, "secrets cannot be synced to namespace kube-system, it is not one of the integration namespaces")
+}
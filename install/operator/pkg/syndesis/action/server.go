@@ -0,0 +1,39 @@
+package action
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+// User the operator calls to the syndesis-server API are made on behalf of
+const operatorUser = "syndesis-operator"
+
+var serverClient = &http.Client{Timeout: 30 * time.Second}
+
+// Address of the syndesis-server API inside the cluster, bypassing the oauth proxy
+func serverURL(syndesis *v1alpha1.Syndesis) string {
+	return "http://syndesis-server." + syndesis.Namespace + ".svc"
+}
+
+// Calls the syndesis-server API with the headers the oauth proxy would have set, sending a JSON body if any
+func callServer(ctx context.Context, method string, url string, token string, body io.Reader) (*http.Response, error) {
+	return callServerWithContent(ctx, method, url, token, "application/json", body)
+}
+
+func callServerWithContent(ctx context.Context, method string, url string, token string, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("X-Forwarded-User", operatorUser)
+	req.Header.Set("X-Forwarded-Access-Token", token)
+
+	return serverClient.Do(req.WithContext(ctx))
+}
@@ -0,0 +1,99 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	serverConfigMap = "syndesis-server-config"
+	serverConfigKey = "application.yml"
+)
+
+// Merges the application.yml of the ConfigMap named by spec.components.server.configOverride into
+// the generated server configuration, for the settings the custom resource doesn't model. The values
+// the override replaces, and an override that cannot be used, are returned as warnings.
+func applyServerConfigOverride(ctx context.Context, cl client.Client, namespace string, name string, resources []unstructured.Unstructured) ([]string, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	override := corev1.ConfigMap{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &override); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return []string{fmt.Sprintf("server configuration override %s not found", name)}, nil
+		}
+		return nil, err
+	}
+	overrideConfig := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(override.Data[serverConfigKey]), &overrideConfig); err != nil {
+		return []string{fmt.Sprintf("server configuration override %s is not valid: %v", name, err)}, nil
+	}
+	if len(overrideConfig) == 0 {
+		return []string{fmt.Sprintf("server configuration override %s has no %s", name, serverConfigKey)}, nil
+	}
+
+	for _, res := range resources {
+		if res.GetKind() != "ConfigMap" || res.GetName() != serverConfigMap {
+			continue
+		}
+
+		generated, _, err := unstructured.NestedString(res.Object, "data", serverConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		config := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(generated), &config); err != nil {
+			return nil, err
+		}
+
+		var warnings []string
+		for _, key := range mergeConfig(config, overrideConfig, "") {
+			warnings = append(warnings, fmt.Sprintf("server configuration override %s replaces the generated %s", name, key))
+		}
+
+		merged, err := yaml.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		return warnings, unstructured.SetNestedField(res.Object, string(merged), "data", serverConfigKey)
+	}
+	return nil, nil
+}
+
+// Deep merges override into base, returning the keys whose value got replaced
+func mergeConfig(base map[string]interface{}, override map[string]interface{}, path string) []string {
+	var conflicts []string
+	for key, value := range override {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		existing, found := base[key]
+		existingMap, existingIsMap := existing.(map[string]interface{})
+		valueMap, valueIsMap := value.(map[string]interface{})
+		switch {
+		case !found:
+			base[key] = value
+		case existingIsMap && valueIsMap:
+			conflicts = append(conflicts, mergeConfig(existingMap, valueMap, keyPath)...)
+		default:
+			if !reflect.DeepEqual(existing, value) {
+				conflicts = append(conflicts, keyPath)
+			}
+			base[key] = value
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
@@ -0,0 +1,59 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_mergeConfig(t *testing.T) {
+	base := map[string]interface{}{
+		"cache": map[string]interface{}{"max": map[string]interface{}{"entries": 100}},
+		"spring": map[string]interface{}{
+			"zipkin": map[string]interface{}{"enabled": false},
+		},
+	}
+	override := map[string]interface{}{
+		"cache": map[string]interface{}{"max": map[string]interface{}{"entries": 500}},
+		"spring": map[string]interface{}{
+			"zipkin":     map[string]interface{}{"enabled": false},
+			"datasource": map[string]interface{}{"hikari": map[string]interface{}{"maximum-pool-size": 20}},
+		},
+	}
+
+	conflicts := mergeConfig(base, override, "")
+
+	assert.Equal(t, []string{"cache.max.entries"}, conflicts)
+	assert.Equal(t, map[string]interface{}{
+		"cache": map[string]interface{}{"max": map[string]interface{}{"entries": 500}},
+		"spring": map[string]interface{}{
+			"zipkin":     map[string]interface{}{"enabled": false},
+			"datasource": map[string]interface{}{"hikari": map[string]interface{}{"maximum-pool-size": 20}},
+		},
+	}, base)
+}
+
+func Test_applyServerConfigOverride(t *testing.T) {
+	generated := resourceOfKind("ConfigMap", serverConfigMap)
+	assert.NoError(t, unstructured.SetNestedField(generated.Object, "cache:\n  max:\n    entries: 100\n", "data", serverConfigKey))
+	override := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-override", Namespace: "syndesis"},
+		Data:       map[string]string{serverConfigKey: "cache:\n  max:\n    entries: 500\nfoo: bar\n"},
+	}
+	cl := fake.NewFakeClient(override)
+
+	warnings, err := applyServerConfigOverride(context.TODO(), cl, "syndesis", "my-override", []unstructured.Unstructured{generated})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"server configuration override my-override replaces the generated cache.max.entries"}, warnings)
+	merged, _, _ := unstructured.NestedString(generated.Object, "data", serverConfigKey)
+	assert.Equal(t, "cache:\n  max:\n    entries: 500\nfoo: bar\n", merged)
+
+	warnings, err = applyServerConfigOverride(context.TODO(), cl, "syndesis", "missing", []unstructured.Unstructured{generated})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"server configuration override missing not found"}, warnings)
+}
@@ -0,0 +1,114 @@
+package action
+
+import (
+	"context"
+	"errors"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const smokeTestJobName = "syndesis-smoke-test"
+
+// Outcome of the smoke test job
+type smokeTestResult int
+
+const (
+	smokeTestRunning smokeTestResult = iota
+	smokeTestPassed
+	smokeTestFailed
+)
+
+// Runs the smoke test of a syndesis resource that opted in, true is returned once it passed. The job of a
+// passed test is deleted, so that the next install or upgrade runs it again, the one of a failed test is kept
+// for its logs and deleting it runs the test again.
+func (a *startupAction) smokeTest(ctx context.Context, syndesis *v1alpha1.Syndesis) (bool, error) {
+	// A standby has no server running the test against
+	if !syndesis.Spec.SmokeTest.Enabled || syndesis.Spec.Standby.Enabled {
+		return true, nil
+	}
+
+	job := &batchv1.Job{}
+	err := a.client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: smokeTestJobName}, job)
+	if k8serrors.IsNotFound(err) {
+		if err := a.startSmokeTest(ctx, syndesis); err != nil {
+			return false, err
+		}
+		target := syndesis.DeepCopy()
+		if setCondition(&target.Status, v1alpha1.SyndesisConditionReady, corev1.ConditionFalse, "SmokeTesting", "Waiting for the smoke test to pass") {
+			return false, a.client.Update(ctx, target)
+		}
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	switch smokeTestResultOf(job) {
+	case smokeTestPassed:
+		a.log.Info("Smoke test of Syndesis resource passed", "name", syndesis.Name)
+		err := a.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		return true, nil
+	case smokeTestFailed:
+		if syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseStartupFailed) && syndesis.Status.Reason == v1alpha1.SyndesisStatusReasonSmokeTestFailed {
+			return false, nil
+		}
+		a.log.Info("Smoke test of Syndesis resource failed", "name", syndesis.Name, "job", job.Name)
+		target := syndesis.DeepCopy()
+		target.Status.Phase = v1alpha1.SyndesisPhaseStartupFailed
+		target.Status.Reason = v1alpha1.SyndesisStatusReasonSmokeTestFailed
+		target.Status.Description = "The smoke test failed, see the logs of the " + job.Name + " job and delete it to run the test again"
+		setCondition(&target.Status, v1alpha1.SyndesisConditionReady, corev1.ConditionFalse, string(v1alpha1.SyndesisStatusReasonSmokeTestFailed), target.Status.Description)
+		if err := a.client.Update(ctx, target); err != nil {
+			return false, err
+		}
+		a.notify(ctx, syndesis, "Smoke test failed", "The smoke test of "+syndesis.Name+" failed: "+target.Status.Description)
+		return false, nil
+	default:
+		a.log.V(2).Info("Waiting for the smoke test of Syndesis resource", "name", syndesis.Name)
+		return false, nil
+	}
+}
+
+// Creates the smoke test job. It is owned by the syndesis resource but not labelled as such, the install
+// action would otherwise remove it as a resource its templates don't render.
+func (a *startupAction) startSmokeTest(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+	resources, err := generator.RenderDir("./smoketest/", config)
+	if err != nil {
+		return err
+	}
+	if len(resources) != 1 {
+		return errors.New("smoke test job not rendered")
+	}
+
+	job := resources[0]
+	job.SetNamespace(syndesis.Namespace)
+	job.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(syndesis, v1alpha1.SchemeGroupVersion.WithKind("Syndesis"))})
+	a.log.Info("Starting the smoke test of Syndesis resource", "name", syndesis.Name)
+	return a.client.Create(ctx, &job)
+}
+
+func smokeTestResultOf(job *batchv1.Job) smokeTestResult {
+	if job.Status.Succeeded > 0 {
+		return smokeTestPassed
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return smokeTestFailed
+		}
+	}
+	return smokeTestRunning
+}
@@ -0,0 +1,24 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_smokeTestResultOf(t *testing.T) {
+	job := &batchv1.Job{}
+	assert.Equal(t, smokeTestRunning, smokeTestResultOf(job))
+
+	job.Status.Failed = 1
+	assert.Equal(t, smokeTestRunning, smokeTestResultOf(job))
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	assert.Equal(t, smokeTestFailed, smokeTestResultOf(job))
+
+	job.Status.Conditions = nil
+	job.Status.Succeeded = 1
+	assert.Equal(t, smokeTestPassed, smokeTestResultOf(job))
+}
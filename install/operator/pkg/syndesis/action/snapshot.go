@@ -0,0 +1,40 @@
+package action
+
+import (
+	"context"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/snapshot"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Name of the snapshot of the database taken before upgrading to the version
+func preUpgradeSnapshotName(version string) string {
+	return "syndesis-db-pre-upgrade-" + strings.ToLower(version)
+}
+
+// Takes a snapshot of the database volume claim before it gets migrated, reporting whether the point in time
+// of the snapshot has been cut so that the upgrade can start. A failed snapshot is notified without blocking the upgrade.
+func (a *baseAction) snapshotDatabase(ctx context.Context, syndesis *v1alpha1.Syndesis, config *configuration.Config, version string) (bool, error) {
+	name := preUpgradeSnapshotName(version)
+	existing, err := snapshot.Get(ctx, a.client, syndesis.Namespace, name)
+	if k8serrors.IsNotFound(err) {
+		if _, err := snapshot.Create(ctx, a.client, syndesis.Namespace, name, snapshot.DatabaseClaim, config.Syndesis.Backup.SnapshotClass); err != nil {
+			return false, err
+		}
+		a.log.Info("Snapshotting the database before upgrading", "name", syndesis.Name, "snapshot", name)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := snapshot.Ready(existing); err != nil {
+		a.log.Error(err, "Cannot snapshot the database before upgrading", "name", syndesis.Name)
+		a.notify(ctx, syndesis, "Database snapshot failed", "The database of "+syndesis.Name+" could not be snapshotted before upgrading: "+err.Error())
+		return true, nil
+	}
+	return snapshot.Taken(existing), nil
+}
@@ -3,6 +3,7 @@ package action
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/openshift/api/apps/v1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -62,11 +63,30 @@ func (a *startupAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 		}
 	}
 
+	// Pods that can't pull their image never get ready, tell it instead of waiting for the startup to time out
+	var pullFailure error
+	if !ready {
+		pods := corev1.PodList{}
+		if err := a.client.List(ctx, &listOptions, &pods); err != nil {
+			return err
+		}
+		pullFailure = imagePullFailure(pods.Items)
+	}
+
 	if ready {
+		// Deployments being ready isn't enough when the resource asks for a smoke test
+		if passed, err := a.smokeTest(ctx, syndesis); err != nil || !passed {
+			return err
+		}
+
 		target := syndesis.DeepCopy()
 		target.Status.Phase = v1alpha1.SyndesisPhaseInstalled
 		target.Status.Reason = v1alpha1.SyndesisStatusReasonMissing
 		target.Status.Description = ""
+		setCondition(&target.Status, v1alpha1.SyndesisConditionReady, corev1.ConditionTrue, "DeploymentsReady", "")
+		if err := a.checkDependencies(ctx, target); err != nil {
+			return err
+		}
 		a.log.Info("Syndesis resource installed successfully", "name", syndesis.Name)
 		return a.client.Update(ctx, target)
 	} else if failedDeployment != nil {
@@ -74,16 +94,41 @@ func (a *startupAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 		target.Status.Phase = v1alpha1.SyndesisPhaseStartupFailed
 		target.Status.Reason = v1alpha1.SyndesisStatusReasonDeploymentNotReady
 		target.Status.Description = "Some Syndesis deployments failed to startup within the allowed time frame"
+		setCondition(&target.Status, v1alpha1.SyndesisConditionReady, corev1.ConditionFalse, string(v1alpha1.SyndesisStatusReasonDeploymentNotReady), "Deployment "+*failedDeployment+" failed to startup")
 		a.log.V(2).Info("Startup failed for Syndesis resource. Deployment not ready", "name", syndesis.Name, "deployment", *failedDeployment)
-		return a.client.Update(ctx, target)
+		if err := a.client.Update(ctx, target); err != nil {
+			return err
+		}
+		return pullFailure
 	} else {
 		target := syndesis.DeepCopy()
 		target.Status.Phase = v1alpha1.SyndesisPhaseStarting
 		target.Status.Reason = v1alpha1.SyndesisStatusReasonMissing
 		target.Status.Description = ""
+		setCondition(&target.Status, v1alpha1.SyndesisConditionReady, corev1.ConditionFalse, "Starting", "Waiting for the deployments to be ready")
 		a.log.V(2).Info("Waiting for Syndesis resource to startup", "name", syndesis.Name)
-		return a.client.Update(ctx, target)
+		if err := a.client.Update(ctx, target); err != nil {
+			return err
+		}
+		return pullFailure
+	}
+}
+
+// Returns an image pull error for the first container of the pods waiting for an image that cannot be pulled
+func imagePullFailure(pods []corev1.Pod) error {
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			waiting := status.State.Waiting
+			if waiting == nil {
+				continue
+			}
+			switch waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+				return newClassifiedError(ErrorClassImagePull, fmt.Errorf("container %s of pod %s cannot pull image %s: %s", status.Name, pod.Name, status.Image, waiting.Message))
+			}
+		}
 	}
+	return nil
 }
 
 func isProcessing(dc *v1.DeploymentConfig) bool {
@@ -0,0 +1,66 @@
+package action
+
+import (
+	"context"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/telemetry"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Periodically reports anonymous usage data of installed syndesis resources that opted in.
+type telemetryAction struct {
+	baseAction
+	lastReports map[types.UID]time.Time
+}
+
+func newTelemetryAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &telemetryAction{
+		newBaseAction(mgr, api, "telemetry"),
+		map[types.UID]time.Time{},
+	}
+}
+
+func (a *telemetryAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseInstalled) && syndesis.Spec.Telemetry.Enabled
+}
+
+// Reporting failures are only logged, telemetry must never get in the way of the reconciliation
+func (a *telemetryAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+	if err != nil {
+		return err
+	}
+
+	settings := config.Syndesis.Telemetry
+	if !settings.Enabled || settings.Endpoint == "" {
+		return nil
+	}
+
+	interval, err := time.ParseDuration(settings.Interval)
+	if err != nil {
+		a.log.Error(err, "Invalid telemetry interval", "name", syndesis.Name, "interval", settings.Interval)
+		return nil
+	}
+	if last, found := a.lastReports[syndesis.UID]; found && time.Since(last) < interval {
+		return nil
+	}
+	a.lastReports[syndesis.UID] = time.Now()
+
+	report, err := telemetry.Collect(ctx, a.client, a.api.Discovery(), config, pkg.DefaultOperatorTag, syndesis.Namespace)
+	if err != nil {
+		a.log.Error(err, "Unable to collect telemetry", "name", syndesis.Name)
+		return nil
+	}
+	if err := telemetry.Send(ctx, settings.Endpoint, report); err != nil {
+		a.log.Error(err, "Unable to send telemetry", "name", syndesis.Name)
+		return nil
+	}
+	a.log.V(2).Info("Telemetry reported", "name", syndesis.Name, "endpoint", settings.Endpoint)
+	return nil
+}
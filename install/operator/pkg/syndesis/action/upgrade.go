@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -71,8 +72,34 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 		// Upgrade pod not found or upgrade forced
 
 		if syndesis.Status.Version != targetVersion {
+			// Don't start an upgrade the cluster can't run
+			config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+			if err != nil {
+				return err
+			}
+			if compatible, err := a.checkCompatibility(ctx, syndesis, config); err != nil || !compatible {
+				return err
+			}
+
+			// Snapshot the database volume before it gets migrated, the upgrade waits for the snapshot to be cut
+			if config.SnapshotsDatabase() && syndesis.Status.UpgradeAttempts == 0 {
+				if taken, err := a.snapshotDatabase(ctx, syndesis, config, targetVersion); err != nil || !taken {
+					return err
+				}
+			}
+
 			a.log.Info("Upgrading syndesis resource ", "name", syndesis.Name, "currentVersion", syndesis.Status.Version, "targetVersion", targetVersion)
 
+			// Keep a copy of the integrations before the database gets migrated
+			if syndesis.Status.UpgradeAttempts == 0 {
+				if name, err := a.saveExport(ctx, syndesis, "upgrade"); err != nil {
+					a.log.Error(err, "Cannot export the integrations before upgrading", "name", syndesis.Name)
+					a.notify(ctx, syndesis, "Integrations export failed", "The integrations of "+syndesis.Name+" could not be exported before upgrading: "+err.Error())
+				} else {
+					a.log.Info("Integrations exported before upgrading", "name", syndesis.Name, "configmap", name)
+				}
+			}
+
 			for _, res := range resources {
 				operation.SetNamespaceAndOwnerReference(res, syndesis)
 
@@ -106,29 +133,118 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis
 
 			a.log.Info("Syndesis resource upgraded", "name", syndesis.Name, "targetVersion", targetVersion)
 			return a.completeUpgrade(ctx, syndesis, targetVersion)
-		} else if upgradePod.Status.Phase == v1.PodFailed {
+		}
+
+		config, err := configuration.GetProperties(configuration.TemplateConfig, ctx, a.client, syndesis)
+		if err != nil {
+			return err
+		}
+
+		if upgradePod.Status.Phase == v1.PodFailed {
 			// Upgrade failed
 			a.log.Error(nil, "Failure while upgrading Syndesis resource: upgrade pod failure", "name", syndesis.Name, "targetVersion", targetVersion)
+			return a.failUpgrade(ctx, syndesis, config, v1alpha1.SyndesisStatusReasonUpgradePodFailed,
+				"Syndesis upgrade from "+syndesis.Status.Version+" to "+targetVersion+" failed")
+		}
 
-			target := syndesis.DeepCopy()
-			target.Status.Phase = v1alpha1.SyndesisPhaseUpgradeFailureBackoff
-			target.Status.Reason = v1alpha1.SyndesisStatusReasonUpgradePodFailed
-			target.Status.Description = "Syndesis upgrade from " + syndesis.Status.Version + " to " + targetVersion + " failed (it will be retried again)"
-			target.Status.LastUpgradeFailure = &metav1.Time{
-				Time: time.Now(),
+		timeout, err := config.UpgradeTimeout()
+		if err != nil {
+			return err
+		}
+		if timeout > 0 && upgradeTimedOut(upgradePod, timeout, time.Now()) {
+			// Stuck upgrade, the pod is stopped and created again when the upgrade is retried. A quarantined
+			// upgrade keeps it running, for an administrator to look into what it is stuck on.
+			a.log.Error(nil, "Failure while upgrading Syndesis resource: upgrade pod timed out", "name", syndesis.Name, "targetVersion", targetVersion, "timeout", timeout.String())
+			if !config.QuarantinesFailedUpgrades() {
+				if err := a.client.Delete(ctx, upgradePod); err != nil && !k8serrors.IsNotFound(err) {
+					return err
+				}
 			}
-			target.Status.UpgradeAttempts = target.Status.UpgradeAttempts + 1
+			return a.failUpgrade(ctx, syndesis, config, v1alpha1.SyndesisStatusReasonUpgradeTimedOut,
+				"Syndesis upgrade from "+syndesis.Status.Version+" to "+targetVersion+" did not complete within "+timeout.String())
+		}
 
-			return a.client.Update(ctx, target)
-		} else {
-			// Still running
-			a.log.Info("Syndesis resource is currently being upgraded", "name", syndesis.Name, "targetVersion", targetVersion)
-			return nil
+		// Still running
+		a.log.Info("Syndesis resource is currently being upgraded", "name", syndesis.Name, "targetVersion", targetVersion)
+		return nil
+	}
+}
+
+// Moves to the backoff phase, the upgrade being retried later, or to the quarantine phase when the
+// failure policy says so. Nothing is touched in quarantine: the upgrade pod, its logs and the database
+// dump of the syndesis-upgrade volume claim are kept until an administrator acknowledges the failure.
+func (a *upgradeAction) failUpgrade(ctx context.Context, syndesis *v1alpha1.Syndesis, config *configuration.Config, reason v1alpha1.SyndesisStatusReason, description string) error {
+	target := syndesis.DeepCopy()
+	target.Status.Phase = v1alpha1.SyndesisPhaseUpgradeFailureBackoff
+	target.Status.Reason = reason
+	target.Status.Description = description + " (it will be retried again)"
+	target.Status.LastUpgradeFailure = &metav1.Time{
+		Time: time.Now(),
+	}
+	target.Status.UpgradeAttempts = target.Status.UpgradeAttempts + 1
+
+	if config.QuarantinesFailedUpgrades() {
+		target.Status.Phase = v1alpha1.SyndesisPhaseUpgradeQuarantined
+		target.Status.Description = description + " (quarantined until the " + AcknowledgeQuarantineAnnotation + " annotation is set)"
+		setCondition(&target.Status, v1alpha1.SyndesisConditionDegraded, v1.ConditionTrue, string(v1alpha1.SyndesisPhaseUpgradeQuarantined), description)
+	}
+
+	if err := a.client.Update(ctx, target); err != nil {
+		return err
+	}
+	a.notify(ctx, syndesis, "Upgrade failed", target.Status.Description)
+	return nil
+}
+
+// Whether the upgrade pod has been running longer than the timeout, counted from its start
+// or from its creation while it is not scheduled
+func upgradeTimedOut(pod *v1.Pod, timeout time.Duration, now time.Time) bool {
+	started := pod.CreationTimestamp.Time
+	if pod.Status.StartTime != nil {
+		started = pod.Status.StartTime.Time
+	}
+	return now.Sub(started) > timeout
+}
+
+// Service accounts of the previous versions the templates no longer render
+var obsoleteServiceAccounts = []string{
+	// Replaced by syndesis-db, the other components it served got their own service account
+	"syndesis-default",
+}
+
+// Removes what the previous versions installed and the upgraded templates no longer render. The install
+// action only prunes the resources labelled with their owner, which older installations didn't do, so
+// they are looked up by name. Only the ones of syndesis are removed, the names removed are returned.
+func removeObsoleteResources(ctx context.Context, cl client.Client, namespace string) ([]string, error) {
+	removed := []string{}
+	for _, name := range obsoleteServiceAccounts {
+		sa := &v1.ServiceAccount{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, sa); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if sa.Labels["syndesis.io/app"] != "syndesis" {
+			continue
 		}
+		if err := cl.Delete(ctx, sa); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		removed = append(removed, "ServiceAccount/"+name)
 	}
+	return removed, nil
 }
 
 func (a *upgradeAction) completeUpgrade(ctx context.Context, syndesis *v1alpha1.Syndesis, newVersion string) error {
+	removed, err := removeObsoleteResources(ctx, a.client, syndesis.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(removed) > 0 {
+		a.log.Info("Obsolete resources removed", "name", syndesis.Name, "resources", removed)
+	}
+
 	target := syndesis.DeepCopy()
 	target.Status.Phase = v1alpha1.SyndesisPhaseInstalled
 	target.Status.TargetVersion = ""
@@ -138,8 +254,16 @@ func (a *upgradeAction) completeUpgrade(ctx context.Context, syndesis *v1alpha1.
 	target.Status.LastUpgradeFailure = nil
 	target.Status.UpgradeAttempts = 0
 	target.Status.ForceUpgrade = false
+	// The upgraded installation goes through the smoke test before being declared ready again
+	if syndesis.Spec.SmokeTest.Enabled {
+		target.Status.Phase = v1alpha1.SyndesisPhaseStarting
+	}
 
-	return a.client.Update(ctx, target)
+	if err := a.client.Update(ctx, target); err != nil {
+		return err
+	}
+	a.notify(ctx, syndesis, "Upgrade completed", "Syndesis "+syndesis.Name+" has been upgraded to version "+newVersion)
+	return nil
 }
 
 func (a *upgradeAction) getUpgradeResources(scheme *runtime.Scheme, syndesis *v1alpha1.Syndesis) ([]runtime.Object, error) {
@@ -0,0 +1,55 @@
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_upgradeTimedOut(t *testing.T) {
+	created := time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+
+	assert.False(t, upgradeTimedOut(pod, time.Hour, created.Add(59*time.Minute)))
+	assert.True(t, upgradeTimedOut(pod, time.Hour, created.Add(61*time.Minute)))
+
+	started := metav1.NewTime(created.Add(30 * time.Minute))
+	pod.Status.StartTime = &started
+	assert.False(t, upgradeTimedOut(pod, time.Hour, created.Add(61*time.Minute)))
+	assert.True(t, upgradeTimedOut(pod, time.Hour, created.Add(91*time.Minute)))
+}
+
+func Test_removeObsoleteResources(t *testing.T) {
+	legacy := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+		Name:      "syndesis-default",
+		Namespace: "syndesis",
+		Labels:    map[string]string{"syndesis.io/app": "syndesis"},
+	}}
+	cl := fake.NewFakeClient(legacy)
+
+	removed, err := removeObsoleteResources(context.TODO(), cl, "syndesis")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ServiceAccount/syndesis-default"}, removed)
+	err = cl.Get(context.TODO(), client.ObjectKey{Namespace: "syndesis", Name: "syndesis-default"}, &corev1.ServiceAccount{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Nothing left to remove, and an account of the same name created by someone else is kept
+	removed, err = removeObsoleteResources(context.TODO(), cl, "syndesis")
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+
+	foreign := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "syndesis-default", Namespace: "other"}}
+	cl = fake.NewFakeClient(foreign)
+	removed, err = removeObsoleteResources(context.TODO(), cl, "other")
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+	assert.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: "other", Name: "syndesis-default"}, &corev1.ServiceAccount{}))
+}
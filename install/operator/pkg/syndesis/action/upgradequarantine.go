@@ -0,0 +1,50 @@
+package action
+
+import (
+	"context"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Setting this annotation releases a quarantined upgrade, which is then retried as after any other failure
+const AcknowledgeQuarantineAnnotation = "syndesis.io/acknowledge-quarantine"
+
+// Keeps a failed upgrade frozen until an administrator acknowledges it. No other action runs
+// in the quarantine phase, so the installation is left exactly as the failed upgrade left it.
+type upgradeQuarantineAction struct {
+	baseAction
+}
+
+func newUpgradeQuarantineAction(mgr manager.Manager, api kubernetes.Interface) SyndesisOperatorAction {
+	return &upgradeQuarantineAction{
+		newBaseAction(mgr, api, "upgrade-quarantine"),
+	}
+}
+
+func (a *upgradeQuarantineAction) CanExecute(syndesis *v1alpha1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1alpha1.SyndesisPhaseUpgradeQuarantined)
+}
+
+func (a *upgradeQuarantineAction) Execute(ctx context.Context, syndesis *v1alpha1.Syndesis) error {
+	if syndesis.Annotations[AcknowledgeQuarantineAnnotation] == "" {
+		a.log.V(2).Info("Upgrade of Syndesis resource is quarantined", "name", syndesis.Name)
+		return nil
+	}
+
+	a.log.Info("Quarantined upgrade of Syndesis resource acknowledged", "name", syndesis.Name)
+	return a.client.Update(ctx, releaseQuarantine(syndesis))
+}
+
+// The syndesis resource moved to the backoff phase, without the annotation so that it
+// doesn't acknowledge the next quarantine as well
+func releaseQuarantine(syndesis *v1alpha1.Syndesis) *v1alpha1.Syndesis {
+	target := syndesis.DeepCopy()
+	delete(target.Annotations, AcknowledgeQuarantineAnnotation)
+	target.Status.Phase = v1alpha1.SyndesisPhaseUpgradeFailureBackoff
+	target.Status.Description = "Upgrading from " + syndesis.Status.Version + " to " + syndesis.Status.TargetVersion + " after an acknowledged failure"
+	setCondition(&target.Status, v1alpha1.SyndesisConditionDegraded, corev1.ConditionFalse, "QuarantineAcknowledged", "")
+	return target
+}
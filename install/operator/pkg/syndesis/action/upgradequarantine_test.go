@@ -0,0 +1,28 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_releaseQuarantine(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AcknowledgeQuarantineAnnotation: "true", "other": "kept"}},
+		Status: v1alpha1.SyndesisStatus{
+			Phase:           v1alpha1.SyndesisPhaseUpgradeQuarantined,
+			UpgradeAttempts: 1,
+			Conditions:      []v1alpha1.SyndesisCondition{{Type: v1alpha1.SyndesisConditionDegraded, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	released := releaseQuarantine(syndesis)
+	assert.Equal(t, map[string]string{"other": "kept"}, released.Annotations)
+	assert.Equal(t, v1alpha1.SyndesisPhaseUpgradeFailureBackoff, released.Status.Phase)
+	assert.EqualValues(t, 1, released.Status.UpgradeAttempts)
+	assert.Equal(t, corev1.ConditionFalse, getCondition(&released.Status, v1alpha1.SyndesisConditionDegraded).Status)
+	assert.Equal(t, v1alpha1.SyndesisPhaseUpgradeQuarantined, syndesis.Status.Phase)
+}
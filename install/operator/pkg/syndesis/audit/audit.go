@@ -0,0 +1,224 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit records the resources the operator creates, updates and deletes, for change-control processes
+// to review what it did and when. Entries are appended to a size rotated file and to a ring buffer kept in a
+// ConfigMap of the operator namespace, both being optional.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// ConfigMap of the operator namespace holding the last entries
+	ConfigMapName = "syndesis-operator-audit"
+	// Key of the ConfigMap holding the entries, one JSON document per line, oldest first
+	ConfigMapKey = "audit.jsonl"
+)
+
+var log = logf.Log.WithName("audit")
+
+// A mutation performed by the operator. Changes only name the fields that changed, never their values,
+// as they may be secret.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Operation string    `json:"operation"` // create, update or delete
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`            // What performed it, usually an action of the operator
+	Changes   []string  `json:"changes,omitempty"` // Paths of the fields an update changed
+}
+
+type Options struct {
+	File             string        // File the entries are appended to, disabled when empty
+	MaxFileSize      int64         // Size in bytes from which the file is rotated, keeping a single previous file
+	ConfigMapEntries int           // Number of entries kept in the ConfigMap, disabled when 0
+	Namespace        string        // Namespace of the ConfigMap, the one of the operator
+	Client           client.Client // Client writing the ConfigMap, which must not be audited itself
+}
+
+type recorder struct {
+	sync.Mutex
+	options Options
+	file    *rotatingFile
+}
+
+var current = &recorder{}
+
+// Starts recording the entries to the given destinations, replacing the previous ones
+func Configure(options Options) {
+	current.Lock()
+	defer current.Unlock()
+	if current.file != nil {
+		current.file.Close()
+	}
+	current.options = options
+	current.file = nil
+	if options.File != "" {
+		current.file = &rotatingFile{path: options.File, maxSize: options.MaxFileSize}
+	}
+}
+
+// Records an entry to the configured destinations. Failures are only logged, the operator works on without them.
+func Record(ctx context.Context, entry Entry) {
+	current.Lock()
+	defer current.Unlock()
+	if current.file == nil && current.options.ConfigMapEntries <= 0 {
+		return
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Error(err, "Cannot encode audit entry")
+		return
+	}
+	if current.file != nil {
+		if err := current.file.WriteLine(line); err != nil {
+			log.Error(err, "Cannot write audit log", "file", current.options.File)
+		}
+	}
+	if current.options.ConfigMapEntries > 0 && current.options.Client != nil {
+		if err := appendToConfigMap(ctx, current.options, line); err != nil {
+			log.Error(err, "Cannot record audit entry", "configmap", ConfigMapName)
+		}
+	}
+}
+
+// Appends a line to the ConfigMap, dropping the oldest ones beyond the number of entries kept
+func appendToConfigMap(ctx context.Context, options Options, line []byte) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		err := options.Client.Get(ctx, types.NamespacedName{Namespace: options.Namespace, Name: ConfigMapName}, cm)
+		if k8serrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigMapName,
+					Namespace: options.Namespace,
+					Labels: map[string]string{
+						"app":                   "syndesis",
+						"syndesis.io/app":       "syndesis",
+						"syndesis.io/type":      "operator",
+						"syndesis.io/component": "syndesis-operator",
+					},
+				},
+				Data: map[string]string{ConfigMapKey: string(line) + "\n"},
+			}
+			return options.Client.Create(ctx, cm)
+		} else if err != nil {
+			return err
+		}
+
+		lines := append(splitLines(cm.Data[ConfigMapKey]), string(line))
+		if len(lines) > options.ConfigMapEntries {
+			lines = lines[len(lines)-options.ConfigMapEntries:]
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[ConfigMapKey] = strings.Join(lines, "\n") + "\n"
+		return options.Client.Update(ctx, cm)
+	})
+}
+
+// Entries of the ring buffer of the ConfigMap, oldest first. Lines that can't be decoded are skipped.
+func Entries(cm *corev1.ConfigMap) []Entry {
+	entries := []Entry{}
+	for _, line := range splitLines(cm.Data[ConfigMapKey]) {
+		entry := Entry{}
+		if err := json.Unmarshal([]byte(line), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func splitLines(data string) []string {
+	lines := []string{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// A file renamed with a .1 suffix once it reaches its maximum size, a new one being started
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func (f *rotatingFile) WriteLine(line []byte) error {
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(line))+1 > f.maxSize {
+		f.Close()
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return err
+		}
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(append(line, '\n'))
+	f.size += int64(n)
+	return err
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Close() {
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+}
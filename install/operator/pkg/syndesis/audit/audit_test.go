@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_diff(t *testing.T) {
+	before := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "syndesis-server", "resourceVersion": "1", "labels": map[string]interface{}{"app": "syndesis"}},
+		"spec":     map[string]interface{}{"replicas": 1, "template": map[string]interface{}{"containers": []interface{}{"a"}}},
+	}
+	after := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "syndesis-server", "resourceVersion": "2", "labels": map[string]interface{}{"app": "syndesis", "team": "a"}},
+		"spec":     map[string]interface{}{"replicas": 2, "template": map[string]interface{}{"containers": []interface{}{"b"}}},
+	}
+	assert.Equal(t, []string{"metadata.labels.team", "spec.replicas", "spec.template.containers"}, diff(before, after))
+	assert.Empty(t, diff(before, before))
+}
+
+func TestClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis", Name: "syndesis-db-metrics"}}
+	cl := fake.NewFakeClientWithScheme(scheme.Scheme, deployment.DeepCopy())
+	Configure(Options{File: filepath.Join(dir, "audit.log"), MaxFileSize: 1024 * 1024, ConfigMapEntries: 2, Namespace: "syndesis", Client: cl})
+	defer Configure(Options{})
+
+	audited := NewClient(cl, scheme.Scheme, "install")
+	ctx := context.TODO()
+	replicas := int32(2)
+	deployment.Spec.Replicas = &replicas
+	require.NoError(t, audited.Update(ctx, deployment))
+	require.NoError(t, audited.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis", Name: "syndesis-global-config"}}))
+	require.NoError(t, audited.Delete(ctx, deployment))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "syndesis", Name: ConfigMapName}, cm))
+	entries := Entries(cm)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "create", entries[0].Operation)
+	assert.Equal(t, "Secret", entries[0].Kind)
+	assert.Equal(t, "delete", entries[1].Operation)
+	assert.Equal(t, "syndesis-db-metrics", entries[1].Name)
+	assert.Equal(t, "install", entries[1].Reason)
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "audit.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"operation":"update","kind":"Deployment","namespace":"syndesis","name":"syndesis-db-metrics","reason":"install","changes":["spec.replicas"]`)
+}
+
+func Test_rotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	f := &rotatingFile{path: path, maxSize: 10}
+	defer f.Close()
+	require.NoError(t, f.WriteLine([]byte("first")))
+	require.NoError(t, f.WriteLine([]byte("second")))
+
+	previous, err := ioutil.ReadFile(path + ".1")
+	require.NoError(t, err)
+	assert.Equal(t, "first\n", string(previous))
+	current, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", string(current))
+}
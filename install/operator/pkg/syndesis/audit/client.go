@@ -0,0 +1,196 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Most changed fields named by an entry, the others being counted
+const maxChanges = 20
+
+// Metadata the API server maintains, which changes on every update
+var ignoredMetadata = map[string]bool{
+	"resourceVersion":   true,
+	"generation":        true,
+	"managedFields":     true,
+	"creationTimestamp": true,
+	"uid":               true,
+	"selfLink":          true,
+}
+
+// A client recording the mutations it performs, with the given reason
+type auditedClient struct {
+	client.Client
+	scheme *runtime.Scheme
+	reason string
+}
+
+func NewClient(c client.Client, scheme *runtime.Scheme, reason string) client.Client {
+	return &auditedClient{Client: c, scheme: scheme, reason: reason}
+}
+
+func (c *auditedClient) Create(ctx context.Context, obj runtime.Object) error {
+	if err := c.Client.Create(ctx, obj); err != nil {
+		return err
+	}
+	c.record(ctx, "create", obj, nil)
+	return nil
+}
+
+func (c *auditedClient) Update(ctx context.Context, obj runtime.Object) error {
+	changes := c.changes(ctx, obj)
+	if err := c.Client.Update(ctx, obj); err != nil {
+		return err
+	}
+	c.record(ctx, "update", obj, changes)
+	return nil
+}
+
+func (c *auditedClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOptionFunc) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(ctx, "delete", obj, nil)
+	return nil
+}
+
+func (c *auditedClient) Status() client.StatusWriter {
+	return &auditedStatusWriter{StatusWriter: c.Client.Status(), client: c}
+}
+
+type auditedStatusWriter struct {
+	client.StatusWriter
+	client *auditedClient
+}
+
+func (w *auditedStatusWriter) Update(ctx context.Context, obj runtime.Object) error {
+	changes := w.client.changes(ctx, obj)
+	if err := w.StatusWriter.Update(ctx, obj); err != nil {
+		return err
+	}
+	w.client.record(ctx, "update", obj, changes)
+	return nil
+}
+
+func (c *auditedClient) record(ctx context.Context, operation string, obj runtime.Object, changes []string) {
+	entry := Entry{
+		Time:      time.Now().UTC(),
+		Operation: operation,
+		Reason:    c.reason,
+		Changes:   changes,
+	}
+	if gvk, err := apiutil.GVKForObject(obj, c.scheme); err == nil {
+		entry.Kind = gvk.Kind
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		entry.Namespace = accessor.GetNamespace()
+		entry.Name = accessor.GetName()
+	}
+	Record(ctx, entry)
+}
+
+// Fields of the resource an update of the live one changes, read before it is issued
+func (c *auditedClient) changes(ctx context.Context, obj runtime.Object) []string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+	live := emptyCopy(obj)
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}, live); err != nil {
+		return nil
+	}
+	before, err := toMap(live)
+	if err != nil {
+		return nil
+	}
+	after, err := toMap(obj)
+	if err != nil {
+		return nil
+	}
+	return summarize(diff(before, after))
+}
+
+// An object of the same type to read the live resource into, decoding into a copy would keep the fields it lacks
+func emptyCopy(obj runtime.Object) runtime.Object {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(u.GroupVersionKind())
+		return live
+	}
+	return reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+}
+
+func toMap(obj runtime.Object) (map[string]interface{}, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.Object, nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
+
+// Paths of the fields differing between two versions of a resource, sorted. Lists are compared as a whole.
+func diff(before map[string]interface{}, after map[string]interface{}) []string {
+	changes := []string{}
+	diffMaps("", before, after, &changes)
+	sort.Strings(changes)
+	return changes
+}
+
+func diffMaps(path string, before map[string]interface{}, after map[string]interface{}, changes *[]string) {
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+	for key := range keys {
+		if path == "metadata" && ignoredMetadata[key] {
+			continue
+		}
+		child := key
+		if path != "" {
+			child = path + "." + key
+		}
+		b, bIsMap := before[key].(map[string]interface{})
+		a, aIsMap := after[key].(map[string]interface{})
+		switch {
+		case bIsMap && aIsMap:
+			diffMaps(child, b, a, changes)
+		case !reflect.DeepEqual(before[key], after[key]):
+			*changes = append(*changes, child)
+		}
+	}
+}
+
+func summarize(changes []string) []string {
+	if len(changes) <= maxChanges {
+		return changes
+	}
+	return append(changes[:maxChanges], fmt.Sprintf("and %d more", len(changes)-maxChanges))
+}
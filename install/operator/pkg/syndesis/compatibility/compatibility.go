@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compatibility holds the clusters this operator supports, and checks the
+// current cluster against them before syndesis gets installed or upgraded.
+package compatibility
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"k8s.io/client-go/discovery"
+)
+
+// A Kubernetes release syndesis is known to run on, with the OpenShift release shipping it
+type release struct {
+	minor     int
+	openShift string
+}
+
+// Kubernetes 1.x releases supported by this operator, from the oldest to the newest
+var releases = []release{
+	{11, "3.11"},
+	{13, "4.1"},
+	{14, "4.2"},
+	{16, "4.3"},
+	{17, "4.4"},
+	{18, "4.5"},
+	{19, "4.6"},
+}
+
+// An API group version the cluster must serve, when the installation uses the feature depending on it
+type requiredAPI struct {
+	groupVersion string
+	usedFor      string
+	required     func(config *configuration.Config) bool // Always required when nil
+}
+
+var requiredAPIs = []requiredAPI{
+	{"apps.openshift.io/v1", "the syndesis components are deployment configs", nil},
+	{"image.openshift.io/v1", "the syndesis images are resolved through image streams", nil},
+	{"build.openshift.io/v1", "integrations are built with S2I builds", func(config *configuration.Config) bool {
+		return config.InstallsApplication()
+	}},
+	{"route.openshift.io/v1", "syndesis is exposed with a route", func(config *configuration.Config) bool {
+		return config.ExposedWithRoute()
+	}},
+	{"extensions/v1beta1", "syndesis is exposed with an ingress", func(config *configuration.Config) bool {
+		return config.Syndesis.Exposure == string(v1alpha1.SyndesisExposureIngress)
+	}},
+	{"oauth.openshift.io/v1", "the oauth client is managed by the operator", func(config *configuration.Config) bool {
+		return config.Syndesis.Components.Oauth.Client.Managed
+	}},
+	{"snapshot.storage.k8s.io/v1beta1", "the database is backed up with volume snapshots", func(config *configuration.Config) bool {
+		return config.SnapshotsDatabase()
+	}},
+}
+
+// What the compatibility of a cluster depends on
+type Cluster struct {
+	Major         int
+	Minor         int
+	GroupVersions map[string]bool // API group versions served by the cluster
+}
+
+// Discovers the version and the API group versions of the cluster
+func Discover(api discovery.DiscoveryInterface) (*Cluster, error) {
+	info, err := api.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := api.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &Cluster{GroupVersions: map[string]bool{}}
+	cluster.Major, _ = strconv.Atoi(info.Major)
+	cluster.Minor, _ = strconv.Atoi(strings.TrimSuffix(info.Minor, "+"))
+	for _, group := range groups.Groups {
+		for _, version := range group.Versions {
+			cluster.GroupVersions[version.GroupVersion] = true
+		}
+	}
+	return cluster, nil
+}
+
+// Checks the cluster against the supported releases and the APIs used by the installation, a nil configuration
+// only checking the APIs every installation uses. A description of every incompatibility is returned.
+func Check(cluster *Cluster, config *configuration.Config) []string {
+	problems := []string{}
+
+	oldest, newest := releases[0], releases[len(releases)-1]
+	version := fmt.Sprintf("%d.%d", cluster.Major, cluster.Minor)
+	if cluster.Major < 1 || cluster.Major == 1 && cluster.Minor < oldest.minor {
+		problems = append(problems, fmt.Sprintf("Kubernetes %s is not supported, the oldest supported release is Kubernetes 1.%d (OpenShift %s)", version, oldest.minor, oldest.openShift))
+	} else if cluster.Major > 1 || cluster.Minor > newest.minor {
+		problems = append(problems, fmt.Sprintf("Kubernetes %s is not supported, the newest supported release is Kubernetes 1.%d (OpenShift %s)", version, newest.minor, newest.openShift))
+	}
+
+	for _, api := range requiredAPIs {
+		if api.required != nil && (config == nil || !api.required(config)) {
+			continue
+		}
+		if !cluster.GroupVersions[api.groupVersion] {
+			problems = append(problems, fmt.Sprintf("API %s is not served by the cluster, it is required as %s", api.groupVersion, api.usedFor))
+		}
+	}
+	return problems
+}
+
+// Lists the supported releases, e.g. for the version command
+func SupportedReleases() []string {
+	supported := make([]string, 0, len(releases))
+	for _, r := range releases {
+		supported = append(supported, fmt.Sprintf("Kubernetes 1.%d (OpenShift %s)", r.minor, r.openShift))
+	}
+	return supported
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func openShift(minor int, groupVersions ...string) *Cluster {
+	cluster := &Cluster{Major: 1, Minor: minor, GroupVersions: map[string]bool{}}
+	for _, gv := range append(groupVersions, "apps.openshift.io/v1", "image.openshift.io/v1", "build.openshift.io/v1", "route.openshift.io/v1") {
+		cluster.GroupVersions[gv] = true
+	}
+	return cluster
+}
+
+func TestCheck(t *testing.T) {
+	config := &configuration.Config{}
+	config.Syndesis.InstallMode = "full"
+	config.Syndesis.Exposure = "route"
+
+	assert.Empty(t, Check(openShift(11), config))
+	assert.Empty(t, Check(openShift(19), nil))
+
+	assert.Equal(t, []string{"Kubernetes 1.9 is not supported, the oldest supported release is Kubernetes 1.11 (OpenShift 3.11)"}, Check(openShift(9), config))
+	assert.Equal(t, []string{"Kubernetes 1.22 is not supported, the newest supported release is Kubernetes 1.19 (OpenShift 4.6)"}, Check(openShift(22), config))
+
+	vanilla := &Cluster{Major: 1, Minor: 18, GroupVersions: map[string]bool{"v1": true, "apps/v1": true}}
+	assert.Len(t, Check(vanilla, nil), 2)
+	assert.Len(t, Check(vanilla, config), 4)
+	assert.Contains(t, Check(vanilla, config), "API route.openshift.io/v1 is not served by the cluster, it is required as syndesis is exposed with a route")
+
+	config.Syndesis.Exposure = "ingress"
+	config.Syndesis.Components.Oauth.Client.Managed = true
+	assert.Equal(t, []string{
+		"API extensions/v1beta1 is not served by the cluster, it is required as syndesis is exposed with an ingress",
+		"API oauth.openshift.io/v1 is not served by the cluster, it is required as the oauth client is managed by the operator",
+	}, Check(openShift(18), config))
+	assert.Empty(t, Check(openShift(18, "extensions/v1beta1", "oauth.openshift.io/v1"), config))
+}
+
+func TestSupportedReleases(t *testing.T) {
+	supported := SupportedReleases()
+	assert.Equal(t, "Kubernetes 1.11 (OpenShift 3.11)", supported[0])
+	assert.Len(t, supported, len(releases))
+}
@@ -0,0 +1,179 @@
+// Package component describes the parts a syndesis installation is made of.
+// Adding a component or an addon means registering it here and providing the
+// templates rendering its resources.
+package component
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type Component struct {
+	Name    string                                      // Name of the component, as used on the command line for addons
+	Assets  string                                      // Directory of the templates rendering the component resources
+	Addon   bool                                        // Addons are optional features, enabled from the custom resource
+	Enabled func(config *configuration.Config) bool     // Whether the component is installed, always when not set
+	Images  func(config *configuration.Config) []string // Docker images run by the component
+}
+
+// Components in the order their resources are rendered
+var Registry = []Component{
+	{
+		Name:   "infrastructure",
+		Assets: "./infrastructure/",
+		Images: func(config *configuration.Config) []string {
+			components := config.Syndesis.Components
+			images := []string{
+				components.Server.Image,
+				components.Meta.Image,
+				components.UI.Image,
+				components.S2I.Image,
+				components.Oauth.Image,
+				components.Prometheus.Image,
+				components.Upgrade.Image,
+			}
+			if components.Database.Maintenance.Enabled {
+				images = append(images, components.Database.Maintenance.Image)
+			}
+			return images
+		},
+	},
+	{
+		Name:   "database",
+		Assets: "./database/",
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Components.Database.ExternalDbURL == ""
+		},
+		Images: func(config *configuration.Config) []string {
+			return []string{
+				config.Syndesis.Components.Database.Image,
+				config.Syndesis.Components.Database.Exporter.Image,
+			}
+		},
+	},
+	{
+		Name:   "internaltls",
+		Assets: "./internaltls/",
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.InternalTLS.Enabled
+		},
+	},
+	{
+		Name:   "testsupport",
+		Assets: "./testsupport/",
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Components.Server.Features.TestSupport
+		},
+	},
+	{
+		Name:   "jaeger",
+		Assets: "./addons/jaeger/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.Jaeger.Enabled
+		},
+	},
+	{
+		Name:   "ops",
+		Assets: "./addons/ops/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.Ops.Enabled
+		},
+	},
+	{
+		Name:   "dv",
+		Assets: "./addons/dv/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.DV.Enabled
+		},
+		Images: func(config *configuration.Config) []string {
+			return []string{config.Syndesis.Addons.DV.Image}
+		},
+	},
+	{
+		Name:   "camelk",
+		Assets: "./addons/camelk/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.CamelK.Enabled
+		},
+	},
+	{
+		Name:   "knative",
+		Assets: "./addons/knative/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.Knative.Enabled
+		},
+	},
+	{
+		Name:   "todo",
+		Assets: "./addons/todo/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.InstallsTodo()
+		},
+	},
+	{
+		Name:   "broker",
+		Assets: "./addons/broker/",
+		Addon:  true,
+		Enabled: func(config *configuration.Config) bool {
+			return config.Syndesis.Addons.Broker.Enabled
+		},
+		Images: func(config *configuration.Config) []string {
+			if config.BrokerOperator {
+				return nil
+			}
+			return []string{config.Syndesis.Addons.Broker.Image}
+		},
+	},
+}
+
+// Looks up an addon by name
+func Addon(name string) (Component, bool) {
+	for _, c := range Registry {
+		if c.Addon && c.Name == name {
+			return c, true
+		}
+	}
+	return Component{}, false
+}
+
+func (c Component) IsEnabled(config *configuration.Config) bool {
+	return c.Enabled == nil || c.Enabled(config)
+}
+
+func (c Component) Render(config *configuration.Config) ([]unstructured.Unstructured, error) {
+	return generator.RenderDir(c.Assets, config)
+}
+
+// Renders the resources of all the enabled components
+func RenderEnabled(config *configuration.Config) ([]unstructured.Unstructured, error) {
+	all := []unstructured.Unstructured{}
+	for _, c := range Registry {
+		if !c.IsEnabled(config) {
+			continue
+		}
+		resources, err := c.Render(config)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resources...)
+	}
+	return all, nil
+}
+
+// Lists the docker images of all the components, enabled or not
+func Images(config *configuration.Config) []string {
+	images := []string{}
+	for _, c := range Registry {
+		if c.Images != nil {
+			images = append(images, c.Images(config)...)
+		}
+	}
+	return images
+}
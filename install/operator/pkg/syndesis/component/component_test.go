@@ -0,0 +1,48 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func TestRegistryAssetsExist(t *testing.T) {
+	for _, c := range Registry {
+		f, err := generator.GetAssetsFS().Open(c.Assets)
+		require.NoError(t, err, c.Name)
+		f.Close()
+	}
+}
+
+func TestRenderEnabled(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Todo: v1alpha1.TodoConfiguration{Enabled: true}},
+		},
+	}
+	config, err := configuration.GetProperties("../../../build/conf/config.yaml", context.TODO(), nil, syndesis)
+	require.NoError(t, err)
+
+	resources, err := RenderEnabled(config)
+	require.NoError(t, err)
+
+	names := map[string]bool{}
+	for _, res := range resources {
+		names[res.GetName()] = true
+	}
+	assert.True(t, names["syndesis-server"])
+	assert.True(t, names["syndesis-db"])
+	assert.True(t, names["todo"])
+	assert.False(t, names["syndesis-test-support"])
+
+	todo, found := Addon("todo")
+	assert.True(t, found)
+	assert.Equal(t, "./addons/todo/", todo.Assets)
+	_, found = Addon("database")
+	assert.False(t, found)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/imdario/mergo"
+	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Name of the ConfigMap of the operator namespace holding the defaults of every installation
+	ClusterDefaultsName = "syndesis-cluster-defaults"
+	// Key of the defaults in the ConfigMap, a yaml document shaped like the Syndesis section of the config file
+	ClusterDefaultsKey = "defaults.yaml"
+)
+
+var operatorNamespace = k8sutil.GetOperatorNamespace
+
+// Merge the defaults managed by the administrators of the cluster into the configuration, the custom
+// resource is merged on top of them. The defaults are ignored when the operator runs outside of the cluster.
+func (config *Config) setClusterDefaults(ctx context.Context, cl client.Client) error {
+	namespace, err := operatorNamespace()
+	if err != nil {
+		return nil
+	}
+
+	defaults := &corev1.ConfigMap{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ClusterDefaultsName}, defaults); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+			return nil
+		}
+		return err
+	}
+
+	data, ok := defaults.Data[ClusterDefaultsKey]
+	if !ok {
+		return nil
+	}
+	syndesis := SyndesisConfig{}
+	jsonData, err := yaml.ToJSON([]byte(data))
+	if err == nil {
+		err = json.Unmarshal(jsonData, &syndesis)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s in config map %s: %v", ClusterDefaultsKey, ClusterDefaultsName, err)
+	}
+
+	return mergo.Merge(&config.Syndesis, syndesis, mergo.WithOverride)
+}
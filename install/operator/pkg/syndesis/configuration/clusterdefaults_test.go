@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func TestGetProperties_clusterDefaults(t *testing.T) {
+	defer func(original func() (string, error)) { operatorNamespace = original }(operatorNamespace)
+	operatorNamespace = func() (string, error) { return "syndesis-operator", nil }
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, routev1.AddToScheme(scheme))
+	require.NoError(t, apis.AddToScheme(scheme))
+
+	defaults := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis-operator", Name: ClusterDefaultsName},
+		Data: map[string]string{ClusterDefaultsKey: `
+RouteDomain: apps.example.com
+Components:
+  Server:
+    Image: registry.example.com/syndesis/syndesis-server:1.8
+Addons:
+  Ops:
+    Enabled: true
+  Todo:
+    Enabled: true
+`},
+	}
+	syndesis := &v1alpha1.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis"},
+		Spec: v1alpha1.SyndesisSpec{
+			Addons: v1alpha1.AddonsSpec{Ops: v1alpha1.OpsConfiguration{
+				SLO: v1alpha1.SLOConfiguration{SuccessRate: "99"},
+			}},
+		},
+	}
+
+	config, err := GetProperties("../../../build/conf/config-test.yaml", context.TODO(), fake.NewFakeClientWithScheme(scheme, defaults), syndesis)
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com/syndesis/syndesis-server:1.8", config.Syndesis.Components.Server.Image)
+	assert.Equal(t, "docker.io/syndesis/syndesis-meta:latest", config.Syndesis.Components.Meta.Image)
+	assert.True(t, config.Syndesis.Addons.Ops.Enabled)
+	assert.True(t, config.Syndesis.Addons.Todo.Enabled)
+	// the custom resource is merged over the defaults
+	assert.Equal(t, "99", config.Syndesis.Addons.Ops.SLO.SuccessRate)
+
+	require.NoError(t, config.SetRoute(context.TODO(), fake.NewFakeClientWithScheme(scheme), syndesis))
+	assert.Equal(t, "syndesis-syndesis.apps.example.com", config.RouteHostname)
+
+	defaults.Data[ClusterDefaultsKey] = "Addons: [ops]"
+	_, err = GetProperties("../../../build/conf/config-test.yaml", context.TODO(), fake.NewFakeClientWithScheme(scheme, defaults), syndesis)
+	assert.Error(t, err)
+}
@@ -20,52 +20,120 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/imdario/mergo"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
 
+var log = logf.Log.WithName("configuration")
+
 var random = rand.New(rand.NewSource(time.Now().UnixNano()))
 
 // Location from where the template configuration is located
 var TemplateConfig string
 
+// ConfigMap of the syndesis namespace overriding the template configuration, so that it can be changed without
+// rebuilding the operator image. Not used when empty.
+var TemplateConfigMap string
+
+// Key of the template ConfigMap holding the configuration, in the format of the template configuration file
+const TemplateConfigMapKey = "config.yaml"
+
+// Annotation prefix on the custom resource used to point a single component
+// (server, meta or ui) to a locally built image stream tag when DevSupport is on
+const DevImageAnnotationPrefix = "syndesis.io/dev-image-"
+
 type Config struct {
 	AllowLocalHost             bool
 	Productized                bool
-	DevSupport                 bool           // If set to true, pull docker images from imagetag instead of upstream source
-	Scheduled                  bool           // Legacy parameter to set scheduled:true in the imagestreams, but we dont use many imagestreams nowadays
-	ProductName                string         // Usually syndesis or fuse-online
-	ImageStreamNamespace       string         // The OpenShift Namespace where the PostgreSQL ImageStream resides
-	PrometheusRules            string         // If some extra rules for prometheus need to be specified, they are defined here
-	OpenShiftProject           string         // The name of the OpenShift project Syndesis is being deployed into
-	OpenShiftOauthClientSecret string         // OpenShift OAuth client secret
-	RouteHostname              string         // The external hostname to access Syndesis
-	OpenShiftConsoleUrl        string         // The URL to the OpenShift console
-	ImagePullSecrets           []string       // Pull secrets attached to services accounts. This field is generated by the operator
-	Syndesis                   SyndesisConfig // Configuration for syndesis components and addons. This fields are overwritten from environment variables and from the custom resource
+	DevSupport                 bool              // If set to true, pull docker images from imagetag instead of upstream source
+	Scheduled                  bool              // Legacy parameter to set scheduled:true in the imagestreams, but we dont use many imagestreams nowadays
+	ProductName                string            // Usually syndesis or fuse-online
+	ImageStreamNamespace       string            // The OpenShift Namespace where the PostgreSQL ImageStream resides
+	PrometheusRules            string            // If some extra rules for prometheus need to be specified, they are defined here
+	OpenShiftProject           string            // The name of the OpenShift project Syndesis is being deployed into
+	OpenShiftOauthClientSecret string            // OpenShift OAuth client secret
+	RouteHostname              string            // The external hostname to access Syndesis
+	OpenShiftConsoleUrl        string            // The URL to the OpenShift console
+	ImagePullSecrets           []string          // Pull secrets attached to services accounts. This field is generated by the operator
+	HttpProxy                  string            // Cluster wide HTTP proxy. This field is generated by the operator
+	HttpsProxy                 string            // Cluster wide HTTPS proxy. This field is generated by the operator
+	NoProxy                    string            // Hosts excluded from the cluster wide proxy. This field is generated by the operator
+	ClusterIngressDomain       string            // Domain of the routes generated by the cluster. This field is generated by the operator
+	DevImageStreamTags         map[string]string // Image stream tags replacing the default ones per component, only used with DevSupport. This field is generated by the operator
+	MirroredImageStreamTags    map[string]string // Mirrored images of the image stream tags served from the syndesis namespace. This field is generated by the operator
+	BrokerOperator             bool              // Whether the AMQ broker operator provisions the broker addon. This field is generated by the operator
+	CamelKPlatformReady        bool              // Whether the Camel K integration platform of the camelk addon is ready. This field is generated by the operator
+	StandbyPromoted            bool              // Whether the installation was a warm standby and has been promoted. This field is generated by the operator
+	StartupProbes              bool              // Whether the cluster runs startup probes. This field is generated by the operator
+	Syndesis                   SyndesisConfig    // Configuration for syndesis components and addons. This fields are overwritten from environment variables and from the custom resource
+	passwordMinLength          int               // Minimum length of the generated passwords, from the cluster password policy
 }
 
 type SyndesisConfig struct {
-	ImageStreamNamespace string         // Namespace where syndesis docker images are located and the operator should look after them
-	Components           ComponentsSpec // Server, Meta, Ui, Name specifications and configurations
-	Addons               AddonsSpec     // Addons specifications and configurations
+	ImageStreamNamespace string                     // Namespace where syndesis docker images are located and the operator should look after them
+	Profile              string                     // Installation profile, "dev" for a small footprint installation
+	InstallMode          string                     // What gets installed: full, or infrastructureOnly without server, meta and ui
+	RelaxedProbes        bool                       // Give more time to pods before liveness probes restart them, for slow environments
+	StartupProbe         StartupProbeConfiguration  // Time given to the java components to start, when the cluster runs startup probes
+	Components           ComponentsSpec             // Server, Meta, Ui, Name specifications and configurations
+	Addons               AddonsSpec                 // Addons specifications and configurations
+	ConsoleLink          ConsoleLinkConfiguration   // Link to syndesis from the OpenShift 4 web console application launcher
+	Notifications        NotificationsConfiguration // SMTP server, recipients and webhook the operator notifies
+	Logging              LoggingConfiguration       // Log levels of the java components
+	Telemetry            TelemetryConfiguration     // Opt-in reporting of anonymous usage data
+	Remediation          RemediationConfiguration   // Watchdog remediating crash looping components
+	Exposure             string                     // How syndesis is exposed: route, ingress, loadbalancer, nodeport or none
+	ExternalHostname     string                     // Hostname syndesis is reachable at when not exposed with a route
+	RouteDomain          string                     // Domain of the route hostname when the route has none yet, giving syndesis-<namespace>.<domain>
+	AlternateHostnames   []string                   // Additional hostnames accepted as CORS origins and OAuth redirect URIs
+	AllowedOrigins       []string                   // Additional origins allowed by the server CORS configuration
+
+	// How the alternate hostnames are served: none, proxy or redirect
+	AlternateHostnamesMode string
+
+	HostAliases []corev1.HostAlias // Entries added to the hosts file of the component pods
+	DNSSuffix   string             // DNS suffix of the cluster the component pods search, when not the one of the nodes
+	Timezone    string             // Time zone of the schedules of the scheduled operations, e.g. Europe/Paris
+
+	Integration IntegrationConfiguration // Tuning of the integration controller of syndesis-server
+	Backup      BackupConfiguration      // How the database is backed up
+
+	ConnectivityChecks bool                      // Probe the external dependencies before declaring syndesis ready
+	Certificates       CertificatesConfiguration // Expiry monitoring of the serving certificates
+	Connectors         ConnectorsConfiguration   // Connectors teams may use
+	SmokeTest          SmokeTestConfiguration    // Job verifying the installation before it is declared ready
+	Route              RouteConfiguration        // Labels and annotations of the generated routes, e.g. to target a router shard
+	Standby            StandbyConfiguration      // Warm standby of a primary installation, kept in sync by database streaming replication
+	InternalTLS        InternalTLSConfiguration  // Certificates encrypting the traffic between the components
+	ImagePolicy        ImagePolicyConfiguration  // Whether the components follow new releases of their images
 }
 
 // Components
@@ -82,14 +150,35 @@ type ComponentsSpec struct {
 }
 
 type OauthConfiguration struct {
-	CookieSecret    string // Secret to use to encrypt oauth cookies
-	Image           string // Docker image for Oauth
-	DisableSarCheck bool   // Enable or disable SAR checks all together
-	SarNamespace    string // The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
+	CookieSecret            string // Secret to use to encrypt oauth cookies
+	Image                   string // Docker image for Oauth
+	DisableSarCheck         bool   // Enable or disable SAR checks all together
+	SarNamespace            string // The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
+	CookieExpire            string // Expiration of the oauth cookie, as a duration (e.g. 168h)
+	CookieRefresh           string // Refresh the oauth cookie after this duration, 0 to disable
+	CookieSecretGracePeriod string // How long the previous cookie secret stays available after a rotation
+	CookieSecretPrevious    string // Cookie secret in use before the last rotation. This field is generated by the operator
+	CookieSecretRotation    string // Value of the rotation annotation that triggered the last rotation. This field is generated by the operator
+	CookieSecretRotatedAt   string // Time of the last rotation, in RFC3339 format. This field is generated by the operator
+
+	// OAuthClient used instead of the syndesis-oauth-client service account
+	Client OAuthClientConfiguration
+
+	Resources  AddonResources          // Requests and limits of the oauth proxy, 20Mi and 200Mi of memory when empty
+	Scheduling SchedulingConfiguration // Nodes the oauth proxy pod is scheduled on
+}
+
+type OAuthClientConfiguration struct {
+	Managed bool   // Create and keep in sync an OAuthClient named syndesis-<namespace>, instead of using the syndesis-oauth-client service account
+	Name    string // Existing OAuthClient provided by the cluster administrator, used as is
 }
 
 type UIConfiguration struct {
-	Image string // Docker image for ui pod
+	Image               string // Docker image for ui pod
+	Replicas            int    // Number of ui pods
+	DisableAntiAffinity bool   // Do not spread ui pods across nodes and zones when running more than one replica
+	Shutdown            ShutdownConfiguration
+	Scheduling          SchedulingConfiguration // Nodes the ui pods are scheduled on
 }
 
 type S2IConfiguration struct {
@@ -97,26 +186,76 @@ type S2IConfiguration struct {
 }
 
 type DatabaseConfiguration struct {
-	User                 string                // Username for PostgreSQL user that will be used for accessing the database
-	Name                 string                // Name of the PostgreSQL database accessed
-	URL                  string                // Host and port of the PostgreSQL database to access
-	ExternalDbURL        string                // If specified, use an external database instead of the installed by syndesis
-	Resources            ResourcesWithVolume   // Resources, memory and database volume size
-	Exporter             ExporterConfiguration // The exporter exports metrics in prometheus format
-	Image                string                // Docker image for database
-	ImageStreamNamespace string                // Namespace where the database image is located
-	Password             string                // Password for the PostgreSQL connection user
-	SampledbPassword     string                // Password for the PostgreSQL sampledb user
+	User                 string                  // Username for PostgreSQL user that will be used for accessing the database
+	Name                 string                  // Name of the PostgreSQL database accessed
+	Connection           DatabaseConnection      // Host, port and parameters of the PostgreSQL database to access
+	ExternalDbURL        string                  // If specified, use an external database instead of the installed by syndesis
+	Resources            ResourcesWithVolume     // Resources, memory and database volume size
+	Exporter             ExporterConfiguration   // The exporter exports metrics in prometheus format
+	Image                string                  // Docker image for database
+	ImageStreamNamespace string                  // Namespace where the database image is located
+	Password             string                  // Password for the PostgreSQL connection user
+	SampledbPassword     string                  // Password for the PostgreSQL sampledb user
+	ReadReplicas         int                     // Number of streaming replicas of the installed database
+	ExternalReplicaURLs  []string                // Read replicas of the external database
+	ReplicationPassword  string                  // Password of the PostgreSQL replication user. This field is generated by the operator
+	Maintenance          DatabaseMaintenance     // Routine maintenance of the database
+	Scheduling           SchedulingConfiguration // Nodes the database pods are scheduled on
+}
+
+type DatabaseConnection struct {
+	Host           string            // Host of the database
+	Port           int               // Port of the database
+	SSLMode        string            // libpq sslmode, one of disable, allow, prefer, require, verify-ca or verify-full
+	ConnectTimeout int               // Seconds to wait for a connection, 0 to wait indefinitely
+	Options        map[string]string // Further libpq parameters, e.g. options: "-c search_path=syndesis"
+}
+
+type DatabaseMaintenance struct {
+	Enabled          bool
+	Schedule         string // Cron schedule of the maintenance, in the time zone of the installation
+	UTCSchedule      string // Schedule shifted to UTC, the time zone of the cron jobs. This field is generated by the operator
+	StatementTimeout string // Longest time a maintenance statement may run, in postgresql units
+	Image            string // Docker image providing psql
 }
 
 type ExporterConfiguration struct {
-	Image string // Docker image for postgres_exporter
+	Image     string         // Docker image for postgres_exporter
+	Resources AddonResources // Requests and limits of the exporter sidecar, 20Mi and 256Mi of memory when empty
 }
 
 type PrometheusConfiguration struct {
-	Image     string              // Docker image for prometheus
-	Rules     string              // Monitoring rules for prometheus
-	Resources ResourcesWithVolume // Set volume size for prometheus pod, where metrics are stored
+	Enabled            bool                    // Whether prometheus is installed, the cluster monitoring may already scrape syndesis
+	Image              string                  // Docker image for prometheus
+	Rules              string                  // Monitoring rules for prometheus
+	Resources          ResourcesWithVolume     // Set volume size for prometheus pod, where metrics are stored
+	DisablePersistence bool                    // Store metrics in an ephemeral volume instead of a persistent volume claim
+	RemoteWrite        []PrometheusRemoteWrite // Remote storages the scraped samples are also sent to
+	Federation         PrometheusFederation    // Federation endpoint exposed with an authenticated route
+	Scheduling         SchedulingConfiguration // Nodes the prometheus pod is scheduled on
+}
+
+type PrometheusFederation struct {
+	Enabled  bool
+	Hostname string   // Hostname of the route, generated by the cluster when empty
+	Match    []string // Series selectors of the match[] parameters of the generated scrape configuration
+}
+
+type PrometheusRemoteWrite struct {
+	URL                 string                    // Remote write endpoint
+	Username            string                    // Username for basic authentication
+	PasswordSecret      string                    // Secret whose password key is the basic authentication password
+	BearerTokenSecret   string                    // Secret whose token key is sent as bearer token
+	WriteRelabelConfigs []PrometheusRelabelConfig // Relabelings applied to the samples before they are sent
+}
+
+type PrometheusRelabelConfig struct {
+	SourceLabels []string
+	Separator    string
+	Regex        string
+	TargetLabel  string
+	Replacement  string
+	Action       string
 }
 
 type GrafanaConfiguration struct {
@@ -131,47 +270,102 @@ type ServerConfiguration struct {
 	ClientStateAuthenticationKey  string         // Key used to perform authentication of client side stored state
 	ClientStateEncryptionKey      string         // Key used to perform encryption of client side stored state
 	ControllersIntegrationEnabled bool           // Should deployment of integrations be enabled?
+	Replicas                      int            // Number of server pods
+	DisableAntiAffinity           bool           // Do not spread server pods across nodes and zones when running more than one replica
+	ConfigOverride                string         // ConfigMap whose application.yml is merged into the generated server configuration
+	Shutdown                      ShutdownConfiguration
+	Scheduling                    SchedulingConfiguration // Nodes the server pods are scheduled on
+
+	ClientStateKeyGracePeriod            string // How long the previous client state keys stay accepted after a rotation
+	ClientStateTid                       string // Identifier of the client state keys, changed on every rotation. This field is generated by the operator
+	ClientStatePreviousTid               string // Identifier of the client state keys in use before the last rotation. This field is generated by the operator
+	ClientStatePreviousAuthenticationKey string // Authentication key in use before the last rotation. This field is generated by the operator
+	ClientStatePreviousEncryptionKey     string // Encryption key in use before the last rotation. This field is generated by the operator
+	ClientStateKeyRotation               string // Value of the rotation annotation that triggered the last rotation. This field is generated by the operator
+	ClientStateKeyRotatedAt              string // Time of the last rotation, in RFC3339 format. This field is generated by the operator
 }
 
 type MetaConfiguration struct {
-	Image     string              // Docker image for meta
-	Resources ResourcesWithVolume // Resources for meta pod, memory
+	Image      string              // Docker image for meta
+	Resources  ResourcesWithVolume // Resources for meta pod, memory
+	Shutdown   ShutdownConfiguration
+	Scheduling SchedulingConfiguration // Nodes the meta pod is scheduled on
+}
+
+type SchedulingConfiguration struct {
+	NodeSelector map[string]string   // Labels of the nodes the pods are scheduled on
+	Tolerations  []corev1.Toleration // Taints of the nodes the pods tolerate
+	Affinity     *corev1.Affinity    // Node and pod affinities of the pods, a pod anti-affinity replaces the one spreading the replicas
+}
+
+// Whether the affinity has its own pod anti-affinity, replacing the one spreading the replicas of the component
+func (scheduling SchedulingConfiguration) HasPodAntiAffinity() bool {
+	return scheduling.Affinity != nil && scheduling.Affinity.PodAntiAffinity != nil
+}
+
+type ShutdownConfiguration struct {
+	TerminationGracePeriodSeconds int64    // Seconds given to the pods to stop before they are killed
+	PreStop                       []string // Command run in the container before it is stopped, none when empty
 }
 
 type UpgradeConfiguration struct {
-	Image     string              // Docker image for Upgrade pod
-	Resources VolumeOnlyResources // Resources for upgrade pod, memory and volume size where database dump is saved
+	Image     string                 // Docker image for Upgrade pod
+	Resources VolumeOnlyResources    // Resources for upgrade pod, memory and volume size where database dump is saved
+	Pods      AddonPodsConfiguration // Nodes and resources of the upgrade pod
+	Timeout   string                 // Longest time the upgrade pod may run before the upgrade is considered failed
+	OnFailure string                 // What happens when the upgrade fails, retry or quarantine
 }
 
 type Resources struct {
-	Memory string
+	Memory        string // Memory limit of the pod
+	CPU           string // CPU request of the pod, the component default when empty
+	MemoryRequest string // Memory request of the pod, the component default when empty
+	CPULimit      string // CPU limit of the pod, the component default when empty
 }
 
 type ResourcesWithVolume struct {
-	Memory         string
+	Memory         string // Memory limit of the pod
 	VolumeCapacity string
+	CPU            string // CPU request of the pod, the component default when empty
+	MemoryRequest  string // Memory request of the pod, the component default when empty
+	CPULimit       string // CPU limit of the pod, the component default when empty
+
+	VolumeStorageClass string // Storage class of the volume claim, the default one of the cluster when empty
+	VolumeAccessMode   string // Access mode of the volume claim, ReadWriteOnce when empty
+	VolumeName         string // Existing persistent volume the claim binds to
 }
 
 type VolumeOnlyResources struct {
-	VolumeCapacity string
+	VolumeCapacity     string
+	VolumeStorageClass string // Storage class of the volume claim, the default one of the cluster when empty
+	VolumeAccessMode   string // Access mode of the volume claim, ReadWriteOnce when empty
+	VolumeName         string // Existing persistent volume the claim binds to
 }
 
 type ServerFeatures struct {
-	IntegrationLimit              int               // Maximum number of integrations single user can create
-	IntegrationStateCheckInterval int               // Interval for checking the state of the integrations
-	DeployIntegrations            bool              // Whether we deploy integrations
-	DemoData                      bool              // Enables starting up with demo data
-	TestSupport                   bool              // Enables test-support endpoint on backend API
-	OpenShiftMaster               string            // Public OpenShift master address
-	ManagementUrlFor3scale        string            // 3scale management URL
-	MavenRepositories             map[string]string // Set repositories for maven
+	IntegrationLimit              int                   // Maximum number of integrations single user can create
+	IntegrationStateCheckInterval int                   // Interval for checking the state of the integrations
+	DeployIntegrations            bool                  // Whether we deploy integrations
+	DemoData                      DemoDataConfiguration // Demo content installed with syndesis
+	TestSupport                   bool                  // Enables test-support endpoint on backend API
+	OpenShiftMaster               string                // Public OpenShift master address
+	ManagementUrlFor3scale        string                // 3scale management URL
+	MavenRepositories             map[string]string     // Set repositories for maven
+}
+
+type DemoDataConfiguration struct {
+	SampleDB           bool // The sampledb database and its sample tables
+	Todo               bool // The todo example application
+	SampleIntegrations bool // The sample integrations loaded by syndesis-server when it starts
+	Purge              bool // Removes the demo content, the sample integrations already loaded are kept
 }
 
 // Addons
 type AddonsSpec struct {
 	Jaeger  JaegerConfiguration
-	Ops     AddonConfiguration
-	Todo    AddonConfiguration
+	Ops     OpsConfiguration
+	Todo    TodoConfiguration
+	Broker  BrokerConfiguration
 	Knative AddonConfiguration
 	DV      DvConfiguration
 	CamelK  CamelKConfiguration
@@ -181,18 +375,187 @@ type JaegerConfiguration struct {
 	Enabled      bool
 	SamplerType  string
 	SamplerParam string
+	Sampling     JaegerSamplingConfiguration
+	Pods         AddonPodsConfiguration
+}
+
+type JaegerSamplingConfiguration struct {
+	Default      JaegerSamplingStrategy            // Strategy of the integrations without their own
+	Integrations map[string]JaegerSamplingStrategy // Strategies by integration name
+}
+
+type JaegerSamplingStrategy struct {
+	Type  string // Either probabilistic or ratelimiting
+	Param string // Probability a trace is kept, or traces kept per second when rate limiting
+}
+
+type OpsConfiguration struct {
+	Enabled bool
+	SLO     SLOConfiguration
+}
+
+type SLOConfiguration struct {
+	SuccessRate      string // Percentage of exchanges expected to succeed, the rest being the error budget
+	LatencyThreshold int    // Processing time in milliseconds the 95th percentile of exchanges should stay below
 }
 
 type DvConfiguration struct {
 	Enabled   bool
 	Resources Resources
 	Image     string
+	Pods      AddonPodsConfiguration
 }
 
 type AddonConfiguration struct {
 	Enabled bool
 }
 
+type TodoConfiguration struct {
+	Enabled bool
+	Pods    AddonPodsConfiguration
+}
+
+type AddonPodsConfiguration struct {
+	NodeSelector map[string]string   // Labels of the nodes the addon pods are scheduled on
+	Tolerations  []corev1.Toleration // Taints of the nodes the addon pods tolerate
+	Resources    AddonResources      // Requests and limits replacing the addon defaults
+}
+
+type AddonResources struct {
+	CPURequest    string
+	CPULimit      string
+	MemoryRequest string
+	MemoryLimit   string
+}
+
+type BrokerConfiguration struct {
+	Enabled  bool
+	Image    string // Docker image of the broker, when not provisioned by the AMQ broker operator
+	User     string // User of the broker
+	Password string // Password of the broker user. This field is generated by the operator
+}
+
+type StartupProbeConfiguration struct {
+	PeriodSeconds    int // Seconds between two probes while starting
+	FailureThreshold int // Failed probes before the container is restarted, components have PeriodSeconds * FailureThreshold seconds to start
+}
+
+type ConsoleLinkConfiguration struct {
+	Disabled bool   // Do not create the console link, even if the cluster supports it
+	Text     string // Text displayed for the link
+	Section  string // Application launcher section the link is grouped under
+	ImageURL string // Icon displayed next to the link
+}
+
+type LoggingConfiguration struct {
+	Categories map[string]string // Level of each logger, e.g. "org.apache.camel": "WARN"
+}
+
+type TelemetryConfiguration struct {
+	Enabled  bool   // Report anonymous usage data, disabled by default
+	Endpoint string // URL receiving the reports as a JSON POST
+	Interval string // Time between two reports
+}
+
+type BackupConfiguration struct {
+	Method        string // dump for a logical dump, or snapshot for CSI volume snapshots of the database volume claim
+	SnapshotClass string // Volume snapshot class of the snapshots, the default class of the CSI driver when empty
+}
+
+type IntegrationConfiguration struct {
+	Controller IntegrationControllerConfiguration
+	Runtime    v1alpha1.SyndesisIntegrationRuntime // springboot or camelk, camelk when empty and the camelk addon is enabled
+	Quota      IntegrationQuota                    // Limits of the integrations
+	SecretSync SecretSyncConfiguration             // Secrets copied to the integration namespaces
+	Republish  IntegrationRepublishConfiguration   // Republication of the published integrations in batches
+}
+
+type IntegrationRepublishConfiguration struct {
+	Automatic        bool   // Republish the integrations once the S2I image or the maven repositories change
+	BatchSize        int    // Integrations republished at the same time
+	Delay            string // Shortest time between the start of two batches
+	Timeout          string // Time the integrations of a batch get to be published before they count as failed
+	FailureThreshold int    // Failed integrations after which the republication is aborted
+}
+
+type SecretSyncConfiguration struct {
+	Selector   string   // Label selector of the secrets of the syndesis namespace to copy, none when empty
+	Namespaces []string // Integration namespaces the secrets are copied to, all of them when empty
+}
+
+type IntegrationQuota struct {
+	MaxIntegrationsPerUser      int    // Integrations a user may create and deploy, Features.IntegrationLimit when 0
+	MaxIntegrationsPerNamespace int    // Integrations deployed at the same time in each integration namespace, unlimited when 0
+	MaxMemory                   string // Memory an integration container may use, unlimited when empty
+}
+
+type IntegrationControllerConfiguration struct {
+	StateCheckInterval       int                     // Seconds between two checks of the integrations state, Features.IntegrationStateCheckInterval when 0
+	MaxConcurrentDeployments int                     // Integrations built and deployed at the same time, server default when 0
+	RetryBackoff             IntegrationRetryBackoff // Delays between the attempts of a failed deployment, no retries when InitialDelay is 0
+}
+
+type IntegrationRetryBackoff struct {
+	InitialDelay int // Seconds before the first retry, server default when 0
+	MaxDelay     int // Longest delay between two attempts in seconds, server default when 0
+}
+
+type SmokeTestConfiguration struct {
+	Enabled bool   // Run the smoke test after installs and upgrades
+	Image   string // Image of the job, with a shell and curl
+	Script  string // Shell script replacing the default one
+}
+
+type RouteConfiguration struct {
+	Labels      map[string]string // Labels set on the generated routes, e.g. the one selecting their router shard
+	Annotations map[string]string // Annotations set on the generated routes
+}
+
+type StandbyConfiguration struct {
+	Enabled          bool   // Follow the primary installation, promoted when set back to false
+	PrimaryHost      string // Host of the database of the primary installation
+	AllowReplication bool   // Let standby installations stream the database
+}
+
+type InternalTLSConfiguration struct {
+	Enabled          bool                       // Encrypt the traffic between syndesis-server, syndesis-meta and the database
+	Issuer           string                     // Who issues the certificates: service-ca or cert-manager
+	IssuerRef        CertificateIssuerReference // Issuer of cert-manager signing the certificates
+	KeystorePassword string                     // Password of the keystores the java components build from the certificates. This field is generated by the operator
+}
+
+type ImagePolicyConfiguration struct {
+	Update        string // pinned, track-minor or track-latest
+	CheckInterval string // How often the registries are checked, daily with the tracking policies when not set
+}
+
+type CertificateIssuerReference struct {
+	Name string // Name of the issuer
+	Kind string // Issuer or ClusterIssuer
+}
+
+type ConnectorsConfiguration struct {
+	Allow []string // Ids of the only connectors offered, all of them when empty
+	Deny  []string // Ids of the connectors never offered
+}
+
+type CertificatesConfiguration struct {
+	ExpiryThreshold string // Time left before the expiry of a serving certificate from which it is reported
+	Renew           bool   // Ask cert-manager to issue the certificates it manages again when they are about to expire
+}
+
+type RemediationConfiguration struct {
+	Enabled          bool   // Remediate crash looping components, disabled by default
+	RestartThreshold int32  // Restarts of a crash looping container before it gets remediated
+	Interval         string // Time given to a remediation to take effect before trying the next one
+}
+
+type NotificationsConfiguration struct {
+	SmtpSecret string   // Secret holding the SMTP server host, port, username, password and from address
+	Recipients []string // Email addresses notified
+	WebhookURL string   // URL receiving a JSON POST for each notification
+}
+
 type CamelKConfiguration struct {
 	Enabled       bool
 	CamelVersion  string
@@ -203,21 +566,34 @@ type CamelKConfiguration struct {
 /*
 / Returns all processed configurations for Syndesis
 
- - Default values for configuration are loaded from file
- - Secrets and passwords are loaded from syndesis-global-config Secret if they exits
- and generated if they dont
- - For QE, some fields are loaded from environment variables
- - Users might define fields using the syndesis custom resource
+  - Default values for configuration are loaded from file, then from the template ConfigMap when there is one
+  - Secrets and passwords are loaded from syndesis-global-config Secret if they exits,
+    from its syndesis-global-config-backup copy when it was deleted, and generated if they dont
+  - For QE, some fields are loaded from environment variables
+  - Users might define fields using the syndesis custom resource
+  - Platform administrators define cluster defaults with the SyndesisOperatorConfig named cluster,
+    the custom resource can only override its default profile
 */
 func GetProperties(file string, ctx context.Context, client client.Client, syndesis *v1alpha1.Syndesis) (*Config, error) {
 	configuration := &Config{}
 	if err := configuration.loadFromFile(file); err != nil {
 		return nil, err
 	}
+	if client != nil && TemplateConfigMap != "" {
+		if err := configuration.loadFromConfigMap(ctx, client, syndesis.Namespace, TemplateConfigMap); err != nil {
+			return nil, err
+		}
+	}
 
 	configuration.OpenShiftProject = syndesis.Namespace
 	configuration.Syndesis.Components.Oauth.SarNamespace = configuration.OpenShiftProject
 
+	operatorConfig, err := getOperatorConfig(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	configuration.passwordMinLength = operatorConfig.PasswordPolicy.MinLength
+
 	if client != nil {
 		if err := configuration.setPasswordsFromSecret(ctx, client, syndesis); err != nil {
 			return nil, err
@@ -229,7 +605,28 @@ func GetProperties(file string, ctx context.Context, client client.Client, synde
 		return nil, err
 	}
 
-	if err := configuration.setSyndesisFromCustomResource(syndesis); err != nil {
+	if client != nil {
+		if err := configuration.setClusterDefaults(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := configuration.setSyndesisFromCustomResource(withClusterDefaults(syndesis, operatorConfig)); err != nil {
+		return nil, err
+	}
+	configuration.enforceOperatorConfig(operatorConfig)
+	configuration.setResolvedImages(syndesis)
+	configuration.setDevImagesFromAnnotations(syndesis)
+	configuration.StandbyPromoted = !configuration.Syndesis.Standby.Enabled &&
+		(syndesis.Status.Standby || syndesis.Status.StandbyPromotedAt != nil)
+
+	if client != nil && operatorConfig.MirrorImageStreams {
+		if err := configuration.setMirroredImageStreamTags(ctx, client, operatorConfig.RegistryMirror); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := configuration.setSchedules(time.Now()); err != nil {
 		return nil, err
 	}
 
@@ -258,26 +655,206 @@ func (config *Config) loadFromFile(file string) error {
 	return nil
 }
 
+// Load configuration from the config.yaml key of a ConfigMap, on top of the one already loaded.
+// Only the fields the ConfigMap sets are changed. A missing ConfigMap or key overrides nothing, so that
+// the ConfigMap can be created when needed and removed to go back to the template configuration file.
+func (config *Config) loadFromConfigMap(ctx context.Context, client client.Client, namespace string, name string) error {
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Info("Template ConfigMap not found, using the template configuration file", "namespace", namespace, "configmap", name)
+			return nil
+		}
+		return err
+	}
+
+	value, ok := cm.Data[TemplateConfigMapKey]
+	if !ok {
+		log.Info("Template ConfigMap has no configuration, using the template configuration file", "namespace", namespace, "configmap", name, "key", TemplateConfigMapKey)
+		return nil
+	}
+	data, err := yaml.ToJSON([]byte(value))
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return fmt.Errorf("invalid configuration in configmap %s: %v", name, err)
+	}
+	return nil
+}
+
 // Set Config.RouteHostname based on the Spec.Host property of the syndesis route
 // If an environment variable is set to overwrite the route, take that instead
+// When syndesis is not exposed with a route, the configured external hostname is used
+// When the route doesn't exist yet, its hostname is derived from the route domain, if any
 func (config *Config) SetRoute(ctx context.Context, client client.Client, syndesis *v1alpha1.Syndesis) error {
-	if os.Getenv("ROUTE_HOSTNAME") == "" {
+	if os.Getenv("ROUTE_HOSTNAME") == "" && !config.ExposedWithRoute() {
+		if config.Syndesis.ExternalHostname == "" {
+			return errors.New("an external hostname is required when syndesis is exposed with " + config.Syndesis.Exposure)
+		}
+		config.RouteHostname = config.Syndesis.ExternalHostname
+	} else if os.Getenv("ROUTE_HOSTNAME") == "" {
+		if hostname, ok := lookups.get(routeLookupKey(syndesis.Namespace)); ok {
+			config.RouteHostname = hostname.(string)
+			return nil
+		}
+
 		syndesisRoute := &routev1.Route{}
 
 		if err := client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: "syndesis"}, syndesisRoute); err != nil {
 			if k8serrors.IsNotFound(err) {
+				if config.Syndesis.RouteDomain != "" {
+					config.RouteHostname = "syndesis-" + syndesis.Namespace + "." + config.Syndesis.RouteDomain
+				}
 				return nil
 			} else {
 				return err
 			}
 		}
 		config.RouteHostname = syndesisRoute.Spec.Host
+		if config.RouteHostname != "" {
+			lookups.put(routeLookupKey(syndesis.Namespace), config.RouteHostname)
+		}
 	} else {
 		config.RouteHostname = os.Getenv("ROUTE_HOSTNAME")
 	}
 	return nil
 }
 
+// Set the proxy and ingress settings from the OpenShift 4 cluster configuration.
+// Clusters without the config.openshift.io API are left untouched.
+func (config *Config) SetClusterNetwork(ctx context.Context, client client.Client) error {
+	if network, ok := lookups.get(clusterNetworkLookupKey); ok {
+		config.setClusterNetworkFrom(network.(*Config))
+		return nil
+	}
+
+	network := &Config{}
+	if err := network.lookupClusterNetwork(ctx, client); err != nil {
+		return err
+	}
+	lookups.put(clusterNetworkLookupKey, network)
+	config.setClusterNetworkFrom(network)
+	return nil
+}
+
+// Set whether the AMQ broker operator is installed, it then provisions the broker of the broker addon
+func (config *Config) SetBrokerOperator(ctx context.Context, cl client.Client, namespace string) error {
+	if !config.Syndesis.Addons.Broker.Enabled {
+		return nil
+	}
+
+	brokers := &unstructured.UnstructuredList{}
+	brokers.SetAPIVersion("broker.amq.io/v2alpha1")
+	brokers.SetKind("ActiveMQArtemisList")
+	err := cl.List(ctx, &client.ListOptions{Namespace: namespace}, brokers)
+	if err != nil && !k8serrors.IsNotFound(err) && !k8serrors.IsForbidden(err) && !util.IsNoKindMatchError(err) {
+		return err
+	}
+	config.BrokerOperator = err == nil
+	return nil
+}
+
+// Set whether the integration platform the camelk addon provisions is ready to run integrations
+func (config *Config) SetCamelKPlatformReady(ctx context.Context, cl client.Client, namespace string) error {
+	if config.Syndesis.Integration.Runtime != v1alpha1.SyndesisIntegrationRuntimeCamelK || !config.Syndesis.Addons.CamelK.Enabled {
+		return nil
+	}
+
+	platform := &unstructured.Unstructured{}
+	platform.SetAPIVersion("camel.apache.org/v1alpha1")
+	platform.SetKind("IntegrationPlatform")
+	err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "camel-k"}, platform)
+	if err != nil {
+		if k8serrors.IsNotFound(err) || util.IsNoKindMatchError(err) {
+			config.CamelKPlatformReady = false
+			return nil
+		}
+		return err
+	}
+	phase, _, _ := unstructured.NestedString(platform.Object, "status", "phase")
+	config.CamelKPlatformReady = phase == "Ready"
+	return nil
+}
+
+// Set whether the cluster runs startup probes, they are enabled by default from kubernetes 1.18 on
+func (config *Config) SetStartupProbes(api discovery.ServerVersionInterface) error {
+	info, err := api.ServerVersion()
+	if err != nil {
+		return err
+	}
+
+	major, _ := strconv.Atoi(info.Major)
+	minor, _ := strconv.Atoi(strings.TrimSuffix(info.Minor, "+"))
+	config.StartupProbes = major > 1 || major == 1 && minor >= 18
+	return nil
+}
+
+func (config *Config) setClusterNetworkFrom(network *Config) {
+	config.HttpProxy = network.HttpProxy
+	config.HttpsProxy = network.HttpsProxy
+	config.NoProxy = network.NoProxy
+	config.ClusterIngressDomain = network.ClusterIngressDomain
+}
+
+func (config *Config) lookupClusterNetwork(ctx context.Context, client client.Client) error {
+	proxy, err := getClusterConfig(ctx, client, "Proxy")
+	if err != nil {
+		return err
+	}
+	if proxy != nil {
+		config.HttpProxy, _, _ = unstructured.NestedString(proxy.Object, "status", "httpProxy")
+		config.HttpsProxy, _, _ = unstructured.NestedString(proxy.Object, "status", "httpsProxy")
+		config.NoProxy, _, _ = unstructured.NestedString(proxy.Object, "status", "noProxy")
+	}
+
+	ingress, err := getClusterConfig(ctx, client, "Ingress")
+	if err != nil {
+		return err
+	}
+	if ingress != nil {
+		config.ClusterIngressDomain, _, _ = unstructured.NestedString(ingress.Object, "spec", "domain")
+	}
+	return nil
+}
+
+func getClusterConfig(ctx context.Context, client client.Client, kind string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("config.openshift.io/v1")
+	obj.SetKind(kind)
+	if err := client.Get(ctx, types.NamespacedName{Name: "cluster"}, obj); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) || util.IsNoKindMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Whether syndesis-server, syndesis-meta and syndesis-ui are installed, or only the infrastructure they run on
+func (config *Config) InstallsApplication() bool {
+	return config.Syndesis.InstallMode != string(v1alpha1.SyndesisInstallModeInfrastructureOnly)
+}
+
+// Client id the oauth proxy authenticates with: an OAuthClient when configured, the syndesis-oauth-client service account otherwise
+func (config *Config) OAuthClientID() string {
+	client := config.Syndesis.Components.Oauth.Client
+	switch {
+	case client.Name != "":
+		return client.Name
+	case client.Managed:
+		return "syndesis-" + config.OpenShiftProject
+	default:
+		return "system:serviceaccount:" + config.OpenShiftProject + ":syndesis-oauth-client"
+	}
+}
+
+// Whether syndesis is exposed outside of the cluster with an OpenShift route
+func (config *Config) ExposedWithRoute() bool {
+	return config.Syndesis.Exposure == "" || config.Syndesis.Exposure == string(v1alpha1.SyndesisExposureRoute)
+}
+
 // When an external database is defined, reset connection parameters
 func (config *Config) ExternalDatabase(ctx context.Context, client client.Client, syndesis *v1alpha1.Syndesis) error {
 	// Handle an external database being defined
@@ -293,16 +870,10 @@ func (config *Config) ExternalDatabase(ctx context.Context, client client.Client
 			return errors.New("failed to find postgresql password in global config")
 		}
 
-		// setup connection string from provided url
-		externalDbURL, err := url.Parse(syndesis.Spec.Components.Database.ExternalDbURL)
-		if err != nil {
+		// setup connection from provided url
+		if err := config.Syndesis.Components.Database.setConnectionFromURL(syndesis.Spec.Components.Database.ExternalDbURL); err != nil {
 			return err
 		}
-		if externalDbURL.Path == "" {
-			externalDbURL.Path = syndesis.Spec.Components.Database.Name
-		}
-
-		config.Syndesis.Components.Database.URL = externalDbURL.String()
 		config.Syndesis.Components.Database.Password = postgresPass
 	}
 
@@ -310,7 +881,7 @@ func (config *Config) ExternalDatabase(ctx context.Context, client client.Client
 }
 
 func (config *Config) setPasswordsFromSecret(ctx context.Context, client client.Client, syndesis *v1alpha1.Syndesis) error {
-	secrets, err := getSyndesisEnvVarsFromOpenShiftNamespace(ctx, client, syndesis.Namespace)
+	secrets, err := GetSyndesisEnvVarsFromOpenShiftNamespace(ctx, client, syndesis.Namespace)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil
@@ -322,10 +893,22 @@ func (config *Config) setPasswordsFromSecret(ctx context.Context, client client.
 	config.OpenShiftOauthClientSecret = secrets["OPENSHIFT_OAUTH_CLIENT_SECRET"]
 	config.Syndesis.Components.Database.Password = secrets["POSTGRESQL_PASSWORD"]
 	config.Syndesis.Components.Database.SampledbPassword = secrets["POSTGRESQL_SAMPLEDB_PASSWORD"]
+	config.Syndesis.Components.Database.ReplicationPassword = secrets["POSTGRESQL_REPLICATION_PASSWORD"]
 	config.Syndesis.Components.Oauth.CookieSecret = secrets["OAUTH_COOKIE_SECRET"]
+	config.Syndesis.Components.Oauth.CookieSecretPrevious = secrets["OAUTH_COOKIE_SECRET_PREVIOUS"]
+	config.Syndesis.Components.Oauth.CookieSecretRotation = secrets["OAUTH_COOKIE_SECRET_ROTATION"]
+	config.Syndesis.Components.Oauth.CookieSecretRotatedAt = secrets["OAUTH_COOKIE_SECRET_ROTATED_AT"]
 	config.Syndesis.Components.Server.SyndesisEncryptKey = secrets["SYNDESIS_ENCRYPT_KEY"]
 	config.Syndesis.Components.Server.ClientStateAuthenticationKey = secrets["CLIENT_STATE_AUTHENTICATION_KEY"]
 	config.Syndesis.Components.Server.ClientStateEncryptionKey = secrets["CLIENT_STATE_ENCRYPTION_KEY"]
+	config.Syndesis.Components.Server.ClientStateTid = secrets["CLIENT_STATE_TID"]
+	config.Syndesis.Components.Server.ClientStatePreviousTid = secrets["CLIENT_STATE_PREVIOUS_TID"]
+	config.Syndesis.Components.Server.ClientStatePreviousAuthenticationKey = secrets["CLIENT_STATE_PREVIOUS_AUTHENTICATION_KEY"]
+	config.Syndesis.Components.Server.ClientStatePreviousEncryptionKey = secrets["CLIENT_STATE_PREVIOUS_ENCRYPTION_KEY"]
+	config.Syndesis.Components.Server.ClientStateKeyRotation = secrets["CLIENT_STATE_KEY_ROTATION"]
+	config.Syndesis.Components.Server.ClientStateKeyRotatedAt = secrets["CLIENT_STATE_KEY_ROTATED_AT"]
+	config.Syndesis.Addons.Broker.Password = secrets["BROKER_PASSWORD"]
+	config.Syndesis.InternalTLS.KeystorePassword = secrets["INTERNAL_TLS_KEYSTORE_PASSWORD"]
 
 	return nil
 }
@@ -377,10 +960,52 @@ func setBoolFromEnv(env string, current bool) bool {
 	return result
 }
 
+// Collect the image stream tags that replace the default component images.
+// Annotations are ignored unless DevSupport is enabled
+func (config *Config) setDevImagesFromAnnotations(syndesis *v1alpha1.Syndesis) {
+	if !config.DevSupport {
+		return
+	}
+
+	for _, component := range []string{"server", "meta", "ui"} {
+		if tag, ok := syndesis.Annotations[DevImageAnnotationPrefix+component]; ok && tag != "" {
+			if config.DevImageStreamTags == nil {
+				config.DevImageStreamTags = map[string]string{}
+			}
+			config.DevImageStreamTags[component] = tag
+		}
+	}
+}
+
+// Sets the deprecated memory of the resources to their memory limit, when set. The configuration still names
+// the memory limit after it.
+func foldMemoryLimits(spec *v1alpha1.SyndesisSpec) {
+	for _, resources := range []*v1alpha1.Resources{
+		&spec.Components.Server.Resources,
+		&spec.Components.Grafana.Resources,
+		&spec.Addons.DV.Resources,
+	} {
+		if resources.MemoryLimit != "" {
+			resources.Memory = resources.MemoryLimit
+		}
+	}
+	for _, resources := range []*v1alpha1.ResourcesWithVolume{
+		&spec.Components.Meta.Resources,
+		&spec.Components.Database.Resources,
+		&spec.Components.Prometheus.Resources,
+	} {
+		if resources.MemoryLimit != "" {
+			resources.Memory = resources.MemoryLimit
+		}
+	}
+}
+
 // Replace default values with those from custom resource
 func (config *Config) setSyndesisFromCustomResource(syndesis *v1alpha1.Syndesis) error {
 	c := SyndesisConfig{}
-	jsonProperties, err := json.Marshal(syndesis.Spec)
+	spec := syndesis.Spec.DeepCopy()
+	foldMemoryLimits(spec)
+	jsonProperties, err := json.Marshal(spec)
 	if err != nil {
 		return err
 	}
@@ -389,41 +1014,713 @@ func (config *Config) setSyndesisFromCustomResource(syndesis *v1alpha1.Syndesis)
 		return err
 	}
 
+	if c.Profile == string(v1alpha1.SyndesisProfileDev) {
+		config.applyDevProfile()
+	}
+
+	// The custom resource can only turn test support on, the TEST_SUPPORT environment variable still applies
+	if syndesis.Spec.TestSupport {
+		c.Components.Server.Features.TestSupport = true
+	}
+
 	if err := mergo.Merge(&config.Syndesis, c, mergo.WithOverride); err != nil {
 		return err
 	}
+
+	// Integrations can't run with Camel K without the addon installing its platform
+	if config.Syndesis.Integration.Runtime == v1alpha1.SyndesisIntegrationRuntimeCamelK {
+		config.Syndesis.Addons.CamelK.Enabled = true
+	}
+
+	// Merging skips false values, prometheus is installed unless explicitly disabled
+	if enabled := syndesis.Spec.Components.Prometheus.Enabled; enabled != nil {
+		config.Syndesis.Components.Prometheus.Enabled = *enabled
+	}
+
+	if databaseURL := syndesis.Spec.Components.Database.URL; databaseURL != "" {
+		if err := config.Syndesis.Components.Database.setConnectionFromURL(databaseURL); err != nil {
+			return err
+		}
+	}
+	return config.Validate()
+}
+
+// Check the install mode, an unknown one would silently install everything
+func (config *Config) validateInstallMode() error {
+	switch v1alpha1.SyndesisInstallMode(config.Syndesis.InstallMode) {
+	case v1alpha1.SyndesisInstallModeFull, v1alpha1.SyndesisInstallModeInfrastructureOnly:
+		return nil
+	default:
+		return fmt.Errorf("install mode %q is neither %s nor %s", config.Syndesis.InstallMode, v1alpha1.SyndesisInstallModeFull, v1alpha1.SyndesisInstallModeInfrastructureOnly)
+	}
+}
+
+// Check the oauth client, the operator can't both manage an OAuthClient and use one provided by the administrator
+func (config *Config) validateOAuthClient() error {
+	client := config.Syndesis.Components.Oauth.Client
+	if client.Managed && client.Name != "" {
+		return fmt.Errorf("oauth client %s is provided by the administrator and cannot be managed by the operator", client.Name)
+	}
+	return nil
+}
+
+// Check the alternate hostnames mode, only an ingress controller can redirect to the canonical hostname
+func (config *Config) validateAlternateHostnames() error {
+	switch v1alpha1.SyndesisAlternateHostnamesMode(config.Syndesis.AlternateHostnamesMode) {
+	case v1alpha1.SyndesisAlternateHostnamesModeNone, v1alpha1.SyndesisAlternateHostnamesModeProxy:
+		return nil
+	case v1alpha1.SyndesisAlternateHostnamesModeRedirect:
+		if config.Syndesis.Exposure != string(v1alpha1.SyndesisExposureIngress) {
+			return fmt.Errorf("alternate hostnames can only redirect to the canonical hostname when syndesis is exposed with %s", v1alpha1.SyndesisExposureIngress)
+		}
+		return nil
+	default:
+		return fmt.Errorf("alternate hostnames mode %q is neither %s, %s nor %s", config.Syndesis.AlternateHostnamesMode,
+			v1alpha1.SyndesisAlternateHostnamesModeNone, v1alpha1.SyndesisAlternateHostnamesModeProxy, v1alpha1.SyndesisAlternateHostnamesModeRedirect)
+	}
+}
+
+// Returns the alternate hostnames served by dedicated routes or ingresses, if any
+func (config *Config) ServedAlternateHostnames() []string {
+	if config.Syndesis.AlternateHostnamesMode == string(v1alpha1.SyndesisAlternateHostnamesModeNone) {
+		return nil
+	}
+	return config.Syndesis.AlternateHostnames
+}
+
+// Check the jaeger sampling strategies, the collector doesn't start with an invalid one
+func (config *Config) validateJaegerSampling() error {
+	jaeger := config.Syndesis.Addons.Jaeger
+	if !jaeger.Enabled {
+		return nil
+	}
+
+	if err := validateSamplingStrategy(jaeger.Sampling.Default); err != nil {
+		return fmt.Errorf("invalid default jaeger sampling strategy: %v", err)
+	}
+	for name, strategy := range jaeger.Sampling.Integrations {
+		if err := validateSamplingStrategy(strategy); err != nil {
+			return fmt.Errorf("invalid jaeger sampling strategy of integration %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// Check the resources of the addon pods, an invalid quantity would only fail once the addon is applied
+func (config *Config) validateAddonPods() error {
+	addons := config.Syndesis.Addons
+	pods := map[string]AddonPodsConfiguration{"jaeger": addons.Jaeger.Pods, "dv": addons.DV.Pods, "todo": addons.Todo.Pods}
+	for _, name := range []string{"jaeger", "dv", "todo"} {
+		resources := pods[name].Resources
+		for _, quantity := range []string{resources.CPURequest, resources.CPULimit, resources.MemoryRequest, resources.MemoryLimit} {
+			if quantity == "" {
+				continue
+			}
+			if _, err := resource.ParseQuantity(quantity); err != nil {
+				return fmt.Errorf("invalid resources of the %s addon: %q is not a quantity", name, quantity)
+			}
+		}
+	}
+	return nil
+}
+
+// Snapshots are taken of the volume claim of the bundled database
+func (config *Config) validateBackup() error {
+	switch v1alpha1.SyndesisBackupMethod(config.Syndesis.Backup.Method) {
+	case v1alpha1.SyndesisBackupMethodDump:
+		return nil
+	case v1alpha1.SyndesisBackupMethodSnapshot:
+		if config.Syndesis.Components.Database.ExternalDbURL != "" {
+			return errors.New("an external database cannot be backed up with volume snapshots")
+		}
+		return nil
+	default:
+		return fmt.Errorf("backup method %q is neither %s nor %s", config.Syndesis.Backup.Method, v1alpha1.SyndesisBackupMethodDump, v1alpha1.SyndesisBackupMethodSnapshot)
+	}
+}
+
+// Whether the database is backed up with volume snapshots
+func (config *Config) SnapshotsDatabase() bool {
+	return config.Syndesis.Backup.Method == string(v1alpha1.SyndesisBackupMethodSnapshot)
+}
+
+// The todo app enabled as an addon keeps using the sampledb database
+func (config *Config) validateDemoData() error {
+	if config.Syndesis.Components.Server.Features.DemoData.Purge && config.Syndesis.Addons.Todo.Enabled {
+		return errors.New("demo data cannot be purged while the todo addon is enabled")
+	}
+	return nil
+}
+
+// Namespace of the image stream the database deployments trigger on, the syndesis one when its tag is mirrored
+func (config *Config) DatabaseImageStreamNamespace() string {
+	if _, ok := config.MirroredImageStreamTags[config.Syndesis.Components.Database.Image]; ok {
+		return config.OpenShiftProject
+	}
+	return config.Syndesis.Components.Database.ImageStreamNamespace
+}
+
+// Whether the sampledb database is installed, for the demo content using it
+func (config *Config) InstallsSampleDB() bool {
+	demo := config.Syndesis.Components.Server.Features.DemoData
+	if demo.Purge {
+		return false
+	}
+	return demo.SampleDB || demo.Todo || demo.SampleIntegrations || config.Syndesis.Addons.Todo.Enabled
+}
+
+// Whether the todo app is installed, as an addon or as demo content
+func (config *Config) InstallsTodo() bool {
+	demo := config.Syndesis.Components.Server.Features.DemoData
+	return config.Syndesis.Addons.Todo.Enabled || demo.Todo && !demo.Purge
+}
+
+// Whether syndesis-server loads the sample integrations when it starts
+func (config *Config) LoadsSampleIntegrations() bool {
+	demo := config.Syndesis.Components.Server.Features.DemoData
+	return demo.SampleIntegrations && !demo.Purge
+}
+
+// Check the tuning of the integration controller
+func (config *Config) validateIntegrationRuntime() error {
+	switch config.Syndesis.Integration.Runtime {
+	case "", v1alpha1.SyndesisIntegrationRuntimeSpringBoot, v1alpha1.SyndesisIntegrationRuntimeCamelK:
+		return nil
+	default:
+		return fmt.Errorf("unsupported integration runtime %s, use one of: springboot, camelk", config.Syndesis.Integration.Runtime)
+	}
+}
+
+// Whether syndesis-server runs the integrations as Camel K integrations instead of building them with S2I.
+// When camelk is asked for explicitly, integrations keep being built with S2I until the Camel K platform is ready.
+func (config *Config) CamelKIntegrations() bool {
+	if !config.Syndesis.Addons.CamelK.Enabled {
+		return false
+	}
+	switch config.Syndesis.Integration.Runtime {
+	case v1alpha1.SyndesisIntegrationRuntimeSpringBoot:
+		return false
+	case v1alpha1.SyndesisIntegrationRuntimeCamelK:
+		return config.CamelKPlatformReady
+	default:
+		return true
+	}
+}
+
+func (config *Config) validateIntegrationController() error {
+	controller := config.Syndesis.Integration.Controller
+	if controller.StateCheckInterval < 0 || controller.MaxConcurrentDeployments < 0 ||
+		controller.RetryBackoff.InitialDelay < 0 || controller.RetryBackoff.MaxDelay < 0 {
+		return errors.New("integration controller settings cannot be negative")
+	}
+	backoff := controller.RetryBackoff
+	if backoff.InitialDelay > 0 && backoff.MaxDelay > 0 && backoff.MaxDelay < backoff.InitialDelay {
+		return fmt.Errorf("integration retry max delay %ds is shorter than the initial delay %ds", backoff.MaxDelay, backoff.InitialDelay)
+	}
+	return nil
+}
+
+// Server defaults of the memory of the integration deployments, lowered to fit the integration quota
+const (
+	integrationMemoryRequestMi = 200
+	integrationMemoryLimitMi   = 512
+)
+
+func (config *Config) validateIntegrationQuota() error {
+	quota := config.Syndesis.Integration.Quota
+	if quota.MaxIntegrationsPerUser < 0 || quota.MaxIntegrationsPerNamespace < 0 {
+		return errors.New("integration quota limits cannot be negative")
+	}
+	if quota.MaxMemory == "" {
+		return nil
+	}
+	memory, err := resource.ParseQuantity(quota.MaxMemory)
+	if err != nil {
+		return fmt.Errorf("integration max memory %q is not a quantity: %v", quota.MaxMemory, err)
+	}
+	if memory.Value() < 64*1024*1024 {
+		return fmt.Errorf("integration max memory %s is below 64Mi, integrations cannot run with it", quota.MaxMemory)
+	}
+	return nil
+}
+
+// Check the selector of the synced secrets, the namespaces being checked against the integration namespaces when syncing
+func (config *Config) validateSecretSync() error {
+	sync := config.Syndesis.Integration.SecretSync
+	if sync.Selector == "" {
+		return nil
+	}
+	selector, err := labels.Parse(sync.Selector)
+	if err != nil {
+		return fmt.Errorf("secret sync selector %q is invalid: %v", sync.Selector, err)
+	}
+	if selector.Empty() {
+		return fmt.Errorf("secret sync selector %q selects every secret", sync.Selector)
+	}
+	return nil
+}
+
+// Integrations a user may create and deploy, 0 meaning unlimited
+func (config *Config) MaxIntegrationsPerUser() int {
+	if limit := config.Syndesis.Integration.Quota.MaxIntegrationsPerUser; limit > 0 {
+		return limit
+	}
+	return config.Syndesis.Components.Server.Features.IntegrationLimit
+}
+
+// Memory limit of the integration deployments in MiB, the server default unless the quota is lower
+func (config *Config) IntegrationMemoryLimitMi() int64 {
+	limit := int64(integrationMemoryLimitMi)
+	if config.Syndesis.Integration.Quota.MaxMemory != "" {
+		if memory, err := resource.ParseQuantity(config.Syndesis.Integration.Quota.MaxMemory); err == nil && memory.Value()/(1024*1024) < limit {
+			limit = memory.Value() / (1024 * 1024)
+		}
+	}
+	return limit
+}
+
+// Memory request of the integration deployments in MiB, never above their limit
+func (config *Config) IntegrationMemoryRequestMi() int64 {
+	if limit := config.IntegrationMemoryLimitMi(); limit < integrationMemoryRequestMi {
+		return limit
+	}
+	return integrationMemoryRequestMi
+}
+
+// Check the connector lists, a connector cannot be both allowed and denied
+func (config *Config) validateConnectors() error {
+	allowed := map[string]bool{}
+	for _, id := range config.Syndesis.Connectors.Allow {
+		if strings.TrimSpace(id) == "" {
+			return errors.New("allowed connector ids cannot be empty")
+		}
+		allowed[id] = true
+	}
+	for _, id := range config.Syndesis.Connectors.Deny {
+		if strings.TrimSpace(id) == "" {
+			return errors.New("denied connector ids cannot be empty")
+		}
+		if allowed[id] {
+			return fmt.Errorf("connector %s is both allowed and denied", id)
+		}
+	}
+	return nil
+}
+
+// The route labels and annotations must be valid, and leave alone the ones the operator manages
+func (config *Config) validateRoute() error {
+	for name, value := range config.Syndesis.Route.Labels {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid route label %s: %s", name, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid value of route label %s: %s", name, strings.Join(errs, ", "))
+		}
+		if name == "app" || strings.HasPrefix(name, "syndesis.io/") {
+			return fmt.Errorf("route label %s is managed by the operator", name)
+		}
+	}
+	for name := range config.Syndesis.Route.Annotations {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid route annotation %s: %s", name, strings.Join(errs, ", "))
+		}
+		if name == "console.alpha.openshift.io/overview-app-route" {
+			return fmt.Errorf("route annotation %s is managed by the operator", name)
+		}
+	}
+	return nil
+}
+
+// Check the resources of the sidecar containers
+func (config *Config) validateSidecarResources() error {
+	sidecars := map[string]AddonResources{
+		"oauth proxy":       config.Syndesis.Components.Oauth.Resources,
+		"database exporter": config.Syndesis.Components.Database.Exporter.Resources,
+	}
+	for name, resources := range sidecars {
+		for _, quantity := range []string{resources.CPURequest, resources.CPULimit, resources.MemoryRequest, resources.MemoryLimit} {
+			if quantity == "" {
+				continue
+			}
+			if _, err := resource.ParseQuantity(quantity); err != nil {
+				return fmt.Errorf("invalid resources of the %s: %q is not a quantity", name, quantity)
+			}
+		}
+	}
+	return nil
+}
+
+// Check the resources and the timeout of the upgrade pod
+func (config *Config) validateUpgrade() error {
+	upgrade := config.Syndesis.Components.Upgrade
+	resources := upgrade.Pods.Resources
+	for _, quantity := range []string{resources.CPURequest, resources.CPULimit, resources.MemoryRequest, resources.MemoryLimit} {
+		if quantity == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(quantity); err != nil {
+			return fmt.Errorf("invalid resources of the upgrade pod: %q is not a quantity", quantity)
+		}
+	}
+	if _, err := config.UpgradeTimeout(); err != nil {
+		return err
+	}
+	switch v1alpha1.SyndesisUpgradeFailurePolicy(upgrade.OnFailure) {
+	case v1alpha1.SyndesisUpgradeFailurePolicyRetry, v1alpha1.SyndesisUpgradeFailurePolicyQuarantine:
+	default:
+		return fmt.Errorf("upgrade failure policy %q is neither %s nor %s", upgrade.OnFailure, v1alpha1.SyndesisUpgradeFailurePolicyRetry, v1alpha1.SyndesisUpgradeFailurePolicyQuarantine)
+	}
 	return nil
 }
 
+// Whether a failed upgrade is kept as it is for an administrator to look into, instead of being retried
+func (config *Config) QuarantinesFailedUpgrades() bool {
+	return config.Syndesis.Components.Upgrade.OnFailure == string(v1alpha1.SyndesisUpgradeFailurePolicyQuarantine)
+}
+
+// Longest time the upgrade pod may run, 0 when it is not limited
+func (config *Config) UpgradeTimeout() (time.Duration, error) {
+	timeout := config.Syndesis.Components.Upgrade.Timeout
+	if timeout == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("upgrade timeout %q is not a duration", timeout)
+	}
+	return duration, nil
+}
+
+// Time left before the expiry of a serving certificate from which it is reported, 0 when they aren't monitored
+func (config *Config) CertificateExpiryThreshold() (time.Duration, error) {
+	threshold := config.Syndesis.Certificates.ExpiryThreshold
+	if threshold == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(threshold)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("certificate expiry threshold %q is not a duration", threshold)
+	}
+	return duration, nil
+}
+
+// Check the federation endpoint, prometheus refuses federation requests without series selectors
+func (config *Config) validatePrometheusFederation() error {
+	prometheus := config.Syndesis.Components.Prometheus
+	if !prometheus.Federation.Enabled {
+		return nil
+	}
+	if !prometheus.Enabled {
+		return errors.New("prometheus federation requires the bundled prometheus to be enabled")
+	}
+	if len(prometheus.Federation.Match) == 0 {
+		return errors.New("prometheus federation requires at least one series selector to match")
+	}
+	for _, match := range prometheus.Federation.Match {
+		if strings.TrimSpace(match) == "" {
+			return errors.New("prometheus federation series selectors cannot be empty")
+		}
+	}
+	return nil
+}
+
+// Hostname of the federation route, empty when the cluster generates it and its domain is unknown
+func (config *Config) FederationHostname() string {
+	if hostname := config.Syndesis.Components.Prometheus.Federation.Hostname; hostname != "" {
+		return hostname
+	}
+	if config.ClusterIngressDomain != "" {
+		// The hostname the cluster generates for the route
+		return "syndesis-prometheus-federation-" + config.OpenShiftProject + "." + config.ClusterIngressDomain
+	}
+	return ""
+}
+
+// Check the host aliases and the DNS suffix, which the API server would only refuse when applying the pods
+func (config *Config) validateNameResolution() error {
+	for _, alias := range config.Syndesis.HostAliases {
+		if net.ParseIP(alias.IP) == nil {
+			return fmt.Errorf("host alias ip %q is not an ip address", alias.IP)
+		}
+		if len(alias.Hostnames) == 0 {
+			return fmt.Errorf("host alias %s has no hostnames", alias.IP)
+		}
+		for _, hostname := range alias.Hostnames {
+			if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+				return fmt.Errorf("host alias hostname %q is invalid: %s", hostname, strings.Join(errs, ", "))
+			}
+		}
+	}
+
+	if suffix := config.Syndesis.DNSSuffix; suffix != "" {
+		if errs := validation.IsDNS1123Subdomain(suffix); len(errs) > 0 {
+			return fmt.Errorf("dns suffix %q is invalid: %s", suffix, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+var relabelActions = map[string]bool{
+	"replace": true, "keep": true, "drop": true, "hashmod": true, "labelmap": true, "labeldrop": true, "labelkeep": true,
+}
+
+// Check the remote writes of prometheus, which otherwise refuses to load its whole configuration
+func (config *Config) validatePrometheusRemoteWrite() error {
+	prometheus := config.Syndesis.Components.Prometheus
+	if len(prometheus.RemoteWrite) == 0 {
+		return nil
+	}
+	if !prometheus.Enabled {
+		return errors.New("prometheus remote write requires the bundled prometheus to be enabled")
+	}
+
+	for _, remote := range prometheus.RemoteWrite {
+		endpoint, err := url.Parse(remote.URL)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("prometheus remote write url %q is not an http(s) url", remote.URL)
+		}
+		if (remote.Username == "") != (remote.PasswordSecret == "") {
+			return fmt.Errorf("prometheus remote write to %s needs both a username and a password secret for basic authentication", remote.URL)
+		}
+		if remote.PasswordSecret != "" && remote.BearerTokenSecret != "" {
+			return fmt.Errorf("prometheus remote write to %s cannot use both basic authentication and a bearer token", remote.URL)
+		}
+		for _, relabel := range remote.WriteRelabelConfigs {
+			if relabel.Action != "" && !relabelActions[relabel.Action] {
+				return fmt.Errorf("prometheus remote write to %s has an unknown relabel action %q", remote.URL, relabel.Action)
+			}
+			if _, err := regexp.Compile(relabel.Regex); err != nil {
+				return fmt.Errorf("prometheus remote write to %s has an invalid relabel regex %q: %v", remote.URL, relabel.Regex, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSamplingStrategy(strategy JaegerSamplingStrategy) error {
+	param, err := strconv.ParseFloat(strategy.Param, 64)
+	if err != nil || param < 0 {
+		return fmt.Errorf("param %q is not a positive number", strategy.Param)
+	}
+
+	switch strategy.Type {
+	case "probabilistic":
+		if param > 1 {
+			return fmt.Errorf("param %q is not a probability", strategy.Param)
+		}
+	case "ratelimiting":
+	default:
+		return fmt.Errorf("type %q is neither probabilistic nor ratelimiting", strategy.Type)
+	}
+	return nil
+}
+
+// Check the read replicas, the activities of the dashboard cannot be read from a datasource the server cannot connect to
+func (config *Config) validateDatabaseReplicas() error {
+	database := config.Syndesis.Components.Database
+	if database.ReadReplicas < 0 {
+		return fmt.Errorf("number of database read replicas %d is negative", database.ReadReplicas)
+	}
+	if len(database.ExternalReplicaURLs) > 0 && database.ExternalDbURL == "" {
+		return errors.New("external database replicas are only supported with an external database")
+	}
+	for _, replica := range database.ExternalReplicaURLs {
+		if u, err := url.Parse(replica); err != nil || u.Host == "" {
+			return fmt.Errorf("external database replica %q is not a postgresql://host:port url", replica)
+		}
+	}
+	return nil
+}
+
+// Check the primary of a standby installation, which streams its database from the one the operator installed there
+func (config *Config) validateStandby() error {
+	standby := config.Syndesis.Standby
+	if !standby.Enabled {
+		return nil
+	}
+	if config.Syndesis.Components.Database.ExternalDbURL != "" {
+		return errors.New("a standby installation replicates the database installed by the operator, not an external one")
+	}
+	if standby.PrimaryHost == "" {
+		return errors.New("the primary host of the standby installation is missing")
+	}
+	if net.ParseIP(standby.PrimaryHost) == nil {
+		if errs := validation.IsDNS1123Subdomain(standby.PrimaryHost); len(errs) > 0 {
+			return fmt.Errorf("primary host %q of the standby installation is invalid: %s", standby.PrimaryHost, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// Whether the installed database runs as a primary standby installations or read replicas can stream
+func (config *Config) DatabaseReplication() bool {
+	return config.Syndesis.Components.Database.ReadReplicas > 0 || config.Syndesis.Standby.AllowReplication
+}
+
+var statementTimeout = regexp.MustCompile(`^[0-9]+(ms|s|min|h|d)?$`)
+
+// Check the database maintenance schedule, an invalid one is only reported by the cronjob controller
+func (config *Config) validateDatabaseMaintenance() error {
+	maintenance := config.Syndesis.Components.Database.Maintenance
+	if !maintenance.Enabled {
+		return nil
+	}
+
+	if len(strings.Fields(maintenance.Schedule)) != 5 {
+		return fmt.Errorf("database maintenance schedule %q is not a cron schedule", maintenance.Schedule)
+	}
+	if !statementTimeout.MatchString(maintenance.StatementTimeout) {
+		return fmt.Errorf("database maintenance statement timeout %q is not a postgresql duration", maintenance.StatementTimeout)
+	}
+	return nil
+}
+
+// Check the service level objectives, they end up verbatim in the prometheus rules
+func (config *Config) validateSLO() error {
+	ops := config.Syndesis.Addons.Ops
+	if !ops.Enabled {
+		return nil
+	}
+
+	rate, err := strconv.ParseFloat(ops.SLO.SuccessRate, 64)
+	if err != nil || rate <= 0 || rate >= 100 {
+		return fmt.Errorf("slo success rate %q is not a percentage below 100", ops.SLO.SuccessRate)
+	}
+	if ops.SLO.LatencyThreshold <= 0 {
+		return fmt.Errorf("slo latency threshold %d is not a positive number of milliseconds", ops.SLO.LatencyThreshold)
+	}
+	return nil
+}
+
+// Rotate the oauth cookie secret when the rotation token changed. The current secret is kept as
+// previous secret until the grace period is over, so that sessions using it are not dropped at once.
+func (config *Config) RotateCookieSecret(rotation string, now time.Time) error {
+	oauth := &config.Syndesis.Components.Oauth
+
+	if rotation != "" && rotation != oauth.CookieSecretRotation {
+		oauth.CookieSecretPrevious = oauth.CookieSecret
+		oauth.CookieSecret = generatePassword(config.passwordLength(32))
+		oauth.CookieSecretRotation = rotation
+		oauth.CookieSecretRotatedAt = now.UTC().Format(time.RFC3339)
+		return nil
+	}
+
+	if oauth.CookieSecretPrevious == "" || oauth.CookieSecretRotatedAt == "" {
+		return nil
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, oauth.CookieSecretRotatedAt)
+	if err != nil {
+		return err
+	}
+	gracePeriod, err := time.ParseDuration(oauth.CookieSecretGracePeriod)
+	if err != nil {
+		return err
+	}
+	if now.After(rotatedAt.Add(gracePeriod)) {
+		oauth.CookieSecretPrevious = ""
+	}
+	return nil
+}
+
+// Rotate the client state keys when the rotation token changed. New client state is signed and encrypted
+// with new keys under a new identifier, while the previous keys are still accepted until the grace period
+// is over, so that the sessions of the users are not broken by the rotation.
+func (config *Config) RotateClientStateKeys(rotation string, now time.Time) error {
+	server := &config.Syndesis.Components.Server
+
+	if rotation != "" && rotation != server.ClientStateKeyRotation {
+		tid, err := strconv.Atoi(server.ClientStateTid)
+		if err != nil {
+			// Installations before rotations were supported always used the first identifier
+			tid = 1
+		}
+		server.ClientStatePreviousTid = strconv.Itoa(tid)
+		server.ClientStatePreviousAuthenticationKey = server.ClientStateAuthenticationKey
+		server.ClientStatePreviousEncryptionKey = server.ClientStateEncryptionKey
+		server.ClientStateTid = strconv.Itoa(tid + 1)
+		server.ClientStateAuthenticationKey = generatePassword(config.passwordLength(32))
+		server.ClientStateEncryptionKey = generatePassword(config.passwordLength(32))
+		server.ClientStateKeyRotation = rotation
+		server.ClientStateKeyRotatedAt = now.UTC().Format(time.RFC3339)
+		return nil
+	}
+
+	if server.ClientStatePreviousTid == "" || server.ClientStateKeyRotatedAt == "" {
+		return nil
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, server.ClientStateKeyRotatedAt)
+	if err != nil {
+		return err
+	}
+	gracePeriod, err := time.ParseDuration(server.ClientStateKeyGracePeriod)
+	if err != nil {
+		return err
+	}
+	if now.After(rotatedAt.Add(gracePeriod)) {
+		server.ClientStatePreviousTid = ""
+		server.ClientStatePreviousAuthenticationKey = ""
+		server.ClientStatePreviousEncryptionKey = ""
+	}
+	return nil
+}
+
+// Reduce the footprint of the installation so that it fits into a small VM like CodeReady Containers.
+// Values explicitly set in the custom resource take precedence.
+func (config *Config) applyDevProfile() {
+	components := &config.Syndesis.Components
+	components.UI.Replicas = 1
+	components.Server.Replicas = 1
+	components.Server.Resources.Memory = "512Mi"
+	components.Meta.Resources.Memory = "256Mi"
+	components.Prometheus.Resources.Memory = "256Mi"
+	components.Prometheus.DisablePersistence = true
+	config.Syndesis.Addons.DV.Resources.Memory = "512Mi"
+	config.Syndesis.RelaxedProbes = true
+}
+
 // Generate random expressions for passwords and secrets
 func (config *Config) generatePasswords() {
 
 	if config.OpenShiftOauthClientSecret == "" {
-		config.OpenShiftOauthClientSecret = generatePassword(64)
+		config.OpenShiftOauthClientSecret = generatePassword(config.passwordLength(64))
 	}
 
 	if config.Syndesis.Components.Database.Password == "" {
-		config.Syndesis.Components.Database.Password = generatePassword(16)
+		config.Syndesis.Components.Database.Password = generatePassword(config.passwordLength(16))
 	}
 
 	if config.Syndesis.Components.Database.SampledbPassword == "" {
-		config.Syndesis.Components.Database.SampledbPassword = generatePassword(16)
+		config.Syndesis.Components.Database.SampledbPassword = generatePassword(config.passwordLength(16))
+	}
+
+	if config.Syndesis.Components.Database.ReplicationPassword == "" {
+		config.Syndesis.Components.Database.ReplicationPassword = generatePassword(config.passwordLength(16))
 	}
 
 	if config.Syndesis.Components.Oauth.CookieSecret == "" {
-		config.Syndesis.Components.Oauth.CookieSecret = generatePassword(32)
+		config.Syndesis.Components.Oauth.CookieSecret = generatePassword(config.passwordLength(32))
 	}
 
 	if config.Syndesis.Components.Server.SyndesisEncryptKey == "" {
-		config.Syndesis.Components.Server.SyndesisEncryptKey = generatePassword(64)
+		config.Syndesis.Components.Server.SyndesisEncryptKey = generatePassword(config.passwordLength(64))
 	}
 
 	if config.Syndesis.Components.Server.ClientStateAuthenticationKey == "" {
-		config.Syndesis.Components.Server.ClientStateAuthenticationKey = generatePassword(32)
+		config.Syndesis.Components.Server.ClientStateAuthenticationKey = generatePassword(config.passwordLength(32))
 	}
 
 	if config.Syndesis.Components.Server.ClientStateEncryptionKey == "" {
-		config.Syndesis.Components.Server.ClientStateEncryptionKey = generatePassword(32)
+		config.Syndesis.Components.Server.ClientStateEncryptionKey = generatePassword(config.passwordLength(32))
+	}
+
+	if config.Syndesis.Addons.Broker.Password == "" {
+		config.Syndesis.Addons.Broker.Password = generatePassword(config.passwordLength(16))
+	}
+
+	if config.Syndesis.InternalTLS.KeystorePassword == "" {
+		config.Syndesis.InternalTLS.KeystorePassword = generatePassword(config.passwordLength(32))
 	}
 }
 
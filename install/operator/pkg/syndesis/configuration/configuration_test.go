@@ -21,8 +21,13 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/stretchr/testify/assert"
 
@@ -61,6 +66,37 @@ func Test_loadFromFile(t *testing.T) {
 	}
 }
 
+func Test_loadFromConfigMap(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-operator-config", Namespace: "syndesis"},
+		Data: map[string]string{TemplateConfigMapKey: `
+Syndesis:
+  Components:
+    Server:
+      Features:
+        IntegrationLimit: 5
+`},
+	}
+
+	config := getConfigLiteral()
+	assert.NoError(t, config.loadFromConfigMap(context.TODO(), fake.NewFakeClient(cm), "syndesis", "syndesis-operator-config"))
+	assert.Equal(t, 5, config.Syndesis.Components.Server.Features.IntegrationLimit)
+	// Fields the ConfigMap doesn't set are kept
+	assert.Equal(t, getConfigLiteral().Syndesis.Addons.CamelK, config.Syndesis.Addons.CamelK)
+
+	// Without the ConfigMap or its key, the configuration of the file is kept
+	config = getConfigLiteral()
+	assert.NoError(t, config.loadFromConfigMap(context.TODO(), fake.NewFakeClient(), "syndesis", "syndesis-operator-config"))
+	assert.Equal(t, getConfigLiteral(), config)
+
+	cm.Data = map[string]string{"application.yml": ""}
+	assert.NoError(t, config.loadFromConfigMap(context.TODO(), fake.NewFakeClient(cm), "syndesis", "syndesis-operator-config"))
+	assert.Equal(t, getConfigLiteral(), config)
+
+	cm.Data = map[string]string{TemplateConfigMapKey: "Syndesis: ["}
+	assert.Error(t, config.loadFromConfigMap(context.TODO(), fake.NewFakeClient(cm), "syndesis", "syndesis-operator-config"))
+}
+
 func Test_setConfigFromEnv(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -191,7 +227,7 @@ func Test_setSyndesisFromCustomResource(t *testing.T) {
 							SamplerType:  "const",
 							SamplerParam: "0",
 						},
-						Todo: v1alpha1.AddonSpec{Enabled: true},
+						Todo: v1alpha1.TodoConfiguration{Enabled: true},
 						DV: v1alpha1.DvConfiguration{
 							Enabled: true,
 						},
@@ -206,9 +242,18 @@ func Test_setSyndesisFromCustomResource(t *testing.T) {
 							Enabled:      true,
 							SamplerType:  "const",
 							SamplerParam: "0",
+							Sampling: JaegerSamplingConfiguration{
+								Default: JaegerSamplingStrategy{Type: "probabilistic", Param: "1"},
+							},
+						},
+						Ops: OpsConfiguration{
+							SLO: SLOConfiguration{SuccessRate: "99.5", LatencyThreshold: 1000},
+						},
+						Todo: TodoConfiguration{Enabled: true},
+						Broker: BrokerConfiguration{
+							Image: "docker.io/vromero/activemq-artemis:2.9.0-alpine",
+							User:  "syndesis",
 						},
-						Ops:     AddonConfiguration{Enabled: false},
-						Todo:    AddonConfiguration{Enabled: true},
 						Knative: AddonConfiguration{Enabled: false},
 						DV: DvConfiguration{
 							Enabled:   true,
@@ -247,12 +292,12 @@ func Test_generatePasswords(t *testing.T) {
 	tests := []struct {
 		name   string
 		got    *Config
-		length [7]int
+		length [8]int
 	}{
 		{
 			name:   "Passwords and secrets should be generated when they values are empty",
 			got:    &Config{},
-			length: [7]int{64, 16, 16, 32, 64, 32, 32},
+			length: [8]int{64, 16, 16, 32, 64, 32, 32, 16},
 		},
 		{
 			name: "Passwords and secrets should be generated when they values are empty",
@@ -262,8 +307,9 @@ func Test_generatePasswords(t *testing.T) {
 					Components: ComponentsSpec{
 						Oauth: OauthConfiguration{CookieSecret: "qwerqwer"},
 						Database: DatabaseConfiguration{
-							Password:         "1234qwer",
-							SampledbPassword: "12ed",
+							Password:            "1234qwer",
+							SampledbPassword:    "12ed",
+							ReplicationPassword: "qa",
 						},
 						Server: ServerConfiguration{
 							SyndesisEncryptKey:           "poyotu",
@@ -273,7 +319,7 @@ func Test_generatePasswords(t *testing.T) {
 					},
 				},
 			},
-			length: [7]int{4, 8, 4, 8, 6, 6, 2},
+			length: [8]int{4, 8, 4, 8, 6, 6, 2, 2},
 		},
 	}
 	for _, tt := range tests {
@@ -286,6 +332,7 @@ func Test_generatePasswords(t *testing.T) {
 			assert.Len(t, tt.got.Syndesis.Components.Server.SyndesisEncryptKey, tt.length[4])
 			assert.Len(t, tt.got.Syndesis.Components.Server.ClientStateAuthenticationKey, tt.length[5])
 			assert.Len(t, tt.got.Syndesis.Components.Server.ClientStateEncryptionKey, tt.length[6])
+			assert.Len(t, tt.got.Syndesis.Components.Database.ReplicationPassword, tt.length[7])
 		})
 	}
 }
@@ -306,14 +353,54 @@ func getConfigLiteral() *Config {
 		RouteHostname:              "",
 		OpenShiftConsoleUrl:        "",
 		Syndesis: SyndesisConfig{
+			InstallMode: "full",
+			Exposure:    "route",
+
+			AlternateHostnamesMode: "none",
+			ConsoleLink: ConsoleLinkConfiguration{
+				Disabled: false,
+				Text:     "Syndesis",
+				Section:  "Integrations",
+				ImageURL: "",
+			},
+			Telemetry: TelemetryConfiguration{
+				Interval: "24h",
+			},
+			Remediation: RemediationConfiguration{
+				RestartThreshold: 5,
+				Interval:         "10m",
+			},
+			Certificates: CertificatesConfiguration{ExpiryThreshold: "720h"},
+			SmokeTest:    SmokeTestConfiguration{Image: "registry.access.redhat.com/ubi8/ubi-minimal:latest"},
+			InternalTLS:  InternalTLSConfiguration{Issuer: "service-ca"},
+			ImagePolicy:  ImagePolicyConfiguration{Update: "pinned"},
+			Integration: IntegrationConfiguration{
+				Republish: IntegrationRepublishConfiguration{BatchSize: 5, Delay: "1m", Timeout: "30m", FailureThreshold: 3},
+			},
+			Backup: BackupConfiguration{Method: "dump"},
+			StartupProbe: StartupProbeConfiguration{
+				PeriodSeconds:    10,
+				FailureThreshold: 60,
+			},
 			Addons: AddonsSpec{
 				Jaeger: JaegerConfiguration{
 					Enabled:      false,
 					SamplerType:  "const",
 					SamplerParam: "0",
+					Sampling: JaegerSamplingConfiguration{
+						Default: JaegerSamplingStrategy{Type: "probabilistic", Param: "1"},
+					},
+				},
+				Ops: OpsConfiguration{
+					Enabled: false,
+					SLO:     SLOConfiguration{SuccessRate: "99.5", LatencyThreshold: 1000},
+				},
+				Todo: TodoConfiguration{Enabled: false},
+				Broker: BrokerConfiguration{
+					Enabled: false,
+					Image:   "docker.io/vromero/activemq-artemis:2.9.0-alpine",
+					User:    "syndesis",
 				},
-				Ops:  AddonConfiguration{Enabled: false},
-				Todo: AddonConfiguration{Enabled: false},
 				DV: DvConfiguration{
 					Enabled:   false,
 					Image:     "docker.io/teiid/syndesis-dv:latest",
@@ -327,17 +414,18 @@ func getConfigLiteral() *Config {
 				},
 			},
 			Components: ComponentsSpec{
-				Oauth: OauthConfiguration{Image: "quay.io/openshift/origin-oauth-proxy:v4.0.0"},
-				UI:    UIConfiguration{Image: "docker.io/syndesis/syndesis-ui:latest"},
+				Oauth: OauthConfiguration{Image: "quay.io/openshift/origin-oauth-proxy:v4.0.0", CookieSecretGracePeriod: "24h"},
+				UI:    UIConfiguration{Image: "docker.io/syndesis/syndesis-ui:latest", Replicas: 1, Shutdown: ShutdownConfiguration{TerminationGracePeriodSeconds: 30}},
 				S2I:   S2IConfiguration{Image: "docker.io/syndesis/syndesis-s2i:latest"},
 				Server: ServerConfiguration{
 					Image:                         "docker.io/syndesis/syndesis-server:latest",
 					ControllersIntegrationEnabled: true,
+					Replicas:                      1,
 					Resources:                     Resources{Memory: "800Mi"},
 					Features: ServerFeatures{
 						IntegrationLimit:              0,
 						IntegrationStateCheckInterval: 60,
-						DemoData:                      false,
+						DemoData:                      DemoDataConfiguration{},
 						DeployIntegrations:            true,
 						TestSupport:                   false,
 						OpenShiftMaster:               "https://localhost:8443",
@@ -347,6 +435,8 @@ func getConfigLiteral() *Config {
 							"repo-03-jboss-ea":  "https://repository.jboss.org/nexus/content/groups/ea/",
 						},
 					},
+					Shutdown:                  ShutdownConfiguration{TerminationGracePeriodSeconds: 60},
+					ClientStateKeyGracePeriod: "24h",
 				},
 				Meta: MetaConfiguration{
 					Image: "docker.io/syndesis/syndesis-meta:latest",
@@ -354,35 +444,213 @@ func getConfigLiteral() *Config {
 						Memory:         "512Mi",
 						VolumeCapacity: "1Gi",
 					},
+					Shutdown: ShutdownConfiguration{TerminationGracePeriodSeconds: 30},
 				},
 				Database: DatabaseConfiguration{
 					ImageStreamNamespace: "openshift",
 					Image:                "postgresql:9.6",
 					User:                 "syndesis",
 					Name:                 "syndesis",
-					URL:                  "postgresql://syndesis-db:5432/syndesis?sslmode=disable",
-					Exporter:             ExporterConfiguration{Image: "docker.io/wrouesnel/postgres_exporter:v0.4.7"},
+					Connection: DatabaseConnection{
+						Host:    "syndesis-db",
+						Port:    5432,
+						SSLMode: "disable",
+					},
+					Exporter: ExporterConfiguration{Image: "docker.io/wrouesnel/postgres_exporter:v0.4.7"},
+					Maintenance: DatabaseMaintenance{
+						Schedule:         "0 3 * * 0",
+						StatementTimeout: "1h",
+						Image:            "docker.io/centos/postgresql-96-centos7:latest",
+					},
 					Resources: ResourcesWithVolume{
 						Memory:         "255Mi",
 						VolumeCapacity: "1Gi",
 					},
 				},
 				Prometheus: PrometheusConfiguration{
-					Image: "docker.io/prom/prometheus:v2.1.0",
+					Enabled: true,
+					Image:   "docker.io/prom/prometheus:v2.1.0",
 					Resources: ResourcesWithVolume{
 						Memory:         "512Mi",
 						VolumeCapacity: "1Gi",
 					},
+					Federation: PrometheusFederation{Match: []string{`{integration=~".+"}`}},
 				},
 				Upgrade: UpgradeConfiguration{
 					Image:     "docker.io/syndesis/syndesis-upgrade:latest",
 					Resources: VolumeOnlyResources{VolumeCapacity: "1Gi"},
+					Timeout:   "1h",
+					OnFailure: "retry",
 				},
 			},
 		},
 	}
 }
 
+func Test_setSyndesisFromCustomResource_devProfile(t *testing.T) {
+	config := getConfigLiteral()
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Profile: v1alpha1.SyndesisProfileDev,
+			Components: v1alpha1.ComponentsSpec{
+				Meta: v1alpha1.MetaConfiguration{Resources: v1alpha1.ResourcesWithVolume{Memory: "384Mi"}},
+			},
+		},
+	}
+
+	assert.NoError(t, config.setSyndesisFromCustomResource(syndesis))
+	assert.Equal(t, "512Mi", config.Syndesis.Components.Server.Resources.Memory)
+	assert.Equal(t, "384Mi", config.Syndesis.Components.Meta.Resources.Memory)
+	assert.True(t, config.Syndesis.Components.Prometheus.DisablePersistence)
+	assert.True(t, config.Syndesis.RelaxedProbes)
+}
+
+func Test_setSyndesisFromCustomResource_memoryLimit(t *testing.T) {
+	config := getConfigLiteral()
+	syndesis := &v1alpha1.Syndesis{
+		Spec: v1alpha1.SyndesisSpec{
+			Components: v1alpha1.ComponentsSpec{
+				Server:   v1alpha1.ServerConfiguration{Resources: v1alpha1.Resources{MemoryLimit: "1Gi"}},
+				Meta:     v1alpha1.MetaConfiguration{Resources: v1alpha1.ResourcesWithVolume{Memory: "384Mi"}},
+				Database: v1alpha1.DatabaseConfiguration{Resources: v1alpha1.ResourcesWithVolume{Memory: "384Mi", MemoryLimit: "768Mi"}},
+			},
+		},
+	}
+
+	assert.NoError(t, config.setSyndesisFromCustomResource(syndesis))
+	assert.Equal(t, "1Gi", config.Syndesis.Components.Server.Resources.Memory)
+	// The deprecated alias still applies, the memory limit wins over it
+	assert.Equal(t, "384Mi", config.Syndesis.Components.Meta.Resources.Memory)
+	assert.Equal(t, "768Mi", config.Syndesis.Components.Database.Resources.Memory)
+	// The custom resource is left untouched
+	assert.Equal(t, "384Mi", syndesis.Spec.Components.Database.Resources.Memory)
+}
+
+func Test_setSyndesisFromCustomResource_prometheusEnabled(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.setSyndesisFromCustomResource(&v1alpha1.Syndesis{}))
+	assert.True(t, config.Syndesis.Components.Prometheus.Enabled)
+
+	enabled := false
+	syndesis := &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{
+		Components: v1alpha1.ComponentsSpec{Prometheus: v1alpha1.PrometheusConfiguration{Enabled: &enabled}},
+	}}
+	assert.NoError(t, config.setSyndesisFromCustomResource(syndesis))
+	assert.False(t, config.Syndesis.Components.Prometheus.Enabled)
+}
+
+func Test_setSyndesisFromCustomResource_testSupport(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.setSyndesisFromCustomResource(&v1alpha1.Syndesis{}))
+	assert.False(t, config.Syndesis.Components.Server.Features.TestSupport)
+
+	syndesis := &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{TestSupport: true}}
+	assert.NoError(t, config.setSyndesisFromCustomResource(syndesis))
+	assert.True(t, config.Syndesis.Components.Server.Features.TestSupport)
+}
+
+func TestConfig_setDevImagesFromAnnotations(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Annotations = map[string]string{
+		DevImageAnnotationPrefix + "server": "syndesis-server:my-build",
+		DevImageAnnotationPrefix + "other":  "ignored:latest",
+	}
+
+	config := getConfigLiteral()
+	config.setDevImagesFromAnnotations(syndesis)
+	assert.Nil(t, config.DevImageStreamTags)
+
+	config.DevSupport = true
+	config.setDevImagesFromAnnotations(syndesis)
+	assert.Equal(t, map[string]string{"server": "syndesis-server:my-build"}, config.DevImageStreamTags)
+}
+
+func Test_setPasswordsFromSecret_backup(t *testing.T) {
+	backup := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SyndesisGlobalConfigBackupSecret, Namespace: "syndesis"},
+		Data: map[string][]byte{
+			SyndesisGlobalConfigParamsProperty: []byte("POSTGRESQL_PASSWORD=db-password\nSYNDESIS_ENCRYPT_KEY=encrypt-key"),
+		},
+	}
+	syndesis := &v1alpha1.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+
+	config := &Config{}
+	assert.NoError(t, config.setPasswordsFromSecret(context.TODO(), fake.NewFakeClient(backup), syndesis))
+	assert.Equal(t, "db-password", config.Syndesis.Components.Database.Password)
+	assert.Equal(t, "encrypt-key", config.Syndesis.Components.Server.SyndesisEncryptKey)
+
+	// The global configuration wins over its backup
+	global := backup.DeepCopy()
+	global.Name = SyndesisGlobalConfigSecret
+	global.Data[SyndesisGlobalConfigParamsProperty] = []byte("POSTGRESQL_PASSWORD=other-password")
+
+	config = &Config{}
+	assert.NoError(t, config.setPasswordsFromSecret(context.TODO(), fake.NewFakeClient(backup, global), syndesis))
+	assert.Equal(t, "other-password", config.Syndesis.Components.Database.Password)
+}
+
+func TestConfig_RotateCookieSecret(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	config := getConfigLiteral()
+	config.Syndesis.Components.Oauth.CookieSecret = "first"
+
+	assert.NoError(t, config.RotateCookieSecret("", now))
+	assert.Equal(t, "first", config.Syndesis.Components.Oauth.CookieSecret)
+
+	assert.NoError(t, config.RotateCookieSecret("r1", now))
+	assert.Len(t, config.Syndesis.Components.Oauth.CookieSecret, 32)
+	assert.Equal(t, "first", config.Syndesis.Components.Oauth.CookieSecretPrevious)
+	assert.Equal(t, "2019-10-01T12:00:00Z", config.Syndesis.Components.Oauth.CookieSecretRotatedAt)
+
+	second := config.Syndesis.Components.Oauth.CookieSecret
+	assert.NoError(t, config.RotateCookieSecret("r1", now.Add(time.Hour)))
+	assert.Equal(t, second, config.Syndesis.Components.Oauth.CookieSecret)
+	assert.Equal(t, "first", config.Syndesis.Components.Oauth.CookieSecretPrevious)
+
+	assert.NoError(t, config.RotateCookieSecret("r1", now.Add(25*time.Hour)))
+	assert.Equal(t, second, config.Syndesis.Components.Oauth.CookieSecret)
+	assert.Empty(t, config.Syndesis.Components.Oauth.CookieSecretPrevious)
+}
+
+func TestConfig_RotateClientStateKeys(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	config := getConfigLiteral()
+	server := &config.Syndesis.Components.Server
+	server.ClientStateAuthenticationKey = "first-authentication"
+	server.ClientStateEncryptionKey = "first-encryption"
+
+	assert.NoError(t, config.RotateClientStateKeys("", now))
+	assert.Equal(t, "first-encryption", server.ClientStateEncryptionKey)
+	assert.Empty(t, server.ClientStatePreviousTid)
+
+	assert.NoError(t, config.RotateClientStateKeys("r1", now))
+	assert.Equal(t, "2", server.ClientStateTid)
+	assert.Len(t, server.ClientStateAuthenticationKey, 32)
+	assert.Len(t, server.ClientStateEncryptionKey, 32)
+	assert.Equal(t, "1", server.ClientStatePreviousTid)
+	assert.Equal(t, "first-authentication", server.ClientStatePreviousAuthenticationKey)
+	assert.Equal(t, "first-encryption", server.ClientStatePreviousEncryptionKey)
+	assert.Equal(t, "2019-10-01T12:00:00Z", server.ClientStateKeyRotatedAt)
+
+	second := server.ClientStateEncryptionKey
+	assert.NoError(t, config.RotateClientStateKeys("r1", now.Add(time.Hour)))
+	assert.Equal(t, second, server.ClientStateEncryptionKey)
+	assert.Equal(t, "1", server.ClientStatePreviousTid)
+
+	assert.NoError(t, config.RotateClientStateKeys("r1", now.Add(25*time.Hour)))
+	assert.Equal(t, "2", server.ClientStateTid)
+	assert.Equal(t, second, server.ClientStateEncryptionKey)
+	assert.Empty(t, server.ClientStatePreviousTid)
+	assert.Empty(t, server.ClientStatePreviousEncryptionKey)
+
+	assert.NoError(t, config.RotateClientStateKeys("r2", now.Add(48*time.Hour)))
+	assert.Equal(t, "3", server.ClientStateTid)
+	assert.Equal(t, "2", server.ClientStatePreviousTid)
+	assert.Equal(t, second, server.ClientStatePreviousEncryptionKey)
+}
+
 func Test_setBoolFromEnv(t *testing.T) {
 	type args struct {
 		env     string
@@ -418,6 +686,31 @@ func Test_setBoolFromEnv(t *testing.T) {
 	}
 }
 
+func TestConfig_SetClusterNetworkCached(t *testing.T) {
+	defer InvalidateClusterNetwork()
+	lookups.put(clusterNetworkLookupKey, &Config{HttpProxy: "http://proxy:3128", ClusterIngressDomain: "apps.example.com"})
+
+	config := getConfigLiteral()
+	assert.NoError(t, config.SetClusterNetwork(context.TODO(), nil))
+	assert.Equal(t, "http://proxy:3128", config.HttpProxy)
+	assert.Equal(t, "apps.example.com", config.ClusterIngressDomain)
+
+	InvalidateClusterNetwork()
+	_, ok := lookups.get(clusterNetworkLookupKey)
+	assert.False(t, ok)
+}
+
+func TestInvalidateLookups(t *testing.T) {
+	lookups.put(clusterNetworkLookupKey, &Config{HttpProxy: "http://proxy:3128"})
+	lookups.put(routeLookupKey("syndesis"), "syndesis.example.com")
+
+	InvalidateLookups()
+	_, ok := lookups.get(clusterNetworkLookupKey)
+	assert.False(t, ok)
+	_, ok = lookups.get(routeLookupKey("syndesis"))
+	assert.False(t, ok)
+}
+
 func TestConfig_SetRoute(t *testing.T) {
 	type args struct {
 		ctx      context.Context
@@ -425,11 +718,13 @@ func TestConfig_SetRoute(t *testing.T) {
 		syndesis *v1alpha1.Syndesis
 	}
 	tests := []struct {
-		name    string
-		args    args
-		env     map[string]string
-		wantErr bool
-		want    string
+		name     string
+		args     args
+		env      map[string]string
+		exposure string
+		hostname string
+		wantErr  bool
+		want     string
 	}{
 		{
 			name: "If ROUTE_HOSTNAME environment variable is set, config.RouteHostname should take that value",
@@ -442,6 +737,29 @@ func TestConfig_SetRoute(t *testing.T) {
 			env:     map[string]string{"ROUTE_HOSTNAME": "some_value"},
 			want:    "some_value",
 		},
+		{
+			name: "If syndesis is not exposed with a route, config.RouteHostname should take the external hostname",
+			args: args{
+				ctx:      context.TODO(),
+				client:   nil,
+				syndesis: nil,
+			},
+			exposure: "loadbalancer",
+			hostname: "syndesis.example.com",
+			wantErr:  false,
+			want:     "syndesis.example.com",
+		},
+		{
+			name: "If syndesis is not exposed with a route, an external hostname is required",
+			args: args{
+				ctx:      context.TODO(),
+				client:   nil,
+				syndesis: nil,
+			},
+			exposure: "ingress",
+			wantErr:  true,
+			want:     "",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -450,6 +768,10 @@ func TestConfig_SetRoute(t *testing.T) {
 			}
 
 			config := getConfigLiteral()
+			if tt.exposure != "" {
+				config.Syndesis.Exposure = tt.exposure
+				config.Syndesis.ExternalHostname = tt.hostname
+			}
 			if err := config.SetRoute(tt.args.ctx, tt.args.client, tt.args.syndesis); (err != nil) != tt.wantErr {
 				t.Errorf("SetRoute() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -461,3 +783,459 @@ func TestConfig_SetRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_validateJaegerSampling(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Addons.Jaeger.Enabled = true
+	assert.NoError(t, config.validateJaegerSampling())
+
+	config.Syndesis.Addons.Jaeger.Sampling.Integrations = map[string]JaegerSamplingStrategy{
+		"orders": {Type: "ratelimiting", Param: "2.5"},
+	}
+	assert.NoError(t, config.validateJaegerSampling())
+
+	config.Syndesis.Addons.Jaeger.Sampling.Integrations["audit"] = JaegerSamplingStrategy{Type: "probabilistic", Param: "10"}
+	assert.Error(t, config.validateJaegerSampling())
+
+	config.Syndesis.Addons.Jaeger.Sampling.Integrations["audit"] = JaegerSamplingStrategy{Type: "const", Param: "1"}
+	assert.Error(t, config.validateJaegerSampling())
+}
+
+func TestConfig_OAuthClientID(t *testing.T) {
+	config := getConfigLiteral()
+	config.OpenShiftProject = "syndesis"
+	assert.Equal(t, "system:serviceaccount:syndesis:syndesis-oauth-client", config.OAuthClientID())
+	assert.NoError(t, config.validateOAuthClient())
+
+	config.Syndesis.Components.Oauth.Client.Managed = true
+	assert.Equal(t, "syndesis-syndesis", config.OAuthClientID())
+	assert.NoError(t, config.validateOAuthClient())
+
+	config.Syndesis.Components.Oauth.Client.Name = "corporate-sso"
+	assert.Error(t, config.validateOAuthClient())
+
+	config.Syndesis.Components.Oauth.Client.Managed = false
+	assert.Equal(t, "corporate-sso", config.OAuthClientID())
+	assert.NoError(t, config.validateOAuthClient())
+}
+
+func TestConfig_validateInstallMode(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateInstallMode())
+	assert.True(t, config.InstallsApplication())
+
+	config.Syndesis.InstallMode = "infrastructureOnly"
+	assert.NoError(t, config.validateInstallMode())
+	assert.False(t, config.InstallsApplication())
+
+	config.Syndesis.InstallMode = "databaseOnly"
+	assert.Error(t, config.validateInstallMode())
+}
+
+func TestConfig_validateAlternateHostnames(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.AlternateHostnames = []string{"syndesis.legacy.example.com"}
+	assert.NoError(t, config.validateAlternateHostnames())
+	assert.Empty(t, config.ServedAlternateHostnames())
+
+	config.Syndesis.AlternateHostnamesMode = "proxy"
+	assert.NoError(t, config.validateAlternateHostnames())
+	assert.Equal(t, []string{"syndesis.legacy.example.com"}, config.ServedAlternateHostnames())
+
+	config.Syndesis.AlternateHostnamesMode = "redirect"
+	assert.Error(t, config.validateAlternateHostnames())
+	config.Syndesis.Exposure = "ingress"
+	assert.NoError(t, config.validateAlternateHostnames())
+
+	config.Syndesis.AlternateHostnamesMode = "rewrite"
+	assert.Error(t, config.validateAlternateHostnames())
+}
+
+func TestConfig_validateAddonPods(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateAddonPods())
+
+	config.Syndesis.Addons.DV.Pods.Resources = AddonResources{CPURequest: "250m", MemoryLimit: "2Gi"}
+	assert.NoError(t, config.validateAddonPods())
+
+	config.Syndesis.Addons.Todo.Pods.Resources.MemoryLimit = "lots"
+	assert.EqualError(t, config.validateAddonPods(), `invalid resources of the todo addon: "lots" is not a quantity`)
+}
+
+func TestConfig_validatePrometheusRemoteWrite(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Prometheus.RemoteWrite = []PrometheusRemoteWrite{
+		{URL: "https://thanos.example.com/api/v1/receive", BearerTokenSecret: "thanos-token"},
+	}
+	assert.NoError(t, config.validatePrometheusRemoteWrite())
+
+	remote := &config.Syndesis.Components.Prometheus.RemoteWrite[0]
+	remote.Username = "syndesis"
+	assert.EqualError(t, config.validatePrometheusRemoteWrite(), "prometheus remote write to https://thanos.example.com/api/v1/receive needs both a username and a password secret for basic authentication")
+
+	remote.PasswordSecret = "thanos-credentials"
+	assert.EqualError(t, config.validatePrometheusRemoteWrite(), "prometheus remote write to https://thanos.example.com/api/v1/receive cannot use both basic authentication and a bearer token")
+
+	remote.BearerTokenSecret = ""
+	remote.WriteRelabelConfigs = []PrometheusRelabelConfig{{Regex: "camel_(.+", Action: "keep"}}
+	assert.Error(t, config.validatePrometheusRemoteWrite())
+
+	remote.WriteRelabelConfigs = []PrometheusRelabelConfig{{Action: "forward"}}
+	assert.Error(t, config.validatePrometheusRemoteWrite())
+
+	remote.WriteRelabelConfigs = nil
+	remote.URL = "thanos:19291"
+	assert.EqualError(t, config.validatePrometheusRemoteWrite(), `prometheus remote write url "thanos:19291" is not an http(s) url`)
+
+	remote.URL = "https://thanos.example.com/api/v1/receive"
+	config.Syndesis.Components.Prometheus.Enabled = false
+	assert.Error(t, config.validatePrometheusRemoteWrite())
+}
+
+func TestConfig_validatePrometheusFederation(t *testing.T) {
+	config := getConfigLiteral()
+	federation := &config.Syndesis.Components.Prometheus.Federation
+	federation.Enabled = true
+	assert.NoError(t, config.validatePrometheusFederation())
+
+	federation.Match = []string{" "}
+	assert.EqualError(t, config.validatePrometheusFederation(), "prometheus federation series selectors cannot be empty")
+
+	federation.Match = nil
+	assert.EqualError(t, config.validatePrometheusFederation(), "prometheus federation requires at least one series selector to match")
+
+	config.Syndesis.Components.Prometheus.Enabled = false
+	assert.EqualError(t, config.validatePrometheusFederation(), "prometheus federation requires the bundled prometheus to be enabled")
+}
+
+func TestConfig_FederationHostname(t *testing.T) {
+	config := getConfigLiteral()
+	config.OpenShiftProject = "syndesis"
+	assert.Equal(t, "", config.FederationHostname())
+
+	config.ClusterIngressDomain = "apps.example.com"
+	assert.Equal(t, "syndesis-prometheus-federation-syndesis.apps.example.com", config.FederationHostname())
+
+	config.Syndesis.Components.Prometheus.Federation.Hostname = "federate.example.com"
+	assert.Equal(t, "federate.example.com", config.FederationHostname())
+}
+
+func TestConfig_validateBackup(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateBackup())
+	assert.False(t, config.SnapshotsDatabase())
+
+	config.Syndesis.Backup.Method = "snapshot"
+	assert.NoError(t, config.validateBackup())
+	assert.True(t, config.SnapshotsDatabase())
+
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://db.example.com:5432"
+	assert.EqualError(t, config.validateBackup(), "an external database cannot be backed up with volume snapshots")
+
+	config.Syndesis.Backup.Method = "rsync"
+	assert.EqualError(t, config.validateBackup(), `backup method "rsync" is neither dump nor snapshot`)
+}
+
+func TestConfig_DemoData(t *testing.T) {
+	config := getConfigLiteral()
+	assert.False(t, config.InstallsSampleDB())
+	assert.False(t, config.InstallsTodo())
+	assert.False(t, config.LoadsSampleIntegrations())
+
+	demo := &config.Syndesis.Components.Server.Features.DemoData
+	demo.Todo = true
+	demo.SampleIntegrations = true
+	assert.True(t, config.InstallsSampleDB())
+	assert.True(t, config.InstallsTodo())
+	assert.True(t, config.LoadsSampleIntegrations())
+
+	demo.Purge = true
+	assert.NoError(t, config.validateDemoData())
+	assert.False(t, config.InstallsSampleDB())
+	assert.False(t, config.InstallsTodo())
+	assert.False(t, config.LoadsSampleIntegrations())
+
+	config.Syndesis.Addons.Todo.Enabled = true
+	assert.EqualError(t, config.validateDemoData(), "demo data cannot be purged while the todo addon is enabled")
+}
+
+func TestConfig_CamelKIntegrations(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateIntegrationRuntime())
+	assert.False(t, config.CamelKIntegrations())
+
+	// Enabling the addon is enough when no runtime is set
+	config.Syndesis.Addons.CamelK.Enabled = true
+	assert.True(t, config.CamelKIntegrations())
+
+	config.Syndesis.Integration.Runtime = v1alpha1.SyndesisIntegrationRuntimeSpringBoot
+	assert.False(t, config.CamelKIntegrations())
+
+	// Asked for explicitly, integrations switch to Camel K once its platform is ready
+	config.Syndesis.Integration.Runtime = v1alpha1.SyndesisIntegrationRuntimeCamelK
+	assert.False(t, config.CamelKIntegrations())
+	config.CamelKPlatformReady = true
+	assert.True(t, config.CamelKIntegrations())
+
+	config.Syndesis.Integration.Runtime = "quarkus"
+	assert.EqualError(t, config.validateIntegrationRuntime(), "unsupported integration runtime quarkus, use one of: springboot, camelk")
+
+	syndesis := &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{
+		Integration: v1alpha1.IntegrationConfiguration{Runtime: v1alpha1.SyndesisIntegrationRuntimeCamelK},
+	}}
+	config, err := GetProperties("../../../build/conf/config-test.yaml", context.TODO(), nil, syndesis)
+	assert.NoError(t, err)
+	assert.True(t, config.Syndesis.Addons.CamelK.Enabled)
+	assert.False(t, config.CamelKIntegrations())
+}
+
+func TestConfig_validateIntegrationController(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateIntegrationController())
+
+	backoff := &config.Syndesis.Integration.Controller.RetryBackoff
+	backoff.InitialDelay = 60
+	assert.NoError(t, config.validateIntegrationController())
+
+	backoff.MaxDelay = 30
+	assert.EqualError(t, config.validateIntegrationController(), "integration retry max delay 30s is shorter than the initial delay 60s")
+
+	backoff.MaxDelay = 600
+	config.Syndesis.Integration.Controller.MaxConcurrentDeployments = -1
+	assert.EqualError(t, config.validateIntegrationController(), "integration controller settings cannot be negative")
+}
+
+func TestConfig_validateUpgrade(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateUpgrade())
+	timeout, err := config.UpgradeTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, timeout)
+
+	config.Syndesis.Components.Upgrade.Pods.Resources.MemoryLimit = "1Gb"
+	assert.EqualError(t, config.validateUpgrade(), `invalid resources of the upgrade pod: "1Gb" is not a quantity`)
+
+	config.Syndesis.Components.Upgrade.Pods.Resources.MemoryLimit = "1Gi"
+	config.Syndesis.Components.Upgrade.Timeout = "an hour"
+	assert.EqualError(t, config.validateUpgrade(), `upgrade timeout "an hour" is not a duration`)
+
+	config.Syndesis.Components.Upgrade.Timeout = ""
+	timeout, err = config.UpgradeTimeout()
+	assert.NoError(t, err)
+	assert.Zero(t, timeout)
+
+	assert.False(t, config.QuarantinesFailedUpgrades())
+	config.Syndesis.Components.Upgrade.OnFailure = "quarantine"
+	assert.NoError(t, config.validateUpgrade())
+	assert.True(t, config.QuarantinesFailedUpgrades())
+
+	config.Syndesis.Components.Upgrade.OnFailure = "rollback"
+	assert.EqualError(t, config.validateUpgrade(), `upgrade failure policy "rollback" is neither retry nor quarantine`)
+}
+
+func TestConfig_validateSidecarResources(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateSidecarResources())
+
+	config.Syndesis.Components.Oauth.Resources.CPULimit = "100m"
+	config.Syndesis.Components.Database.Exporter.Resources.MemoryRequest = "32Mi"
+	assert.NoError(t, config.validateSidecarResources())
+
+	config.Syndesis.Components.Database.Exporter.Resources.MemoryLimit = "256MB"
+	assert.EqualError(t, config.validateSidecarResources(), `invalid resources of the database exporter: "256MB" is not a quantity`)
+}
+
+func TestConfig_CertificateExpiryThreshold(t *testing.T) {
+	config := getConfigLiteral()
+	threshold, err := config.CertificateExpiryThreshold()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*24*time.Hour, threshold)
+
+	config.Syndesis.Certificates.ExpiryThreshold = "30d"
+	_, err = config.CertificateExpiryThreshold()
+	assert.EqualError(t, err, `certificate expiry threshold "30d" is not a duration`)
+}
+
+func TestConfig_validateConnectors(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateConnectors())
+
+	config.Syndesis.Connectors = ConnectorsConfiguration{Allow: []string{"sql", "kafka"}, Deny: []string{"ftp"}}
+	assert.NoError(t, config.validateConnectors())
+
+	config.Syndesis.Connectors.Deny = []string{"ftp", "kafka"}
+	assert.EqualError(t, config.validateConnectors(), "connector kafka is both allowed and denied")
+
+	config.Syndesis.Connectors.Deny = []string{" "}
+	assert.EqualError(t, config.validateConnectors(), "denied connector ids cannot be empty")
+}
+
+func TestConfig_validateRoute(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateRoute())
+
+	config.Syndesis.Route = RouteConfiguration{
+		Labels:      map[string]string{"router": "internal"},
+		Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "2m"},
+	}
+	assert.NoError(t, config.validateRoute())
+
+	config.Syndesis.Route.Labels = map[string]string{"syndesis.io/app": "other"}
+	assert.EqualError(t, config.validateRoute(), "route label syndesis.io/app is managed by the operator")
+
+	config.Syndesis.Route.Labels = map[string]string{"router": "not valid"}
+	assert.Error(t, config.validateRoute())
+
+	config.Syndesis.Route.Labels = nil
+	config.Syndesis.Route.Annotations = map[string]string{"console.alpha.openshift.io/overview-app-route": "false"}
+	assert.EqualError(t, config.validateRoute(), "route annotation console.alpha.openshift.io/overview-app-route is managed by the operator")
+}
+
+func TestConfig_IntegrationQuota(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateIntegrationQuota())
+	assert.Equal(t, int64(512), config.IntegrationMemoryLimitMi())
+	assert.Equal(t, int64(200), config.IntegrationMemoryRequestMi())
+
+	config.Syndesis.Components.Server.Features.IntegrationLimit = 5
+	assert.Equal(t, 5, config.MaxIntegrationsPerUser())
+	config.Syndesis.Integration.Quota.MaxIntegrationsPerUser = 3
+	assert.Equal(t, 3, config.MaxIntegrationsPerUser())
+
+	config.Syndesis.Integration.Quota.MaxMemory = "2Gi"
+	assert.NoError(t, config.validateIntegrationQuota())
+	assert.Equal(t, int64(512), config.IntegrationMemoryLimitMi())
+
+	config.Syndesis.Integration.Quota.MaxMemory = "150Mi"
+	assert.Equal(t, int64(150), config.IntegrationMemoryLimitMi())
+	assert.Equal(t, int64(150), config.IntegrationMemoryRequestMi())
+
+	config.Syndesis.Integration.Quota.MaxMemory = "32Mi"
+	assert.Error(t, config.validateIntegrationQuota())
+	config.Syndesis.Integration.Quota.MaxMemory = "lots"
+	assert.Error(t, config.validateIntegrationQuota())
+	config.Syndesis.Integration.Quota = IntegrationQuota{MaxIntegrationsPerNamespace: -1}
+	assert.Error(t, config.validateIntegrationQuota())
+}
+
+func TestConfig_validateSecretSync(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateSecretSync())
+
+	config.Syndesis.Integration.SecretSync.Selector = "syndesis.io/sync=true"
+	assert.NoError(t, config.validateSecretSync())
+	config.Syndesis.Integration.SecretSync.Selector = "syndesis.io/sync in (true"
+	assert.Error(t, config.validateSecretSync())
+}
+
+func TestConfig_validateStandby(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Standby.AllowReplication = true
+	assert.NoError(t, config.validateStandby())
+	assert.True(t, config.DatabaseReplication())
+
+	config.Syndesis.Standby = StandbyConfiguration{Enabled: true}
+	assert.EqualError(t, config.validateStandby(), "the primary host of the standby installation is missing")
+
+	config.Syndesis.Standby.PrimaryHost = "syndesis-db.syndesis.svc"
+	assert.NoError(t, config.validateStandby())
+	config.Syndesis.Standby.PrimaryHost = "10.0.12.4"
+	assert.NoError(t, config.validateStandby())
+	config.Syndesis.Standby.PrimaryHost = "syndesis-db:5432"
+	assert.Error(t, config.validateStandby())
+
+	config.Syndesis.Standby.PrimaryHost = "syndesis-db.syndesis.svc"
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://db.example.com:5432"
+	assert.Error(t, config.validateStandby())
+}
+
+func TestConfig_validateNameResolution(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.HostAliases = []corev1.HostAlias{{IP: "10.0.0.12", Hostnames: []string{"sso.corp.example.com"}}}
+	config.Syndesis.DNSSuffix = "cluster.corp"
+	assert.NoError(t, config.validateNameResolution())
+
+	config.Syndesis.DNSSuffix = "Cluster_Corp"
+	assert.Error(t, config.validateNameResolution())
+
+	config.Syndesis.DNSSuffix = ""
+	config.Syndesis.HostAliases[0].Hostnames = nil
+	assert.EqualError(t, config.validateNameResolution(), "host alias 10.0.0.12 has no hostnames")
+
+	config.Syndesis.HostAliases[0].IP = "sso"
+	assert.EqualError(t, config.validateNameResolution(), `host alias ip "sso" is not an ip address`)
+}
+
+func TestConfig_validateSLO(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Addons.Ops.Enabled = true
+	assert.NoError(t, config.validateSLO())
+
+	config.Syndesis.Addons.Ops.SLO.SuccessRate = "100"
+	assert.Error(t, config.validateSLO())
+
+	config.Syndesis.Addons.Ops.SLO.SuccessRate = "99.9"
+	config.Syndesis.Addons.Ops.SLO.LatencyThreshold = 0
+	assert.Error(t, config.validateSLO())
+}
+
+func TestConfig_validateDatabaseReplicas(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Database.ReadReplicas = 1
+	assert.NoError(t, config.validateDatabaseReplicas())
+
+	config.Syndesis.Components.Database.ExternalReplicaURLs = []string{"postgresql://replica:5432"}
+	assert.Error(t, config.validateDatabaseReplicas())
+
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://primary:5432"
+	assert.NoError(t, config.validateDatabaseReplicas())
+
+	config.Syndesis.Components.Database.ExternalReplicaURLs = []string{"replica"}
+	assert.Error(t, config.validateDatabaseReplicas())
+}
+
+func TestConfig_validateDatabaseMaintenance(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Database.Maintenance.Enabled = true
+	assert.NoError(t, config.validateDatabaseMaintenance())
+
+	config.Syndesis.Components.Database.Maintenance.StatementTimeout = "30m"
+	assert.Error(t, config.validateDatabaseMaintenance())
+
+	config.Syndesis.Components.Database.Maintenance.StatementTimeout = "30min"
+	config.Syndesis.Components.Database.Maintenance.Schedule = "@weekly 3"
+	assert.Error(t, config.validateDatabaseMaintenance())
+}
+
+func TestConfig_SetStartupProbes(t *testing.T) {
+	for minor, supported := range map[string]bool{"11+": false, "17": false, "18": true, "20+": true} {
+		config := getConfigLiteral()
+		assert.NoError(t, config.SetStartupProbes(serverVersion{Major: "1", Minor: minor}))
+		assert.Equal(t, supported, config.StartupProbes, minor)
+	}
+}
+
+type serverVersion version.Info
+
+func (v serverVersion) ServerVersion() (*version.Info, error) {
+	info := version.Info(v)
+	return &info, nil
+}
+
+func TestConfig_validateIntegrationRepublish(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateIntegrationRepublish())
+	delay, err := config.RepublishDelay()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, delay)
+
+	config.Syndesis.Integration.Republish.Delay = "1 minute"
+	assert.EqualError(t, config.validateIntegrationRepublish(), `integration republish delay "1 minute" is not a duration`)
+
+	config.Syndesis.Integration.Republish.Delay = ""
+	config.Syndesis.Integration.Republish.Timeout = ""
+	assert.EqualError(t, config.validateIntegrationRepublish(), "the integration republish timeout is missing")
+
+	config.Syndesis.Integration.Republish.BatchSize = 0
+	assert.EqualError(t, config.validateIntegrationRepublish(), "integration republish batch size 0 is below 1")
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// libpq parameters named differently by the jdbc driver
+var jdbcParameterNames = map[string]string{
+	"connect_timeout": "connectTimeout",
+}
+
+// Connection URL of the database, as understood by libpq, e.g. by psql
+func (database DatabaseConfiguration) URL() string {
+	connection := database.Connection
+	return connection.url([]string{connection.address()}, database.Name, connection.parameters())
+}
+
+// Connection URL of the database, as understood by the jdbc driver of the server
+func (database DatabaseConfiguration) JDBCURL() string {
+	connection := database.Connection
+	return "jdbc:" + connection.url([]string{connection.address()}, database.Name, jdbcParameters(connection.parameters()))
+}
+
+// Connection URL of the read-only queries of the server, balanced over the read replicas. Empty without read replicas
+func (database DatabaseConfiguration) ReadOnlyJDBCURL() string {
+	var hosts []string
+	if database.ExternalDbURL != "" {
+		for _, replica := range database.ExternalReplicaURLs {
+			if u, err := url.Parse(replica); err == nil {
+				hosts = append(hosts, u.Host)
+			}
+		}
+	} else if database.ReadReplicas > 0 {
+		hosts = []string{"syndesis-db-replica:5432"}
+	}
+	if len(hosts) == 0 {
+		return ""
+	}
+
+	parameters := jdbcParameters(database.Connection.parameters())
+	parameters.Set("readOnly", "true")
+	if len(hosts) > 1 {
+		parameters.Set("loadBalanceHosts", "true")
+	}
+	return "jdbc:" + database.Connection.url(hosts, database.Name, parameters)
+}
+
+// Set the connection from a postgresql://host:port/name?parameters url, the name of the database
+// is only replaced when the url has one
+func (database *DatabaseConfiguration) setConnectionFromURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("database url %q has no host", raw)
+	}
+
+	connection := DatabaseConnection{Host: u.Hostname(), Port: 5432}
+	if u.Port() != "" {
+		if connection.Port, err = strconv.Atoi(u.Port()); err != nil {
+			return fmt.Errorf("database url %q has an invalid port", raw)
+		}
+	}
+	for name, values := range u.Query() {
+		switch name {
+		case "sslmode":
+			connection.SSLMode = values[0]
+		case "connect_timeout":
+			if connection.ConnectTimeout, err = strconv.Atoi(values[0]); err != nil {
+				return fmt.Errorf("database url %q has an invalid connect_timeout", raw)
+			}
+		default:
+			if connection.Options == nil {
+				connection.Options = map[string]string{}
+			}
+			connection.Options[name] = values[0]
+		}
+	}
+
+	if name := strings.TrimPrefix(u.Path, "/"); name != "" {
+		database.Name = name
+	}
+	database.Connection = connection
+	return nil
+}
+
+// Check the connection parameters, libpq refuses to connect with an unknown ssl mode
+func (config *Config) validateDatabaseConnection() error {
+	connection := config.Syndesis.Components.Database.Connection
+	switch connection.SSLMode {
+	case "", "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		return fmt.Errorf("database ssl mode %q is not a libpq sslmode", connection.SSLMode)
+	}
+	if connection.ConnectTimeout < 0 {
+		return fmt.Errorf("database connect timeout %d is negative", connection.ConnectTimeout)
+	}
+	return nil
+}
+
+func (connection DatabaseConnection) address() string {
+	return net.JoinHostPort(connection.Host, strconv.Itoa(connection.Port))
+}
+
+// The libpq parameters of the connection
+func (connection DatabaseConnection) parameters() url.Values {
+	parameters := url.Values{}
+	for name, value := range connection.Options {
+		parameters.Set(name, value)
+	}
+	if connection.SSLMode != "" {
+		parameters.Set("sslmode", connection.SSLMode)
+	}
+	if connection.ConnectTimeout > 0 {
+		parameters.Set("connect_timeout", strconv.Itoa(connection.ConnectTimeout))
+	}
+	return parameters
+}
+
+func (connection DatabaseConnection) url(hosts []string, name string, parameters url.Values) string {
+	u := url.URL{
+		Scheme:   "postgresql",
+		Host:     strings.Join(hosts, ","),
+		Path:     "/" + name,
+		RawQuery: parameters.Encode(),
+	}
+	return u.String()
+}
+
+func jdbcParameters(parameters url.Values) url.Values {
+	jdbc := url.Values{}
+	for name, values := range parameters {
+		if jdbcName, ok := jdbcParameterNames[name]; ok {
+			name = jdbcName
+		}
+		jdbc[name] = values
+	}
+	return jdbc
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseConfiguration_URL(t *testing.T) {
+	database := getConfigLiteral().Syndesis.Components.Database
+	assert.Equal(t, "postgresql://syndesis-db:5432/syndesis?sslmode=disable", database.URL())
+	assert.Equal(t, "jdbc:postgresql://syndesis-db:5432/syndesis?sslmode=disable", database.JDBCURL())
+
+	database.Connection.SSLMode = "verify-full"
+	database.Connection.ConnectTimeout = 10
+	database.Connection.Options = map[string]string{"options": "-c search_path=syndesis"}
+	assert.Equal(t, "postgresql://syndesis-db:5432/syndesis?connect_timeout=10&options=-c+search_path%3Dsyndesis&sslmode=verify-full", database.URL())
+	assert.Equal(t, "jdbc:postgresql://syndesis-db:5432/syndesis?connectTimeout=10&options=-c+search_path%3Dsyndesis&sslmode=verify-full", database.JDBCURL())
+}
+
+func TestDatabaseConfiguration_ReadOnlyJDBCURL(t *testing.T) {
+	database := getConfigLiteral().Syndesis.Components.Database
+	assert.Equal(t, "", database.ReadOnlyJDBCURL())
+
+	database.ReadReplicas = 2
+	assert.Equal(t, "jdbc:postgresql://syndesis-db-replica:5432/syndesis?readOnly=true&sslmode=disable", database.ReadOnlyJDBCURL())
+
+	require.NoError(t, database.setConnectionFromURL("postgresql://primary:5432"))
+	database.ExternalDbURL = "postgresql://primary:5432"
+	assert.Equal(t, "", database.ReadOnlyJDBCURL())
+
+	database.ExternalReplicaURLs = []string{"postgresql://replica-a:5432", "postgresql://replica-b:5433"}
+	assert.Equal(t, "jdbc:postgresql://replica-a:5432,replica-b:5433/syndesis?loadBalanceHosts=true&readOnly=true", database.ReadOnlyJDBCURL())
+}
+
+func TestDatabaseConfiguration_setConnectionFromURL(t *testing.T) {
+	database := getConfigLiteral().Syndesis.Components.Database
+	require.NoError(t, database.setConnectionFromURL("postgresql://db.example.com/fuse?sslmode=require&connect_timeout=5&application_name=syndesis"))
+	assert.Equal(t, "fuse", database.Name)
+	assert.Equal(t, DatabaseConnection{
+		Host:           "db.example.com",
+		Port:           5432,
+		SSLMode:        "require",
+		ConnectTimeout: 5,
+		Options:        map[string]string{"application_name": "syndesis"},
+	}, database.Connection)
+
+	assert.Error(t, database.setConnectionFromURL("postgresql://db.example.com:port/fuse"))
+	assert.Error(t, database.setConnectionFromURL("fuse"))
+}
+
+func TestConfig_validateDatabaseConnection(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateDatabaseConnection())
+
+	config.Syndesis.Components.Database.Connection.SSLMode = "on"
+	assert.Error(t, config.validateDatabaseConnection())
+}
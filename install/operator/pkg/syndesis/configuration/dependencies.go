@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// Addresses (host:port) of the services syndesis depends on, by description. Only the services
+// configured for this installation are returned, the ones installed by syndesis itself aren't.
+func (config *Config) ExternalDependencies() map[string]string {
+	dependencies := map[string]string{}
+
+	for id, repository := range config.Syndesis.Components.Server.Features.MavenRepositories {
+		if address := urlAddress(repository, map[string]string{"http": "80", "https": "443"}); address != "" {
+			dependencies["maven repository "+id] = address
+		}
+	}
+
+	database := config.Syndesis.Components.Database
+	if database.ExternalDbURL != "" {
+		dependencies["external database"] = database.Connection.address()
+		for i, replica := range database.ExternalReplicaURLs {
+			if address := urlAddress(replica, map[string]string{"postgresql": "5432", "postgres": "5432"}); address != "" {
+				dependencies[fmt.Sprintf("external database replica %d", i+1)] = address
+			}
+		}
+	}
+
+	if config.Syndesis.Addons.Jaeger.Enabled {
+		dependencies["jaeger collector"] = "syndesis-jaeger-collector." + config.OpenShiftProject + ".svc:14268"
+	}
+
+	return dependencies
+}
+
+// The host:port of an url, using the default port of its scheme when it has none. Empty for an invalid url
+func urlAddress(raw string, defaultPorts map[string]string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	port := u.Port()
+	if port == "" {
+		if port = defaultPorts[u.Scheme]; port == "" {
+			return ""
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
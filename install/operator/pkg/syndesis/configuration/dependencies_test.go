@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ExternalDependencies(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Server.Features.MavenRepositories = map[string]string{
+		"central": "https://repo.maven.apache.org/maven2/",
+		"nexus":   "http://nexus.example.com:8081/repository/maven-public/",
+	}
+	assert.Equal(t, map[string]string{
+		"maven repository central": "repo.maven.apache.org:443",
+		"maven repository nexus":   "nexus.example.com:8081",
+	}, config.ExternalDependencies())
+
+	config.Syndesis.Components.Server.Features.MavenRepositories = nil
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://db.example.com:5433/syndesis"
+	config.Syndesis.Components.Database.Connection = DatabaseConnection{Host: "db.example.com", Port: 5433}
+	config.Syndesis.Components.Database.ExternalReplicaURLs = []string{"postgresql://replica.example.com/syndesis"}
+	config.Syndesis.Addons.Jaeger.Enabled = true
+	config.OpenShiftProject = "syndesis"
+	assert.Equal(t, map[string]string{
+		"external database":           "db.example.com:5433",
+		"external database replica 1": "replica.example.com:5432",
+		"jaeger collector":            "syndesis-jaeger-collector.syndesis.svc:14268",
+	}, config.ExternalDependencies())
+}
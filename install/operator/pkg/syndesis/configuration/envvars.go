@@ -23,15 +23,18 @@ import (
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	SyndesisGlobalConfigSecret         = "syndesis-global-config"
+	SyndesisGlobalConfigBackupSecret   = "syndesis-global-config-backup"
 	SyndesisGlobalConfigParamsProperty = "params"
 )
 
-func getSyndesisEnvVarsFromOpenShiftNamespace(ctx context.Context, client client.Client, namespace string) (map[string]string, error) {
+// Returns the parameters of the installation, as stored in the global configuration secret
+func GetSyndesisEnvVarsFromOpenShiftNamespace(ctx context.Context, client client.Client, namespace string) (map[string]string, error) {
 	secret, err := getSyndesisConfigurationSecret(ctx, client, namespace)
 	if err != nil {
 		return nil, err
@@ -63,9 +66,15 @@ func parseConfigurationBlob(blob []byte) map[string]string {
 	return configs
 }
 
+// Returns the global configuration secret, or its backup when it has been deleted,
+// so that it gets recreated with the values the running system depends on
 func getSyndesisConfigurationSecret(ctx context.Context, client client.Client, namespace string) (*v1.Secret, error) {
 	secret := v1.Secret{}
-	if err := client.Get(ctx, util.NewObjectKey(SyndesisGlobalConfigSecret, namespace), &secret); err != nil {
+	err := client.Get(ctx, util.NewObjectKey(SyndesisGlobalConfigSecret, namespace), &secret)
+	if k8serrors.IsNotFound(err) {
+		err = client.Get(ctx, util.NewObjectKey(SyndesisGlobalConfigBackupSecret, namespace), &secret)
+	}
+	if err != nil {
 		return nil, err
 	}
 	return &secret, nil
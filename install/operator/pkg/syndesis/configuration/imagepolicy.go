@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+// How often the registries are checked with the tracking policies when the interval isn't set
+const defaultImageCheckInterval = 24 * time.Hour
+
+func (config *Config) validateImagePolicy() error {
+	switch v1alpha1.ImageUpdatePolicy(config.Syndesis.ImagePolicy.Update) {
+	case v1alpha1.ImageUpdatePolicyPinned, v1alpha1.ImageUpdatePolicyTrackMinor, v1alpha1.ImageUpdatePolicyTrackLatest:
+	default:
+		return fmt.Errorf("update policy %q is neither %s, %s nor %s", config.Syndesis.ImagePolicy.Update,
+			v1alpha1.ImageUpdatePolicyPinned, v1alpha1.ImageUpdatePolicyTrackMinor, v1alpha1.ImageUpdatePolicyTrackLatest)
+	}
+	_, err := config.ImageCheckInterval()
+	return err
+}
+
+// Whether the components follow new releases of their images
+func (config *Config) TracksImages() bool {
+	policy := v1alpha1.ImageUpdatePolicy(config.Syndesis.ImagePolicy.Update)
+	return policy == v1alpha1.ImageUpdatePolicyTrackMinor || policy == v1alpha1.ImageUpdatePolicyTrackLatest
+}
+
+// How often the registries are checked for new releases of the component images, 0 when they aren't
+func (config *Config) ImageCheckInterval() (time.Duration, error) {
+	value := config.Syndesis.ImagePolicy.CheckInterval
+	if value == "" {
+		if config.TracksImages() {
+			return defaultImageCheckInterval, nil
+		}
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("check interval %q is not a positive duration", value)
+	}
+	return interval, nil
+}
+
+// The images of the components installed that follow the image policy. The database image is an image stream
+// tag, it isn't pulled from a registry.
+func (config *Config) TrackedImages() []string {
+	images := []string{}
+	seen := map[string]bool{}
+	for _, image := range config.trackedImages() {
+		if *image != "" && !seen[*image] {
+			images = append(images, *image)
+			seen[*image] = true
+		}
+	}
+	return images
+}
+
+// The fields of the images of the components installed that follow the image policy
+func (config *Config) trackedImages() []*string {
+	components := &config.Syndesis.Components
+	images := []*string{
+		&components.Server.Image,
+		&components.Meta.Image,
+		&components.UI.Image,
+		&components.S2I.Image,
+		&components.Oauth.Image,
+		&components.Upgrade.Image,
+	}
+	if components.Prometheus.Enabled {
+		images = append(images, &components.Prometheus.Image)
+	}
+	if components.Database.ExternalDbURL == "" {
+		images = append(images, &components.Database.Exporter.Image)
+	}
+	if components.Database.Maintenance.Enabled {
+		images = append(images, &components.Database.Maintenance.Image)
+	}
+	return images
+}
+
+// Replace the images of the components by the releases the tracking policies resolved at the last check.
+// Images the check didn't resolve, e.g. ones configured since, are used as configured.
+func (config *Config) setResolvedImages(syndesis *v1alpha1.Syndesis) {
+	status := syndesis.Status.Images
+	if !config.TracksImages() || status == nil {
+		return
+	}
+
+	for _, image := range config.trackedImages() {
+		if resolved, ok := status.Resolved[*image]; ok && resolved != "" {
+			*image = resolved
+		}
+	}
+}
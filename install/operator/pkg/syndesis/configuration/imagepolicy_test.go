@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func TestConfig_validateImagePolicy(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.validateImagePolicy())
+	interval, err := config.ImageCheckInterval()
+	assert.NoError(t, err)
+	assert.Zero(t, interval)
+
+	config.Syndesis.ImagePolicy.Update = "track-minor"
+	interval, _ = config.ImageCheckInterval()
+	assert.Equal(t, 24*time.Hour, interval)
+
+	config.Syndesis.ImagePolicy.CheckInterval = "6h"
+	interval, _ = config.ImageCheckInterval()
+	assert.Equal(t, 6*time.Hour, interval)
+
+	config.Syndesis.ImagePolicy.CheckInterval = "-1h"
+	assert.EqualError(t, config.validateImagePolicy(), `check interval "-1h" is not a positive duration`)
+
+	config.Syndesis.ImagePolicy = ImagePolicyConfiguration{Update: "track-major"}
+	assert.EqualError(t, config.validateImagePolicy(), `update policy "track-major" is neither pinned, track-minor nor track-latest`)
+}
+
+func TestConfig_setResolvedImages(t *testing.T) {
+	config := getConfigLiteral()
+	server := config.Syndesis.Components.Server.Image
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Status.Images = &v1alpha1.ImagePolicyStatus{Resolved: map[string]string{
+		server: "docker.io/syndesis/syndesis-server:1.9.1@sha256:abcd",
+	}}
+	assert.Contains(t, config.TrackedImages(), server)
+	assert.NotContains(t, config.TrackedImages(), config.Syndesis.Components.Database.Image)
+
+	// The pinned policy ignores what was resolved before
+	config.setResolvedImages(syndesis)
+	assert.Equal(t, server, config.Syndesis.Components.Server.Image)
+
+	config.Syndesis.ImagePolicy.Update = "track-latest"
+	config.setResolvedImages(syndesis)
+	assert.Equal(t, "docker.io/syndesis/syndesis-server:1.9.1@sha256:abcd", config.Syndesis.Components.Server.Image)
+	assert.Equal(t, "docker.io/syndesis/syndesis-meta:latest", config.Syndesis.Components.Meta.Image)
+}
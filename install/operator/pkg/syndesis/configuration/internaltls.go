@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+// Where the components find the certificate authority of the internal certificates
+const (
+	serviceCAFile     = "/etc/syndesis/internal-ca/service-ca.crt"
+	certManagerCAFile = "/etc/syndesis/tls/ca.crt"
+)
+
+// Check the issuer of the internal certificates. The read replicas copy the data of the primary database but not
+// its certificate, they would serve the server unencrypted.
+func (config *Config) validateInternalTLS() error {
+	internalTLS := config.Syndesis.InternalTLS
+	if !internalTLS.Enabled {
+		return nil
+	}
+	switch v1alpha1.SyndesisCertificateIssuer(internalTLS.Issuer) {
+	case v1alpha1.SyndesisCertificateIssuerServiceCA:
+	case v1alpha1.SyndesisCertificateIssuerCertManager:
+		if internalTLS.IssuerRef.Name == "" {
+			return errors.New("the cert-manager issuer of the internal certificates is missing")
+		}
+		switch internalTLS.IssuerRef.Kind {
+		case "", "Issuer", "ClusterIssuer":
+		default:
+			return fmt.Errorf("issuer kind %q is neither Issuer nor ClusterIssuer", internalTLS.IssuerRef.Kind)
+		}
+	default:
+		return fmt.Errorf("certificate issuer %q is neither %s nor %s", internalTLS.Issuer,
+			v1alpha1.SyndesisCertificateIssuerServiceCA, v1alpha1.SyndesisCertificateIssuerCertManager)
+	}
+	if config.Syndesis.Components.Database.ReadReplicas > 0 {
+		return errors.New("the database read replicas don't support internal TLS")
+	}
+	return nil
+}
+
+// Whether the internal certificates are issued by cert-manager, which can issue client certificates too: the
+// components then authenticate each other
+func (config *Config) MutualInternalTLS() bool {
+	internalTLS := config.Syndesis.InternalTLS
+	return internalTLS.Enabled && internalTLS.Issuer == string(v1alpha1.SyndesisCertificateIssuerCertManager)
+}
+
+// File of the certificate authority the components verify the internal certificates with
+func (config *Config) InternalCAFile() string {
+	if config.MutualInternalTLS() {
+		return certManagerCAFile
+	}
+	return serviceCAFile
+}
+
+// Connection URL of the database for the server, verifying the certificate of the installed database with internal TLS
+func (config *Config) DatabaseJDBCURL() string {
+	database := config.internalTLSDatabase("verify-full")
+	if database.Connection.SSLMode == "verify-full" {
+		database.Connection.Options["sslrootcert"] = config.InternalCAFile()
+	}
+	return database.JDBCURL()
+}
+
+// Connection URL of the database for psql, encrypted with internal TLS. The jobs running psql don't mount the
+// certificate authority, the certificate is not verified.
+func (config *Config) DatabaseURL() string {
+	return config.internalTLSDatabase("require").URL()
+}
+
+// The database configuration connecting to the installed database over TLS with the ssl mode, addressed by the
+// name its certificate is issued for. It is left as is without internal TLS or with an external database.
+func (config *Config) internalTLSDatabase(sslMode string) DatabaseConfiguration {
+	database := config.Syndesis.Components.Database
+	if !config.Syndesis.InternalTLS.Enabled || database.ExternalDbURL != "" {
+		return database
+	}
+
+	options := map[string]string{}
+	for name, value := range database.Connection.Options {
+		options[name] = value
+	}
+	database.Connection.Options = options
+	database.Connection.Host = fmt.Sprintf("syndesis-db.%s.svc", config.OpenShiftProject)
+	database.Connection.SSLMode = sslMode
+	return database
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_validateInternalTLS(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.InternalTLS.Enabled = true
+	assert.NoError(t, config.validateInternalTLS())
+	assert.False(t, config.MutualInternalTLS())
+
+	config.Syndesis.InternalTLS.Issuer = "vault"
+	assert.EqualError(t, config.validateInternalTLS(), `certificate issuer "vault" is neither service-ca nor cert-manager`)
+
+	config.Syndesis.InternalTLS.Issuer = "cert-manager"
+	assert.EqualError(t, config.validateInternalTLS(), "the cert-manager issuer of the internal certificates is missing")
+
+	config.Syndesis.InternalTLS.IssuerRef = CertificateIssuerReference{Name: "internal", Kind: "ClusterIssuer"}
+	assert.NoError(t, config.validateInternalTLS())
+	assert.True(t, config.MutualInternalTLS())
+
+	config.Syndesis.InternalTLS.IssuerRef.Kind = "Secret"
+	assert.Error(t, config.validateInternalTLS())
+
+	config.Syndesis.InternalTLS.IssuerRef.Kind = ""
+	config.Syndesis.Components.Database.ReadReplicas = 1
+	assert.EqualError(t, config.validateInternalTLS(), "the database read replicas don't support internal TLS")
+}
+
+func TestConfig_DatabaseJDBCURL(t *testing.T) {
+	config := getConfigLiteral()
+	config.OpenShiftProject = "syndesis"
+	assert.Equal(t, "jdbc:postgresql://syndesis-db:5432/syndesis?sslmode=disable", config.DatabaseJDBCURL())
+	assert.Equal(t, "postgresql://syndesis-db:5432/syndesis?sslmode=disable", config.DatabaseURL())
+
+	config.Syndesis.InternalTLS.Enabled = true
+	assert.Equal(t, "jdbc:postgresql://syndesis-db.syndesis.svc:5432/syndesis?sslmode=verify-full&sslrootcert=%2Fetc%2Fsyndesis%2Finternal-ca%2Fservice-ca.crt", config.DatabaseJDBCURL())
+	assert.Equal(t, "postgresql://syndesis-db.syndesis.svc:5432/syndesis?sslmode=require", config.DatabaseURL())
+	assert.Empty(t, config.Syndesis.Components.Database.Connection.Options)
+
+	config.Syndesis.InternalTLS.Issuer = "cert-manager"
+	assert.Equal(t, "jdbc:postgresql://syndesis-db.syndesis.svc:5432/syndesis?sslmode=verify-full&sslrootcert=%2Fetc%2Fsyndesis%2Ftls%2Fca.crt", config.DatabaseJDBCURL())
+
+	// External databases keep their own connection settings
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://db.example.com:5432"
+	config.Syndesis.Components.Database.Connection = DatabaseConnection{Host: "db.example.com", Port: 5432, SSLMode: "require"}
+	assert.Equal(t, "jdbc:postgresql://db.example.com:5432/syndesis?sslmode=require", config.DatabaseJDBCURL())
+}
@@ -0,0 +1,73 @@
+package configuration
+
+import (
+	"sync"
+	"time"
+)
+
+// How long values looked up on the cluster are reused. Events on the resources
+// they were read from invalidate them earlier.
+var lookupTTL = 5 * time.Minute
+
+type lookupEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// Values looked up on the cluster on every reconcile, such as the route
+// hostname or the cluster network settings, which seldom change
+type lookupCache struct {
+	lock    sync.Mutex
+	entries map[string]lookupEntry
+}
+
+var lookups = &lookupCache{entries: map[string]lookupEntry{}}
+
+func (c *lookupCache) get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *lookupCache) put(key string, value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = lookupEntry{value: value, expires: time.Now().Add(lookupTTL)}
+}
+
+func (c *lookupCache) invalidateAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = map[string]lookupEntry{}
+}
+
+func (c *lookupCache) invalidate(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, key)
+}
+
+func routeLookupKey(namespace string) string {
+	return "route/" + namespace
+}
+
+const clusterNetworkLookupKey = "cluster-network"
+
+// Forget the syndesis route hostname resolved in the namespace, to be called when the route changes
+func InvalidateRoute(namespace string) {
+	lookups.invalidate(routeLookupKey(namespace))
+}
+
+// Forget the cluster network settings, to be called when the cluster proxy or ingress configuration changes
+func InvalidateClusterNetwork() {
+	lookups.invalidate(clusterNetworkLookupKey)
+}
+
+// Forget everything looked up on the cluster, to be called when a resync is forced
+func InvalidateLookups() {
+	lookups.invalidateAll()
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+// Returns the cluster defaults set by the platform administrators, empty ones when there are none
+// or the operator isn't allowed to read them.
+func getOperatorConfig(ctx context.Context, client client.Client) (v1alpha1.SyndesisOperatorConfigSpec, error) {
+	if client == nil {
+		return v1alpha1.SyndesisOperatorConfigSpec{}, nil
+	}
+
+	operatorConfig := &v1alpha1.SyndesisOperatorConfig{}
+	if err := client.Get(ctx, types.NamespacedName{Name: v1alpha1.SyndesisOperatorConfigName}, operatorConfig); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) || util.IsNoKindMatchError(err) {
+			return v1alpha1.SyndesisOperatorConfigSpec{}, nil
+		}
+		return v1alpha1.SyndesisOperatorConfigSpec{}, err
+	}
+	return operatorConfig.Spec, nil
+}
+
+// Returns the custom resource completed with the cluster defaults it can override
+func withClusterDefaults(syndesis *v1alpha1.Syndesis, operatorConfig v1alpha1.SyndesisOperatorConfigSpec) *v1alpha1.Syndesis {
+	if syndesis.Spec.Profile != v1alpha1.SyndesisProfileDefault || operatorConfig.DefaultProfile == v1alpha1.SyndesisProfileDefault {
+		return syndesis
+	}
+
+	target := syndesis.DeepCopy()
+	target.Spec.Profile = operatorConfig.DefaultProfile
+	return target
+}
+
+// Apply the cluster settings the custom resource cannot override
+func (config *Config) enforceOperatorConfig(operatorConfig v1alpha1.SyndesisOperatorConfigSpec) {
+	for _, addon := range operatorConfig.ForbiddenAddons {
+		if enabled := config.addonEnabled(addon); enabled != nil {
+			*enabled = false
+		}
+	}
+
+	if mirror := strings.TrimSuffix(operatorConfig.RegistryMirror, "/"); mirror != "" {
+		addons := &config.Syndesis.Addons
+		components := &config.Syndesis.Components
+		// The database image is an image stream tag, it isn't pulled from a registry
+		for _, image := range []*string{
+			&addons.DV.Image,
+			&addons.CamelK.Image,
+			&addons.Broker.Image,
+			&components.Oauth.Image,
+			&components.UI.Image,
+			&components.S2I.Image,
+			&components.Prometheus.Image,
+			&components.Upgrade.Image,
+			&components.Meta.Image,
+			&components.Server.Image,
+			&components.Database.Exporter.Image,
+			&components.Database.Maintenance.Image,
+			&config.Syndesis.SmokeTest.Image,
+		} {
+			if *image != "" {
+				*image = mirrorImage(mirror, *image)
+			}
+		}
+	}
+}
+
+// Resolve the image stream tags the components trigger on to their images in the registry mirror.
+// Tags that cannot be read are left where they are, the deployments keep triggering on them.
+func (config *Config) setMirroredImageStreamTags(ctx context.Context, client client.Client, registryMirror string) error {
+	mirror := strings.TrimSuffix(registryMirror, "/")
+	database := config.Syndesis.Components.Database
+	if mirror == "" || database.ExternalDbURL != "" {
+		return nil
+	}
+
+	image, err := imageStreamTagImage(ctx, client, database.ImageStreamNamespace, database.Image)
+	if err != nil || image == "" {
+		return err
+	}
+
+	config.MirroredImageStreamTags = map[string]string{database.Image: mirrorImage(mirror, image)}
+	return nil
+}
+
+// The image an image stream tag imports, empty when the tag doesn't exist or cannot be read
+func imageStreamTagImage(ctx context.Context, client client.Client, namespace string, name string) (string, error) {
+	tag := &unstructured.Unstructured{}
+	tag.SetAPIVersion("image.openshift.io/v1")
+	tag.SetKind("ImageStreamTag")
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, tag); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) || util.IsNoKindMatchError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if kind, _, _ := unstructured.NestedString(tag.Object, "tag", "from", "kind"); kind == "DockerImage" {
+		image, _, _ := unstructured.NestedString(tag.Object, "tag", "from", "name")
+		return image, nil
+	}
+	image, _, _ := unstructured.NestedString(tag.Object, "image", "dockerImageReference")
+	return image, nil
+}
+
+// The enabled flag of an addon given its name, nil for an unknown addon
+func (config *Config) addonEnabled(name string) *bool {
+	addons := &config.Syndesis.Addons
+	switch name {
+	case "jaeger":
+		return &addons.Jaeger.Enabled
+	case "ops":
+		return &addons.Ops.Enabled
+	case "dv":
+		return &addons.DV.Enabled
+	case "camelk":
+		return &addons.CamelK.Enabled
+	case "knative":
+		return &addons.Knative.Enabled
+	case "todo":
+		return &addons.Todo.Enabled
+	case "broker":
+		return &addons.Broker.Enabled
+	}
+	return nil
+}
+
+// Replace the registry of an image with the mirror, images without registry are pulled from docker.io
+func mirrorImage(mirror string, image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return mirror + "/" + parts[1]
+	}
+	if len(parts) == 1 {
+		return mirror + "/library/" + image
+	}
+	return mirror + "/" + image
+}
+
+// Length of a generated password, at least the one required by the password policy
+func (config *Config) passwordLength(size int) int {
+	if size < config.passwordMinLength {
+		return config.passwordMinLength
+	}
+	return size
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	imagev1 "github.com/openshift/api/image/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func Test_getOperatorConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apis.AddToScheme(scheme))
+
+	spec, err := getOperatorConfig(context.TODO(), fake.NewFakeClientWithScheme(scheme))
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyndesisOperatorConfigSpec{}, spec)
+
+	cluster := &v1alpha1.SyndesisOperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.SyndesisOperatorConfigName},
+		Spec:       v1alpha1.SyndesisOperatorConfigSpec{RegistryMirror: "mirror.example.com"},
+	}
+	spec, err = getOperatorConfig(context.TODO(), fake.NewFakeClientWithScheme(scheme, cluster))
+	require.NoError(t, err)
+	assert.Equal(t, "mirror.example.com", spec.RegistryMirror)
+}
+
+func Test_withClusterDefaults(t *testing.T) {
+	operatorConfig := v1alpha1.SyndesisOperatorConfigSpec{DefaultProfile: v1alpha1.SyndesisProfileDev}
+
+	syndesis := &v1alpha1.Syndesis{}
+	assert.Equal(t, v1alpha1.SyndesisProfileDev, withClusterDefaults(syndesis, operatorConfig).Spec.Profile)
+	assert.Equal(t, v1alpha1.SyndesisProfileDefault, syndesis.Spec.Profile)
+
+	syndesis.Spec.Profile = "production"
+	assert.Equal(t, syndesis, withClusterDefaults(syndesis, operatorConfig))
+}
+
+func TestConfig_enforceOperatorConfig(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Addons.Todo.Enabled = true
+	config.Syndesis.Addons.Ops.Enabled = true
+
+	config.enforceOperatorConfig(v1alpha1.SyndesisOperatorConfigSpec{
+		RegistryMirror:  "mirror.example.com/",
+		ForbiddenAddons: []string{"todo", "unknown"},
+	})
+
+	assert.False(t, config.Syndesis.Addons.Todo.Enabled)
+	assert.True(t, config.Syndesis.Addons.Ops.Enabled)
+	assert.Equal(t, "mirror.example.com/syndesis/syndesis-server:latest", config.Syndesis.Components.Server.Image)
+	assert.Equal(t, "mirror.example.com/openshift/origin-oauth-proxy:v4.0.0", config.Syndesis.Components.Oauth.Image)
+	assert.Equal(t, "mirror.example.com/syndesis/syndesis-s2i:latest", config.Syndesis.Components.S2I.Image)
+	assert.Equal(t, "postgresql:9.6", config.Syndesis.Components.Database.Image)
+}
+
+func TestConfig_setMirroredImageStreamTags(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apis.AddToScheme(scheme))
+	require.NoError(t, imagev1.AddToScheme(scheme))
+
+	config := getConfigLiteral()
+	require.NoError(t, config.setMirroredImageStreamTags(context.TODO(), fake.NewFakeClientWithScheme(scheme), "mirror.example.com"))
+	assert.Nil(t, config.MirroredImageStreamTags)
+	assert.Equal(t, "openshift", config.DatabaseImageStreamNamespace())
+
+	tag := &imagev1.ImageStreamTag{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "image.openshift.io/v1", Kind: "ImageStreamTag"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift", Name: "postgresql:9.6"},
+		Tag: &imagev1.TagReference{
+			Name: "9.6",
+			From: &corev1.ObjectReference{Kind: "DockerImage", Name: "registry.redhat.io/rhscl/postgresql-96-rhel7:latest"},
+		},
+	}
+	config.OpenShiftProject = "syndesis"
+	require.NoError(t, config.setMirroredImageStreamTags(context.TODO(), fake.NewFakeClientWithScheme(scheme, tag), "mirror.example.com/"))
+	assert.Equal(t, map[string]string{"postgresql:9.6": "mirror.example.com/rhscl/postgresql-96-rhel7:latest"}, config.MirroredImageStreamTags)
+	assert.Equal(t, "syndesis", config.DatabaseImageStreamNamespace())
+}
+
+func Test_mirrorImage(t *testing.T) {
+	assert.Equal(t, "mirror:5000/syndesis/syndesis-ui:1.8", mirrorImage("mirror:5000", "docker.io/syndesis/syndesis-ui:1.8"))
+	assert.Equal(t, "mirror:5000/fabric8/s2i-java", mirrorImage("mirror:5000", "fabric8/s2i-java"))
+	assert.Equal(t, "mirror:5000/library/busybox", mirrorImage("mirror:5000", "busybox"))
+	assert.Equal(t, "mirror:5000/image", mirrorImage("mirror:5000", "localhost/image"))
+}
+
+func TestConfig_passwordLength(t *testing.T) {
+	config := &Config{}
+	assert.Equal(t, 16, config.passwordLength(16))
+
+	config.passwordMinLength = 24
+	assert.Equal(t, 24, config.passwordLength(16))
+	assert.Equal(t, 64, config.passwordLength(64))
+}
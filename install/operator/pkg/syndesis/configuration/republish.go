@@ -0,0 +1,86 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Check the batches the integrations are republished in
+func (config *Config) validateIntegrationRepublish() error {
+	republish := config.Syndesis.Integration.Republish
+	if republish.BatchSize < 1 {
+		return fmt.Errorf("integration republish batch size %d is below 1", republish.BatchSize)
+	}
+	if republish.FailureThreshold < 1 {
+		return fmt.Errorf("integration republish failure threshold %d is below 1", republish.FailureThreshold)
+	}
+	if _, err := config.RepublishDelay(); err != nil {
+		return err
+	}
+	timeout, err := config.RepublishTimeout()
+	if err != nil {
+		return err
+	}
+	if timeout == 0 {
+		return errors.New("the integration republish timeout is missing")
+	}
+	return nil
+}
+
+// Shortest time between the start of two batches of republished integrations
+func (config *Config) RepublishDelay() (time.Duration, error) {
+	delay := config.Syndesis.Integration.Republish.Delay
+	if delay == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(delay)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("integration republish delay %q is not a duration", delay)
+	}
+	return duration, nil
+}
+
+// Time the integrations of a batch get to be published again before they count as failed
+func (config *Config) RepublishTimeout() (time.Duration, error) {
+	timeout := config.Syndesis.Integration.Republish.Timeout
+	if timeout == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("integration republish timeout %q is not a duration", timeout)
+	}
+	return duration, nil
+}
+
+// Hash of the S2I image and of the maven repositories the integrations are built with, the published
+// integrations only get a change of them once republished
+func (config *Config) IntegrationBuildFingerprint() string {
+	data, _ := json.Marshal(struct {
+		Image        string
+		Repositories map[string]string
+	}{
+		config.Syndesis.Components.S2I.Image,
+		config.Syndesis.Components.Server.Features.MavenRepositories,
+	})
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
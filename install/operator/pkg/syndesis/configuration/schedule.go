@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Shift the schedules of the cron jobs from the time zone of the installation to UTC, the time zone cron jobs
+// run in on the supported clusters. The offset of the time zone at the given time is used, so the schedules
+// follow daylight saving time changes with the next reconcile.
+func (config *Config) setSchedules(now time.Time) error {
+	maintenance := &config.Syndesis.Components.Database.Maintenance
+	maintenance.UTCSchedule = maintenance.Schedule
+	if config.Syndesis.Timezone == "" || !maintenance.Enabled {
+		return nil
+	}
+
+	location, err := time.LoadLocation(config.Syndesis.Timezone)
+	if err != nil {
+		return fmt.Errorf("time zone %q is unknown: %v", config.Syndesis.Timezone, err)
+	}
+	schedule, err := utcSchedule(maintenance.Schedule, location, now)
+	if err != nil {
+		return fmt.Errorf("database maintenance schedule %q cannot run in time zone %s: %v", maintenance.Schedule, config.Syndesis.Timezone, err)
+	}
+	maintenance.UTCSchedule = schedule
+	return nil
+}
+
+// Shifts a cron schedule from the given location to UTC. Only schedules whose shifted fields stay cron
+// fields can be converted: the minutes must be a single number when the offset has minutes, the hours a list
+// of numbers unless every hour is scheduled, and the days of the month every day when the day changes.
+func utcSchedule(schedule string, location *time.Location, now time.Time) (string, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("not a cron schedule")
+	}
+	_, offset := now.In(location).Zone()
+	if offset == 0 {
+		return schedule, nil
+	}
+	minute, hour, dayOfMonth, month, dayOfWeek := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	hourShift := -offset / 3600
+	if minuteShift := -offset / 60 % 60; minuteShift != 0 {
+		m, err := strconv.Atoi(minute)
+		if err != nil {
+			return "", fmt.Errorf("the minutes must be a single number with an offset of %s", time.Duration(offset)*time.Second)
+		}
+		m += minuteShift
+		hourShift += floorDiv(m, 60)
+		minute = strconv.Itoa(m - floorDiv(m, 60)*60)
+	}
+	if hour == "*" {
+		return strings.Join([]string{minute, hour, dayOfMonth, month, dayOfWeek}, " "), nil
+	}
+
+	hours, err := numbers(hour)
+	if err != nil {
+		return "", fmt.Errorf("the hours must be a list of numbers")
+	}
+	dayShift := floorDiv(hours[0]+hourShift, 24)
+	shifted := make([]int, 0, len(hours))
+	for _, h := range hours {
+		h += hourShift
+		if floorDiv(h, 24) != dayShift && (dayOfMonth != "*" || dayOfWeek != "*" || month != "*") {
+			return "", fmt.Errorf("the hours would fall on different days")
+		}
+		shifted = append(shifted, h-floorDiv(h, 24)*24)
+	}
+	hour = join(shifted, 24)
+
+	if dayShift != 0 {
+		if dayOfMonth != "*" || month != "*" {
+			return "", fmt.Errorf("the days of the month and the months must be * when the day changes")
+		}
+		if dayOfWeek != "*" {
+			days, err := numbers(dayOfWeek)
+			if err != nil {
+				return "", fmt.Errorf("the days of the week must be a list of numbers when the day changes")
+			}
+			for i, d := range days {
+				days[i] = d + dayShift
+			}
+			dayOfWeek = join(days, 7)
+		}
+	}
+	return strings.Join([]string{minute, hour, dayOfMonth, month, dayOfWeek}, " "), nil
+}
+
+func numbers(field string) ([]int, error) {
+	values := []int{}
+	for _, value := range strings.Split(field, ",") {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// Joins numbers modulo the given base into a cron list, sorted and without duplicates
+func join(values []int, base int) string {
+	seen := map[int]bool{}
+	for _, v := range values {
+		seen[(v%base+base)%base] = true
+	}
+	sorted := make([]int, 0, len(seen))
+	for v := range seen {
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+	list := make([]string, 0, len(sorted))
+	for _, v := range sorted {
+		list = append(list, strconv.Itoa(v))
+	}
+	return strings.Join(list, ",")
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
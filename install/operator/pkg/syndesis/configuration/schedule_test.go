@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_utcSchedule(t *testing.T) {
+	winter := time.Date(2019, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2019, 7, 15, 12, 0, 0, 0, time.UTC)
+	zone := func(hours, minutes int) *time.Location {
+		return time.FixedZone("test", hours*3600+minutes*60)
+	}
+
+	tests := []struct {
+		schedule string
+		location *time.Location
+		utc      string
+	}{
+		{"0 3 * * 0", time.UTC, "0 3 * * 0"},
+		{"0 3 * * 0", zone(2, 0), "0 1 * * 0"},
+		{"0 1 * * 0", zone(2, 0), "0 23 * * 6"},
+		{"0 22 * * 6", zone(-5, 0), "0 3 * * 0"},
+		{"15 3 * * *", zone(5, 30), "45 21 * * *"},
+		{"0 0,12 * * *", zone(1, 0), "0 11,23 * * *"},
+		{"*/5 * * * *", zone(1, 0), "*/5 * * * *"},
+		{"30 * * * *", zone(-3, -30), "0 * * * *"},
+	}
+	for _, tt := range tests {
+		utc, err := utcSchedule(tt.schedule, tt.location, winter)
+		require.NoError(t, err, tt.schedule)
+		assert.Equal(t, tt.utc, utc, tt.schedule)
+	}
+
+	// Unrepresentable shifts are refused
+	for _, schedule := range []string{"*/15 3 * * *", "0 1 1 * *", "0 1-3 * * 0", "0 0,12 * * 1"} {
+		_, err := utcSchedule(schedule, zone(5, 30), winter)
+		assert.Error(t, err, schedule)
+	}
+
+	// Daylight saving time changes the offset
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	utc, _ := utcSchedule("0 3 * * 0", paris, winter)
+	assert.Equal(t, "0 2 * * 0", utc)
+	utc, _ = utcSchedule("0 3 * * 0", paris, summer)
+	assert.Equal(t, "0 1 * * 0", utc)
+}
+
+func TestConfig_setSchedules(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Database.Maintenance.Enabled = true
+	config.Syndesis.Components.Database.Maintenance.Schedule = "0 3 * * 0"
+	require.NoError(t, config.setSchedules(time.Now()))
+	assert.Equal(t, "0 3 * * 0", config.Syndesis.Components.Database.Maintenance.UTCSchedule)
+
+	config.Syndesis.Timezone = "Asia/Tokyo"
+	require.NoError(t, config.setSchedules(time.Now()))
+	assert.Equal(t, "0 18 * * 6", config.Syndesis.Components.Database.Maintenance.UTCSchedule)
+
+	config.Syndesis.Timezone = "Mars/Olympus_Mons"
+	assert.Error(t, config.setSchedules(time.Now()))
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// A JSON Schema document
+type Schema map[string]interface{}
+
+// JSON Schema of the operator configuration file, the one given with --operator-config
+func ConfigSchema() Schema {
+	schema := schemaOf(reflect.TypeOf(Config{}), map[reflect.Type]bool{})
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "Syndesis operator configuration"
+	return schema
+}
+
+// JSON Schema of the Syndesis custom resource, only its spec being described in depth
+func CustomResourceSchema() Schema {
+	return Schema{
+		"$schema": jsonSchemaDraft,
+		"title":   "Syndesis custom resource",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"apiVersion": Schema{"type": "string", "enum": []string{v1alpha1.SchemeGroupVersion.String()}},
+			"kind":       Schema{"type": "string", "enum": []string{"Syndesis"}},
+			"metadata":   Schema{"type": "object"},
+			"spec":       schemaOf(reflect.TypeOf(v1alpha1.SyndesisSpec{}), map[reflect.Type]bool{}),
+		},
+		"required": []string{"apiVersion", "kind"},
+	}
+}
+
+// Marshals the schema the way editors expect it
+func (s Schema) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+var (
+	timeType         = reflect.TypeOf(time.Time{})
+	metaTimeType     = reflect.TypeOf(metav1.Time{})
+	quantityType     = reflect.TypeOf(resource.Quantity{})
+	intOrStringType  = reflect.TypeOf(intstr.IntOrString{})
+	rawMessageType   = reflect.TypeOf(json.RawMessage{})
+	unmarshalerType  = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	stringOrIntegers = []interface{}{Schema{"type": "string"}, Schema{"type": "integer"}}
+)
+
+// Schema of the values encoding/json decodes into the type. Types decoding themselves are left
+// unconstrained, as are the types recursively containing themselves past their first occurrence.
+func schemaOf(t reflect.Type, visiting map[reflect.Type]bool) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType, metaTimeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case quantityType, intOrStringType:
+		return Schema{"anyOf": stringOrIntegers}
+	case rawMessageType:
+		return Schema{}
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		}
+		return Schema{"type": "array", "items": schemaOf(t.Elem(), visiting)}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": schemaOf(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return Schema{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		properties := map[string]interface{}{}
+		addProperties(t, properties, visiting)
+		return Schema{"type": "object", "properties": properties, "additionalProperties": false}
+	default:
+		return Schema{}
+	}
+}
+
+// Adds the properties of the exported fields of a struct, those of its untagged embedded structs being inlined
+func addProperties(t reflect.Type, properties map[string]interface{}, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			addProperties(fieldType, properties, visiting)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = schemaOf(field.Type, visiting)
+	}
+}
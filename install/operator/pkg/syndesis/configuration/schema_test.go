@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+// Fails on the values of the document the schema does not describe
+func assertDescribed(t *testing.T, path string, schema map[string]interface{}, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, ok := schema["properties"].(map[string]interface{})
+		if !ok {
+			if additional, ok := schema["additionalProperties"].(Schema); ok {
+				for key, item := range v {
+					assertDescribed(t, path+"."+key, additional, item)
+				}
+			}
+			return
+		}
+		for key, item := range v {
+			property, ok := properties[key].(Schema)
+			if assert.True(t, ok, "%s.%s is not in the schema", path, key) {
+				assertDescribed(t, path+"."+key, property, item)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(Schema); ok {
+			for _, item := range v {
+				assertDescribed(t, path+"[]", items, item)
+			}
+		}
+	case string:
+		if schema["type"] != nil {
+			assert.Equal(t, "string", schema["type"], path)
+		}
+	case bool:
+		assert.Equal(t, "boolean", schema["type"], path)
+	case float64:
+		assert.Contains(t, []interface{}{"integer", "number", nil}, schema["type"], path)
+	}
+}
+
+func TestConfigSchema(t *testing.T) {
+	data, err := ioutil.ReadFile("../../../build/conf/config.yaml")
+	require.NoError(t, err)
+	config := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(data, &config))
+
+	schema := ConfigSchema()
+	assert.Equal(t, jsonSchemaDraft, schema["$schema"])
+	assertDescribed(t, "", schema, config)
+
+	properties := schema["properties"].(map[string]interface{})
+	assert.NotContains(t, properties, "passwordMinLength")
+	syndesis := properties["Syndesis"].(Schema)["properties"].(map[string]interface{})
+	assert.Equal(t, Schema{"type": "array", "items": Schema{"type": "string"}}, syndesis["AlternateHostnames"])
+
+	_, err = schema.JSON()
+	assert.NoError(t, err)
+}
+
+func TestCustomResourceSchema(t *testing.T) {
+	resource := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"apiVersion": "syndesis.io/v1alpha1",
+		"kind": "Syndesis",
+		"metadata": {"name": "app"},
+		"spec": {
+			"addons": {"jaeger": {"enabled": true}},
+			"components": {"server": {"features": {"mavenRepositories": {"central": "https://repo.maven.apache.org/maven2/"}}}}
+		}
+	}`), &resource))
+
+	schema := CustomResourceSchema()
+	assertDescribed(t, "", schema, resource)
+
+	spec := schema["properties"].(map[string]interface{})["spec"].(Schema)
+	assert.Equal(t, false, spec["additionalProperties"])
+	assert.NotContains(t, spec["properties"], "Addons")
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Defaults that change with the operator version, like images, which are never written to the custom resource
+// so that installations keep following the operator when it gets upgraded
+var versionedDefaults = map[string]bool{
+	"Image":         true,
+	"CamelVersion":  true,
+	"CamelKRuntime": true,
+}
+
+// Returns the spec of the custom resource with the defaults it doesn't override filled in: the ones of the
+// configuration file, of the template ConfigMap and of the cluster defaults, with the dev profile applied when
+// selected. The spec then shows the configuration the installation actually runs with, except for the versioned
+// defaults. The ConfigMaps are not read when the client is nil.
+func DefaultedSpec(ctx context.Context, cl client.Client, file string, syndesis *v1alpha1.Syndesis) (*v1alpha1.SyndesisSpec, error) {
+	config := &Config{}
+	if err := config.loadFromFile(file); err != nil {
+		return nil, err
+	}
+	if cl != nil {
+		if TemplateConfigMap != "" {
+			if err := config.loadFromConfigMap(ctx, cl, syndesis.Namespace, TemplateConfigMap); err != nil {
+				return nil, err
+			}
+		}
+		if err := config.setClusterDefaults(ctx, cl); err != nil {
+			return nil, err
+		}
+	}
+	if syndesis.Spec.Profile == v1alpha1.SyndesisProfileDev {
+		config.applyDevProfile()
+	}
+
+	data, err := json.Marshal(config.Syndesis)
+	if err != nil {
+		return nil, err
+	}
+	defaults := map[string]interface{}{}
+	if err := json.Unmarshal(data, &defaults); err != nil {
+		return nil, err
+	}
+	removeVersionedDefaults(defaults)
+	if data, err = json.Marshal(defaults); err != nil {
+		return nil, err
+	}
+
+	// Field names are matched regardless of their case, so the configuration decodes into the spec,
+	// then the fields set by the custom resource are decoded on top of it
+	spec := &v1alpha1.SyndesisSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(syndesis.Spec); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	foldMemoryLimits(spec)
+	return spec, nil
+}
+
+func removeVersionedDefaults(value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if versionedDefaults[key] {
+				delete(value, key)
+				continue
+			}
+			removeVersionedDefaults(child)
+		}
+	case []interface{}:
+		for _, child := range value {
+			removeVersionedDefaults(child)
+		}
+	}
+}
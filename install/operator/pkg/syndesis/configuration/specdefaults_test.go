@@ -0,0 +1,58 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+)
+
+func TestDefaultedSpec(t *testing.T) {
+	syndesis := &v1alpha1.Syndesis{}
+	syndesis.Spec.Components.Server.Resources.Memory = "2Gi"
+	syndesis.Spec.Addons.Jaeger.Enabled = true
+
+	spec, err := DefaultedSpec(context.TODO(), nil, "../../../build/conf/config.yaml", syndesis)
+	require.NoError(t, err)
+	assert.Equal(t, "2Gi", spec.Components.Server.Resources.Memory)
+	assert.True(t, spec.Addons.Jaeger.Enabled)
+	assert.Equal(t, "const", spec.Addons.Jaeger.SamplerType)
+	assert.Equal(t, "1Gi", spec.Components.Database.Resources.VolumeCapacity)
+	assert.Equal(t, "512Mi", spec.Components.Meta.Resources.Memory)
+
+	// The memory limit replaces the default of its deprecated alias
+	syndesis.Spec.Components.Meta.Resources.MemoryLimit = "1Gi"
+	spec, err = DefaultedSpec(context.TODO(), nil, "../../../build/conf/config.yaml", syndesis)
+	require.NoError(t, err)
+	assert.Equal(t, "1Gi", spec.Components.Meta.Resources.Memory)
+	assert.Equal(t, "1Gi", spec.Components.Meta.Resources.MemoryLimit)
+
+	// Images follow the operator version
+	assert.Empty(t, spec.Addons.CamelK.Image)
+	assert.Empty(t, spec.Addons.CamelK.CamelVersion)
+	assert.Empty(t, spec.SmokeTest.Image)
+
+	syndesis = &v1alpha1.Syndesis{Spec: v1alpha1.SyndesisSpec{Profile: v1alpha1.SyndesisProfileDev}}
+	spec, err = DefaultedSpec(context.TODO(), nil, "../../../build/conf/config.yaml", syndesis)
+	require.NoError(t, err)
+	assert.Equal(t, "256Mi", spec.Components.Meta.Resources.Memory)
+}
@@ -0,0 +1,420 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Checks the whole configuration before anything gets deployed with it. Every problem is reported, each one
+// prefixed with the path of the custom resource field to fix, rather than only the first one found.
+func (config *Config) Validate() error {
+	spec := field.NewPath("spec")
+	checks := []struct {
+		path     *field.Path
+		validate func() error
+	}{
+		{spec.Child("installMode"), config.validateInstallMode},
+		{spec.Child("components", "oauth", "client"), config.validateOAuthClient},
+		{spec.Child("alternateHostnamesMode"), config.validateAlternateHostnames},
+		{spec.Child("addons", "jaeger", "sampling"), config.validateJaegerSampling},
+		{spec.Child("addons"), config.validateAddonPods},
+		{spec.Child("components", "prometheus", "remoteWrite"), config.validatePrometheusRemoteWrite},
+		{spec.Child("hostAliases"), config.validateNameResolution},
+		{spec.Child("components", "prometheus", "federation"), config.validatePrometheusFederation},
+		{spec.Child("components", "upgrade"), config.validateUpgrade},
+		{spec.Child("integration", "controller"), config.validateIntegrationController},
+		{spec.Child("integration", "quota"), config.validateIntegrationQuota},
+		{spec.Child("integration", "secretSync"), config.validateSecretSync},
+		{spec.Child("integration", "republish"), config.validateIntegrationRepublish},
+		{spec.Child("components"), config.validateSidecarResources},
+		{spec.Child("integration", "runtime"), config.validateIntegrationRuntime},
+		{spec.Child("components", "server", "features", "demoData"), config.validateDemoData},
+		{spec.Child("backup"), config.validateBackup},
+		{spec.Child("certificates", "expiryThreshold"), func() error {
+			_, err := config.CertificateExpiryThreshold()
+			return err
+		}},
+		{spec.Child("connectors"), config.validateConnectors},
+		{spec.Child("route"), config.validateRoute},
+		{spec.Child("components", "database", "connection"), config.validateDatabaseConnection},
+		{spec.Child("components", "database"), config.validateDatabaseReplicas},
+		{spec.Child("components", "database", "maintenance"), config.validateDatabaseMaintenance},
+		{spec.Child("standby"), config.validateStandby},
+		{spec.Child("internalTLS"), config.validateInternalTLS},
+		{spec.Child("imagePolicy"), config.validateImagePolicy},
+		{spec.Child("addons", "ops", "slo"), config.validateSLO},
+	}
+
+	errs := []error{}
+	for _, check := range checks {
+		if err := check.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", check.path, err))
+		}
+	}
+	fieldErrs := config.validateQuantities(spec)
+	fieldErrs = append(fieldErrs, config.validateImages(spec)...)
+	fieldErrs = append(fieldErrs, config.validateVolumes(spec)...)
+	fieldErrs = append(fieldErrs, config.validateScheduling(spec)...)
+	fieldErrs = append(fieldErrs, config.validateURLs(spec)...)
+	fieldErrs = append(fieldErrs, config.validateHostnames(spec)...)
+	fieldErrs = append(fieldErrs, config.validateLimits(spec)...)
+	fieldErrs = append(fieldErrs, config.validateAddonDependencies(spec)...)
+	for _, err := range fieldErrs {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Memory, cpu and volume sizes of the components, which would otherwise only fail once their resources are applied
+func (config *Config) validateQuantities(spec *field.Path) field.ErrorList {
+	components := config.Syndesis.Components
+	path := spec.Child("components")
+	quantities := []struct {
+		path  *field.Path
+		value string
+	}{
+		{path.Child("server", "resources", "memory"), components.Server.Resources.Memory},
+		{path.Child("server", "resources", "memoryRequest"), components.Server.Resources.MemoryRequest},
+		{path.Child("server", "resources", "cpu"), components.Server.Resources.CPU},
+		{path.Child("server", "resources", "cpuLimit"), components.Server.Resources.CPULimit},
+		{path.Child("meta", "resources", "memory"), components.Meta.Resources.Memory},
+		{path.Child("meta", "resources", "memoryRequest"), components.Meta.Resources.MemoryRequest},
+		{path.Child("meta", "resources", "cpu"), components.Meta.Resources.CPU},
+		{path.Child("meta", "resources", "cpuLimit"), components.Meta.Resources.CPULimit},
+		{path.Child("meta", "resources", "volumeCapacity"), components.Meta.Resources.VolumeCapacity},
+		{path.Child("database", "resources", "memory"), components.Database.Resources.Memory},
+		{path.Child("database", "resources", "memoryRequest"), components.Database.Resources.MemoryRequest},
+		{path.Child("database", "resources", "cpu"), components.Database.Resources.CPU},
+		{path.Child("database", "resources", "cpuLimit"), components.Database.Resources.CPULimit},
+		{path.Child("database", "resources", "volumeCapacity"), components.Database.Resources.VolumeCapacity},
+		{path.Child("prometheus", "resources", "memory"), components.Prometheus.Resources.Memory},
+		{path.Child("prometheus", "resources", "memoryRequest"), components.Prometheus.Resources.MemoryRequest},
+		{path.Child("prometheus", "resources", "cpu"), components.Prometheus.Resources.CPU},
+		{path.Child("prometheus", "resources", "cpuLimit"), components.Prometheus.Resources.CPULimit},
+		{path.Child("prometheus", "resources", "volumeCapacity"), components.Prometheus.Resources.VolumeCapacity},
+		{path.Child("grafana", "resources", "memory"), components.Grafana.Resources.Memory},
+		{path.Child("upgrade", "resources", "volumeCapacity"), components.Upgrade.Resources.VolumeCapacity},
+		{spec.Child("addons", "dv", "resources", "memory"), config.Syndesis.Addons.DV.Resources.Memory},
+	}
+
+	errs := field.ErrorList{}
+	for _, quantity := range quantities {
+		if quantity.value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(quantity.value); err != nil {
+			errs = append(errs, field.Invalid(quantity.path, quantity.value, "not a quantity"))
+		}
+	}
+
+	// Pods whose requests exceed their limits are rejected by the API server. The defaults of the templates are
+	// compared too, the field that is set being reported when the other one is a default.
+	server, meta := path.Child("server", "resources"), path.Child("meta", "resources")
+	database, prometheus := path.Child("database", "resources"), path.Child("prometheus", "resources")
+	requests := []requestLimit{
+		{server.Child("memoryRequest"), server.Child("memory"), components.Server.Resources.MemoryRequest, components.Server.Resources.Memory, "256Mi", ""},
+		{server.Child("cpu"), server.Child("cpuLimit"), components.Server.Resources.CPU, components.Server.Resources.CPULimit, "450m", "750m"},
+		{meta.Child("memoryRequest"), meta.Child("memory"), components.Meta.Resources.MemoryRequest, components.Meta.Resources.Memory, "280Mi", ""},
+		{meta.Child("cpu"), meta.Child("cpuLimit"), components.Meta.Resources.CPU, components.Meta.Resources.CPULimit, "", ""},
+		{database.Child("memoryRequest"), database.Child("memory"), components.Database.Resources.MemoryRequest, components.Database.Resources.Memory, "", ""},
+		{database.Child("cpu"), database.Child("cpuLimit"), components.Database.Resources.CPU, components.Database.Resources.CPULimit, "", ""},
+		{prometheus.Child("memoryRequest"), prometheus.Child("memory"), components.Prometheus.Resources.MemoryRequest, components.Prometheus.Resources.Memory, "", ""},
+		{prometheus.Child("cpu"), prometheus.Child("cpuLimit"), components.Prometheus.Resources.CPU, components.Prometheus.Resources.CPULimit, "", ""},
+	}
+	for _, r := range requests {
+		request, limit := r.request, r.limit
+		if request == "" {
+			request = r.defaultRequest
+		}
+		if limit == "" {
+			limit = r.defaultLimit
+		}
+		requested, err := resource.ParseQuantity(request)
+		if err != nil {
+			continue
+		}
+		limited, err := resource.ParseQuantity(limit)
+		if err != nil || requested.Cmp(limited) <= 0 {
+			continue
+		}
+		if r.request != "" {
+			errs = append(errs, field.Invalid(r.requestPath, request, fmt.Sprintf("above the limit %s", limit)))
+		} else {
+			errs = append(errs, field.Invalid(r.limitPath, limit, fmt.Sprintf("below the default request %s", request)))
+		}
+	}
+	return errs
+}
+
+// A request of the pod of a component with the limit it may not exceed, and the defaults of the templates for both
+type requestLimit struct {
+	requestPath, limitPath       *field.Path
+	request, limit               string
+	defaultRequest, defaultLimit string
+}
+
+// Storage classes, access modes and volumes of the volume claims of the components
+func (config *Config) validateVolumes(spec *field.Path) field.ErrorList {
+	components := config.Syndesis.Components
+	path := spec.Child("components")
+	volumes := []struct {
+		path                             *field.Path
+		storageClass, accessMode, volume string
+	}{
+		{path.Child("meta", "resources"), components.Meta.Resources.VolumeStorageClass, components.Meta.Resources.VolumeAccessMode, components.Meta.Resources.VolumeName},
+		{path.Child("database", "resources"), components.Database.Resources.VolumeStorageClass, components.Database.Resources.VolumeAccessMode, components.Database.Resources.VolumeName},
+		{path.Child("prometheus", "resources"), components.Prometheus.Resources.VolumeStorageClass, components.Prometheus.Resources.VolumeAccessMode, components.Prometheus.Resources.VolumeName},
+		{path.Child("upgrade", "resources"), components.Upgrade.Resources.VolumeStorageClass, components.Upgrade.Resources.VolumeAccessMode, components.Upgrade.Resources.VolumeName},
+	}
+
+	errs := field.ErrorList{}
+	for _, volume := range volumes {
+		for _, name := range []struct {
+			field, value string
+		}{{"volumeStorageClass", volume.storageClass}, {"volumeName", volume.volume}} {
+			if name.value == "" {
+				continue
+			}
+			if problems := validation.IsDNS1123Subdomain(name.value); len(problems) > 0 {
+				errs = append(errs, field.Invalid(volume.path.Child(name.field), name.value, strings.Join(problems, ", ")))
+			}
+		}
+		// The components write to their volume, ReadOnlyMany claims won't do
+		switch corev1.PersistentVolumeAccessMode(volume.accessMode) {
+		case "", corev1.ReadWriteOnce, corev1.ReadWriteMany, "ReadWriteOncePod":
+		default:
+			errs = append(errs, field.NotSupported(volume.path.Child("volumeAccessMode"), volume.accessMode,
+				[]string{string(corev1.ReadWriteOnce), string(corev1.ReadWriteMany), "ReadWriteOncePod"}))
+		}
+	}
+	return errs
+}
+
+// Node selectors and tolerations of the components, the pods of a component with an invalid one would never be created
+func (config *Config) validateScheduling(spec *field.Path) field.ErrorList {
+	components := config.Syndesis.Components
+	path := spec.Child("components")
+	schedulings := []struct {
+		path       *field.Path
+		scheduling SchedulingConfiguration
+	}{
+		{path.Child("ui", "scheduling"), components.UI.Scheduling},
+		{path.Child("oauth", "scheduling"), components.Oauth.Scheduling},
+		{path.Child("server", "scheduling"), components.Server.Scheduling},
+		{path.Child("meta", "scheduling"), components.Meta.Scheduling},
+		{path.Child("database", "scheduling"), components.Database.Scheduling},
+		{path.Child("prometheus", "scheduling"), components.Prometheus.Scheduling},
+	}
+
+	errs := field.ErrorList{}
+	for _, s := range schedulings {
+		keys := make([]string, 0, len(s.scheduling.NodeSelector))
+		for key := range s.scheduling.NodeSelector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if problems := validation.IsQualifiedName(key); len(problems) > 0 {
+				errs = append(errs, field.Invalid(s.path.Child("nodeSelector"), key, strings.Join(problems, ", ")))
+			}
+			if problems := validation.IsValidLabelValue(s.scheduling.NodeSelector[key]); len(problems) > 0 {
+				errs = append(errs, field.Invalid(s.path.Child("nodeSelector").Key(key), s.scheduling.NodeSelector[key], strings.Join(problems, ", ")))
+			}
+		}
+
+		for i, toleration := range s.scheduling.Tolerations {
+			tolerationPath := s.path.Child("tolerations").Index(i)
+			if toleration.Key != "" {
+				if problems := validation.IsQualifiedName(toleration.Key); len(problems) > 0 {
+					errs = append(errs, field.Invalid(tolerationPath.Child("key"), toleration.Key, strings.Join(problems, ", ")))
+				}
+			}
+			switch toleration.Operator {
+			case "", corev1.TolerationOpEqual:
+				if toleration.Key == "" {
+					errs = append(errs, field.Invalid(tolerationPath.Child("operator"), toleration.Operator, "must be Exists when the key is empty"))
+				}
+			case corev1.TolerationOpExists:
+				if toleration.Value != "" {
+					errs = append(errs, field.Invalid(tolerationPath.Child("value"), toleration.Value, "must be empty when the operator is Exists"))
+				}
+			default:
+				errs = append(errs, field.NotSupported(tolerationPath.Child("operator"), toleration.Operator,
+					[]string{string(corev1.TolerationOpEqual), string(corev1.TolerationOpExists)}))
+			}
+			switch toleration.Effect {
+			case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+			default:
+				errs = append(errs, field.NotSupported(tolerationPath.Child("effect"), toleration.Effect,
+					[]string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}))
+			}
+		}
+	}
+	return errs
+}
+
+// Repository, optionally prefixed by a registry host and followed by a tag and a digest
+var imageReference = regexp.MustCompile(`^([a-zA-Z0-9.-]+(:[0-9]+)?/)?[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*(/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*)*(:[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?(@sha256:[a-f0-9]{64})?$`)
+
+// Images of the components, required for the ones that get deployed
+func (config *Config) validateImages(spec *field.Path) field.ErrorList {
+	components := config.Syndesis.Components
+	addons := config.Syndesis.Addons
+	path := spec.Child("components")
+	bundledDatabase := components.Database.ExternalDbURL == ""
+	images := []struct {
+		path     *field.Path
+		value    string
+		required bool
+	}{
+		{path.Child("server", "image"), components.Server.Image, true},
+		{path.Child("meta", "image"), components.Meta.Image, true},
+		{path.Child("ui", "image"), components.UI.Image, true},
+		{path.Child("s2i", "image"), components.S2I.Image, true},
+		{path.Child("oauth", "image"), components.Oauth.Image, true},
+		{path.Child("upgrade", "image"), components.Upgrade.Image, true},
+		{path.Child("database", "image"), components.Database.Image, bundledDatabase},
+		{path.Child("database", "exporter", "image"), components.Database.Exporter.Image, bundledDatabase},
+		{path.Child("database", "maintenance", "image"), components.Database.Maintenance.Image, components.Database.Maintenance.Enabled},
+		{path.Child("prometheus", "image"), components.Prometheus.Image, components.Prometheus.Enabled},
+		{spec.Child("addons", "dv", "image"), addons.DV.Image, addons.DV.Enabled},
+		{spec.Child("addons", "broker", "image"), addons.Broker.Image, false},
+		{spec.Child("addons", "camelk", "image"), addons.CamelK.Image, false},
+		{spec.Child("smokeTest", "image"), config.Syndesis.SmokeTest.Image, config.Syndesis.SmokeTest.Enabled},
+	}
+
+	errs := field.ErrorList{}
+	for _, image := range images {
+		switch {
+		case image.value == "" && image.required:
+			errs = append(errs, field.Required(image.path, "the image is required"))
+		case image.value != "" && !imageReference.MatchString(image.value):
+			errs = append(errs, field.Invalid(image.path, image.value, "not an image reference"))
+		}
+	}
+	return errs
+}
+
+// URLs the components call or link to, which must be absolute
+func (config *Config) validateURLs(spec *field.Path) field.ErrorList {
+	features := config.Syndesis.Components.Server.Features
+	featuresPath := spec.Child("components", "server", "features")
+	urls := []struct {
+		path  *field.Path
+		value string
+	}{
+		{spec.Child("telemetry", "endpoint"), config.Syndesis.Telemetry.Endpoint},
+		{spec.Child("notifications", "webhookURL"), config.Syndesis.Notifications.WebhookURL},
+		{spec.Child("consoleLink", "imageURL"), config.Syndesis.ConsoleLink.ImageURL},
+		{featuresPath.Child("managementUrlFor3scale"), features.ManagementUrlFor3scale},
+	}
+	names := make([]string, 0, len(features.MavenRepositories))
+	for name := range features.MavenRepositories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		urls = append(urls, struct {
+			path  *field.Path
+			value string
+		}{featuresPath.Child("mavenRepositories").Key(name), features.MavenRepositories[name]})
+	}
+
+	errs := field.ErrorList{}
+	for _, u := range urls {
+		if u.value == "" {
+			continue
+		}
+		if parsed, err := url.Parse(u.value); err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			errs = append(errs, field.Invalid(u.path, u.value, "not an absolute url"))
+		}
+	}
+	return errs
+}
+
+// Hostnames of the routes and ingresses, which the cluster refuses when they are not DNS names
+func (config *Config) validateHostnames(spec *field.Path) field.ErrorList {
+	hostnames := []struct {
+		path  *field.Path
+		value string
+	}{
+		{spec.Child("externalHostname"), config.Syndesis.ExternalHostname},
+		{spec.Child("components", "prometheus", "federation", "hostname"), config.Syndesis.Components.Prometheus.Federation.Hostname},
+	}
+	for i, hostname := range config.Syndesis.AlternateHostnames {
+		hostnames = append(hostnames, struct {
+			path  *field.Path
+			value string
+		}{spec.Child("alternateHostnames").Index(i), hostname})
+	}
+
+	errs := field.ErrorList{}
+	for _, hostname := range hostnames {
+		if hostname.value == "" {
+			continue
+		}
+		if problems := validation.IsDNS1123Subdomain(hostname.value); len(problems) > 0 {
+			errs = append(errs, field.Invalid(hostname.path, hostname.value, strings.Join(problems, ", ")))
+		}
+	}
+	return errs
+}
+
+// Counts and limits, none of which can be negative
+func (config *Config) validateLimits(spec *field.Path) field.ErrorList {
+	components := config.Syndesis.Components
+	path := spec.Child("components")
+	limits := []struct {
+		path  *field.Path
+		value int
+	}{
+		{path.Child("server", "features", "integrationLimit"), components.Server.Features.IntegrationLimit},
+		{path.Child("server", "features", "integrationStateCheckInterval"), components.Server.Features.IntegrationStateCheckInterval},
+		{path.Child("server", "replicas"), components.Server.Replicas},
+		{path.Child("ui", "replicas"), components.UI.Replicas},
+	}
+
+	errs := field.ErrorList{}
+	for _, limit := range limits {
+		if limit.value < 0 {
+			errs = append(errs, field.Invalid(limit.path, limit.value, "cannot be negative"))
+		}
+	}
+	return errs
+}
+
+// Addons that only work on top of other ones
+func (config *Config) validateAddonDependencies(spec *field.Path) field.ErrorList {
+	addons := config.Syndesis.Addons
+	errs := field.ErrorList{}
+	// The Camel K platform is what runs the integrations as knative services
+	if addons.Knative.Enabled && !addons.CamelK.Enabled {
+		errs = append(errs, field.Invalid(spec.Child("addons", "knative", "enabled"), true, "the knative addon requires the camelk addon"))
+	}
+	return errs
+}
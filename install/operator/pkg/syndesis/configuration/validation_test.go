@@ -0,0 +1,153 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	config := getConfigLiteral()
+	assert.NoError(t, config.Validate())
+
+	config.Syndesis.InstallMode = "minimal"
+	config.Syndesis.Components.Server.Resources.Memory = "1024Zi"
+	config.Syndesis.Components.Meta.Image = ""
+	config.Syndesis.Components.UI.Image = "Syndesis UI"
+	config.Syndesis.Telemetry.Endpoint = "/report"
+	config.Syndesis.Components.Server.Features.IntegrationLimit = -1
+	config.Syndesis.Addons.Knative.Enabled = true
+	config.Syndesis.Addons.CamelK.Enabled = false
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Equal(t, []string{
+		`spec.installMode: install mode "minimal" is neither full nor infrastructureOnly`,
+		`spec.components.server.resources.memory: Invalid value: "1024Zi": not a quantity`,
+		`spec.components.meta.image: Required value: the image is required`,
+		`spec.components.ui.image: Invalid value: "Syndesis UI": not an image reference`,
+		`spec.telemetry.endpoint: Invalid value: "/report": not an absolute url`,
+		`spec.components.server.features.integrationLimit: Invalid value: -1: cannot be negative`,
+		`spec.addons.knative.enabled: Invalid value: true: the knative addon requires the camelk addon`,
+	}, errorMessages(err))
+}
+
+func TestConfig_validateImages(t *testing.T) {
+	config := getConfigLiteral()
+	for _, image := range []string{
+		"postgresql:9.6",
+		"fabric8/s2i-java:3.0-java8",
+		"registry.example.com:5000/syndesis/syndesis-server:1.9",
+		"quay.io/syndesis/syndesis-server@sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	} {
+		config.Syndesis.Components.Server.Image = image
+		assert.Empty(t, config.validateImages(nil), image)
+	}
+
+	// Images of the bundled database are not needed with an external one
+	config.Syndesis.Components.Database.Image = ""
+	assert.Len(t, config.validateImages(nil), 1)
+	config.Syndesis.Components.Database.ExternalDbURL = "postgresql://db.example.com:5432/syndesis"
+	assert.Empty(t, config.validateImages(nil))
+}
+
+func TestConfig_validateQuantities(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Server.Resources = Resources{Memory: "1Gi", MemoryRequest: "512Mi", CPU: "500m", CPULimit: "1"}
+	config.Syndesis.Components.Database.Resources.CPU = "250m"
+	assert.Empty(t, config.validateQuantities(field.NewPath("spec")))
+
+	config.Syndesis.Components.Server.Resources.CPU = "1500m"
+	config.Syndesis.Components.Meta.Resources.CPULimit = "one"
+	errs := config.validateQuantities(field.NewPath("spec"))
+	require.Len(t, errs, 2)
+	assert.Equal(t, `spec.components.meta.resources.cpuLimit: Invalid value: "one": not a quantity`, errs[0].Error())
+	assert.Equal(t, `spec.components.server.resources.cpu: Invalid value: "1500m": above the limit 1`, errs[1].Error())
+
+	// The defaults of the templates count when only one of the request and the limit is set
+	config = getConfigLiteral()
+	config.Syndesis.Components.Server.Resources = Resources{Memory: "200Mi", CPU: "1"}
+	config.Syndesis.Components.Meta.Resources.MemoryRequest = "1Gi"
+	errs = config.validateQuantities(field.NewPath("spec"))
+	require.Len(t, errs, 3)
+	assert.Equal(t, `spec.components.server.resources.memory: Invalid value: "200Mi": below the default request 256Mi`, errs[0].Error())
+	assert.Equal(t, `spec.components.server.resources.cpu: Invalid value: "1": above the limit 750m`, errs[1].Error())
+	assert.Equal(t, `spec.components.meta.resources.memoryRequest: Invalid value: "1Gi": above the limit `+config.Syndesis.Components.Meta.Resources.Memory, errs[2].Error())
+}
+
+func TestConfig_validateVolumes(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Database.Resources.VolumeStorageClass = "ceph-rbd"
+	config.Syndesis.Components.Database.Resources.VolumeAccessMode = "ReadWriteMany"
+	config.Syndesis.Components.Upgrade.Resources.VolumeName = "syndesis-backups"
+	assert.Empty(t, config.validateVolumes(field.NewPath("spec")))
+
+	config.Syndesis.Components.Meta.Resources.VolumeStorageClass = "Fast_SSD"
+	config.Syndesis.Components.Prometheus.Resources.VolumeAccessMode = "ReadOnlyMany"
+	errs := config.validateVolumes(field.NewPath("spec"))
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.components.meta.resources.volumeStorageClass", errs[0].Field)
+	assert.Equal(t, `spec.components.prometheus.resources.volumeAccessMode: Unsupported value: "ReadOnlyMany": supported values: "ReadWriteOnce", "ReadWriteMany", "ReadWriteOncePod"`, errs[1].Error())
+}
+
+func TestConfig_validateScheduling(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.Components.Database.Scheduling = SchedulingConfiguration{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/storage": "true"},
+		Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "storage", Effect: corev1.TaintEffectNoSchedule}},
+	}
+	config.Syndesis.Components.Server.Scheduling.Tolerations = []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+	assert.Empty(t, config.validateScheduling(field.NewPath("spec")))
+
+	config.Syndesis.Components.Meta.Scheduling.NodeSelector = map[string]string{"zone": "eu west"}
+	config.Syndesis.Components.Prometheus.Scheduling.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpExists, Value: "infra"},
+		{Value: "infra", Effect: "NoRun"},
+	}
+	errs := config.validateScheduling(field.NewPath("spec"))
+	require.Len(t, errs, 4)
+	assert.Equal(t, "spec.components.meta.scheduling.nodeSelector[zone]", errs[0].Field)
+	assert.Equal(t, "spec.components.prometheus.scheduling.tolerations[0].value", errs[1].Field)
+	assert.Equal(t, "spec.components.prometheus.scheduling.tolerations[1].operator", errs[2].Field)
+	assert.Equal(t, `spec.components.prometheus.scheduling.tolerations[1].effect: Unsupported value: "NoRun": supported values: "NoSchedule", "PreferNoSchedule", "NoExecute"`, errs[3].Error())
+}
+
+func TestConfig_validateHostnames(t *testing.T) {
+	config := getConfigLiteral()
+	config.Syndesis.ExternalHostname = "syndesis.apps.example.com"
+	config.Syndesis.AlternateHostnames = []string{"integrations.example.com", "https://integrations.example.com"}
+	config.Syndesis.Components.Prometheus.Federation.Hostname = "Federation_Host"
+
+	errs := config.validateHostnames(field.NewPath("spec"))
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.components.prometheus.federation.hostname", errs[0].Field)
+	assert.Equal(t, "spec.alternateHostnames[1]", errs[1].Field)
+}
+
+func errorMessages(err error) []string {
+	messages := []string{}
+	for _, e := range err.(utilerrors.Aggregate).Errors() {
+		messages = append(messages, e.Error())
+	}
+	return messages
+}
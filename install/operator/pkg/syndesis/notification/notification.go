@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+// Keys expected in the secret referenced by NotificationsConfiguration.SmtpSecret
+const (
+	SmtpHostKey     = "host"
+	SmtpPortKey     = "port"
+	SmtpUsernameKey = "username"
+	SmtpPasswordKey = "password"
+	SmtpFromKey     = "from"
+)
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// Time given to the SMTP server to take a notification, so that an unresponsive server does not block the operator
+var smtpTimeout = 30 * time.Second
+
+type Event struct {
+	Namespace string `json:"namespace"`
+	Subject   string `json:"subject"`
+	Message   string `json:"message"`
+}
+
+// Send the event to the configured webhook and by email to the configured recipients.
+// Both channels are attempted, the first error found is returned.
+func Send(ctx context.Context, cl client.Client, config configuration.NotificationsConfiguration, event Event) error {
+	var result error
+
+	if config.WebhookURL != "" {
+		if err := sendWebhook(ctx, config.WebhookURL, event); err != nil {
+			result = err
+		}
+	}
+
+	if config.SmtpSecret != "" && len(config.Recipients) > 0 {
+		if err := sendMail(ctx, cl, config, event); err != nil && result == nil {
+			result = err
+		}
+	}
+
+	return result
+}
+
+func sendWebhook(ctx context.Context, url string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook %s answered with status %s", url, res.Status)
+	}
+	return nil
+}
+
+func sendMail(ctx context.Context, cl client.Client, config configuration.NotificationsConfiguration, event Event) error {
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: event.Namespace, Name: config.SmtpSecret}, secret); err != nil {
+		return err
+	}
+
+	host := string(secret.Data[SmtpHostKey])
+	if host == "" {
+		return fmt.Errorf("secret %s does not define the smtp %s", config.SmtpSecret, SmtpHostKey)
+	}
+	port := string(secret.Data[SmtpPortKey])
+	if port == "" {
+		port = "25"
+	}
+	from := string(secret.Data[SmtpFromKey])
+	if from == "" {
+		from = "syndesis-operator@" + host
+	}
+
+	var auth smtp.Auth
+	if username := string(secret.Data[SmtpUsernameKey]); username != "" {
+		auth = smtp.PlainAuth("", username, string(secret.Data[SmtpPasswordKey]), host)
+	}
+
+	msg := "From: " + from + "\r\n" +
+		"To: " + strings.Join(config.Recipients, ", ") + "\r\n" +
+		"Subject: [syndesis/" + event.Namespace + "] " + event.Subject + "\r\n" +
+		"\r\n" +
+		event.Message + "\r\n"
+
+	return submit(ctx, host, port, auth, from, config.Recipients, []byte(msg))
+}
+
+// Same as smtp.SendMail, with the whole conversation with the server bounded by smtpTimeout
+func submit(ctx context.Context, host string, port string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(smtpTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server %s does not support authentication", host)
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := c.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func TestSend_Webhook(t *testing.T) {
+	var received Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	event := Event{Namespace: "syndesis", Subject: "Upgrade completed", Message: "done"}
+	err := Send(context.TODO(), nil, configuration.NotificationsConfiguration{WebhookURL: server.URL}, event)
+
+	assert.NoError(t, err)
+	assert.Equal(t, event, received)
+}
+
+func TestSend_WebhookFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := Send(context.TODO(), nil, configuration.NotificationsConfiguration{WebhookURL: server.URL}, Event{})
+
+	assert.Error(t, err)
+}
+
+// SMTP server taking a single message, sending the commands and the message it received when the client quits.
+// When it does not respond, it keeps the connection open without answering.
+func smtpServer(t *testing.T, respond bool) (string, <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	received := make(chan string, 1)
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if !respond {
+			ioutil.ReadAll(conn)
+			return
+		}
+
+		r := bufio.NewReader(conn)
+		transcript := strings.Builder{}
+		data := false
+		fmt.Fprint(conn, "220 localhost ESMTP\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			transcript.WriteString(line)
+			switch {
+			case data && line == ".\r\n":
+				data = false
+				fmt.Fprint(conn, "250 OK\r\n")
+			case data:
+			case strings.HasPrefix(line, "EHLO"):
+				fmt.Fprint(conn, "250 localhost\r\n")
+			case strings.HasPrefix(line, "DATA"):
+				data = true
+				fmt.Fprint(conn, "354 End data with <CR><LF>.<CR><LF>\r\n")
+			case strings.HasPrefix(line, "QUIT"):
+				fmt.Fprint(conn, "221 Bye\r\n")
+				received <- transcript.String()
+				return
+			default:
+				fmt.Fprint(conn, "250 OK\r\n")
+			}
+		}
+	}()
+	return l.Addr().String(), received
+}
+
+func smtpSecret(addr string) *corev1.Secret {
+	host, port, _ := net.SplitHostPort(addr)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "smtp", Namespace: "syndesis"},
+		Data: map[string][]byte{
+			SmtpHostKey: []byte(host),
+			SmtpPortKey: []byte(port),
+			SmtpFromKey: []byte("syndesis@example.com"),
+		},
+	}
+}
+
+func TestSend_Mail(t *testing.T) {
+	addr, received := smtpServer(t, true)
+	config := configuration.NotificationsConfiguration{SmtpSecret: "smtp", Recipients: []string{"ops@example.com", "dev@example.com"}}
+	event := Event{Namespace: "syndesis", Subject: "Upgrade completed", Message: "done"}
+
+	require.NoError(t, Send(context.TODO(), fake.NewFakeClient(smtpSecret(addr)), config, event))
+	transcript := <-received
+	assert.Contains(t, transcript, "MAIL FROM:<syndesis@example.com>")
+	assert.Contains(t, transcript, "RCPT TO:<ops@example.com>")
+	assert.Contains(t, transcript, "RCPT TO:<dev@example.com>")
+	assert.Contains(t, transcript, "To: ops@example.com, dev@example.com\r\n")
+	assert.Contains(t, transcript, "Subject: [syndesis/syndesis] Upgrade completed\r\n\r\ndone\r\n")
+}
+
+func TestSend_MailFailure(t *testing.T) {
+	timeout := smtpTimeout
+	defer func() { smtpTimeout = timeout }()
+	smtpTimeout = 100 * time.Millisecond
+
+	addr, _ := smtpServer(t, false)
+	config := configuration.NotificationsConfiguration{SmtpSecret: "smtp", Recipients: []string{"ops@example.com"}}
+	err := Send(context.TODO(), fake.NewFakeClient(smtpSecret(addr)), config, Event{Namespace: "syndesis"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "i/o timeout")
+
+	secret := smtpSecret(addr)
+	delete(secret.Data, SmtpHostKey)
+	err = Send(context.TODO(), fake.NewFakeClient(secret), config, Event{Namespace: "syndesis"})
+	assert.EqualError(t, err, "secret smtp does not define the smtp host")
+}
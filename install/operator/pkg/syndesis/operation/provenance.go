@@ -0,0 +1,48 @@
+package operation
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/syndesisio/syndesis/install/operator/version"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// Annotation recording the version of the operator that applied a resource
+	OperatorVersionAnnotation = "syndesis.io/operator-version"
+
+	// Annotation recording the hash of the configuration a resource was applied with
+	ConfigHashAnnotation = "syndesis.io/config-hash"
+)
+
+// Stamps a rendered resource with the version of the operator and the hash of its configuration,
+// provenance annotations included, so that a resource applied again unchanged keeps its hash
+func SetProvenance(res *unstructured.Unstructured) error {
+	annotations := res.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[OperatorVersionAnnotation] = version.Version
+	delete(annotations, ConfigHashAnnotation)
+	res.SetAnnotations(annotations)
+
+	data, err := json.Marshal(res.Object)
+	if err != nil {
+		return err
+	}
+	annotations[ConfigHashAnnotation] = fmt.Sprintf("%x", sha256.Sum256(data))
+	res.SetAnnotations(annotations)
+	return nil
+}
+
+// Whether a resource the operator had to update got changed outside of it, the live resource
+// having been applied with the very configuration the operator applied again
+func Drifted(live *unstructured.Unstructured, applied *unstructured.Unstructured) bool {
+	if live == nil {
+		return false
+	}
+	hash := live.GetAnnotations()[ConfigHashAnnotation]
+	return hash != "" && hash == applied.GetAnnotations()[ConfigHashAnnotation]
+}
@@ -0,0 +1,59 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/version"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func configMap(data string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":        "syndesis-server-config",
+			"annotations": map[string]interface{}{"syndesis.io/template": "infrastructure/03-syndesis-server-config.yml.tmpl"},
+		},
+		"data": map[string]interface{}{"application.yml": data},
+	}}
+}
+
+func TestSetProvenance(t *testing.T) {
+	applied := configMap("a")
+	require.NoError(t, SetProvenance(applied))
+	hash := applied.GetAnnotations()[ConfigHashAnnotation]
+	assert.Len(t, hash, 64)
+	assert.Equal(t, version.Version, applied.GetAnnotations()[OperatorVersionAnnotation])
+	assert.Equal(t, "infrastructure/03-syndesis-server-config.yml.tmpl", applied.GetAnnotations()["syndesis.io/template"])
+
+	// Applying the same configuration again keeps the hash
+	again := applied.DeepCopy()
+	require.NoError(t, SetProvenance(again))
+	assert.Equal(t, hash, again.GetAnnotations()[ConfigHashAnnotation])
+
+	changed := configMap("b")
+	require.NoError(t, SetProvenance(changed))
+	assert.NotEqual(t, hash, changed.GetAnnotations()[ConfigHashAnnotation])
+}
+
+func TestDrifted(t *testing.T) {
+	applied := configMap("a")
+	require.NoError(t, SetProvenance(applied))
+
+	// Edited by hand since the operator applied it
+	live := applied.DeepCopy()
+	live.Object["data"] = map[string]interface{}{"application.yml": "edited"}
+	assert.True(t, Drifted(live, applied))
+
+	// Applied with another configuration
+	changed := configMap("b")
+	require.NoError(t, SetProvenance(changed))
+	assert.False(t, Drifted(live, changed))
+
+	// Created, or applied by an operator that didn't record provenance
+	assert.False(t, Drifted(nil, applied))
+	assert.False(t, Drifted(configMap("a"), applied))
+}
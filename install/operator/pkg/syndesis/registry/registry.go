@@ -0,0 +1,310 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package registry reads the tags of image repositories and the digests they point to, over the HTTP API of
+// the docker registries, so that the components can follow new releases of their images.
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Host of the registry images without one are pulled from, and the one serving its API
+const (
+	dockerHub    = "docker.io"
+	dockerHubAPI = "registry-1.docker.io"
+)
+
+// Manifests a tag may point to, the digest of a manifest list is the one of the image for every architecture
+var manifestTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// Next page of a tag list, e.g. </v2/syndesis/syndesis-server/tags/list?last=1.9.0&n=100>; rel="next"
+var nextLink = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="next"`)
+
+// Parameters of an authentication challenge, e.g. realm="https://auth.docker.io/token",service="registry.docker.io"
+var challengeParameter = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// An image reference split into the repository and the tag
+type Reference struct {
+	Name       string // Image without its tag and digest, as written
+	Registry   string // Host serving the registry API
+	Repository string // Path of the repository in the registry
+	Tag        string // Tag of the image, latest when it has none
+}
+
+// Splits an image reference, images without a registry host being pulled from docker hub
+func ParseReference(image string) (Reference, error) {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	tag := "latest"
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if name == "" {
+		return Reference{}, fmt.Errorf("%q is not an image reference", image)
+	}
+
+	registry, repository := dockerHub, name
+	if parts := strings.SplitN(name, "/", 2); len(parts) == 2 && strings.ContainsAny(parts[0], ".:") {
+		registry, repository = parts[0], parts[1]
+	}
+	if registry == dockerHub {
+		registry = dockerHubAPI
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	return Reference{Name: name, Registry: registry, Repository: repository, Tag: tag}, nil
+}
+
+// The image of another tag of the repository pinned to a digest, e.g. docker.io/syndesis/syndesis-server:1.9.1@sha256:...
+func (r Reference) Pinned(tag string, digest string) string {
+	return r.Name + ":" + tag + "@" + digest
+}
+
+// Username and password of a registry
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Reads the credentials of the registries from a docker config.json or .dockercfg document, by registry host
+func ParseDockerConfig(data []byte) (map[string]Credentials, error) {
+	type entry struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	config := struct {
+		Auths map[string]entry `json:"auths"`
+	}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	if config.Auths == nil {
+		// The legacy .dockercfg format has the entries at the top level
+		if err := json.Unmarshal(data, &config.Auths); err != nil {
+			return nil, err
+		}
+	}
+
+	credentials := map[string]Credentials{}
+	for server, e := range config.Auths {
+		c := Credentials{Username: e.Username, Password: e.Password}
+		if e.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(e.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("credentials of %s: %v", server, err)
+			}
+			parts := strings.SplitN(string(decoded), ":", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("credentials of %s are not username:password", server)
+			}
+			c = Credentials{Username: parts[0], Password: parts[1]}
+		}
+		credentials[registryHost(server)] = c
+	}
+	return credentials, nil
+}
+
+// The host of a server of a docker config, which may be a URL, docker hub being known under several names
+func registryHost(server string) string {
+	host := server
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	host = strings.SplitN(host, "/", 2)[0]
+	switch host {
+	case dockerHub, "index.docker.io", "registry.hub.docker.com":
+		return dockerHubAPI
+	}
+	return host
+}
+
+// Client of the registry API, authenticating with the credentials of the registries when it is challenged
+type Client struct {
+	http        *http.Client
+	credentials map[string]Credentials
+}
+
+func NewClient(credentials map[string]Credentials) *Client {
+	return &Client{http: &http.Client{Timeout: 30 * time.Second}, credentials: credentials}
+}
+
+// All the tags of the repository of an image
+func (c *Client) Tags(ctx context.Context, ref Reference) ([]string, error) {
+	tags := []string{}
+	next := "/v2/" + ref.Repository + "/tags/list"
+	for next != "" {
+		res, err := c.get(ctx, http.MethodGet, ref, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		page := struct {
+			Tags []string `json:"tags"`
+		}{}
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("tags of %s: %v", ref.Name, err)
+		}
+		tags = append(tags, page.Tags...)
+
+		next = ""
+		if match := nextLink.FindStringSubmatch(res.Header.Get("Link")); match != nil {
+			next = match[1]
+		}
+	}
+	return tags, nil
+}
+
+// The digest of the manifest a tag of the repository of an image points to
+func (c *Client) Digest(ctx context.Context, ref Reference, tag string) (string, error) {
+	res, err := c.get(ctx, http.MethodHead, ref, "/v2/"+ref.Repository+"/manifests/"+tag, map[string]string{
+		"Accept": strings.Join(manifestTypes, ", "),
+	})
+	if err != nil {
+		return "", err
+	}
+	res.Body.Close()
+
+	digest := res.Header.Get("Docker-Content-Digest")
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("registry %s gave no digest for %s:%s", ref.Registry, ref.Name, tag)
+	}
+	return digest, nil
+}
+
+// Sends a request to the registry, answering its authentication challenge if any
+func (c *Client) get(ctx context.Context, method string, ref Reference, path string, headers map[string]string) (*http.Response, error) {
+	target, err := url.Parse("https://" + ref.Registry)
+	if err != nil {
+		return nil, err
+	}
+	if target, err = target.Parse(path); err != nil {
+		return nil, err
+	}
+
+	authorization := ""
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(method, target.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		res, err := c.http.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			res.Body.Close()
+			if authorization, err = c.authorize(ctx, ref, res.Header.Get("WWW-Authenticate")); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if res.StatusCode >= 300 {
+			res.Body.Close()
+			return nil, fmt.Errorf("registry %s answered %s to %s %s", ref.Registry, res.Status, method, target.Path)
+		}
+		return res, nil
+	}
+}
+
+// The authorization header answering a challenge of the registry: the credentials with basic authentication,
+// a token of the authentication service with bearer authentication, anonymous when there are no credentials
+func (c *Client) authorize(ctx context.Context, ref Reference, challenge string) (string, error) {
+	credentials, found := c.credentials[ref.Registry]
+	scheme := strings.ToLower(strings.SplitN(challenge, " ", 2)[0])
+	switch scheme {
+	case "basic":
+		if !found {
+			return "", fmt.Errorf("registry %s requires credentials", ref.Registry)
+		}
+		return "Basic " + basicAuth(credentials), nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("registry %s asks for unsupported authentication %q", ref.Registry, challenge)
+	}
+
+	parameters := map[string]string{}
+	for _, match := range challengeParameter.FindAllStringSubmatch(challenge, -1) {
+		parameters[match[1]] = match[2]
+	}
+	realm, err := url.Parse(parameters["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("registry %s gave no authentication realm", ref.Registry)
+	}
+	query := realm.Query()
+	if service := parameters["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", "repository:"+ref.Repository+":pull")
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if found {
+		req.Header.Set("Authorization", "Basic "+basicAuth(credentials))
+	}
+	res, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return "", fmt.Errorf("authentication service of %s answered %s", ref.Registry, res.Status)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return "Bearer " + token.Token, nil
+}
+
+func basicAuth(credentials Credentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected Reference
+	}{
+		{"docker.io/syndesis/syndesis-server:1.9.0", Reference{"docker.io/syndesis/syndesis-server", "registry-1.docker.io", "syndesis/syndesis-server", "1.9.0"}},
+		{"fabric8/s2i-java:3.0-java8", Reference{"fabric8/s2i-java", "registry-1.docker.io", "fabric8/s2i-java", "3.0-java8"}},
+		{"postgres", Reference{"postgres", "registry-1.docker.io", "library/postgres", "latest"}},
+		{"quay.io/openshift/origin-oauth-proxy:v4.0.0", Reference{"quay.io/openshift/origin-oauth-proxy", "quay.io", "openshift/origin-oauth-proxy", "v4.0.0"}},
+		{"localhost:5000/syndesis/syndesis-ui", Reference{"localhost:5000/syndesis/syndesis-ui", "localhost:5000", "syndesis/syndesis-ui", "latest"}},
+		{"registry.example.com:5000/syndesis/syndesis-meta:1.9.0@sha256:0123", Reference{"registry.example.com:5000/syndesis/syndesis-meta", "registry.example.com:5000", "syndesis/syndesis-meta", "1.9.0"}},
+	}
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			ref, err := ParseReference(test.image)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ref)
+		})
+	}
+
+	_, err := ParseReference(":1.9.0")
+	assert.Error(t, err)
+
+	ref, _ := ParseReference("docker.io/syndesis/syndesis-server:1.9.0")
+	assert.Equal(t, "docker.io/syndesis/syndesis-server:1.9.2@sha256:abcd", ref.Pinned("1.9.2", "sha256:abcd"))
+}
+
+func TestParseDockerConfig(t *testing.T) {
+	// bWU6c2VjcmV0 is me:secret
+	tests := []struct {
+		name     string
+		config   string
+		expected map[string]Credentials
+	}{
+		{"config.json", `{"auths": {"https://index.docker.io/v1/": {"auth": "bWU6c2VjcmV0"}, "quay.io": {"username": "robot", "password": "token"}}}`, map[string]Credentials{
+			"registry-1.docker.io": {Username: "me", Password: "secret"},
+			"quay.io":              {Username: "robot", Password: "token"},
+		}},
+		{".dockercfg", `{"registry.example.com:5000": {"auth": "bWU6c2VjcmV0"}}`, map[string]Credentials{
+			"registry.example.com:5000": {Username: "me", Password: "secret"},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			credentials, err := ParseDockerConfig([]byte(test.config))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, credentials)
+		})
+	}
+
+	_, err := ParseDockerConfig([]byte(`{"auths": {"quay.io": {"auth": "bm9jb2xvbg=="}}}`))
+	assert.EqualError(t, err, "credentials of quay.io are not username:password")
+}
+
+func TestClient(t *testing.T) {
+	var registryHost string
+	tokens := []string{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			// Tokens are only given to the ones with the credentials of the registry
+			if user, password, ok := r.BasicAuth(); !ok || user != "me" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "registry", r.URL.Query().Get("service"))
+			tokens = append(tokens, r.URL.Query().Get("scope"))
+			w.Write([]byte(`{"token": "t0k3n"}`))
+		case r.Header.Get("Authorization") != "Bearer t0k3n":
+			w.Header().Set("WWW-Authenticate", `Bearer realm="https://`+registryHost+`/token",service="registry",scope="repository:syndesis/syndesis-server:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/syndesis/syndesis-server/tags/list" && r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/syndesis/syndesis-server/tags/list?last=1.9.0&n=2>; rel="next"`)
+			w.Write([]byte(`{"name": "syndesis/syndesis-server", "tags": ["1.8.0", "1.9.0"]}`))
+		case r.URL.Path == "/v2/syndesis/syndesis-server/tags/list":
+			w.Write([]byte(`{"name": "syndesis/syndesis-server", "tags": ["latest"]}`))
+		case r.URL.Path == "/v2/syndesis/syndesis-server/manifests/1.9.0":
+			assert.Equal(t, http.MethodHead, r.Method)
+			assert.Contains(t, r.Header.Get("Accept"), "application/vnd.docker.distribution.manifest.list.v2+json")
+			w.Header().Set("Docker-Content-Digest", "sha256:abcd")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	registryHost = strings.TrimPrefix(server.URL, "https://")
+
+	client := NewClient(map[string]Credentials{registryHost: {Username: "me", Password: "secret"}})
+	client.http = server.Client()
+	ref, err := ParseReference(registryHost + "/syndesis/syndesis-server:1.9.0")
+	require.NoError(t, err)
+
+	tags, err := client.Tags(context.TODO(), ref)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.8.0", "1.9.0", "latest"}, tags)
+
+	digest, err := client.Digest(context.TODO(), ref, "1.9.0")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:abcd", digest)
+	assert.Equal(t, []string{"repository:syndesis/syndesis-server:pull", "repository:syndesis/syndesis-server:pull", "repository:syndesis/syndesis-server:pull"}, tokens)
+
+	_, err = client.Digest(context.TODO(), ref, "2.0.0")
+	assert.Contains(t, err.Error(), "answered 404 Not Found to HEAD /v2/syndesis/syndesis-server/manifests/2.0.0")
+
+	// Without credentials the registry cannot be read
+	client.credentials = nil
+	_, err = client.Tags(context.TODO(), ref)
+	assert.EqualError(t, err, "authentication service of "+registryHost+" answered 401 Unauthorized")
+}
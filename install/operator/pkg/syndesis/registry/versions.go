@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// A release tag: an optional v, two or three numbers and an optional suffix, e.g. 1.9.0, v2.1.0 or 3.0-java8
+var releaseTag = regexp.MustCompile(`^(v?)([0-9]+)\.([0-9]+)(\.([0-9]+))?(-.*)?$`)
+
+type release struct {
+	prefix  string
+	numbers [3]int
+	parts   int
+	suffix  string
+}
+
+func parseRelease(tag string) (release, bool) {
+	match := releaseTag.FindStringSubmatch(tag)
+	if match == nil {
+		return release{}, false
+	}
+	r := release{prefix: match[1], parts: 2, suffix: match[6]}
+	for i, number := range []string{match[2], match[3], match[5]} {
+		if number == "" {
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return release{}, false
+		}
+		r.numbers[i] = n
+	}
+	if match[5] != "" {
+		r.parts = 3
+	}
+	return r, true
+}
+
+// Whether both tags are written the same way, e.g. 1.9.0 and 1.10.2 but not v1.10.2, 1.10 or 1.10.2-rc1
+func (r release) sameLine(other release) bool {
+	return r.prefix == other.prefix && r.parts == other.parts && r.suffix == other.suffix
+}
+
+func (r release) newerThan(other release) bool {
+	for i := range r.numbers {
+		if r.numbers[i] != other.numbers[i] {
+			return r.numbers[i] > other.numbers[i]
+		}
+	}
+	return false
+}
+
+// Releases newer than the current tag among the tags of its repository
+type Releases struct {
+	Patch string // Newest release of the same major and minor version
+	Minor string // Newest release of the same major version
+	Major string // Newest release
+}
+
+// The releases newer than the current tag, empty when there are none or the current tag isn't a release
+func NewerReleases(current string, tags []string) Releases {
+	releases := Releases{}
+	base, ok := parseRelease(current)
+	if !ok {
+		return releases
+	}
+
+	patch, minor, major := base, base, base
+	for _, tag := range tags {
+		r, ok := parseRelease(tag)
+		if !ok || !r.sameLine(base) {
+			continue
+		}
+		if r.newerThan(major) {
+			major, releases.Major = r, tag
+		}
+		if r.numbers[0] == base.numbers[0] && r.newerThan(minor) {
+			minor, releases.Minor = r, tag
+		}
+		if r.numbers[0] == base.numbers[0] && r.numbers[1] == base.numbers[1] && r.newerThan(patch) {
+			patch, releases.Patch = r, tag
+		}
+	}
+	return releases
+}
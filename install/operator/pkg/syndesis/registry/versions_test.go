@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewerReleases(t *testing.T) {
+	tags := []string{"latest", "1.8.4", "1.9.0", "1.9.2", "1.9.10", "1.10.1", "1.10.0", "2.0.0", "2.0.0-rc1", "v1.9.11", "1.11", "nightly-1.12.0"}
+	tests := []struct {
+		name     string
+		current  string
+		tags     []string
+		expected Releases
+	}{
+		{"patch, minor and major", "1.9.0", tags, Releases{Patch: "1.9.10", Minor: "1.10.1", Major: "2.0.0"}},
+		{"numbers compare as numbers", "1.9.2", tags, Releases{Patch: "1.9.10", Minor: "1.10.1", Major: "2.0.0"}},
+		{"newest of the minor version", "1.10.1", tags, Releases{Major: "2.0.0"}},
+		{"newest release", "2.0.0", tags, Releases{}},
+		{"same suffix only", "2.0.0-rc1", tags, Releases{}},
+		{"same prefix only", "v1.9.0", tags, Releases{Patch: "v1.9.11", Minor: "v1.9.11", Major: "v1.9.11"}},
+		{"two numbers", "1.10", tags, Releases{Minor: "1.11", Major: "1.11"}},
+		{"suffixed line", "3.0-java8", []string{"3.0-java8", "3.1-java8", "3.1-java11", "4.0"}, Releases{Minor: "3.1-java8", Major: "3.1-java8"}},
+		{"not a release", "latest", tags, Releases{}},
+		{"no tags", "1.9.0", nil, Releases{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NewerReleases(test.current, test.tags))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package snapshot takes CSI volume snapshots of the database volume claim and
+// restores them into new claims, a faster alternative to logical dumps.
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	Group        = "snapshot.storage.k8s.io"
+	GroupVersion = Group + "/v1beta1"
+	Kind         = "VolumeSnapshot"
+
+	// Volume claim of the database
+	DatabaseClaim = "syndesis-db"
+)
+
+// Snapshot of the volume claim, taken by the CSI driver with the given snapshot class,
+// the default one of the driver when empty
+func New(namespace string, name string, claim string, class string) *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetAPIVersion(GroupVersion)
+	snapshot.SetKind(Kind)
+	snapshot.SetNamespace(namespace)
+	snapshot.SetName(name)
+	snapshot.SetLabels(map[string]string{
+		"app":                   "syndesis",
+		"syndesis.io/app":       "syndesis",
+		"syndesis.io/component": "syndesis-db",
+	})
+	unstructured.SetNestedField(snapshot.Object, claim, "spec", "source", "persistentVolumeClaimName")
+	if class != "" {
+		unstructured.SetNestedField(snapshot.Object, class, "spec", "volumeSnapshotClassName")
+	}
+	return snapshot
+}
+
+// Creates the snapshot of the volume claim
+func Create(ctx context.Context, cl client.Client, namespace string, name string, claim string, class string) (*unstructured.Unstructured, error) {
+	snapshot := New(namespace, name, claim, class)
+	if err := cl.Create(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Reads the snapshot
+func Get(ctx context.Context, cl client.Client, namespace string, name string) (*unstructured.Unstructured, error) {
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetAPIVersion(GroupVersion)
+	snapshot.SetKind(Kind)
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Whether the point in time of the snapshot has been cut, the volume can be written to again from then on
+func Taken(snapshot *unstructured.Unstructured) bool {
+	creationTime, _, _ := unstructured.NestedString(snapshot.Object, "status", "creationTime")
+	return creationTime != ""
+}
+
+// Whether the snapshot can be restored, an error being returned when the CSI driver failed taking it
+func Ready(snapshot *unstructured.Unstructured) (bool, error) {
+	if message, ok, _ := unstructured.NestedString(snapshot.Object, "status", "error", "message"); ok {
+		return false, fmt.Errorf("volume snapshot %s failed: %s", snapshot.GetName(), message)
+	}
+	ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	return ready, nil
+}
+
+// Volume claim restoring the snapshot, with the storage class, access modes and size of the snapshotted claim
+func RestoreClaim(name string, snapshot string, source *corev1.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
+	apiGroup := Group
+	return &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       source.Namespace,
+			Name:            name,
+			Labels:          source.Labels,
+			OwnerReferences: source.OwnerReferences,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      source.Spec.AccessModes,
+			StorageClassName: source.Spec.StorageClassName,
+			VolumeMode:       source.Spec.VolumeMode,
+			Resources:        source.Spec.Resources,
+			DataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     Kind,
+				Name:     snapshot,
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNew(t *testing.T) {
+	snapshot := New("syndesis", "syndesis-db-backup", DatabaseClaim, "csi-snapclass")
+	assert.Equal(t, "snapshot.storage.k8s.io/v1beta1", snapshot.GetAPIVersion())
+	claim, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	assert.Equal(t, "syndesis-db", claim)
+	class, _, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+	assert.Equal(t, "csi-snapclass", class)
+
+	snapshot = New("syndesis", "syndesis-db-backup", DatabaseClaim, "")
+	_, found, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+	assert.False(t, found)
+}
+
+func TestReady(t *testing.T) {
+	snapshot := New("syndesis", "syndesis-db-backup", DatabaseClaim, "")
+	ready, err := Ready(snapshot)
+	assert.NoError(t, err)
+	assert.False(t, ready)
+	assert.False(t, Taken(snapshot))
+
+	unstructured.SetNestedField(snapshot.Object, "2020-03-01T10:00:00Z", "status", "creationTime")
+	assert.True(t, Taken(snapshot))
+	unstructured.SetNestedField(snapshot.Object, true, "status", "readyToUse")
+	ready, err = Ready(snapshot)
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	unstructured.SetNestedField(snapshot.Object, "driver timed out", "status", "error", "message")
+	_, err = Ready(snapshot)
+	assert.EqualError(t, err, "volume snapshot syndesis-db-backup failed: driver timed out")
+}
+
+func TestRestoreClaim(t *testing.T) {
+	storageClass := "gp2-csi"
+	source := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "syndesis", Name: DatabaseClaim, ResourceVersion: "42", Labels: map[string]string{"app": "syndesis"}},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: &storageClass,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+			VolumeName: "pvc-1234",
+		},
+	}
+
+	claim := RestoreClaim(DatabaseClaim, "syndesis-db-backup", source)
+	assert.Equal(t, "syndesis", claim.Namespace)
+	assert.Empty(t, claim.ResourceVersion)
+	assert.Empty(t, claim.Spec.VolumeName)
+	assert.Equal(t, &storageClass, claim.Spec.StorageClassName)
+	assert.Equal(t, "VolumeSnapshot", claim.Spec.DataSource.Kind)
+	assert.Equal(t, "syndesis-db-backup", claim.Spec.DataSource.Name)
+	assert.Equal(t, "snapshot.storage.k8s.io", *claim.Spec.DataSource.APIGroup)
+}
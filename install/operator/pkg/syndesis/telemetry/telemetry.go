@@ -0,0 +1,112 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package telemetry reports how syndesis is used, when the custom resource opts in.
+// Reports never contain names, hostnames, namespaces or anything else identifying
+// the installation or its users.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/openshift/api/apps/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/component"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+const (
+	ClusterTypeOpenShift  = "openshift"
+	ClusterTypeKubernetes = "kubernetes"
+)
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+type Report struct {
+	Version      string   `json:"version"`
+	ClusterType  string   `json:"clusterType"`
+	Addons       []string `json:"addons"`
+	Integrations int      `json:"integrations"`
+}
+
+// Gathers the report of the syndesis installation in the given namespace
+func Collect(ctx context.Context, cl client.Client, api discovery.DiscoveryInterface, config *configuration.Config, version string, namespace string) (Report, error) {
+	integrations := v1.DeploymentConfigList{}
+	options := client.ListOptions{Namespace: namespace}
+	if err := options.SetLabelSelector("syndesis.io/type=integration"); err != nil {
+		return Report{}, err
+	}
+	if err := cl.List(ctx, &options, &integrations); err != nil {
+		return Report{}, err
+	}
+
+	return newReport(config, version, clusterType(api), len(integrations.Items)), nil
+}
+
+func newReport(config *configuration.Config, version string, clusterType string, integrations int) Report {
+	addons := []string{}
+	for _, c := range component.Registry {
+		if c.Addon && c.IsEnabled(config) {
+			addons = append(addons, c.Name)
+		}
+	}
+
+	return Report{
+		Version:      version,
+		ClusterType:  clusterType,
+		Addons:       addons,
+		Integrations: integrations,
+	}
+}
+
+func clusterType(api discovery.DiscoveryInterface) string {
+	if _, err := api.ServerResourcesForGroupVersion("route.openshift.io/v1"); err == nil {
+		return ClusterTypeOpenShift
+	}
+	return ClusterTypeKubernetes
+}
+
+// Posts the report to the telemetry endpoint
+func Send(ctx context.Context, endpoint string, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint %s answered with status %s", endpoint, res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+)
+
+func Test_newReport(t *testing.T) {
+	config := &configuration.Config{}
+	config.Syndesis.Addons.Jaeger.Enabled = true
+	config.Syndesis.Addons.Todo.Enabled = true
+
+	report := newReport(config, "1.9.0", ClusterTypeOpenShift, 3)
+
+	assert.Equal(t, Report{
+		Version:      "1.9.0",
+		ClusterType:  ClusterTypeOpenShift,
+		Addons:       []string{"jaeger", "todo"},
+		Integrations: 3,
+	}, report)
+}
+
+func TestSend(t *testing.T) {
+	var received Report
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	report := Report{Version: "1.9.0", ClusterType: ClusterTypeKubernetes, Addons: []string{}, Integrations: 1}
+	assert.NoError(t, Send(context.TODO(), server.URL, report))
+	assert.Equal(t, report, received)
+}
+
+func TestSend_Failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	assert.Error(t, Send(context.TODO(), server.URL, Report{}))
+}
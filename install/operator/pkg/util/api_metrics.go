@@ -0,0 +1,54 @@
+package util
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Number of requests sent to the API server since the operator started
+var apiCalls uint64
+
+var reconcileAPICalls = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "syndesis_operator_reconcile_api_calls",
+	Help:    "Number of API server requests made by a single reconcile of a Syndesis resource.",
+	Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+})
+
+func init() {
+	metrics.Registry.MustRegister(reconcileAPICalls)
+}
+
+// Counts the requests sent to the API server by the clients built from the given config
+func CountAPICalls(config *rest.Config) {
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &countingRoundTripper{next: rt}
+	}
+}
+
+// Returns the number of requests sent to the API server so far
+func APICalls() uint64 {
+	return atomic.LoadUint64(&apiCalls)
+}
+
+// Records the requests sent to the API server since the given count, as returned by APICalls,
+// as made by a single reconcile. Intended to be deferred at the start of a reconcile.
+func ObserveReconcileAPICalls(since uint64) {
+	reconcileAPICalls.Observe(float64(APICalls() - since))
+}
+
+type countingRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddUint64(&apiCalls, 1)
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,31 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestCountAPICalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL}
+	CountAPICalls(config)
+	transport, err := rest.TransportFor(config)
+	require.NoError(t, err)
+
+	before := APICalls()
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, before+1, APICalls())
+}
@@ -0,0 +1,74 @@
+package util
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Kinds the operator reads straight from the API server. There are many of them in
+// a namespace running integrations while the operator reads only a handful, so
+// caching them all with an informer would cost a lot of memory. In exchange, every
+// read of their content is a request to the API server: the reads only needing
+// their existence or ownership go through GetObjectMetadata and its metadata cache.
+var uncachedKinds = map[schema.GroupVersionKind]bool{
+	corev1.SchemeGroupVersion.WithKind("Secret"):    true,
+	corev1.SchemeGroupVersion.WithKind("ConfigMap"): true,
+}
+
+// Creates the manager client. Like the default one it reads from the cache and
+// writes to the API server, except for the uncached kinds which are always read
+// from the API server.
+func NewClient(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+	c, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.DelegatingClient{
+		Reader: &uncachedKindsReader{
+			DelegatingReader: client.DelegatingReader{
+				CacheReader:  cache,
+				ClientReader: c,
+			},
+			scheme: options.Scheme,
+		},
+		Writer:       c,
+		StatusClient: c,
+	}, nil
+}
+
+type uncachedKindsReader struct {
+	client.DelegatingReader
+	scheme *runtime.Scheme
+}
+
+func (r *uncachedKindsReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if r.isUncached(obj) {
+		return r.ClientReader.Get(ctx, key, obj)
+	}
+	return r.DelegatingReader.Get(ctx, key, obj)
+}
+
+func (r *uncachedKindsReader) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	if r.isUncached(list) {
+		return r.ClientReader.List(ctx, opts, list)
+	}
+	return r.DelegatingReader.List(ctx, opts, list)
+}
+
+func (r *uncachedKindsReader) isUncached(obj runtime.Object) bool {
+	gvk, err := apiutil.GVKForObject(obj, r.scheme)
+	if err != nil {
+		return false
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	return uncachedKinds[gvk]
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUncachedKindsReader(t *testing.T) {
+	meta := metav1.ObjectMeta{Namespace: "syndesis", Name: "syndesis"}
+	api := fake.NewFakeClient(&corev1.Secret{ObjectMeta: meta}, &corev1.ServiceAccount{ObjectMeta: meta})
+	reader := &uncachedKindsReader{
+		DelegatingReader: client.DelegatingReader{
+			CacheReader:  fake.NewFakeClient(),
+			ClientReader: api,
+		},
+		scheme: clientscheme.Scheme,
+	}
+
+	key := client.ObjectKey{Namespace: "syndesis", Name: "syndesis"}
+	assert.NoError(t, reader.Get(context.TODO(), key, &corev1.Secret{}))
+	assert.True(t, errors.IsNotFound(reader.Get(context.TODO(), key, &corev1.ServiceAccount{})))
+
+	secrets := &corev1.SecretList{}
+	assert.NoError(t, reader.List(context.TODO(), &client.ListOptions{Namespace: "syndesis"}, secrets))
+	assert.Len(t, secrets.Items, 1)
+}
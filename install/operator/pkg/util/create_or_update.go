@@ -17,17 +17,31 @@ var FlagSet *pflag.FlagSet = nil
 var showResourceDiffs = false
 var KnownDockerImages map[string]bool = map[string]bool{}
 
+// Fields that cannot change once the resource is created, the values of the live resource are kept
+var immutableFields = map[string]bool{
+	"v1/PersistentVolumeClaim/spec/storageClassName": true,
+	"v1/PersistentVolumeClaim/spec/accessModes":      true,
+	"v1/PersistentVolumeClaim/spec/volumeName":       true,
+}
+
 func init() {
 	FlagSet = pflag.NewFlagSet("util", pflag.ExitOnError)
 	FlagSet.BoolVar(&showResourceDiffs, "print-resource-diffs", false, "Enable printing resource diffs for resources that get updated.")
 }
 
 func CreateOrUpdate(ctx context.Context, cl client.Client, o runtime.Object, skipFields ...string) (*unstructured.Unstructured, controllerutil.OperationResult, error) {
+	updated, _, modType, err := CreateOrUpdateFromLive(ctx, cl, o, skipFields...)
+	return updated, modType, err
+}
+
+// Like CreateOrUpdate, also returning the live resource as it was before being updated, nil when it got created
+func CreateOrUpdateFromLive(ctx context.Context, cl client.Client, o runtime.Object, skipFields ...string) (*unstructured.Unstructured, *unstructured.Unstructured, controllerutil.OperationResult, error) {
 
 	desired, err := ToUnstructured(o)
 	if err != nil {
-		return desired, controllerutil.OperationResultNone, err
+		return desired, nil, controllerutil.OperationResultNone, err
 	}
+	var live *unstructured.Unstructured
 
 	originalYaml := ""
 	updatedYaml := ""
@@ -36,6 +50,8 @@ func CreateOrUpdate(ctx context.Context, cl client.Client, o runtime.Object, ski
 	modType, err := controllerutil.CreateOrUpdate(ctx, cl, createdCopy, func(o runtime.Object) error {
 
 		existing := o.(*unstructured.Unstructured)
+		original := existing.DeepCopy()
+		live = original
 		originalYaml = Dump(existing)
 
 		mergePath := desired.GetAPIVersion() + "/" + desired.GetKind()
@@ -49,6 +65,12 @@ func CreateOrUpdate(ctx context.Context, cl client.Client, o runtime.Object, ski
 		}
 
 		mergeMap(mergePath, existing.Object, desired.Object, skip)
+
+		// Don't issue updates that only differ in their encoding from the live resource,
+		// as they would still bump its resourceVersion and may trigger rollouts
+		if semanticallyEqual("", original.Object, existing.Object) {
+			existing.Object = original.Object
+		}
 		updatedYaml = Dump(existing)
 
 		//if d.GetKind() == "DeploymentConfig" && d.GetName() == "syndesis-meta" {
@@ -61,7 +83,10 @@ func CreateOrUpdate(ctx context.Context, cl client.Client, o runtime.Object, ski
 		fmt.Println("resource", desired.GetKind(), "update:", desired.GetName())
 		fmt.Println(UnifiedDiff(originalYaml, updatedYaml))
 	}
-	return createdCopy, modType, err
+	if modType == controllerutil.OperationResultCreated {
+		live = nil
+	}
+	return createdCopy, live, modType, err
 }
 
 func mergeMap(path string, to map[string]interface{}, from map[string]interface{}, skip map[string]bool) {
@@ -79,6 +104,9 @@ func mergeMap(path string, to map[string]interface{}, from map[string]interface{
 		if skip[field] {
 			continue
 		}
+		if _, live := to[key]; live && immutableFields[field] {
+			continue
+		}
 
 		// handle cases like https://issues.jboss.org/browse/ENTESB-11711 setting a env value to "" does not work well, k8s gives delete
 		// the value field under the covers, and we keep trying to set it again to the "" value.
@@ -205,3 +233,96 @@ func mergeSecretValues(to map[string]interface{}, from map[string]interface{}) {
 		}
 	}
 }
+
+// Compares resources the way the API server would store them: numbers of
+// different types, resource quantities in different formats and zero values
+// against missing fields are considered equal.
+func semanticallyEqual(key string, a interface{}, b interface{}) bool {
+	if isZero(a) && isZero(b) {
+		return true
+	}
+
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range a {
+			if !semanticallyEqual(k, v, b[k]) {
+				return false
+			}
+		}
+		for k, v := range b {
+			if _, found := a[k]; !found && !isZero(v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !semanticallyEqual(key, a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return false
+		}
+		if a == b {
+			return true
+		}
+		if quantityFields[key] {
+			aQ, errA := resource.ParseQuantity(a)
+			bQ, errB := resource.ParseQuantity(b)
+			return errA == nil && errB == nil && aQ.Cmp(bQ) == 0
+		}
+		return false
+	}
+
+	aF, aIsNumber := toFloat(a)
+	bF, bIsNumber := toFloat(b)
+	if aIsNumber && bIsNumber {
+		return aF == bF
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Fields holding resource quantities, e.g. under resources/limits
+var quantityFields = map[string]bool{
+	"cpu":               true,
+	"memory":            true,
+	"storage":           true,
+	"ephemeral-storage": true,
+}
+
+func isZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+	return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,74 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemanticallyEqual(t *testing.T) {
+	live := map[string]interface{}{
+		"replicas": int64(1),
+		"resources": map[string]interface{}{
+			"limits": map[string]interface{}{"memory": "1Gi"},
+		},
+		"ports": []interface{}{
+			map[string]interface{}{"containerPort": int64(8080), "protocol": "TCP"},
+		},
+	}
+
+	assert.True(t, semanticallyEqual("", live, map[string]interface{}{
+		"replicas": float64(1),
+		"resources": map[string]interface{}{
+			"limits":   map[string]interface{}{"memory": "1024Mi"},
+			"requests": map[string]interface{}{},
+		},
+		"ports": []interface{}{
+			map[string]interface{}{"containerPort": float64(8080), "protocol": "TCP", "hostPort": int64(0)},
+		},
+		"paused": false,
+	}))
+
+	assert.False(t, semanticallyEqual("", live, map[string]interface{}{
+		"replicas": float64(2),
+		"resources": map[string]interface{}{
+			"limits": map[string]interface{}{"memory": "1Gi"},
+		},
+		"ports": []interface{}{
+			map[string]interface{}{"containerPort": int64(8080), "protocol": "TCP"},
+		},
+	}))
+	assert.False(t, semanticallyEqual("", "1024Mi", "1Gi"))
+	assert.False(t, semanticallyEqual("memory", "1Gi", "1G"))
+}
+
+func TestMergeMapImmutableFields(t *testing.T) {
+	live := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"storageClassName": "gp2",
+			"accessModes":      []interface{}{"ReadWriteOnce"},
+			"volumeName":       "pvc-0123",
+			"resources":        map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}},
+		},
+	}
+	mergeMap("v1/PersistentVolumeClaim", live, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"storageClassName": "ceph-rbd",
+			"accessModes":      []interface{}{"ReadWriteMany"},
+			"resources":        map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}},
+		},
+	}, map[string]bool{})
+	assert.Equal(t, map[string]interface{}{
+		"storageClassName": "gp2",
+		"accessModes":      []interface{}{"ReadWriteOnce"},
+		"volumeName":       "pvc-0123",
+		"resources":        map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}},
+	}, live["spec"])
+
+	// Fields the live claim doesn't have yet are set
+	created := map[string]interface{}{"spec": map[string]interface{}{}}
+	mergeMap("v1/PersistentVolumeClaim", created, map[string]interface{}{
+		"spec": map[string]interface{}{"storageClassName": "ceph-rbd"},
+	}, map[string]bool{})
+	assert.Equal(t, "ceph-rbd", created["spec"].(map[string]interface{})["storageClassName"])
+}
@@ -0,0 +1,119 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// Faults injected in the requests sent to the API server, exercising how reconciliation retries and
+// recovers in CI and soak tests. Only operators built with the chaos tag inject them.
+type Faults struct {
+	FailEvery     uint64        // Every Nth create, update or delete fails with an internal error
+	ConflictEvery uint64        // Every Nth update fails with a conflict, as if the resource had been modified meanwhile
+	Delay         time.Duration // Delay before sending every request
+}
+
+// Reads the faults from the SYNDESIS_FAULT_FAIL_EVERY, SYNDESIS_FAULT_CONFLICT_EVERY and SYNDESIS_FAULT_DELAY
+// environment variables
+func FaultsFromEnv() (Faults, error) {
+	faults := Faults{}
+	var err error
+	if value := os.Getenv("SYNDESIS_FAULT_FAIL_EVERY"); value != "" {
+		if faults.FailEvery, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return faults, fmt.Errorf("invalid SYNDESIS_FAULT_FAIL_EVERY %q: %v", value, err)
+		}
+	}
+	if value := os.Getenv("SYNDESIS_FAULT_CONFLICT_EVERY"); value != "" {
+		if faults.ConflictEvery, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return faults, fmt.Errorf("invalid SYNDESIS_FAULT_CONFLICT_EVERY %q: %v", value, err)
+		}
+	}
+	if value := os.Getenv("SYNDESIS_FAULT_DELAY"); value != "" {
+		if faults.Delay, err = time.ParseDuration(value); err != nil {
+			return faults, fmt.Errorf("invalid SYNDESIS_FAULT_DELAY %q: %v", value, err)
+		}
+	}
+	return faults, nil
+}
+
+func (f Faults) enabled() bool {
+	return f.FailEvery > 0 || f.ConflictEvery > 0 || f.Delay > 0
+}
+
+// Injects the faults configured in the environment into the requests sent by the clients built from the
+// given config. Does nothing unless the operator is built with the chaos tag.
+func InjectFaults(config *rest.Config) error {
+	if !faultInjection {
+		return nil
+	}
+	faults, err := FaultsFromEnv()
+	if err != nil || !faults.enabled() {
+		return err
+	}
+
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &faultyRoundTripper{next: rt, faults: faults}
+	}
+	return nil
+}
+
+type faultyRoundTripper struct {
+	next    http.RoundTripper
+	faults  Faults
+	writes  uint64
+	updates uint64
+}
+
+func (rt *faultyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.faults.Delay > 0 {
+		time.Sleep(rt.faults.Delay)
+	}
+
+	switch req.Method {
+	case http.MethodPut, http.MethodPatch:
+		if every := rt.faults.ConflictEvery; every > 0 && atomic.AddUint64(&rt.updates, 1)%every == 0 {
+			return faultResponse(req, http.StatusConflict, metav1.StatusReasonConflict, "conflict injected by the chaos build")
+		}
+		fallthrough
+	case http.MethodPost, http.MethodDelete:
+		if every := rt.faults.FailEvery; every > 0 && atomic.AddUint64(&rt.writes, 1)%every == 0 {
+			return faultResponse(req, http.StatusInternalServerError, metav1.StatusReasonInternalError, "failure injected by the chaos build")
+		}
+	}
+	return rt.next.RoundTrip(req)
+}
+
+// Answers the request with the status the API server would send, so that clients decode it as an API error
+func faultResponse(req *http.Request, code int32, reason metav1.StatusReason, message string) (*http.Response, error) {
+	body, err := json.Marshal(metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Code:     code,
+		Reason:   reason,
+		Message:  message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", code, http.StatusText(int(code))),
+		StatusCode: int(code),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
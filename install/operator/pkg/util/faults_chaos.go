@@ -0,0 +1,6 @@
+// +build chaos
+
+package util
+
+// Built for resilience tests, the faults configured in the environment get injected
+const faultInjection = true
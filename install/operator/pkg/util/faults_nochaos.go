@@ -0,0 +1,6 @@
+// +build !chaos
+
+package util
+
+// Faults are only injected by the operators built with the chaos tag
+const faultInjection = false
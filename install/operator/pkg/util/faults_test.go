@@ -0,0 +1,67 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestFaultsFromEnv(t *testing.T) {
+	defer os.Unsetenv("SYNDESIS_FAULT_FAIL_EVERY")
+	defer os.Unsetenv("SYNDESIS_FAULT_DELAY")
+
+	faults, err := FaultsFromEnv()
+	require.NoError(t, err)
+	assert.False(t, faults.enabled())
+
+	os.Setenv("SYNDESIS_FAULT_FAIL_EVERY", "3")
+	os.Setenv("SYNDESIS_FAULT_DELAY", "10ms")
+	faults, err = FaultsFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, Faults{FailEvery: 3, Delay: 10 * time.Millisecond}, faults)
+
+	os.Setenv("SYNDESIS_FAULT_FAIL_EVERY", "-1")
+	_, err = FaultsFromEnv()
+	assert.Error(t, err)
+}
+
+func Test_faultyRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"syndesis"}}`))
+	}))
+	defer server.Close()
+
+	config := &rest.Config{
+		Host: server.URL,
+		ContentConfig: rest.ContentConfig{
+			GroupVersion:         &schema.GroupVersion{Version: "v1"},
+			NegotiatedSerializer: kubernetesscheme.Codecs,
+		},
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			return &faultyRoundTripper{next: rt, faults: Faults{FailEvery: 2, ConflictEvery: 3}}
+		},
+	}
+	api, err := rest.RESTClientFor(config)
+	require.NoError(t, err)
+	update := func() error {
+		return api.Put().Resource("configmaps").Name("syndesis").Body([]byte("{}")).Do().Error()
+	}
+
+	assert.NoError(t, api.Get().Resource("configmaps").Name("syndesis").Do().Error())
+	assert.NoError(t, update())
+	err = update()
+	assert.True(t, k8serrors.IsInternalError(err), err)
+	err = update()
+	assert.True(t, k8serrors.IsConflict(err), err)
+	assert.NoError(t, update())
+}
@@ -0,0 +1,196 @@
+package util
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/runtime/serializer/streaming"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+// Representations asked for when only the metadata is needed. Servers not supporting partial metadata
+// send the whole resources, their metadata is decoded all the same
+const (
+	metadataAccept     = "application/json;as=PartialObjectMetadata;g=meta.k8s.io;v=v1beta1, application/json"
+	metadataListAccept = "application/json;as=PartialObjectMetadataList;g=meta.k8s.io;v=v1beta1, application/json"
+)
+
+// The cache GetObjectMetadata reads from, when it holds the object
+var metadataCache *MetadataCache
+
+// Has GetObjectMetadata read from the cache the objects it holds, instead of asking the API server
+func UseMetadataCache(cache *MetadataCache) {
+	metadataCache = cache
+}
+
+// Fetches only the metadata of a core resource, for when its existence or ownership is all that's needed.
+// It is read from the metadata cache when it holds the object, from the API server otherwise.
+func GetObjectMetadata(api kubernetes.Interface, resource string, namespace string, name string) (*metav1beta1.PartialObjectMetadata, error) {
+	if metadata, cached, err := metadataCache.get(resource, namespace, name); cached {
+		return metadata, err
+	}
+
+	body, err := api.CoreV1().RESTClient().Get().
+		SetHeader("Accept", metadataAccept).
+		Namespace(namespace).
+		Resource(resource).
+		Name(name).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &metav1beta1.PartialObjectMetadata{}
+	if err := json.Unmarshal(body, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// Metadata of the core resources of a namespace, kept up to date by informers watching their metadata only.
+// Unlike the cache of the manager, the content of e.g. the secrets never reaches the memory of the operator,
+// however many of them the integrations create. It is a runnable of the manager.
+type MetadataCache struct {
+	namespace string
+	informers map[string]toolscache.SharedIndexInformer
+
+	lock    sync.RWMutex
+	started bool
+}
+
+func NewMetadataCache(api rest.Interface, namespace string, resources ...string) *MetadataCache {
+	cache := &MetadataCache{
+		namespace: namespace,
+		informers: map[string]toolscache.SharedIndexInformer{},
+	}
+	for _, resource := range resources {
+		cache.informers[resource] = NewMetadataInformer(api, resource, namespace)
+	}
+	return cache
+}
+
+// Runs the informers until stopped
+func (c *MetadataCache) Start(stop <-chan struct{}) error {
+	c.lock.Lock()
+	for _, informer := range c.informers {
+		go informer.Run(stop)
+	}
+	c.started = true
+	c.lock.Unlock()
+
+	<-stop
+	return nil
+}
+
+// Gives the metadata of an object, and whether the cache holds its resource and namespace. Until the
+// informers are synced, nothing is held.
+func (c *MetadataCache) get(resource string, namespace string, name string) (*metav1beta1.PartialObjectMetadata, bool, error) {
+	if c == nil || namespace != c.namespace {
+		return nil, false, nil
+	}
+	c.lock.RLock()
+	started := c.started
+	c.lock.RUnlock()
+	informer, found := c.informers[resource]
+	if !found || !started || !informer.HasSynced() {
+		return nil, false, nil
+	}
+
+	obj, exists, err := informer.GetStore().GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, true, err
+	}
+	if !exists {
+		return nil, true, k8serrors.NewNotFound(schema.GroupResource{Resource: resource}, name)
+	}
+	return obj.(*metav1beta1.PartialObjectMetadata).DeepCopy(), true, nil
+}
+
+// An informer of the metadata of the objects of a core resource in a namespace, all the namespaces when empty
+func NewMetadataInformer(api rest.Interface, resource string, namespace string) toolscache.SharedIndexInformer {
+	lw := &toolscache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			body, err := api.Get().
+				SetHeader("Accept", metadataListAccept).
+				Namespace(namespace).
+				Resource(resource).
+				VersionedParams(&options, metav1.ParameterCodec).
+				DoRaw()
+			if err != nil {
+				return nil, err
+			}
+			list := &partialObjectMetadataList{}
+			if err := json.Unmarshal(body, list); err != nil {
+				return nil, err
+			}
+			return list, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.Watch = true
+			return api.Get().
+				SetHeader("Accept", metadataAccept).
+				Namespace(namespace).
+				Resource(resource).
+				VersionedParams(&options, metav1.ParameterCodec).
+				WatchWithSpecificDecoders(func(body io.ReadCloser) streaming.Decoder {
+					return streaming.NewDecoder(k8sjson.Framer.NewFrameReader(body), watchEventDecoder{})
+				}, metadataDecoder{})
+		},
+	}
+	return toolscache.NewSharedIndexInformer(lw, &metav1beta1.PartialObjectMetadata{}, 0, toolscache.Indexers{})
+}
+
+// A list of partial object metadata. The vendored meta.k8s.io/v1beta1 list lacks the list metadata, whose
+// resource version the informers start watching from
+type partialObjectMetadataList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []metav1beta1.PartialObjectMetadata `json:"items"`
+}
+
+func (l *partialObjectMetadataList) DeepCopyObject() runtime.Object {
+	out := &partialObjectMetadataList{TypeMeta: l.TypeMeta}
+	l.ListMeta.DeepCopyInto(&out.ListMeta)
+	out.Items = make([]metav1beta1.PartialObjectMetadata, len(l.Items))
+	for i := range l.Items {
+		l.Items[i].DeepCopyInto(&out.Items[i])
+	}
+	return out
+}
+
+// Decodes the watch events, their object being left to the metadata decoder
+type watchEventDecoder struct{}
+
+func (watchEventDecoder) Decode(data []byte, defaults *schema.GroupVersionKind, into runtime.Object) (runtime.Object, *schema.GroupVersionKind, error) {
+	return into, defaults, json.Unmarshal(data, into)
+}
+
+// Decodes the objects of the watch events as their metadata, or as the status of the failed watches
+type metadataDecoder struct{}
+
+func (metadataDecoder) Decode(data []byte, defaults *schema.GroupVersionKind, into runtime.Object) (runtime.Object, *schema.GroupVersionKind, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, nil, err
+	}
+	var obj runtime.Object = &metav1beta1.PartialObjectMetadata{}
+	if typeMeta.Kind == "Status" {
+		obj = &metav1.Status{}
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, nil, err
+	}
+	gvk := typeMeta.GroupVersionKind()
+	return obj, &gvk, nil
+}
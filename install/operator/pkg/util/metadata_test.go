@@ -0,0 +1,95 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestMetadataCache(t *testing.T) {
+	var lock sync.Mutex
+	accepted := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/syndesis/secrets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		lock.Lock()
+		accepted = append(accepted, r.Header.Get("Accept"))
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"type": "ADDED", "object": {"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1",
+				"metadata": {"name": "integration-token", "namespace": "syndesis", "resourceVersion": "2"}}}`))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"kind": "PartialObjectMetadataList", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"resourceVersion": "1"}, "items": [
+			{"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1",
+				"metadata": {"name": "syndesis-pull-secret", "namespace": "syndesis", "resourceVersion": "1", "annotations": {"owner": "syndesis"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	api, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	cache := NewMetadataCache(api.CoreV1().RESTClient(), "syndesis", "secrets")
+
+	// Not started, nothing is held
+	_, cached, _ := cache.get("secrets", "syndesis", "syndesis-pull-secret")
+	assert.False(t, cached)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go cache.Start(stop)
+	require.Eventually(t, func() bool {
+		_, cached, err := cache.get("secrets", "syndesis", "integration-token")
+		return cached && err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	metadata, cached, err := cache.get("secrets", "syndesis", "syndesis-pull-secret")
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, "syndesis", metadata.Annotations["owner"])
+
+	_, cached, err = cache.get("secrets", "syndesis", "unknown")
+	assert.True(t, cached)
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// The other namespaces and resources are left to the API server
+	_, cached, _ = cache.get("secrets", "integrations", "syndesis-pull-secret")
+	assert.False(t, cached)
+	_, cached, _ = cache.get("configmaps", "syndesis", "syndesis-pull-secret")
+	assert.False(t, cached)
+
+	UseMetadataCache(cache)
+	defer UseMetadataCache(nil)
+	metadata, err = GetObjectMetadata(api, "secrets", "syndesis", "integration-token")
+	require.NoError(t, err)
+	assert.Equal(t, "2", metadata.ResourceVersion)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, accepted, 2)
+	assert.True(t, strings.HasPrefix(accepted[0], "application/json;as=PartialObjectMetadataList;"), accepted[0])
+	assert.True(t, strings.HasPrefix(accepted[1], "application/json;as=PartialObjectMetadata;"), accepted[1])
+}
+
+func TestMetadataDecoder(t *testing.T) {
+	obj, _, err := metadataDecoder{}.Decode([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "code": 410, "reason": "Expired"}`), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.StatusReasonExpired, obj.(*metav1.Status).Reason)
+}
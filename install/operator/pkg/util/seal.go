@@ -0,0 +1,157 @@
+package util
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Parses the public key of a sealed secrets controller, either the certificate
+// returned by "kubeseal --fetch-cert" or a PEM encoded public key
+func ParseSealingKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found in the sealing key")
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = cert.PublicKey
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = parsed
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported sealing key type: %s", block.Type)
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("the sealing key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// Converts a Secret into a SealedSecret only the sealed secrets controller holding the
+// private key can decrypt, so that it can be stored and applied on another cluster.
+// Values are sealed for the namespace and name of the secret (strict scope).
+func SealSecret(key *rsa.PublicKey, secret unstructured.Unstructured) (unstructured.Unstructured, error) {
+	values := map[string][]byte{}
+	data, _, err := unstructured.NestedStringMap(secret.Object, "data")
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	for name, value := range data {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return unstructured.Unstructured{}, err
+		}
+		values[name] = decoded
+	}
+	stringData, _, err := unstructured.NestedStringMap(secret.Object, "stringData")
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	for name, value := range stringData {
+		values[name] = []byte(value)
+	}
+
+	label := []byte(secret.GetNamespace() + "/" + secret.GetName())
+	encryptedData := map[string]interface{}{}
+	for name, value := range values {
+		encrypted, err := hybridEncrypt(rand.Reader, key, value, label)
+		if err != nil {
+			return unstructured.Unstructured{}, err
+		}
+		encryptedData[name] = base64.StdEncoding.EncodeToString(encrypted)
+	}
+
+	templateMetadata := map[string]interface{}{
+		"name":      secret.GetName(),
+		"namespace": secret.GetNamespace(),
+	}
+	if labels := secret.GetLabels(); len(labels) > 0 {
+		templateMetadata["labels"] = toInterfaceMap(labels)
+	}
+	if annotations := secret.GetAnnotations(); len(annotations) > 0 {
+		templateMetadata["annotations"] = toInterfaceMap(annotations)
+	}
+	template := map[string]interface{}{
+		"metadata": templateMetadata,
+	}
+	if secretType, found, _ := unstructured.NestedString(secret.Object, "type"); found {
+		template["type"] = secretType
+	}
+
+	sealed := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"encryptedData": encryptedData,
+			"template":      template,
+		},
+	}}
+	sealed.SetAPIVersion("bitnami.com/v1alpha1")
+	sealed.SetKind("SealedSecret")
+	sealed.SetName(secret.GetName())
+	sealed.SetNamespace(secret.GetNamespace())
+	sealed.SetLabels(secret.GetLabels())
+	return sealed, nil
+}
+
+// Encrypts with a random AES-GCM session key, itself encrypted with RSA-OAEP,
+// as expected by the sealed secrets controller
+func hybridEncrypt(rnd io.Reader, key *rsa.PublicKey, plaintext []byte, label []byte) ([]byte, error) {
+	sessionKey := make([]byte, 32)
+	if _, err := io.ReadFull(rnd, sessionKey); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaCiphertext, err := rsa.EncryptOAEP(sha256.New(), rnd, key, sessionKey, label)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := make([]byte, 2, 2+len(rsaCiphertext)+len(plaintext)+aead.Overhead())
+	binary.BigEndian.PutUint16(ciphertext, uint16(len(rsaCiphertext)))
+	ciphertext = append(ciphertext, rsaCiphertext...)
+
+	// The session key is only ever used once, a zero nonce is safe
+	zeroNonce := make([]byte, aead.NonceSize())
+	return aead.Seal(ciphertext, zeroNonce, plaintext, nil), nil
+}
+
+func toInterfaceMap(values map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		result[k] = v
+	}
+	return result
+}
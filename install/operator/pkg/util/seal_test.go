@@ -0,0 +1,67 @@
+package util
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func hybridDecrypt(t *testing.T, key *rsa.PrivateKey, ciphertext []byte, label []byte) []byte {
+	rsaLen := int(binary.BigEndian.Uint16(ciphertext))
+	sessionKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, ciphertext[2:2+rsaLen], label)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(sessionKey)
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext[2+rsaLen:], nil)
+	require.NoError(t, err)
+	return plaintext
+}
+
+func TestSealSecret(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	publicKey, err := ParseSealingKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	secret := unstructured.Unstructured{Object: map[string]interface{}{
+		"stringData": map[string]interface{}{"password": "secret"},
+		"data":       map[string]interface{}{"key": base64.StdEncoding.EncodeToString([]byte("value"))},
+	}}
+	secret.SetKind("Secret")
+	secret.SetName("syndesis-global-config")
+	secret.SetNamespace("syndesis")
+	secret.SetLabels(map[string]string{"app": "syndesis"})
+
+	sealed, err := SealSecret(publicKey, secret)
+	require.NoError(t, err)
+
+	assert.Equal(t, "SealedSecret", sealed.GetKind())
+	assert.Equal(t, "syndesis", sealed.GetNamespace())
+	name, _, _ := unstructured.NestedString(sealed.Object, "spec", "template", "metadata", "name")
+	assert.Equal(t, "syndesis-global-config", name)
+
+	encrypted, _, _ := unstructured.NestedStringMap(sealed.Object, "spec", "encryptedData")
+	label := []byte("syndesis/syndesis-global-config")
+	for key, expected := range map[string]string{"password": "secret", "key": "value"} {
+		ciphertext, err := base64.StdEncoding.DecodeString(encrypted[key])
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(hybridDecrypt(t, privateKey, ciphertext, label)))
+	}
+}
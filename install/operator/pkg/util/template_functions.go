@@ -3,9 +3,14 @@ package util
 import "strings"
 
 func TagOf(image string) string {
-	splits := strings.Split(image, ":")
+	// Images pinned to a digest keep their tag in front of it
+	splits := strings.Split(strings.Split(image, "@")[0], ":")
 	if len(splits) == 1 {
 		return "latest"
 	}
 	return splits[len(splits)-1]
 }
+
+func ImageStreamOf(imageStreamTag string) string {
+	return strings.Split(imageStreamTag, ":")[0]
+}
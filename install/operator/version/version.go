@@ -1,5 +1,42 @@
 package version
 
+import (
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg"
+)
+
 var (
 	Version = "0.0.1"
+
+	// Set at build time with -ldflags "-X ..."
+	GitCommit = ""
+
+	// Comma separated lists, set at build time with -ldflags "-X ..."
+	SyndesisVersions = ""
+	UpgradeFrom      = ""
 )
+
+// SupportedSyndesisVersions returns the Syndesis versions this operator is able to install,
+// defaulting to the operator image tag when none were set at build time
+func SupportedSyndesisVersions() []string {
+	if versions := split(SyndesisVersions); len(versions) > 0 {
+		return versions
+	}
+	return []string{pkg.DefaultOperatorTag}
+}
+
+// SupportedUpgradeSources returns the Syndesis versions this operator is able to upgrade from
+func SupportedUpgradeSources() []string {
+	return split(UpgradeFrom)
+}
+
+func split(list string) []string {
+	result := []string{}
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}